@@ -358,12 +358,16 @@ func computeLCS(a, b [][]byte, buf *bytes.Buffer, config DiffConfig) bool {
 	return generateDiffFromLCS(a, b, lcs, buf, config)
 }
 
-// generateDiffFromLCS generates diff output from LCS table
+// generateDiffFromLCS generates diff output from LCS table.
+// The table is walked backwards, so lines are collected first and written in
+// file order once the walk completes.
 func generateDiffFromLCS(a, b [][]byte, lcs [][]int, buf *bytes.Buffer, config DiffConfig) bool {
 	i, j := len(a), len(b)
 
+	var lines []string
+	size := buf.Len()
 	for i > 0 || j > 0 {
-		if buf.Len() > config.MaxDiffSize {
+		if size > config.MaxDiffSize {
 			return false
 		}
 
@@ -373,15 +377,22 @@ func generateDiffFromLCS(a, b [][]byte, lcs [][]int, buf *bytes.Buffer, config D
 			j--
 		} else if j > 0 && (i == 0 || lcs[i][j-1] >= lcs[i-1][j]) {
 			// Line added
-			fmt.Fprintf(buf, "+%s\n", string(b[j-1]))
+			lines = append(lines, "+"+string(b[j-1]))
+			size += len(b[j-1]) + 2
 			j--
 		} else if i > 0 {
 			// Line deleted
-			fmt.Fprintf(buf, "-%s\n", string(a[i-1]))
+			lines = append(lines, "-"+string(a[i-1]))
+			size += len(a[i-1]) + 2
 			i--
 		}
 	}
 
+	for k := len(lines) - 1; k >= 0; k-- {
+		buf.WriteString(lines[k])
+		buf.WriteByte('\n')
+	}
+
 	return true
 }
 
@@ -259,6 +259,15 @@ func TestDiffOptimized(t *testing.T) {
 	}
 }
 
+func TestDiffOptimized_LineOrder(t *testing.T) {
+	a := []byte("one\ntwo\nthree\nfour")
+	b := []byte("one\n2\nthree\nfour\nfive")
+
+	diff, ok := DiffOptimized(a, b, 1024)
+	require.True(t, ok)
+	assert.Equal(t, "-two\n+2\n+five\n", string(diff))
+}
+
 func TestDiffOptimizedWithConfig(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -0,0 +1,565 @@
+// Package bitbucket provides a Bitbucket Cloud client for go-broadcast.
+//
+// The client implements gh.Client so the sync engine stays provider-agnostic.
+// Only the operations a sync needs are backed by the Bitbucket REST API 2.0:
+// branches, files, commits, pull requests and the current user. Everything
+// else returns ErrNotSupported.
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+// DefaultBaseURL is the Bitbucket Cloud REST API root
+const DefaultBaseURL = "https://api.bitbucket.org/2.0"
+
+const (
+	// defaultTimeout bounds a single API request
+	defaultTimeout = 30 * time.Second
+
+	// pageLength is the page size requested from paginated endpoints
+	pageLength = 100
+
+	// maxErrorBodySize caps how much of an error response is kept in errors
+	maxErrorBodySize = 4 * 1024
+)
+
+// Environment variables read by ConfigFromEnv
+const (
+	EnvUsername    = "BITBUCKET_USERNAME"
+	EnvAppPassword = "BITBUCKET_APP_PASSWORD" //nolint:gosec // G101: environment variable name, not a credential
+	EnvToken       = "BITBUCKET_TOKEN"        //nolint:gosec // G101: environment variable name, not a credential
+)
+
+// Bitbucket client errors
+var (
+	ErrNotSupported       = errors.New("operation not supported by the Bitbucket provider")
+	ErrNoCredentials      = errors.New("bitbucket credentials not set: use BITBUCKET_TOKEN or BITBUCKET_USERNAME and BITBUCKET_APP_PASSWORD")
+	ErrInvalidRepo        = errors.New("repository must be in workspace/repo_slug format")
+	ErrAPIRequestFailed   = errors.New("bitbucket API request failed")
+	ErrInvalidPRState     = errors.New("unsupported pull request state")
+	errUnexpectedRedirect = errors.New("unexpected redirect from Bitbucket API")
+)
+
+// Config holds the Bitbucket credentials. Either Token (a repository,
+// workspace or OAuth access token) or Username with AppPassword is required.
+type Config struct {
+	BaseURL     string
+	Username    string
+	AppPassword string
+	Token       string
+}
+
+// ConfigFromEnv reads credentials from BITBUCKET_TOKEN, or from
+// BITBUCKET_USERNAME and BITBUCKET_APP_PASSWORD
+func ConfigFromEnv() Config {
+	return Config{
+		Username:    strings.TrimSpace(os.Getenv(EnvUsername)),
+		AppPassword: strings.TrimSpace(os.Getenv(EnvAppPassword)),
+		Token:       strings.TrimSpace(os.Getenv(EnvToken)),
+	}
+}
+
+// Validate checks that a complete set of credentials is configured
+func (c Config) Validate() error {
+	if c.Token != "" || (c.Username != "" && c.AppPassword != "") {
+		return nil
+	}
+	return ErrNoCredentials
+}
+
+// Client talks to the Bitbucket Cloud REST API
+type Client struct {
+	cfg        Config
+	httpClient *http.Client
+	logger     *logrus.Logger
+
+	mu              sync.Mutex
+	currentUser     *gh.User
+	defaultBranches map[string]string
+}
+
+// Compile-time check that Client can stand in for the GitHub client
+var _ gh.Client = (*Client)(nil)
+
+// NewClient creates a Bitbucket client. The credentials are checked against
+// the API by looking up the current user.
+func NewClient(ctx context.Context, cfg Config, logger *logrus.Logger) (*Client, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	client := NewClientWithHTTP(cfg, &http.Client{Timeout: defaultTimeout}, logger)
+	if _, err := client.GetCurrentUser(ctx); err != nil {
+		return nil, fmt.Errorf("verify bitbucket credentials: %w", err)
+	}
+	return client, nil
+}
+
+// NewClientWithHTTP creates a Bitbucket client using httpClient without
+// verifying the credentials (useful for testing)
+func NewClientWithHTTP(cfg Config, httpClient *http.Client, logger *logrus.Logger) *Client {
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = DefaultBaseURL
+	}
+	cfg.BaseURL = strings.TrimRight(cfg.BaseURL, "/")
+	if logger == nil {
+		logger = logrus.StandardLogger()
+	}
+	return &Client{
+		cfg:             cfg,
+		httpClient:      httpClient,
+		logger:          logger,
+		defaultBranches: make(map[string]string),
+	}
+}
+
+// repoPath returns the API path of a workspace/repo_slug repository
+func repoPath(repo string) (string, error) {
+	workspace, slug, ok := strings.Cut(repo, "/")
+	if !ok || workspace == "" || slug == "" || strings.Contains(slug, "/") {
+		return "", fmt.Errorf("%w: %q", ErrInvalidRepo, repo)
+	}
+	return "/repositories/" + url.PathEscape(workspace) + "/" + url.PathEscape(slug), nil
+}
+
+// apiError is returned for non-2xx responses
+type apiError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s: status %d: %s", ErrAPIRequestFailed, e.StatusCode, e.Body)
+}
+
+func (e *apiError) Unwrap() error {
+	return ErrAPIRequestFailed
+}
+
+// statusCode returns the HTTP status of an API error, or 0
+func statusCode(err error) int {
+	var apiErr *apiError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// do sends a request to path (relative to the API root, or absolute for
+// pagination links) and returns the response body
+func (c *Client) do(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	target := path
+	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
+		target = c.cfg.BaseURL + path
+	}
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("marshal request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target, reader)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.cfg.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.cfg.Token)
+	} else {
+		req.SetBasicAuth(c.cfg.Username, c.cfg.AppPassword)
+	}
+
+	c.logger.WithFields(logrus.Fields{
+		"method": method,
+		"url":    target,
+	}).Debug("Bitbucket API request")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", method, path, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		if len(data) > maxErrorBodySize {
+			data = data[:maxErrorBodySize]
+		}
+		if resp.StatusCode < http.StatusBadRequest {
+			return nil, fmt.Errorf("%w: status %d", errUnexpectedRedirect, resp.StatusCode)
+		}
+		return nil, &apiError{StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(data))}
+	}
+	return data, nil
+}
+
+// doJSON sends a request and decodes the JSON response into out
+func (c *Client) doJSON(ctx context.Context, method, path string, body, out interface{}) error {
+	data, err := c.do(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("decode %s response: %w", path, err)
+	}
+	return nil
+}
+
+// getPages follows the "next" links of a paginated endpoint and returns every value
+func getPages[T any](ctx context.Context, c *Client, path string) ([]T, error) {
+	var all []T
+	next := path
+	for next != "" {
+		var page paginated[T]
+		if err := c.doJSON(ctx, http.MethodGet, next, nil, &page); err != nil {
+			return nil, err
+		}
+		all = append(all, page.Values...)
+		next = page.Next
+	}
+	return all, nil
+}
+
+// ListBranches returns all branches for a repository
+func (c *Client) ListBranches(ctx context.Context, repo string) ([]gh.Branch, error) {
+	base, err := repoPath(repo)
+	if err != nil {
+		return nil, err
+	}
+	refs, err := getPages[branchRef](ctx, c, fmt.Sprintf("%s/refs/branches?pagelen=%d", base, pageLength))
+	if err != nil {
+		return nil, fmt.Errorf("list branches: %w", err)
+	}
+
+	branches := make([]gh.Branch, 0, len(refs))
+	for _, ref := range refs {
+		branches = append(branches, ref.toBranch())
+	}
+	return branches, nil
+}
+
+// GetBranch returns details for a specific branch
+func (c *Client) GetBranch(ctx context.Context, repo, branch string) (*gh.Branch, error) {
+	base, err := repoPath(repo)
+	if err != nil {
+		return nil, err
+	}
+	var ref branchRef
+	if err := c.doJSON(ctx, http.MethodGet, base+"/refs/branches/"+url.PathEscape(branch), nil, &ref); err != nil {
+		if statusCode(err) == http.StatusNotFound {
+			return nil, gh.ErrBranchNotFound
+		}
+		return nil, fmt.Errorf("get branch: %w", err)
+	}
+	result := ref.toBranch()
+	return &result, nil
+}
+
+// DeleteBranch deletes a branch from the repository
+func (c *Client) DeleteBranch(ctx context.Context, repo, branch string) error {
+	base, err := repoPath(repo)
+	if err != nil {
+		return err
+	}
+	if _, err := c.do(ctx, http.MethodDelete, base+"/refs/branches/"+url.PathEscape(branch), nil); err != nil {
+		if statusCode(err) == http.StatusNotFound {
+			return gh.ErrBranchNotFound
+		}
+		return fmt.Errorf("delete branch: %w", err)
+	}
+	return nil
+}
+
+// GetFile retrieves file contents from a repository. An empty ref reads the
+// repository's main branch.
+func (c *Client) GetFile(ctx context.Context, repo, path, ref string) (*gh.FileContent, error) {
+	base, err := repoPath(repo)
+	if err != nil {
+		return nil, err
+	}
+	if ref == "" {
+		if ref, err = c.defaultBranch(ctx, repo); err != nil {
+			return nil, err
+		}
+	}
+
+	escaped := make([]string, 0, strings.Count(path, "/")+1)
+	for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		escaped = append(escaped, url.PathEscape(part))
+	}
+
+	content, err := c.do(ctx, http.MethodGet, base+"/src/"+url.PathEscape(ref)+"/"+strings.Join(escaped, "/"), nil)
+	if err != nil {
+		if statusCode(err) == http.StatusNotFound {
+			return nil, gh.ErrFileNotFound
+		}
+		return nil, fmt.Errorf("get file: %w", err)
+	}
+	return &gh.FileContent{Path: path, Content: content}, nil
+}
+
+// defaultBranch returns the repository's main branch, cached per repository
+func (c *Client) defaultBranch(ctx context.Context, repo string) (string, error) {
+	c.mu.Lock()
+	branch, ok := c.defaultBranches[repo]
+	c.mu.Unlock()
+	if ok {
+		return branch, nil
+	}
+
+	base, err := repoPath(repo)
+	if err != nil {
+		return "", err
+	}
+	var info repository
+	if err := c.doJSON(ctx, http.MethodGet, base, nil, &info); err != nil {
+		if statusCode(err) == http.StatusNotFound {
+			return "", gh.ErrRepositoryNotFound
+		}
+		return "", fmt.Errorf("get repository: %w", err)
+	}
+
+	c.mu.Lock()
+	c.defaultBranches[repo] = info.MainBranch.Name
+	c.mu.Unlock()
+	return info.MainBranch.Name, nil
+}
+
+// GetCommit retrieves commit details
+func (c *Client) GetCommit(ctx context.Context, repo, sha string) (*gh.Commit, error) {
+	base, err := repoPath(repo)
+	if err != nil {
+		return nil, err
+	}
+	var result commit
+	if err := c.doJSON(ctx, http.MethodGet, base+"/commit/"+url.PathEscape(sha), nil, &result); err != nil {
+		if statusCode(err) == http.StatusNotFound {
+			return nil, gh.ErrCommitNotFound
+		}
+		return nil, fmt.Errorf("get commit: %w", err)
+	}
+	return result.toCommit(), nil
+}
+
+// CreatePR creates a new pull request. Bitbucket has no pull request labels
+// or assignees, so those fields are ignored, and reviewers must be account
+// IDs or {uuid} values because Bitbucket does not accept usernames.
+func (c *Client) CreatePR(ctx context.Context, repo string, req gh.PRRequest) (*gh.PR, error) {
+	base, err := repoPath(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Labels) > 0 || len(req.Assignees) > 0 || len(req.TeamReviewers) > 0 {
+		c.logger.WithFields(logrus.Fields{
+			"repo":           repo,
+			"labels":         req.Labels,
+			"assignees":      req.Assignees,
+			"team_reviewers": req.TeamReviewers,
+		}).Warn("Bitbucket pull requests do not support labels, assignees or team reviewers; ignoring them")
+	}
+
+	body := createPullRequest{
+		Title:       req.Title,
+		Description: req.Body,
+		Draft:       req.Draft,
+		Source:      endpoint{Branch: branchName{Name: req.Head}},
+		Destination: endpoint{Branch: branchName{Name: req.Base}},
+		Reviewers:   toReviewers(req.Reviewers),
+	}
+
+	var result pullRequest
+	if err := c.doJSON(ctx, http.MethodPost, base+"/pullrequests", body, &result); err != nil {
+		if statusCode(err) == http.StatusBadRequest {
+			return nil, fmt.Errorf("%w: %w", gh.ErrPRValidationFailed, err)
+		}
+		return nil, fmt.Errorf("create pull request: %w", err)
+	}
+	return result.toPR(repo), nil
+}
+
+// GetPR retrieves a pull request by number
+func (c *Client) GetPR(ctx context.Context, repo string, number int) (*gh.PR, error) {
+	base, err := repoPath(repo)
+	if err != nil {
+		return nil, err
+	}
+	var result pullRequest
+	if err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("%s/pullrequests/%d", base, number), nil, &result); err != nil {
+		if statusCode(err) == http.StatusNotFound {
+			return nil, gh.ErrPRNotFound
+		}
+		return nil, fmt.Errorf("get pull request: %w", err)
+	}
+	return result.toPR(repo), nil
+}
+
+// ListPRs lists pull requests for a repository. state is "open", "closed"
+// (merged, declined or superseded) or "all".
+func (c *Client) ListPRs(ctx context.Context, repo, state string) ([]gh.PR, error) {
+	base, err := repoPath(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("pagelen", "50")
+	switch state {
+	case "", "open":
+		query.Add("state", stateOpen)
+	case "closed":
+		query.Add("state", stateMerged)
+		query.Add("state", stateDeclined)
+		query.Add("state", stateSuperseded)
+	case "all":
+		query.Add("state", stateOpen)
+		query.Add("state", stateMerged)
+		query.Add("state", stateDeclined)
+		query.Add("state", stateSuperseded)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrInvalidPRState, state)
+	}
+
+	results, err := getPages[pullRequest](ctx, c, base+"/pullrequests?"+query.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("list pull requests: %w", err)
+	}
+
+	prs := make([]gh.PR, 0, len(results))
+	for i := range results {
+		prs = append(prs, *results[i].toPR(repo))
+	}
+	return prs, nil
+}
+
+// UpdatePR updates a pull request. Closing a pull request declines it.
+func (c *Client) UpdatePR(ctx context.Context, repo string, number int, updates gh.PRUpdate) error {
+	base, err := repoPath(repo)
+	if err != nil {
+		return err
+	}
+	path := fmt.Sprintf("%s/pullrequests/%d", base, number)
+
+	if updates.Body != nil || updates.Draft != nil {
+		// Bitbucket requires the title on every update, so start from the current pull request
+		var current pullRequest
+		if err := c.doJSON(ctx, http.MethodGet, path, nil, &current); err != nil {
+			return c.prUpdateError(err)
+		}
+		update := updatePullRequest{Title: current.Title, Description: current.Description, Draft: current.Draft}
+		if updates.Body != nil {
+			update.Description = *updates.Body
+		}
+		if updates.Draft != nil {
+			update.Draft = *updates.Draft
+		}
+		if err := c.doJSON(ctx, http.MethodPut, path, update, nil); err != nil {
+			return c.prUpdateError(err)
+		}
+	}
+
+	if updates.State != nil {
+		switch *updates.State {
+		case "closed":
+			if _, err := c.do(ctx, http.MethodPost, path+"/decline", nil); err != nil {
+				return c.prUpdateError(err)
+			}
+		case "open":
+			// Bitbucket cannot reopen a declined pull request
+			return fmt.Errorf("%w: reopening pull requests", ErrNotSupported)
+		default:
+			return fmt.Errorf("%w: %q", ErrInvalidPRState, *updates.State)
+		}
+	}
+	return nil
+}
+
+// prUpdateError maps pull request update failures to the gh sentinel errors
+func (c *Client) prUpdateError(err error) error {
+	switch statusCode(err) {
+	case http.StatusNotFound:
+		return gh.ErrPRNotFound
+	case http.StatusConflict:
+		return fmt.Errorf("%w: %w", gh.ErrPRUpdateConflict, err)
+	default:
+		return fmt.Errorf("update pull request: %w", err)
+	}
+}
+
+// ClosePR declines a pull request, commenting first when comment is set
+func (c *Client) ClosePR(ctx context.Context, repo string, number int, comment string) error {
+	if comment != "" {
+		if err := c.AddPRComment(ctx, repo, number, comment); err != nil {
+			c.logger.WithError(err).Warn("Failed to add comment before declining pull request")
+		}
+	}
+	closed := "closed"
+	return c.UpdatePR(ctx, repo, number, gh.PRUpdate{State: &closed})
+}
+
+// AddPRComment adds a comment to a pull request
+func (c *Client) AddPRComment(ctx context.Context, repo string, number int, comment string) error {
+	base, err := repoPath(repo)
+	if err != nil {
+		return err
+	}
+	body := map[string]interface{}{"content": map[string]string{"raw": comment}}
+	if _, err := c.do(ctx, http.MethodPost, fmt.Sprintf("%s/pullrequests/%d/comments", base, number), body); err != nil {
+		if statusCode(err) == http.StatusNotFound {
+			return gh.ErrPRNotFound
+		}
+		return fmt.Errorf("add pull request comment: %w", err)
+	}
+	return nil
+}
+
+// GetCurrentUser returns the authenticated user. Login is the Bitbucket
+// nickname; reviewers are matched against it when filtering out the author.
+func (c *Client) GetCurrentUser(ctx context.Context) (*gh.User, error) {
+	c.mu.Lock()
+	if c.currentUser != nil {
+		user := c.currentUser
+		c.mu.Unlock()
+		return user, nil
+	}
+	c.mu.Unlock()
+
+	var result account
+	if err := c.doJSON(ctx, http.MethodGet, "/user", nil, &result); err != nil {
+		return nil, fmt.Errorf("get current user: %w", err)
+	}
+	user := &gh.User{Login: result.login(), Name: result.DisplayName}
+
+	c.mu.Lock()
+	c.currentUser = user
+	c.mu.Unlock()
+	return user, nil
+}
@@ -0,0 +1,297 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+// newTestClient starts a server with handler and returns a client pointed at it
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return NewClientWithHTTP(Config{BaseURL: server.URL, Token: "secret"}, server.Client(), logger)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, Config{Token: "t"}.Validate())
+	require.NoError(t, Config{Username: "u", AppPassword: "p"}.Validate())
+	require.ErrorIs(t, Config{Username: "u"}.Validate(), ErrNoCredentials)
+	require.ErrorIs(t, Config{}.Validate(), ErrNoCredentials)
+}
+
+func TestRepoPath(t *testing.T) {
+	t.Parallel()
+
+	path, err := repoPath("acme/widgets")
+	require.NoError(t, err)
+	assert.Equal(t, "/repositories/acme/widgets", path)
+
+	for _, repo := range []string{"widgets", "/widgets", "acme/", "acme/widgets/extra"} {
+		_, err := repoPath(repo)
+		require.ErrorIs(t, err, ErrInvalidRepo, repo)
+	}
+}
+
+func TestClient_ListBranches(t *testing.T) {
+	t.Parallel()
+
+	var server string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.Equal(t, "/repositories/acme/widgets/refs/branches", r.URL.Path)
+		if r.URL.Query().Get("page") == "2" {
+			_, _ = fmt.Fprint(w, `{"values":[{"name":"chore/sync-files-ci-20250130-120000-abc123","target":{"hash":"222"}}]}`)
+			return
+		}
+		server = "http://" + r.Host
+		_, _ = fmt.Fprintf(w, `{"values":[{"name":"main","target":{"hash":"111"}}],"next":"%s/repositories/acme/widgets/refs/branches?page=2"}`, server)
+	})
+
+	branches, err := client.ListBranches(context.Background(), "acme/widgets")
+	require.NoError(t, err)
+	require.Len(t, branches, 2)
+	assert.Equal(t, "main", branches[0].Name)
+	assert.Equal(t, "111", branches[0].Commit.SHA)
+	assert.Equal(t, "chore/sync-files-ci-20250130-120000-abc123", branches[1].Name)
+}
+
+func TestClient_GetBranchAndDeleteBranch_NotFound(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repositories/acme/widgets/refs/branches/chore/sync", r.URL.Path)
+		assert.Equal(t, "/repositories/acme/widgets/refs/branches/chore%2Fsync", r.URL.EscapedPath())
+		http.Error(w, `{"type":"error"}`, http.StatusNotFound)
+	})
+
+	_, err := client.GetBranch(context.Background(), "acme/widgets", "chore/sync")
+	require.ErrorIs(t, err, gh.ErrBranchNotFound)
+	require.ErrorIs(t, client.DeleteBranch(context.Background(), "acme/widgets", "chore/sync"), gh.ErrBranchNotFound)
+}
+
+func TestClient_GetFile(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repositories/acme/widgets":
+			_, _ = fmt.Fprint(w, `{"mainbranch":{"name":"develop"}}`)
+		case "/repositories/acme/widgets/src/develop/.github/ci.yml":
+			_, _ = fmt.Fprint(w, "name: ci\n")
+		case "/repositories/acme/widgets/src/abc123/.github/ci.yml":
+			_, _ = fmt.Fprint(w, "name: old\n")
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	file, err := client.GetFile(context.Background(), "acme/widgets", ".github/ci.yml", "")
+	require.NoError(t, err)
+	assert.Equal(t, "name: ci\n", string(file.Content))
+
+	file, err = client.GetFile(context.Background(), "acme/widgets", ".github/ci.yml", "abc123")
+	require.NoError(t, err)
+	assert.Equal(t, "name: old\n", string(file.Content))
+
+	_, err = client.GetFile(context.Background(), "acme/widgets", "missing.txt", "abc123")
+	require.ErrorIs(t, err, gh.ErrFileNotFound)
+}
+
+func TestClient_GetCommit(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, `{"hash":"abc123","message":"fix: thing","date":"2025-01-30T12:00:00+00:00",
+			"author":{"raw":"Jane Doe <jane@example.com>"},"parents":[{"hash":"def456"}]}`)
+	})
+
+	commit, err := client.GetCommit(context.Background(), "acme/widgets", "abc123")
+	require.NoError(t, err)
+	assert.Equal(t, "abc123", commit.SHA)
+	assert.Equal(t, "fix: thing", commit.Commit.Message)
+	assert.Equal(t, "Jane Doe", commit.Commit.Author.Name)
+	assert.Equal(t, "jane@example.com", commit.Commit.Author.Email)
+	require.Len(t, commit.Parents, 1)
+	assert.Equal(t, "def456", commit.Parents[0].SHA)
+}
+
+func TestClient_CreatePR(t *testing.T) {
+	t.Parallel()
+
+	var got createPullRequest
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/repositories/acme/widgets/pullrequests", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		_, _ = fmt.Fprint(w, `{"id":7,"title":"Sync","state":"OPEN","draft":true,
+			"source":{"branch":{"name":"chore/sync"},"commit":{"hash":"aaa"}},
+			"destination":{"branch":{"name":"main"}},"author":{"nickname":"bot"}}`)
+	})
+
+	pr, err := client.CreatePR(context.Background(), "acme/widgets", gh.PRRequest{
+		Title:     "Sync",
+		Body:      "body",
+		Head:      "chore/sync",
+		Base:      "main",
+		Draft:     true,
+		Reviewers: []string{"{b6f3c0a2-1111-2222-3333-444455556666}", "557058:abcd", " "},
+		Labels:    []string{"ignored"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "chore/sync", got.Source.Branch.Name)
+	assert.Equal(t, "main", got.Destination.Branch.Name)
+	assert.True(t, got.Draft)
+	assert.Equal(t, []account{
+		{UUID: "{b6f3c0a2-1111-2222-3333-444455556666}"},
+		{AccountID: "557058:abcd"},
+	}, got.Reviewers)
+
+	assert.Equal(t, 7, pr.Number)
+	assert.Equal(t, "open", pr.State)
+	assert.True(t, pr.Draft)
+	assert.Equal(t, "chore/sync", pr.Head.Ref)
+	assert.Equal(t, "aaa", pr.Head.SHA)
+	assert.Equal(t, "main", pr.Base.Ref)
+	assert.Equal(t, "bot", pr.User.Login)
+	assert.Equal(t, "acme/widgets", pr.Repo)
+}
+
+func TestClient_ListPRs(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		states := r.URL.Query()["state"]
+		if len(states) == 1 {
+			assert.Equal(t, []string{stateOpen}, states)
+			_, _ = fmt.Fprint(w, `{"values":[{"id":1,"state":"OPEN"}]}`)
+			return
+		}
+		assert.Equal(t, []string{stateMerged, stateDeclined, stateSuperseded}, states)
+		_, _ = fmt.Fprint(w, `{"values":[{"id":2,"state":"MERGED"},{"id":3,"state":"DECLINED"}]}`)
+	})
+
+	open, err := client.ListPRs(context.Background(), "acme/widgets", "open")
+	require.NoError(t, err)
+	require.Len(t, open, 1)
+	assert.Equal(t, "open", open[0].State)
+
+	closed, err := client.ListPRs(context.Background(), "acme/widgets", "closed")
+	require.NoError(t, err)
+	require.Len(t, closed, 2)
+	assert.Equal(t, "closed", closed[0].State)
+	assert.NotNil(t, closed[0].MergedAt)
+	assert.Nil(t, closed[1].MergedAt)
+
+	_, err = client.ListPRs(context.Background(), "acme/widgets", "merged")
+	require.ErrorIs(t, err, ErrInvalidPRState)
+}
+
+func TestClient_UpdatePR(t *testing.T) {
+	t.Parallel()
+
+	var (
+		put      updatePullRequest
+		declined bool
+		comment  map[string]map[string]string
+	)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repositories/acme/widgets/pullrequests/7":
+			_, _ = fmt.Fprint(w, `{"id":7,"title":"Sync","description":"old","draft":true}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/repositories/acme/widgets/pullrequests/7":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&put))
+			_, _ = fmt.Fprint(w, `{}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/repositories/acme/widgets/pullrequests/7/comments":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&comment))
+			_, _ = fmt.Fprint(w, `{}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/repositories/acme/widgets/pullrequests/7/decline":
+			declined = true
+			_, _ = fmt.Fprint(w, `{}`)
+		case r.URL.Path == "/repositories/acme/widgets/pullrequests/8":
+			http.Error(w, "conflict", http.StatusConflict)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	ctx := context.Background()
+
+	body := "new"
+	ready := false
+	require.NoError(t, client.UpdatePR(ctx, "acme/widgets", 7, gh.PRUpdate{Body: &body, Draft: &ready}))
+	assert.Equal(t, updatePullRequest{Title: "Sync", Description: "new", Draft: false}, put)
+
+	require.NoError(t, client.ClosePR(ctx, "acme/widgets", 7, "superseded"))
+	assert.True(t, declined)
+	assert.Equal(t, "superseded", comment["content"]["raw"])
+
+	require.ErrorIs(t, client.UpdatePR(ctx, "acme/widgets", 8, gh.PRUpdate{Body: &body}), gh.ErrPRUpdateConflict)
+	require.ErrorIs(t, client.UpdatePR(ctx, "acme/widgets", 9, gh.PRUpdate{Body: &body}), gh.ErrPRNotFound)
+
+	reopen := "open"
+	require.ErrorIs(t, client.UpdatePR(ctx, "acme/widgets", 7, gh.PRUpdate{State: &reopen}), ErrNotSupported)
+}
+
+func TestClient_GetCurrentUser(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, "/user", r.URL.Path)
+		_, _ = fmt.Fprint(w, `{"nickname":"jdoe","display_name":"Jane Doe","account_id":"557058:abcd"}`)
+	})
+
+	user, err := client.GetCurrentUser(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "jdoe", user.Login)
+	assert.Equal(t, "Jane Doe", user.Name)
+
+	_, err = client.GetCurrentUser(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls, "current user is cached")
+}
+
+func TestClient_BasicAuthAndAPIError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "jdoe", username)
+		assert.Equal(t, "app-pass", password)
+		http.Error(w, "server exploded", http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClientWithHTTP(Config{BaseURL: server.URL, Username: "jdoe", AppPassword: "app-pass"}, server.Client(), nil)
+	_, err := client.GetCommit(context.Background(), "acme/widgets", "abc")
+	require.ErrorIs(t, err, ErrAPIRequestFailed)
+	assert.Contains(t, err.Error(), "status 500")
+	assert.Contains(t, err.Error(), "server exploded")
+}
+
+func TestClient_UnsupportedOperations(t *testing.T) {
+	t.Parallel()
+
+	client := NewClientWithHTTP(Config{Token: "t"}, http.DefaultClient, nil)
+	_, err := client.GetGitTree(context.Background(), "acme/widgets", "abc", true)
+	require.ErrorIs(t, err, ErrNotSupported)
+	require.ErrorIs(t, client.EnableAutoMergePR(context.Background(), "acme/widgets", 1, gh.MergeMethodSquash), ErrNotSupported)
+}
@@ -0,0 +1,196 @@
+package bitbucket
+
+import (
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+// Bitbucket pull request states
+const (
+	stateOpen       = "OPEN"
+	stateMerged     = "MERGED"
+	stateDeclined   = "DECLINED"
+	stateSuperseded = "SUPERSEDED"
+)
+
+// paginated is the envelope of Bitbucket list endpoints
+type paginated[T any] struct {
+	Values []T    `json:"values"`
+	Next   string `json:"next"`
+}
+
+// branchName identifies a branch in pull request endpoints
+type branchName struct {
+	Name string `json:"name"`
+}
+
+// commitHash identifies a commit in pull request endpoints
+type commitHash struct {
+	Hash string `json:"hash"`
+}
+
+// branchRef is a branch as returned by /refs/branches
+type branchRef struct {
+	Name   string     `json:"name"`
+	Target commitHash `json:"target"`
+}
+
+// toBranch converts a Bitbucket branch to the gh model
+func (b branchRef) toBranch() gh.Branch {
+	var branch gh.Branch
+	branch.Name = b.Name
+	branch.Commit.SHA = b.Target.Hash
+	return branch
+}
+
+// repository is the subset of /repositories/{workspace}/{slug} used by the client
+type repository struct {
+	MainBranch branchName `json:"mainbranch"`
+}
+
+// account is a Bitbucket user or team
+type account struct {
+	UUID        string `json:"uuid,omitempty"`
+	AccountID   string `json:"account_id,omitempty"`
+	Nickname    string `json:"nickname,omitempty"`
+	Username    string `json:"username,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+}
+
+// login returns the name used as gh.User.Login
+func (a account) login() string {
+	switch {
+	case a.Nickname != "":
+		return a.Nickname
+	case a.Username != "":
+		return a.Username
+	default:
+		return a.AccountID
+	}
+}
+
+// toReviewers converts reviewer identifiers to Bitbucket accounts. Values in
+// braces are treated as UUIDs, everything else as Atlassian account IDs.
+func toReviewers(reviewers []string) []account {
+	if len(reviewers) == 0 {
+		return nil
+	}
+	accounts := make([]account, 0, len(reviewers))
+	for _, reviewer := range reviewers {
+		reviewer = strings.TrimSpace(reviewer)
+		switch {
+		case reviewer == "":
+			continue
+		case strings.HasPrefix(reviewer, "{") && strings.HasSuffix(reviewer, "}"):
+			accounts = append(accounts, account{UUID: reviewer})
+		default:
+			accounts = append(accounts, account{AccountID: reviewer})
+		}
+	}
+	return accounts
+}
+
+// commit is a commit as returned by /commit/{sha}
+type commit struct {
+	Hash    string    `json:"hash"`
+	Message string    `json:"message"`
+	Date    time.Time `json:"date"`
+	Author  struct {
+		Raw string `json:"raw"` // "Name <email>"
+	} `json:"author"`
+	Parents []commitHash `json:"parents"`
+}
+
+// toCommit converts a Bitbucket commit to the gh model
+func (c commit) toCommit() *gh.Commit {
+	result := &gh.Commit{SHA: c.Hash}
+	result.Commit.Message = c.Message
+
+	name, email := c.Author.Raw, ""
+	if start := strings.LastIndex(name, "<"); start >= 0 && strings.HasSuffix(name, ">") {
+		email = name[start+1 : len(name)-1]
+		name = strings.TrimSpace(name[:start])
+	}
+	result.Commit.Author.Name = name
+	result.Commit.Author.Email = email
+	result.Commit.Author.Date = c.Date
+	result.Commit.Committer = result.Commit.Author
+
+	for _, parent := range c.Parents {
+		result.Parents = append(result.Parents, struct {
+			SHA string `json:"sha"`
+		}{SHA: parent.Hash})
+	}
+	return result
+}
+
+// endpoint is the source or destination of a pull request
+type endpoint struct {
+	Branch branchName  `json:"branch"`
+	Commit *commitHash `json:"commit,omitempty"`
+}
+
+// createPullRequest is the body of POST /pullrequests
+type createPullRequest struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Draft       bool      `json:"draft,omitempty"`
+	Source      endpoint  `json:"source"`
+	Destination endpoint  `json:"destination"`
+	Reviewers   []account `json:"reviewers,omitempty"`
+}
+
+// updatePullRequest is the body of PUT /pullrequests/{id}
+type updatePullRequest struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Draft       bool   `json:"draft"`
+}
+
+// pullRequest is a pull request as returned by the pullrequests endpoints
+type pullRequest struct {
+	ID          int       `json:"id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	State       string    `json:"state"`
+	Draft       bool      `json:"draft"`
+	Author      account   `json:"author"`
+	Source      endpoint  `json:"source"`
+	Destination endpoint  `json:"destination"`
+	CreatedOn   time.Time `json:"created_on"`
+	UpdatedOn   time.Time `json:"updated_on"`
+}
+
+// toPR converts a Bitbucket pull request to the gh model. Merged, declined and
+// superseded pull requests are all reported as "closed".
+func (p *pullRequest) toPR(repo string) *gh.PR {
+	pr := &gh.PR{
+		Number:    p.ID,
+		State:     "closed",
+		Title:     p.Title,
+		Body:      p.Description,
+		Draft:     p.Draft,
+		CreatedAt: p.CreatedOn,
+		UpdatedAt: p.UpdatedOn,
+		Repo:      repo,
+	}
+	if p.State == stateOpen {
+		pr.State = "open"
+	}
+	if p.State == stateMerged {
+		merged := p.UpdatedOn
+		pr.MergedAt = &merged
+	}
+	pr.Head.Ref = p.Source.Branch.Name
+	if p.Source.Commit != nil {
+		pr.Head.SHA = p.Source.Commit.Hash
+	}
+	pr.Base.Ref = p.Destination.Branch.Name
+	if p.Destination.Commit != nil {
+		pr.Base.SHA = p.Destination.Commit.Hash
+	}
+	pr.User.Login = p.Author.login()
+	return pr
+}
@@ -0,0 +1,216 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+// The methods below have no Bitbucket implementation; go-broadcast only needs
+// them for GitHub-specific commands and features.
+
+// GetGitTree is not supported by the Bitbucket provider
+func (*Client) GetGitTree(_ context.Context, _ string, _ string, _ bool) (*gh.GitTree, error) {
+	return nil, notSupported("GetGitTree")
+}
+
+// GetRepository is not supported by the Bitbucket provider
+func (*Client) GetRepository(_ context.Context, _ string) (*gh.Repository, error) {
+	return nil, notSupported("GetRepository")
+}
+
+// ReviewPR is not supported by the Bitbucket provider
+func (*Client) ReviewPR(_ context.Context, _ string, _ int, _ string) error {
+	return notSupported("ReviewPR")
+}
+
+// MergePR is not supported by the Bitbucket provider
+func (*Client) MergePR(_ context.Context, _ string, _ int, _ gh.MergeMethod) error {
+	return notSupported("MergePR")
+}
+
+// BypassMergePR is not supported by the Bitbucket provider
+func (*Client) BypassMergePR(_ context.Context, _ string, _ int, _ gh.MergeMethod) error {
+	return notSupported("BypassMergePR")
+}
+
+// EnableAutoMergePR is not supported by the Bitbucket provider
+func (*Client) EnableAutoMergePR(_ context.Context, _ string, _ int, _ gh.MergeMethod) error {
+	return notSupported("EnableAutoMergePR")
+}
+
+// SearchAssignedPRs is not supported by the Bitbucket provider
+func (*Client) SearchAssignedPRs(_ context.Context) ([]gh.PR, error) {
+	return nil, notSupported("SearchAssignedPRs")
+}
+
+// SearchAssignedPRsByAuthor is not supported by the Bitbucket provider
+func (*Client) SearchAssignedPRsByAuthor(_ context.Context, _ string) ([]gh.PR, error) {
+	return nil, notSupported("SearchAssignedPRsByAuthor")
+}
+
+// GetPRReviews is not supported by the Bitbucket provider
+func (*Client) GetPRReviews(_ context.Context, _ string, _ int) ([]gh.Review, error) {
+	return nil, notSupported("GetPRReviews")
+}
+
+// HasApprovedReview is not supported by the Bitbucket provider
+func (*Client) HasApprovedReview(_ context.Context, _ string, _ int, _ string) (bool, error) {
+	return false, notSupported("HasApprovedReview")
+}
+
+// GetPRCheckStatus is not supported by the Bitbucket provider
+func (*Client) GetPRCheckStatus(_ context.Context, _ string, _ int) (*gh.CheckStatusSummary, error) {
+	return nil, notSupported("GetPRCheckStatus")
+}
+
+// GetCommitCheckStatus is not supported by the Bitbucket provider
+func (*Client) GetCommitCheckStatus(_ context.Context, _, _ string) (*gh.CheckStatusSummary, error) {
+	return nil, notSupported("GetCommitCheckStatus")
+}
+
+// GetCombinedStatus is not supported by the Bitbucket provider
+func (*Client) GetCombinedStatus(_ context.Context, _, _ string) (*gh.CombinedStatus, error) {
+	return nil, notSupported("GetCombinedStatus")
+}
+
+// DiscoverOrgRepos is not supported by the Bitbucket provider
+func (*Client) DiscoverOrgRepos(_ context.Context, _ string) ([]gh.RepoInfo, error) {
+	return nil, notSupported("DiscoverOrgRepos")
+}
+
+// ExecuteGraphQL is not supported by the Bitbucket provider
+func (*Client) ExecuteGraphQL(_ context.Context, _ string) (map[string]interface{}, error) {
+	return nil, notSupported("ExecuteGraphQL")
+}
+
+// GetDependabotAlerts is not supported by the Bitbucket provider
+func (*Client) GetDependabotAlerts(_ context.Context, _ string) ([]gh.DependabotAlert, error) {
+	return nil, notSupported("GetDependabotAlerts")
+}
+
+// GetCodeScanningAlerts is not supported by the Bitbucket provider
+func (*Client) GetCodeScanningAlerts(_ context.Context, _ string) ([]gh.CodeScanningAlert, error) {
+	return nil, notSupported("GetCodeScanningAlerts")
+}
+
+// GetSecretScanningAlerts is not supported by the Bitbucket provider
+func (*Client) GetSecretScanningAlerts(_ context.Context, _ string) ([]gh.SecretScanningAlert, error) {
+	return nil, notSupported("GetSecretScanningAlerts")
+}
+
+// GetVulnerabilityAlertsGraphQL is not supported by the Bitbucket provider
+func (*Client) GetVulnerabilityAlertsGraphQL(_ context.Context, _ string) ([]gh.VulnerabilityAlert, error) {
+	return nil, notSupported("GetVulnerabilityAlertsGraphQL")
+}
+
+// ListWorkflows is not supported by the Bitbucket provider
+func (*Client) ListWorkflows(_ context.Context, _ string) ([]gh.Workflow, error) {
+	return nil, notSupported("ListWorkflows")
+}
+
+// GetWorkflowRuns is not supported by the Bitbucket provider
+func (*Client) GetWorkflowRuns(_ context.Context, _ string, _ int64, _ int) ([]gh.WorkflowRun, error) {
+	return nil, notSupported("GetWorkflowRuns")
+}
+
+// GetRunArtifacts is not supported by the Bitbucket provider
+func (*Client) GetRunArtifacts(_ context.Context, _ string, _ int64) ([]gh.Artifact, error) {
+	return nil, notSupported("GetRunArtifacts")
+}
+
+// DownloadRunArtifact is not supported by the Bitbucket provider
+func (*Client) DownloadRunArtifact(_ context.Context, _ string, _ int64, _ string, _ string) error {
+	return notSupported("DownloadRunArtifact")
+}
+
+// GetRateLimit is not supported by the Bitbucket provider
+func (*Client) GetRateLimit(_ context.Context) (*gh.RateLimitResponse, error) {
+	return nil, notSupported("GetRateLimit")
+}
+
+// GetContributorCount is not supported by the Bitbucket provider
+func (*Client) GetContributorCount(_ context.Context, _ string) (int, error) {
+	return 0, notSupported("GetContributorCount")
+}
+
+// CreateRepository is not supported by the Bitbucket provider
+func (*Client) CreateRepository(_ context.Context, _ gh.CreateRepoOptions) (*gh.Repository, error) {
+	return nil, notSupported("CreateRepository")
+}
+
+// UpdateRepoSettings is not supported by the Bitbucket provider
+func (*Client) UpdateRepoSettings(_ context.Context, _ string, _ gh.RepoSettings) error {
+	return notSupported("UpdateRepoSettings")
+}
+
+// GetRepoSettings is not supported by the Bitbucket provider
+func (*Client) GetRepoSettings(_ context.Context, _ string) (*gh.RepoSettings, error) {
+	return nil, notSupported("GetRepoSettings")
+}
+
+// CreateOrUpdateRuleset is not supported by the Bitbucket provider
+func (*Client) CreateOrUpdateRuleset(_ context.Context, _ string, _ gh.Ruleset) error {
+	return notSupported("CreateOrUpdateRuleset")
+}
+
+// ListRulesets is not supported by the Bitbucket provider
+func (*Client) ListRulesets(_ context.Context, _ string) ([]gh.Ruleset, error) {
+	return nil, notSupported("ListRulesets")
+}
+
+// SyncLabels is not supported by the Bitbucket provider
+func (*Client) SyncLabels(_ context.Context, _ string, _ []gh.Label) error {
+	return notSupported("SyncLabels")
+}
+
+// ListLabels is not supported by the Bitbucket provider
+func (*Client) ListLabels(_ context.Context, _ string) ([]gh.Label, error) {
+	return nil, notSupported("ListLabels")
+}
+
+// SetTopics is not supported by the Bitbucket provider
+func (*Client) SetTopics(_ context.Context, _ string, _ []string) error {
+	return notSupported("SetTopics")
+}
+
+// GetRepoTopics is not supported by the Bitbucket provider
+func (*Client) GetRepoTopics(_ context.Context, _ string) ([]string, error) {
+	return nil, notSupported("GetRepoTopics")
+}
+
+// CloneRepository is not supported by the Bitbucket provider
+func (*Client) CloneRepository(_ context.Context, _ string, _ string) error {
+	return notSupported("CloneRepository")
+}
+
+// CreateFileCommit is not supported by the Bitbucket provider
+func (*Client) CreateFileCommit(_ context.Context, _ string, _ string, _ string, _ []byte, _ string) error {
+	return notSupported("CreateFileCommit")
+}
+
+// RenameBranch is not supported by the Bitbucket provider
+func (*Client) RenameBranch(_ context.Context, _ string, _ string, _ string) error {
+	return notSupported("RenameBranch")
+}
+
+// ListIssueComments is not supported by the Bitbucket provider
+func (*Client) ListIssueComments(_ context.Context, _ string, _ int) ([]gh.IssueComment, error) {
+	return nil, notSupported("ListIssueComments")
+}
+
+// CreateIssueComment is not supported by the Bitbucket provider
+func (*Client) CreateIssueComment(_ context.Context, _ string, _ int, _ string) (*gh.IssueComment, error) {
+	return nil, notSupported("CreateIssueComment")
+}
+
+// UpdateIssueComment is not supported by the Bitbucket provider
+func (*Client) UpdateIssueComment(_ context.Context, _ string, _ int64, _ string) error {
+	return notSupported("UpdateIssueComment")
+}
+
+// notSupported returns ErrNotSupported for the named operation
+func notSupported(operation string) error {
+	return fmt.Errorf("%w: %s", ErrNotSupported, operation)
+}
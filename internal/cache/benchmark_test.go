@@ -121,10 +121,10 @@ func BenchmarkCacheHitRates(b *testing.B) {
 			})
 
 			// Report final cache stats
-			hits, misses, _, actualHitRate := cache.Stats()
-			b.ReportMetric(actualHitRate*100, "hit_rate_%")
-			b.ReportMetric(float64(hits), "cache_hits")
-			b.ReportMetric(float64(misses), "cache_misses")
+			stats := cache.Stats()
+			b.ReportMetric(stats.HitRate()*100, "hit_rate_%")
+			b.ReportMetric(float64(stats.Hits), "cache_hits")
+			b.ReportMetric(float64(stats.Misses), "cache_misses")
 		})
 	}
 }
@@ -181,10 +181,10 @@ func BenchmarkCacheWithAPISimulation(b *testing.B) {
 				b.ReportMetric(float64(avgLatency.Nanoseconds())/1e6, "avg_latency_ms")
 
 				// Report cache stats
-				hits, misses, _, actualHitRate := cache.Stats()
-				b.ReportMetric(actualHitRate*100, "actual_hit_rate_%")
-				b.ReportMetric(float64(hits), "cache_hits")
-				b.ReportMetric(float64(misses), "cache_misses")
+				stats := cache.Stats()
+				b.ReportMetric(stats.HitRate()*100, "actual_hit_rate_%")
+				b.ReportMetric(float64(stats.Hits), "cache_hits")
+				b.ReportMetric(float64(stats.Misses), "cache_misses")
 			})
 		}
 	}
@@ -307,11 +307,11 @@ func BenchmarkCacheExpiration(b *testing.B) {
 			}
 
 			// Report final cache stats
-			hits, misses, size, hitRate := cache.Stats()
-			b.ReportMetric(float64(size), "final_cache_size")
-			b.ReportMetric(hitRate*100, "hit_rate_%")
-			b.ReportMetric(float64(hits), "total_hits")
-			b.ReportMetric(float64(misses), "total_misses")
+			stats := cache.Stats()
+			b.ReportMetric(float64(stats.Size), "final_cache_size")
+			b.ReportMetric(stats.HitRate()*100, "hit_rate_%")
+			b.ReportMetric(float64(stats.Hits), "total_hits")
+			b.ReportMetric(float64(stats.Misses), "total_misses")
 		})
 	}
 }
@@ -345,7 +345,7 @@ func BenchmarkCacheEviction(b *testing.B) {
 			}
 
 			// Verify cache stayed within size limits
-			_, _, currentSize, _ := cache.Stats()
+			currentSize := cache.Stats().Size
 			if currentSize > size {
 				b.Errorf("Cache size exceeded limit: %d > %d", currentSize, size)
 			}
@@ -366,7 +366,7 @@ func BenchmarkCacheStatsOverhead(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		_, _, _, _ = cache.Stats()
+		_ = cache.Stats()
 	}
 }
 
@@ -416,8 +416,91 @@ func BenchmarkCacheWithJSONSerialization(b *testing.B) {
 	}
 
 	// Report final stats
-	hits, misses, _, hitRate := cache.Stats()
-	b.ReportMetric(hitRate*100, "hit_rate_%")
-	b.ReportMetric(float64(hits), "cache_hits")
-	b.ReportMetric(float64(misses), "cache_misses")
+	stats := cache.Stats()
+	b.ReportMetric(stats.HitRate()*100, "hit_rate_%")
+	b.ReportMetric(float64(stats.Hits), "cache_hits")
+	b.ReportMetric(float64(stats.Misses), "cache_misses")
+}
+
+// BenchmarkCacheEvictionPolicy compares TTL-only and LRU eviction on a full cache
+// with a skewed workload where a small set of hot keys receives most reads
+func BenchmarkCacheEvictionPolicy(b *testing.B) {
+	const cacheSize = 1000
+	policies := []EvictionPolicy{EvictionTTLOnly, EvictionLRU}
+
+	for _, policy := range policies {
+		b.Run(fmt.Sprintf("Policy_%s/SetFull", policy), func(b *testing.B) {
+			cache := NewTTLCache(time.Minute, cacheSize, WithEvictionPolicy(policy))
+			defer cache.Close()
+
+			for i := 0; i < cacheSize; i++ {
+				cache.Set(fmt.Sprintf("fill_%d", i), i)
+			}
+			keys := make([]string, b.N)
+			for i := range keys {
+				keys[i] = fmt.Sprintf("new_%d", i)
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				cache.Set(keys[i], i)
+			}
+		})
+
+		b.Run(fmt.Sprintf("Policy_%s/HotKeys", policy), func(b *testing.B) {
+			cache := NewTTLCache(time.Minute, cacheSize, WithEvictionPolicy(policy))
+			defer cache.Close()
+
+			hotKeys := make([]string, cacheSize/10)
+			for i := range hotKeys {
+				hotKeys[i] = fmt.Sprintf("hot_%d", i)
+				cache.Set(hotKeys[i], i)
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				// 80% reads of hot keys, 20% inserts of cold keys
+				if i%5 == 0 {
+					cache.Set(fmt.Sprintf("cold_%d", i), i)
+					continue
+				}
+				key := hotKeys[i%len(hotKeys)]
+				if _, ok := cache.Get(key); !ok {
+					cache.Set(key, i)
+				}
+			}
+
+			b.StopTimer()
+			b.ReportMetric(cache.Stats().HitRate()*100, "hit%")
+		})
+
+		b.Run(fmt.Sprintf("Policy_%s/Concurrent", policy), func(b *testing.B) {
+			cache := NewTTLCache(time.Minute, cacheSize, WithEvictionPolicy(policy))
+			defer cache.Close()
+
+			for i := 0; i < cacheSize; i++ {
+				cache.Set(fmt.Sprintf("key_%d", i), i)
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					key := fmt.Sprintf("key_%d", i%(cacheSize*2))
+					if i%4 == 0 {
+						cache.Set(key, i)
+					} else {
+						cache.Get(key)
+					}
+					i++
+				}
+			})
+		})
+	}
 }
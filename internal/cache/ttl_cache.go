@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"container/list"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -14,6 +15,62 @@ type Entry struct {
 	ExpiresAt time.Time
 }
 
+// EvictionPolicy selects which entry is evicted when the cache is full
+type EvictionPolicy int
+
+const (
+	// EvictionTTLOnly evicts an expired entry, falling back to the entry
+	// closest to expiry. Reads never reorder entries. This is the default.
+	EvictionTTLOnly EvictionPolicy = iota
+
+	// EvictionLRU evicts the least-recently-used entry regardless of its TTL.
+	// Reads and writes both count as a use.
+	EvictionLRU
+)
+
+// String returns the policy name
+func (p EvictionPolicy) String() string {
+	switch p {
+	case EvictionTTLOnly:
+		return "ttl"
+	case EvictionLRU:
+		return "lru"
+	default:
+		return "unknown"
+	}
+}
+
+// CacheStats is a point-in-time view of a cache's counters. Each field is read
+// atomically on its own, so a snapshot taken during concurrent use may be off
+// by the operations in flight.
+type CacheStats struct {
+	Hits      int64 `json:"hits"`      // Get calls that found a live entry
+	Misses    int64 `json:"misses"`    // Get calls that found no entry or an expired one
+	Evictions int64 `json:"evictions"` // Live or expired entries removed to make room for a new key
+	Size      int   `json:"size"`      // Entries currently stored, including expired ones not yet cleaned up
+	Capacity  int   `json:"capacity"`  // Maximum number of entries
+}
+
+// HitRate returns the fraction of Get calls that were hits, or zero before
+// the first Get
+func (s CacheStats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// Option configures a TTLCache
+type Option func(*TTLCache)
+
+// WithEvictionPolicy sets the policy used to make room when the cache is full
+func WithEvictionPolicy(policy EvictionPolicy) Option {
+	return func(c *TTLCache) {
+		c.policy = policy
+	}
+}
+
 // TTLCache provides time-based caching with automatic expiration and cleanup.
 //
 // The cache uses a background goroutine for periodic cleanup of expired entries.
@@ -24,10 +81,19 @@ type TTLCache struct {
 	items   map[string]Entry
 	ttl     time.Duration
 	maxSize int
+	policy  EvictionPolicy
+
+	// Access order for EvictionLRU: front is most recently used.
+	// Both are nil under EvictionTTLOnly.
+	lru      *list.List
+	lruIndex map[string]*list.Element
 
-	// Metrics
-	hits   atomic.Int64
-	misses atomic.Int64
+	// Metrics, readable without the lock. size mirrors len(items) and is
+	// stored whenever items changes under the write lock.
+	hits      atomic.Int64
+	misses    atomic.Int64
+	evictions atomic.Int64
+	size      atomic.Int64
 
 	// Cleanup
 	cleanupInterval time.Duration
@@ -52,9 +118,10 @@ const MinCleanupInterval = time.Millisecond
 // Parameters:
 //   - ttl: Time-to-live for cache entries. If <= 0, defaults to 1 minute.
 //   - maxSize: Maximum number of entries. If <= 0, defaults to 1000.
+//   - opts: Optional settings such as WithEvictionPolicy.
 //
 // IMPORTANT: Call Close() when done to stop the background cleanup goroutine.
-func NewTTLCache(ttl time.Duration, maxSize int) *TTLCache {
+func NewTTLCache(ttl time.Duration, maxSize int, opts ...Option) *TTLCache {
 	// Validate and apply defaults for TTL
 	if ttl <= 0 {
 		ttl = DefaultTTL
@@ -79,6 +146,15 @@ func NewTTLCache(ttl time.Duration, maxSize int) *TTLCache {
 		stopCleanup:     make(chan struct{}),
 	}
 
+	for _, opt := range opts {
+		opt(cache)
+	}
+
+	if cache.policy == EvictionLRU {
+		cache.lru = list.New()
+		cache.lruIndex = make(map[string]*list.Element)
+	}
+
 	// Start cleanup goroutine
 	go cache.cleanup()
 
@@ -87,6 +163,10 @@ func NewTTLCache(ttl time.Duration, maxSize int) *TTLCache {
 
 // Get retrieves a value from cache
 func (c *TTLCache) Get(key string) (interface{}, bool) {
+	if c.policy == EvictionLRU {
+		return c.getLRU(key)
+	}
+
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
@@ -105,11 +185,33 @@ func (c *TTLCache) Get(key string) (interface{}, bool) {
 	return entry.Value, true
 }
 
+// getLRU retrieves a value and marks it as most recently used.
+// It takes the write lock because a hit reorders the access list.
+func (c *TTLCache) getLRU(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, exists := c.items[key]
+	if !exists || time.Now().After(entry.ExpiresAt) {
+		c.misses.Add(1)
+		return nil, false
+	}
+
+	c.lru.MoveToFront(c.lruIndex[key])
+	c.hits.Add(1)
+	return entry.Value, true
+}
+
 // Set stores a value in cache
 func (c *TTLCache) Set(key string, value interface{}) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	if c.policy == EvictionLRU {
+		c.setLRU(key, value)
+		return
+	}
+
 	// Evict oldest entry if at capacity
 	if len(c.items) >= c.maxSize {
 		c.evictOldest()
@@ -119,6 +221,30 @@ func (c *TTLCache) Set(key string, value interface{}) {
 		Value:     value,
 		ExpiresAt: time.Now().Add(c.ttl),
 	}
+	c.size.Store(int64(len(c.items)))
+}
+
+// setLRU stores a value, evicting the least-recently-used entry when a new
+// key would exceed maxSize. Caller must hold the write lock.
+func (c *TTLCache) setLRU(key string, value interface{}) {
+	entry := Entry{
+		Value:     value,
+		ExpiresAt: time.Now().Add(c.ttl),
+	}
+
+	if elem, exists := c.lruIndex[key]; exists {
+		c.items[key] = entry
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	for len(c.items) >= c.maxSize {
+		c.evictLRU()
+	}
+
+	c.items[key] = entry
+	c.lruIndex[key] = c.lru.PushFront(key)
+	c.size.Store(int64(len(c.items)))
 }
 
 // GetOrLoad retrieves from cache or loads using the provided function.
@@ -159,7 +285,8 @@ func (c *TTLCache) Delete(key string) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	delete(c.items, key)
+	c.removeLocked(key)
+	c.size.Store(int64(len(c.items)))
 }
 
 // Clear removes all entries from the cache
@@ -168,35 +295,32 @@ func (c *TTLCache) Clear() {
 	defer c.mu.Unlock()
 
 	c.items = make(map[string]Entry)
+	if c.lru != nil {
+		c.lru.Init()
+		c.lruIndex = make(map[string]*list.Element)
+	}
 	c.hits.Store(0)
 	c.misses.Store(0)
+	c.evictions.Store(0)
+	c.size.Store(0)
 }
 
-// Stats returns cache statistics.
-//
-// The returned values represent a consistent snapshot - all values
-// are captured under the same lock to ensure consistency.
-func (c *TTLCache) Stats() (hits, misses int64, size int, hitRate float64) {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	size = len(c.items)
-	hits = c.hits.Load()
-	misses = c.misses.Load()
-
-	total := hits + misses
-	if total > 0 {
-		hitRate = float64(hits) / float64(total)
+// Stats returns the cache's hit, miss and eviction counters with its size
+// and capacity. It reads atomics only and never waits for the cache lock, so
+// it is safe to poll while Get and Set are on the hot path.
+func (c *TTLCache) Stats() CacheStats {
+	return CacheStats{
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+		Size:      int(c.size.Load()),
+		Capacity:  c.maxSize,
 	}
-
-	return hits, misses, size, hitRate
 }
 
 // Size returns current cache size
 func (c *TTLCache) Size() int {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-	return len(c.items)
+	return int(c.size.Load())
 }
 
 // Close stops the cleanup goroutine
@@ -218,9 +342,10 @@ func (c *TTLCache) cleanup() {
 			now := time.Now()
 			for key, entry := range c.items {
 				if now.After(entry.ExpiresAt) {
-					delete(c.items, key)
+					c.removeLocked(key)
 				}
 			}
+			c.size.Store(int64(len(c.items)))
 			c.mu.Unlock()
 		case <-c.stopCleanup:
 			return
@@ -228,10 +353,38 @@ func (c *TTLCache) cleanup() {
 	}
 }
 
+// removeLocked deletes a key and its access-order record. Caller must hold the write lock.
+func (c *TTLCache) removeLocked(key string) {
+	delete(c.items, key)
+	if c.lruIndex == nil {
+		return
+	}
+	if elem, exists := c.lruIndex[key]; exists {
+		c.lru.Remove(elem)
+		delete(c.lruIndex, key)
+	}
+}
+
+// evictLRU removes the least-recently-used entry. Caller must hold the write lock.
+func (c *TTLCache) evictLRU() {
+	elem := c.lru.Back()
+	if elem == nil {
+		return
+	}
+	key, ok := elem.Value.(string)
+	if !ok {
+		// The list only ever holds keys; drop anything else so eviction cannot stall
+		c.lru.Remove(elem)
+		return
+	}
+	c.removeLocked(key)
+	c.evictions.Add(1)
+}
+
 // evictOldest removes an entry to make room for a new one.
 // It first tries to remove an expired entry, falling back to the oldest valid entry.
 // This is O(n) - for high-performance use cases with large caches,
-// consider using a heap-based or LRU eviction strategy.
+// consider EvictionLRU, which evicts in O(1).
 func (c *TTLCache) evictOldest() {
 	now := time.Now()
 
@@ -239,6 +392,7 @@ func (c *TTLCache) evictOldest() {
 	for key, entry := range c.items {
 		if now.After(entry.ExpiresAt) {
 			delete(c.items, key)
+			c.evictions.Add(1)
 			return
 		}
 	}
@@ -256,5 +410,6 @@ func (c *TTLCache) evictOldest() {
 
 	if oldestKey != "" {
 		delete(c.items, oldestKey)
+		c.evictions.Add(1)
 	}
 }
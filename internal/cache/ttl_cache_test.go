@@ -161,11 +161,8 @@ func TestTTLCacheStats(t *testing.T) {
 	defer cache.Close()
 
 	// Initial stats
-	hits, misses, size, hitRate := cache.Stats()
-	require.Equal(t, int64(0), hits)
-	require.Equal(t, int64(0), misses)
-	require.Equal(t, 0, size)
-	require.InDelta(t, 0.0, hitRate, 0.001)
+	require.Equal(t, CacheStats{Capacity: 10}, cache.Stats())
+	require.InDelta(t, 0.0, cache.Stats().HitRate(), 0.001)
 
 	// Add some entries
 	cache.Set("key1", "value1")
@@ -182,11 +179,38 @@ func TestTTLCacheStats(t *testing.T) {
 	time.Sleep(250 * time.Millisecond)
 	_, _ = cache.Get("expired")
 
-	hits, misses, size, hitRate = cache.Stats()
-	require.Equal(t, int64(1), hits)
-	require.Equal(t, int64(2), misses)
-	require.Equal(t, 0, size) // all keys have expired after 250ms
-	require.InDelta(t, 0.333, hitRate, 0.001)
+	stats := cache.Stats()
+	require.Equal(t, int64(1), stats.Hits)
+	require.Equal(t, int64(2), stats.Misses)
+	require.Equal(t, 0, stats.Size) // all keys have expired after 250ms
+	require.Equal(t, int64(0), stats.Evictions)
+	require.InDelta(t, 0.333, stats.HitRate(), 0.001)
+}
+
+// TestTTLCacheStatsEvictions tests that evictions, size and capacity are reported for both policies
+func TestTTLCacheStatsEvictions(t *testing.T) {
+	for _, policy := range []EvictionPolicy{EvictionTTLOnly, EvictionLRU} {
+		t.Run(policy.String(), func(t *testing.T) {
+			cache := NewTTLCache(time.Hour, 2, WithEvictionPolicy(policy))
+			defer cache.Close()
+
+			cache.Set("key1", "value1")
+			cache.Set("key2", "value2")
+			cache.Set("key3", "value3")
+			cache.Set("key4", "value4")
+
+			stats := cache.Stats()
+			assert.Equal(t, int64(2), stats.Evictions)
+			assert.Equal(t, 2, stats.Size)
+			assert.Equal(t, 2, stats.Capacity)
+
+			cache.Delete("key4")
+			assert.Equal(t, 1, cache.Stats().Size)
+
+			cache.Clear()
+			assert.Equal(t, CacheStats{Capacity: 2}, cache.Stats())
+		})
+	}
 }
 
 // TestTTLCacheConcurrency tests concurrent access
@@ -286,11 +310,11 @@ func TestTTLCacheMetrics(t *testing.T) {
 	_, _ = cache.Get("nonexistent") // Miss
 
 	// Check stats
-	hits, misses, size, hitRate := cache.Stats()
-	assert.Equal(t, int64(1), hits)
-	assert.Equal(t, int64(1), misses)
-	assert.GreaterOrEqual(t, size, 1)
-	assert.InDelta(t, 0.5, hitRate, 0.001) // 50% hit rate
+	stats := cache.Stats()
+	assert.Equal(t, int64(1), stats.Hits)
+	assert.Equal(t, int64(1), stats.Misses)
+	assert.GreaterOrEqual(t, stats.Size, 1)
+	assert.InDelta(t, 0.5, stats.HitRate(), 0.001) // 50% hit rate
 }
 
 // TestTTLCacheZeroTTL tests that zero TTL gets a sensible default
@@ -449,3 +473,124 @@ func TestTTLCacheVerySmallTTL(t *testing.T) {
 	_, exists := cache.Get("key")
 	require.False(t, exists)
 }
+
+// TestTTLCacheLRUEviction tests that the LRU policy evicts the least-recently-used entry
+func TestTTLCacheLRUEviction(t *testing.T) {
+	cache := NewTTLCache(time.Hour, 3, WithEvictionPolicy(EvictionLRU))
+	defer cache.Close()
+
+	cache.Set("key1", "value1")
+	cache.Set("key2", "value2")
+	cache.Set("key3", "value3")
+
+	// Touch key1 so key2 becomes the least recently used
+	_, exists := cache.Get("key1")
+	require.True(t, exists)
+
+	cache.Set("key4", "value4")
+	require.Equal(t, 3, cache.Size())
+
+	_, exists = cache.Get("key2")
+	require.False(t, exists, "least-recently-used key should be evicted")
+	for _, key := range []string{"key1", "key3", "key4"} {
+		_, exists = cache.Get(key)
+		require.True(t, exists, key)
+	}
+}
+
+// TestTTLCacheLRUOverwriteDoesNotEvict tests that updating an existing key keeps all entries
+func TestTTLCacheLRUOverwriteDoesNotEvict(t *testing.T) {
+	cache := NewTTLCache(time.Hour, 2, WithEvictionPolicy(EvictionLRU))
+	defer cache.Close()
+
+	cache.Set("key1", "value1")
+	cache.Set("key2", "value2")
+	cache.Set("key1", "updated")
+	require.Equal(t, 2, cache.Size())
+
+	// key1 was refreshed by the overwrite, so key2 is evicted next
+	cache.Set("key3", "value3")
+	value, exists := cache.Get("key1")
+	require.True(t, exists)
+	require.Equal(t, "updated", value)
+	_, exists = cache.Get("key2")
+	require.False(t, exists)
+}
+
+// TestTTLCacheLRUKeepsOrderConsistent tests that Delete, Clear and cleanup maintain the access list
+func TestTTLCacheLRUKeepsOrderConsistent(t *testing.T) {
+	cache := NewTTLCache(20*time.Millisecond, 5, WithEvictionPolicy(EvictionLRU))
+	defer cache.Close()
+
+	cache.Set("key1", "value1")
+	cache.Set("key2", "value2")
+	cache.Delete("key1")
+	require.Equal(t, 1, cache.lru.Len())
+	require.Len(t, cache.lruIndex, 1)
+
+	// Expired entries are removed from both the map and the access list
+	require.Eventually(t, func() bool {
+		cache.mu.RLock()
+		defer cache.mu.RUnlock()
+		return len(cache.items) == 0 && cache.lru.Len() == 0 && len(cache.lruIndex) == 0
+	}, time.Second, 5*time.Millisecond)
+
+	cache.Set("key3", "value3")
+	cache.Clear()
+	require.Equal(t, 0, cache.Size())
+	require.Equal(t, 0, cache.lru.Len())
+	require.Empty(t, cache.lruIndex)
+}
+
+// TestTTLCacheLRUConcurrency tests the LRU policy under concurrent access
+func TestTTLCacheLRUConcurrency(t *testing.T) {
+	const maxSize = 50
+	cache := NewTTLCache(time.Minute, maxSize, WithEvictionPolicy(EvictionLRU))
+	defer cache.Close()
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				key := fmt.Sprintf("key_%d_%d", g, i%100)
+				cache.Set(key, i)
+				cache.Get(key)
+				if i%25 == 0 {
+					cache.Delete(key)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	require.LessOrEqual(t, cache.Size(), maxSize)
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+	require.Len(t, cache.lruIndex, len(cache.items))
+	require.Equal(t, len(cache.items), cache.lru.Len())
+}
+
+// TestEvictionPolicyString tests policy names
+func TestEvictionPolicyString(t *testing.T) {
+	assert.Equal(t, "ttl", EvictionTTLOnly.String())
+	assert.Equal(t, "lru", EvictionLRU.String())
+	assert.Equal(t, "unknown", EvictionPolicy(99).String())
+}
+
+// TestTTLCacheLRUEvictIgnoresForeignElement tests that a non-key list element is dropped instead of panicking
+func TestTTLCacheLRUEvictIgnoresForeignElement(t *testing.T) {
+	cache := NewTTLCache(time.Hour, 2, WithEvictionPolicy(EvictionLRU))
+	defer cache.Close()
+
+	cache.Set("key1", "value1")
+	cache.mu.Lock()
+	cache.lru.PushBack(42)
+	require.NotPanics(t, cache.evictLRU)
+	cache.mu.Unlock()
+
+	require.Equal(t, 1, cache.lru.Len())
+	_, exists := cache.Get("key1")
+	require.True(t, exists)
+}
@@ -95,7 +95,7 @@ with change detection to minimize database writes.`,
 			orgRepo := db.NewOrganizationRepository(gormDB)
 
 			// Initialize GitHub client
-			ghClient, err := gh.NewClient(ctx, logger, &logging.LogConfig{})
+			ghClient, err := gh.NewClient(ctx, logger, &logging.LogConfig{}, ghAuthOption(nil))
 			if err != nil {
 				return fmt.Errorf("failed to create GitHub client: %w", err)
 			}
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/output"
+)
+
+// newConfigSchemaCmd creates the "config-schema" command
+func newConfigSchemaCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "config-schema",
+		Short: "Print the JSON Schema of the configuration file",
+		Long: `Print a JSON Schema describing the go-broadcast configuration file.
+
+The schema is generated from the configuration types, so it always matches the
+running version. It lists every field with its description, the accepted values
+of enumerated fields such as provider, and the fields that are required.
+
+Point an editor at it for completion and inline validation, or validate
+configuration files against it in CI.`,
+		Example: `  # Save the schema next to the configuration
+  go-broadcast config-schema > go-broadcast.schema.json
+
+  # Reference it from sync.yaml for the YAML language server
+  # yaml-language-server: $schema=./go-broadcast.schema.json`,
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			schema, err := config.JSONSchema()
+			if err != nil {
+				return fmt.Errorf("failed to generate configuration schema: %w", err)
+			}
+			if _, err := fmt.Fprintln(output.Stdout(), string(schema)); err != nil {
+				return fmt.Errorf("failed to write configuration schema: %w", err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,27 @@
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/output"
+)
+
+func TestConfigSchemaCmd(t *testing.T) {
+	scope := output.CaptureOutput()
+	defer scope.Restore()
+
+	cmd := newConfigSchemaCmd()
+	cmd.SetArgs([]string{})
+	require.NoError(t, cmd.Execute())
+
+	var schema map[string]interface{}
+	require.NoError(t, json.Unmarshal(scope.Stdout.Bytes(), &schema))
+	assert.Equal(t, config.SchemaDraft, schema["$schema"])
+	assert.Contains(t, schema["$defs"], "Config")
+
+}
@@ -243,6 +243,9 @@ func queryByRepo(ctx context.Context, repo db.QueryRepository, repoName string)
 		if len(target.Transform.Variables) > 0 {
 			output.Info(fmt.Sprintf("  • variables: %d defined", len(target.Transform.Variables)))
 		}
+		if target.Transform.TemplateRender {
+			output.Info("  • template_render: enabled")
+		}
 		output.Info("")
 	}
 
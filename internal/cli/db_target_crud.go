@@ -688,7 +688,7 @@ func runTargetClone(cmd *cobra.Command, groupExternalID, fromRepo, toRepo, branc
 
 		if !IsDryRun() {
 			logger := logrus.StandardLogger()
-			ghClient, ghErr := gh.NewClient(ctx, logger, &logging.LogConfig{})
+			ghClient, ghErr := gh.NewClient(ctx, logger, &logging.LogConfig{}, ghAuthOption(nil))
 			if ghErr != nil {
 				return printErrorResponse("target", "cloned",
 					fmt.Sprintf("GitHub client: %v", ghErr), "", jsonOutput)
@@ -850,6 +850,15 @@ func cloneTargetInTx(
 		PRReviewers:     copyJSONStringSlice(source.PRReviewers),
 		PRTeamReviewers: copyJSONStringSlice(source.PRTeamReviewers),
 		Position:        position,
+
+		PRBodyExtraSections: copyJSONPRBodySections(source.PRBodyExtraSections),
+
+		PostSyncHook:      source.PostSyncHook,
+		HookFailurePolicy: source.HookFailurePolicy,
+
+		Fork: source.Fork,
+
+		SourceRoot: source.SourceRoot,
 	}
 
 	// Apply overrides (only if flag was explicitly provided)
@@ -876,12 +885,15 @@ func cloneTargetInTx(
 	// Clone FileMappings (polymorphic: OwnerType="target")
 	for _, fm := range source.FileMappings {
 		clone := db.FileMapping{
-			OwnerType:  "target",
-			OwnerID:    newTarget.ID,
-			Src:        fm.Src,
-			Dest:       fm.Dest,
-			DeleteFlag: fm.DeleteFlag,
-			Position:   fm.Position,
+			OwnerType:   "target",
+			OwnerID:     newTarget.ID,
+			Src:         fm.Src,
+			Dest:        fm.Dest,
+			DeleteFlag:  fm.DeleteFlag,
+			When:        fm.When,
+			MarkerStart: fm.MarkerStart,
+			MarkerEnd:   fm.MarkerEnd,
+			Position:    fm.Position,
 		}
 		if err = tx.WithContext(ctx).Create(&clone).Error; err != nil {
 			return nil, fmt.Errorf("failed to clone file mapping %q: %w", fm.Dest, err)
@@ -910,10 +922,25 @@ func cloneTargetInTx(
 		// Clone directory-level transform (OwnerType="directory_mapping")
 		if dm.Transform.ID != 0 {
 			tmClone := db.Transform{
-				OwnerType: "directory_mapping",
-				OwnerID:   clone.ID,
-				RepoName:  dm.Transform.RepoName,
-				Variables: copyJSONStringMap(dm.Transform.Variables),
+				OwnerType:           "directory_mapping",
+				OwnerID:             clone.ID,
+				RepoName:            dm.Transform.RepoName,
+				Variables:           copyJSONStringMap(dm.Transform.Variables),
+				TemplateRender:      dm.Transform.TemplateRender,
+				TemplateSuffix:      dm.Transform.TemplateSuffix,
+				GoModulePath:        dm.Transform.GoModulePath,
+				GoSourceModulePath:  dm.Transform.GoSourceModulePath,
+				UpdateCopyrightYear: dm.Transform.UpdateCopyrightYear,
+				CopyrightYear:       dm.Transform.CopyrightYear,
+				ManagedHeader:       dm.Transform.ManagedHeader,
+				StripManagedHeader:  dm.Transform.StripManagedHeader,
+				LineEndings:         dm.Transform.LineEndings,
+				SecretScrub:         dm.Transform.SecretScrub,
+				SecretPatterns:      copyJSONStringSlice(dm.Transform.SecretPatterns),
+				SecretReplacement:   dm.Transform.SecretReplacement,
+				SecretFailOnMatch:   dm.Transform.SecretFailOnMatch,
+				ConditionalBlocks:   dm.Transform.ConditionalBlocks,
+				Pipeline:            copyJSONStringSlice(dm.Transform.Pipeline),
 			}
 			if err = tx.WithContext(ctx).Create(&tmClone).Error; err != nil {
 				return nil, fmt.Errorf("failed to clone transform for directory %q: %w", dm.Dest, err)
@@ -924,10 +951,25 @@ func cloneTargetInTx(
 	// Clone target-level Transform (OwnerType="target")
 	if source.Transform.ID != 0 {
 		tmClone := db.Transform{
-			OwnerType: "target",
-			OwnerID:   newTarget.ID,
-			RepoName:  source.Transform.RepoName,
-			Variables: copyJSONStringMap(source.Transform.Variables),
+			OwnerType:           "target",
+			OwnerID:             newTarget.ID,
+			RepoName:            source.Transform.RepoName,
+			Variables:           copyJSONStringMap(source.Transform.Variables),
+			TemplateRender:      source.Transform.TemplateRender,
+			TemplateSuffix:      source.Transform.TemplateSuffix,
+			GoModulePath:        source.Transform.GoModulePath,
+			GoSourceModulePath:  source.Transform.GoSourceModulePath,
+			UpdateCopyrightYear: source.Transform.UpdateCopyrightYear,
+			CopyrightYear:       source.Transform.CopyrightYear,
+			ManagedHeader:       source.Transform.ManagedHeader,
+			StripManagedHeader:  source.Transform.StripManagedHeader,
+			LineEndings:         source.Transform.LineEndings,
+			SecretScrub:         source.Transform.SecretScrub,
+			SecretPatterns:      copyJSONStringSlice(source.Transform.SecretPatterns),
+			SecretReplacement:   source.Transform.SecretReplacement,
+			SecretFailOnMatch:   source.Transform.SecretFailOnMatch,
+			ConditionalBlocks:   source.Transform.ConditionalBlocks,
+			Pipeline:            copyJSONStringSlice(source.Transform.Pipeline),
 		}
 		if err = tx.WithContext(ctx).Create(&tmClone).Error; err != nil {
 			return nil, fmt.Errorf("failed to clone target transform: %w", err)
@@ -971,6 +1013,16 @@ func copyJSONStringSlice(s db.JSONStringSlice) db.JSONStringSlice {
 	return c
 }
 
+// copyJSONPRBodySections creates a deep copy of a JSONPRBodySections
+func copyJSONPRBodySections(s db.JSONPRBodySections) db.JSONPRBodySections {
+	if s == nil {
+		return nil
+	}
+	c := make(db.JSONPRBodySections, len(s))
+	copy(c, s)
+	return c
+}
+
 // copyJSONStringMap creates a deep copy of a JSONStringMap
 func copyJSONStringMap(m db.JSONStringMap) db.JSONStringMap {
 	if m == nil {
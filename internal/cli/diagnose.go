@@ -3,7 +3,6 @@ package cli
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
@@ -72,15 +71,31 @@ This command gathers:
 - Environment variables (with sensitive data redacted)
 - Configuration file status and validation
 
+Tokens, authorization headers and secrets are scrubbed from the collected
+data before it is written (--redact, on by default). Add patterns with
+--redact-pattern; use --no-redact only for local debugging.
+
 All output is in JSON format for easy analysis and sharing with support.`,
 	Example: `  # Collect diagnostic information
   go-broadcast diagnose
 
   # Save diagnostics to file
-  go-broadcast diagnose > diagnostics.json`,
+  go-broadcast diagnose > diagnostics.json
+
+  # Also scrub internal hostnames
+  go-broadcast diagnose --redact-pattern '[a-z0-9-]+\.corp\.example\.com'`,
 	RunE: runDiagnose,
 }
 
+// globalDiagnoseOptions holds the redaction flags of the global diagnose command
+//
+//nolint:gochecknoglobals // Bound to the global diagnose command's flags
+var globalDiagnoseOptions = newDiagnoseOptions()
+
+func init() {
+	addDiagnoseFlags(diagnoseCmd, globalDiagnoseOptions)
+}
+
 // createDiagnoseCmdWithVerbose creates a diagnose command with verbose logging support.
 //
 // Parameters:
@@ -89,7 +104,8 @@ All output is in JSON format for easy analysis and sharing with support.`,
 // Returns:
 // - Cobra command configured for diagnostic operations with verbose support
 func createDiagnoseCmdWithVerbose(config *LogConfig) *cobra.Command {
-	return &cobra.Command{
+	opts := newDiagnoseOptions()
+	cmd := &cobra.Command{
 		Use:   "diagnose",
 		Short: "Collect diagnostic information",
 		Long: `Collects comprehensive system information for troubleshooting.
@@ -101,6 +117,10 @@ This command gathers:
 - Environment variables (with sensitive data redacted)
 - Configuration file status and validation
 
+Tokens, authorization headers and secrets are scrubbed from the collected
+data before it is written (--redact, on by default). Add patterns with
+--redact-pattern; use --no-redact only for local debugging.
+
 All output is in JSON format for easy analysis and sharing with support.`,
 		Example: `  # Collect diagnostic information
   go-broadcast diagnose
@@ -108,10 +128,15 @@ All output is in JSON format for easy analysis and sharing with support.`,
   # Save diagnostics to file
   go-broadcast diagnose > diagnostics.json
 
+  # Also scrub internal hostnames
+  go-broadcast diagnose --redact-pattern '[a-z0-9-]+\.corp\.example\.com'
+
   # Include diagnostics in verbose logging session
   go-broadcast diagnose && go-broadcast sync -vvv`,
-		RunE: createRunDiagnoseWithVerbose(config),
+		RunE: createRunDiagnoseWithVerbose(config, opts),
 	}
+	addDiagnoseFlags(cmd, opts)
+	return cmd
 }
 
 // createRunDiagnoseWithVerbose creates a diagnose run function with verbose logging support.
@@ -121,32 +146,13 @@ All output is in JSON format for easy analysis and sharing with support.`,
 //
 // Parameters:
 // - config: LogConfig containing logging and debug configuration
+// - opts: Redaction options bound to the command's flags
 //
 // Returns:
 // - Function that can be used as RunE for Cobra diagnose commands
-func createRunDiagnoseWithVerbose(config *LogConfig) func(*cobra.Command, []string) error {
+func createRunDiagnoseWithVerbose(config *LogConfig, opts *diagnoseOptions) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, _ []string) error {
-		ctx := cmd.Context()
-
-		// Collect all diagnostic information
-		info := &DiagnosticInfo{
-			Timestamp:   time.Now(),
-			Version:     getVersionInfo(),
-			System:      getSystemInfo(),
-			Environment: collectEnvironment(ctx),
-			GitVersion:  getGitVersion(ctx),
-			GHVersion:   getGHCLIVersion(ctx),
-			Config:      getConfigInfo(ctx, config),
-		}
-
-		// Output as formatted JSON to stdout
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(info); err != nil {
-			return fmt.Errorf("failed to encode diagnostic information: %w", err)
-		}
-
-		return nil
+		return writeDiagnostics(cmd.Context(), config, opts)
 	}
 }
 
@@ -328,8 +334,8 @@ func getConfigInfo(ctx context.Context, logConfig *LogConfig) DiagnosticConfigIn
 		Valid:  false,
 	}
 
-	// Check if file exists
-	if _, err := os.Stat(info.Path); err == nil {
+	// Check if file exists (stdin always "exists" and is read by config.Load)
+	if err := statConfigPath(info.Path); err == nil {
 		info.Exists = true
 
 		// Try to load and validate configuration
@@ -353,6 +359,19 @@ func getConfigInfo(ctx context.Context, logConfig *LogConfig) DiagnosticConfigIn
 	return info
 }
 
+// statConfigPath stats every path of a config value, treating "-" (stdin) as present
+func statConfigPath(path string) error {
+	for _, p := range config.SplitPaths(path) {
+		if config.IsStdinPath(p) {
+			continue
+		}
+		if _, err := os.Stat(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // runDiagnose is the global diagnose command run function.
 //
 // This function collects diagnostic information using the global flags
@@ -375,25 +394,7 @@ func runDiagnose(cmd *cobra.Command, _ []string) error {
 		LogFormat:  "text", // Default format for global version
 	}
 
-	// Collect all diagnostic information
-	info := &DiagnosticInfo{
-		Timestamp:   time.Now(),
-		Version:     getVersionInfo(),
-		System:      getSystemInfo(),
-		Environment: collectEnvironment(ctx),
-		GitVersion:  getGitVersion(ctx),
-		GHVersion:   getGHCLIVersion(ctx),
-		Config:      getConfigInfo(ctx, logConfig),
-	}
-
-	// Output as formatted JSON to stdout
-	encoder := json.NewEncoder(os.Stdout)
-	encoder.SetIndent("", "  ")
-	if err := encoder.Encode(info); err != nil {
-		return fmt.Errorf("failed to encode diagnostic information: %w", err)
-	}
-
-	return nil
+	return writeDiagnostics(ctx, logConfig, globalDiagnoseOptions)
 }
 
 // createDiagnoseCmd creates an isolated diagnose command with the given flags.
@@ -407,7 +408,8 @@ func runDiagnose(cmd *cobra.Command, _ []string) error {
 // Returns:
 // - Cobra command configured for diagnostic operations
 func createDiagnoseCmd(flags *Flags) *cobra.Command {
-	return &cobra.Command{
+	opts := newDiagnoseOptions()
+	cmd := &cobra.Command{
 		Use:   "diagnose",
 		Short: "Collect diagnostic information",
 		Long: `Collects comprehensive system information for troubleshooting.
@@ -419,14 +421,23 @@ This command gathers:
 - Environment variables (with sensitive data redacted)
 - Configuration file status and validation
 
+Tokens, authorization headers and secrets are scrubbed from the collected
+data before it is written (--redact, on by default). Add patterns with
+--redact-pattern; use --no-redact only for local debugging.
+
 All output is in JSON format for easy analysis and sharing with support.`,
 		Example: `  # Collect diagnostic information
   go-broadcast diagnose
 
   # Save diagnostics to file
-  go-broadcast diagnose > diagnostics.json`,
-		RunE: createRunDiagnose(flags),
+  go-broadcast diagnose > diagnostics.json
+
+  # Also scrub internal hostnames
+  go-broadcast diagnose --redact-pattern '[a-z0-9-]+\.corp\.example\.com'`,
+		RunE: createRunDiagnose(flags, opts),
 	}
+	addDiagnoseFlags(cmd, opts)
+	return cmd
 }
 
 // createRunDiagnose creates a diagnose run function with the given flags.
@@ -436,10 +447,11 @@ All output is in JSON format for easy analysis and sharing with support.`,
 //
 // Parameters:
 // - flags: Flags containing basic CLI configuration
+// - opts: Redaction options bound to the command's flags
 //
 // Returns:
 // - Function that can be used as RunE for Cobra diagnose commands
-func createRunDiagnose(flags *Flags) func(*cobra.Command, []string) error {
+func createRunDiagnose(flags *Flags, opts *diagnoseOptions) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, _ []string) error {
 		ctx := cmd.Context()
 
@@ -451,24 +463,6 @@ func createRunDiagnose(flags *Flags) func(*cobra.Command, []string) error {
 			LogFormat:  "text", // Default format for isolated version
 		}
 
-		// Collect all diagnostic information
-		info := &DiagnosticInfo{
-			Timestamp:   time.Now(),
-			Version:     getVersionInfo(),
-			System:      getSystemInfo(),
-			Environment: collectEnvironment(ctx),
-			GitVersion:  getGitVersion(ctx),
-			GHVersion:   getGHCLIVersion(ctx),
-			Config:      getConfigInfo(ctx, logConfig),
-		}
-
-		// Output as formatted JSON to stdout
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(info); err != nil {
-			return fmt.Errorf("failed to encode diagnostic information: %w", err)
-		}
-
-		return nil
+		return writeDiagnostics(ctx, logConfig, opts)
 	}
 }
@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/go-broadcast/internal/logging"
+	"github.com/mrz1836/go-broadcast/internal/output"
+)
+
+// diagnoseRedacted replaces values removed by the diagnose redaction pass
+const diagnoseRedacted = "***REDACTED***"
+
+// diagnoseOptions holds the redaction flags of a diagnose command
+type diagnoseOptions struct {
+	Redact         bool     // Scrub secrets from the collected data (default true)
+	NoRedact       bool     // Skip the redaction pass, for local debugging
+	RedactPatterns []string // Additional regular expressions to scrub
+}
+
+// newDiagnoseOptions returns diagnose options with redaction enabled
+func newDiagnoseOptions() *diagnoseOptions {
+	return &diagnoseOptions{Redact: true}
+}
+
+// addDiagnoseFlags registers the redaction flags of a diagnose command
+func addDiagnoseFlags(cmd *cobra.Command, opts *diagnoseOptions) {
+	cmd.Flags().BoolVar(&opts.Redact, "redact", true, "Scrub tokens, auth headers and secret environment values from the output")
+	cmd.Flags().BoolVar(&opts.NoRedact, "no-redact", false, "Disable redaction for local debugging (never share the output)")
+	cmd.Flags().StringSliceVar(&opts.RedactPatterns, "redact-pattern", nil, "Additional regular expression to scrub from the output (repeatable)")
+}
+
+// redactEnabled reports whether the redaction pass runs. Options that were
+// never configured redact.
+func (o *diagnoseOptions) redactEnabled() bool {
+	return o == nil || (o.Redact && !o.NoRedact)
+}
+
+// writeDiagnostics collects diagnostic information, runs the redaction pass
+// unless disabled, and writes it to stdout as indented JSON
+func writeDiagnostics(ctx context.Context, logConfig *LogConfig, opts *diagnoseOptions) error {
+	info := &DiagnosticInfo{
+		Timestamp:   time.Now(),
+		Version:     getVersionInfo(),
+		System:      getSystemInfo(),
+		Environment: collectEnvironment(ctx),
+		GitVersion:  getGitVersion(ctx),
+		GHVersion:   getGHCLIVersion(ctx),
+		Config:      getConfigInfo(ctx, logConfig),
+	}
+
+	if opts.redactEnabled() {
+		var extra []string
+		if opts != nil {
+			extra = opts.RedactPatterns
+		}
+		redactor, err := newDiagnosticRedactor(extra)
+		if err != nil {
+			return err
+		}
+		redactor.redact(info)
+	} else {
+		output.Warn("Diagnostics are not redacted (--no-redact); do not share this output")
+	}
+
+	return encodeDiagnostics(os.Stdout, info)
+}
+
+// encodeDiagnostics writes diagnostic information as indented JSON
+func encodeDiagnostics(w io.Writer, info *DiagnosticInfo) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(info); err != nil {
+		return fmt.Errorf("failed to encode diagnostic information: %w", err)
+	}
+	return nil
+}
+
+// diagnosticRedactor scrubs secrets from collected diagnostic information
+type diagnosticRedactor struct {
+	service *logging.RedactionService
+	extra   []*regexp.Regexp
+}
+
+// newDiagnosticRedactor returns a redactor applying the logging redaction
+// patterns plus the given additional regular expressions
+func newDiagnosticRedactor(patterns []string) (*diagnosticRedactor, error) {
+	r := &diagnosticRedactor{service: logging.NewRedactionService()}
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --redact-pattern %q: %w", pattern, err)
+		}
+		r.extra = append(r.extra, re)
+	}
+	return r, nil
+}
+
+// redact scrubs every free-text field of info in place. Environment
+// variables with a secret-looking name, or a name matching an additional
+// pattern, lose their whole value.
+func (r *diagnosticRedactor) redact(info *DiagnosticInfo) {
+	for key, value := range info.Environment {
+		if isSensitiveEnvVar(key) || r.matchesExtra(key) {
+			info.Environment[key] = diagnoseRedacted
+			continue
+		}
+		info.Environment[key] = r.text(value)
+	}
+
+	info.GitVersion = r.text(info.GitVersion)
+	info.GHVersion = r.text(info.GHVersion)
+	info.Config.Path = r.text(info.Config.Path)
+	info.Config.Error = r.text(info.Config.Error)
+}
+
+// text scrubs secrets from a single value
+func (r *diagnosticRedactor) text(value string) string {
+	if value == "" {
+		return value
+	}
+	value = r.service.RedactSensitive(value)
+	for _, re := range r.extra {
+		value = re.ReplaceAllString(value, diagnoseRedacted)
+	}
+	return value
+}
+
+// matchesExtra reports whether any additional pattern matches value
+func (r *diagnosticRedactor) matchesExtra(value string) bool {
+	for _, re := range r.extra {
+		if re.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
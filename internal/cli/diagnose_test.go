@@ -363,7 +363,7 @@ func TestCreateRunDiagnoseWithVerbose(t *testing.T) {
 		LogFormat:  "json",
 	}
 
-	runFunc := createRunDiagnoseWithVerbose(logConfig)
+	runFunc := createRunDiagnoseWithVerbose(logConfig, newDiagnoseOptions())
 	require.NotNil(t, runFunc)
 
 	// Capture stdout
@@ -420,7 +420,7 @@ func TestCreateRunDiagnose(t *testing.T) {
 		DryRun:     false,
 	}
 
-	runFunc := createRunDiagnose(flags)
+	runFunc := createRunDiagnose(flags, newDiagnoseOptions())
 	require.NotNil(t, runFunc)
 
 	// Capture stdout
@@ -0,0 +1,359 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/output"
+	"github.com/mrz1836/go-broadcast/internal/sync"
+	"github.com/mrz1836/go-broadcast/internal/transform"
+)
+
+const (
+	// maxDiffOutputSize caps the content size diffed for a single file
+	maxDiffOutputSize = 1024 * 1024
+
+	// diffContextLines is the default number of unchanged lines shown around each change
+	diffContextLines = 3
+
+	// maxDiffContextLines caps --diff-context so a large value cannot print
+	// entire files
+	maxDiffContextLines = 100
+)
+
+// Diff command errors
+var (
+	// ErrDiffTargetRequired indicates the diff command was run without --target
+	ErrDiffTargetRequired = errors.New("--target is required")
+
+	// ErrDiffFileNotMapped indicates --file did not match any file mapping of the target
+	ErrDiffFileNotMapped = errors.New("file is not mapped for target")
+
+	// ErrInvalidDiffContext indicates --diff-context is negative
+	ErrInvalidDiffContext = errors.New("--diff-context must be >= 0")
+)
+
+// diffOptions holds the flags for the diff command
+type diffOptions struct {
+	Target  string
+	File    string
+	Context int // Unchanged lines shown around each change
+}
+
+// diffSummary counts the outcome of a diff run
+type diffSummary struct {
+	Files     int
+	Changed   int
+	Skipped   int
+	Unchanged int
+}
+
+// newDiffCmd creates the "diff" command
+func newDiffCmd() *cobra.Command {
+	opts := &diffOptions{Context: diffContextLines}
+
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Preview transformed content against a target without syncing",
+		Long: `Show a diff of what a sync would change in a single target repository.
+
+Source files are read through the GitHub API, transformed exactly as a sync
+would transform them, and compared against the current content of the target.
+No repositories are cloned and no branches, commits or pull requests are
+created.
+
+Only file mappings are previewed; directory mappings are reported and skipped.`,
+		Example: `  # Preview every mapped file for a target
+  go-broadcast diff --config sync.yaml --target org/repo
+
+  # Limit the preview to a single mapping (source or destination path)
+  go-broadcast diff --target org/repo --file .github/workflows/ci.yml
+
+  # Show only the changed lines, without surrounding context
+  go-broadcast diff --target org/repo -U0`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if opts.Target == "" {
+				return ErrDiffTargetRequired
+			}
+			if opts.Context < 0 {
+				return fmt.Errorf("%w: got %d", ErrInvalidDiffContext, opts.Context)
+			}
+			if opts.Context > maxDiffContextLines {
+				output.Warnf("--diff-context %d is capped at %d lines", opts.Context, maxDiffContextLines)
+				opts.Context = maxDiffContextLines
+			}
+
+			cfg, err := loadConfig()
+			if err != nil {
+				return fmt.Errorf("failed to load configuration: %w", err)
+			}
+
+			ghClient, err := newGHClient(cmd.Context(), logrus.StandardLogger(), nil, ghAuthOption(nil), ghProxyOption(cfg), ghHeaderOption(cfg))
+			if err != nil {
+				return fmt.Errorf("failed to initialize GitHub client: %w", err)
+			}
+
+			_, err = runDiffWithClient(cmd.Context(), cfg, ghClient, opts)
+			return err
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Target, "target", "", "Target repository to preview (org/repo)")
+	cmd.Flags().StringVar(&opts.File, "file", "", "Only diff the mapping with this source or destination path")
+	cmd.Flags().IntVarP(&opts.Context, "diff-context", "U", diffContextLines,
+		fmt.Sprintf("Unchanged lines shown around each change (0 shows changes only, at most %d)", maxDiffContextLines))
+
+	return cmd
+}
+
+// runDiffWithClient prints a diff for every file mapping of the requested target
+// using the given GitHub client
+func runDiffWithClient(ctx context.Context, cfg *config.Config, ghClient gh.Client, opts *diffOptions) (*diffSummary, error) {
+	if cfg == nil {
+		return nil, ErrNilConfig
+	}
+
+	summary := &diffSummary{}
+	matched := false
+	for _, group := range config.ExpandSourceBranches(cfg.Groups) {
+		for _, target := range group.Targets {
+			if target.Repo != opts.Target {
+				continue
+			}
+			matched = true
+
+			if err := diffTarget(ctx, ghClient, group, target, opts, summary); err != nil {
+				return summary, err
+			}
+		}
+	}
+
+	if !matched {
+		return nil, fmt.Errorf("%w: %s", ErrNoMatchingTargets, opts.Target)
+	}
+
+	output.Plain("")
+	output.Infof("%d of %d file(s) would change", summary.Changed, summary.Files)
+	return summary, nil
+}
+
+// diffTarget diffs the file mappings of a single target within its group
+func diffTarget(ctx context.Context, ghClient gh.Client, group config.Group, target config.TargetConfig, opts *diffOptions, summary *diffSummary) error {
+	file := opts.File
+	target, err := sync.RenderDestPaths(group.Source.Repo, target)
+	if err != nil {
+		return err
+	}
+	files, err := sync.MatchingFileMappings(ctx, ghClient.GetRepoTopics, target)
+	if err != nil {
+		return err
+	}
+
+	mappings := make([]config.FileMapping, 0, len(files))
+	globs := 0
+	for _, mapping := range files {
+		if mapping.IsGlob() {
+			globs++
+			continue
+		}
+		mapping.Dest = transform.RenderedPath(mapping.Src, mapping.Dest, target.Transform.RenderSuffix())
+		if file == "" || mapping.Src == file || mapping.Dest == file {
+			mappings = append(mappings, mapping)
+		}
+	}
+	if file != "" && len(mappings) == 0 {
+		return fmt.Errorf("%w: %s (%s)", ErrDiffFileNotMapped, file, target.Repo)
+	}
+
+	if len(target.Directories) > 0 && file == "" {
+		output.Warnf("Skipping %d directory mapping(s) for %s; diff previews file mappings only", len(target.Directories), target.Repo)
+	}
+	if globs > 0 && file == "" {
+		output.Warnf("Skipping %d glob file mapping(s) for %s; globs are expanded against the cloned source during sync", globs, target.Repo)
+	}
+
+	chain, err := newDiffTransformChain(group, target)
+	if err != nil {
+		return err
+	}
+	var attrs *transform.GitAttributes
+	if !target.Transform.IsEmpty() {
+		attrs = diffGitAttributes(ctx, ghClient, group)
+	}
+	var topics []string
+	if target.Transform.ConditionalBlocks {
+		if topics, err = ghClient.GetRepoTopics(ctx, target.Repo); err != nil {
+			return fmt.Errorf("failed to get topics of %s: %w", target.Repo, err)
+		}
+	}
+
+	for _, mapping := range mappings {
+		summary.Files++
+
+		current, err := fetchDiffContent(ctx, ghClient, target.Repo, mapping.Dest, target.Branch)
+		if err != nil {
+			return err
+		}
+
+		var desired []byte
+		if !mapping.Delete {
+			source, fetchErr := fetchDiffContent(ctx, ghClient, group.Source.Repo, target.SourcePath(mapping.Src), group.Source.Branch)
+			if fetchErr != nil {
+				return fetchErr
+			}
+			if source == nil {
+				output.Warnf("Source file %s not found in %s, skipping", mapping.Src, group.Source.Repo)
+				summary.Skipped++
+				continue
+			}
+
+			desired, err = transformDiffContent(ctx, chain, group, target, topics, attrs, mapping, source)
+			if err != nil {
+				return fmt.Errorf("failed to transform %s: %w", mapping.Src, err)
+			}
+			if mapping.IsManagedBlock() {
+				if desired, err = sync.SpliceManagedBlock(current, desired, mapping.MarkerStart, mapping.MarkerEnd); err != nil {
+					return fmt.Errorf("failed to splice %s: %w", mapping.Dest, err)
+				}
+			}
+		}
+
+		if !renderFileDiff(mapping.Dest, current, desired, opts.Context) {
+			summary.Unchanged++
+			continue
+		}
+		summary.Changed++
+	}
+
+	return nil
+}
+
+// fetchDiffContent reads a file through the GitHub API, returning nil when it does not exist
+func fetchDiffContent(ctx context.Context, ghClient gh.Client, repo, path, ref string) ([]byte, error) {
+	file, err := ghClient.GetFile(ctx, repo, path, ref)
+	if err != nil {
+		if errors.Is(err, gh.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read %s from %s: %w", path, repo, err)
+	}
+	return file.Content, nil
+}
+
+// newDiffTransformChain builds the transformers a sync would apply to this
+// target using the engine's own chain builders
+func newDiffTransformChain(group config.Group, target config.TargetConfig) (transform.Chain, error) {
+	if len(target.Transform.Pipeline) > 0 {
+		return sync.PipelineTransformChain(target.Transform, logrus.StandardLogger(), nil)
+	}
+	group.Targets = []config.TargetConfig{target}
+	return sync.NewTransformChain([]config.Group{group}, logrus.StandardLogger(), nil), nil
+}
+
+// transformDiffContent applies the target's transformations to source content,
+// matching conditional blocks against the target's topics. Binary content, as
+// judged by the source .gitattributes in attrs and the file itself, and targets
+// without transformations are returned unchanged.
+func transformDiffContent(ctx context.Context, chain transform.Chain, group config.Group, target config.TargetConfig, topics []string, attrs *transform.GitAttributes, mapping config.FileMapping, content []byte) ([]byte, error) {
+	if target.Transform.IsEmpty() {
+		return content, nil
+	}
+	if sync.IsBinarySource(attrs, target.SourcePath(mapping.Src), content) {
+		return content, nil
+	}
+
+	transformCtx := sync.NewTransformContext(&group, group.Source.Repo, target, target.Transform, mapping.Src, mapping.Dest, topics)
+	return chain.Transform(ctx, content, transformCtx)
+}
+
+// diffGitAttributes reads the root .gitattributes of the group's source through
+// the API, as sync reads it from the clone. It returns nil when the group sets
+// source.ignore_gitattributes or the file is missing or unreadable, leaving
+// binary detection to the content.
+func diffGitAttributes(ctx context.Context, ghClient gh.Client, group config.Group) *transform.GitAttributes {
+	if group.Source.IgnoreGitAttributes {
+		return nil
+	}
+	content, err := fetchDiffContent(ctx, ghClient, group.Source.Repo, ".gitattributes", group.Source.Branch)
+	if err != nil {
+		output.Warnf("Failed to read source .gitattributes, using content detection: %v", err)
+		return nil
+	}
+	if content == nil {
+		return nil
+	}
+	return transform.ParseGitAttributes(content)
+}
+
+// renderFileDiff prints a unified diff between the current and desired content
+// of a file, with contextLines lines of context, and reports whether anything
+// changed. A nil slice means the file is absent.
+func renderFileDiff(path string, current, desired []byte, contextLines int) bool {
+	if current == nil && desired == nil {
+		return false
+	}
+	if current != nil && desired != nil && bytes.Equal(current, desired) {
+		return false
+	}
+
+	oldName, newName := "a/"+path, "b/"+path
+	if current == nil {
+		oldName = "/dev/null"
+	}
+	if desired == nil {
+		newName = "/dev/null"
+	}
+
+	output.Plain("")
+	switch {
+	case len(current)+len(desired) > maxDiffOutputSize:
+		output.Diff(fmt.Sprintf("--- %s\n+++ %s\n", oldName, newName))
+		output.Warnf("Diff for %s exceeds %d bytes and was not rendered", path, maxDiffOutputSize)
+	case transform.IsBinary(path, current) || transform.IsBinary(path, desired):
+		output.Diff(fmt.Sprintf("--- %s\n+++ %s\n", oldName, newName))
+		output.Plainf("Binary files differ (%d -> %d bytes)", len(current), len(desired))
+	default:
+		output.Diff(unifiedDiff(oldName, newName, current, desired, contextLines))
+	}
+
+	return true
+}
+
+// unifiedDiff renders a unified diff with contextLines lines of context
+func unifiedDiff(oldName, newName string, current, desired []byte, contextLines int) string {
+	text, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitDiffLines(current),
+		B:        splitDiffLines(desired),
+		FromFile: oldName,
+		ToFile:   newName,
+		Context:  contextLines,
+	})
+	if err != nil {
+		return fmt.Sprintf("--- %s\n+++ %s\n", oldName, newName)
+	}
+	return text
+}
+
+// splitDiffLines splits content into newline-terminated lines for difflib. A
+// final line without a newline carries the "\ No newline at end of file"
+// marker, as in git and patch output.
+func splitDiffLines(content []byte) []string {
+	if len(content) == 0 {
+		return nil
+	}
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+	lines[len(lines)-1] += "\n\\ No newline at end of file\n"
+	return lines
+}
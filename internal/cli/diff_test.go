@@ -0,0 +1,209 @@
+package cli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/output"
+)
+
+// newDiffTestConfig returns a single-group config with one target for diff tests
+func newDiffTestConfig() *config.Config {
+	return &config.Config{
+		Groups: []config.Group{{
+			Name:   "core",
+			ID:     "core",
+			Source: config.SourceConfig{Repo: "org/template", Branch: "main"},
+			Targets: []config.TargetConfig{{
+				Repo: "org/service",
+				Files: []config.FileMapping{
+					{Src: "README.md", Dest: "README.md"},
+					{Src: "Makefile", Dest: "Makefile"},
+					{Src: "new.txt", Dest: "new.txt"},
+					{Dest: "legacy.txt", Delete: true},
+				},
+				Transform: config.Transform{RepoName: true},
+			}},
+		}},
+	}
+}
+
+func TestRunDiffWithClient(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("renders transformed changes without git operations", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/template", ".gitattributes", "main").Return(nil, gh.ErrFileNotFound)
+		ghClient.On("GetFile", mock.Anything, "org/template", "README.md", "main").
+			Return(&gh.FileContent{Content: []byte("# template\nSee github.com/org/template\n")}, nil)
+		ghClient.On("GetFile", mock.Anything, "org/service", "README.md", "").
+			Return(&gh.FileContent{Content: []byte("# template\nSee github.com/org/old\n")}, nil)
+		ghClient.On("GetFile", mock.Anything, "org/template", "Makefile", "main").
+			Return(&gh.FileContent{Content: []byte("all:\n\tgo build\n")}, nil)
+		ghClient.On("GetFile", mock.Anything, "org/service", "Makefile", "").
+			Return(&gh.FileContent{Content: []byte("all:\n\tgo build\n")}, nil)
+		ghClient.On("GetFile", mock.Anything, "org/template", "new.txt", "main").
+			Return(&gh.FileContent{Content: []byte("hello\n")}, nil)
+		ghClient.On("GetFile", mock.Anything, "org/service", "new.txt", "").
+			Return(nil, gh.ErrFileNotFound)
+		ghClient.On("GetFile", mock.Anything, "org/service", "legacy.txt", "").
+			Return(&gh.FileContent{Content: []byte("old\n")}, nil)
+
+		summary, err := runDiffWithClient(ctx, newDiffTestConfig(), ghClient, &diffOptions{Target: "org/service"})
+		require.NoError(t, err)
+		ghClient.AssertExpectations(t)
+
+		assert.Equal(t, &diffSummary{Files: 4, Changed: 3, Unchanged: 1}, summary)
+
+		out := scope.Stdout.String()
+		assert.Contains(t, out, "--- a/README.md")
+		assert.Contains(t, out, "+++ b/README.md")
+		assert.Contains(t, out, "@@ -1,2 +1,2 @@")
+		assert.Contains(t, out, "-See github.com/org/old")
+		assert.Contains(t, out, "+See github.com/org/service")
+		assert.NotContains(t, out, "a/Makefile")
+		assert.Contains(t, out, "--- /dev/null")
+		assert.Contains(t, out, "+++ b/new.txt")
+		assert.Contains(t, out, "+++ /dev/null")
+		assert.Contains(t, out, "-old")
+		assert.Contains(t, out, "3 of 4 file(s) would change")
+	})
+
+	t.Run("limits output to a single mapping", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/template", ".gitattributes", "main").Return(nil, gh.ErrFileNotFound)
+		ghClient.On("GetFile", mock.Anything, "org/template", "Makefile", "main").
+			Return(&gh.FileContent{Content: []byte("all:\n")}, nil)
+		ghClient.On("GetFile", mock.Anything, "org/service", "Makefile", "").
+			Return(&gh.FileContent{Content: []byte("build:\n")}, nil)
+
+		summary, err := runDiffWithClient(ctx, newDiffTestConfig(), ghClient, &diffOptions{Target: "org/service", File: "Makefile"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, summary.Files)
+		assert.Equal(t, 1, summary.Changed)
+		ghClient.AssertNumberOfCalls(t, "GetFile", 3)
+	})
+
+	t.Run("skips missing source files", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/template", ".gitattributes", "main").Return(nil, gh.ErrFileNotFound)
+		ghClient.On("GetFile", mock.Anything, "org/template", "README.md", "main").Return(nil, gh.ErrFileNotFound)
+		ghClient.On("GetFile", mock.Anything, "org/service", "README.md", "").
+			Return(&gh.FileContent{Content: []byte("readme\n")}, nil)
+
+		summary, err := runDiffWithClient(ctx, newDiffTestConfig(), ghClient, &diffOptions{Target: "org/service", File: "README.md"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, summary.Skipped)
+		assert.Contains(t, scope.Stderr.String(), "Source file README.md not found")
+	})
+
+	t.Run("source gitattributes mark files binary", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/template", ".gitattributes", "main").
+			Return(&gh.FileContent{Content: []byte("README.md binary\n")}, nil)
+		ghClient.On("GetFile", mock.Anything, "org/template", "README.md", "main").
+			Return(&gh.FileContent{Content: []byte("See github.com/org/template\n")}, nil)
+		ghClient.On("GetFile", mock.Anything, "org/service", "README.md", "").
+			Return(&gh.FileContent{Content: []byte("See github.com/org/template\n")}, nil)
+
+		summary, err := runDiffWithClient(ctx, newDiffTestConfig(), ghClient, &diffOptions{Target: "org/service", File: "README.md"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, summary.Unchanged, "binary files are synced untransformed, as sync does")
+	})
+
+	t.Run("unknown target", func(t *testing.T) {
+		_, err := runDiffWithClient(ctx, newDiffTestConfig(), &gh.MockClient{}, &diffOptions{Target: "org/missing"})
+		require.ErrorIs(t, err, ErrNoMatchingTargets)
+	})
+
+	t.Run("unmapped file", func(t *testing.T) {
+		_, err := runDiffWithClient(ctx, newDiffTestConfig(), &gh.MockClient{}, &diffOptions{Target: "org/service", File: "nope.txt"})
+		require.ErrorIs(t, err, ErrDiffFileNotMapped)
+	})
+
+	t.Run("nil config", func(t *testing.T) {
+		_, err := runDiffWithClient(ctx, nil, &gh.MockClient{}, &diffOptions{Target: "org/service"})
+		require.ErrorIs(t, err, ErrNilConfig)
+	})
+
+	t.Run("source read failure", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/template", ".gitattributes", "main").Return(nil, gh.ErrFileNotFound)
+		ghClient.On("GetFile", mock.Anything, "org/service", "README.md", "").Return(nil, errNoNetwork)
+
+		_, err := runDiffWithClient(ctx, newDiffTestConfig(), ghClient, &diffOptions{Target: "org/service", File: "README.md"})
+		require.ErrorIs(t, err, errNoNetwork)
+	})
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	current := []byte("one\ntwo\nthree\nfour\nfive\nsix\nseven\neight\n")
+	desired := []byte("one\ntwo\nthree\nfour\nFIVE\nsix\nseven\neight\n")
+
+	assert.Equal(t, `--- a/list.txt
++++ b/list.txt
+@@ -2,7 +2,7 @@
+ two
+ three
+ four
+-five
++FIVE
+ six
+ seven
+ eight
+`, unifiedDiff("a/list.txt", "b/list.txt", current, desired, diffContextLines))
+
+	t.Run("changes only", func(t *testing.T) {
+		assert.Equal(t, "--- a/list.txt\n+++ b/list.txt\n@@ -5 +5 @@\n-five\n+FIVE\n",
+			unifiedDiff("a/list.txt", "b/list.txt", current, desired, 0))
+	})
+
+	t.Run("one line of context", func(t *testing.T) {
+		assert.Equal(t, "--- a/list.txt\n+++ b/list.txt\n@@ -4,3 +4,3 @@\n four\n-five\n+FIVE\n six\n",
+			unifiedDiff("a/list.txt", "b/list.txt", current, desired, 1))
+	})
+
+	t.Run("new file", func(t *testing.T) {
+		assert.Equal(t, "--- /dev/null\n+++ b/new.txt\n@@ -0,0 +1 @@\n+hello\n",
+			unifiedDiff("/dev/null", "b/new.txt", nil, []byte("hello\n"), diffContextLines))
+	})
+
+	t.Run("missing trailing newline", func(t *testing.T) {
+		assert.Equal(t, "--- a/f\n+++ b/f\n@@ -1 +1 @@\n-end\n\\ No newline at end of file\n+end\n",
+			unifiedDiff("a/f", "b/f", []byte("end"), []byte("end\n"), diffContextLines))
+	})
+}
+
+func TestNewDiffCmd(t *testing.T) {
+	cmd := newDiffCmd()
+	assert.Equal(t, "diff", cmd.Use)
+	assert.NotNil(t, cmd.Flags().Lookup("target"))
+	assert.NotNil(t, cmd.Flags().Lookup("file"))
+	require.NotNil(t, cmd.Flags().ShorthandLookup("U"))
+	assert.Equal(t, "3", cmd.Flags().Lookup("diff-context").DefValue)
+
+	cmd.SetArgs([]string{})
+	require.ErrorIs(t, cmd.Execute(), ErrDiffTargetRequired)
+
+	cmd = newDiffCmd()
+	cmd.SetArgs([]string{"--target", "org/repo", "-U", "-1"})
+	require.ErrorIs(t, cmd.Execute(), ErrInvalidDiffContext)
+}
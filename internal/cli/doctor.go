@@ -0,0 +1,187 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/output"
+)
+
+const (
+	// doctorCheckTimeout bounds each individual doctor check
+	doctorCheckTimeout = 15 * time.Second
+
+	// doctorGitHubAddress is dialed to check network reachability
+	doctorGitHubAddress = "github.com:443"
+)
+
+// Doctor command errors
+var (
+	// ErrDoctorChecksFailed indicates at least one critical doctor check failed
+	ErrDoctorChecksFailed = errors.New("doctor found problems with the environment")
+
+	errDoctorGitVersion = errors.New("git --version failed")
+)
+
+// doctorCheck is a single environment check run by the doctor command
+type doctorCheck struct {
+	Name     string
+	Critical bool
+	Hint     string // Remediation shown when the check fails
+	Run      func(ctx context.Context) (string, error)
+}
+
+// newDoctorCmd creates the "doctor" command. authOption is called when the
+// command runs so it sees the parsed --token-file/--token-command values.
+func newDoctorCmd(authOption func() gh.ClientOption) *cobra.Command {
+	return &cobra.Command{
+		Use:   "doctor",
+		Short: "Check that git, gh and GitHub access are ready for a sync",
+		Long: `Run a quick preflight of the environment go-broadcast depends on.
+
+Checks:
+  - git is installed
+  - the GitHub CLI is installed and its token is valid
+  - github.com is reachable
+  - the temporary directory is writable
+
+Each check prints pass or fail with a hint on how to fix it. The command exits
+non-zero when any critical check fails. For a full JSON bundle to share when
+reporting a problem, use "diagnose" instead.`,
+		Example: `  # Check the environment before the first sync
+  go-broadcast doctor
+
+  # Check with a token from a secrets manager
+  go-broadcast doctor --token-command "op read op://ci/github/token"`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runDoctor(cmd.Context(), defaultDoctorChecks(authOption()))
+		},
+	}
+}
+
+// defaultDoctorChecks returns the checks run by the doctor command
+func defaultDoctorChecks(authOption gh.ClientOption) []doctorCheck {
+	return []doctorCheck{
+		{
+			Name:     "git",
+			Critical: true,
+			Hint:     "Install git and make sure it is on your PATH: https://git-scm.com/downloads",
+			Run:      checkGitInstalled,
+		},
+		{
+			Name:     "GitHub CLI authentication",
+			Critical: true,
+			Hint:     "Install gh (https://cli.github.com), then run `gh auth login` or set GH_TOKEN, --token-file or --token-command",
+			Run: func(ctx context.Context) (string, error) {
+				return checkGitHubAuth(ctx, authOption)
+			},
+		},
+		{
+			Name:     "network access to github.com",
+			Critical: true,
+			Hint:     "Check your network connection, proxy and firewall settings for " + doctorGitHubAddress,
+			Run:      checkGitHubReachable,
+		},
+		{
+			Name:     "temporary directory",
+			Critical: true,
+			Hint:     "Make the temporary directory writable or point TMPDIR at one that is",
+			Run:      checkTempDirWritable,
+		},
+	}
+}
+
+// runDoctor runs every check, prints the results and returns an exit code
+// error when a critical check failed
+func runDoctor(ctx context.Context, checks []doctorCheck) error {
+	output.Info("Checking go-broadcast environment...")
+
+	failed := 0
+	for _, check := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, doctorCheckTimeout)
+		detail, err := check.Run(checkCtx)
+		cancel()
+
+		if err == nil {
+			output.Success(fmt.Sprintf("✓ %s: %s", check.Name, detail))
+			continue
+		}
+
+		if check.Critical {
+			failed++
+			output.Error(fmt.Sprintf("✗ %s: %v", check.Name, err))
+		} else {
+			output.Warn(fmt.Sprintf("! %s: %v", check.Name, err))
+		}
+		if check.Hint != "" {
+			output.Plain("    " + check.Hint)
+		}
+	}
+
+	if failed > 0 {
+		return newExitCodeError(1, fmt.Errorf("%w: %d critical check(s) failed", ErrDoctorChecksFailed, failed))
+	}
+	output.Success("All checks passed")
+	return nil
+}
+
+// checkGitInstalled reports the installed git version
+func checkGitInstalled(ctx context.Context) (string, error) {
+	if _, err := exec.LookPath("git"); err != nil {
+		return "", fmt.Errorf("git not found: %w", err)
+	}
+	version := getGitVersion(ctx)
+	if strings.HasPrefix(version, "error:") {
+		return "", fmt.Errorf("%w: %s", errDoctorGitVersion, strings.TrimPrefix(version, "error: "))
+	}
+	return version, nil
+}
+
+// checkGitHubAuth verifies the gh CLI and its token by looking up the current user
+func checkGitHubAuth(ctx context.Context, authOption gh.ClientOption) (string, error) {
+	client, err := newGHClient(ctx, logrus.StandardLogger(), nil, authOption)
+	if err != nil {
+		return "", err
+	}
+	user, err := client.GetCurrentUser(ctx)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("authenticated as %s (%s)", user.Login, getGHCLIVersion(ctx)), nil
+}
+
+// checkGitHubReachable opens a TCP connection to github.com
+func checkGitHubReachable(ctx context.Context) (string, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", doctorGitHubAddress)
+	if err != nil {
+		return "", err
+	}
+	_ = conn.Close()
+	return doctorGitHubAddress + " reachable", nil
+}
+
+// checkTempDirWritable creates and removes a file in the temporary directory
+// used for sync clones
+func checkTempDirWritable(_ context.Context) (string, error) {
+	dir := os.TempDir()
+	file, err := os.CreateTemp(dir, "go-broadcast-doctor-*")
+	if err != nil {
+		return "", fmt.Errorf("%s is not writable: %w", dir, err)
+	}
+	name := file.Name()
+	_ = file.Close()
+	_ = os.Remove(name)
+	return dir + " writable", nil
+}
@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/logging"
+	"github.com/mrz1836/go-broadcast/internal/output"
+)
+
+var errDoctorTestFailure = errors.New("check failed")
+
+// passingCheck returns a doctor check that always succeeds
+func passingCheck(name string) doctorCheck {
+	return doctorCheck{Name: name, Critical: true, Run: func(context.Context) (string, error) {
+		return "ok", nil
+	}}
+}
+
+//nolint:paralleltest // captures global output writers
+func TestRunDoctor(t *testing.T) {
+	t.Run("all checks pass", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		require.NoError(t, runDoctor(context.Background(), []doctorCheck{passingCheck("git"), passingCheck("network")}))
+		assert.Contains(t, scope.Stdout.String(), "✓ git: ok")
+		assert.Contains(t, scope.Stdout.String(), "All checks passed")
+	})
+
+	t.Run("critical failure exits non-zero with a hint", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		failing := doctorCheck{
+			Name:     "git",
+			Critical: true,
+			Hint:     "install git",
+			Run:      func(context.Context) (string, error) { return "", errDoctorTestFailure },
+		}
+		err := runDoctor(context.Background(), []doctorCheck{failing, passingCheck("network")})
+		require.ErrorIs(t, err, ErrDoctorChecksFailed)
+		assert.Equal(t, 1, ExitCodeForError(err))
+		assert.Contains(t, scope.Stderr.String(), "✗ git: check failed")
+		assert.Contains(t, scope.Stdout.String(), "install git")
+		assert.Contains(t, scope.Stdout.String(), "✓ network: ok", "remaining checks still run")
+	})
+
+	t.Run("non-critical failure only warns", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		warning := doctorCheck{Name: "optional", Run: func(context.Context) (string, error) { return "", errDoctorTestFailure }}
+		require.NoError(t, runDoctor(context.Background(), []doctorCheck{warning}))
+	})
+}
+
+//nolint:paralleltest // modifies TMPDIR
+func TestCheckTempDirWritable(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("TMPDIR", dir)
+	detail, err := checkTempDirWritable(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, detail, dir)
+
+	t.Setenv("TMPDIR", filepath.Join(dir, "missing"))
+	_, err = checkTempDirWritable(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not writable")
+}
+
+//nolint:paralleltest // swaps the newGHClient seam
+func TestCheckGitHubAuth(t *testing.T) {
+	original := newGHClient
+	t.Cleanup(func() { newGHClient = original })
+
+	client := &gh.MockClient{}
+	client.On("GetCurrentUser", mock.Anything).Return(&gh.User{Login: "octocat"}, nil)
+	newGHClient = func(context.Context, *logrus.Logger, *logging.LogConfig, ...gh.ClientOption) (gh.Client, error) {
+		return client, nil
+	}
+
+	detail, err := checkGitHubAuth(context.Background(), gh.WithAuth(gh.AuthConfig{}))
+	require.NoError(t, err)
+	assert.Contains(t, detail, "authenticated as octocat")
+
+	newGHClient = original
+	_, err = checkGitHubAuth(context.Background(), gh.WithAuth(gh.AuthConfig{}))
+	require.ErrorIs(t, err, gh.ErrGHNotFound)
+}
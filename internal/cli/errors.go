@@ -21,6 +21,10 @@ func (e *exitCodeError) ExitCode() int {
 	return e.code
 }
 
+// planOnlyExitChanges is the exit code of a sync --plan-only run that found
+// targets out of sync
+const planOnlyExitChanges = 2
+
 func newExitCodeError(code int, err error) error {
 	return &exitCodeError{code: code, err: err}
 }
@@ -47,4 +51,46 @@ var (
 
 	// ErrDatabaseFileNotFound indicates the database file was not found
 	ErrDatabaseFileNotFound = errors.New("database file not found")
+
+	// ErrInvalidLogFormat indicates --log-format was neither "text" nor "json"
+	ErrInvalidLogFormat = errors.New(`invalid log format: must be "text" or "json"`)
+
+	// ErrInvalidConcurrency indicates --concurrency was negative
+	ErrInvalidConcurrency = errors.New("concurrency must be >= 0")
+
+	// ErrInvalidAPIRateLimit indicates --api-rate-limit or --api-burst was negative
+	ErrInvalidAPIRateLimit = errors.New("api rate limit and burst must be >= 0")
+
+	// ErrInvalidStateCacheTTL indicates --state-cache-ttl was negative
+	ErrInvalidStateCacheTTL = errors.New("state cache ttl must be >= 0")
+
+	// ErrAllowEmptyCommitWithoutForce indicates --allow-empty-commit was set without --force
+	ErrAllowEmptyCommitWithoutForce = errors.New("--allow-empty-commit requires --force")
+
+	// ErrDryRunOutputWithoutDryRun indicates --dry-run-output was set without --dry-run
+	ErrDryRunOutputWithoutDryRun = errors.New("--dry-run-output requires --dry-run or --plan-only")
+
+	// ErrLockDirAndRef indicates both --lock-dir and --lock-ref were set
+	ErrLockDirAndRef = errors.New("--lock-dir and --lock-ref cannot be used together")
+
+	// ErrInvalidLockRef indicates --lock-ref was not a full ref name such as refs/go-broadcast/lock
+	ErrInvalidLockRef = errors.New("--lock-ref must be a ref under refs/, such as refs/go-broadcast/lock")
+
+	// ErrInvalidProfile indicates --profile named an unknown profile kind
+	ErrInvalidProfile = errors.New("--profile accepts cpu, mem, trace, block and mutex")
+
+	// ErrValidateDirNotDirectory indicates validate --dir was given a path that is not a directory
+	ErrValidateDirNotDirectory = errors.New("--dir must be a directory")
+
+	// ErrNoConfigFiles indicates validate --dir found no *.yaml or *.yml files
+	ErrNoConfigFiles = errors.New("no configuration files found")
+
+	// ErrConfigFilesInvalid indicates validate --dir found configuration files that failed validation
+	ErrConfigFilesInvalid = errors.New("configuration files failed validation")
+
+	// ErrPlanHasChanges indicates a --plan-only run found targets that would change
+	ErrPlanHasChanges = errors.New("plan has pending changes")
+
+	// ErrMetadataSecretUnset indicates metadata_secret_env names an unset environment variable
+	ErrMetadataSecretUnset = errors.New("metadata secret environment variable is not set")
 )
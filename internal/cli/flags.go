@@ -16,6 +16,7 @@ package cli
 
 import (
 	"sync"
+	"time"
 
 	"github.com/mrz1836/go-broadcast/internal/logging"
 )
@@ -34,14 +35,60 @@ type DebugFlags = logging.DebugFlags
 
 // Flags contains all global flags for the CLI (legacy support)
 type Flags struct {
-	ConfigFile       string
-	DryRun           bool
-	LogLevel         string
-	GroupFilter      []string // Groups to sync (by name or ID)
-	SkipGroups       []string // Groups to skip during sync
-	Automerge        bool     // Enable automerge labels on created PRs
-	ClearModuleCache bool     // Clear module version cache before sync
-	FromDB           bool     // Load configuration from database instead of YAML
+	ConfigFile            string
+	DryRun                bool
+	LogLevel              string
+	LogFormat             string        // Log output format: "text" or "json"
+	GroupFilter           []string      // Groups to sync (by name or ID)
+	SkipGroups            []string      // Groups to skip during sync
+	Targets               []string      // Target repositories to sync (in addition to positional arguments)
+	Automerge             bool          // Enable automerge labels on created PRs
+	AutomergeMethod       string        // Merge method for auto-merge (merge, squash, rebase)
+	Draft                 bool          // Create PRs as drafts
+	ClearModuleCache      bool          // Clear module version cache before sync
+	FromDB                bool          // Load configuration from database instead of YAML
+	FailFast              bool          // Abort the entire sync on the first target failure
+	Concurrency           int           // Maximum targets synced simultaneously (0 = number of CPUs)
+	APIRateLimit          float64       // Maximum GitHub API requests per second (0 = unlimited)
+	APIBurst              int           // Back-to-back GitHub API requests allowed by APIRateLimit
+	TokenFile             string        // Read the GitHub token from this file
+	TokenCommand          string        // Run this command and use its stdout as the GitHub token
+	OutputDir             string        // Directory for per-target JSON sync result artifacts
+	MetricsFile           string        // File receiving one JSON performance record per sync run
+	ContentAware          bool          // Skip targets whose mapped content hash is unchanged
+	Force                 bool          // Sync targets even when they appear up to date
+	AllowEmptyCommit      bool          // With Force, commit and open a PR even when content is unchanged
+	Stagger               time.Duration // Minimum delay between PR creations across targets
+	StaggerJitter         time.Duration // Random extra delay added to each Stagger gap
+	TempDir               string        // Base directory for target working trees
+	KeepTemp              bool          // Keep every target working tree after the sync
+	KeepTempOnFail        bool          // Keep the working tree of failed targets
+	MaxPRs                int           // Pull requests one run may create or update (0 = unlimited)
+	DryRunOutput          string        // File receiving the JSON dry-run plan
+	CheckpointFile        string        // File recording completed targets for resuming an interrupted sync
+	PlanOnly              bool          // Dry run that exits with code 2 when any target would change
+	NoPR                  bool          // Push sync branches without creating or updating pull requests
+	WaitForChecks         bool          // Wait for PR checks to pass before enabling auto-merge
+	ChecksTimeout         time.Duration // Longest wait for PR checks per target (0 = default)
+	SummaryOnly           bool          // Print only a final per-target summary table
+	Explain               bool          // Print why each target is or is not synced
+	LockDir               string        // Directory of per-target lock files
+	LockRef               string        // Ref locking each target in its repository
+	LockTTL               time.Duration // Age after which a stale target lock is reclaimed (0 = default)
+	CloseSuperseded       bool          // Close older open sync PRs of a target once a new one is created
+	Interactive           bool          // Ask before pushing each target's branch and opening its PR
+	BinaryTransformPolicy string        // Binary files with transforms configured: skip (sync untransformed) or fail
+	TargetTimeout         time.Duration // Longest sync per target (0 = no limit)
+	CloneTimeout          time.Duration // Longest source clone per target (0 = no limit)
+	PushTimeout           time.Duration // Longest branch push per target (0 = no limit)
+	PRTimeout             time.Duration // Longest PR creation or update per target (0 = no limit)
+	SourceStatusIssue     int           // Source repository issue whose comment tracks the broadcast status (0 = none)
+	Profile               []string      // Profiles captured while the sync runs: cpu, mem, trace, block, mutex
+	ProfileDir            string        // Directory receiving the captured profiles
+	PRLabels              []string      // PR labels overriding configuration
+	PRAssignees           []string      // PR assignees overriding configuration
+	PRReviewers           []string      // PR reviewers overriding configuration
+	PRLabelsMode          string        // How PR overrides combine with configuration: replace or merge
 }
 
 // globalFlags is the singleton instance of flags
@@ -52,6 +99,7 @@ var (
 	globalFlags = &Flags{
 		ConfigFile: "sync.yaml",
 		LogLevel:   "info",
+		LogFormat:  "text",
 	}
 	globalFlagsMu sync.RWMutex
 )
@@ -101,6 +149,7 @@ func ResetGlobalFlags() {
 	globalFlags.ConfigFile = "sync.yaml"
 	globalFlags.DryRun = false
 	globalFlags.LogLevel = "info"
+	globalFlags.LogFormat = "text"
 	globalFlags.FromDB = false
 }
 
@@ -114,13 +163,59 @@ func GetGlobalFlags() *Flags {
 	}
 	// Return a copy to prevent race conditions
 	return &Flags{
-		ConfigFile:       globalFlags.ConfigFile,
-		DryRun:           globalFlags.DryRun,
-		LogLevel:         globalFlags.LogLevel,
-		GroupFilter:      append([]string(nil), globalFlags.GroupFilter...),
-		SkipGroups:       append([]string(nil), globalFlags.SkipGroups...),
-		Automerge:        globalFlags.Automerge,
-		ClearModuleCache: globalFlags.ClearModuleCache,
-		FromDB:           globalFlags.FromDB,
+		ConfigFile:            globalFlags.ConfigFile,
+		DryRun:                globalFlags.DryRun,
+		LogLevel:              globalFlags.LogLevel,
+		LogFormat:             globalFlags.LogFormat,
+		GroupFilter:           append([]string(nil), globalFlags.GroupFilter...),
+		SkipGroups:            append([]string(nil), globalFlags.SkipGroups...),
+		Targets:               append([]string(nil), globalFlags.Targets...),
+		Automerge:             globalFlags.Automerge,
+		AutomergeMethod:       globalFlags.AutomergeMethod,
+		Draft:                 globalFlags.Draft,
+		ClearModuleCache:      globalFlags.ClearModuleCache,
+		FromDB:                globalFlags.FromDB,
+		FailFast:              globalFlags.FailFast,
+		Concurrency:           globalFlags.Concurrency,
+		APIRateLimit:          globalFlags.APIRateLimit,
+		APIBurst:              globalFlags.APIBurst,
+		TokenFile:             globalFlags.TokenFile,
+		TokenCommand:          globalFlags.TokenCommand,
+		OutputDir:             globalFlags.OutputDir,
+		MetricsFile:           globalFlags.MetricsFile,
+		ContentAware:          globalFlags.ContentAware,
+		Force:                 globalFlags.Force,
+		AllowEmptyCommit:      globalFlags.AllowEmptyCommit,
+		Stagger:               globalFlags.Stagger,
+		StaggerJitter:         globalFlags.StaggerJitter,
+		TempDir:               globalFlags.TempDir,
+		KeepTemp:              globalFlags.KeepTemp,
+		KeepTempOnFail:        globalFlags.KeepTempOnFail,
+		MaxPRs:                globalFlags.MaxPRs,
+		DryRunOutput:          globalFlags.DryRunOutput,
+		CheckpointFile:        globalFlags.CheckpointFile,
+		PlanOnly:              globalFlags.PlanOnly,
+		NoPR:                  globalFlags.NoPR,
+		WaitForChecks:         globalFlags.WaitForChecks,
+		ChecksTimeout:         globalFlags.ChecksTimeout,
+		SummaryOnly:           globalFlags.SummaryOnly,
+		Explain:               globalFlags.Explain,
+		LockDir:               globalFlags.LockDir,
+		LockRef:               globalFlags.LockRef,
+		LockTTL:               globalFlags.LockTTL,
+		CloseSuperseded:       globalFlags.CloseSuperseded,
+		Interactive:           globalFlags.Interactive,
+		BinaryTransformPolicy: globalFlags.BinaryTransformPolicy,
+		TargetTimeout:         globalFlags.TargetTimeout,
+		CloneTimeout:          globalFlags.CloneTimeout,
+		PushTimeout:           globalFlags.PushTimeout,
+		PRTimeout:             globalFlags.PRTimeout,
+		SourceStatusIssue:     globalFlags.SourceStatusIssue,
+		Profile:               append([]string(nil), globalFlags.Profile...),
+		ProfileDir:            globalFlags.ProfileDir,
+		PRLabels:              append([]string(nil), globalFlags.PRLabels...),
+		PRAssignees:           append([]string(nil), globalFlags.PRAssignees...),
+		PRReviewers:           append([]string(nil), globalFlags.PRReviewers...),
+		PRLabelsMode:          globalFlags.PRLabelsMode,
 	}
 }
@@ -1,6 +1,16 @@
 package cli
 
-import "github.com/mrz1836/go-broadcast/internal/gh"
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/mrz1836/go-broadcast/internal/bitbucket"
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/logging"
+)
 
 // newGHClient constructs the GitHub client used by commands that talk to GitHub
 // (status, validate, cancel, ...). It is a package-level seam so tests can inject
@@ -11,3 +21,64 @@ import "github.com/mrz1836/go-broadcast/internal/gh"
 //
 //nolint:gochecknoglobals // test injection seam
 var newGHClient = gh.NewClient
+
+// newBitbucketClient constructs the Bitbucket client for `provider: bitbucket`
+//
+//nolint:gochecknoglobals // test injection seam
+var newBitbucketClient = func(ctx context.Context, logger *logrus.Logger) (gh.Client, error) {
+	return bitbucket.NewClient(ctx, bitbucket.ConfigFromEnv(), logger)
+}
+
+// newForgeClient constructs the client for the provider selected in the
+// configuration. GitHub options are ignored for Bitbucket, which reads its
+// credentials from BITBUCKET_TOKEN or BITBUCKET_USERNAME/BITBUCKET_APP_PASSWORD.
+func newForgeClient(ctx context.Context, cfg *config.Config, logger *logrus.Logger, logConfig *logging.LogConfig, opts ...gh.ClientOption) (gh.Client, error) {
+	if cfg != nil && cfg.Provider == config.ProviderBitbucket {
+		client, err := newBitbucketClient(ctx, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Bitbucket client: %w", err)
+		}
+		return client, nil
+	}
+
+	client, err := newGHClient(ctx, logger, logConfig, append(opts, ghProxyOption(cfg), ghHeaderOption(cfg))...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitHub client: %w", err)
+	}
+	return client, nil
+}
+
+// ghAuthOption selects the GitHub token source from --token-file or
+// --token-command. Commands built from a LogConfig carry their own flag
+// values; everything else reads the global flags.
+func ghAuthOption(logConfig *LogConfig) gh.ClientOption {
+	if logConfig != nil && (logConfig.TokenFile != "" || logConfig.TokenCommand != "") {
+		return gh.WithAuth(gh.AuthConfig{TokenFile: logConfig.TokenFile, TokenCommand: logConfig.TokenCommand})
+	}
+	flags := GetGlobalFlags()
+	return gh.WithAuth(gh.AuthConfig{TokenFile: flags.TokenFile, TokenCommand: flags.TokenCommand})
+}
+
+// ghProxyOption routes the GitHub client through the proxy configured in cfg
+func ghProxyOption(cfg *config.Config) gh.ClientOption {
+	if cfg == nil {
+		return gh.WithProxy(gh.ProxyConfig{})
+	}
+	return gh.WithProxy(gh.ProxyConfig{
+		URL:         cfg.Proxy.URL,
+		Username:    cfg.Proxy.Username,
+		PasswordEnv: cfg.Proxy.PasswordEnv,
+		CAFile:      cfg.Proxy.CAFile,
+	})
+}
+
+// ghHeaderOption adds the API headers configured in cfg to every GitHub request
+func ghHeaderOption(cfg *config.Config) gh.ClientOption {
+	if cfg == nil {
+		return gh.WithHeaders(gh.HeaderConfig{})
+	}
+	return gh.WithHeaders(gh.HeaderConfig{
+		UserAgent:    cfg.APIHeaders.UserAgent,
+		ExtraHeaders: cfg.APIHeaders.Headers,
+	})
+}
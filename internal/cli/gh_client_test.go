@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/bitbucket"
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+//nolint:paralleltest // swaps the newBitbucketClient seam
+func TestNewForgeClient_Bitbucket(t *testing.T) {
+	original := newBitbucketClient
+	t.Cleanup(func() { newBitbucketClient = original })
+
+	client := &gh.MockClient{}
+	newBitbucketClient = func(context.Context, *logrus.Logger) (gh.Client, error) {
+		return client, nil
+	}
+
+	got, err := newForgeClient(context.Background(), &config.Config{Provider: config.ProviderBitbucket}, logrus.New(), nil)
+	require.NoError(t, err)
+	assert.Same(t, client, got)
+
+	newBitbucketClient = original
+	t.Setenv(bitbucket.EnvToken, "")
+	t.Setenv(bitbucket.EnvUsername, "")
+	t.Setenv(bitbucket.EnvAppPassword, "")
+	_, err = newForgeClient(context.Background(), &config.Config{Provider: config.ProviderBitbucket}, logrus.New(), nil)
+	require.ErrorIs(t, err, bitbucket.ErrNoCredentials)
+}
@@ -18,12 +18,15 @@ func FilterConfigByGroups(cfg *config.Config, groupFilter, skipGroups []string)
 
 	// Create a copy of the config with filtered groups
 	filteredCfg := &config.Config{
-		Version:        cfg.Version,
-		Name:           cfg.Name,
-		ID:             cfg.ID,
-		Groups:         []config.Group{},
-		FileLists:      cfg.FileLists,
-		DirectoryLists: cfg.DirectoryLists,
+		Version:           cfg.Version,
+		Name:              cfg.Name,
+		ID:                cfg.ID,
+		Groups:            []config.Group{},
+		FileLists:         cfg.FileLists,
+		DirectoryLists:    cfg.DirectoryLists,
+		Provider:          cfg.Provider,
+		GlobalExclude:     cfg.GlobalExclude,
+		MetadataSecretEnv: cfg.MetadataSecretEnv,
 	}
 
 	for _, group := range cfg.Groups {
@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/output"
+	"github.com/mrz1836/go-broadcast/internal/sync"
+)
+
+// listTargetsOptions holds the flags for the list-targets command
+type listTargetsOptions struct {
+	JSON       bool
+	Groups     []string
+	SkipGroups []string
+}
+
+// newListTargetsCmd creates the "list-targets" command
+func newListTargetsCmd() *cobra.Command {
+	opts := &listTargetsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list-targets",
+		Short: "List the targets the configuration resolves to",
+		Long: `Load and fully resolve the configuration, then list every target a sync would
+write to: its group, the number of file and directory mappings, the sync branch
+prefix, and the PR labels and reviewers after group defaults and global
+settings are applied.
+
+Environment interpolation, file and directory list references and group
+filters are resolved exactly as for sync: disabled groups are left out and a
+group with several source branches is listed once per branch. Nothing is read
+from or written to GitHub.`,
+		Example: `  # Show every target as a table
+  go-broadcast list-targets --config sync.yaml
+
+  # Only the targets of some groups
+  go-broadcast list-targets --groups core --skip-groups experimental
+
+  # JSON output for scripting
+  go-broadcast list-targets --json`,
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			cfg, err := loadConfig()
+			if err != nil {
+				return fmt.Errorf("failed to load configuration: %w", err)
+			}
+			return runListTargets(cfg, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output as JSON")
+	cmd.Flags().StringSliceVar(&opts.Groups, "groups", nil, "Only list targets of these groups (by name or ID)")
+	cmd.Flags().StringSliceVar(&opts.SkipGroups, "skip-groups", nil, "Skip these groups (by name or ID)")
+	return cmd
+}
+
+// runListTargets prints the resolved targets of cfg as a table or JSON
+func runListTargets(cfg *config.Config, opts *listTargetsOptions) error {
+	cfg = FilterConfigByGroups(cfg, opts.Groups, opts.SkipGroups)
+	targets := sync.ResolveTargets(cfg, sync.DefaultOptions())
+	if targets == nil {
+		targets = []sync.TargetSummary{}
+	}
+
+	if opts.JSON {
+		encoder := json.NewEncoder(output.Stdout())
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(targets); err != nil {
+			return fmt.Errorf("failed to write targets: %w", err)
+		}
+		return nil
+	}
+
+	writer := tabwriter.NewWriter(output.Stdout(), 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "GROUP\tTARGET\tFILES\tDIRS\tBRANCH PREFIX\tLABELS\tREVIEWERS")
+	for _, target := range targets {
+		reviewers := append(append([]string(nil), target.Reviewers...), teamReviewerNames(target.TeamReviewers)...)
+		_, _ = fmt.Fprintf(writer, "%s\t%s\t%d\t%d\t%s\t%s\t%s\n", target.Group, target.Repo,
+			target.Files, target.Directories, target.BranchPrefix, listOrDash(target.Labels), listOrDash(reviewers))
+	}
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("failed to write targets: %w", err)
+	}
+	return nil
+}
+
+// teamReviewerNames marks team reviewers with a leading @ to set them apart
+// from user reviewers in the table
+func teamReviewerNames(teams []string) []string {
+	names := make([]string, 0, len(teams))
+	for _, team := range teams {
+		names = append(names, "@"+team)
+	}
+	return names
+}
+
+// listOrDash joins values with commas, or returns "-" when there are none
+func listOrDash(values []string) string {
+	if len(values) == 0 {
+		return "-"
+	}
+	return strings.Join(values, ",")
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/output"
+	"github.com/mrz1836/go-broadcast/internal/sync"
+)
+
+func listTargetsConfig() *config.Config {
+	return &config.Config{Groups: []config.Group{
+		{
+			Name:     "Core",
+			ID:       "core",
+			Defaults: config.DefaultConfig{PRLabels: []string{"automated-sync"}},
+			Targets: []config.TargetConfig{{
+				Repo:            "org/a",
+				Files:           []config.FileMapping{{Src: "a", Dest: "a"}},
+				PRReviewers:     []string{"alice"},
+				PRTeamReviewers: []string{"platform"},
+			}},
+		},
+		{Name: "Docs", ID: "docs", Targets: []config.TargetConfig{{Repo: "org/docs"}}},
+	}}
+}
+
+func TestRunListTargets(t *testing.T) {
+	t.Run("table", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		require.NoError(t, runListTargets(listTargetsConfig(), &listTargetsOptions{}))
+
+		out := scope.Stdout.String()
+		assert.Contains(t, out, "BRANCH PREFIX")
+		assert.Regexp(t, `(?m)^core\s+org/a\s+1\s+0\s+chore/sync-files\s+automated-sync\s+alice,@platform$`, out)
+		assert.Regexp(t, `(?m)^docs\s+org/docs\s+0\s+0\s+chore/sync-files\s+-\s+-$`, out)
+	})
+
+	t.Run("json with group filter", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		require.NoError(t, runListTargets(listTargetsConfig(), &listTargetsOptions{JSON: true, SkipGroups: []string{"core"}}))
+
+		var targets []sync.TargetSummary
+		require.NoError(t, json.Unmarshal(scope.Stdout.Bytes(), &targets))
+		require.Len(t, targets, 1)
+		assert.Equal(t, "org/docs", targets[0].Repo)
+		assert.Equal(t, []string{}, targets[0].Labels)
+	})
+}
@@ -130,9 +130,7 @@ func (s *LoggerService) ConfigureLogger(ctx context.Context) error {
 	}
 
 	// Add redaction hook for automatic sensitive data protection
-	redactionService := logging.NewRedactionService()
-	redactionHook := redactionService.CreateHook()
-	logrus.AddHook(redactionHook)
+	logging.EnsureRedactionHook(logrus.StandardLogger())
 
 	// Configure formatter based on format preference and verbose level
 	if s.config.LogFormat == "json" || s.config.JSONOutput {
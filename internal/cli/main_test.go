@@ -27,7 +27,7 @@ var errNoNetwork = errors.New("network access disabled in tests")
 // helpers or the newReviewPRClient seam; the real-API integration tests are
 // explicitly t.Skip'd.
 func TestMain(m *testing.M) {
-	newGHClient = func(context.Context, *logrus.Logger, *logging.LogConfig) (gh.Client, error) {
+	newGHClient = func(context.Context, *logrus.Logger, *logging.LogConfig, ...gh.ClientOption) (gh.Client, error) {
 		return nil, gh.ErrGHNotFound
 	}
 
@@ -37,6 +37,7 @@ func initMetrics() {
 	metricsCmd.Flags().StringVar(&metricsRepo, "repo", "", "Filter by target repo (owner/name)")
 	metricsCmd.Flags().StringVar(&metricsRunID, "run", "", "Show details for specific run ID (external_id)")
 	metricsCmd.Flags().BoolVar(&metricsJSON, "json", false, "Output as JSON")
+	initMetricsServe()
 }
 
 //nolint:gochecknoglobals // Cobra commands are designed to be global variables
@@ -376,6 +377,8 @@ func formatMetricsStatus(status string) string {
 		return "- skipped"
 	case "no_changes":
 		return "- no changes"
+	case "branch_pushed":
+		return "⎇ branch pushed"
 	default:
 		return status
 	}
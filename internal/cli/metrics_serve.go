@@ -0,0 +1,268 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	gosync "sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/go-broadcast/internal/output"
+	"github.com/mrz1836/go-broadcast/internal/reporting"
+	"github.com/mrz1836/go-broadcast/internal/sync"
+)
+
+// metricsServeShutdownTimeout bounds how long in-flight scrapes may take to
+// finish once the command is interrupted
+const metricsServeShutdownTimeout = 5 * time.Second
+
+// prometheusContentType is the content type of the Prometheus text exposition format
+const prometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// ErrInvalidMetricsServeInterval indicates --interval is not a positive duration
+var ErrInvalidMetricsServeInterval = errors.New("--interval must be positive")
+
+//nolint:gochecknoglobals // Package-level variables for CLI flags
+var (
+	metricsServeMu       gosync.RWMutex
+	metricsServeFile     string
+	metricsServePort     int
+	metricsServeInterval time.Duration
+)
+
+// getMetricsServeFlags returns a snapshot of the metrics serve flags (thread-safe)
+func getMetricsServeFlags() (file string, port int, interval time.Duration) {
+	metricsServeMu.RLock()
+	defer metricsServeMu.RUnlock()
+	return metricsServeFile, metricsServePort, metricsServeInterval
+}
+
+// initMetricsServe initializes the metrics serve command flags
+func initMetricsServe() {
+	metricsServeCmd.Flags().StringVar(&metricsServeFile, "file", "", "Metrics log written by sync --metrics-file")
+	metricsServeCmd.Flags().IntVar(&metricsServePort, "port", 9090, "Port to serve the aggregated metrics on")
+	metricsServeCmd.Flags().DurationVar(&metricsServeInterval, "interval", 2*time.Second, "How often the metrics log is checked for new runs")
+	_ = metricsServeCmd.MarkFlagRequired("file")
+	metricsCmd.AddCommand(metricsServeCmd)
+}
+
+//nolint:gochecknoglobals // Cobra commands are designed to be global variables
+var metricsServeCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve aggregated run metrics from a metrics log over HTTP",
+	Long: `Tail the JSON lines log written by sync --metrics-file and serve the
+aggregated activity of every run recorded in it:
+
+  • /metrics       Prometheus text format
+  • /metrics.json  JSON summary
+
+The summary counts runs and failed runs, the failure rate and the average run
+duration, and sums every per-run metric such as pull requests, API calls and
+changed files. Runs appended to the log are picked up while serving. When the
+log is rotated or truncated, reading starts over at the top of the new
+content; runs already counted are kept, so counters never go backwards.`,
+	Example: `  # Serve the metrics log on port 9090
+  go-broadcast metrics serve --file metrics.jsonl
+
+  # Serve on another port and check for new runs every 10 seconds
+  go-broadcast metrics serve --file metrics.jsonl --port 9100 --interval 10s`,
+	Args: cobra.NoArgs,
+	RunE: runMetricsServe,
+}
+
+// runMetricsServe executes the metrics serve command
+func runMetricsServe(cmd *cobra.Command, _ []string) error {
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	file, port, interval := getMetricsServeFlags()
+	if interval <= 0 {
+		return fmt.Errorf("%w: got %s", ErrInvalidMetricsServeInterval, interval)
+	}
+
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return fmt.Errorf("failed to listen on port %d: %w", port, err)
+	}
+
+	tailer := newMetricsTailer(file, logrus.NewEntry(logrus.StandardLogger()))
+	output.Infof("Serving run metrics from %s on http://localhost:%d/metrics", file, port)
+	return serveRunMetrics(ctx, listener, tailer, interval)
+}
+
+// serveRunMetrics serves the tailer's aggregate on listener, polling the
+// metrics log every interval, until ctx is canceled
+func serveRunMetrics(ctx context.Context, listener net.Listener, tailer *metricsTailer, interval time.Duration) error {
+	if err := tailer.poll(); err != nil {
+		_ = listener.Close()
+		return err
+	}
+
+	server := &http.Server{
+		Handler:           newRunMetricsHandler(tailer.aggregator),
+		ReadHeaderTimeout: 30 * time.Second, // Prevent Slowloris attacks
+		ReadTimeout:       60 * time.Second,
+		WriteTimeout:      60 * time.Second,
+		IdleTimeout:       120 * time.Second,
+	}
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.Serve(listener)
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), metricsServeShutdownTimeout)
+			err := server.Shutdown(shutdownCtx)
+			cancel()
+			return err
+		case err := <-serveErr:
+			return fmt.Errorf("metrics server stopped: %w", err)
+		case <-ticker.C:
+			if err := tailer.poll(); err != nil {
+				tailer.logger.WithError(err).Warn("Failed to read metrics log")
+			}
+		}
+	}
+}
+
+// newRunMetricsHandler serves aggregator's summary at /metrics in Prometheus
+// text format and at /metrics.json as JSON
+func newRunMetricsHandler(aggregator *reporting.RunAggregator) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, _ *http.Request) {
+		var buf bytes.Buffer
+		if err := aggregator.Summary().WritePrometheus(&buf); err != nil {
+			http.Error(w, "Failed to encode metrics", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", prometheusContentType)
+		_, _ = w.Write(buf.Bytes())
+	})
+	mux.HandleFunc("/metrics.json", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(aggregator.Summary())
+	})
+	return mux
+}
+
+// metricsTailer follows a metrics log like tail -F, adding each complete
+// RunMetrics line to its aggregator once
+type metricsTailer struct {
+	path       string
+	aggregator *reporting.RunAggregator
+	logger     *logrus.Entry
+	file       *os.File
+	partial    []byte // Trailing line not yet terminated by a newline
+}
+
+// newMetricsTailer creates a tailer of path that has read nothing yet
+func newMetricsTailer(path string, logger *logrus.Entry) *metricsTailer {
+	return &metricsTailer{path: path, aggregator: reporting.NewRunAggregator(), logger: logger}
+}
+
+// poll reads the lines appended since the last poll. A log truncated in place
+// is read again from the top; a rotated log is drained and the file now at
+// the path is read from the top. A log that does not exist yet is not an error.
+func (t *metricsTailer) poll() error {
+	if t.file == nil {
+		if err := t.open(); err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+	}
+
+	info, err := t.file.Stat()
+	if err != nil {
+		return err
+	}
+	offset, err := t.file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if info.Size() < offset {
+		t.logger.WithField("path", t.path).Info("Metrics log truncated, reading it from the top")
+		if _, err = t.file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		t.partial = nil
+	}
+	if err = t.read(); err != nil {
+		return err
+	}
+
+	// A missing path means mid-rotation; the old file is kept until a new one appears
+	if current, statErr := os.Stat(t.path); statErr == nil && !os.SameFile(info, current) {
+		t.logger.WithField("path", t.path).Info("Metrics log rotated, reading the new file")
+		_ = t.file.Close()
+		t.file, t.partial = nil, nil
+		if err = t.open(); err != nil {
+			return err
+		}
+		return t.read()
+	}
+	return nil
+}
+
+// open opens the log at the path for reading from the top
+func (t *metricsTailer) open() error {
+	file, err := os.Open(t.path) //nolint:gosec // path is operator-supplied
+	if err != nil {
+		return err
+	}
+	t.file = file
+	return nil
+}
+
+// read adds every complete line from the current position to the end of the log
+func (t *metricsTailer) read() error {
+	data, err := io.ReadAll(t.file)
+	if err != nil {
+		return err
+	}
+	data = append(t.partial, data...)
+	for {
+		end := bytes.IndexByte(data, '\n')
+		if end < 0 {
+			break
+		}
+		t.add(data[:end])
+		data = data[end+1:]
+	}
+	t.partial = append([]byte(nil), data...)
+	return nil
+}
+
+// add records one line of the log. Blank lines are ignored and malformed
+// lines are logged and skipped.
+func (t *metricsTailer) add(line []byte) {
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 {
+		return
+	}
+	var record sync.RunMetrics
+	if err := json.Unmarshal(line, &record); err != nil {
+		t.logger.WithError(err).WithField("path", t.path).Warn("Skipping malformed metrics log line")
+		return
+	}
+	values := record.Values()
+	// A ratio does not sum across runs; the cache hits and misses it comes from do
+	delete(values, "cache_hit_rate")
+	t.aggregator.Add(values, record.Error != "", record.EndedAt)
+}
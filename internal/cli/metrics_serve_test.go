@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/reporting"
+	"github.com/mrz1836/go-broadcast/internal/sync"
+)
+
+// runMetricsLine returns one metrics log line for a run with prs pull requests
+func runMetricsLine(t *testing.T, prs int, runErr string) string {
+	t.Helper()
+	data, err := json.Marshal(sync.RunMetrics{DurationMs: 1000, PullRequests: prs, CacheHitRate: 0.5, Error: runErr})
+	require.NoError(t, err)
+	return string(data) + "\n"
+}
+
+// appendToFile appends content to path, creating it when missing
+func appendToFile(t *testing.T, path, content string) {
+	t.Helper()
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) //nolint:gosec // test-controlled path
+	require.NoError(t, err)
+	_, err = file.WriteString(content)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+}
+
+func TestMetricsTailer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.jsonl")
+	tailer := newMetricsTailer(path, logrus.NewEntry(logrus.New()))
+	runs := func() reporting.RunSummary {
+		t.Helper()
+		require.NoError(t, tailer.poll())
+		return tailer.aggregator.Summary()
+	}
+
+	// Nothing to read until the log is written
+	assert.Zero(t, runs().Runs)
+
+	appendToFile(t, path, runMetricsLine(t, 2, "")+runMetricsLine(t, 1, "boom")+"not json\n\n")
+	summary := runs()
+	assert.Equal(t, 2, summary.Runs)
+	assert.Equal(t, 1, summary.FailedRuns)
+	assert.InDelta(t, 3.0, summary.Totals["pull_requests"], 0)
+	assert.NotContains(t, summary.Totals, "cache_hit_rate")
+
+	// A line is only counted once it is complete
+	line := runMetricsLine(t, 1, "")
+	appendToFile(t, path, line[:10])
+	assert.Equal(t, 2, runs().Runs)
+	appendToFile(t, path, line[10:])
+	assert.Equal(t, 3, runs().Runs)
+
+	t.Run("truncated log is read from the top", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte(runMetricsLine(t, 1, "")), 0o600))
+		assert.Equal(t, 4, runs().Runs)
+	})
+
+	t.Run("rotated log is drained and the new file read", func(t *testing.T) {
+		appendToFile(t, path, runMetricsLine(t, 1, ""))
+		require.NoError(t, os.Rename(path, path+".1"))
+		assert.Equal(t, 5, runs().Runs, "the old file is drained while the path is missing")
+
+		appendToFile(t, path+".1", runMetricsLine(t, 1, ""))
+		appendToFile(t, path, runMetricsLine(t, 1, ""))
+		summary := runs()
+		assert.Equal(t, 7, summary.Runs)
+		assert.InDelta(t, 8.0, summary.Totals["pull_requests"], 0)
+	})
+}
+
+func TestServeRunMetrics(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.jsonl")
+	appendToFile(t, path, runMetricsLine(t, 3, "")+runMetricsLine(t, 1, "boom"))
+
+	listener, err := (&net.ListenConfig{}).Listen(context.Background(), "tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	baseURL := "http://" + listener.Addr().String()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- serveRunMetrics(ctx, listener, newMetricsTailer(path, logrus.NewEntry(logrus.New())), time.Hour)
+	}()
+
+	get := func(endpoint string) (string, string) {
+		t.Helper()
+		var resp *http.Response
+		require.Eventually(t, func() bool {
+			req, reqErr := http.NewRequestWithContext(context.Background(), http.MethodGet, baseURL+endpoint, nil)
+			require.NoError(t, reqErr)
+			var getErr error
+			resp, getErr = http.DefaultClient.Do(req) //nolint:bodyclose // closed below
+			return getErr == nil
+		}, 5*time.Second, 10*time.Millisecond)
+		defer func() { _ = resp.Body.Close() }()
+		body, readErr := io.ReadAll(resp.Body)
+		require.NoError(t, readErr)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		return resp.Header.Get("Content-Type"), string(body)
+	}
+
+	contentType, body := get("/metrics")
+	assert.Equal(t, prometheusContentType, contentType)
+	assert.Contains(t, body, "go_broadcast_runs_total 2\n")
+	assert.Contains(t, body, "go_broadcast_run_failure_ratio 0.5\n")
+	assert.Contains(t, body, "go_broadcast_run_pull_requests_total 4\n")
+
+	contentType, body = get("/metrics.json")
+	assert.Equal(t, "application/json", contentType)
+	var summary reporting.RunSummary
+	require.NoError(t, json.Unmarshal([]byte(body), &summary))
+	assert.Equal(t, 2, summary.Runs)
+	assert.InDelta(t, 1000.0, summary.AvgDurationMs, 0)
+
+	cancel()
+	require.NoError(t, <-done)
+}
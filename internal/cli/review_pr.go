@@ -67,7 +67,7 @@ var reviewPRCmd = createReviewPRCmd(globalFlags)
 //
 //nolint:gochecknoglobals // test injection seam
 var newReviewPRClient = func(ctx context.Context, logger *logrus.Logger) (gh.Client, error) {
-	return gh.NewClient(ctx, logger, nil)
+	return gh.NewClient(ctx, logger, nil, ghAuthOption(nil))
 }
 
 // PRInfo contains parsed information from a PR URL
@@ -0,0 +1,182 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/output"
+	"github.com/mrz1836/go-broadcast/internal/sync"
+)
+
+// ErrRollbackFailed indicates at least one target could not be rolled back
+var ErrRollbackFailed = errors.New("rollback failed for one or more targets")
+
+// rollbackOptions holds the flags for the rollback command
+type rollbackOptions struct {
+	Targets []string
+}
+
+// rollbackPlanner plans and applies rollbacks; *sync.Rollbacker implements it
+type rollbackPlanner interface {
+	Plan(ctx context.Context, repo string) (*sync.RollbackPlan, error)
+	Apply(ctx context.Context, plan *sync.RollbackPlan) (*gh.PR, error)
+}
+
+// rollbackSummary counts the outcome of a rollback run
+type rollbackSummary struct {
+	Targets   int
+	Opened    int
+	Unchanged int
+	Failed    int
+}
+
+// newRollbackCmd creates the "rollback" command
+func newRollbackCmd() *cobra.Command {
+	opts := &rollbackOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Revert the last merged sync in target repositories",
+		Long: `Open a pull request in each target that reverts its last merged sync.
+
+For every target, the most recently merged pull request carrying go-broadcast
+sync metadata is located. The files changed by its sync commit are read at the
+commit the sync was applied on, and a new branch restores them: modified and
+removed files get their prior content back and files added by the sync are
+deleted. Files that already match their pre-sync content are left alone.
+
+Use --dry-run to list the changes without creating branches or pull requests.`,
+		Example: `  # Preview the rollback of every configured target
+  go-broadcast rollback --config sync.yaml --dry-run
+
+  # Roll back specific targets
+  go-broadcast rollback --target org/repo1 --target org/repo2`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := loadConfig()
+			if err != nil {
+				return fmt.Errorf("failed to load configuration: %w", err)
+			}
+
+			repos, err := rollbackTargets(cfg, opts.Targets)
+			if err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+			logger := logrus.StandardLogger()
+			ghClient, err := newGHClient(ctx, logger, nil, ghAuthOption(nil), ghProxyOption(cfg), ghHeaderOption(cfg))
+			if err != nil {
+				return fmt.Errorf("failed to initialize GitHub client: %w", err)
+			}
+			gitClient, err := newSyncGitClient(ctx, cfg, logger, nil)
+			if err != nil {
+				return err
+			}
+
+			summary := runRollback(ctx, sync.NewRollbacker(cfg, ghClient, gitClient, logger), repos, IsDryRun())
+			if summary.Failed > 0 {
+				return fmt.Errorf("%w: %d of %d", ErrRollbackFailed, summary.Failed, summary.Targets)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&opts.Targets, "target", nil, "Target repository to roll back (org/repo); repeat for several, default all")
+
+	return cmd
+}
+
+// rollbackTargets returns the configured target repositories, each once and
+// in config order, limited to filter when it is not empty
+func rollbackTargets(cfg *config.Config, filter []string) ([]string, error) {
+	if cfg == nil {
+		return nil, ErrNilConfig
+	}
+
+	seen := make(map[string]bool)
+	var repos []string
+	for _, group := range cfg.Groups {
+		for _, target := range group.Targets {
+			if !seen[target.Repo] {
+				seen[target.Repo] = true
+				repos = append(repos, target.Repo)
+			}
+		}
+	}
+
+	if len(filter) == 0 {
+		return repos, nil
+	}
+	for _, repo := range filter {
+		if !seen[repo] {
+			return nil, fmt.Errorf("%w: %q", ErrTargetNotFound, repo)
+		}
+	}
+	return filter, nil
+}
+
+// runRollback plans the rollback of each repository and, unless dryRun is
+// set, opens its rollback pull request. A failing target does not stop the
+// others.
+func runRollback(ctx context.Context, planner rollbackPlanner, repos []string, dryRun bool) *rollbackSummary {
+	summary := &rollbackSummary{Targets: len(repos)}
+	if dryRun {
+		output.Warn("DRY-RUN MODE: No changes will be made")
+	}
+
+	for _, repo := range repos {
+		output.Plain("")
+		output.Infof("📦 %s", repo)
+
+		plan, err := planner.Plan(ctx, repo)
+		if err != nil {
+			output.Errorf("  ✗ %v", err)
+			summary.Failed++
+			continue
+		}
+
+		output.Plainf("  Reverting sync PR #%d (content from %s)", plan.SyncPR.Number, plan.BaseCommit)
+		if len(plan.Changes) == 0 {
+			output.Plain("  Already matches the pre-sync content")
+			summary.Unchanged++
+			continue
+		}
+		for _, change := range plan.Changes {
+			switch {
+			case change.IsDeleted:
+				output.Plainf("  - delete  %s", change.Path)
+			case change.IsNew:
+				output.Plainf("  + restore %s", change.Path)
+			default:
+				output.Plainf("  ~ restore %s", change.Path)
+			}
+		}
+
+		if dryRun {
+			continue
+		}
+
+		pr, err := planner.Apply(ctx, plan)
+		if err != nil {
+			output.Errorf("  ✗ %v", err)
+			summary.Failed++
+			continue
+		}
+		output.Successf("  ✓ Opened rollback PR #%d", pr.Number)
+		summary.Opened++
+	}
+
+	output.Plain("")
+	if dryRun {
+		output.Infof("Would roll back %d of %d target(s)", summary.Targets-summary.Unchanged-summary.Failed, summary.Targets)
+	} else {
+		output.Infof("Opened %d rollback PR(s) for %d target(s)", summary.Opened, summary.Targets)
+	}
+	return summary
+}
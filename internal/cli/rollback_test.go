@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/output"
+	"github.com/mrz1836/go-broadcast/internal/sync"
+)
+
+var errRollbackPlan = errors.New("plan failed")
+
+// fakeRollbackPlanner returns canned plans and records applied ones
+type fakeRollbackPlanner struct {
+	plans   map[string]*sync.RollbackPlan
+	applied []string
+}
+
+func (f *fakeRollbackPlanner) Plan(_ context.Context, repo string) (*sync.RollbackPlan, error) {
+	plan, ok := f.plans[repo]
+	if !ok {
+		return nil, errRollbackPlan
+	}
+	return plan, nil
+}
+
+func (f *fakeRollbackPlanner) Apply(_ context.Context, plan *sync.RollbackPlan) (*gh.PR, error) {
+	f.applied = append(f.applied, plan.Repo)
+	return &gh.PR{Number: 100 + len(f.applied)}, nil
+}
+
+func TestRollbackTargets(t *testing.T) {
+	cfg := &config.Config{Groups: []config.Group{
+		{ID: "a", Targets: []config.TargetConfig{{Repo: "org/one"}, {Repo: "org/two"}}},
+		{ID: "b", Targets: []config.TargetConfig{{Repo: "org/two"}, {Repo: "org/three"}}},
+	}}
+
+	repos, err := rollbackTargets(cfg, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"org/one", "org/two", "org/three"}, repos)
+
+	repos, err = rollbackTargets(cfg, []string{"org/three"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"org/three"}, repos)
+
+	_, err = rollbackTargets(cfg, []string{"org/unknown"})
+	require.ErrorIs(t, err, ErrTargetNotFound)
+
+	_, err = rollbackTargets(nil, nil)
+	require.ErrorIs(t, err, ErrNilConfig)
+}
+
+func TestRunRollback(t *testing.T) {
+	newPlanner := func() *fakeRollbackPlanner {
+		changed := &sync.RollbackPlan{Repo: "org/one", Changes: []sync.FileChange{{Path: "README.md", Content: []byte("prior")}}}
+		changed.SyncPR.Number = 5
+		return &fakeRollbackPlanner{plans: map[string]*sync.RollbackPlan{
+			"org/one": changed,
+			"org/two": {Repo: "org/two"},
+		}}
+	}
+	repos := []string{"org/one", "org/two", "org/three"}
+
+	t.Run("opens PRs for changed targets", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		planner := newPlanner()
+		summary := runRollback(context.Background(), planner, repos, false)
+		assert.Equal(t, &rollbackSummary{Targets: 3, Opened: 1, Unchanged: 1, Failed: 1}, summary)
+		assert.Equal(t, []string{"org/one"}, planner.applied)
+		assert.Contains(t, scope.Stdout.String(), "Opened rollback PR #101")
+	})
+
+	t.Run("dry-run only plans", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		planner := newPlanner()
+		summary := runRollback(context.Background(), planner, repos, true)
+		assert.Equal(t, 0, summary.Opened)
+		assert.Empty(t, planner.applied)
+		assert.Contains(t, scope.Stdout.String(), "~ restore README.md")
+	})
+}
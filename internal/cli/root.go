@@ -14,6 +14,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
+	"github.com/mrz1836/go-broadcast/internal/gh"
 	"github.com/mrz1836/go-broadcast/internal/logging"
 	"github.com/mrz1836/go-broadcast/internal/output"
 )
@@ -21,6 +22,13 @@ import (
 // loggerContextKey is a type for context keys to avoid collisions
 type loggerContextKey struct{}
 
+// Help text for the config and GitHub token source flags, shared by every root command variant
+const (
+	configUsage       = "Path to configuration file (use - to read from stdin); comma-separate several files or pass a directory to merge them"
+	tokenFileUsage    = "Read the GitHub token from this file instead of GH_TOKEN/GITHUB_TOKEN"
+	tokenCommandUsage = `Run this command and use its output as the GitHub token (e.g. "gh auth token"); run without a shell`
+)
+
 //nolint:gochecknoglobals // Cobra flags are designed to be global variables
 var (
 	showVersionMu sync.RWMutex
@@ -87,13 +95,17 @@ Common Use Cases:
 //nolint:gochecknoinits // Cobra commands require init() for flag registration
 func init() {
 	// Global flags
-	rootCmd.PersistentFlags().StringVarP(&globalFlags.ConfigFile, "config", "c", "sync.yaml", "Path to configuration file")
+	rootCmd.PersistentFlags().StringVarP(&globalFlags.ConfigFile, "config", "c", "sync.yaml", configUsage)
 	rootCmd.PersistentFlags().BoolVar(&globalFlags.DryRun, "dry-run", false, "Preview changes without making them")
 	rootCmd.PersistentFlags().StringVar(&globalFlags.LogLevel, "log-level", "info", "Log level (debug, info, warn, error)")
+	rootCmd.PersistentFlags().StringVar(&globalFlags.LogFormat, "log-format", "text", `Log output format: "text" (human-readable) or "json" (structured, for log aggregators)`)
 	rootCmd.PersistentFlags().BoolVar(&showVersion, "version", false, "Show version information")
 	rootCmd.PersistentFlags().BoolVar(&checkAuth, "check-auth", false, "Check GitHub authentication and exit (codes: 0 authenticated, 1 no token, 2 token rejected)")
 	rootCmd.PersistentFlags().StringVar(&dbPath, "db-path", "", "Path to database file (default: ~/.config/go-broadcast/broadcast.db)")
 	rootCmd.PersistentFlags().BoolVar(&globalFlags.FromDB, "from-db", false, "Load configuration from database instead of YAML file")
+	rootCmd.PersistentFlags().StringVar(&globalFlags.TokenFile, "token-file", "", tokenFileUsage)
+	rootCmd.PersistentFlags().StringVar(&globalFlags.TokenCommand, "token-command", "", tokenCommandUsage)
+	rootCmd.MarkFlagsMutuallyExclusive("token-file", "token-command")
 
 	// New verbose flags are not added to global command to avoid conflicts
 	// They will be added to individual commands that use LogConfig
@@ -108,16 +120,23 @@ func init() {
 	rootCmd.AddCommand(statusCmd)
 	rootCmd.AddCommand(validateCmd)
 	rootCmd.AddCommand(diagnoseCmd)
+	rootCmd.AddCommand(newDoctorCmd(func() gh.ClientOption { return ghAuthOption(nil) }))
 	rootCmd.AddCommand(cancelCmd)
 	rootCmd.AddCommand(reviewPRCmd)
 	rootCmd.AddCommand(modulesCmd)
 	rootCmd.AddCommand(newUpgradeCmd())
+	rootCmd.AddCommand(newVersionCmd())
 	rootCmd.AddCommand(dbCmd)
 	rootCmd.AddCommand(newAnalyticsCmd())
 	rootCmd.AddCommand(metricsCmd)
 	rootCmd.AddCommand(newScaffoldCmd())
 	rootCmd.AddCommand(newSettingsCmd())
 	rootCmd.AddCommand(newPresetsCmd())
+	rootCmd.AddCommand(newDiffCmd())
+	rootCmd.AddCommand(newListTargetsCmd())
+	rootCmd.AddCommand(newRollbackCmd())
+	rootCmd.AddCommand(newConfigSchemaCmd())
+	rootCmd.AddCommand(newTransformCmd())
 }
 
 // NewRootCmd creates a new isolated root command instance for testing
@@ -127,6 +146,7 @@ func NewRootCmd() *cobra.Command {
 	flags := &Flags{
 		ConfigFile: "sync.yaml",
 		LogLevel:   "info",
+		LogFormat:  "text",
 	}
 
 	// Create local version flag for this command instance (avoids race on global)
@@ -158,20 +178,28 @@ state locally. It supports file transformations and provides progress tracking.`
 	}
 
 	// Add isolated flags
-	cmd.PersistentFlags().StringVarP(&flags.ConfigFile, "config", "c", "sync.yaml", "Path to configuration file")
+	cmd.PersistentFlags().StringVarP(&flags.ConfigFile, "config", "c", "sync.yaml", configUsage)
 	cmd.PersistentFlags().BoolVar(&flags.DryRun, "dry-run", false, "Preview changes without making them")
 	cmd.PersistentFlags().StringVar(&flags.LogLevel, "log-level", "info", "Log level (debug, info, warn, error)")
+	cmd.PersistentFlags().StringVar(&flags.LogFormat, "log-format", "text", `Log output format: "text" (human-readable) or "json" (structured, for log aggregators)`)
 	cmd.PersistentFlags().BoolVar(&localShowVersion, "version", false, "Show version information")
 	cmd.PersistentFlags().BoolVar(&localCheckAuth, "check-auth", false, "Check GitHub authentication and exit (codes: 0 authenticated, 1 no token, 2 token rejected)")
+	cmd.PersistentFlags().StringVar(&flags.TokenFile, "token-file", "", tokenFileUsage)
+	cmd.PersistentFlags().StringVar(&flags.TokenCommand, "token-command", "", tokenCommandUsage)
+	cmd.MarkFlagsMutuallyExclusive("token-file", "token-command")
 
 	// Add commands with isolated flags
 	cmd.AddCommand(createSyncCmd(flags))
 	cmd.AddCommand(createStatusCmd(flags))
 	cmd.AddCommand(createValidateCmd(flags))
 	cmd.AddCommand(createDiagnoseCmd(flags))
+	cmd.AddCommand(newDoctorCmd(func() gh.ClientOption {
+		return gh.WithAuth(gh.AuthConfig{TokenFile: flags.TokenFile, TokenCommand: flags.TokenCommand})
+	}))
 	cmd.AddCommand(createCancelCmd(flags))
 	cmd.AddCommand(createReviewPRCmd(flags))
 	cmd.AddCommand(newUpgradeCmd())
+	cmd.AddCommand(newVersionCmd())
 
 	return cmd
 }
@@ -209,6 +237,7 @@ state locally. It supports file transformations and provides progress tracking.`
 	cmd.AddCommand(createStatusCmdWithVerbose(logConfig))
 	cmd.AddCommand(createValidateCmdWithVerbose(logConfig))
 	cmd.AddCommand(createDiagnoseCmdWithVerbose(logConfig))
+	cmd.AddCommand(newDoctorCmd(func() gh.ClientOption { return ghAuthOption(logConfig) }))
 	cmd.AddCommand(createCancelCmdWithVerbose(logConfig))
 	// review-pr uses the regular command (no verbose-specific features yet)
 	cmd.AddCommand(createReviewPRCmd(&Flags{
@@ -217,6 +246,7 @@ state locally. It supports file transformations and provides progress tracking.`
 		LogLevel:   logConfig.LogLevel,
 	}))
 	cmd.AddCommand(newUpgradeCmd())
+	cmd.AddCommand(newVersionCmd())
 
 	return cmd
 }
@@ -283,20 +313,23 @@ func createSetupLogging(flags *Flags) func(*cobra.Command, []string) error {
 			return fmt.Errorf("invalid log level %q: %w", flags.LogLevel, err)
 		}
 
+		formatter, err := newLogFormatter(flags.LogFormat)
+		if err != nil {
+			return err
+		}
+
 		// Create isolated logger instance
 		logger := logrus.New()
 		logger.SetLevel(level)
-		logger.SetFormatter(&logrus.TextFormatter{
-			DisableColors:    false,
-			FullTimestamp:    true,
-			TimestampFormat:  "15:04:05",
-			PadLevelText:     true,
-			QuoteEmptyFields: true,
-		})
+		logger.SetFormatter(formatter)
+		logging.EnsureRedactionHook(logger)
 
 		// Log to stderr to keep stdout clean for output
 		logger.SetOutput(os.Stderr)
 
+		// In-place file progress would corrupt structured log lines
+		output.SetProgressEnabled(flags.LogFormat != "json")
+
 		// Store logger in command context for isolated access
 		cmd.SetContext(context.WithValue(cmd.Context(), loggerContextKey{}, logger))
 
@@ -344,28 +377,53 @@ func setupLogging(_ *cobra.Command, _ []string) error {
 		return fmt.Errorf("invalid log level %q: %w", globalFlags.LogLevel, err)
 	}
 
+	formatter, err := newLogFormatter(globalFlags.LogFormat)
+	if err != nil {
+		return err
+	}
+
 	// Configure logrus
 	logrus.SetLevel(level)
-	logrus.SetFormatter(&logrus.TextFormatter{
-		DisableColors:    false,
-		FullTimestamp:    true,
-		TimestampFormat:  "15:04:05",
-		PadLevelText:     true,
-		QuoteEmptyFields: true,
-	})
+	logrus.SetFormatter(formatter)
+
+	// Secrets must never reach the logs, even at debug level
+	logging.EnsureRedactionHook(logrus.StandardLogger())
 
 	// Log to stderr to keep stdout clean for output
 	logrus.SetOutput(os.Stderr)
 
+	// In-place file progress would corrupt structured log lines
+	output.SetProgressEnabled(globalFlags.LogFormat != "json")
+
 	logrus.WithFields(logrus.Fields{
-		"config":    globalFlags.ConfigFile,
-		"dry_run":   globalFlags.DryRun,
-		"log_level": globalFlags.LogLevel,
+		"config":     globalFlags.ConfigFile,
+		"dry_run":    globalFlags.DryRun,
+		"log_level":  globalFlags.LogLevel,
+		"log_format": globalFlags.LogFormat,
 	}).Debug("CLI initialized")
 
 	return nil
 }
 
+// newLogFormatter returns the logrus formatter for the --log-format value.
+// An empty format selects text.
+func newLogFormatter(format string) (logrus.Formatter, error) {
+	switch strings.ToLower(format) {
+	case "", "text":
+		return &logrus.TextFormatter{
+			DisableColors:    false,
+			FullTimestamp:    true,
+			TimestampFormat:  "15:04:05",
+			PadLevelText:     true,
+			QuoteEmptyFields: true,
+		}, nil
+	case "json":
+		return logging.NewStructuredFormatter(), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrInvalidLogFormat, format)
+	}
+}
+
 // addVerboseFlags adds verbose and debug flags to the given command.
 //
 // This function adds the following flags:
@@ -405,14 +463,16 @@ func addVerboseFlags(cmd *cobra.Command, config *LogConfig) {
 		"Enable structured JSON output for log aggregation and automation")
 
 	// Add standard flags
-	cmd.PersistentFlags().StringVarP(&config.ConfigFile, "config", "c", "sync.yaml",
-		"Path to configuration file")
+	cmd.PersistentFlags().StringVarP(&config.ConfigFile, "config", "c", "sync.yaml", configUsage)
 	cmd.PersistentFlags().BoolVar(&config.DryRun, "dry-run", false,
 		"Preview changes without making them")
 	cmd.PersistentFlags().StringVar(&config.LogLevel, "log-level", "info",
 		"Log level (debug, info, warn, error) - overridden by verbose flags")
 	cmd.PersistentFlags().BoolVar(&showVersion, "version", false, "Show version information")
 	cmd.PersistentFlags().BoolVar(&checkAuth, "check-auth", false, "Check GitHub authentication and exit (codes: 0 authenticated, 1 no token, 2 token rejected)")
+	cmd.PersistentFlags().StringVar(&config.TokenFile, "token-file", "", tokenFileUsage)
+	cmd.PersistentFlags().StringVar(&config.TokenCommand, "token-command", "", tokenCommandUsage)
+	cmd.MarkFlagsMutuallyExclusive("token-file", "token-command")
 }
 
 // createSetupLoggingWithVerbose creates a verbose logging setup function.
@@ -446,6 +506,9 @@ func createSetupLoggingWithVerbose(config *LogConfig) func(*cobra.Command, []str
 			config.LogFormat = "json"
 		}
 
+		// In-place file progress would corrupt structured log lines
+		output.SetProgressEnabled(config.LogFormat != "json")
+
 		// Create logger service with the configuration
 		loggerService := NewLoggerService(config)
 
@@ -761,7 +824,7 @@ func createRunSyncWithVerbose(config *LogConfig) func(*cobra.Command, []string)
 		}
 
 		// Filter targets if specified
-		targets := args
+		targets := syncTargets(args, config.Targets)
 		if len(targets) > 0 {
 			log.WithField("targets", targets).Info("Syncing specific targets")
 		} else {
@@ -772,6 +835,9 @@ func createRunSyncWithVerbose(config *LogConfig) func(*cobra.Command, []string)
 		if config.DryRun {
 			output.Warn("DRY-RUN MODE: No changes will be made to repositories")
 		}
+		if config.SummaryOnly {
+			defer beginSummaryOnly(logrus.StandardLogger(), config.LogFormat)()
+		}
 
 		// Initialize sync engine with LogConfig
 		engine, err := createSyncEngineWithLogConfig(ctx, cfg, config)
@@ -784,7 +850,9 @@ func createRunSyncWithVerbose(config *LogConfig) func(*cobra.Command, []string)
 		defer closeMetrics()
 
 		// Execute sync
-		if err := engine.Sync(ctx, targets); err != nil {
+		if err := runProfiled(config.Profile, config.ProfileDir, func() error {
+			return syncAndSummarize(ctx, engine, targets, config.SummaryOnly, config.LogFormat)
+		}); err != nil {
 			return fmt.Errorf("sync failed: %w", err)
 		}
 
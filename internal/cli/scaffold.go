@@ -72,7 +72,7 @@ func runScaffold(ctx context.Context, repoName, desc, presetID, topics, _ string
 
 	// Initialize GitHub client
 	logger := logrus.StandardLogger()
-	ghClient, err := gh.NewClient(ctx, logger, &logging.LogConfig{})
+	ghClient, err := gh.NewClient(ctx, logger, &logging.LogConfig{}, ghAuthOption(nil))
 	if err != nil {
 		return fmt.Errorf("failed to create GitHub client: %w", err)
 	}
@@ -95,7 +95,7 @@ func runSettingsApply(ctx context.Context, repo, presetID, topics, description s
 
 	// Initialize GitHub client
 	logger := logrus.StandardLogger()
-	ghClient, err := gh.NewClient(ctx, logger, &logging.LogConfig{})
+	ghClient, err := gh.NewClient(ctx, logger, &logging.LogConfig{}, ghAuthOption(nil))
 	if err != nil {
 		return fmt.Errorf("failed to create GitHub client: %w", err)
 	}
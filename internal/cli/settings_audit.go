@@ -104,7 +104,7 @@ func runSettingsAudit(ctx context.Context, repos []string, presetID, org string,
 
 	// Initialize GitHub client
 	logger := logrus.StandardLogger()
-	ghClient, err := gh.NewClient(ctx, logger, &logging.LogConfig{})
+	ghClient, err := gh.NewClient(ctx, logger, &logging.LogConfig{}, ghAuthOption(nil))
 	if err != nil {
 		return fmt.Errorf("failed to create GitHub client: %w", err)
 	}
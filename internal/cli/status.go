@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"sort"
 	"strings"
 	"sync"
@@ -22,12 +23,28 @@ import (
 
 //nolint:gochecknoglobals // Package-level variables for CLI flags
 var (
-	statusFlagsMu     sync.RWMutex
-	jsonOutput        bool
-	statusGroupFilter []string
-	statusSkipGroups  []string
+	statusFlagsMu       sync.RWMutex
+	jsonOutput          bool
+	statusGroupFilter   []string
+	statusSkipGroups    []string
+	statusRefresh       bool          // Discard cached status and discover it again
+	statusConcurrency   int           // Maximum targets discovered at once (0 = number of CPUs)
+	statusAPIRateLimit  float64       // Client-side GitHub API requests per second (0 = unlimited)
+	statusAPIBurst      int           // Requests allowed back-to-back under statusAPIRateLimit
+	statusStateCacheDir string        // On-disk state cache directory (empty = GO_BROADCAST_STATE_CACHE_DIR or disabled)
+	statusStateCacheTTL time.Duration // How long cached status is reused while sources are unchanged
 )
 
+// statusOptions controls how status discovers state
+type statusOptions struct {
+	refresh     bool
+	concurrency int
+	rateLimit   gh.RateLimitConfig
+	cacheDir    string
+	cacheTTL    time.Duration
+	secret      []byte // Key PR metadata signatures are verified with, nil to skip verification
+}
+
 // setJSONOutput sets the JSON output flag (thread-safe, for testing)
 func setJSONOutput(v bool) {
 	statusFlagsMu.Lock()
@@ -56,11 +73,49 @@ func getStatusSkipGroups() []string {
 	return append([]string(nil), statusSkipGroups...)
 }
 
+// getStatusOptions returns the validated discovery options from the status
+// flags (thread-safe). The cache directory comes from --state-cache-dir,
+// falling back to GO_BROADCAST_STATE_CACHE_DIR, the same cache sync uses.
+func getStatusOptions() (statusOptions, error) {
+	statusFlagsMu.RLock()
+	defer statusFlagsMu.RUnlock()
+
+	concurrency, err := resolveConcurrency(statusConcurrency)
+	if err != nil {
+		return statusOptions{}, err
+	}
+	rateLimit, err := resolveAPIRateLimit(statusAPIRateLimit, statusAPIBurst)
+	if err != nil {
+		return statusOptions{}, err
+	}
+	if statusStateCacheTTL < 0 {
+		return statusOptions{}, fmt.Errorf("%w: got %s", ErrInvalidStateCacheTTL, statusStateCacheTTL)
+	}
+	dir := statusStateCacheDir
+	if dir == "" {
+		dir = os.Getenv("GO_BROADCAST_STATE_CACHE_DIR")
+	}
+
+	return statusOptions{
+		refresh:     statusRefresh,
+		concurrency: concurrency,
+		rateLimit:   rateLimit,
+		cacheDir:    dir,
+		cacheTTL:    statusStateCacheTTL,
+	}, nil
+}
+
 // initStatus initializes status command flags
 func initStatus() {
 	statusCmd.Flags().BoolVar(&jsonOutput, "json", false, "Output status in JSON format")
 	statusCmd.Flags().StringSliceVar(&statusGroupFilter, "groups", nil, "Only show status for these groups (by name or ID)")
 	statusCmd.Flags().StringSliceVar(&statusSkipGroups, "skip-groups", nil, "Skip these groups (by name or ID)")
+	statusCmd.Flags().BoolVar(&statusRefresh, "refresh", false, "Discard the cached status and discover it from GitHub again")
+	statusCmd.Flags().IntVar(&statusConcurrency, "concurrency", 0, "Maximum number of targets discovered simultaneously (default: number of CPUs; 1 = sequential)")
+	statusCmd.Flags().Float64Var(&statusAPIRateLimit, "api-rate-limit", 0, "Maximum GitHub API requests per second (0 = unlimited)")
+	statusCmd.Flags().IntVar(&statusAPIBurst, "api-burst", 1, "Maximum back-to-back GitHub API requests allowed by --api-rate-limit")
+	statusCmd.Flags().StringVar(&statusStateCacheDir, "state-cache-dir", "", "Cache discovered status in this directory (default: GO_BROADCAST_STATE_CACHE_DIR, unset = no cache)")
+	statusCmd.Flags().DurationVar(&statusStateCacheTTL, "state-cache-ttl", state.DefaultStateCacheTTL, "How long cached status is reused while source commits are unchanged")
 }
 
 //nolint:gochecknoglobals // Cobra commands are designed to be global variables
@@ -80,7 +135,15 @@ Configuration Source:
   Use --from-db to load configuration from the database instead.
 
 For configurations with groups, you can filter which groups to display using
---groups or --skip-groups flags.`,
+--groups or --skip-groups flags.
+
+Large Configurations:
+  Targets are discovered concurrently (--concurrency), sharing one GitHub API
+  rate limit (--api-rate-limit). With a state cache directory configured
+  (--state-cache-dir or GO_BROADCAST_STATE_CACHE_DIR), a re-run within
+  --state-cache-ttl whose sources have not moved is answered from the cache,
+  and a discovery that is interrupted resumes from the targets it already
+  found. Use --refresh to discard the cache and discover everything again.`,
 	Example: `  # Show status for all targets
   go-broadcast status --config sync.yaml
   go-broadcast status --from-db
@@ -94,7 +157,11 @@ For configurations with groups, you can filter which groups to display using
   go-broadcast status --from-db --groups "core,security"
 
   # Show all groups except specific ones
-  go-broadcast status --skip-groups "experimental"`,
+  go-broadcast status --skip-groups "experimental"
+
+  # Cache status between runs, and force a fresh pull
+  go-broadcast status --state-cache-dir ~/.cache/go-broadcast --api-rate-limit 10
+  go-broadcast status --state-cache-dir ~/.cache/go-broadcast --refresh`,
 	Aliases: []string{"st"},
 	RunE:    runStatus,
 }
@@ -128,7 +195,7 @@ type SourceStatus struct {
 // TargetStatus represents a target repository status
 type TargetStatus struct {
 	Repository  string           `json:"repository"`
-	State       string           `json:"state"` // "synced", "outdated", "pending", "error"
+	State       string           `json:"state"` // "synced", "outdated", "pending", "branch-only", "error"
 	SyncBranch  *string          `json:"sync_branch,omitempty"`
 	PullRequest *PullRequestInfo `json:"pull_request,omitempty"`
 	LastSync    *SyncInfo        `json:"last_sync,omitempty"`
@@ -137,10 +204,11 @@ type TargetStatus struct {
 
 // PullRequestInfo contains PR details
 type PullRequestInfo struct {
-	Number int    `json:"number"`
-	State  string `json:"state"`
-	URL    string `json:"url"`
-	Title  string `json:"title"`
+	Number   int    `json:"number"`
+	State    string `json:"state"`
+	URL      string `json:"url"`
+	Title    string `json:"title"`
+	Metadata string `json:"metadata,omitempty"` // "unsigned" or "tampered" when its metadata failed signature verification
 }
 
 // SyncInfo contains last sync details
@@ -196,8 +264,16 @@ func getRealStatus(ctx context.Context, cfg *config.Config) (*SyncStatus, error)
 		Verbose: 0,
 	}
 
+	opts, err := getStatusOptions()
+	if err != nil {
+		return nil, err
+	}
+	if opts.secret, err = metadataSecret(cfg); err != nil {
+		return nil, err
+	}
+
 	// Initialize GitHub client with comprehensive error handling
-	ghClient, err := newGHClient(ctx, logger, logConfig)
+	ghClient, err := newGHClient(ctx, logger, logConfig, ghAuthOption(logConfig), ghProxyOption(cfg), ghHeaderOption(cfg), gh.WithRateLimit(opts.rateLimit))
 	if err != nil {
 		// Provide specific error messages for common issues
 		switch {
@@ -211,7 +287,8 @@ func getRealStatus(ctx context.Context, cfg *config.Config) (*SyncStatus, error)
 	}
 
 	// Initialize state discoverer
-	discoverer := state.NewDiscoverer(ghClient, logger, logConfig)
+	discoverer, done := newStatusDiscoverer(ghClient, logger, logConfig, cfg, opts)
+	defer done()
 
 	// Discover current state with comprehensive error handling
 	currentState, err := discoverer.DiscoverState(ctx, cfg)
@@ -231,6 +308,36 @@ func getRealStatus(ctx context.Context, cfg *config.Config) (*SyncStatus, error)
 	return convertStateToStatus(currentState, cfg), nil
 }
 
+// newStatusDiscoverer creates the discoverer for status: targets are
+// discovered opts.concurrency at a time with a progress line on stderr, and
+// cached in opts.cacheDir when set. With opts.refresh the cached status for
+// cfg is discarded first. The returned func clears the progress line.
+func newStatusDiscoverer(ghClient gh.Client, logger *logrus.Logger, logConfig *logging.LogConfig, cfg *config.Config, opts statusOptions) (state.Discoverer, func()) {
+	var progress *output.FileProgress
+	reportProgress := func(done, total int, repo string) {
+		if progress == nil {
+			progress = output.NewTargetProgress("Discovering status", total)
+		}
+		progress.Update(done, repo)
+	}
+
+	discoverer := state.NewDiscoverer(ghClient, logger, logConfig,
+		state.WithDiscoveryConcurrency(opts.concurrency),
+		state.WithDiscoveryProgress(reportProgress),
+		state.WithMetadataSecret(opts.secret),
+	)
+	if opts.cacheDir != "" {
+		discoverer = state.NewCachingDiscoverer(discoverer, ghClient, opts.cacheDir, opts.cacheTTL, logger)
+		if invalidator, ok := discoverer.(state.CacheInvalidator); ok && opts.refresh {
+			if err := invalidator.InvalidateState(cfg); err != nil {
+				logger.WithError(err).Warn("Failed to discard cached status")
+			}
+		}
+	}
+
+	return discoverer, func() { progress.Done() }
+}
+
 // convertStateToStatus converts internal state to CLI status format
 func convertStateToStatus(s *state.State, cfg *config.Config) *SyncStatus {
 	// Check if we have groups in the configuration
@@ -293,10 +400,11 @@ func convertStateToStatus(s *state.State, cfg *config.Config) *SyncStatus {
 			// Use the first open PR (most recent)
 			pr := targetState.OpenPRs[0]
 			targetStatus.PullRequest = &PullRequestInfo{
-				Number: pr.Number,
-				State:  strings.ToLower(pr.State),
-				URL:    fmt.Sprintf("https://github.com/%s/pull/%d", targetState.Repo, pr.Number),
-				Title:  pr.Title,
+				Number:   pr.Number,
+				State:    strings.ToLower(pr.State),
+				URL:      fmt.Sprintf("https://github.com/%s/pull/%d", targetState.Repo, pr.Number),
+				Title:    pr.Title,
+				Metadata: string(targetState.UntrustedPRs[pr.Number]),
 			}
 		}
 
@@ -315,6 +423,8 @@ func convertSyncStatus(s state.SyncStatus) string {
 		return "outdated"
 	case state.StatusPending:
 		return "pending"
+	case state.StatusBranchOnly:
+		return "branch-only"
 	case state.StatusConflict:
 		return "error"
 	case state.StatusUnknown:
@@ -330,8 +440,8 @@ func convertStateToGroupStatus(s *state.State, cfg *config.Config) *SyncStatus {
 		Groups: make([]GroupStatus, 0),
 	}
 
-	// Get groups from configuration
-	groups := cfg.Groups
+	// Get groups from configuration, one per source branch
+	groups := config.ExpandSourceBranches(cfg.Groups)
 
 	// Convert each group to status
 	for _, group := range groups {
@@ -390,10 +500,11 @@ func convertStateToGroupStatus(s *state.State, cfg *config.Config) *SyncStatus {
 				if len(targetState.OpenPRs) > 0 {
 					pr := targetState.OpenPRs[0]
 					targetStatus.PullRequest = &PullRequestInfo{
-						Number: pr.Number,
-						State:  strings.ToLower(pr.State),
-						URL:    fmt.Sprintf("https://github.com/%s/pull/%d", targetState.Repo, pr.Number),
-						Title:  pr.Title,
+						Number:   pr.Number,
+						State:    strings.ToLower(pr.State),
+						URL:      fmt.Sprintf("https://github.com/%s/pull/%d", targetState.Repo, pr.Number),
+						Title:    pr.Title,
+						Metadata: string(targetState.UntrustedPRs[pr.Number]),
 					}
 				}
 
@@ -472,6 +583,8 @@ func outputTextStatus(status *SyncStatus) error {
 			icon = "⚠"
 		case "pending":
 			icon = "⏳"
+		case "branch-only":
+			icon = "⎇"
 		case "error":
 			icon = "✗"
 		default:
@@ -486,6 +599,9 @@ func outputTextStatus(status *SyncStatus) error {
 				target.PullRequest.Title,
 				target.PullRequest.State))
 			output.Info(fmt.Sprintf("    URL: %s", target.PullRequest.URL))
+			if target.PullRequest.Metadata != "" {
+				output.Warn(fmt.Sprintf("    Metadata %s: sync state from this PR is not trusted", target.PullRequest.Metadata))
+			}
 		}
 
 		if target.LastSync != nil {
@@ -509,6 +625,7 @@ func outputTextStatus(status *SyncStatus) error {
 	synced := 0
 	outdated := 0
 	pending := 0
+	branchOnly := 0
 	errors := 0
 
 	for _, t := range status.Targets {
@@ -519,6 +636,8 @@ func outputTextStatus(status *SyncStatus) error {
 			outdated++
 		case "pending":
 			pending++
+		case "branch-only":
+			branchOnly++
 		case "error":
 			errors++
 		}
@@ -535,6 +654,10 @@ func outputTextStatus(status *SyncStatus) error {
 		output.Info(fmt.Sprintf("  Pending: %d", pending))
 	}
 
+	if branchOnly > 0 {
+		output.Info(fmt.Sprintf("  Branch only (no PR): %d", branchOnly))
+	}
+
 	if errors > 0 {
 		output.Error(fmt.Sprintf("  Errors: %d", errors))
 	}
@@ -593,6 +716,8 @@ func outputGroupTextStatus(status *SyncStatus) error {
 					icon = "⚠"
 				case "pending":
 					icon = "⏳"
+				case "branch-only":
+					icon = "⎇"
 				case "error":
 					icon = "✗"
 				default:
@@ -606,6 +731,13 @@ func outputGroupTextStatus(status *SyncStatus) error {
 						target.PullRequest.Number,
 						target.PullRequest.Title,
 						target.PullRequest.State))
+					if target.PullRequest.Metadata != "" {
+						output.Warn(fmt.Sprintf("      Metadata %s: sync state from this PR is not trusted", target.PullRequest.Metadata))
+					}
+				}
+
+				if target.State == "branch-only" && target.SyncBranch != nil {
+					output.Info(fmt.Sprintf("      Branch: %s (no pull request)", *target.SyncBranch))
 				}
 
 				if target.LastSync != nil && len(target.LastSync.Commit) >= 7 {
@@ -9,8 +9,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mrz1836/go-broadcast/internal/config"
@@ -268,6 +270,11 @@ func TestConvertSyncStatus(t *testing.T) {
 			input:    state.StatusPending,
 			expected: "pending",
 		},
+		{
+			name:     "StatusBranchOnly",
+			input:    state.StatusBranchOnly,
+			expected: "branch-only",
+		},
 		{
 			name:     "StatusUnknown",
 			input:    state.StatusUnknown,
@@ -1431,3 +1438,139 @@ func TestConvertStateToGroupStatus_EdgeCases(t *testing.T) {
 		assert.Equal(t, "ready", status.Groups[0].State)
 	})
 }
+
+// setStatusDiscoveryFlags sets the status discovery flags for a test and
+// restores them afterwards
+func setStatusDiscoveryFlags(t *testing.T, refresh bool, concurrency int, rate float64, ttl time.Duration, dir string) {
+	t.Helper()
+	statusFlagsMu.Lock()
+	prevRefresh, prevConcurrency, prevRate, prevTTL, prevDir := statusRefresh, statusConcurrency, statusAPIRateLimit, statusStateCacheTTL, statusStateCacheDir
+	statusRefresh, statusConcurrency, statusAPIRateLimit, statusStateCacheTTL, statusStateCacheDir = refresh, concurrency, rate, ttl, dir
+	statusFlagsMu.Unlock()
+
+	t.Cleanup(func() {
+		statusFlagsMu.Lock()
+		defer statusFlagsMu.Unlock()
+		statusRefresh, statusConcurrency, statusAPIRateLimit, statusStateCacheTTL, statusStateCacheDir = prevRefresh, prevConcurrency, prevRate, prevTTL, prevDir
+	})
+}
+
+// TestGetStatusOptions tests validation of the status discovery flags
+//
+//nolint:paralleltest // modifies the global status flags and environment
+func TestGetStatusOptions(t *testing.T) {
+	for _, name := range []string{"refresh", "concurrency", "api-rate-limit", "api-burst", "state-cache-dir", "state-cache-ttl"} {
+		assert.NotNil(t, statusCmd.Flags().Lookup(name), name)
+	}
+
+	t.Run("cache dir falls back to the environment", func(t *testing.T) {
+		t.Setenv("GO_BROADCAST_STATE_CACHE_DIR", "/tmp/env-cache")
+		setStatusDiscoveryFlags(t, true, 3, 5, time.Minute, "")
+
+		opts, err := getStatusOptions()
+		require.NoError(t, err)
+		assert.True(t, opts.refresh)
+		assert.Equal(t, 3, opts.concurrency)
+		assert.InDelta(t, 5.0, opts.rateLimit.RequestsPerSecond, 0)
+		assert.Equal(t, "/tmp/env-cache", opts.cacheDir)
+		assert.Equal(t, time.Minute, opts.cacheTTL)
+	})
+
+	t.Run("flag overrides the environment", func(t *testing.T) {
+		t.Setenv("GO_BROADCAST_STATE_CACHE_DIR", "/tmp/env-cache")
+		setStatusDiscoveryFlags(t, false, 1, 0, time.Minute, "/tmp/flag-cache")
+
+		opts, err := getStatusOptions()
+		require.NoError(t, err)
+		assert.Equal(t, "/tmp/flag-cache", opts.cacheDir)
+	})
+
+	t.Run("invalid values are rejected", func(t *testing.T) {
+		setStatusDiscoveryFlags(t, false, -1, 0, time.Minute, "")
+		_, err := getStatusOptions()
+		require.ErrorIs(t, err, ErrInvalidConcurrency)
+
+		setStatusDiscoveryFlags(t, false, 1, -1, time.Minute, "")
+		_, err = getStatusOptions()
+		require.ErrorIs(t, err, ErrInvalidAPIRateLimit)
+
+		setStatusDiscoveryFlags(t, false, 1, 0, -time.Minute, "")
+		_, err = getStatusOptions()
+		require.ErrorIs(t, err, ErrInvalidStateCacheTTL)
+	})
+}
+
+// TestNewStatusDiscoverer_CacheAndRefresh tests that status reuses its cache
+// and that --refresh discovers the targets again
+func TestNewStatusDiscoverer_CacheAndRefresh(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		Version: 1,
+		Groups: []config.Group{{
+			ID:      "core",
+			Source:  config.SourceConfig{Repo: "org/template", Branch: "master"},
+			Targets: []config.TargetConfig{{Repo: "org/service-a"}, {Repo: "org/service-b"}},
+		}},
+	}
+
+	ghClient := gh.NewMockClient()
+	source := &gh.Branch{Name: "master"}
+	source.Commit.SHA = "abc123"
+	ghClient.On("GetBranch", mock.Anything, "org/template", "master").Return(source, nil)
+	ghClient.On("ListBranches", mock.Anything, mock.Anything).Return([]gh.Branch{{Name: "master"}}, nil)
+	ghClient.On("ListPRs", mock.Anything, mock.Anything, "open").Return([]gh.PR{}, nil)
+
+	opts := statusOptions{concurrency: 2, cacheDir: t.TempDir(), cacheTTL: time.Minute}
+	discover := func(opts statusOptions) *state.State {
+		discoverer, done := newStatusDiscoverer(ghClient, logrus.New(), nil, cfg, opts)
+		defer done()
+		discovered, err := discoverer.DiscoverState(ctx, cfg)
+		require.NoError(t, err)
+		return discovered
+	}
+
+	first := discover(opts)
+	assert.Len(t, first.Targets, 2)
+	ghClient.AssertNumberOfCalls(t, "ListBranches", 2)
+
+	cached := discover(opts)
+	assert.Len(t, cached.Targets, 2)
+	ghClient.AssertNumberOfCalls(t, "ListBranches", 2)
+
+	opts.refresh = true
+	refreshed := discover(opts)
+	assert.Len(t, refreshed.Targets, 2)
+	ghClient.AssertNumberOfCalls(t, "ListBranches", 4)
+}
+
+func TestConvertStateToStatus_UntrustedPRMetadata(t *testing.T) {
+	s := &state.State{
+		Targets: map[string]*state.TargetState{
+			"org/target1": {
+				Repo:         "org/target1",
+				Status:       state.StatusPending,
+				OpenPRs:      []gh.PR{{Number: 7, State: "OPEN", Title: "Sync"}},
+				UntrustedPRs: map[int]state.MetadataTrust{7: state.MetadataTampered},
+			},
+		},
+	}
+
+	status := convertStateToStatus(s, &config.Config{})
+	require.Len(t, status.Targets, 1)
+	require.NotNil(t, status.Targets[0].PullRequest)
+	assert.Equal(t, "tampered", status.Targets[0].PullRequest.Metadata)
+}
+
+func TestMetadataSecret(t *testing.T) {
+	secret, err := metadataSecret(&config.Config{})
+	require.NoError(t, err)
+	assert.Nil(t, secret)
+
+	t.Setenv("TEST_METADATA_SECRET", "s3cret")
+	secret, err = metadataSecret(&config.Config{MetadataSecretEnv: "TEST_METADATA_SECRET"})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("s3cret"), secret)
+
+	_, err = metadataSecret(&config.Config{MetadataSecretEnv: "TEST_METADATA_SECRET_UNSET"})
+	require.ErrorIs(t, err, ErrMetadataSecretUnset)
+}
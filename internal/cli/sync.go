@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"runtime"
 	"strings"
 	gosync "sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -19,7 +21,7 @@ import (
 	"github.com/mrz1836/go-broadcast/internal/output"
 	"github.com/mrz1836/go-broadcast/internal/state"
 	"github.com/mrz1836/go-broadcast/internal/sync"
-	"github.com/mrz1836/go-broadcast/internal/transform"
+	"github.com/mrz1836/go-broadcast/internal/validation"
 )
 
 // SyncService defines the interface for sync operations
@@ -44,14 +46,29 @@ type DefaultConfigLoader struct{}
 // LoadConfig loads and parses configuration from file
 func (d *DefaultConfigLoader) LoadConfig(configPath string) (*config.Config, error) {
 	// Check if config file exists
-	if _, err := os.Stat(configPath); os.IsNotExist(err) {
-		return nil, fmt.Errorf("%w: %s", ErrConfigFileNotFound, configPath)
+	if err := checkConfigFile(configPath); err != nil {
+		return nil, err
 	}
 
 	// Load and parse configuration
 	return config.Load(configPath)
 }
 
+// checkConfigFile returns ErrConfigFileNotFound when a path of configPath does
+// not exist. configPath may list several comma-separated files or directories.
+// Standard input ("--config -") is always accepted; config.Load reads it.
+func checkConfigFile(configPath string) error {
+	for _, path := range config.SplitPaths(configPath) {
+		if config.IsStdinPath(path) {
+			continue
+		}
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return fmt.Errorf("%w: %s", ErrConfigFileNotFound, path)
+		}
+	}
+	return nil
+}
+
 // ValidateConfig validates the configuration
 func (d *DefaultConfigLoader) ValidateConfig(cfg *config.Config) error {
 	return cfg.ValidateWithLogging(context.Background(), nil)
@@ -107,7 +124,7 @@ func (s *SyncCommand) ExecuteSync(ctx context.Context, flags *Flags, args []stri
 	}
 
 	// Filter targets if specified
-	targets := args
+	targets := syncTargets(args, flags.Targets)
 	if len(targets) > 0 {
 		log.WithField("targets", targets).Info("Syncing specific targets")
 	} else {
@@ -115,9 +132,12 @@ func (s *SyncCommand) ExecuteSync(ctx context.Context, flags *Flags, args []stri
 	}
 
 	// Show dry-run warning
-	if flags.DryRun {
+	if flags.DryRun || flags.PlanOnly {
 		s.outputWriter.Warn("DRY-RUN MODE: No changes will be made to repositories")
 	}
+	if flags.SummaryOnly {
+		defer beginSummaryOnly(logger, flags.LogFormat)()
+	}
 
 	// Initialize sync engine
 	syncEngine, err := s.syncEngineFactory.CreateSyncEngine(ctx, cfg, flags, logger)
@@ -126,9 +146,14 @@ func (s *SyncCommand) ExecuteSync(ctx context.Context, flags *Flags, args []stri
 	}
 
 	// Execute sync
-	if err := syncEngine.Sync(ctx, targets); err != nil {
+	if err := runProfiled(flags.Profile, flags.ProfileDir, func() error {
+		return syncAndSummarize(ctx, syncEngine, targets, flags.SummaryOnly, flags.LogFormat)
+	}); err != nil {
 		return fmt.Errorf("sync failed: %w", err)
 	}
+	if flags.PlanOnly {
+		return planOnlyResult(true, syncEngine)
+	}
 
 	s.outputWriter.Success("Sync completed successfully")
 	return nil
@@ -147,11 +172,57 @@ const (
 
 //nolint:gochecknoglobals // Package-level variables for CLI flags
 var (
-	syncFlagsMu      gosync.RWMutex // Protects sync flag variables for thread-safety
-	groupFilter      []string
-	skipGroups       []string
-	automerge        bool
-	clearModuleCache bool
+	syncFlagsMu           gosync.RWMutex // Protects sync flag variables for thread-safety
+	groupFilter           []string
+	skipGroups            []string
+	targetFilter          []string // --target repositories restricting the sync
+	automerge             bool
+	automergeMethod       string
+	draftPRs              bool
+	clearModuleCache      bool
+	failFast              bool
+	concurrency           int           // Maximum targets synced simultaneously (0 = runtime.NumCPU())
+	apiRateLimit          float64       // Client-side GitHub API requests per second (0 = unlimited)
+	apiBurst              int           // Requests allowed back-to-back under apiRateLimit
+	stateCacheDir         string        // On-disk state cache directory (empty = GO_BROADCAST_STATE_CACHE_DIR or disabled)
+	stateCacheTTL         time.Duration // How long cached state is reused while sources are unchanged
+	noStateCache          bool          // Bypass the state cache for this run
+	outputDir             string        // Directory for per-target JSON result artifacts (empty = none)
+	metricsFile           string        // File receiving one JSON performance record per run (empty = none)
+	contentAware          bool          // Skip targets whose mapped content hash is unchanged
+	forceSync             bool          // Sync targets even when they appear up to date
+	allowEmptyCommit      bool          // With forceSync, commit and open a PR even when content is unchanged
+	prStagger             time.Duration // Minimum delay between PR creations across targets (0 = none)
+	prStaggerJitter       time.Duration // Random extra delay of up to this much per --stagger gap
+	tempBaseDir           string        // Base directory for target working trees (empty = system temp dir)
+	keepTemp              bool          // Keep every target working tree after the sync
+	keepTempOnFail        bool          // Keep the working tree of failed targets
+	maxPRs                int           // Pull requests one run may create or update (0 = unlimited)
+	dryRunOutput          string        // File receiving the JSON dry-run plan (empty = none)
+	checkpointFile        string        // File recording completed targets for resuming (empty = none)
+	planOnly              bool          // Dry run that exits with code 2 when any target would change
+	noPR                  bool          // Push sync branches without creating or updating pull requests
+	waitForChecks         bool          // Wait for PR checks to pass before enabling auto-merge
+	checksTimeout         time.Duration // Longest wait for PR checks per target (0 = default)
+	summaryOnly           bool          // Print only a final per-target summary table
+	explain               bool          // Print why each target is or is not synced
+	lockDir               string        // Directory of per-target lock files (empty = no file locks)
+	lockRef               string        // Ref locking each target in its repository (empty = no ref locks)
+	lockTTL               time.Duration // Age after which a stale target lock is reclaimed (0 = default)
+	closeSuperseded       bool          // Close older open sync PRs of a target once a new one is created
+	interactive           bool          // Ask before pushing each target's branch and opening its PR
+	binaryTransformPolicy string        // Binary files with transforms configured: skip or fail
+	targetTimeout         time.Duration // Longest sync per target (0 = no limit)
+	cloneTimeout          time.Duration // Longest source clone per target (0 = no limit)
+	pushTimeout           time.Duration // Longest branch push per target (0 = no limit)
+	prTimeout             time.Duration // Longest PR creation or update per target (0 = no limit)
+	sourceStatusIssue     int           // Source repository issue whose comment tracks the broadcast status (0 = none)
+	profileKinds          []string      // Profiles captured while the sync runs (empty = none)
+	profileDir            string        // Directory receiving the captured profiles
+	prLabels              []string      // PR labels overriding configuration
+	prAssignees           []string      // PR assignees overriding configuration
+	prReviewers           []string      // PR reviewers overriding configuration
+	prLabelsMode          string        // How PR overrides combine with configuration: replace or merge
 
 	// Rate-limit preflight flags. Defaults mirror the documented config defaults
 	// so that, absent any --config rate_limit_preflight block, the gate behaves
@@ -189,6 +260,31 @@ func getSkipGroups() []string {
 	return append([]string(nil), skipGroups...)
 }
 
+// getTargetFilter returns a copy of the --target repositories (thread-safe)
+func getTargetFilter() []string {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return append([]string(nil), targetFilter...)
+}
+
+// syncTargets combines positional target arguments with --target values,
+// dropping duplicates while keeping first-seen order
+func syncTargets(args, flagTargets []string) []string {
+	if len(flagTargets) == 0 {
+		return args
+	}
+	targets := make([]string, 0, len(args)+len(flagTargets))
+	seen := make(map[string]bool, len(args)+len(flagTargets))
+	for _, repo := range append(append([]string(nil), args...), flagTargets...) {
+		if seen[repo] {
+			continue
+		}
+		seen[repo] = true
+		targets = append(targets, repo)
+	}
+	return targets
+}
+
 // getAutomerge returns the automerge flag (thread-safe)
 func getAutomerge() bool {
 	syncFlagsMu.RLock()
@@ -196,6 +292,20 @@ func getAutomerge() bool {
 	return automerge
 }
 
+// getAutomergeMethod returns the automerge method flag (thread-safe)
+func getAutomergeMethod() string {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return automergeMethod
+}
+
+// getDraftPRs returns the --draft flag (thread-safe)
+func getDraftPRs() bool {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return draftPRs
+}
+
 // getClearModuleCache returns the clear module cache flag (thread-safe)
 func getClearModuleCache() bool {
 	syncFlagsMu.RLock()
@@ -203,6 +313,305 @@ func getClearModuleCache() bool {
 	return clearModuleCache
 }
 
+// getFailFast returns the fail-fast flag (thread-safe)
+func getFailFast() bool {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return failFast
+}
+
+// getOutputDir returns the --output-dir flag (thread-safe)
+func getOutputDir() string {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return outputDir
+}
+
+// getMetricsFile returns the --metrics-file flag (thread-safe)
+func getMetricsFile() string {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return metricsFile
+}
+
+// getContentAware returns the --content-aware flag (thread-safe)
+func getContentAware() bool {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return contentAware
+}
+
+// getForceSync returns the --force flag (thread-safe)
+func getForceSync() bool {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return forceSync
+}
+
+// getAllowEmptyCommit returns the --allow-empty-commit flag (thread-safe)
+func getAllowEmptyCommit() bool {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return allowEmptyCommit
+}
+
+// getPRStagger returns the --stagger and --stagger-jitter flags (thread-safe)
+func getPRStagger() (time.Duration, time.Duration) {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return prStagger, prStaggerJitter
+}
+
+// getTempDirFlags returns the --temp-dir, --keep-temp and
+// --keep-temp-on-failure flags (thread-safe)
+func getTempDirFlags() (string, bool, bool) {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return tempBaseDir, keepTemp, keepTempOnFail
+}
+
+// getMaxPRs returns the --max-prs flag (thread-safe)
+func getMaxPRs() int {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return maxPRs
+}
+
+// getDryRunOutput returns the --dry-run-output flag (thread-safe)
+func getDryRunOutput() string {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return dryRunOutput
+}
+
+// getCheckpointFile returns the --checkpoint flag (thread-safe)
+func getCheckpointFile() string {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return checkpointFile
+}
+
+// getPlanOnly returns the --plan-only flag (thread-safe)
+func getPlanOnly() bool {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return planOnly
+}
+
+// getNoPR returns the --no-pr flag (thread-safe)
+func getNoPR() bool {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return noPR
+}
+
+// getWaitForChecks returns the --wait-for-checks and --checks-timeout flags (thread-safe)
+func getWaitForChecks() (bool, time.Duration) {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return waitForChecks, checksTimeout
+}
+
+// getTargetLock returns the --lock-dir, --lock-ref and --lock-ttl flags (thread-safe)
+func getTargetLock() (string, string, time.Duration) {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return lockDir, lockRef, lockTTL
+}
+
+// getCloseSuperseded returns the --close-superseded flag (thread-safe)
+func getCloseSuperseded() bool {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return closeSuperseded
+}
+
+// getInteractive returns the --interactive flag (thread-safe)
+func getInteractive() bool {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return interactive
+}
+
+// getBinaryTransformPolicy returns the --binary-transform-policy flag (thread-safe)
+func getBinaryTransformPolicy() string {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return binaryTransformPolicy
+}
+
+// getTimeouts returns the --target-timeout, --clone-timeout, --push-timeout
+// and --pr-timeout flags (thread-safe)
+func getTimeouts() (time.Duration, sync.OperationTimeouts) {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return targetTimeout, sync.OperationTimeouts{Clone: cloneTimeout, Push: pushTimeout, PRCreate: prTimeout}
+}
+
+// getSourceStatusIssue returns the --source-status-issue flag (thread-safe)
+func getSourceStatusIssue() int {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return sourceStatusIssue
+}
+
+// getProfile returns the --profile and --profile-dir flags (thread-safe)
+func getProfile() ([]string, string) {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return append([]string(nil), profileKinds...), profileDir
+}
+
+// getSummaryOnly returns the --summary-only flag (thread-safe)
+func getSummaryOnly() bool {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return summaryOnly
+}
+
+// getExplain returns the --explain flag (thread-safe)
+func getExplain() bool {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return explain
+}
+
+// planOnlyResult turns a successful --plan-only run into an exit code 2 error
+// when any target would change, so CI can gate on pending changes
+func planOnlyResult(planOnly bool, engine SyncService) error {
+	if !planOnly {
+		return nil
+	}
+	reporter, ok := engine.(interface{ PlanHasChanges() bool })
+	if !ok || !reporter.PlanHasChanges() {
+		output.Success("Plan complete: all targets are in sync")
+		return nil
+	}
+	output.Warn("Plan complete: changes pending; run sync without --plan-only to apply them")
+	return newExitCodeError(planOnlyExitChanges, ErrPlanHasChanges)
+}
+
+// validateDryRunOutput rejects --dry-run-output without --dry-run or
+// --plan-only, since only a dry run produces a plan
+func validateDryRunOutput(dryRun bool, path string) error {
+	if path != "" && !dryRun {
+		return ErrDryRunOutputWithoutDryRun
+	}
+	return nil
+}
+
+// validateTargetLock rejects setting both --lock-dir and --lock-ref, and a
+// --lock-ref that is not a full ref name under refs/
+func validateTargetLock(dir, ref string) error {
+	if dir != "" && ref != "" {
+		return ErrLockDirAndRef
+	}
+	if ref == "" {
+		return nil
+	}
+	name, isRef := strings.CutPrefix(ref, "refs/")
+	if !isRef || !strings.Contains(name, "/") {
+		return fmt.Errorf("%w: %q", ErrInvalidLockRef, ref)
+	}
+	if err := validation.ValidateBranchName(name); err != nil {
+		return fmt.Errorf("%w: %q: %w", ErrInvalidLockRef, ref, err)
+	}
+	return nil
+}
+
+// validateAllowEmptyCommit rejects --allow-empty-commit without --force, so
+// an empty resync is never created by a normal run
+func validateAllowEmptyCommit(force, allowEmpty bool) error {
+	if allowEmpty && !force {
+		return ErrAllowEmptyCommitWithoutForce
+	}
+	return nil
+}
+
+// getPRLabels returns a copy of the --pr-label overrides (thread-safe)
+func getPRLabels() []string {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return append([]string(nil), prLabels...)
+}
+
+// getPRAssignees returns a copy of the --pr-assignee overrides (thread-safe)
+func getPRAssignees() []string {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return append([]string(nil), prAssignees...)
+}
+
+// getPRReviewers returns a copy of the --pr-reviewer overrides (thread-safe)
+func getPRReviewers() []string {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return append([]string(nil), prReviewers...)
+}
+
+// getPRLabelsMode returns the --pr-labels-mode flag (thread-safe)
+func getPRLabelsMode() string {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return prLabelsMode
+}
+
+// getConcurrency returns the maximum number of targets to sync simultaneously
+// from the --concurrency flag (thread-safe)
+func getConcurrency() (int, error) {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return resolveConcurrency(concurrency)
+}
+
+// resolveConcurrency validates a requested concurrency. Zero defaults to
+// runtime.NumCPU(); negative values are rejected.
+func resolveConcurrency(requested int) (int, error) {
+	if requested < 0 {
+		return 0, fmt.Errorf("%w: got %d", ErrInvalidConcurrency, requested)
+	}
+	if requested == 0 {
+		return runtime.NumCPU(), nil
+	}
+	return requested, nil
+}
+
+// getAPIRateLimit returns the client-side GitHub API rate limit from the
+// --api-rate-limit and --api-burst flags (thread-safe)
+func getAPIRateLimit() (gh.RateLimitConfig, error) {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	return resolveAPIRateLimit(apiRateLimit, apiBurst)
+}
+
+// resolveAPIRateLimit validates a client-side GitHub API rate limit.
+// A zero rate disables the limiter; negative values are rejected.
+func resolveAPIRateLimit(rate float64, burst int) (gh.RateLimitConfig, error) {
+	if rate < 0 || burst < 0 {
+		return gh.RateLimitConfig{}, fmt.Errorf("%w: --api-rate-limit=%g --api-burst=%d", ErrInvalidAPIRateLimit, rate, burst)
+	}
+	return gh.RateLimitConfig{RequestsPerSecond: rate, Burst: burst}, nil
+}
+
+// getStateCache returns the state cache directory and TTL (thread-safe). The
+// directory comes from --state-cache-dir, falling back to
+// GO_BROADCAST_STATE_CACHE_DIR; --no-state-cache disables the cache entirely.
+func getStateCache() (string, time.Duration, error) {
+	syncFlagsMu.RLock()
+	defer syncFlagsMu.RUnlock()
+	if stateCacheTTL < 0 {
+		return "", 0, fmt.Errorf("%w: got %s", ErrInvalidStateCacheTTL, stateCacheTTL)
+	}
+	if noStateCache {
+		return "", 0, nil
+	}
+	dir := stateCacheDir
+	if dir == "" {
+		dir = os.Getenv("GO_BROADCAST_STATE_CACHE_DIR")
+	}
+	return dir, stateCacheTTL, nil
+}
+
 // rateLimitPreflightOverrides captures the CLI override intent for the
 // rate-limit preflight. A nil pointer field means "not overridden — use the
 // config default"; a non-nil field overrides config. The ignore escape hatch is
@@ -305,6 +714,22 @@ Group Filtering:
   Use --skip-groups to exclude specific groups from sync.
   When both are specified, skip-groups takes precedence.
 
+Failure handling:
+  By default a failed group only skips the groups that depend on it. Use
+  --fail-fast to stop the entire sync on the first target failure: in-flight
+  targets are canceled, remaining targets and groups never start, and the
+  command exits non-zero with that failure.
+
+Concurrency:
+  Up to --concurrency targets in a group are synced at once (default: the number
+  of CPUs); groups always run one after another. Use --concurrency 1 to sync
+  targets strictly in order, for fully sequential, reproducible output.
+
+API rate limiting:
+  Use --api-rate-limit to cap GitHub API requests per second across all targets
+  (with --api-burst back-to-back requests allowed). Calls wait for the shared
+  limiter; the number of delayed calls appears in the performance metrics.
+
 Scope is resolved up front, so a target argument or --groups/--skip-groups always
 narrows the run to exactly what you named — even in a multi-group config. The
 resolved scope (groups, repos, repo count) is printed before any write.
@@ -321,7 +746,20 @@ Blast-radius confirmation guard:
   go-broadcast sync                        # Sync all targets from config
   go-broadcast sync --config sync.yaml     # Use specific config file
   go-broadcast sync org/repo1 org/repo2    # Sync only specified repositories
+  go-broadcast sync --target org/repo1     # Same, as a repeatable flag
   go-broadcast sync --dry-run              # Preview changes without making them
+  go-broadcast sync --plan-only            # CI gate: exit 2 when changes are pending, 0 when in sync
+  go-broadcast sync --no-pr                # Push sync branches only; open PRs yourself
+  go-broadcast sync --automerge --wait-for-checks  # Enable auto-merge once PR checks pass
+  go-broadcast sync --summary-only         # CI logs: print only the final per-target table
+  go-broadcast sync --dry-run --explain    # Show why each target would or would not sync
+  go-broadcast sync --lock-dir /var/lock/go-broadcast  # Keep overlapping runs from pushing over each other
+  go-broadcast sync --close-superseded     # Close older sync PRs once a new one is opened
+  go-broadcast sync --interactive          # Approve, skip or quit before each target's PR is opened
+  go-broadcast sync --binary-transform-policy fail  # Fail targets whose binary files have transforms configured
+  go-broadcast sync --target-timeout 10m --push-timeout 2m  # Fail targets that hang instead of stalling the run
+  go-broadcast sync --source-status-issue 42  # Keep a status comment on issue #42 of the source repo up to date
+  go-broadcast sync --profile cpu,mem --profile-dir ./profiles  # Capture CPU and memory profiles of the run
 
   # Database-backed configuration
   go-broadcast sync --from-db              # Load configuration from database
@@ -342,6 +780,19 @@ Blast-radius confirmation guard:
   # Automerge configuration
   go-broadcast sync --automerge                         # Add automerge labels to PRs
   go-broadcast sync --automerge --groups "core"        # Automerge with group filtering
+  go-broadcast sync --automerge --automerge-method rebase  # Auto-merge PRs by rebasing
+  go-broadcast sync --draft                             # Create PRs as drafts
+
+  # Forced resync
+  go-broadcast sync --force org/repo1                       # Sync even if the target looks up to date
+  go-broadcast sync --force --allow-empty-commit org/repo1  # Empty commit + PR to re-trigger target CI
+
+  # Resume an interrupted sync
+  go-broadcast sync --checkpoint sync.checkpoint.json  # Rerun after Ctrl-C skips completed targets
+
+  # One-off PR metadata overrides
+  go-broadcast sync --pr-label hotfix --pr-assignee alice   # Replace configured labels/assignees
+  go-broadcast sync --pr-reviewer bob --pr-labels-mode merge  # Add to configured reviewers
 
   # Common workflows
   go-broadcast validate && go-broadcast sync --dry-run  # Validate then preview
@@ -357,8 +808,54 @@ Blast-radius confirmation guard:
 func init() {
 	syncCmd.Flags().StringSliceVar(&groupFilter, "groups", nil, "Sync only specified groups (by name or ID)")
 	syncCmd.Flags().StringSliceVar(&skipGroups, "skip-groups", nil, "Skip specified groups during sync")
-	syncCmd.Flags().BoolVar(&automerge, "automerge", false, "Add automerge labels from GO_BROADCAST_AUTOMERGE_LABELS to created PRs")
+	syncCmd.Flags().StringSliceVar(&targetFilter, "target", nil, "Sync only this target repository (org/repo) across all groups (repeatable)")
+	syncCmd.Flags().BoolVar(&automerge, "automerge", false, "Enable auto-merge and add automerge labels from GO_BROADCAST_AUTOMERGE_LABELS to created PRs")
+	syncCmd.Flags().StringVar(&automergeMethod, "automerge-method", "", "Merge method used to enable auto-merge on created PRs: merge, squash, rebase (default: config or squash)")
+	syncCmd.Flags().BoolVar(&draftPRs, "draft", false, "Create sync PRs as drafts (overrides pr_draft in config)")
 	syncCmd.Flags().BoolVar(&clearModuleCache, "clear-cache", false, "Clear module version cache before sync")
+	syncCmd.Flags().BoolVar(&failFast, "fail-fast", false, "Abort the entire sync on the first target failure")
+	syncCmd.Flags().IntVar(&concurrency, "concurrency", 0, "Maximum number of targets synced simultaneously (default: number of CPUs; 1 = sequential)")
+	syncCmd.Flags().Float64Var(&apiRateLimit, "api-rate-limit", 0, "Maximum GitHub API requests per second across all targets (0 = unlimited)")
+	syncCmd.Flags().IntVar(&apiBurst, "api-burst", 1, "Maximum back-to-back GitHub API requests allowed by --api-rate-limit")
+	syncCmd.Flags().StringVar(&stateCacheDir, "state-cache-dir", "", "Cache discovered sync state in this directory (default: GO_BROADCAST_STATE_CACHE_DIR, unset = no cache)")
+	syncCmd.Flags().DurationVar(&stateCacheTTL, "state-cache-ttl", state.DefaultStateCacheTTL, "How long cached state is reused while source commits are unchanged")
+	syncCmd.Flags().BoolVar(&noStateCache, "no-state-cache", false, "Ignore the state cache and discover state from GitHub")
+	syncCmd.Flags().StringVar(&outputDir, "output-dir", "", "Write a JSON result file per target and a summary.json to this directory")
+	syncCmd.Flags().StringVar(&metricsFile, "metrics-file", "", "Append one JSON line of run performance metrics to this file")
+	syncCmd.Flags().BoolVar(&contentAware, "content-aware", false, "Skip targets whose mapped content is unchanged even when the source commit changed")
+	syncCmd.Flags().BoolVar(&forceSync, "force", false, "Sync targets even when they appear up to date")
+	syncCmd.Flags().BoolVar(&allowEmptyCommit, "allow-empty-commit", false, "With --force, create an empty commit and open or update the PR even when content is unchanged (re-triggers target CI)")
+	syncCmd.Flags().DurationVar(&prStagger, "stagger", 0, "Wait at least this long between opening PRs across targets, e.g. 30s (clones and transforms still run concurrently)")
+	syncCmd.Flags().DurationVar(&prStaggerJitter, "stagger-jitter", 0, "Add a random delay of up to this duration to each --stagger gap")
+	syncCmd.Flags().StringVar(&tempBaseDir, "temp-dir", "", "Base directory for target working trees (default: system temp directory)")
+	syncCmd.Flags().BoolVar(&keepTemp, "keep-temp", false, "Keep every target working tree after the sync and log its path")
+	syncCmd.Flags().BoolVar(&keepTempOnFail, "keep-temp-on-failure", false, "Keep the working tree of failed targets for inspection and log its path")
+	syncCmd.Flags().IntVar(&maxPRs, "max-prs", 0, "Abort the run before creating or updating more than this many pull requests (0 = unlimited)")
+	syncCmd.Flags().StringVar(&checkpointFile, "checkpoint", "", "Record completed targets in this file; a rerun after an interruption skips them until the source commit changes (--force syncs all)")
+	syncCmd.Flags().BoolVar(&planOnly, "plan-only", false, "Compute the plan like --dry-run, then exit 2 if any target would change and 0 if all are in sync")
+	syncCmd.Flags().BoolVar(&noPR, "no-pr", false, "Push the sync branch without creating or updating a pull request, printing the branch per target")
+	syncCmd.Flags().BoolVar(&waitForChecks, "wait-for-checks", false, "With --automerge, wait for the PR checks to finish and enable auto-merge only when they pass; failed checks fail the target")
+	syncCmd.Flags().DurationVar(&checksTimeout, "checks-timeout", 0, "Longest wait for PR checks per target with --wait-for-checks (default 30m)")
+	syncCmd.Flags().BoolVar(&summaryOnly, "summary-only", false, "Suppress progress output and print only a final table of each target's PR, files changed and status (JSON with --log-format json)")
+	syncCmd.Flags().StringVar(&lockDir, "lock-dir", "", "Hold a lock file per target in this directory while it syncs, so overlapping runs sharing it cannot push over each other")
+	syncCmd.Flags().StringVar(&lockRef, "lock-ref", "", "Lock each target with this ref in its repository while it syncs (e.g. refs/go-broadcast/lock), so runs on any host exclude each other")
+	syncCmd.Flags().DurationVar(&lockTTL, "lock-ttl", 0, "Reclaim target locks older than this, left by crashed runs (default 1h)")
+	syncCmd.Flags().BoolVar(&closeSuperseded, "close-superseded", false, "When a new sync PR is created, close the target's older open sync PRs with a comment linking to it and delete their branches")
+	syncCmd.Flags().StringVar(&binaryTransformPolicy, "binary-transform-policy", config.BinaryTransformPolicySkip, "What to do with binary files whose target has transforms configured: skip (sync untransformed with a warning) or fail")
+	syncCmd.Flags().DurationVar(&targetTimeout, "target-timeout", 0, "Fail a target whose sync runs longer than this, e.g. 10m, and continue with the others (0 = no limit)")
+	syncCmd.Flags().DurationVar(&cloneTimeout, "clone-timeout", 0, "Fail a target whose source clone runs longer than this (0 = no limit)")
+	syncCmd.Flags().DurationVar(&pushTimeout, "push-timeout", 0, "Fail a target whose branch push runs longer than this (0 = no limit)")
+	syncCmd.Flags().DurationVar(&prTimeout, "pr-timeout", 0, "Fail a target whose PR creation or update runs longer than this (0 = no limit)")
+	syncCmd.Flags().IntVar(&sourceStatusIssue, "source-status-issue", 0, "After the run, post or update one comment on this issue of the source repository listing which targets are in sync, have open PRs or failed (0 = off)")
+	syncCmd.Flags().BoolVar(&interactive, "interactive", false, "Show each target's changed files and ask to [a]pprove, [s]kip or [q]uit before pushing its branch and opening its PR (needs a terminal)")
+	syncCmd.Flags().StringSliceVar(&profileKinds, "profile", nil, "Profile the sync run: any of cpu, mem, trace, block, mutex (e.g. cpu,mem); profiles are written under --profile-dir")
+	syncCmd.Flags().StringVar(&profileDir, "profile-dir", defaultProfileDir, "Directory receiving the profiles captured by --profile")
+	syncCmd.Flags().BoolVar(&explain, "explain", false, "Print why each target is or is not synced: commits compared, content-aware results, disabled groups and failed dependencies (combine with --dry-run to preview)")
+	syncCmd.Flags().StringVar(&dryRunOutput, "dry-run-output", "", "With --dry-run or --plan-only, write the full plan (file changes with hashes, PR title and body per target) as JSON to this file")
+	syncCmd.Flags().StringSliceVar(&prLabels, "pr-label", nil, "PR label to apply instead of the configured labels (repeatable)")
+	syncCmd.Flags().StringSliceVar(&prAssignees, "pr-assignee", nil, "PR assignee to use instead of the configured assignees (repeatable)")
+	syncCmd.Flags().StringSliceVar(&prReviewers, "pr-reviewer", nil, "PR reviewer to request instead of the configured reviewers (repeatable)")
+	syncCmd.Flags().StringVar(&prLabelsMode, "pr-labels-mode", config.PRLabelsModeReplace, "How --pr-label, --pr-assignee and --pr-reviewer combine with config: replace or merge")
 
 	// Rate-limit preflight flags (override the config rate_limit_preflight block).
 	syncCmd.Flags().BoolVar(&rateLimitPreflight, flagRateLimitPreflight, true, "Enable the pre-sync GitHub rate-limit preflight gate")
@@ -405,7 +902,7 @@ func runSync(cmd *cobra.Command, args []string) error {
 	}
 
 	// Filter targets if specified
-	targets := args
+	targets := syncTargets(args, getTargetFilter())
 	if len(targets) > 0 {
 		log.WithField("targets", targets).Info("Syncing specific targets")
 	} else if len(gf) == 0 && len(sg) == 0 {
@@ -413,9 +910,13 @@ func runSync(cmd *cobra.Command, args []string) error {
 	}
 
 	// Show dry-run warning
-	if IsDryRun() {
+	if IsDryRun() || getPlanOnly() {
 		output.Warn("DRY-RUN MODE: No changes will be made to repositories")
 	}
+	logFormat := GetGlobalFlags().LogFormat
+	if getSummaryOnly() {
+		defer beginSummaryOnly(logrus.StandardLogger(), logFormat)()
+	}
 
 	// Initialize sync engine with real implementations
 	engine, err := createSyncEngine(ctx, cfg)
@@ -428,9 +929,15 @@ func runSync(cmd *cobra.Command, args []string) error {
 	defer closeMetrics()
 
 	// Execute sync
-	if err := engine.Sync(ctx, targets); err != nil {
+	kinds, dir := getProfile()
+	if err := runProfiled(kinds, dir, func() error {
+		return syncAndSummarize(ctx, engine, targets, getSummaryOnly(), logFormat)
+	}); err != nil {
 		return fmt.Errorf("sync failed: %w", err)
 	}
+	if getPlanOnly() {
+		return planOnlyResult(true, engine)
+	}
 
 	output.Success("Sync completed successfully")
 	return nil
@@ -455,7 +962,7 @@ func createRunSync(flags *Flags) func(*cobra.Command, []string) error {
 		}
 
 		// Filter targets if specified
-		targets := args
+		targets := syncTargets(args, flags.Targets)
 		if len(targets) > 0 {
 			log.WithField("targets", targets).Info("Syncing specific targets")
 		} else {
@@ -463,9 +970,12 @@ func createRunSync(flags *Flags) func(*cobra.Command, []string) error {
 		}
 
 		// Show dry-run warning
-		if flags.DryRun {
+		if flags.DryRun || flags.PlanOnly {
 			output.Warn("DRY-RUN MODE: No changes will be made to repositories")
 		}
+		if flags.SummaryOnly {
+			defer beginSummaryOnly(logger, flags.LogFormat)()
+		}
 
 		// Initialize sync engine with real implementations
 		engine, err := createSyncEngineWithFlags(ctx, cfg, flags, logger)
@@ -478,9 +988,14 @@ func createRunSync(flags *Flags) func(*cobra.Command, []string) error {
 		defer closeMetrics()
 
 		// Execute sync
-		if err := engine.Sync(ctx, targets); err != nil {
+		if err := runProfiled(flags.Profile, flags.ProfileDir, func() error {
+			return syncAndSummarize(ctx, engine, targets, flags.SummaryOnly, flags.LogFormat)
+		}); err != nil {
 			return fmt.Errorf("sync failed: %w", err)
 		}
+		if flags.PlanOnly {
+			return planOnlyResult(true, engine)
+		}
 
 		output.Success("Sync completed successfully")
 		return nil
@@ -501,8 +1016,8 @@ func loadConfig() (*config.Config, error) {
 	configPath := GetConfigFile()
 
 	// Check if config file exists
-	if _, err := os.Stat(configPath); os.IsNotExist(err) {
-		return nil, fmt.Errorf("%w: %s", ErrConfigFileNotFound, configPath)
+	if err := checkConfigFile(configPath); err != nil {
+		return nil, err
 	}
 
 	// Load and parse configuration
@@ -563,8 +1078,8 @@ func loadConfigWithFlags(flags *Flags, logger *logrus.Logger) (*config.Config, e
 	configPath := flags.ConfigFile
 
 	// Check if config file exists
-	if _, err := os.Stat(configPath); os.IsNotExist(err) {
-		return nil, fmt.Errorf("%w: %s", ErrConfigFileNotFound, configPath)
+	if err := checkConfigFile(configPath); err != nil {
+		return nil, err
 	}
 
 	// Load and parse configuration
@@ -625,69 +1140,119 @@ func tryAttachMetricsRecorder(engine *sync.Engine, log *logrus.Logger) func() {
 	return func() { _ = database.Close() }
 }
 
+// newSyncGitClient creates the Git client for a sync. When the config sets a
+// commit signing key, it first checks that git and the key can sign, so a
+// misconfiguration fails before any repository is touched.
+func newSyncGitClient(ctx context.Context, cfg *config.Config, logger *logrus.Logger, logConfig *LogConfig) (git.Client, error) {
+	signing := commitSigning(cfg)
+	if err := git.ValidateSigning(ctx, signing); err != nil {
+		return nil, fmt.Errorf("commit signing: %w", err)
+	}
+
+	gitClient, err := git.NewClient(logger, logConfig, git.WithSigning(signing), git.WithIdentity(commitIdentity(cfg)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Git client: %w", err)
+	}
+	return gitClient, nil
+}
+
+// commitSigning returns the commit signing settings of cfg
+func commitSigning(cfg *config.Config) git.SigningConfig {
+	if cfg == nil {
+		return git.SigningConfig{}
+	}
+	return git.SigningConfig{Key: cfg.CommitSigning.Key, Format: cfg.CommitSigning.Format}
+}
+
+// metadataSecret returns the key PR metadata blocks are signed and verified
+// with, read from the environment variable named by cfg.MetadataSecretEnv. It
+// is nil when no variable is configured.
+func metadataSecret(cfg *config.Config) ([]byte, error) {
+	if cfg == nil || cfg.MetadataSecretEnv == "" {
+		return nil, nil
+	}
+	secret := os.Getenv(cfg.MetadataSecretEnv)
+	if secret == "" {
+		return nil, fmt.Errorf("%w: %s", ErrMetadataSecretUnset, cfg.MetadataSecretEnv)
+	}
+	return []byte(secret), nil
+}
+
+// commitIdentity returns the commit author and committer settings of cfg
+func commitIdentity(cfg *config.Config) git.IdentityConfig {
+	if cfg == nil {
+		return git.IdentityConfig{}
+	}
+	return git.IdentityConfig{
+		AuthorName:     cfg.CommitAuthorName,
+		AuthorEmail:    cfg.CommitAuthorEmail,
+		CommitterName:  cfg.CommitterName,
+		CommitterEmail: cfg.CommitterEmail,
+	}
+}
+
 // createSyncEngine initializes the sync engine with all required dependencies
 func createSyncEngine(ctx context.Context, cfg *config.Config) (*sync.Engine, error) {
 	logger := logrus.StandardLogger()
 
+	// Validate the automerge method, PR labels mode and binary transform policy before touching GitHub
+	if err := config.ValidateAutomergeMethod(getAutomergeMethod()); err != nil {
+		return nil, err
+	}
+	if err := config.ValidatePRLabelsMode(getPRLabelsMode()); err != nil {
+		return nil, err
+	}
+	if err := config.ValidateBinaryTransformPolicy(getBinaryTransformPolicy()); err != nil {
+		return nil, err
+	}
+	if err := validateAllowEmptyCommit(getForceSync(), getAllowEmptyCommit()); err != nil {
+		return nil, err
+	}
+	if err := validateDryRunOutput(IsDryRun() || getPlanOnly(), getDryRunOutput()); err != nil {
+		return nil, err
+	}
+	targetLockDir, targetLockRef, targetLockTTL := getTargetLock()
+	if err := validateTargetLock(targetLockDir, targetLockRef); err != nil {
+		return nil, err
+	}
+	kinds, _ := getProfile()
+	if err := validateProfile(kinds); err != nil {
+		return nil, err
+	}
+
 	// Initialize GitHub client
-	ghClient, err := gh.NewClient(ctx, logger, nil)
+	maxConcurrency, err := getConcurrency()
+	if err != nil {
+		return nil, err
+	}
+	rateLimit, err := getAPIRateLimit()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create GitHub client: %w", err)
+		return nil, err
+	}
+	cacheDir, cacheTTL, err := getStateCache()
+	if err != nil {
+		return nil, err
+	}
+	ghClient, err := newForgeClient(ctx, cfg, logger, nil, gh.WithRateLimit(rateLimit), ghAuthOption(nil))
+	if err != nil {
+		return nil, err
 	}
 
 	// Initialize Git client
-	gitClient, err := git.NewClient(logger, nil)
+	gitClient, err := newSyncGitClient(ctx, cfg, logger, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create Git client: %w", err)
+		return nil, err
 	}
 
 	// Initialize state discoverer
-	stateDiscoverer := state.NewDiscoverer(ghClient, logger, nil)
-
-	// Initialize transform chain
-	transformChain := transform.NewChain(logger)
-
-	// Add email transformer FIRST if any source or target has email configuration
-	// This must run before repo name transformer to prevent email addresses from being corrupted
-	groups := cfg.Groups
-	for _, group := range groups {
-		// Check if source has email configuration
-		if group.Source.SecurityEmail != "" || group.Source.SupportEmail != "" {
-			transformChain.Add(transform.NewEmailTransformer())
-			goto emailTransformerAdded
-		}
-		// Check if any target has email configuration
-		for _, target := range group.Targets {
-			if target.SecurityEmail != "" || target.SupportEmail != "" {
-				transformChain.Add(transform.NewEmailTransformer())
-				goto emailTransformerAdded
-			}
-		}
-	}
-emailTransformerAdded:
-
-	// Add template variable transformer if any target uses it
-	for _, group := range groups {
-		for _, target := range group.Targets {
-			if len(target.Transform.Variables) > 0 {
-				transformChain.Add(transform.NewTemplateTransformer(logrus.StandardLogger(), nil))
-				goto templateTransformerAdded
-			}
-		}
+	secret, err := metadataSecret(cfg)
+	if err != nil {
+		return nil, err
 	}
-templateTransformerAdded:
+	stateDiscoverer := state.NewDiscoverer(ghClient, logger, nil, state.WithMetadataSecret(secret))
 
-	// Add repository name transformer LAST if any target uses it
-	// This runs last to avoid corrupting email addresses during transformation
-	for _, group := range groups {
-		for _, target := range group.Targets {
-			if target.Transform.RepoName {
-				transformChain.Add(transform.NewRepoTransformer())
-				goto repoTransformerAdded
-			}
-		}
-	}
-repoTransformerAdded:
+	// Initialize transform chain
+	transformChain := sync.NewTransformChain(cfg.Groups, logger, nil)
 
 	// Load automerge labels from environment if automerge is enabled (thread-safe)
 	var automergeLabels []string
@@ -704,17 +1269,52 @@ repoTransformerAdded:
 	}
 
 	// Create sync options (using thread-safe getters)
+	tempBase, keepAll, keepOnFailure := getTempDirFlags()
+	targetLimit, operationLimits := getTimeouts()
 	opts := sync.DefaultOptions().
 		WithDryRun(IsDryRun()).
-		WithMaxConcurrency(5).
+		WithMaxConcurrency(maxConcurrency).
 		WithGroupFilter(getGroupFilter()).
 		WithSkipGroups(getSkipGroups()).
 		WithAutomerge(autoMergeEnabled).
 		WithAutomergeLabels(automergeLabels).
-		WithClearModuleCache(getClearModuleCache())
+		WithAutomergeMethod(getAutomergeMethod()).
+		WithDraft(getDraftPRs()).
+		WithPRLabels(getPRLabels()).
+		WithPRAssignees(getPRAssignees()).
+		WithPRReviewers(getPRReviewers()).
+		WithPRLabelsMode(getPRLabelsMode()).
+		WithClearModuleCache(getClearModuleCache()).
+		WithFailFast(getFailFast())
 
 	// Apply rate-limit preflight settings (config base + CLI overrides)
-	opts = mergeRateLimitPreflight(opts, cfg, currentRateLimitOverrides())
+	opts = mergeRateLimitPreflight(opts, cfg, currentRateLimitOverrides()).
+		WithStateCache(cacheDir, cacheTTL).
+		WithOutputDir(getOutputDir()).
+		WithMetricsFile(getMetricsFile()).
+		WithContentAwareSync(getContentAware()).
+		WithForce(getForceSync()).
+		WithAllowEmptyCommit(getAllowEmptyCommit()).
+		WithPRStagger(getPRStagger()).
+		WithTempDir(tempBase).
+		WithKeepTemp(keepAll, keepOnFailure).
+		WithMaxPRs(getMaxPRs()).
+		WithDryRunPlanFile(getDryRunOutput()).
+		WithCheckpointFile(getCheckpointFile()).
+		WithPlanOnly(getPlanOnly()).
+		WithNoPR(getNoPR()).
+		WithWaitForChecks(getWaitForChecks()).
+		WithSummaryOnly(getSummaryOnly()).
+		WithExplain(getExplain()).
+		WithTargetLock(targetLockDir, targetLockRef, targetLockTTL).
+		WithCloseSupersededPRs(getCloseSuperseded()).
+		WithInteractive(getInteractive()).
+		WithBinaryTransformPolicy(getBinaryTransformPolicy()).
+		WithTargetTimeout(targetLimit).
+		WithOperationTimeouts(operationLimits).
+		WithSourceStatusIssue(getSourceStatusIssue()).
+		WithCommitSigning(commitSigning(cfg).Key, commitSigning(cfg).Format).
+		WithMetadataSecret(secret)
 
 	// Create and return engine
 	engine := sync.NewEngine(ctx, cfg, ghClient, gitClient, stateDiscoverer, transformChain, opts)
@@ -725,65 +1325,63 @@ repoTransformerAdded:
 
 // createSyncEngineWithFlags initializes the sync engine with flags instead of global state
 func createSyncEngineWithFlags(ctx context.Context, cfg *config.Config, flags *Flags, logger *logrus.Logger) (*sync.Engine, error) {
+	// Validate the automerge method, PR labels mode and binary transform policy before touching GitHub
+	if err := config.ValidateAutomergeMethod(flags.AutomergeMethod); err != nil {
+		return nil, err
+	}
+	if err := config.ValidatePRLabelsMode(flags.PRLabelsMode); err != nil {
+		return nil, err
+	}
+	if err := config.ValidateBinaryTransformPolicy(flags.BinaryTransformPolicy); err != nil {
+		return nil, err
+	}
+	if err := validateAllowEmptyCommit(flags.Force, flags.AllowEmptyCommit); err != nil {
+		return nil, err
+	}
+	if err := validateDryRunOutput(flags.DryRun || flags.PlanOnly, flags.DryRunOutput); err != nil {
+		return nil, err
+	}
+	if err := validateTargetLock(flags.LockDir, flags.LockRef); err != nil {
+		return nil, err
+	}
+	if err := validateProfile(flags.Profile); err != nil {
+		return nil, err
+	}
+
 	// Initialize GitHub client
-	ghClient, err := gh.NewClient(ctx, logger, nil)
+	maxConcurrency, err := resolveConcurrency(flags.Concurrency)
+	if err != nil {
+		return nil, err
+	}
+	rateLimit, err := resolveAPIRateLimit(flags.APIRateLimit, flags.APIBurst)
+	if err != nil {
+		return nil, err
+	}
+	cacheDir, cacheTTL, err := getStateCache()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create GitHub client: %w", err)
+		return nil, err
+	}
+	ghClient, err := newForgeClient(ctx, cfg, logger, nil, gh.WithRateLimit(rateLimit),
+		gh.WithAuth(gh.AuthConfig{TokenFile: flags.TokenFile, TokenCommand: flags.TokenCommand}))
+	if err != nil {
+		return nil, err
 	}
 
 	// Initialize Git client
-	gitClient, err := git.NewClient(logger, nil)
+	gitClient, err := newSyncGitClient(ctx, cfg, logger, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create Git client: %w", err)
+		return nil, err
 	}
 
 	// Initialize state discoverer
-	stateDiscoverer := state.NewDiscoverer(ghClient, logger, nil)
-
-	// Initialize transform chain
-	transformChain := transform.NewChain(logger)
-
-	// Add email transformer FIRST if any source or target has email configuration
-	// This must run before repo name transformer to prevent email addresses from being corrupted
-	groups := cfg.Groups
-	for _, group := range groups {
-		// Check if source has email configuration
-		if group.Source.SecurityEmail != "" || group.Source.SupportEmail != "" {
-			transformChain.Add(transform.NewEmailTransformer())
-			goto emailTransformerAdded2
-		}
-		// Check if any target has email configuration
-		for _, target := range group.Targets {
-			if target.SecurityEmail != "" || target.SupportEmail != "" {
-				transformChain.Add(transform.NewEmailTransformer())
-				goto emailTransformerAdded2
-			}
-		}
-	}
-emailTransformerAdded2:
-
-	// Add template variable transformer if any target uses it
-	for _, group := range groups {
-		for _, target := range group.Targets {
-			if len(target.Transform.Variables) > 0 {
-				transformChain.Add(transform.NewTemplateTransformer(logger, nil))
-				goto templateTransformerAdded2
-			}
-		}
+	secret, err := metadataSecret(cfg)
+	if err != nil {
+		return nil, err
 	}
-templateTransformerAdded2:
+	stateDiscoverer := state.NewDiscoverer(ghClient, logger, nil, state.WithMetadataSecret(secret))
 
-	// Add repository name transformer LAST if any target uses it
-	// This runs last to avoid corrupting email addresses during transformation
-	for _, group := range groups {
-		for _, target := range group.Targets {
-			if target.Transform.RepoName {
-				transformChain.Add(transform.NewRepoTransformer())
-				goto repoTransformerAdded2
-			}
-		}
-	}
-repoTransformerAdded2:
+	// Initialize transform chain
+	transformChain := sync.NewTransformChain(cfg.Groups, logger, nil)
 
 	// Load automerge labels from environment if automerge is enabled
 	var automergeLabels []string
@@ -801,14 +1399,47 @@ repoTransformerAdded2:
 	// Create sync options using flags instead of global state
 	opts := sync.DefaultOptions().
 		WithDryRun(flags.DryRun).
-		WithMaxConcurrency(5).
+		WithMaxConcurrency(maxConcurrency).
 		WithGroupFilter(flags.GroupFilter).
 		WithSkipGroups(flags.SkipGroups).
 		WithAutomerge(flags.Automerge).
-		WithAutomergeLabels(automergeLabels)
+		WithAutomergeLabels(automergeLabels).
+		WithAutomergeMethod(flags.AutomergeMethod).
+		WithDraft(flags.Draft).
+		WithPRLabels(flags.PRLabels).
+		WithPRAssignees(flags.PRAssignees).
+		WithPRReviewers(flags.PRReviewers).
+		WithPRLabelsMode(flags.PRLabelsMode).
+		WithFailFast(flags.FailFast)
 
 	// Apply rate-limit preflight settings (config base + CLI overrides)
-	opts = mergeRateLimitPreflight(opts, cfg, currentRateLimitOverrides())
+	opts = mergeRateLimitPreflight(opts, cfg, currentRateLimitOverrides()).
+		WithStateCache(cacheDir, cacheTTL).
+		WithOutputDir(flags.OutputDir).
+		WithMetricsFile(flags.MetricsFile).
+		WithContentAwareSync(flags.ContentAware).
+		WithForce(flags.Force).
+		WithAllowEmptyCommit(flags.AllowEmptyCommit).
+		WithPRStagger(flags.Stagger, flags.StaggerJitter).
+		WithTempDir(flags.TempDir).
+		WithKeepTemp(flags.KeepTemp, flags.KeepTempOnFail).
+		WithMaxPRs(flags.MaxPRs).
+		WithDryRunPlanFile(flags.DryRunOutput).
+		WithCheckpointFile(flags.CheckpointFile).
+		WithPlanOnly(flags.PlanOnly).
+		WithNoPR(flags.NoPR).
+		WithWaitForChecks(flags.WaitForChecks, flags.ChecksTimeout).
+		WithSummaryOnly(flags.SummaryOnly).
+		WithExplain(flags.Explain).
+		WithTargetLock(flags.LockDir, flags.LockRef, flags.LockTTL).
+		WithCloseSupersededPRs(flags.CloseSuperseded).
+		WithInteractive(flags.Interactive).
+		WithBinaryTransformPolicy(flags.BinaryTransformPolicy).
+		WithTargetTimeout(flags.TargetTimeout).
+		WithOperationTimeouts(sync.OperationTimeouts{Clone: flags.CloneTimeout, Push: flags.PushTimeout, PRCreate: flags.PRTimeout}).
+		WithSourceStatusIssue(flags.SourceStatusIssue).
+		WithCommitSigning(commitSigning(cfg).Key, commitSigning(cfg).Format).
+		WithMetadataSecret(secret)
 
 	// Create and return engine
 	engine := sync.NewEngine(ctx, cfg, ghClient, gitClient, stateDiscoverer, transformChain, opts)
@@ -835,8 +1466,8 @@ func loadConfigWithLogConfig(logConfig *LogConfig) (*config.Config, error) {
 	configPath := logConfig.ConfigFile
 
 	// Check if config file exists
-	if _, err := os.Stat(configPath); os.IsNotExist(err) {
-		return nil, fmt.Errorf("%w: %s", ErrConfigFileNotFound, configPath)
+	if err := checkConfigFile(configPath); err != nil {
+		return nil, err
 	}
 
 	// Load and parse configuration
@@ -889,65 +1520,63 @@ func loadConfigWithLogConfig(logConfig *LogConfig) (*config.Config, error) {
 func createSyncEngineWithLogConfig(ctx context.Context, cfg *config.Config, logConfig *LogConfig) (*sync.Engine, error) {
 	logger := logrus.StandardLogger()
 
+	// Validate the automerge method, PR labels mode and binary transform policy before touching GitHub
+	if err := config.ValidateAutomergeMethod(logConfig.AutomergeMethod); err != nil {
+		return nil, err
+	}
+	if err := config.ValidatePRLabelsMode(logConfig.PRLabelsMode); err != nil {
+		return nil, err
+	}
+	if err := config.ValidateBinaryTransformPolicy(logConfig.BinaryTransformPolicy); err != nil {
+		return nil, err
+	}
+	if err := validateAllowEmptyCommit(logConfig.Force, logConfig.AllowEmptyCommit); err != nil {
+		return nil, err
+	}
+	if err := validateDryRunOutput(logConfig.DryRun || logConfig.PlanOnly, logConfig.DryRunOutput); err != nil {
+		return nil, err
+	}
+	if err := validateTargetLock(logConfig.LockDir, logConfig.LockRef); err != nil {
+		return nil, err
+	}
+	if err := validateProfile(logConfig.Profile); err != nil {
+		return nil, err
+	}
+
 	// Initialize GitHub client with verbose logging
-	ghClient, err := gh.NewClient(ctx, logger, logConfig)
+	maxConcurrency, err := resolveConcurrency(logConfig.Concurrency)
+	if err != nil {
+		return nil, err
+	}
+	rateLimit, err := resolveAPIRateLimit(logConfig.APIRateLimit, logConfig.APIBurst)
+	if err != nil {
+		return nil, err
+	}
+	cacheDir, cacheTTL, err := getStateCache()
+	if err != nil {
+		return nil, err
+	}
+	ghClient, err := newForgeClient(ctx, cfg, logger, logConfig, gh.WithRateLimit(rateLimit),
+		gh.WithAuth(gh.AuthConfig{TokenFile: logConfig.TokenFile, TokenCommand: logConfig.TokenCommand}))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create GitHub client: %w", err)
+		return nil, err
 	}
 
 	// Initialize Git client with verbose logging
-	gitClient, err := git.NewClient(logger, logConfig)
+	gitClient, err := newSyncGitClient(ctx, cfg, logger, logConfig)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create Git client: %w", err)
+		return nil, err
 	}
 
 	// Initialize state discoverer with LogConfig
-	stateDiscoverer := state.NewDiscoverer(ghClient, logger, logConfig)
-
-	// Initialize transform chain
-	transformChain := transform.NewChain(logger)
-
-	// Add email transformer FIRST if any source or target has email configuration
-	// This must run before repo name transformer to prevent email addresses from being corrupted
-	groups := cfg.Groups
-	for _, group := range groups {
-		// Check if source has email configuration
-		if group.Source.SecurityEmail != "" || group.Source.SupportEmail != "" {
-			transformChain.Add(transform.NewEmailTransformer())
-			goto emailTransformerAdded3
-		}
-		// Check if any target has email configuration
-		for _, target := range group.Targets {
-			if target.SecurityEmail != "" || target.SupportEmail != "" {
-				transformChain.Add(transform.NewEmailTransformer())
-				goto emailTransformerAdded3
-			}
-		}
-	}
-emailTransformerAdded3:
-
-	// Add template variable transformer if any target uses it
-	for _, group := range groups {
-		for _, target := range group.Targets {
-			if len(target.Transform.Variables) > 0 {
-				transformChain.Add(transform.NewTemplateTransformer(logger, logConfig))
-				goto templateTransformerAdded3
-			}
-		}
+	secret, err := metadataSecret(cfg)
+	if err != nil {
+		return nil, err
 	}
-templateTransformerAdded3:
+	stateDiscoverer := state.NewDiscoverer(ghClient, logger, logConfig, state.WithMetadataSecret(secret))
 
-	// Add repository name transformer LAST if any target uses it
-	// This runs last to avoid corrupting email addresses during transformation
-	for _, group := range groups {
-		for _, target := range group.Targets {
-			if target.Transform.RepoName {
-				transformChain.Add(transform.NewRepoTransformer())
-				goto repoTransformerAdded3
-			}
-		}
-	}
-repoTransformerAdded3:
+	// Initialize transform chain
+	transformChain := sync.NewTransformChain(cfg.Groups, logger, logConfig)
 
 	// Load automerge labels from environment if automerge is enabled
 	var automergeLabels []string
@@ -965,14 +1594,47 @@ repoTransformerAdded3:
 	// Create sync options using LogConfig instead of global state
 	opts := sync.DefaultOptions().
 		WithDryRun(logConfig.DryRun).
-		WithMaxConcurrency(5).
+		WithMaxConcurrency(maxConcurrency).
 		WithGroupFilter(logConfig.GroupFilter).
 		WithSkipGroups(logConfig.SkipGroups).
 		WithAutomerge(logConfig.Automerge).
-		WithAutomergeLabels(automergeLabels)
+		WithAutomergeLabels(automergeLabels).
+		WithAutomergeMethod(logConfig.AutomergeMethod).
+		WithDraft(logConfig.Draft).
+		WithPRLabels(logConfig.PRLabels).
+		WithPRAssignees(logConfig.PRAssignees).
+		WithPRReviewers(logConfig.PRReviewers).
+		WithPRLabelsMode(logConfig.PRLabelsMode).
+		WithFailFast(logConfig.FailFast)
 
 	// Apply rate-limit preflight settings (config base + CLI overrides)
-	opts = mergeRateLimitPreflight(opts, cfg, currentRateLimitOverrides())
+	opts = mergeRateLimitPreflight(opts, cfg, currentRateLimitOverrides()).
+		WithStateCache(cacheDir, cacheTTL).
+		WithOutputDir(logConfig.OutputDir).
+		WithMetricsFile(logConfig.MetricsFile).
+		WithContentAwareSync(logConfig.ContentAware).
+		WithForce(logConfig.Force).
+		WithAllowEmptyCommit(logConfig.AllowEmptyCommit).
+		WithPRStagger(logConfig.Stagger, logConfig.StaggerJitter).
+		WithTempDir(logConfig.TempDir).
+		WithKeepTemp(logConfig.KeepTemp, logConfig.KeepTempOnFail).
+		WithMaxPRs(logConfig.MaxPRs).
+		WithDryRunPlanFile(logConfig.DryRunOutput).
+		WithCheckpointFile(logConfig.CheckpointFile).
+		WithPlanOnly(logConfig.PlanOnly).
+		WithNoPR(logConfig.NoPR).
+		WithWaitForChecks(logConfig.WaitForChecks, logConfig.ChecksTimeout).
+		WithSummaryOnly(logConfig.SummaryOnly).
+		WithExplain(logConfig.Explain).
+		WithTargetLock(logConfig.LockDir, logConfig.LockRef, logConfig.LockTTL).
+		WithCloseSupersededPRs(logConfig.CloseSuperseded).
+		WithInteractive(logConfig.Interactive).
+		WithBinaryTransformPolicy(logConfig.BinaryTransformPolicy).
+		WithTargetTimeout(logConfig.TargetTimeout).
+		WithOperationTimeouts(sync.OperationTimeouts{Clone: logConfig.CloneTimeout, Push: logConfig.PushTimeout, PRCreate: logConfig.PRTimeout}).
+		WithSourceStatusIssue(logConfig.SourceStatusIssue).
+		WithCommitSigning(commitSigning(cfg).Key, commitSigning(cfg).Format).
+		WithMetadataSecret(secret)
 
 	// Create and return engine
 	engine := sync.NewEngine(ctx, cfg, ghClient, gitClient, stateDiscoverer, transformChain, opts)
@@ -1,31 +1,232 @@
 package cli
 
 import (
+	"context"
+	"runtime"
 	"testing"
+	"time"
 
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/logging"
 )
 
-// TestSyncFlagAccessors covers the thread-safe getAutomerge / getClearModuleCache
-// accessors. Serial because they read package-level flag globals.
+// TestSyncFlagAccessors covers the thread-safe getAutomerge / getClearModuleCache /
+// getFailFast accessors. Serial because they read package-level flag globals.
 func TestSyncFlagAccessors(t *testing.T) { //nolint:paralleltest // mutates package globals
 	syncFlagsMu.Lock()
-	oldAuto, oldClear := automerge, clearModuleCache
-	automerge, clearModuleCache = true, true
+	oldAuto, oldClear, oldFailFast := automerge, clearModuleCache, failFast
+	automerge, clearModuleCache, failFast = true, true, true
 	syncFlagsMu.Unlock()
 	t.Cleanup(func() {
 		syncFlagsMu.Lock()
-		automerge, clearModuleCache = oldAuto, oldClear
+		automerge, clearModuleCache, failFast = oldAuto, oldClear, oldFailFast
 		syncFlagsMu.Unlock()
 	})
 
 	assert.True(t, getAutomerge())
 	assert.True(t, getClearModuleCache())
+	assert.True(t, getFailFast())
 
 	syncFlagsMu.Lock()
-	automerge, clearModuleCache = false, false
+	automerge, clearModuleCache, failFast = false, false, false
 	syncFlagsMu.Unlock()
 
 	assert.False(t, getAutomerge())
 	assert.False(t, getClearModuleCache())
+	assert.False(t, getFailFast())
+}
+
+// TestGetAPIRateLimit covers the --api-rate-limit / --api-burst accessor.
+func TestGetAPIRateLimit(t *testing.T) { //nolint:paralleltest // mutates package globals
+	syncFlagsMu.Lock()
+	oldRate, oldBurst := apiRateLimit, apiBurst
+	syncFlagsMu.Unlock()
+	t.Cleanup(func() {
+		syncFlagsMu.Lock()
+		apiRateLimit, apiBurst = oldRate, oldBurst
+		syncFlagsMu.Unlock()
+	})
+
+	set := func(rate float64, burst int) {
+		syncFlagsMu.Lock()
+		apiRateLimit, apiBurst = rate, burst
+		syncFlagsMu.Unlock()
+	}
+
+	set(0, 1)
+	cfg, err := getAPIRateLimit()
+	require.NoError(t, err)
+	assert.False(t, cfg.Enabled())
+
+	set(12.5, 4)
+	cfg, err = getAPIRateLimit()
+	require.NoError(t, err)
+	assert.Equal(t, gh.RateLimitConfig{RequestsPerSecond: 12.5, Burst: 4}, cfg)
+
+	set(-1, 1)
+	_, err = getAPIRateLimit()
+	require.ErrorIs(t, err, ErrInvalidAPIRateLimit)
+
+	set(1, -1)
+	_, err = getAPIRateLimit()
+	require.ErrorIs(t, err, ErrInvalidAPIRateLimit)
+}
+
+// TestGetConcurrency covers the --concurrency accessor and its NumCPU default.
+func TestGetConcurrency(t *testing.T) { //nolint:paralleltest // mutates package globals
+	syncFlagsMu.Lock()
+	oldConcurrency := concurrency
+	syncFlagsMu.Unlock()
+	t.Cleanup(func() {
+		syncFlagsMu.Lock()
+		concurrency = oldConcurrency
+		syncFlagsMu.Unlock()
+	})
+
+	set := func(n int) {
+		syncFlagsMu.Lock()
+		concurrency = n
+		syncFlagsMu.Unlock()
+	}
+
+	set(0)
+	n, err := getConcurrency()
+	require.NoError(t, err)
+	assert.Equal(t, runtime.NumCPU(), n)
+
+	set(1)
+	n, err = getConcurrency()
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	set(-2)
+	_, err = getConcurrency()
+	require.ErrorIs(t, err, ErrInvalidConcurrency)
+}
+
+// TestCreateSyncEngine_IsolatedFlags verifies the Flags and LogConfig paths
+// validate their own concurrency and rate-limit values rather than the
+// package-level sync flags.
+func TestCreateSyncEngine_IsolatedFlags(t *testing.T) { //nolint:paralleltest // reads package globals
+	ctx := context.Background()
+	cfg := &config.Config{Groups: []config.Group{{
+		Source:  config.SourceConfig{Repo: "org/template", Branch: "master"},
+		Targets: []config.TargetConfig{{Repo: "org/target1"}},
+	}}}
+
+	_, err := createSyncEngineWithFlags(ctx, cfg, &Flags{Concurrency: -1}, logrus.New())
+	require.ErrorIs(t, err, ErrInvalidConcurrency)
+
+	_, err = createSyncEngineWithFlags(ctx, cfg, &Flags{APIRateLimit: -1}, logrus.New())
+	require.ErrorIs(t, err, ErrInvalidAPIRateLimit)
+
+	_, err = createSyncEngineWithLogConfig(ctx, cfg, &LogConfig{Concurrency: -1})
+	require.ErrorIs(t, err, ErrInvalidConcurrency)
+
+	_, err = createSyncEngineWithLogConfig(ctx, cfg, &LogConfig{APIBurst: -1})
+	require.ErrorIs(t, err, ErrInvalidAPIRateLimit)
+}
+
+// TestGetStateCache covers the --state-cache-dir / --state-cache-ttl /
+// --no-state-cache accessor and its environment fallback.
+func TestGetStateCache(t *testing.T) { //nolint:paralleltest // mutates package globals
+	syncFlagsMu.Lock()
+	oldDir, oldTTL, oldNoCache := stateCacheDir, stateCacheTTL, noStateCache
+	syncFlagsMu.Unlock()
+	t.Cleanup(func() {
+		syncFlagsMu.Lock()
+		stateCacheDir, stateCacheTTL, noStateCache = oldDir, oldTTL, oldNoCache
+		syncFlagsMu.Unlock()
+	})
+
+	set := func(dir string, ttl time.Duration, disabled bool) {
+		syncFlagsMu.Lock()
+		stateCacheDir, stateCacheTTL, noStateCache = dir, ttl, disabled
+		syncFlagsMu.Unlock()
+	}
+
+	t.Setenv("GO_BROADCAST_STATE_CACHE_DIR", "")
+	set("", time.Minute, false)
+	dir, _, err := getStateCache()
+	require.NoError(t, err)
+	assert.Empty(t, dir, "cache is disabled by default")
+
+	t.Setenv("GO_BROADCAST_STATE_CACHE_DIR", "/tmp/env-cache")
+	dir, ttl, err := getStateCache()
+	require.NoError(t, err)
+	assert.Equal(t, "/tmp/env-cache", dir)
+	assert.Equal(t, time.Minute, ttl)
+
+	set("/tmp/flag-cache", time.Hour, false)
+	dir, ttl, err = getStateCache()
+	require.NoError(t, err)
+	assert.Equal(t, "/tmp/flag-cache", dir, "flag wins over environment")
+	assert.Equal(t, time.Hour, ttl)
+
+	set("/tmp/flag-cache", time.Hour, true)
+	dir, _, err = getStateCache()
+	require.NoError(t, err)
+	assert.Empty(t, dir, "--no-state-cache disables the cache")
+
+	set("", -time.Second, false)
+	_, _, err = getStateCache()
+	require.ErrorIs(t, err, ErrInvalidStateCacheTTL)
+}
+
+// TestCreateSyncEngine_SameOptionsOnEveryPath verifies the global-flag, Flags
+// and LogConfig constructors turn the same settings into the same options.
+func TestCreateSyncEngine_SameOptionsOnEveryPath(t *testing.T) { //nolint:paralleltest // mutates package globals and the newGHClient seam
+	original := newGHClient
+	t.Cleanup(func() { newGHClient = original })
+	newGHClient = func(context.Context, *logrus.Logger, *logging.LogConfig, ...gh.ClientOption) (gh.Client, error) {
+		return &gh.MockClient{}, nil
+	}
+
+	syncFlagsMu.Lock()
+	oldMethod, oldConcurrency := automergeMethod, concurrency
+	automergeMethod, concurrency = "rebase", 2
+	syncFlagsMu.Unlock()
+	t.Cleanup(func() {
+		syncFlagsMu.Lock()
+		automergeMethod, concurrency = oldMethod, oldConcurrency
+		syncFlagsMu.Unlock()
+	})
+
+	ctx := context.Background()
+	cfg := &config.Config{Groups: []config.Group{{
+		Source:  config.SourceConfig{Repo: "org/template", Branch: "master"},
+		Targets: []config.TargetConfig{{Repo: "org/target1"}},
+	}}}
+
+	fromGlobals, err := createSyncEngine(ctx, cfg)
+	require.NoError(t, err)
+	fromFlags, err := createSyncEngineWithFlags(ctx, cfg, &Flags{
+		AutomergeMethod:       "rebase",
+		Concurrency:           2,
+		PRLabelsMode:          getPRLabelsMode(),
+		BinaryTransformPolicy: getBinaryTransformPolicy(),
+	}, logrus.New())
+	require.NoError(t, err)
+	fromLogConfig, err := createSyncEngineWithLogConfig(ctx, cfg, &LogConfig{
+		AutomergeMethod:       "rebase",
+		Concurrency:           2,
+		PRLabelsMode:          getPRLabelsMode(),
+		BinaryTransformPolicy: getBinaryTransformPolicy(),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "rebase", fromGlobals.Options().AutomergeMethod)
+	assert.Equal(t, fromGlobals.Options(), fromFlags.Options())
+	assert.Equal(t, fromGlobals.Options(), fromLogConfig.Options())
+
+	// An invalid method is rejected on every path before any client is built
+	_, err = createSyncEngineWithLogConfig(ctx, cfg, &LogConfig{AutomergeMethod: "fast-forward"})
+	require.ErrorIs(t, err, config.ErrInvalidAutomergeMethod)
+	_, err = createSyncEngineWithFlags(ctx, cfg, &Flags{AutomergeMethod: "fast-forward"}, logrus.New())
+	require.ErrorIs(t, err, config.ErrInvalidAutomergeMethod)
 }
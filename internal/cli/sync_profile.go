@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/mrz1836/go-broadcast/internal/output"
+	"github.com/mrz1836/go-broadcast/internal/profiling"
+)
+
+// Profile kinds accepted by --profile
+const (
+	profileCPU   = "cpu"
+	profileMem   = "mem"
+	profileTrace = "trace"
+	profileBlock = "block"
+	profileMutex = "mutex"
+)
+
+const (
+	// defaultProfileDir is the --profile-dir used when none is given
+	defaultProfileDir = "profiles"
+
+	// syncProfileSession names the profiling session of a sync run
+	syncProfileSession = "sync"
+)
+
+// validateProfile rejects --profile kinds the profiling suite does not capture
+func validateProfile(kinds []string) error {
+	_, err := syncProfileConfig(kinds)
+	return err
+}
+
+// syncProfileConfig returns the profiling suite configuration capturing
+// kinds. The memory session records a CPU profile and an execution trace of
+// its own, and only one of each can run per process, so with mem they are
+// captured by the memory session instead of the suite.
+func syncProfileConfig(kinds []string) (profiling.ProfileConfig, error) {
+	enabled := make(map[string]bool, len(kinds))
+	for _, kind := range kinds {
+		kind = strings.ToLower(strings.TrimSpace(kind))
+		switch kind {
+		case profileCPU, profileMem, profileTrace, profileBlock, profileMutex:
+			enabled[kind] = true
+		default:
+			return profiling.ProfileConfig{}, fmt.Errorf("%w: %q", ErrInvalidProfile, kind)
+		}
+	}
+
+	return profiling.ProfileConfig{
+		EnableCPU:            enabled[profileCPU] && !enabled[profileMem],
+		EnableMemory:         enabled[profileMem],
+		EnableTrace:          enabled[profileTrace] && !enabled[profileMem],
+		EnableBlock:          enabled[profileBlock],
+		EnableMutex:          enabled[profileMutex],
+		BlockProfileRate:     1,
+		MutexProfileFraction: 1,
+		GenerateReports:      true,
+		ReportFormat:         "text",
+		AutoCleanup:          false, // Profiles of earlier runs belong to the user
+	}, nil
+}
+
+// runProfiled runs fn while capturing the kinds of profiles into dir, then
+// prints the files written. Profiling stops and flushes its files even when fn
+// fails or panics. Profiling that cannot start fails before fn runs; failing to
+// stop only warns, so profiling never fails a sync that succeeded.
+func runProfiled(kinds []string, dir string, fn func() error) error {
+	if len(kinds) == 0 {
+		return fn()
+	}
+	cfg, err := syncProfileConfig(kinds)
+	if err != nil {
+		return err
+	}
+	if dir == "" {
+		dir = defaultProfileDir
+	}
+
+	suite := profiling.NewProfileSuite(dir)
+	suite.Configure(cfg)
+	if err := suite.StartProfiling(syncProfileSession); err != nil {
+		return fmt.Errorf("failed to start profiling: %w", err)
+	}
+
+	defer func() {
+		if err := suite.StopProfiling(); err != nil {
+			output.Warn(fmt.Sprintf("Failed to write profiles: %v", err))
+			return
+		}
+		printProfileFiles(suite, dir, cfg.EnableMemory)
+	}()
+	return fn()
+}
+
+// printProfileFiles lists the files written by the last session of suite and,
+// with memory profiling, by its memory session
+func printProfileFiles(suite *profiling.ProfileSuite, dir string, memory bool) {
+	history := suite.GetSessionHistory()
+	if len(history) == 0 {
+		return
+	}
+	dirs := []string{history[len(history)-1].OutputDir}
+	if memory {
+		dirs = append(dirs, filepath.Join(dir, "memory", syncProfileSession))
+	}
+
+	var files []string
+	for _, root := range dirs {
+		_ = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+			if err == nil && !entry.IsDir() {
+				files = append(files, path)
+			}
+			return nil
+		})
+	}
+	slices.Sort(files)
+
+	output.Info(fmt.Sprintf("Profiles written to %s:", dir))
+	for _, file := range files {
+		output.Plain("  " + file)
+	}
+}
@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errProfiledSync = errors.New("sync failed")
+
+func TestSyncProfileConfig(t *testing.T) {
+	cfg, err := syncProfileConfig([]string{"cpu", " Block "})
+	require.NoError(t, err)
+	assert.True(t, cfg.EnableCPU)
+	assert.False(t, cfg.EnableMemory)
+	assert.False(t, cfg.EnableTrace)
+	assert.True(t, cfg.EnableBlock)
+	assert.False(t, cfg.EnableMutex)
+	assert.False(t, cfg.AutoCleanup)
+
+	// The memory session records the CPU profile and trace itself
+	cfg, err = syncProfileConfig([]string{"cpu", "mem", "trace"})
+	require.NoError(t, err)
+	assert.False(t, cfg.EnableCPU)
+	assert.True(t, cfg.EnableMemory)
+	assert.False(t, cfg.EnableTrace)
+
+	require.NoError(t, validateProfile(nil))
+	require.ErrorIs(t, validateProfile([]string{"cpu", "heap"}), ErrInvalidProfile)
+}
+
+func TestRunProfiled(t *testing.T) {
+	t.Cleanup(func() {
+		runtime.SetBlockProfileRate(0)
+		runtime.SetMutexProfileFraction(0)
+	})
+
+	t.Run("without profiles runs fn only", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "profiles")
+		called := false
+		require.NoError(t, runProfiled(nil, dir, func() error {
+			called = true
+			return nil
+		}))
+		assert.True(t, called)
+		assert.NoDirExists(t, dir)
+	})
+
+	t.Run("profiles are flushed when the sync fails", func(t *testing.T) {
+		dir := t.TempDir()
+		err := runProfiled([]string{"block", "mutex"}, dir, func() error { return errProfiledSync })
+		require.ErrorIs(t, err, errProfiledSync)
+
+		sessions, err := filepath.Glob(filepath.Join(dir, syncProfileSession+"_*"))
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		for _, name := range []string{"block.prof", "mutex.prof", "comprehensive_report.txt"} {
+			assert.FileExists(t, filepath.Join(sessions[0], name))
+		}
+	})
+
+	t.Run("invalid kind fails before the sync", func(t *testing.T) {
+		err := runProfiled([]string{"gpu"}, t.TempDir(), func() error {
+			t.Fatal("sync must not run")
+			return nil
+		})
+		require.ErrorIs(t, err, ErrInvalidProfile)
+	})
+
+	t.Run("unwritable directory fails before the sync", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "file")
+		require.NoError(t, os.WriteFile(file, nil, 0o600))
+		err := runProfiled([]string{"block"}, file, func() error {
+			t.Fatal("sync must not run")
+			return nil
+		})
+		require.Error(t, err)
+	})
+}
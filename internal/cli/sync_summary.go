@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/mrz1836/go-broadcast/internal/output"
+	"github.com/mrz1836/go-broadcast/internal/sync"
+)
+
+// beginSummaryOnly quiets a --summary-only run: info and success messages and
+// file progress are dropped and logger only logs warnings and errors, so the
+// final summary is the only output on stdout. The returned func undoes it.
+func beginSummaryOnly(logger *logrus.Logger, logFormat string) func() {
+	level := logger.GetLevel()
+	if level > logrus.WarnLevel {
+		logger.SetLevel(logrus.WarnLevel)
+	}
+	output.SetQuiet(true)
+	output.SetProgressEnabled(false)
+
+	return func() {
+		logger.SetLevel(level)
+		output.SetQuiet(false)
+		output.SetProgressEnabled(logFormat != "json")
+	}
+}
+
+// syncAndSummarize runs engine.Sync and afterwards prints the targets skipped
+// at the --interactive prompt, the --explain decisions when the engine
+// recorded any and, with summaryOnly, the summary, also when the sync failed
+func syncAndSummarize(ctx context.Context, engine SyncService, targets []string, summaryOnly bool, logFormat string) error {
+	syncErr := engine.Sync(ctx, targets)
+	printInteractiveSkips(engine)
+	if err := printSyncExplanation(engine, logFormat); err != nil && syncErr == nil {
+		syncErr = err
+	}
+	if !summaryOnly {
+		return syncErr
+	}
+	if err := printSyncSummary(engine, logFormat); err != nil && syncErr == nil {
+		return err
+	}
+	return syncErr
+}
+
+// printSyncSummary prints the --summary-only table of the targets engine
+// synced, as JSON when logFormat is "json". Engines that do not report
+// results print nothing.
+func printSyncSummary(engine SyncService, logFormat string) error {
+	reporter, ok := engine.(interface{ SyncResults() []sync.SyncResult })
+	if !ok {
+		return nil
+	}
+
+	rows := summaryRows(reporter.SyncResults())
+	if logFormat == "json" {
+		return output.SummaryJSON(rows)
+	}
+	output.SummaryTable(rows)
+	return nil
+}
+
+// printInteractiveSkips lists the targets the operator did not approve at the
+// --interactive prompt. Engines that do not report them print nothing.
+func printInteractiveSkips(engine SyncService) {
+	reporter, ok := engine.(interface{ InteractiveSkips() []string })
+	if !ok {
+		return
+	}
+	skipped := reporter.InteractiveSkips()
+	if len(skipped) == 0 {
+		return
+	}
+
+	output.Warn(fmt.Sprintf("Skipped %d target(s) at the interactive prompt:", len(skipped)))
+	for _, repo := range skipped {
+		output.Warn("  - " + repo)
+	}
+}
+
+// printSyncExplanation prints the --explain table of why each target was or
+// was not synced, as JSON when logFormat is "json". It prints nothing unless
+// the engine recorded decisions.
+func printSyncExplanation(engine SyncService, logFormat string) error {
+	explainer, ok := engine.(interface{ Explanations() []sync.TargetDecision })
+	if !ok {
+		return nil
+	}
+	decisions := explainer.Explanations()
+	if decisions == nil {
+		return nil
+	}
+
+	rows := make([]output.ExplainRow, 0, len(decisions))
+	for _, decision := range decisions {
+		rows = append(rows, output.ExplainRow{
+			Target:         decision.Target,
+			Group:          decision.Group,
+			Decision:       decision.Decision,
+			Reason:         decision.Reason,
+			SourceCommit:   decision.SourceCommit,
+			LastSyncCommit: decision.LastSyncCommit,
+		})
+	}
+	if logFormat == "json" {
+		return output.ExplainJSON(rows)
+	}
+	output.ExplainTable(rows)
+	return nil
+}
+
+// summaryRows converts sync results to summary rows sorted by target
+func summaryRows(results []sync.SyncResult) []output.SummaryRow {
+	rows := make([]output.SummaryRow, 0, len(results))
+	for _, result := range results {
+		rows = append(rows, output.SummaryRow{
+			Target:       result.Repo,
+			Group:        result.Group,
+			PRNumber:     result.PRNumber,
+			PRURL:        result.PRURL,
+			FilesChanged: len(result.FilesChanged),
+			Status:       summaryStatus(result),
+			Error:        result.Error,
+		})
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].Target != rows[j].Target {
+			return rows[i].Target < rows[j].Target
+		}
+		return rows[i].Group < rows[j].Group
+	})
+	return rows
+}
+
+// summaryStatus maps a target's sync result to its summary status
+func summaryStatus(result sync.SyncResult) string {
+	switch {
+	case result.Status == sync.TargetStatusFailed:
+		return output.SummaryFailed
+	case result.Status == sync.TargetStatusSkipped, result.Status == sync.TargetStatusNoChanges:
+		return output.SummaryUnchanged
+	case result.Status == sync.TargetStatusBranchPushed:
+		return output.SummaryPushed
+	case result.PRAction == sync.PRActionCreated:
+		return output.SummaryCreated
+	case result.PRAction == sync.PRActionUpdated:
+		return output.SummaryUpdated
+	case result.DryRun:
+		return output.SummaryPlanned
+	default:
+		return output.SummaryUpdated
+	}
+}
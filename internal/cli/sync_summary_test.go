@@ -0,0 +1,178 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/output"
+	"github.com/mrz1836/go-broadcast/internal/sync"
+)
+
+var errSummarySyncFailed = errors.New("sync failed")
+
+// resultsReporter is a SyncService that reports fixed sync results
+type resultsReporter struct {
+	results []sync.SyncResult
+	err     error
+}
+
+func (r resultsReporter) Sync(context.Context, []string) error { return r.err }
+
+func (r resultsReporter) SyncResults() []sync.SyncResult { return r.results }
+
+// TestSummaryStatus tests mapping each sync result to its summary status
+func TestSummaryStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		result sync.SyncResult
+		want   string
+	}{
+		{"failed", sync.SyncResult{Status: sync.TargetStatusFailed, PRAction: sync.PRActionUpdated}, output.SummaryFailed},
+		{"up to date", sync.SyncResult{Status: sync.TargetStatusSkipped}, output.SummaryUnchanged},
+		{"no changes", sync.SyncResult{Status: sync.TargetStatusNoChanges}, output.SummaryUnchanged},
+		{"branch pushed", sync.SyncResult{Status: sync.TargetStatusBranchPushed}, output.SummaryPushed},
+		{"PR created", sync.SyncResult{Status: sync.TargetStatusSuccess, PRAction: sync.PRActionCreated}, output.SummaryCreated},
+		{"PR updated", sync.SyncResult{Status: sync.TargetStatusSuccess, PRAction: sync.PRActionUpdated}, output.SummaryUpdated},
+		{"dry run", sync.SyncResult{Status: sync.TargetStatusSuccess, DryRun: true}, output.SummaryPlanned},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, summaryStatus(tt.result))
+		})
+	}
+}
+
+// TestSyncAndSummarize tests that --summary-only prints the table after the sync, even a failed one
+func TestSyncAndSummarize(t *testing.T) {
+	prNumber := 7
+	engine := resultsReporter{
+		results: []sync.SyncResult{
+			{Repo: "org/b", Status: sync.TargetStatusFailed, Error: "clone failed"},
+			{Repo: "org/a", Status: sync.TargetStatusSuccess, PRAction: sync.PRActionCreated, PRNumber: &prNumber, FilesChanged: []string{"a", "b"}},
+		},
+		err: errSummarySyncFailed,
+	}
+
+	t.Run("json", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		require.ErrorIs(t, syncAndSummarize(context.Background(), engine, nil, true, "json"), errSummarySyncFailed)
+
+		var rows []output.SummaryRow
+		require.NoError(t, json.Unmarshal(scope.Stdout.Bytes(), &rows))
+		assert.Equal(t, []output.SummaryRow{
+			{Target: "org/a", PRNumber: &prNumber, FilesChanged: 2, Status: output.SummaryCreated},
+			{Target: "org/b", Status: output.SummaryFailed, Error: "clone failed"},
+		}, rows)
+	})
+
+	t.Run("text", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		require.ErrorIs(t, syncAndSummarize(context.Background(), engine, nil, true, "text"), errSummarySyncFailed)
+		assert.Contains(t, scope.Stdout.String(), "2 targets: 1 created, 1 failed")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		require.ErrorIs(t, syncAndSummarize(context.Background(), engine, nil, false, "text"), errSummarySyncFailed)
+		assert.Empty(t, scope.Stdout.String())
+	})
+}
+
+// explainReporter is a SyncService that reports fixed --explain decisions
+type explainReporter struct {
+	decisions []sync.TargetDecision
+}
+
+func (r explainReporter) Sync(context.Context, []string) error { return nil }
+
+func (r explainReporter) Explanations() []sync.TargetDecision { return r.decisions }
+
+// TestSyncAndSummarize_Explain tests that --explain decisions are printed after the sync
+func TestSyncAndSummarize_Explain(t *testing.T) {
+	engine := explainReporter{decisions: []sync.TargetDecision{
+		{Group: "core", Target: "org/a", Decision: sync.DecisionSkip, Reason: "group is disabled"},
+	}}
+
+	t.Run("json", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		require.NoError(t, syncAndSummarize(context.Background(), engine, nil, false, "json"))
+
+		var rows []output.ExplainRow
+		require.NoError(t, json.Unmarshal(scope.Stdout.Bytes(), &rows))
+		assert.Equal(t, []output.ExplainRow{
+			{Target: "org/a", Group: "core", Decision: output.ExplainSkip, Reason: "group is disabled"},
+		}, rows)
+	})
+
+	t.Run("text", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		require.NoError(t, syncAndSummarize(context.Background(), engine, nil, false, "text"))
+		assert.Contains(t, scope.Stdout.String(), "group is disabled")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		require.NoError(t, syncAndSummarize(context.Background(), explainReporter{}, nil, false, "text"))
+		assert.Empty(t, scope.Stdout.String())
+	})
+}
+
+// TestBeginSummaryOnly tests that --summary-only quiets output and the returned func restores it
+func TestBeginSummaryOnly(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.DebugLevel)
+	scope := output.CaptureOutput()
+	defer scope.Restore()
+
+	restore := beginSummaryOnly(logger, "text")
+	assert.Equal(t, logrus.WarnLevel, logger.GetLevel())
+	output.Info("hidden")
+	assert.Empty(t, scope.Stdout.String())
+
+	restore()
+	assert.Equal(t, logrus.DebugLevel, logger.GetLevel())
+	output.Info("shown")
+	assert.Contains(t, scope.Stdout.String(), "shown")
+
+	logger.SetLevel(logrus.ErrorLevel)
+	beginSummaryOnly(logger, "text")()
+	assert.Equal(t, logrus.ErrorLevel, logger.GetLevel(), "a quieter level is kept")
+}
+
+// interactiveReporter is a SyncService that reports targets skipped at the --interactive prompt
+type interactiveReporter struct {
+	skipped []string
+}
+
+func (r interactiveReporter) Sync(context.Context, []string) error { return nil }
+
+func (r interactiveReporter) InteractiveSkips() []string { return r.skipped }
+
+// TestSyncAndSummarize_InteractiveSkips tests that targets skipped at the prompt are listed after the sync
+func TestSyncAndSummarize_InteractiveSkips(t *testing.T) {
+	scope := output.CaptureOutput()
+	defer scope.Restore()
+
+	require.NoError(t, syncAndSummarize(context.Background(), interactiveReporter{skipped: []string{"org/a", "org/b"}}, nil, false, "text"))
+	captured := scope.Stdout.String() + scope.Stderr.String()
+	assert.Contains(t, captured, "Skipped 2 target(s) at the interactive prompt")
+	assert.Contains(t, captured, "  - org/b")
+}
@@ -34,6 +34,61 @@ func TestSyncCmd(t *testing.T) {
 	assert.NotNil(t, cmd.RunE)
 }
 
+// TestValidateAllowEmptyCommit tests that --allow-empty-commit requires --force
+func TestValidateAllowEmptyCommit(t *testing.T) {
+	require.NoError(t, validateAllowEmptyCommit(false, false))
+	require.NoError(t, validateAllowEmptyCommit(true, false))
+	require.NoError(t, validateAllowEmptyCommit(true, true))
+	require.ErrorIs(t, validateAllowEmptyCommit(false, true), ErrAllowEmptyCommitWithoutForce)
+}
+
+// TestValidateDryRunOutput tests that --dry-run-output requires --dry-run
+func TestValidateDryRunOutput(t *testing.T) {
+	require.NoError(t, validateDryRunOutput(false, ""))
+	require.NoError(t, validateDryRunOutput(true, "plan.json"))
+	require.ErrorIs(t, validateDryRunOutput(false, "plan.json"), ErrDryRunOutputWithoutDryRun)
+}
+
+// TestValidateTargetLock tests that --lock-dir and --lock-ref are exclusive and --lock-ref is a full ref
+func TestValidateTargetLock(t *testing.T) {
+	require.NoError(t, validateTargetLock("", ""))
+	require.NoError(t, validateTargetLock("/tmp/locks", ""))
+	require.NoError(t, validateTargetLock("", "refs/go-broadcast/lock"))
+	require.ErrorIs(t, validateTargetLock("/tmp/locks", "refs/go-broadcast/lock"), ErrLockDirAndRef)
+	require.ErrorIs(t, validateTargetLock("", "go-broadcast/lock"), ErrInvalidLockRef)
+	require.ErrorIs(t, validateTargetLock("", "refs/lock"), ErrInvalidLockRef)
+	require.ErrorIs(t, validateTargetLock("", "refs/go-broadcast/bad lock"), ErrInvalidLockRef)
+}
+
+// planReporter is a SyncService that reports a fixed plan outcome
+type planReporter struct {
+	hasChanges bool
+}
+
+func (p planReporter) Sync(context.Context, []string) error { return nil }
+
+func (p planReporter) PlanHasChanges() bool { return p.hasChanges }
+
+// TestPlanOnlyResult tests the --plan-only exit code for each plan outcome
+func TestPlanOnlyResult(t *testing.T) {
+	require.NoError(t, planOnlyResult(false, planReporter{hasChanges: true}))
+	require.NoError(t, planOnlyResult(true, planReporter{hasChanges: false}))
+
+	err := planOnlyResult(true, planReporter{hasChanges: true})
+	require.ErrorIs(t, err, ErrPlanHasChanges)
+	assert.Equal(t, planOnlyExitChanges, ExitCodeForError(err))
+}
+
+// TestSyncTargets tests combining positional targets with --target values
+func TestSyncTargets(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, syncTargets(nil, nil))
+	assert.Equal(t, []string{"org/a"}, syncTargets([]string{"org/a"}, nil))
+	assert.Equal(t, []string{"org/b"}, syncTargets(nil, []string{"org/b"}))
+	assert.Equal(t, []string{"org/a", "org/b"}, syncTargets([]string{"org/a"}, []string{"org/b", "org/a"}))
+}
+
 // TestRunSync tests the main sync command execution
 func TestRunSync(t *testing.T) {
 	t.Run("ConfigNotFound", func(t *testing.T) {
@@ -252,6 +307,33 @@ func TestLoadConfig(t *testing.T) {
 }
 
 // TestLoadConfigWithFlags tests configuration loading with flags
+func TestCheckConfigFile(t *testing.T) {
+	t.Run("stdin is accepted without a file", func(t *testing.T) {
+		require.NoError(t, checkConfigFile("-"))
+		require.NoError(t, statConfigPath("-"))
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		err := checkConfigFile("/non/existent/file.yml")
+		require.ErrorIs(t, err, ErrConfigFileNotFound)
+		assert.Contains(t, err.Error(), "/non/existent/file.yml")
+		require.Error(t, statConfigPath("/non/existent/file.yml"))
+	})
+
+	t.Run("every merged path is checked", func(t *testing.T) {
+		existing := filepath.Join(t.TempDir(), "base.yaml")
+		require.NoError(t, os.WriteFile(existing, []byte("version: 1\n"), 0o600))
+
+		require.NoError(t, checkConfigFile(existing+",-"))
+		require.NoError(t, statConfigPath(existing+",-"))
+
+		err := checkConfigFile(existing + ",/non/existent/team.yml")
+		require.ErrorIs(t, err, ErrConfigFileNotFound)
+		assert.Contains(t, err.Error(), "/non/existent/team.yml")
+		require.Error(t, statConfigPath(existing+",/non/existent/team.yml"))
+	})
+}
+
 func TestLoadConfigWithFlags(t *testing.T) {
 	logger := logrus.New()
 
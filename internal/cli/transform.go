@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/go-broadcast/internal/output"
+	"github.com/mrz1836/go-broadcast/internal/transform"
+)
+
+// newTransformCmd creates the "transform" command with its subcommands
+func newTransformCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transform",
+		Short: "Inspect the available file transforms",
+		Long: `Inspect the transforms go-broadcast can apply to synced files.
+
+Each transform is a step that can be named in a transform pipeline.`,
+	}
+
+	cmd.AddCommand(newTransformListCmd())
+
+	return cmd
+}
+
+// newTransformListCmd creates the "transform list" command
+func newTransformListCmd() *cobra.Command {
+	var jsonOutput bool
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List every available transform",
+		Long: `List every transform with its pipeline step name, the configuration key that
+turns it on, what it changes, and whether it only touches text files.
+
+Transforms that are not text only rely on binary files being skipped before
+transforms run. The names are the values accepted by transform.pipeline.`,
+		Example: `  # Show the transforms as a table
+  go-broadcast transform list
+
+  # JSON output for scripting
+  go-broadcast transform list --json`,
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return runTransformList(jsonOutput)
+		},
+	}
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+	return cmd
+}
+
+// runTransformList prints the registered transforms as a table or JSON
+func runTransformList(jsonOutput bool) error {
+	transforms := transform.Registered()
+
+	if jsonOutput {
+		encoder := json.NewEncoder(output.Stdout())
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(transforms); err != nil {
+			return fmt.Errorf("failed to write transforms: %w", err)
+		}
+		return nil
+	}
+
+	writer := tabwriter.NewWriter(output.Stdout(), 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "NAME\tCONFIG KEY\tTEXT ONLY\tDESCRIPTION")
+	for _, info := range transforms {
+		textOnly := "no"
+		if info.TextOnly {
+			textOnly = "yes"
+		}
+		_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", info.Name, info.ConfigKey, textOnly, info.Description)
+	}
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("failed to write transforms: %w", err)
+	}
+	return nil
+}
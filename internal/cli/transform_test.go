@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/output"
+	"github.com/mrz1836/go-broadcast/internal/transform"
+)
+
+func TestTransformListCmd(t *testing.T) {
+	t.Run("table", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		cmd := newTransformCmd()
+		cmd.SetArgs([]string{"list"})
+		require.NoError(t, cmd.Execute())
+
+		out := scope.Stdout.String()
+		assert.Contains(t, out, "NAME")
+		assert.Regexp(t, `(?m)^line_endings\s+line_endings\s+yes\s+Normalizes line endings`, out)
+		assert.Regexp(t, `(?m)^repo_name\s+repo_name\s+no\s+`, out)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		cmd := newTransformCmd()
+		cmd.SetArgs([]string{"list", "--json"})
+		require.NoError(t, cmd.Execute())
+
+		var transforms []transform.Info
+		require.NoError(t, json.Unmarshal(scope.Stdout.Bytes(), &transforms))
+		assert.Len(t, transforms, len(transform.Registered()))
+		assert.Equal(t, "conditional_blocks", transforms[0].Name)
+	})
+}
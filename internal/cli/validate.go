@@ -3,7 +3,6 @@ package cli
 import (
 	"context"
 	"fmt"
-	"os"
 	"path/filepath"
 	"strings"
 
@@ -43,7 +42,11 @@ Checks performed:
 
 Configuration Source:
   By default, validates the YAML file specified by --config.
-  Use --from-db to validate configuration from the database instead.`,
+  Use --from-db to validate configuration from the database instead.
+  Use --dir to validate every *.yaml and *.yml file in a directory as a
+  separate configuration (add --recursive for subdirectories). Each file is
+  checked offline and reported as passed or failed; the command exits non-zero
+  if any file fails.`,
 	Example: `  # Basic validation
   go-broadcast validate                     # Validate default config file
   go-broadcast validate --config sync.yaml  # Validate specific file
@@ -62,7 +65,8 @@ Configuration Source:
 
   # Common patterns
   go-broadcast validate --config prod.yaml  # Validate production config
-  find . -name "*.yaml" -exec go-broadcast validate --config {} \;  # Validate multiple files`,
+  go-broadcast validate --dir configs/      # Validate each config in a directory
+  go-broadcast validate --dir configs/ --recursive  # Include nested directories`,
 	Aliases: []string{"v", "check"},
 	RunE:    runValidate,
 }
@@ -72,6 +76,13 @@ func runValidate(cmd *cobra.Command, _ []string) error {
 }
 
 func runValidateWithFlags(flags *Flags, cmd *cobra.Command) error {
+	if dir, _ := cmd.Flags().GetString("dir"); dir != "" {
+		if flags.FromDB {
+			return fmt.Errorf("--dir cannot be used with --from-db") //nolint:err113 // user-facing CLI error
+		}
+		recursive, _ := cmd.Flags().GetBool("recursive")
+		return runValidateDir(dir, recursive)
+	}
 	if flags.FromDB {
 		return runValidateFromDB(flags, cmd)
 	}
@@ -79,17 +90,22 @@ func runValidateWithFlags(flags *Flags, cmd *cobra.Command) error {
 	log := logrus.WithField("command", "validate")
 	configPath := flags.ConfigFile
 
-	output.Info(fmt.Sprintf("Validating configuration file: %s", configPath))
+	if config.IsStdinPath(configPath) {
+		output.Info("Validating configuration from stdin")
+	} else {
+		output.Info(fmt.Sprintf("Validating configuration file: %s", configPath))
 
-	// Check if file exists
-	if _, err := os.Stat(configPath); os.IsNotExist(err) {
-		return fmt.Errorf("%w: %s", ErrConfigFileNotFound, configPath)
-	}
+		// Check if file exists
+		if err := checkConfigFile(configPath); err != nil {
+			return err
+		}
 
-	// Get absolute path for clarity
-	absPath, err := filepath.Abs(configPath)
-	if err == nil {
-		configPath = absPath
+		// Get absolute path for clarity (a merged file list is shown as given)
+		if len(config.SplitPaths(configPath)) == 1 {
+			if absPath, err := filepath.Abs(configPath); err == nil {
+				configPath = absPath
+			}
+		}
 	}
 
 	// Load configuration
@@ -279,7 +295,7 @@ func validateRepositoryAccessibility(ctx context.Context, cfg *config.Config, lo
 	}
 
 	// Try to create GitHub client
-	ghClient, err := newGHClient(ctx, logrus.StandardLogger(), logConfig)
+	ghClient, err := newGHClient(ctx, logrus.StandardLogger(), logConfig, ghAuthOption(logConfig), ghProxyOption(cfg), ghHeaderOption(cfg))
 	if err != nil {
 		if strings.Contains(err.Error(), "gh CLI not found") {
 			output.Error("  ✗ GitHub CLI not found in PATH")
@@ -307,7 +323,7 @@ func validateRepositoryAccessibilityWithClient(ctx context.Context, cfg *config.
 	log := logrus.WithField("component", "validate-repos")
 
 	// Check source repository accessibility
-	groups := cfg.Groups
+	groups := config.ExpandSourceBranches(cfg.Groups)
 	if len(groups) == 0 {
 		output.Error("  ✗ No configuration groups found")
 		return ErrNoConfigGroups
@@ -465,7 +481,7 @@ func checkCircularDependency(groupID string, dependencyMap map[string][]string,
 // validateSourceFilesExist checks if all configured source files exist in the source repository
 func validateSourceFilesExist(ctx context.Context, cfg *config.Config, logConfig *logging.LogConfig) {
 	// Initialize GitHub client (reuse from previous function, but handle errors gracefully)
-	ghClient, err := newGHClient(ctx, logrus.StandardLogger(), logConfig)
+	ghClient, err := newGHClient(ctx, logrus.StandardLogger(), logConfig, ghAuthOption(logConfig), ghProxyOption(cfg), ghHeaderOption(cfg))
 	if err != nil {
 		output.Info("  ⚠ Skipping source file validation (GitHub client unavailable)")
 		return // Don't fail if client can't be created
@@ -481,7 +497,7 @@ func validateSourceFilesExistWithClient(ctx context.Context, cfg *config.Config,
 
 	// Collect all unique source files across all targets
 	sourceFiles := make(map[string]bool)
-	groups := cfg.Groups
+	groups := config.ExpandSourceBranches(cfg.Groups)
 	if len(groups) == 0 {
 		output.Info("  ⚠ No configuration groups found")
 		return
@@ -489,8 +505,8 @@ func validateSourceFilesExistWithClient(ctx context.Context, cfg *config.Config,
 	group := groups[0] // For compatibility with old format, work with first group
 	for _, target := range group.Targets {
 		for _, file := range target.Files {
-			if file.Delete || file.Src == "" {
-				continue // Skip delete-only mappings that have no source
+			if file.Delete || file.Src == "" || file.IsGlob() {
+				continue // Skip delete-only mappings that have no source, and globs expanded at sync time
 			}
 			sourceFiles[file.Src] = true
 		}
@@ -535,4 +551,6 @@ func validateSourceFilesExistWithClient(ctx context.Context, cfg *config.Config,
 func init() {
 	validateCmd.Flags().Bool("skip-remote-checks", false, "Skip GitHub and Git repository checks (offline validation)")
 	validateCmd.Flags().Bool("source-only", false, "Only validate source repository access (skip target repositories)")
+	validateCmd.Flags().String("dir", "", "Validate every *.yaml and *.yml file in this directory as a separate configuration")
+	validateCmd.Flags().Bool("recursive", false, "With --dir, also validate files in subdirectories")
 }
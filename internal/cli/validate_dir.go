@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/output"
+)
+
+// runValidateDir validates every *.yaml and *.yml file in dir as a standalone
+// configuration, descending into subdirectories when recursive is set. Each
+// file is loaded and validated offline like validate --skip-remote-checks; the
+// command fails when any file is invalid or none is found.
+func runValidateDir(dir string, recursive bool) error {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrConfigFileNotFound, dir)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%w: %s", ErrValidateDirNotDirectory, dir)
+	}
+
+	paths, err := findConfigFiles(dir, recursive)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return fmt.Errorf("%w in %s", ErrNoConfigFiles, dir)
+	}
+
+	output.Info(fmt.Sprintf("Validating %d configuration file(s) in %s", len(paths), dir))
+	output.Info("")
+
+	failed := 0
+	for _, path := range paths {
+		if err := validateConfigFile(path); err != nil {
+			failed++
+			output.Error(fmt.Sprintf("  ✗ %s: %v", path, err))
+			continue
+		}
+		output.Success("  ✓ " + path)
+	}
+
+	output.Info("")
+	if failed > 0 {
+		output.Error(fmt.Sprintf("%d of %d configuration file(s) failed validation", failed, len(paths)))
+		return fmt.Errorf("%w: %d of %d", ErrConfigFilesInvalid, failed, len(paths))
+	}
+	output.Success(fmt.Sprintf("All %d configuration file(s) are valid", len(paths)))
+	return nil
+}
+
+// findConfigFiles returns the *.yaml and *.yml files in dir, and in its
+// subdirectories when recursive is set, in lexical order
+func findConfigFiles(dir string, recursive bool) ([]string, error) {
+	var paths []string
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if path != dir && !recursive {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if ext := strings.ToLower(filepath.Ext(path)); ext == ".yaml" || ext == ".yml" {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+	slices.Sort(paths)
+	return paths, nil
+}
+
+// validateConfigFile loads and validates a single configuration file
+func validateConfigFile(path string) error {
+	cfg, err := config.Load(path)
+	if err != nil {
+		return err
+	}
+	return cfg.ValidateWithLogging(context.Background(), nil)
+}
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/output"
+)
+
+const validDirConfig = `version: 1
+groups:
+  - name: "test-group"
+    id: "test-group-1"
+    source:
+      repo: org/template
+      branch: main
+    targets:
+      - repo: org/target1
+        files:
+          - src: README.md
+            dest: README.md
+`
+
+// newValidateDirCmd returns a command with the validate --dir flags set
+func newValidateDirCmd(t *testing.T, dir string, recursive bool) *cobra.Command {
+	t.Helper()
+	cmd := &cobra.Command{}
+	cmd.Flags().String("dir", "", "")
+	cmd.Flags().Bool("recursive", false, "")
+	require.NoError(t, cmd.Flags().Set("dir", dir))
+	if recursive {
+		require.NoError(t, cmd.Flags().Set("recursive", "true"))
+	}
+	return cmd
+}
+
+func TestRunValidateDir(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+	writeFile("a.yaml", validDirConfig)
+	writeFile("b.yml", validDirConfig)
+	writeFile("notes.txt", "not a config")
+	writeFile("nested/broken.yaml", "version: 1\ngroups: [")
+
+	t.Run("top level only by default", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		err := runValidateWithFlags(&Flags{}, newValidateDirCmd(t, dir, false))
+		scope.Restore()
+
+		require.NoError(t, err)
+		captured := scope.Stdout.String() + scope.Stderr.String()
+		assert.Contains(t, captured, "✓ "+filepath.Join(dir, "a.yaml"))
+		assert.Contains(t, captured, "✓ "+filepath.Join(dir, "b.yml"))
+		assert.Contains(t, captured, "All 2 configuration file(s) are valid")
+		assert.NotContains(t, captured, "notes.txt")
+		assert.NotContains(t, captured, "broken.yaml")
+	})
+
+	t.Run("recursive reports every file and fails on an invalid one", func(t *testing.T) {
+		scope := output.CaptureOutput()
+		err := runValidateWithFlags(&Flags{}, newValidateDirCmd(t, dir, true))
+		scope.Restore()
+
+		require.ErrorIs(t, err, ErrConfigFilesInvalid)
+		captured := scope.Stdout.String() + scope.Stderr.String()
+		assert.Contains(t, captured, "✓ "+filepath.Join(dir, "a.yaml"))
+		assert.Contains(t, captured, "✗ "+filepath.Join(dir, "nested", "broken.yaml"))
+		assert.Contains(t, captured, "1 of 3 configuration file(s) failed validation")
+	})
+
+	t.Run("empty directory", func(t *testing.T) {
+		err := runValidateWithFlags(&Flags{}, newValidateDirCmd(t, t.TempDir(), false))
+		require.ErrorIs(t, err, ErrNoConfigFiles)
+	})
+
+	t.Run("not a directory", func(t *testing.T) {
+		err := runValidateWithFlags(&Flags{}, newValidateDirCmd(t, filepath.Join(dir, "a.yaml"), false))
+		require.ErrorIs(t, err, ErrValidateDirNotDirectory)
+	})
+
+	t.Run("conflicts with from-db", func(t *testing.T) {
+		err := runValidateWithFlags(&Flags{FromDB: true}, newValidateDirCmd(t, dir, false))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--from-db")
+	})
+}
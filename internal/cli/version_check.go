@@ -0,0 +1,243 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/go-broadcast/internal/cache"
+	"github.com/mrz1836/go-broadcast/internal/output"
+	versionpkg "github.com/mrz1836/go-broadcast/internal/version"
+)
+
+const (
+	// releaseOwner and releaseRepo identify the repository checked for new releases
+	releaseOwner = "mrz1836"
+	releaseRepo  = "go-broadcast"
+
+	// versionCheckTTL is how long a latest-release lookup is reused
+	versionCheckTTL = time.Hour
+
+	// versionCheckTimeout bounds the release lookup so an unreachable API
+	// never stalls the version command
+	versionCheckTimeout = 5 * time.Second
+
+	// releaseCacheFileName is the file under the user cache directory that
+	// persists the last successful release lookup between invocations
+	releaseCacheFileName = "latest-release.json"
+)
+
+//nolint:gochecknoglobals // Shared release lookup cache, created on first use
+var (
+	releaseCacheOnce sync.Once
+	releaseCache     *cache.TTLCache
+)
+
+// releaseCachePath returns the persisted release cache file location
+var releaseCachePath = defaultReleaseCachePath //nolint:gochecknoglobals // test seam
+
+// getReleaseCache returns the process-wide, in-memory latest-release cache.
+// It sits in front of the persisted cache file read by loadLatestRelease.
+func getReleaseCache() *cache.TTLCache {
+	releaseCacheOnce.Do(func() {
+		releaseCache = cache.NewTTLCache(versionCheckTTL, 1)
+	})
+	return releaseCache
+}
+
+// cachedRelease is the on-disk form of a release lookup
+type cachedRelease struct {
+	CheckedAt time.Time                 `json:"checked_at"`
+	Release   *versionpkg.GitHubRelease `json:"release"`
+}
+
+// defaultReleaseCachePath returns <user cache dir>/go-broadcast/latest-release.json
+func defaultReleaseCachePath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "go-broadcast", releaseCacheFileName), nil
+}
+
+// VersionCheckResult reports whether a newer release is available
+type VersionCheckResult struct {
+	Current         string `json:"current"`
+	Latest          string `json:"latest,omitempty"`
+	UpdateAvailable bool   `json:"update_available"`
+	ReleaseURL      string `json:"release_url,omitempty"`
+	Error           string `json:"error,omitempty"` // Set when the lookup failed (offline, rate limited, ...)
+}
+
+// versionWithCheck is the JSON output of "version --check"
+type versionWithCheck struct {
+	VersionInfo
+
+	Check VersionCheckResult `json:"check"`
+}
+
+// newVersionCmd creates the version command
+func newVersionCmd() *cobra.Command {
+	var (
+		check      bool
+		jsonFormat bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "version",
+		Short: "Show version information",
+		Long: `Show go-broadcast build information.
+
+With --check, also query the latest GitHub release and report whether a newer
+version is available. Lookups are cached for an hour; if the release cannot be
+fetched (offline, rate limited) only the local version is shown.`,
+		Example: `  # Show build information
+  go-broadcast version
+
+  # Check for a newer release
+  go-broadcast version --check
+
+  # Machine-readable output
+  go-broadcast version --check --json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if !check {
+				return printVersion(jsonFormat)
+			}
+			return printVersionWithCheck(cmd.Context(), jsonFormat)
+		},
+	}
+
+	cmd.Flags().BoolVar(&check, "check", false, "Check GitHub for a newer release")
+	cmd.Flags().BoolVar(&jsonFormat, "json", false, "Output version information in JSON format")
+
+	return cmd
+}
+
+// printVersionWithCheck prints version information followed by the update check
+func printVersionWithCheck(ctx context.Context, jsonFormat bool) error {
+	result := checkLatestVersion(ctx, GetCurrentVersion())
+
+	if jsonFormat {
+		encoder := json.NewEncoder(output.Stdout())
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(versionWithCheck{VersionInfo: GetVersionInfo(), Check: result})
+	}
+
+	if err := printVersion(false); err != nil {
+		return err
+	}
+
+	switch {
+	case result.Error != "":
+		output.Warn(fmt.Sprintf("Could not check for updates: %s", result.Error))
+	case result.UpdateAvailable:
+		output.Warn(fmt.Sprintf("A newer version is available: %s → %s", formatVersion(result.Current), formatVersion(result.Latest)))
+		output.Info(fmt.Sprintf("Release:    %s", result.ReleaseURL))
+		output.Info("Run 'go-broadcast upgrade' to upgrade")
+	default:
+		output.Success(fmt.Sprintf("You are on the latest version (%s)", formatVersion(result.Latest)))
+	}
+
+	return nil
+}
+
+// checkLatestVersion compares current against the latest GitHub release.
+// Lookup failures are reported in the result instead of returned, so callers
+// can always fall back to printing the local version.
+func checkLatestVersion(ctx context.Context, current string) VersionCheckResult {
+	result := VersionCheckResult{Current: current}
+
+	release, err := fetchLatestRelease(ctx)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Latest = strings.TrimPrefix(release.TagName, "v")
+	result.UpdateAvailable = versionpkg.IsNewerVersion(current, result.Latest)
+	result.ReleaseURL = release.HTMLURL
+	if result.ReleaseURL == "" {
+		result.ReleaseURL = fmt.Sprintf("https://github.com/%s/%s/releases/tag/%s", releaseOwner, releaseRepo, release.TagName)
+	}
+
+	return result
+}
+
+// fetchLatestRelease returns the latest release, reusing a cached lookup for
+// up to versionCheckTTL. Failed lookups are not cached.
+func fetchLatestRelease(ctx context.Context) (*versionpkg.GitHubRelease, error) {
+	val, err := getReleaseCache().GetOrLoad(releaseOwner+"/"+releaseRepo, func() (interface{}, error) {
+		return loadLatestRelease(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	release, ok := val.(*versionpkg.GitHubRelease)
+	if !ok || release == nil {
+		return nil, versionpkg.ErrGitHubAPIFailed
+	}
+	return release, nil
+}
+
+// loadLatestRelease returns the persisted release when it is younger than
+// versionCheckTTL, otherwise queries GitHub and persists the result. Cache file
+// problems are never fatal; they only cost an extra lookup.
+func loadLatestRelease(ctx context.Context) (*versionpkg.GitHubRelease, error) {
+	path, pathErr := releaseCachePath()
+	if pathErr == nil {
+		if release := readReleaseCacheFile(path); release != nil {
+			return release, nil
+		}
+	}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, versionCheckTimeout)
+	defer cancel()
+	release, err := getLatestRelease(lookupCtx, releaseOwner, releaseRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	if pathErr == nil && release != nil {
+		writeReleaseCacheFile(path, release)
+	}
+	return release, nil
+}
+
+// readReleaseCacheFile returns the release stored at path, or nil when the
+// file is missing, unreadable, or older than versionCheckTTL
+func readReleaseCacheFile(path string) *versionpkg.GitHubRelease {
+	data, err := os.ReadFile(path) //nolint:gosec // path is derived from the user cache dir
+	if err != nil {
+		return nil
+	}
+
+	var cached cachedRelease
+	if err := json.Unmarshal(data, &cached); err != nil || cached.Release == nil {
+		return nil
+	}
+	if age := time.Since(cached.CheckedAt); age < 0 || age >= versionCheckTTL {
+		return nil
+	}
+	return cached.Release
+}
+
+// writeReleaseCacheFile persists release with the current time, ignoring errors
+func writeReleaseCacheFile(path string, release *versionpkg.GitHubRelease) {
+	data, err := json.Marshal(cachedRelease{CheckedAt: time.Now(), Release: release})
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return
+	}
+	_ = os.WriteFile(path, data, 0o600)
+}
@@ -0,0 +1,233 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/output"
+	versionpkg "github.com/mrz1836/go-broadcast/internal/version"
+)
+
+// resetReleaseCache clears cached release lookups before and after the test
+// and points the persisted cache at a fresh temp file.
+// Tests using it must NOT call t.Parallel(); the cache and seams are package globals.
+func resetReleaseCache(t *testing.T) string {
+	t.Helper()
+	getReleaseCache().Clear()
+	t.Cleanup(getReleaseCache().Clear)
+
+	path := filepath.Join(t.TempDir(), "go-broadcast", releaseCacheFileName)
+	prev := releaseCachePath
+	releaseCachePath = func() (string, error) { return path, nil }
+	t.Cleanup(func() { releaseCachePath = prev })
+	return path
+}
+
+func TestCheckLatestVersion(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("newer release available", func(t *testing.T) {
+		resetReleaseCache(t)
+		swapGetLatestRelease(t, func(_ context.Context, owner, repo string) (*versionpkg.GitHubRelease, error) {
+			assert.Equal(t, "mrz1836", owner)
+			assert.Equal(t, "go-broadcast", repo)
+			return &versionpkg.GitHubRelease{TagName: "v1.5.0", HTMLURL: "https://github.com/mrz1836/go-broadcast/releases/tag/v1.5.0"}, nil
+		})
+
+		result := checkLatestVersion(ctx, "1.2.0")
+		assert.Equal(t, VersionCheckResult{
+			Current:         "1.2.0",
+			Latest:          "1.5.0",
+			UpdateAvailable: true,
+			ReleaseURL:      "https://github.com/mrz1836/go-broadcast/releases/tag/v1.5.0",
+		}, result)
+	})
+
+	t.Run("already on latest", func(t *testing.T) {
+		resetReleaseCache(t)
+		swapGetLatestRelease(t, func(_ context.Context, _, _ string) (*versionpkg.GitHubRelease, error) {
+			return &versionpkg.GitHubRelease{TagName: "v1.2.0"}, nil
+		})
+
+		result := checkLatestVersion(ctx, "1.2.0")
+		assert.False(t, result.UpdateAvailable)
+		assert.Empty(t, result.Error)
+		assert.Equal(t, "https://github.com/mrz1836/go-broadcast/releases/tag/v1.2.0", result.ReleaseURL, "falls back to the tag URL")
+	})
+
+	t.Run("lookup failure is reported, not returned", func(t *testing.T) {
+		resetReleaseCache(t)
+		swapGetLatestRelease(t, func(_ context.Context, _, _ string) (*versionpkg.GitHubRelease, error) {
+			return nil, errTestRelease
+		})
+
+		result := checkLatestVersion(ctx, "1.2.0")
+		assert.Equal(t, "1.2.0", result.Current)
+		assert.Empty(t, result.Latest)
+		assert.False(t, result.UpdateAvailable)
+		assert.Equal(t, errTestRelease.Error(), result.Error)
+	})
+}
+
+func TestFetchLatestRelease_Cached(t *testing.T) {
+	resetReleaseCache(t)
+
+	calls := 0
+	swapGetLatestRelease(t, func(_ context.Context, _, _ string) (*versionpkg.GitHubRelease, error) {
+		calls++
+		if calls == 1 {
+			return nil, errTestRelease
+		}
+		return &versionpkg.GitHubRelease{TagName: "v2.0.0"}, nil
+	})
+
+	// Failures are not cached
+	_, err := fetchLatestRelease(context.Background())
+	require.ErrorIs(t, err, errTestRelease)
+
+	for i := 0; i < 3; i++ {
+		release, fetchErr := fetchLatestRelease(context.Background())
+		require.NoError(t, fetchErr)
+		assert.Equal(t, "v2.0.0", release.TagName)
+	}
+	assert.Equal(t, 2, calls, "successful lookup should be served from cache")
+}
+
+func TestFetchLatestRelease_PersistedCache(t *testing.T) {
+	t.Run("successful lookup is reused by a new process", func(t *testing.T) {
+		path := resetReleaseCache(t)
+
+		calls := 0
+		swapGetLatestRelease(t, func(_ context.Context, _, _ string) (*versionpkg.GitHubRelease, error) {
+			calls++
+			return &versionpkg.GitHubRelease{TagName: "v2.1.0"}, nil
+		})
+
+		_, err := fetchLatestRelease(context.Background())
+		require.NoError(t, err)
+		require.FileExists(t, path)
+
+		// Simulate a new invocation: the in-memory layer starts empty
+		getReleaseCache().Clear()
+		release, err := fetchLatestRelease(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "v2.1.0", release.TagName)
+		assert.Equal(t, 1, calls, "persisted lookup should be served from disk")
+	})
+
+	t.Run("expired file triggers a lookup", func(t *testing.T) {
+		path := resetReleaseCache(t)
+
+		stale, err := json.Marshal(cachedRelease{
+			CheckedAt: time.Now().Add(-2 * versionCheckTTL),
+			Release:   &versionpkg.GitHubRelease{TagName: "v1.0.0"},
+		})
+		require.NoError(t, err)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, stale, 0o600))
+
+		swapGetLatestRelease(t, func(_ context.Context, _, _ string) (*versionpkg.GitHubRelease, error) {
+			return &versionpkg.GitHubRelease{TagName: "v3.0.0"}, nil
+		})
+
+		release, err := fetchLatestRelease(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "v3.0.0", release.TagName)
+	})
+
+	t.Run("unreadable file falls back to lookup", func(t *testing.T) {
+		path := resetReleaseCache(t)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, []byte("not json"), 0o600))
+
+		swapGetLatestRelease(t, func(_ context.Context, _, _ string) (*versionpkg.GitHubRelease, error) {
+			return &versionpkg.GitHubRelease{TagName: "v3.1.0"}, nil
+		})
+
+		release, err := fetchLatestRelease(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "v3.1.0", release.TagName)
+	})
+}
+
+func TestVersionCmd_Check(t *testing.T) {
+	swapCurrentVersion(t, "1.0.0")
+
+	t.Run("text output reports update", func(t *testing.T) {
+		resetReleaseCache(t)
+		swapGetLatestRelease(t, func(_ context.Context, _, _ string) (*versionpkg.GitHubRelease, error) {
+			return &versionpkg.GitHubRelease{TagName: "v1.1.0", HTMLURL: "https://example.com/release"}, nil
+		})
+
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		cmd := newVersionCmd()
+		cmd.SetArgs([]string{"--check"})
+		require.NoError(t, cmd.Execute())
+
+		assert.Contains(t, scope.Stdout.String(), "go-broadcast 1.0.0")
+		assert.Contains(t, scope.Stdout.String(), "https://example.com/release")
+		assert.Contains(t, scope.Stderr.String(), "A newer version is available: v1.0.0 → v1.1.0")
+	})
+
+	t.Run("offline degrades to local version", func(t *testing.T) {
+		resetReleaseCache(t)
+		swapGetLatestRelease(t, func(_ context.Context, _, _ string) (*versionpkg.GitHubRelease, error) {
+			return nil, errTestRelease
+		})
+
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		cmd := newVersionCmd()
+		cmd.SetArgs([]string{"--check"})
+		require.NoError(t, cmd.Execute())
+
+		assert.Contains(t, scope.Stdout.String(), "go-broadcast 1.0.0")
+		assert.Contains(t, scope.Stderr.String(), "Could not check for updates")
+	})
+
+	t.Run("json output", func(t *testing.T) {
+		resetReleaseCache(t)
+		swapGetLatestRelease(t, func(_ context.Context, _, _ string) (*versionpkg.GitHubRelease, error) {
+			return &versionpkg.GitHubRelease{TagName: "v1.1.0"}, nil
+		})
+
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		cmd := newVersionCmd()
+		cmd.SetArgs([]string{"--check", "--json"})
+		require.NoError(t, cmd.Execute())
+
+		var got versionWithCheck
+		require.NoError(t, json.Unmarshal(scope.Stdout.Bytes(), &got))
+		assert.Equal(t, "1.0.0", got.Version)
+		assert.True(t, got.Check.UpdateAvailable)
+		assert.Equal(t, "1.1.0", got.Check.Latest)
+	})
+
+	t.Run("without --check makes no lookup", func(t *testing.T) {
+		resetReleaseCache(t)
+		swapGetLatestRelease(t, func(_ context.Context, _, _ string) (*versionpkg.GitHubRelease, error) {
+			t.Fatal("release lookup should not run without --check")
+			return nil, nil //nolint:nilnil // unreachable after t.Fatal
+		})
+
+		scope := output.CaptureOutput()
+		defer scope.Restore()
+
+		cmd := newVersionCmd()
+		cmd.SetArgs([]string{})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, scope.Stdout.String(), "go-broadcast 1.0.0")
+	})
+}
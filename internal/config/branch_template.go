@@ -0,0 +1,66 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/mrz1836/go-broadcast/internal/validation"
+)
+
+// BranchNameData holds the variables a branch_name_template can use
+type BranchNameData struct {
+	Prefix       string // Branch prefix (defaults.branch_prefix, default chore/sync-files)
+	GroupID      string // ID of the group being synced
+	SourceCommit string // Short (7 character) source commit SHA
+	Date         string // Sync date, YYYYMMDD
+	Time         string // Sync time, HHMMSS
+	Timestamp    string // Sync date and time, YYYYMMDD-HHMMSS
+	TargetRepo   string // Target repository, org/repo
+	TargetName   string // Target repository name without the org
+}
+
+// sampleBranchNameData is rendered when validating templates, so that a
+// template producing an invalid git ref is rejected before any sync runs
+//
+//nolint:gochecknoglobals // Read-only validation sample
+var sampleBranchNameData = BranchNameData{
+	Prefix:       "chore/sync-files",
+	GroupID:      "default",
+	SourceCommit: "abc1234",
+	Date:         "20250102",
+	Time:         "150405",
+	Timestamp:    "20250102-150405",
+	TargetRepo:   "org/repo",
+	TargetName:   "repo",
+}
+
+// RenderBranchName renders a branch_name_template with data and checks the
+// result is a valid branch name
+func RenderBranchName(tmpl string, data BranchNameData) (string, error) {
+	parsed, err := template.New("branch_name_template").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidBranchNameTemplate, err)
+	}
+
+	var name strings.Builder
+	if err := parsed.Execute(&name, data); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidBranchNameTemplate, err)
+	}
+	if err := validation.ValidateBranchName(name.String()); err != nil {
+		return "", fmt.Errorf("%w: %q renders %q: %w", ErrInvalidBranchNameTemplate, tmpl, name.String(), err)
+	}
+	return name.String(), nil
+}
+
+// validateBranchNameTemplate checks that a non-empty template renders a valid
+// branch name
+func validateBranchNameTemplate(field, tmpl string) error {
+	if tmpl == "" {
+		return nil
+	}
+	if _, err := RenderBranchName(tmpl, sampleBranchNameData); err != nil {
+		return fmt.Errorf("%s: %w", field, err)
+	}
+	return nil
+}
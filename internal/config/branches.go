@@ -0,0 +1,99 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// groupIDUnsafeChars matches characters that cannot appear in the group ID part
+// of a sync branch name
+var groupIDUnsafeChars = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// SourceBranchGroupID returns the ID of the group expanded from groupID for a
+// source branch, e.g. "ci" and "release/v2" give "ci-release-v2". The ID is
+// part of every sync branch name, so each source branch gets its own target
+// branches and pull requests.
+func SourceBranchGroupID(groupID, branch string) string {
+	slug := strings.Trim(groupIDUnsafeChars.ReplaceAllString(branch, "-"), "-")
+	return groupID + "-" + slug
+}
+
+// ConfiguredID returns the group ID as written in the configuration, which for
+// a group expanded from a multi-branch source is the multi-branch group's ID
+func (g Group) ConfiguredID() string {
+	if g.ExpandedFrom != "" {
+		return g.ExpandedFrom
+	}
+	return g.ID
+}
+
+// HasSourceBranches reports whether any group sets source.branches
+func HasSourceBranches(groups []Group) bool {
+	for _, group := range groups {
+		if len(group.Source.Branches) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpandSourceBranches returns groups with every multi-branch group (one that
+// sets source.branches) replaced by one group per source branch. The expanded
+// groups keep their position and priority, get IDs from SourceBranchGroupID
+// and record the original ID in ExpandedFrom. Dependencies on a multi-branch
+// group become dependencies on all of its expansions. groups is returned
+// unchanged when HasSourceBranches is false.
+func ExpandSourceBranches(groups []Group) []Group {
+	if !HasSourceBranches(groups) {
+		return groups
+	}
+
+	expansions := make(map[string][]string)
+	for _, group := range groups {
+		if len(group.Source.Branches) == 0 {
+			continue
+		}
+		ids := make([]string, 0, len(group.Source.Branches))
+		for _, branch := range group.Source.Branches {
+			ids = append(ids, SourceBranchGroupID(group.ID, branch))
+		}
+		expansions[group.ID] = ids
+	}
+
+	expanded := make([]Group, 0, len(groups))
+	for _, group := range groups {
+		group.DependsOn = expandDependencies(group.DependsOn, expansions)
+
+		if len(group.Source.Branches) == 0 {
+			expanded = append(expanded, group)
+			continue
+		}
+		for _, branch := range group.Source.Branches {
+			branchGroup := group
+			branchGroup.ID = SourceBranchGroupID(group.ID, branch)
+			branchGroup.Name = fmt.Sprintf("%s (%s)", group.Name, branch)
+			branchGroup.Source.Branch = branch
+			branchGroup.Source.Branches = nil
+			branchGroup.ExpandedFrom = group.ID
+			expanded = append(expanded, branchGroup)
+		}
+	}
+	return expanded
+}
+
+// expandDependencies replaces dependencies on multi-branch groups with their expansions
+func expandDependencies(dependsOn []string, expansions map[string][]string) []string {
+	if len(dependsOn) == 0 {
+		return dependsOn
+	}
+	result := make([]string, 0, len(dependsOn))
+	for _, dep := range dependsOn {
+		if ids, ok := expansions[dep]; ok {
+			result = append(result, ids...)
+			continue
+		}
+		result = append(result, dep)
+	}
+	return result
+}
@@ -0,0 +1,106 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourceBranchGroupID(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "ci-main", SourceBranchGroupID("ci", "main"))
+	assert.Equal(t, "ci-release-v2", SourceBranchGroupID("ci", "release/v2"))
+	assert.Equal(t, "ci-v1_0-x", SourceBranchGroupID("ci", "v1_0.x"))
+}
+
+func TestExpandSourceBranches(t *testing.T) {
+	t.Parallel()
+
+	groups := []Group{
+		{
+			Name:    "CI",
+			ID:      "ci",
+			Source:  SourceConfig{Repo: "org/templates", Branches: []string{"main", "release/v2"}},
+			Targets: []TargetConfig{{Repo: "org/service"}},
+		},
+		{
+			Name:      "Docs",
+			ID:        "docs",
+			DependsOn: []string{"ci", "other"},
+			Source:    SourceConfig{Repo: "org/templates", Branch: "main"},
+		},
+	}
+
+	expanded := ExpandSourceBranches(groups)
+	require.Len(t, expanded, 3)
+
+	assert.Equal(t, "ci-main", expanded[0].ID)
+	assert.Equal(t, "CI (main)", expanded[0].Name)
+	assert.Equal(t, "main", expanded[0].Source.Branch)
+	assert.Empty(t, expanded[0].Source.Branches)
+	assert.Equal(t, "ci", expanded[0].ExpandedFrom)
+	assert.Equal(t, "ci", expanded[0].ConfiguredID())
+
+	assert.Equal(t, "ci-release-v2", expanded[1].ID)
+	assert.Equal(t, "release/v2", expanded[1].Source.Branch)
+	assert.Equal(t, []TargetConfig{{Repo: "org/service"}}, expanded[1].Targets)
+
+	assert.Equal(t, "docs", expanded[2].ID)
+	assert.Empty(t, expanded[2].ExpandedFrom)
+	assert.Equal(t, "docs", expanded[2].ConfiguredID())
+	assert.Equal(t, []string{"ci-main", "ci-release-v2", "other"}, expanded[2].DependsOn)
+
+	// The input is not modified
+	assert.Equal(t, []string{"main", "release/v2"}, groups[0].Source.Branches)
+	assert.Equal(t, []string{"ci", "other"}, groups[1].DependsOn)
+
+	single := []Group{{ID: "docs", Source: SourceConfig{Branch: "main"}}}
+	assert.False(t, HasSourceBranches(single))
+	assert.Equal(t, single, ExpandSourceBranches(single))
+}
+
+func TestValidate_SourceBranches(t *testing.T) {
+	newConfig := func(source SourceConfig) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:    "test",
+				ID:      "test",
+				Source:  source,
+				Targets: []TargetConfig{{Repo: "org/target", Files: []FileMapping{{Src: "a", Dest: "a"}}}},
+			}},
+		}
+	}
+
+	require.NoError(t, newConfig(SourceConfig{Repo: "org/source", Branches: []string{"main", "release"}}).Validate())
+	require.ErrorIs(t, newConfig(SourceConfig{Repo: "org/source", Branch: "main", Branches: []string{"release"}}).Validate(),
+		ErrSourceBranchConflict)
+	require.ErrorIs(t, newConfig(SourceConfig{Repo: "org/source", Branches: []string{"main", "main"}}).Validate(),
+		ErrDuplicateSourceBranch)
+	require.Error(t, newConfig(SourceConfig{Repo: "org/source", Branches: []string{"main", ""}}).Validate())
+}
+
+func TestLoadFromReader_SourceBranches(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := LoadFromReader(strings.NewReader(`version: 1
+groups:
+  - name: ci
+    id: ci
+    source:
+      repo: org/templates
+      branches: [main, release]
+    targets:
+      - repo: org/service
+        files:
+          - src: a
+            dest: a
+`))
+	require.NoError(t, err)
+	assert.Empty(t, cfg.Groups[0].Source.Branch, "branches replaces the default branch")
+	assert.Equal(t, []string{"main", "release"}, cfg.Groups[0].Source.Branches)
+	require.NoError(t, cfg.Validate())
+}
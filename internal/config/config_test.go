@@ -330,7 +330,6 @@ func TestValidate_InvalidRepoFormat(t *testing.T) {
 	}{
 		{"no slash", "invalid-repo"},
 		{"multiple slashes", "org/repo/extra"},
-		{"empty org", "/repo"},
 		{"empty repo", "org/"},
 		{"starts with dash", "-org/repo"},
 		{"starts with dot", ".org/repo"},
@@ -6,6 +6,68 @@ package config
 // Use "0" to disable filtering and clone all blobs.
 const DefaultBlobSizeLimit = "10m"
 
+// DefaultAutomergeMethod is the merge method used to enable auto-merge on sync
+// PRs when neither the CLI nor the group defaults specify one.
+const DefaultAutomergeMethod = "squash"
+
+// Forge providers accepted by Config.Provider. An empty provider is GitHub.
+const (
+	ProviderGitHub    = "github"
+	ProviderBitbucket = "bitbucket"
+)
+
+// Commit signing formats accepted by CommitSigningConfig.Format. An empty
+// format is GPG.
+const (
+	SigningFormatGPG = "gpg"
+	SigningFormatSSH = "ssh"
+)
+
+// Modes for combining the sync command's --pr-label, --pr-assignee and
+// --pr-reviewer overrides with the values resolved from configuration
+const (
+	PRLabelsModeReplace = "replace"
+	PRLabelsModeMerge   = "merge"
+)
+
+// Policies for a failing post-sync hook
+const (
+	HookFailurePolicyWarn = "warn" // Log the failure and keep the target successful
+	HookFailurePolicyFail = "fail" // Mark the target failed
+)
+
+// Policies for a binary source file whose target has content transforms
+// configured, selected with the sync command's --binary-transform-policy
+const (
+	BinaryTransformPolicySkip = "skip" // Sync the file untransformed and warn (default)
+	BinaryTransformPolicyFail = "fail" // Fail the target
+)
+
+// Line ending modes of the line_endings transform
+const (
+	LineEndingsPreserve = "preserve" // Keep line endings as they are in the source (default)
+	LineEndingsLF       = "lf"       // Write "\n" line endings
+	LineEndingsCRLF     = "crlf"     // Write "\r\n" line endings
+)
+
+// Strategies for picking reviewers from a PRReviewerPool
+const (
+	ReviewerStrategyRoundRobin  = "round-robin"  // Rotate through the pool in order
+	ReviewerStrategyRandomN     = "random-n"     // Pick at random, weighted
+	ReviewerStrategyLeastLoaded = "least-loaded" // Pick reviewers with the fewest picks this run, weighted
+)
+
+// DefaultHookTimeoutSeconds bounds a post-sync hook that does not set its own timeout
+const DefaultHookTimeoutSeconds = 300
+
+// DefaultPRUpdateRetries is how many times an update to an existing sync PR is
+// retried after GitHub reports a conflicting concurrent update.
+const DefaultPRUpdateRetries = 3
+
+// DefaultPRFileChecklistMax is how many files the changed file checklist in a
+// sync PR body lists before summarizing the rest.
+const DefaultPRFileChecklistMax = 50
+
 // Rate-limit preflight defaults (see RateLimitPreflightConfig). These match the
 // conservative defaults agreed for the sync preflight gate: keep 20% of the
 // live primary budget as headroom, and reserve 10 of the documented 80/min
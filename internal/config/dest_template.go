@@ -0,0 +1,112 @@
+package config
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"text/template"
+
+	"github.com/mrz1836/go-broadcast/internal/validation"
+)
+
+// DestPathData holds the variables a templated file or directory dest can use
+type DestPathData struct {
+	RepoName   string // Target repository name without the org
+	Org        string // Target repository org
+	TargetRepo string // Target repository, org/repo
+	SourceRepo string // Source repository, org/repo
+	SourcePath string // The mapping's src path
+	SourceBase string // Last element of src, such as config.yaml
+	SourceDir  string // src without its last element, "." when it has none
+}
+
+// sampleDestPathData is rendered when validating templates, so that a
+// template escaping the repository is rejected before any sync runs
+//
+//nolint:gochecknoglobals // Read-only validation sample
+var sampleDestPathData = DestPathData{
+	RepoName:   "repo",
+	Org:        "org",
+	TargetRepo: "org/repo",
+	SourceRepo: "org/template",
+	SourcePath: "configs/config.yaml",
+	SourceBase: "config.yaml",
+	SourceDir:  "configs",
+}
+
+// NewDestPathData returns the template variables for a mapping from src in
+// sourceRepo to targetRepo
+func NewDestPathData(sourceRepo, targetRepo, src string) DestPathData {
+	org, name, _ := strings.Cut(targetRepo, "/")
+	src = strings.TrimSuffix(src, "/")
+	return DestPathData{
+		RepoName:   name,
+		Org:        org,
+		TargetRepo: targetRepo,
+		SourceRepo: sourceRepo,
+		SourcePath: src,
+		SourceBase: path.Base(src),
+		SourceDir:  path.Dir(src),
+	}
+}
+
+// IsDestTemplate reports whether dest is a template rendered per target
+func IsDestTemplate(dest string) bool {
+	return strings.Contains(dest, "{{")
+}
+
+// RenderDestPath renders a templated dest with data and checks the result is
+// a relative path inside the repository. The path is returned cleaned.
+func RenderDestPath(tmpl string, data DestPathData) (string, error) {
+	parsed, err := template.New("dest").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidDestTemplate, err)
+	}
+
+	var dest strings.Builder
+	if err := parsed.Execute(&dest, data); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidDestTemplate, err)
+	}
+	if err := validation.ValidateFilePath(dest.String(), "destination"); err != nil {
+		return "", fmt.Errorf("%w: %q renders %q: %w", ErrInvalidDestTemplate, tmpl, dest.String(), err)
+	}
+	return path.Clean(dest.String()), nil
+}
+
+// validateDestTemplate checks that a templated dest renders a path inside the
+// repository. Static dests are validated as plain paths elsewhere.
+func validateDestTemplate(dest string) error {
+	if !IsDestTemplate(dest) {
+		return nil
+	}
+	_, err := RenderDestPath(dest, sampleDestPathData)
+	return err
+}
+
+// validateFileGlob checks that a glob src is a valid pattern and that its dest
+// is a template giving each matching file its own path
+func validateFileGlob(file FileMapping) error {
+	if !file.IsGlob() {
+		return nil
+	}
+	if _, err := path.Match(file.Src, ""); err != nil {
+		return fmt.Errorf("%w: %q: %w", ErrInvalidFileGlob, file.Src, err)
+	}
+	if !IsDestTemplate(file.Dest) {
+		return fmt.Errorf("%w: %q needs a templated dest such as \"configs/{{.SourceBase}}\", got %q", ErrInvalidFileGlob, file.Src, file.Dest)
+	}
+
+	first, err := RenderDestPath(file.Dest, NewDestPathData("org/template", "org/repo", "configs/a.yaml"))
+	if err != nil {
+		return err
+	}
+	second, err := RenderDestPath(file.Dest, NewDestPathData("org/template", "org/repo", "configs/b.yaml"))
+	if err != nil {
+		return err
+	}
+	if first == second {
+		return fmt.Errorf("%w: dest %q renders the same path for every match of %q; use {{.SourceBase}} or {{.SourcePath}}",
+			ErrInvalidFileGlob, file.Dest, file.Src)
+	}
+	return nil
+}
@@ -274,8 +274,9 @@ func FuzzRepoNameValidation(f *testing.F) {
 			},
 		}
 
+		// Paths are accepted as local source directories
 		validateErr := cfg.ValidateWithLogging(ctx, nil)
-		if validateErr == nil && !isValid {
+		if validateErr == nil && !isValid && !IsLocalSource(repoName) {
 			t.Errorf("Validate() accepted invalid repo name: %q", repoName)
 		}
 		if validateErr != nil && isValid {
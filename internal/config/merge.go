@@ -0,0 +1,271 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/mrz1836/go-broadcast/internal/logging"
+)
+
+// PathSeparator separates the config files of a single --config value,
+// e.g. "base.yaml,team-a.yaml"
+const PathSeparator = ","
+
+// mergedListKeys are the top-level lists whose entries are merged by ID
+// across config files instead of being replaced
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var mergedListKeys = map[string]bool{
+	"groups":           true,
+	"file_lists":       true,
+	"directory_lists":  true,
+	"settings_presets": true,
+}
+
+// SplitPaths splits a --config value into its config paths. Empty entries
+// are dropped.
+func SplitPaths(path string) []string {
+	var paths []string
+	for _, p := range strings.Split(path, PathSeparator) {
+		if p = strings.TrimSpace(p); p != "" {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
+// isMultiPath reports whether path names more than one config file or a
+// directory of config files
+func isMultiPath(path string) bool {
+	if IsStdinPath(path) {
+		return false
+	}
+	if len(SplitPaths(path)) > 1 {
+		return true
+	}
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+// expandConfigPaths resolves every path of a --config value to the files it
+// names. A directory contributes its *.yaml and *.yml files in name order.
+func expandConfigPaths(path string) ([]string, error) {
+	var files []string
+	for _, p := range SplitPaths(path) {
+		if IsStdinPath(p) {
+			files = append(files, p)
+			continue
+		}
+
+		info, err := os.Stat(p)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open config file: %w", err)
+		}
+		if !info.IsDir() {
+			files = append(files, p)
+			continue
+		}
+
+		entries, err := os.ReadDir(p)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config directory: %w", err)
+		}
+		var dirFiles []string
+		for _, entry := range entries {
+			ext := strings.ToLower(filepath.Ext(entry.Name()))
+			if !entry.IsDir() && (ext == ".yaml" || ext == ".yml") {
+				dirFiles = append(dirFiles, filepath.Join(p, entry.Name()))
+			}
+		}
+		if len(dirFiles) == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrNoConfigFiles, p)
+		}
+		sort.Strings(dirFiles)
+		files = append(files, dirFiles...)
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%w: %q", ErrNoConfigFiles, path)
+	}
+	return files, nil
+}
+
+// LoadFiles reads the given config files and deep-merges them into a single
+// configuration, then applies defaults and resolves list references on the
+// merged result. Precedence follows the order of paths:
+//
+//   - Mappings merge key by key; a later file's scalar or list replaces an
+//     earlier file's value for the same key.
+//   - groups, file_lists, directory_lists and settings_presets merge by id:
+//     an entry whose id appeared in an earlier file is deep-merged into it by
+//     the same rules, and an entry with a new id is appended.
+//
+// Directories are expanded to their *.yaml and *.yml files in name order.
+func LoadFiles(paths ...string) (*Config, error) {
+	auditLogger := logging.NewAuditLogger()
+	name := strings.Join(paths, PathSeparator)
+
+	files, err := expandConfigPaths(name)
+	if err != nil {
+		auditLogger.LogConfigChange("system", "config_load_failed", name)
+		return nil, err
+	}
+
+	cfg, err := loadMerged(files)
+	if err != nil {
+		auditLogger.LogConfigChange("system", "config_parse_failed", name)
+		return nil, err
+	}
+
+	auditLogger.LogConfigChange("system", "config_loaded", name)
+	return cfg, nil
+}
+
+// loadMerged strictly parses each file, merges them in order and loads the
+// merged document
+func loadMerged(files []string) (*Config, error) {
+	var merged *yaml.Node
+	for _, file := range files {
+		data, err := readConfigFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		root, err := parseConfigDocument(data)
+		if err != nil {
+			return nil, fmt.Errorf("config file %s: %w", file, err)
+		}
+		if root == nil {
+			continue
+		}
+		if !IsStdinPath(file) {
+			resolveVariablesFilePaths(root, filepath.Dir(file))
+		}
+
+		if merged == nil {
+			merged = root
+			continue
+		}
+		mergeMappingNodes(merged, root, true)
+	}
+
+	if merged == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNoConfigFiles, strings.Join(files, PathSeparator))
+	}
+
+	data, err := yaml.Marshal(merged)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode merged config: %w", err)
+	}
+	return LoadFromReader(bytes.NewReader(data))
+}
+
+// readConfigFile returns the content of one config file, or of standard
+// input for StdinPath
+func readConfigFile(path string) ([]byte, error) {
+	if !IsStdinPath(path) {
+		data, err := os.ReadFile(path) //#nosec G304 -- Path is user-provided config file
+		if err != nil {
+			return nil, fmt.Errorf("failed to open config file: %w", err)
+		}
+		return data, nil
+	}
+
+	return readStdin()
+}
+
+// parseConfigDocument checks data against the Config schema and returns its
+// top-level mapping. An empty document returns nil.
+func parseConfigDocument(data []byte) (*yaml.Node, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true) // Strict parsing - fail on unknown fields
+	if err := decoder.Decode(&Config{}); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	return doc.Content[0], nil
+}
+
+// mergeMappingNodes merges the src mapping into dst. Matching keys merge
+// recursively when both values are mappings; otherwise src wins. At the top
+// level, the lists in mergedListKeys merge their entries by id.
+func mergeMappingNodes(dst, src *yaml.Node, topLevel bool) {
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		key, value := src.Content[i], src.Content[i+1]
+
+		existing := mappingValue(dst, key.Value)
+		switch {
+		case existing == nil:
+			dst.Content = append(dst.Content, key, value)
+		case existing.Kind == yaml.MappingNode && value.Kind == yaml.MappingNode:
+			mergeMappingNodes(existing, value, false)
+		case topLevel && mergedListKeys[key.Value] &&
+			existing.Kind == yaml.SequenceNode && value.Kind == yaml.SequenceNode:
+			mergeSequenceByID(existing, value)
+		default:
+			*existing = *value
+		}
+	}
+}
+
+// mergeSequenceByID merges each src entry into the dst entry with the same
+// id, appending entries whose id is new or missing
+func mergeSequenceByID(dst, src *yaml.Node) {
+	for _, item := range src.Content {
+		if match := sequenceEntryByID(dst, item); match != nil {
+			mergeMappingNodes(match, item, false)
+			continue
+		}
+		dst.Content = append(dst.Content, item)
+	}
+}
+
+// sequenceEntryByID returns the mapping entry of seq whose id matches the id
+// of item, or nil
+func sequenceEntryByID(seq, item *yaml.Node) *yaml.Node {
+	if item.Kind != yaml.MappingNode {
+		return nil
+	}
+	id := mappingValue(item, "id")
+	if id == nil || id.Kind != yaml.ScalarNode || id.Value == "" {
+		return nil
+	}
+
+	for _, entry := range seq.Content {
+		if entry.Kind != yaml.MappingNode {
+			continue
+		}
+		if entryID := mappingValue(entry, "id"); entryID != nil && entryID.Value == id.Value {
+			return entry
+		}
+	}
+	return nil
+}
+
+// mappingValue returns the value node stored under key in a mapping node
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/testutil"
+)
+
+// writeMergeFixtures writes a global defaults file and a team file that
+// overrides one group and adds another
+func writeMergeFixtures(t *testing.T) (dir, base, team string) {
+	t.Helper()
+	dir = testutil.CreateTempDir(t)
+
+	base = filepath.Join(dir, "00-base.yaml")
+	testutil.WriteTestFile(t, base, `
+version: 1
+name: "global"
+max_parallel_groups: 2
+file_lists:
+  - id: "shared"
+    name: "Shared files"
+    files:
+      - src: "LICENSE"
+        dest: "LICENSE"
+groups:
+  - name: "Core"
+    id: "core"
+    source:
+      repo: "org/template"
+      branch: "master"
+    defaults:
+      branch_prefix: "chore/sync"
+      pr_labels: ["sync"]
+    targets:
+      - repo: "org/service-a"
+        file_list_refs: ["shared"]
+`)
+
+	team = filepath.Join(dir, "10-team.yaml")
+	testutil.WriteTestFile(t, team, `
+name: "team-a"
+groups:
+  - id: "core"
+    defaults:
+      pr_labels: ["team-a"]
+  - name: "Team A"
+    id: "team-a"
+    source:
+      repo: "org/team-template"
+    targets:
+      - repo: "org/service-b"
+        file_list_refs: ["shared"]
+`)
+	return dir, base, team
+}
+
+func TestLoadFiles_MergesInOrder(t *testing.T) {
+	_, base, team := writeMergeFixtures(t)
+
+	cfg, err := LoadFiles(base, team)
+	require.NoError(t, err)
+
+	// Later scalars win, untouched keys are kept
+	assert.Equal(t, "team-a", cfg.Name)
+	assert.Equal(t, 1, cfg.Version)
+	assert.Equal(t, 2, cfg.MaxParallelGroups)
+
+	// Groups with a matching id merge, new ids append
+	require.Len(t, cfg.Groups, 2)
+	core := cfg.Groups[0]
+	assert.Equal(t, "core", core.ID)
+	assert.Equal(t, "Core", core.Name)
+	assert.Equal(t, "org/template", core.Source.Repo)
+	assert.Equal(t, "chore/sync", core.Defaults.BranchPrefix)
+	assert.Equal(t, []string{"team-a"}, core.Defaults.PRLabels, "lists are replaced, not concatenated")
+	require.Len(t, core.Targets, 1)
+
+	teamGroup := cfg.Groups[1]
+	assert.Equal(t, "team-a", teamGroup.ID)
+	assert.Equal(t, "main", teamGroup.Source.Branch, "defaults apply to the merged result")
+
+	// List references resolve across files
+	require.Len(t, teamGroup.Targets, 1)
+	require.Len(t, teamGroup.Targets[0].Files, 1)
+	assert.Equal(t, "LICENSE", teamGroup.Targets[0].Files[0].Dest)
+}
+
+func TestLoad_MultiplePaths(t *testing.T) {
+	dir, base, team := writeMergeFixtures(t)
+
+	t.Run("comma-separated files", func(t *testing.T) {
+		cfg, err := Load(base + PathSeparator + team)
+		require.NoError(t, err)
+		assert.Equal(t, "team-a", cfg.Name)
+		assert.Len(t, cfg.Groups, 2)
+	})
+
+	t.Run("directory in name order", func(t *testing.T) {
+		testutil.WriteTestFile(t, filepath.Join(dir, "notes.txt"), "not: config")
+
+		cfg, err := Load(dir)
+		require.NoError(t, err)
+		assert.Equal(t, "team-a", cfg.Name)
+		assert.Len(t, cfg.Groups, 2)
+	})
+
+	t.Run("reverse order reverses precedence", func(t *testing.T) {
+		cfg, err := Load(team + PathSeparator + base)
+		require.NoError(t, err)
+		assert.Equal(t, "global", cfg.Name)
+		require.Len(t, cfg.Groups, 2)
+		assert.Equal(t, "core", cfg.Groups[0].ID, "a group keeps the position of its first file")
+		assert.Equal(t, []string{"sync"}, cfg.Groups[0].Defaults.PRLabels)
+	})
+
+	t.Run("validation runs on the merged result", func(t *testing.T) {
+		cfg, err := Load(base + PathSeparator + team)
+		require.NoError(t, err)
+		require.NoError(t, cfg.Validate())
+	})
+}
+
+func TestLoadFiles_Errors(t *testing.T) {
+	t.Run("unknown field names the file", func(t *testing.T) {
+		dir := testutil.CreateTempDir(t)
+		bad := filepath.Join(dir, "bad.yaml")
+		testutil.WriteTestFile(t, bad, "version: 1\nunknown_key: true\n")
+
+		_, err := LoadFiles(bad)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), bad)
+	})
+
+	t.Run("directory without yaml files", func(t *testing.T) {
+		_, err := LoadFiles(testutil.CreateTempDir(t))
+		require.ErrorIs(t, err, ErrNoConfigFiles)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := Load("/path/does/not/exist/a.yaml,/path/does/not/exist/b.yaml")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to open config file")
+	})
+}
+
+func TestSplitPaths(t *testing.T) {
+	assert.Equal(t, []string{"sync.yaml"}, SplitPaths("sync.yaml"))
+	assert.Equal(t, []string{"base.yaml", "team.yaml"}, SplitPaths("base.yaml, team.yaml,"))
+	assert.Empty(t, SplitPaths(""))
+}
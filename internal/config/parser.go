@@ -1,11 +1,14 @@
 package config
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"sort"
+	"sync"
 	"time"
 
 	"gopkg.in/yaml.v3"
@@ -20,16 +23,51 @@ const (
 	configLoadRetryDelay = 100 * time.Millisecond
 )
 
+// StdinPath is the config path that selects standard input instead of a file
+const StdinPath = "-"
+
+// stdinName identifies standard input in audit logs and error messages
+const stdinName = "stdin"
+
+// stdinConfig holds the configuration bytes read from standard input.
+// Stdin can only be consumed once, so every load of StdinPath within a
+// process parses the same buffered content.
+//
+//nolint:gochecknoglobals // Stdin is process-wide state
+var stdinConfig struct {
+	once sync.Once
+	data []byte
+	err  error
+}
+
+// IsStdinPath reports whether path selects standard input
+func IsStdinPath(path string) bool {
+	return path == StdinPath
+}
+
 // Load reads and parses a configuration file from the given path.
 // It includes retry logic for transient I/O errors (e.g., file being
 // modified by an editor during read).
+//
+// A path of "-" (StdinPath) reads the configuration from standard input.
+// Several comma-separated paths, or a directory of *.yaml files, are merged
+// into one configuration by LoadFiles.
 func Load(path string) (*Config, error) {
 	// Initialize audit logger for security event tracking
 	auditLogger := logging.NewAuditLogger()
 
+	if IsStdinPath(path) {
+		return loadStdin(auditLogger)
+	}
+
+	load := func() (*Config, error) { return loadOnce(path, auditLogger) }
+	if isMultiPath(path) {
+		load = func() (*Config, error) { return LoadFiles(SplitPaths(path)...) }
+	}
+
 	var lastErr error
 	for attempt := 1; attempt <= configLoadMaxRetries; attempt++ {
-		cfg, err := loadOnce(path, auditLogger)
+		cfg, err := load()
 		if err == nil {
 			if attempt > 1 {
 				auditLogger.LogConfigChange("system", "config_loaded_after_retry", path)
@@ -64,7 +102,7 @@ func loadOnce(path string, auditLogger *logging.AuditLogger) (*Config, error) {
 
 	defer func() { _ = file.Close() }()
 
-	config, parseErr := LoadFromReader(file)
+	config, parseErr := loadFromReader(file, filepath.Dir(path))
 	if parseErr != nil {
 		// Log failed configuration parsing
 		auditLogger.LogConfigChange("system", "config_parse_failed", path)
@@ -77,6 +115,41 @@ func loadOnce(path string, auditLogger *logging.AuditLogger) (*Config, error) {
 	return config, nil
 }
 
+// loadStdin parses configuration from standard input. It is not retried:
+// a failed read cannot be repeated and the content does not change.
+func loadStdin(auditLogger *logging.AuditLogger) (*Config, error) {
+	data, err := readStdin()
+	if err != nil {
+		auditLogger.LogConfigChange("system", "config_load_failed", stdinName)
+		return nil, err
+	}
+	if len(data) == 0 {
+		auditLogger.LogConfigChange("system", "config_load_failed", stdinName)
+		return nil, fmt.Errorf("%w: %s", ErrEmptyStdinConfig, stdinName)
+	}
+
+	config, err := LoadFromReader(bytes.NewReader(data))
+	if err != nil {
+		auditLogger.LogConfigChange("system", "config_parse_failed", stdinName)
+		return nil, fmt.Errorf("config from %s: %w", stdinName, err)
+	}
+
+	auditLogger.LogConfigChange("system", "config_loaded", stdinName)
+	return config, nil
+}
+
+// readStdin returns the configuration bytes piped to standard input, reading
+// them on first use
+func readStdin() ([]byte, error) {
+	stdinConfig.once.Do(func() {
+		stdinConfig.data, stdinConfig.err = io.ReadAll(os.Stdin)
+	})
+	if stdinConfig.err != nil {
+		return nil, fmt.Errorf("failed to read config from %s: %w", stdinName, stdinConfig.err)
+	}
+	return stdinConfig.data, nil
+}
+
 // isTransientConfigError determines if an error is likely transient and worth retrying.
 // Semantic errors (like missing list references) are not retried as they require config changes.
 func isTransientConfigError(err error) bool {
@@ -90,7 +163,9 @@ func isTransientConfigError(err error) bool {
 		errors.Is(err, ErrDuplicateTarget) ||
 		errors.Is(err, ErrNoTargets) ||
 		errors.Is(err, ErrNoMappings) ||
-		errors.Is(err, ErrPathTraversal) {
+		errors.Is(err, ErrPathTraversal) ||
+		errors.Is(err, ErrNoConfigFiles) ||
+		errors.Is(err, ErrInvalidVariablesFile) {
 		return false
 	}
 
@@ -145,8 +220,15 @@ func ApplyDefaultsAndResolve(cfg *Config) error {
 	return nil
 }
 
-// LoadFromReader parses configuration from an io.Reader
+// LoadFromReader parses configuration from an io.Reader. Relative
+// variables_file paths are resolved against the working directory.
 func LoadFromReader(reader io.Reader) (*Config, error) {
+	return loadFromReader(reader, "")
+}
+
+// loadFromReader parses configuration from reader, resolving relative
+// variables_file paths against baseDir
+func loadFromReader(reader io.Reader, baseDir string) (*Config, error) {
 	config := &Config{}
 
 	decoder := yaml.NewDecoder(reader)
@@ -156,6 +238,12 @@ func LoadFromReader(reader io.Reader) (*Config, error) {
 		return nil, fmt.Errorf("failed to parse YAML: %w", err)
 	}
 
+	// Variables files are merged before list references are resolved, so
+	// directory lists carry their file variables into every target using them
+	if err := loadVariablesFiles(config, baseDir); err != nil {
+		return nil, err
+	}
+
 	if err := ApplyDefaultsAndResolve(config); err != nil {
 		return nil, err
 	}
@@ -181,8 +269,8 @@ func applyDefaults(config *Config) {
 	for i := range config.Groups {
 		group := &config.Groups[i]
 
-		// Set default source branch if not specified
-		if group.Source.Branch == "" {
+		// Set default source branch if not specified (a branches list replaces it)
+		if group.Source.Branch == "" && len(group.Source.Branches) == 0 {
 			group.Source.Branch = "main"
 		}
 
@@ -224,7 +312,18 @@ func applyDefaults(config *Config) {
 // including the Variables map to avoid shared mutable state.
 func deepCopyTransform(t Transform) Transform {
 	result := Transform{
-		RepoName: t.RepoName,
+		RepoName:            t.RepoName,
+		VariablesFile:       t.VariablesFile,
+		TemplateRender:      t.TemplateRender,
+		TemplateSuffix:      t.TemplateSuffix,
+		GoModulePath:        t.GoModulePath,
+		GoSourceModulePath:  t.GoSourceModulePath,
+		UpdateCopyrightYear: t.UpdateCopyrightYear,
+		CopyrightYear:       t.CopyrightYear,
+		ManagedHeader:       t.ManagedHeader,
+		StripManagedHeader:  t.StripManagedHeader,
+		LineEndings:         t.LineEndings,
+		ConditionalBlocks:   t.ConditionalBlocks,
 	}
 	if t.Variables != nil {
 		result.Variables = make(map[string]string, len(t.Variables))
@@ -232,6 +331,16 @@ func deepCopyTransform(t Transform) Transform {
 			result.Variables[k] = v
 		}
 	}
+	if t.Pipeline != nil {
+		result.Pipeline = append([]string(nil), t.Pipeline...)
+	}
+	if t.SecretScrub != nil {
+		scrub := *t.SecretScrub
+		if scrub.Patterns != nil {
+			scrub.Patterns = append([]string(nil), scrub.Patterns...)
+		}
+		result.SecretScrub = &scrub
+	}
 	return result
 }
 
@@ -277,9 +386,12 @@ func resolveListReferences(config *Config) error {
 					// Add or override file mappings from the list
 					for _, file := range list.Files {
 						fileMap[file.Dest] = FileMapping{
-							Src:    file.Src,
-							Dest:   file.Dest,
-							Delete: file.Delete,
+							Src:         file.Src,
+							Dest:        file.Dest,
+							Delete:      file.Delete,
+							When:        file.When,
+							MarkerStart: file.MarkerStart,
+							MarkerEnd:   file.MarkerEnd,
 						}
 					}
 				}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -1012,7 +1013,7 @@ func TestLoadSecurityEdgeCases(t *testing.T) {
 				return tmpDir // Return directory path instead of file
 			},
 			expectError: true,
-			errorMsg:    "failed to parse YAML", // os.Open succeeds but YAML parsing fails
+			errorMsg:    "no configuration files found", // directories are merged and this one holds no YAML
 		},
 		{
 			name: "file with null bytes",
@@ -1373,3 +1374,98 @@ func TestFormatListNotFoundError(t *testing.T) {
 		assert.Contains(t, msg, "did you mean to use file_list_refs")
 	})
 }
+
+// withStdin replaces os.Stdin with content for the duration of the test and
+// clears the buffered stdin config so each test reads afresh
+func withStdin(t *testing.T, content string) {
+	t.Helper()
+
+	path := filepath.Join(testutil.CreateTempDir(t), "stdin.yaml")
+	testutil.WriteTestFile(t, path, content)
+	file, err := os.Open(path) //#nosec G304 -- test file in temp dir
+	require.NoError(t, err)
+
+	original := os.Stdin
+	os.Stdin = file
+	resetStdinConfig := func() {
+		stdinConfig.once = sync.Once{}
+		stdinConfig.data = nil
+		stdinConfig.err = nil
+	}
+	resetStdinConfig()
+
+	t.Cleanup(func() {
+		os.Stdin = original
+		_ = file.Close()
+		resetStdinConfig()
+	})
+}
+
+func TestLoad_Stdin(t *testing.T) {
+	validConfig := `version: 1
+groups:
+  - name: "stdin-group"
+    id: "stdin-group"
+    source:
+      repo: "org/template-repo"
+    targets:
+      - repo: "org/service-a"
+        files:
+          - src: "README.md"
+            dest: "README.md"
+`
+
+	t.Run("parses yaml from stdin", func(t *testing.T) {
+		withStdin(t, validConfig)
+
+		cfg, err := Load(StdinPath)
+		require.NoError(t, err)
+		require.Len(t, cfg.Groups, 1)
+		assert.Equal(t, "org/template-repo", cfg.Groups[0].Source.Repo)
+		assert.Equal(t, "main", cfg.Groups[0].Source.Branch, "defaults are applied as for files")
+
+		// Stdin is consumed once; later loads reuse the buffered content
+		again, err := Load(StdinPath)
+		require.NoError(t, err)
+		assert.Equal(t, cfg, again)
+	})
+
+	t.Run("empty stdin", func(t *testing.T) {
+		withStdin(t, "")
+
+		_, err := Load(StdinPath)
+		require.ErrorIs(t, err, ErrEmptyStdinConfig)
+		assert.Contains(t, err.Error(), "stdin")
+	})
+
+	t.Run("invalid yaml references stdin", func(t *testing.T) {
+		withStdin(t, "groups: [unclosed\n")
+
+		_, err := Load(StdinPath)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "config from stdin")
+		assert.Contains(t, err.Error(), "failed to parse YAML")
+	})
+
+	t.Run("semantic errors match file loading", func(t *testing.T) {
+		withStdin(t, `version: 1
+groups:
+  - name: "g"
+    id: "g"
+    source:
+      repo: "org/template-repo"
+    targets:
+      - repo: "org/service-a"
+        file_list_refs: ["missing"]
+`)
+
+		_, err := Load(StdinPath)
+		require.ErrorIs(t, err, ErrListReferenceNotFound)
+	})
+}
+
+func TestIsStdinPath(t *testing.T) {
+	assert.True(t, IsStdinPath("-"))
+	assert.False(t, IsStdinPath("sync.yaml"))
+	assert.False(t, IsStdinPath(""))
+}
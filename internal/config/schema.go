@@ -0,0 +1,205 @@
+package config
+
+import (
+	_ "embed" // Embeds types.go for field descriptions
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strings"
+)
+
+// SchemaDraft is the JSON Schema dialect of the generated config schema
+const SchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// typesSource is the source of the config structs. Field and type
+// descriptions in the schema come from its comments, so they cannot drift
+// from the structs they describe.
+//
+//go:embed types.go
+var typesSource string
+
+// schemaEnums lists the accepted values of enumerated fields, keyed by
+// "Type.Field". Values mirror the validator.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var schemaEnums = map[string][]interface{}{
+	"Config.Version":                          {1},
+	"Config.Provider":                         {ProviderGitHub, ProviderBitbucket},
+	"CommitSigningConfig.Format":              {SigningFormatGPG, SigningFormatSSH},
+	"DefaultConfig.AutomergeMethod":           {"merge", "squash", "rebase"},
+	"DefaultConfig.HookFailurePolicy":         {HookFailurePolicyWarn, HookFailurePolicyFail},
+	"TargetConfig.HookFailurePolicy":          {HookFailurePolicyWarn, HookFailurePolicyFail},
+	"Transform.LineEndings":                   {LineEndingsPreserve, LineEndingsLF, LineEndingsCRLF},
+	"PRReviewerPool.Strategy":                 {ReviewerStrategyRoundRobin, ReviewerStrategyRandomN, ReviewerStrategyLeastLoaded},
+	"ModuleConfig.Type":                       {"go"},
+	"RulesetConfig.Target":                    {"branch", "tag"},
+	"RulesetConfig.Enforcement":               {"active", "disabled", "evaluate"},
+	"SettingsPreset.SquashMergeCommitTitle":   {"PR_TITLE", "COMMIT_OR_PR_TITLE"},
+	"SettingsPreset.SquashMergeCommitMessage": {"COMMIT_MESSAGES", "PR_BODY", "BLANK"},
+}
+
+// schemaRequired lists the YAML keys the validator requires, keyed by type
+// name. Keys without omitempty are not all required: many have defaults.
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var schemaRequired = map[string][]string{
+	"Config":           {"version", "groups"},
+	"Group":            {"name", "id", "source", "targets"},
+	"SourceConfig":     {"repo"},
+	"TargetConfig":     {"repo"},
+	"FileMapping":      {"dest"},
+	"DirectoryMapping": {"dest"},
+	"PRBodySection":    {"title"},
+	"PRReviewerPool":   {"reviewers"},
+	"FileList":         {"id", "name", "files"},
+	"DirectoryList":    {"id", "name", "directories"},
+}
+
+// schemaDocs holds the comments of the config structs and their fields
+type schemaDocs struct {
+	types  map[string]string            // Type name -> doc comment
+	fields map[string]map[string]string // Type name -> field name -> comment
+}
+
+// JSONSchema returns a JSON Schema describing the YAML config file, for
+// editor autocompletion and CI validation. It is generated by reflecting
+// over Config: every struct becomes a definition under $defs, descriptions
+// are the comments in types.go, and unknown keys are rejected as the parser
+// does.
+func JSONSchema() ([]byte, error) {
+	docs, err := parseSchemaDocs()
+	if err != nil {
+		return nil, err
+	}
+
+	defs := make(map[string]interface{})
+	root := map[string]interface{}{
+		"$schema":     SchemaDraft,
+		"title":       "go-broadcast configuration",
+		"description": docs.types["Config"],
+		"$ref":        docs.typeSchema(reflect.TypeOf(Config{}), defs)["$ref"],
+		"$defs":       defs,
+	}
+	return json.MarshalIndent(root, "", "  ")
+}
+
+// parseSchemaDocs collects the doc and line comments of the structs in types.go
+func parseSchemaDocs() (*schemaDocs, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "types.go", typesSource, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config types: %w", err)
+	}
+
+	docs := &schemaDocs{types: make(map[string]string), fields: make(map[string]map[string]string)}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			typeSpec, ok := spec.(*ast.TypeSpec)
+			if !ok {
+				continue
+			}
+			structType, ok := typeSpec.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			docs.types[typeSpec.Name.Name] = commentText(gen.Doc)
+
+			fields := make(map[string]string)
+			for _, field := range structType.Fields.List {
+				// A line comment describes its field; a doc comment may
+				// head a section of fields
+				text := commentText(field.Comment)
+				if text == "" {
+					text = commentText(field.Doc)
+				}
+				for _, name := range field.Names {
+					fields[name.Name] = text
+				}
+			}
+			docs.fields[typeSpec.Name.Name] = fields
+		}
+	}
+	return docs, nil
+}
+
+// commentText flattens a comment group into a single line
+func commentText(group *ast.CommentGroup) string {
+	if group == nil {
+		return ""
+	}
+	return strings.Join(strings.Fields(group.Text()), " ")
+}
+
+// typeSchema returns the schema of t. Structs are added to defs once and
+// referenced by name.
+func (d *schemaDocs) typeSchema(t reflect.Type, defs map[string]interface{}) map[string]interface{} {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if _, ok := defs[t.Name()]; !ok {
+			defs[t.Name()] = nil // Reserve the name before recursing
+			defs[t.Name()] = d.structSchema(t, defs)
+		}
+		return map[string]interface{}{"$ref": "#/$defs/" + t.Name()}
+	case reflect.Slice:
+		return map[string]interface{}{"type": "array", "items": d.typeSchema(t.Elem(), defs)}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": d.typeSchema(t.Elem(), defs)}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	default:
+		return map[string]interface{}{"type": "string"}
+	}
+}
+
+// structSchema returns the object schema of a config struct
+func (d *schemaDocs) structSchema(t reflect.Type, defs map[string]interface{}) map[string]interface{} {
+	properties := make(map[string]interface{})
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		key, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if key == "-" || !field.IsExported() {
+			continue
+		}
+		if key == "" {
+			key = strings.ToLower(field.Name)
+		}
+
+		// Keywords beside $ref are allowed from draft 2019-09 on
+		property := d.typeSchema(field.Type, defs)
+		if description := d.fields[t.Name()][field.Name]; description != "" {
+			property["description"] = description
+		}
+		if values, ok := schemaEnums[t.Name()+"."+field.Name]; ok {
+			property["enum"] = values
+		}
+		properties[key] = property
+	}
+
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if description := d.types[t.Name()]; description != "" {
+		schema["description"] = description
+	}
+	if required, ok := schemaRequired[t.Name()]; ok {
+		schema["required"] = required
+	}
+	return schema
+}
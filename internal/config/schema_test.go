@@ -0,0 +1,91 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestJSONSchema tests the generated config schema
+func TestJSONSchema(t *testing.T) {
+	data, err := JSONSchema()
+	require.NoError(t, err)
+
+	var schema struct {
+		Schema string                            `json:"$schema"`
+		Ref    string                            `json:"$ref"`
+		Defs   map[string]map[string]interface{} `json:"$defs"`
+	}
+	require.NoError(t, json.Unmarshal(data, &schema))
+	assert.Equal(t, SchemaDraft, schema.Schema)
+	assert.Equal(t, "#/$defs/Config", schema.Ref)
+
+	property := func(def, key string) map[string]interface{} {
+		t.Helper()
+		properties, ok := schema.Defs[def]["properties"].(map[string]interface{})
+		require.True(t, ok, def)
+		prop, ok := properties[key].(map[string]interface{})
+		require.True(t, ok, "%s.%s", def, key)
+		return prop
+	}
+
+	t.Run("structure", func(t *testing.T) {
+		assert.Equal(t, "#/$defs/Group", property("Config", "groups")["items"].(map[string]interface{})["$ref"])
+		assert.Equal(t, "#/$defs/Transform", property("TargetConfig", "transform")["$ref"])
+		assert.Equal(t, "boolean", property("TargetConfig", "pr_draft")["type"], "pointers are unwrapped")
+		assert.Equal(t, "integer", property("DefaultConfig", "hook_timeout_seconds")["type"])
+		assert.Equal(t, map[string]interface{}{"type": "string"}, property("Transform", "variables")["additionalProperties"])
+		assert.NotContains(t, schema.Defs["Group"]["properties"], "ExpandedFrom", `yaml:"-" fields are skipped`)
+		assert.Equal(t, false, schema.Defs["Transform"]["additionalProperties"])
+	})
+
+	t.Run("descriptions come from types.go comments", func(t *testing.T) {
+		assert.Equal(t, "Format: org/repo", property("TargetConfig", "repo")["description"])
+		assert.Equal(t, `URL is the proxy address, e.g. "http://proxy.corp.example:8080"`, property("ProxyConfig", "url")["description"])
+		assert.Equal(t, "DirectoryMapping defines source to destination directory mapping", schema.Defs["DirectoryMapping"]["description"])
+	})
+
+	t.Run("enums and required fields", func(t *testing.T) {
+		assert.Equal(t, []interface{}{ProviderGitHub, ProviderBitbucket}, property("Config", "provider")["enum"])
+		assert.Equal(t, []interface{}{float64(1)}, property("Config", "version")["enum"])
+		assert.Equal(t, []interface{}{"version", "groups"}, schema.Defs["Config"]["required"])
+		assert.Equal(t, []interface{}{"dest"}, schema.Defs["FileMapping"]["required"])
+	})
+}
+
+// TestJSONSchemaCoversConfigTypes tests that every config field is described
+// and that the enum and required tables only name existing fields
+func TestJSONSchemaCoversConfigTypes(t *testing.T) {
+	docs, err := parseSchemaDocs()
+	require.NoError(t, err)
+	defs := make(map[string]interface{})
+	docs.typeSchema(reflect.TypeOf(Config{}), defs)
+
+	for name, fields := range docs.fields {
+		if _, used := defs[name]; !used {
+			continue
+		}
+		for field, description := range fields {
+			if field == "ExpandedFrom" {
+				continue
+			}
+			assert.NotEmpty(t, description, "%s.%s needs a comment for the schema", name, field)
+		}
+	}
+
+	for key := range schemaEnums {
+		typeName, field, _ := strings.Cut(key, ".")
+		_, ok := docs.fields[typeName][field]
+		assert.True(t, ok, "enum for unknown field %s", key)
+	}
+	for typeName, keys := range schemaRequired {
+		properties := defs[typeName].(map[string]interface{})["properties"].(map[string]interface{})
+		for _, key := range keys {
+			assert.Contains(t, properties, key, "%s requires unknown key", typeName)
+		}
+	}
+}
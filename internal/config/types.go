@@ -1,5 +1,13 @@
 package config
 
+import (
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
 // Config represents the complete sync configuration
 type Config struct {
 	Version            int                      `yaml:"version"`                        // Config version (1)
@@ -7,9 +15,58 @@ type Config struct {
 	ID                 string                   `yaml:"id,omitempty"`                   // Optional config ID
 	FileLists          []FileList               `yaml:"file_lists,omitempty"`           // Reusable file lists
 	DirectoryLists     []DirectoryList          `yaml:"directory_lists,omitempty"`      // Reusable directory lists
+	GlobalExclude      []string                 `yaml:"global_exclude,omitempty"`       // Glob patterns excluded from every directory mapping
 	Groups             []Group                  `yaml:"groups"`                         // List of sync groups
 	SettingsPresets    []SettingsPreset         `yaml:"settings_presets,omitempty"`     // Repository settings presets
 	RateLimitPreflight RateLimitPreflightConfig `yaml:"rate_limit_preflight,omitempty"` // Pre-sync rate-limit gate settings
+	MaxParallelGroups  int                      `yaml:"max_parallel_groups,omitempty"`  // Independent groups synced at once (0 or 1 = sequential)
+	Provider           string                   `yaml:"provider,omitempty"`             // Forge hosting every repo: github (default) or bitbucket
+	CommitSigning      CommitSigningConfig      `yaml:"commit_signing,omitempty"`       // Sign sync commits for targets that require signed commits
+	CommitAuthorName   string                   `yaml:"commit_author_name,omitempty"`   // Author name of sync commits (default: git's configured user.name)
+	CommitAuthorEmail  string                   `yaml:"commit_author_email,omitempty"`  // Author email of sync commits (default: git's configured user.email)
+	CommitterName      string                   `yaml:"committer_name,omitempty"`       // Committer name of sync commits (default: the author)
+	CommitterEmail     string                   `yaml:"committer_email,omitempty"`      // Committer email of sync commits (default: the author)
+	Proxy              ProxyConfig              `yaml:"proxy,omitempty"`                // HTTP(S) proxy for GitHub API calls
+	APIHeaders         APIHeadersConfig         `yaml:"api_headers,omitempty"`          // Extra headers sent with every GitHub API call
+	MetadataSecretEnv  string                   `yaml:"metadata_secret_env,omitempty"`  // Environment variable holding the key PR metadata blocks are signed and verified with
+}
+
+// ProxyConfig routes GitHub API calls through an HTTP(S) proxy. It is
+// validated when the GitHub client is created.
+type ProxyConfig struct {
+	// URL is the proxy address, e.g. "http://proxy.corp.example:8080"
+	URL string `yaml:"url,omitempty"`
+
+	// Username authenticates with the proxy
+	Username string `yaml:"username,omitempty"`
+
+	// PasswordEnv names the environment variable holding the proxy password
+	PasswordEnv string `yaml:"password_env,omitempty"`
+
+	// CAFile is a PEM bundle to trust for TLS, for proxies that intercept TLS
+	CAFile string `yaml:"ca_file,omitempty"`
+}
+
+// APIHeadersConfig adds headers to every GitHub API call, for gateways in
+// front of GitHub. It is validated when the GitHub client is created.
+type APIHeadersConfig struct {
+	// UserAgent replaces the gh CLI's User-Agent
+	UserAgent string `yaml:"user_agent,omitempty"`
+
+	// Headers maps header names to values; Authorization and other headers
+	// the gh CLI owns cannot be set
+	Headers map[string]string `yaml:"headers,omitempty"`
+}
+
+// CommitSigningConfig selects the key sync commits are signed with. An empty
+// key leaves commits unsigned.
+type CommitSigningConfig struct {
+	// Key is the GPG key ID, or for SSH the path to the key (or a literal
+	// "key::ssh-..." public key), passed to git as user.signingkey
+	Key string `yaml:"key,omitempty"`
+
+	// Format is the signature format: gpg (default) or ssh
+	Format string `yaml:"format,omitempty"`
 }
 
 // RateLimitPreflightConfig configures the pre-sync GitHub rate-limit gate.
@@ -101,11 +158,38 @@ func DefaultPreset() SettingsPreset {
 
 // SourceConfig defines the source repository settings
 type SourceConfig struct {
-	Repo          string `yaml:"repo"`                      // Format: org/repo
-	Branch        string `yaml:"branch"`                    // Default: master
-	BlobSizeLimit string `yaml:"blob_size_limit,omitempty"` // Max blob size for partial clone (e.g., "10m"), "0" to disable
-	SecurityEmail string `yaml:"security_email,omitempty"`  // Security contact email address (for transformation)
-	SupportEmail  string `yaml:"support_email,omitempty"`   // Support/contact email address (for transformation)
+	Repo          string   `yaml:"repo"`                      // Format: org/repo, or a local path (/, ./, ../ or file://)
+	Branch        string   `yaml:"branch"`                    // Default: master
+	Branches      []string `yaml:"branches,omitempty"`        // Sync each branch as its own group (exclusive with branch)
+	BlobSizeLimit string   `yaml:"blob_size_limit,omitempty"` // Max blob size for partial clone (e.g., "10m"), "0" to disable
+	SecurityEmail string   `yaml:"security_email,omitempty"`  // Security contact email address (for transformation)
+	SupportEmail  string   `yaml:"support_email,omitempty"`   // Support/contact email address (for transformation)
+
+	IgnoreGitAttributes bool `yaml:"ignore_gitattributes,omitempty"` // Detect binary files by content only, ignoring the source .gitattributes
+}
+
+// LocalSourcePrefix marks a source repo given as a file URL
+const LocalSourcePrefix = "file://"
+
+// IsLocalSource reports whether repo names a local directory rather than a
+// GitHub repository: an absolute path, a path starting with ./ or ../, or a
+// file:// URL
+func IsLocalSource(repo string) bool {
+	return strings.HasPrefix(repo, "/") ||
+		strings.HasPrefix(repo, "./") ||
+		strings.HasPrefix(repo, "../") ||
+		strings.HasPrefix(repo, LocalSourcePrefix)
+}
+
+// LocalSourcePath returns the directory of a local source repo, stripping a
+// file:// prefix
+func LocalSourcePath(repo string) string {
+	return strings.TrimPrefix(repo, LocalSourcePrefix)
+}
+
+// IsLocal reports whether the source is read from a local directory
+func (s SourceConfig) IsLocal() bool {
+	return IsLocalSource(s.Repo)
 }
 
 // GlobalConfig contains global settings applied across all targets
@@ -115,6 +199,9 @@ type GlobalConfig struct {
 	PRAssignees     []string `yaml:"pr_assignees,omitempty"`      // Global GitHub usernames to assign to all PRs
 	PRReviewers     []string `yaml:"pr_reviewers,omitempty"`      // Global GitHub usernames to request reviews from
 	PRTeamReviewers []string `yaml:"pr_team_reviewers,omitempty"` // Global GitHub team slugs to request reviews from
+	PRDraft         bool     `yaml:"pr_draft,omitempty"`          // Create all PRs as drafts
+
+	BranchNameTemplate string `yaml:"branch_name_template,omitempty"` // Template for sync branch names (see BranchNameData)
 }
 
 // DefaultConfig contains default settings applied to all targets
@@ -124,13 +211,51 @@ type DefaultConfig struct {
 	PRAssignees     []string `yaml:"pr_assignees,omitempty"`      // GitHub usernames to assign to PRs
 	PRReviewers     []string `yaml:"pr_reviewers,omitempty"`      // GitHub usernames to request reviews from
 	PRTeamReviewers []string `yaml:"pr_team_reviewers,omitempty"` // GitHub team slugs to request reviews from
+	PRDraft         bool     `yaml:"pr_draft,omitempty"`          // Create PRs as drafts
+	AutomergeMethod string   `yaml:"automerge_method,omitempty"`  // Merge method when automerge is enabled: merge, squash, rebase (default: squash)
+	PRUpdateRetries *int     `yaml:"pr_update_retries,omitempty"` // Retries when updating an existing PR hits a conflict (default: 3, 0 disables)
+
+	PRFileChecklistMax *int `yaml:"pr_file_checklist_max,omitempty"` // Files listed in the PR body's changed file checklist (default: 50, 0 disables)
+
+	BranchNameTemplate string `yaml:"branch_name_template,omitempty"` // Template for sync branch names when neither global nor target sets one
+	VariablesFile      string `yaml:"variables_file,omitempty"`       // JSON or YAML file of transform variables for every target; target variables win
+
+	PRBodyExtraSections []PRBodySection `yaml:"pr_body_extra_sections,omitempty"` // Extra sections appended to generated PR bodies
+	UseTargetPRTemplate bool            `yaml:"use_target_pr_template,omitempty"` // Build PR bodies from the target repo's pull request template when it has one
+
+	PRReviewerPool *PRReviewerPool   `yaml:"pr_reviewer_pool,omitempty"` // Pool a subset of reviewers is picked from for each PR
+	AutoLabels     map[string]string `yaml:"auto_labels,omitempty"`      // Path glob -> label added to PRs changing a matching file
+
+	PostSyncHook       string `yaml:"post_sync_hook,omitempty"`       // Shell command run after a target's sync PR is created or updated
+	HookFailurePolicy  string `yaml:"hook_failure_policy,omitempty"`  // What a failing hook does to the target: warn (default) or fail
+	HookTimeoutSeconds int    `yaml:"hook_timeout_seconds,omitempty"` // Seconds a hook may run before it is killed (default: 300)
+}
+
+// PRBodySection is a custom section rendered into sync PR bodies after the
+// standard sections and before the go-broadcast-metadata block. Markdown may
+// reference the target's transform variables using {{VAR}} or ${VAR} syntax.
+type PRBodySection struct {
+	Title    string `yaml:"title"`    // Section heading (rendered as "## Title")
+	Markdown string `yaml:"markdown"` // Section content
+}
+
+// PRReviewerPool picks a subset of its reviewers for each sync PR, spreading
+// review load across a team. Picked reviewers are requested in addition to
+// the pr_reviewers lists; the PR author is never picked.
+type PRReviewerPool struct {
+	Reviewers []string       `yaml:"reviewers"`          // GitHub usernames in the pool
+	Strategy  string         `yaml:"strategy,omitempty"` // Selection strategy: round-robin (default), random-n or least-loaded
+	Count     int            `yaml:"count,omitempty"`    // Reviewers picked per PR (default: 1)
+	Weights   map[string]int `yaml:"weights,omitempty"`  // Relative share of PRs per reviewer (default: 1, 0 excludes)
 }
 
 // TargetConfig defines a target repository and its file mappings
 type TargetConfig struct {
 	Repo              string             `yaml:"repo"`                          // Format: org/repo
+	Fork              string             `yaml:"fork,omitempty"`                // Fork of repo to push the sync branch to; the PR is opened from it against repo
 	Branch            string             `yaml:"branch,omitempty"`              // Target branch for PR base (defaults to repo's default branch)
 	BlobSizeLimit     string             `yaml:"blob_size_limit,omitempty"`     // Override source blob size limit for partial clone
+	SourceRoot        string             `yaml:"source_root,omitempty"`         // Source directory mapping src paths are relative to (default: repository root)
 	Files             []FileMapping      `yaml:"files,omitempty"`               // Files to sync
 	Directories       []DirectoryMapping `yaml:"directories,omitempty"`         // Directories to sync
 	FileListRefs      []string           `yaml:"file_list_refs,omitempty"`      // References to file lists by ID
@@ -142,13 +267,80 @@ type TargetConfig struct {
 	PRAssignees       []string           `yaml:"pr_assignees,omitempty"`        // Override default PR assignees
 	PRReviewers       []string           `yaml:"pr_reviewers,omitempty"`        // Override default PR reviewers
 	PRTeamReviewers   []string           `yaml:"pr_team_reviewers,omitempty"`   // Override default PR team reviewers
+	PRDraft           *bool              `yaml:"pr_draft,omitempty"`            // Override whether PRs are created as drafts
+
+	BranchNameTemplate string `yaml:"branch_name_template,omitempty"` // Override the sync branch name template
+	FixedBranch        string `yaml:"fixed_branch,omitempty"`         // Force-push every sync to this branch and reuse its PR instead of creating timestamped branches
+
+	PRBodyExtraSections []PRBodySection `yaml:"pr_body_extra_sections,omitempty"` // Override default extra PR body sections
+	UseTargetPRTemplate *bool           `yaml:"use_target_pr_template,omitempty"` // Override whether PR bodies use the target repo's pull request template
+
+	PostSyncHook      string `yaml:"post_sync_hook,omitempty"`      // Override the default post-sync hook
+	HookFailurePolicy string `yaml:"hook_failure_policy,omitempty"` // Override the default hook failure policy
+}
+
+// SourcePath returns the path in the source repository of the mapping source
+// src, which is relative to SourceRoot when one is set
+func (t TargetConfig) SourcePath(src string) string {
+	if t.SourceRoot == "" {
+		return src
+	}
+	return path.Join(t.SourceRoot, src)
 }
 
 // FileMapping defines source to destination file mapping
 type FileMapping struct {
-	Src    string `yaml:"src"`              // Source file path
-	Dest   string `yaml:"dest"`             // Destination file path
-	Delete bool   `yaml:"delete,omitempty"` // Delete the destination file instead of syncing
+	Src         string         `yaml:"src"`                    // Source file path, or a glob such as configs/*.yaml synced per matching file
+	Dest        string         `yaml:"dest"`                   // Destination file path
+	Delete      bool           `yaml:"delete,omitempty"`       // Delete the destination file instead of syncing
+	When        *FileCondition `yaml:"when,omitempty"`         // Only sync to targets matching this condition
+	MarkerStart string         `yaml:"marker_start,omitempty"` // Line opening the managed block; only the block is synced
+	MarkerEnd   string         `yaml:"marker_end,omitempty"`   // Line closing the managed block
+}
+
+// IsManagedBlock reports whether the mapping syncs only the block between its
+// markers, leaving the rest of the target file alone
+func (f FileMapping) IsManagedBlock() bool {
+	return f.MarkerStart != "" || f.MarkerEnd != ""
+}
+
+// IsGlob reports whether Src is a glob pattern expanded against the source
+// tree, syncing each matching file to its own rendering of Dest
+func (f FileMapping) IsGlob() bool {
+	return !f.Delete && strings.ContainsAny(f.Src, "*?[")
+}
+
+// FileCondition limits a file mapping to the targets it matches. Every set
+// predicate must match.
+type FileCondition struct {
+	RepoMatches string `yaml:"repo_matches,omitempty"` // Regular expression the target repo ("org/name") must match
+	HasTopic    string `yaml:"has_topic,omitempty"`    // Topic the target repository must have
+}
+
+// NeedsTopics reports whether evaluating the condition requires the target's topics
+func (c *FileCondition) NeedsTopics() bool {
+	return c != nil && c.HasTopic != ""
+}
+
+// Matches reports whether a target with the given repo name and topics meets
+// the condition. A nil condition matches every target; an invalid pattern,
+// which validation rejects, matches none.
+func (c *FileCondition) Matches(repo string, topics []string) bool {
+	if c == nil {
+		return true
+	}
+	if c.RepoMatches != "" {
+		matched, err := regexp.MatchString(c.RepoMatches, repo)
+		if err != nil || !matched {
+			return false
+		}
+	}
+	if c.HasTopic != "" && !slices.ContainsFunc(topics, func(topic string) bool {
+		return strings.EqualFold(topic, c.HasTopic)
+	}) {
+		return false
+	}
+	return true
 }
 
 // DirectoryMapping defines source to destination directory mapping
@@ -166,8 +358,155 @@ type DirectoryMapping struct {
 
 // Transform defines transformation settings
 type Transform struct {
-	RepoName  bool              `yaml:"repo_name,omitempty"` // Replace repository names
-	Variables map[string]string `yaml:"variables,omitempty"` // Template variables
+	RepoName            bool              `yaml:"repo_name,omitempty"`             // Replace repository names
+	Variables           map[string]string `yaml:"variables,omitempty"`             // Template variables
+	VariablesFile       string            `yaml:"variables_file,omitempty"`        // JSON or YAML file of variables merged in at load time; inline variables win
+	TemplateRender      bool              `yaml:"template_render,omitempty"`       // Render matching source files as text/template
+	TemplateSuffix      string            `yaml:"template_suffix,omitempty"`       // Suffix of files to render (default: ".tmpl")
+	GoModulePath        string            `yaml:"go_module_path,omitempty"`        // Rewrite Go imports of the source module to this path ("auto" = github.com/<target repo>)
+	GoSourceModulePath  string            `yaml:"go_source_module_path,omitempty"` // Source module path when it is not github.com/<source repo>
+	UpdateCopyrightYear bool              `yaml:"update_copyright_year,omitempty"` // Move the end year of copyright notices forward
+	CopyrightYear       int               `yaml:"copyright_year,omitempty"`        // End year to write (default: current year)
+	ManagedHeader       string            `yaml:"managed_header,omitempty"`        // Header text written as a comment at the top of each file
+	StripManagedHeader  bool              `yaml:"strip_managed_header,omitempty"`  // Remove an existing managed header instead of writing one
+	LineEndings         string            `yaml:"line_endings,omitempty"`          // Normalize text file line endings: lf, crlf or preserve (default)
+	SecretScrub         *SecretScrub      `yaml:"secret_scrub,omitempty"`          // Replace secret-like text in text files before it reaches targets
+	ConditionalBlocks   bool              `yaml:"conditional_blocks,omitempty"`    // Keep go-broadcast:include/exclude regions only for matching targets
+	Pipeline            []string          `yaml:"pipeline,omitempty"`              // Transforms to apply, in order; unlisted transforms are off (default: DefaultTransformPipeline)
+}
+
+// Transform pipeline step names
+const (
+	TransformStepConditionalBlocks = "conditional_blocks"
+	TransformStepEmail             = "email"
+	TransformStepVariables         = "variables"
+	TransformStepTemplateRender    = "template_render"
+	TransformStepGoImports         = "go_imports"
+	TransformStepCopyrightYear     = "copyright_year"
+	TransformStepRepoName          = "repo_name"
+	TransformStepManagedHeader     = "managed_header"
+	TransformStepSecretScrub       = "secret_scrub"
+	TransformStepLineEndings       = "line_endings"
+)
+
+// DefaultTransformPipeline returns the order transforms run in when no
+// pipeline is configured. Conditional blocks are resolved first, so regions a
+// target does not keep are never transformed. Emails are replaced before repository names so
+// addresses are not corrupted by repo renames, and the managed header is
+// written after them so its source repository name is not renamed. Secrets
+// are scrubbed after every transform that writes content, so variables cannot
+// reintroduce them, and line endings are normalized last, covering lines the
+// other transforms wrote.
+func DefaultTransformPipeline() []string {
+	return []string{
+		TransformStepConditionalBlocks,
+		TransformStepEmail,
+		TransformStepVariables,
+		TransformStepTemplateRender,
+		TransformStepGoImports,
+		TransformStepCopyrightYear,
+		TransformStepRepoName,
+		TransformStepManagedHeader,
+		TransformStepSecretScrub,
+		TransformStepLineEndings,
+	}
+}
+
+// DefaultTemplateSuffix is the suffix of rendered template files when none is configured
+const DefaultTemplateSuffix = ".tmpl"
+
+// RenderSuffix returns the suffix of source files to render as templates,
+// or an empty string when template rendering is disabled
+func (t Transform) RenderSuffix() string {
+	if !t.TemplateRender {
+		return ""
+	}
+	if t.TemplateSuffix == "" {
+		return DefaultTemplateSuffix
+	}
+	return t.TemplateSuffix
+}
+
+// GoModulePathAuto derives the Go module path from the target repository name
+const GoModulePathAuto = "auto"
+
+// TargetGoModulePath returns the module path Go imports are rewritten to for
+// targetRepo, or an empty string when import rewriting is disabled
+func (t Transform) TargetGoModulePath(targetRepo string) string {
+	if t.GoModulePath == GoModulePathAuto {
+		return "github.com/" + targetRepo
+	}
+	return t.GoModulePath
+}
+
+// TargetCopyrightYear returns the end year written into copyright notices,
+// or zero when copyright year updates are disabled
+func (t Transform) TargetCopyrightYear() int {
+	if !t.UpdateCopyrightYear {
+		return 0
+	}
+	if t.CopyrightYear > 0 {
+		return t.CopyrightYear
+	}
+	return time.Now().Year()
+}
+
+// NormalizesLineEndings reports whether line endings are rewritten, i.e.
+// LineEndings is set to a mode other than LineEndingsPreserve
+func (t Transform) NormalizesLineEndings() bool {
+	return t.LineEndings != "" && t.LineEndings != LineEndingsPreserve
+}
+
+// IsEmpty reports whether no transformations are configured
+func (t Transform) IsEmpty() bool {
+	return !t.RepoName && len(t.Variables) == 0 && !t.TemplateRender && t.GoModulePath == "" && !t.UpdateCopyrightYear &&
+		t.ManagedHeader == "" && !t.StripManagedHeader && !t.NormalizesLineEndings() && t.SecretScrub == nil &&
+		!t.ConditionalBlocks && len(t.Pipeline) == 0
+}
+
+// SecretScrub configures the secret_scrub transform, which replaces text
+// matching secret-like patterns in text files. An empty secret_scrub block
+// scrubs DefaultSecretScrubPatterns with DefaultSecretScrubReplacement.
+type SecretScrub struct {
+	Patterns    []string `yaml:"patterns,omitempty"`      // Regular expressions to scrub; replaces the defaults (default: DefaultSecretScrubPatterns)
+	Replacement string   `yaml:"replacement,omitempty"`   // Text written in place of each match (default: DefaultSecretScrubReplacement)
+	FailOnMatch bool     `yaml:"fail_on_match,omitempty"` // Fail the file instead of replacing a match
+}
+
+// DefaultSecretScrubReplacement is the text written in place of a scrubbed secret
+const DefaultSecretScrubReplacement = "REDACTED"
+
+// DefaultSecretScrubPatterns returns the patterns scrubbed when none are
+// configured. Each matches a credential format with a distinctive prefix or
+// framing, so ordinary code and prose are left alone.
+func DefaultSecretScrubPatterns() []string {
+	return []string{
+		`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`,                                              // AWS access key IDs
+		`\bgh[pousr]_[A-Za-z0-9]{36,255}\b`,                                          // GitHub tokens
+		`\bgithub_pat_[A-Za-z0-9_]{22,255}\b`,                                        // GitHub fine-grained personal access tokens
+		`\bxox[abeoprs]-[A-Za-z0-9-]{10,}`,                                           // Slack tokens
+		`\b[rs]k_live_[A-Za-z0-9]{16,}\b`,                                            // Stripe live keys
+		`\bAIza[0-9A-Za-z_-]{35}`,                                                    // Google API keys
+		`\beyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}`,         // JSON web tokens
+		`-----BEGIN[A-Z ]* PRIVATE KEY-----[\s\S]*?-----END[A-Z ]* PRIVATE KEY-----`, // PEM private keys
+	}
+}
+
+// ScrubPatterns returns the patterns to scrub: the configured ones, or
+// DefaultSecretScrubPatterns when none are configured
+func (s SecretScrub) ScrubPatterns() []string {
+	if len(s.Patterns) == 0 {
+		return DefaultSecretScrubPatterns()
+	}
+	return s.Patterns
+}
+
+// ScrubReplacement returns the text written in place of each match
+func (s SecretScrub) ScrubReplacement() string {
+	if s.Replacement == "" {
+		return DefaultSecretScrubReplacement
+	}
+	return s.Replacement
 }
 
 // Group represents a sync group with its own source and targets
@@ -182,6 +521,10 @@ type Group struct {
 	Global      GlobalConfig   `yaml:"global,omitempty"`      // Group-level globals
 	Defaults    DefaultConfig  `yaml:"defaults,omitempty"`    // Group-level defaults
 	Targets     []TargetConfig `yaml:"targets"`               // Target repositories
+
+	// ExpandedFrom is the ID of the multi-branch group this group was expanded
+	// from by ExpandSourceBranches; empty for configured groups
+	ExpandedFrom string `yaml:"-" json:"-"`
 }
 
 // ModuleConfig defines module-aware sync settings
@@ -215,28 +558,28 @@ type SettingsPreset struct {
 	Description string `yaml:"description,omitempty"` // Optional description
 
 	// Repository feature flags
-	HasIssues      bool `yaml:"has_issues"`
-	HasWiki        bool `yaml:"has_wiki"`
-	HasProjects    bool `yaml:"has_projects"`
-	HasDiscussions bool `yaml:"has_discussions"`
+	HasIssues      bool `yaml:"has_issues"`      // Enable issues
+	HasWiki        bool `yaml:"has_wiki"`        // Enable the wiki
+	HasProjects    bool `yaml:"has_projects"`    // Enable projects
+	HasDiscussions bool `yaml:"has_discussions"` // Enable discussions
 
 	// Merge settings
-	AllowSquashMerge    bool `yaml:"allow_squash_merge"`
-	AllowMergeCommit    bool `yaml:"allow_merge_commit"`
-	AllowRebaseMerge    bool `yaml:"allow_rebase_merge"`
-	DeleteBranchOnMerge bool `yaml:"delete_branch_on_merge"`
-	AllowAutoMerge      bool `yaml:"allow_auto_merge"`
-	AllowUpdateBranch   bool `yaml:"allow_update_branch"`
+	AllowSquashMerge    bool `yaml:"allow_squash_merge"`     // Allow squash merging pull requests
+	AllowMergeCommit    bool `yaml:"allow_merge_commit"`     // Allow merge commits
+	AllowRebaseMerge    bool `yaml:"allow_rebase_merge"`     // Allow rebase merging
+	DeleteBranchOnMerge bool `yaml:"delete_branch_on_merge"` // Delete head branches after merge
+	AllowAutoMerge      bool `yaml:"allow_auto_merge"`       // Allow auto-merge on pull requests
+	AllowUpdateBranch   bool `yaml:"allow_update_branch"`    // Suggest updating pull request branches
 
 	// Squash merge commit format
 	SquashMergeCommitTitle   string `yaml:"squash_merge_commit_title,omitempty"`   // PR_TITLE or COMMIT_OR_PR_TITLE
 	SquashMergeCommitMessage string `yaml:"squash_merge_commit_message,omitempty"` // COMMIT_MESSAGES, PR_BODY, or BLANK
 
 	// Rulesets
-	Rulesets []RulesetConfig `yaml:"rulesets,omitempty"`
+	Rulesets []RulesetConfig `yaml:"rulesets,omitempty"` // Branch and tag rulesets to apply
 
 	// Labels
-	Labels []LabelSpec `yaml:"labels,omitempty"`
+	Labels []LabelSpec `yaml:"labels,omitempty"` // Issue labels to create
 }
 
 // RulesetConfig defines a repository ruleset
@@ -251,9 +594,9 @@ type RulesetConfig struct {
 
 // LabelSpec defines a repository label
 type LabelSpec struct {
-	Name        string `yaml:"name"`
-	Color       string `yaml:"color"`
-	Description string `yaml:"description,omitempty"`
+	Name        string `yaml:"name"`                  // Label name
+	Color       string `yaml:"color"`                 // Hex color without "#" (e.g., "d73a4a")
+	Description string `yaml:"description,omitempty"` // Optional description
 }
 
 // boolPtr is a helper function to create a pointer to a boolean value.
@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -153,6 +154,41 @@ func TestTransformWithEmptyVariables(t *testing.T) {
 	assert.Empty(t, transform.Variables)
 }
 
+// TestTransformRenderSuffix tests the effective template suffix
+func TestTransformRenderSuffix(t *testing.T) {
+	assert.Empty(t, Transform{TemplateSuffix: ".tpl"}.RenderSuffix())
+	assert.Equal(t, DefaultTemplateSuffix, Transform{TemplateRender: true}.RenderSuffix())
+	assert.Equal(t, ".tpl", Transform{TemplateRender: true, TemplateSuffix: ".tpl"}.RenderSuffix())
+}
+
+// TestTransformIsEmpty tests detection of configured transformations
+func TestTransformIsEmpty(t *testing.T) {
+	assert.True(t, Transform{}.IsEmpty())
+	assert.True(t, Transform{Variables: map[string]string{}}.IsEmpty())
+	assert.False(t, Transform{RepoName: true}.IsEmpty())
+	assert.False(t, Transform{Variables: map[string]string{"A": "b"}}.IsEmpty())
+	assert.False(t, Transform{TemplateRender: true}.IsEmpty())
+	assert.False(t, Transform{GoModulePath: GoModulePathAuto}.IsEmpty())
+	assert.False(t, Transform{UpdateCopyrightYear: true}.IsEmpty())
+	assert.False(t, Transform{ManagedHeader: "Source: org/template"}.IsEmpty())
+	assert.False(t, Transform{StripManagedHeader: true}.IsEmpty())
+	assert.False(t, Transform{Pipeline: []string{TransformStepVariables}}.IsEmpty())
+}
+
+// TestTransformTargetCopyrightYear tests resolution of the copyright end year
+func TestTransformTargetCopyrightYear(t *testing.T) {
+	assert.Zero(t, Transform{CopyrightYear: 2020}.TargetCopyrightYear())
+	assert.Equal(t, time.Now().Year(), Transform{UpdateCopyrightYear: true}.TargetCopyrightYear())
+	assert.Equal(t, 2020, Transform{UpdateCopyrightYear: true, CopyrightYear: 2020}.TargetCopyrightYear())
+}
+
+// TestTransformTargetGoModulePath tests resolution of the Go import rewrite target
+func TestTransformTargetGoModulePath(t *testing.T) {
+	assert.Empty(t, Transform{}.TargetGoModulePath("acme/service"))
+	assert.Equal(t, "github.com/acme/service", Transform{GoModulePath: GoModulePathAuto}.TargetGoModulePath("acme/service"))
+	assert.Equal(t, "go.acme.dev/service", Transform{GoModulePath: "go.acme.dev/service"}.TargetGoModulePath("acme/service"))
+}
+
 // TestConfigFieldModification tests that group fields can be modified
 func TestConfigFieldModification(t *testing.T) {
 	config := &Config{
@@ -639,3 +675,76 @@ func TestBoolPtrHelper(t *testing.T) {
 		assert.False(t, *ptr)
 	})
 }
+
+func TestIsLocalSource(t *testing.T) {
+	tests := []struct {
+		repo  string
+		local bool
+		path  string
+	}{
+		{repo: "org/template", local: false, path: "org/template"},
+		{repo: "/srv/templates", local: true, path: "/srv/templates"},
+		{repo: "./templates", local: true, path: "./templates"},
+		{repo: "../templates", local: true, path: "../templates"},
+		{repo: "file:///srv/templates", local: true, path: "/srv/templates"},
+		{repo: "templates", local: false, path: "templates"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.repo, func(t *testing.T) {
+			assert.Equal(t, tt.local, IsLocalSource(tt.repo))
+			assert.Equal(t, tt.local, SourceConfig{Repo: tt.repo}.IsLocal())
+			assert.Equal(t, tt.path, LocalSourcePath(tt.repo))
+		})
+	}
+}
+
+func TestValidate_LocalSource(t *testing.T) {
+	newConfig := func(source SourceConfig) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:    "test",
+				ID:      "test",
+				Source:  source,
+				Targets: []TargetConfig{{Repo: "org/target", Files: []FileMapping{{Src: "a", Dest: "a"}}}},
+			}},
+		}
+	}
+
+	require.NoError(t, newConfig(SourceConfig{Repo: "./templates", Branch: "main"}).Validate())
+	require.NoError(t, newConfig(SourceConfig{Repo: "file:///srv/templates", Branch: "main"}).Validate())
+	require.ErrorIs(t, newConfig(SourceConfig{Repo: "file://", Branch: "main"}).Validate(), ErrEmptyLocalSource)
+	require.ErrorIs(t, newConfig(SourceConfig{Repo: "./templates", Branches: []string{"main", "release"}}).Validate(),
+		ErrLocalSourceBranches)
+	require.Error(t, newConfig(SourceConfig{Repo: "./templates"}).Validate(), "branch is still required")
+}
+
+func TestFileConditionMatches(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition *FileCondition
+		repo      string
+		topics    []string
+		want      bool
+	}{
+		{name: "nil condition", condition: nil, repo: "org/web", want: true},
+		{name: "repo matches", condition: &FileCondition{RepoMatches: "-service$"}, repo: "org/api-service", want: true},
+		{name: "repo does not match", condition: &FileCondition{RepoMatches: "-service$"}, repo: "org/web", want: false},
+		{name: "topic present", condition: &FileCondition{HasTopic: "go"}, repo: "org/web", topics: []string{"docker", "go"}, want: true},
+		{name: "topic compared case-insensitively", condition: &FileCondition{HasTopic: "Go"}, repo: "org/web", topics: []string{"go"}, want: true},
+		{name: "topic missing", condition: &FileCondition{HasTopic: "go"}, repo: "org/web", topics: []string{"node"}, want: false},
+		{name: "all predicates must match", condition: &FileCondition{RepoMatches: "^org/", HasTopic: "go"}, repo: "org/web", topics: []string{"node"}, want: false},
+		{name: "invalid pattern matches nothing", condition: &FileCondition{RepoMatches: "("}, repo: "org/web", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.condition.Matches(tt.repo, tt.topics))
+		})
+	}
+
+	assert.False(t, (*FileCondition)(nil).NeedsTopics())
+	assert.False(t, (&FileCondition{RepoMatches: "x"}).NeedsTopics())
+	assert.True(t, (&FileCondition{HasTopic: "go"}).NeedsTopics())
+}
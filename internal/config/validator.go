@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -43,6 +45,11 @@ var (
 	ErrListNameEmpty = errors.New("list name cannot be empty")
 	// ErrListReferenceNotFound indicates a referenced list does not exist
 	ErrListReferenceNotFound = errors.New("list reference not found")
+	// ErrEmptyStdinConfig indicates --config - was used but nothing was piped to stdin
+	ErrEmptyStdinConfig = errors.New("no configuration received")
+
+	// ErrNoConfigFiles indicates a --config value named no YAML files to merge
+	ErrNoConfigFiles = errors.New("no configuration files found")
 	// ErrCircularDependency indicates a circular dependency between groups
 	ErrCircularDependency = errors.New("circular dependency detected")
 	// ErrUnknownDependency indicates a group depends on a non-existent group
@@ -53,8 +60,308 @@ var (
 	ErrInvalidRateLimitMargin = errors.New("rate_limit_preflight primary_margin_percent must be between 0 and 100")
 	// ErrInvalidRateLimitReserve indicates the secondary reserve is negative
 	ErrInvalidRateLimitReserve = errors.New("rate_limit_preflight secondary_reserve must be >= 0")
+	// ErrInvalidMaxParallelGroups indicates the group parallelism limit is negative
+	ErrInvalidMaxParallelGroups = errors.New("max_parallel_groups must be >= 0")
+	// ErrInvalidAutomergeMethod indicates the automerge method is not merge, squash, or rebase
+	ErrInvalidAutomergeMethod = errors.New("automerge_method must be one of: merge, squash, rebase")
+	// ErrInvalidPRLabelsMode indicates the --pr-labels-mode value is not replace or merge
+	ErrInvalidPRLabelsMode = errors.New("pr labels mode must be one of: replace, merge")
+	// ErrInvalidPRUpdateRetries indicates the PR update retry count is negative
+	ErrInvalidPRUpdateRetries = errors.New("pr_update_retries must be >= 0")
+
+	// ErrInvalidPRFileChecklistMax indicates the PR file checklist maximum is negative
+	ErrInvalidPRFileChecklistMax = errors.New("pr_file_checklist_max must be >= 0")
+	// ErrInvalidTemplateSuffix indicates the template suffix is not a plain file suffix
+	ErrInvalidTemplateSuffix = errors.New("template_suffix must start with '.' and cannot contain path separators")
+	// ErrInvalidGoModulePath indicates go_module_path is neither "auto" nor a plausible module path
+	ErrInvalidGoModulePath = errors.New(`go_module_path must be "auto" or a module path such as github.com/org/repo`)
+	// ErrInvalidCopyrightYear indicates copyright_year is not a four-digit year
+	ErrInvalidCopyrightYear = errors.New("copyright_year must be a four-digit year")
+	// ErrManagedHeaderConflict indicates a transform both writes and strips the managed header
+	ErrManagedHeaderConflict = errors.New("managed_header and strip_managed_header cannot both be set")
+	// ErrInvalidPRBodySection indicates an extra PR body section is missing a title or would break metadata parsing
+	ErrInvalidPRBodySection = errors.New("invalid pr_body_extra_sections entry")
+	// ErrInvalidProvider indicates the forge provider is not supported
+	ErrInvalidProvider = errors.New("provider must be one of: github, bitbucket")
+	// ErrInvalidSigningFormat indicates the commit signing format is not gpg or ssh
+	ErrInvalidSigningFormat = errors.New("commit_signing format must be one of: gpg, ssh")
+	// ErrSigningKeyRequired indicates a commit signing format was set without a key
+	ErrSigningKeyRequired = errors.New("commit_signing key is required when a format is set")
+	// ErrIncompleteCommitIdentity indicates a commit author or committer has only a name or only an email
+	ErrIncompleteCommitIdentity = errors.New("commit identity needs both a name and an email")
+	// ErrSourceBranchConflict indicates a group source sets both branch and branches
+	ErrSourceBranchConflict = errors.New("source cannot set both branch and branches")
+	// ErrDuplicateSourceBranch indicates a source branch is listed more than once
+	ErrDuplicateSourceBranch = errors.New("duplicate source branch")
+	// ErrLocalSourceBranches indicates a local source lists branches to sync
+	ErrLocalSourceBranches = errors.New("a local source cannot set branches")
+	// ErrEmptyLocalSource indicates a local source without a directory
+	ErrEmptyLocalSource = errors.New("local source path is empty")
+	// ErrInvalidHookFailurePolicy indicates the post-sync hook failure policy is not supported
+	ErrInvalidHookFailurePolicy = errors.New("hook_failure_policy must be one of: warn, fail")
+	// ErrInvalidBinaryTransformPolicy indicates the --binary-transform-policy value is not skip or fail
+	ErrInvalidBinaryTransformPolicy = errors.New("binary transform policy must be one of: skip, fail")
+	// ErrInvalidHookTimeout indicates the post-sync hook timeout is negative
+	ErrInvalidHookTimeout = errors.New("hook_timeout_seconds must be >= 0")
+	// ErrInvalidLineEndings indicates a transform's line_endings mode is not supported
+	ErrInvalidLineEndings = errors.New("line_endings must be one of: preserve, lf, crlf")
+	// ErrInvalidSecretScrub indicates a transform's secret_scrub pattern does not compile or matches empty text
+	ErrInvalidSecretScrub = errors.New("invalid secret_scrub pattern")
+	// ErrUnknownTransformStep indicates a transform pipeline names a transform that does not exist
+	ErrUnknownTransformStep = errors.New("unknown transform in pipeline")
+	// ErrDuplicateTransformStep indicates a transform pipeline lists a transform twice
+	ErrDuplicateTransformStep = errors.New("duplicate transform in pipeline")
+	// ErrInvalidFileCondition indicates a file mapping's when condition is malformed
+	ErrInvalidFileCondition = errors.New("invalid file mapping condition")
+	// ErrInvalidFileMarkers indicates a file mapping's managed block markers are malformed
+	ErrInvalidFileMarkers = errors.New("invalid file mapping markers")
+	// ErrInvalidReviewerPool indicates a PR reviewer pool is malformed
+	ErrInvalidReviewerPool = errors.New("invalid pr_reviewer_pool")
+	// ErrInvalidAutoLabel indicates an auto_labels entry has an empty glob or label
+	ErrInvalidAutoLabel = errors.New("invalid auto_labels entry")
+	// ErrInvalidBranchNameTemplate indicates a branch_name_template does not render a valid branch name
+	ErrInvalidBranchNameTemplate = errors.New("invalid branch_name_template")
+	// ErrInvalidDestTemplate indicates a templated dest does not render a path inside the repository
+	ErrInvalidDestTemplate = errors.New("invalid dest template")
+	// ErrInvalidFileGlob indicates a glob src is malformed or its dest does not name each matching file
+	ErrInvalidFileGlob = errors.New("invalid glob src")
+	// ErrInvalidSourceRoot indicates a target's source_root is not a relative path inside the source repository
+	ErrInvalidSourceRoot = errors.New("source_root must be a relative path inside the source repository")
+	// ErrInvalidFixedBranch indicates a target's fixed_branch is not a valid branch name or is the PR base branch
+	ErrInvalidFixedBranch = errors.New("invalid fixed_branch")
+	// ErrInvalidVariablesFile indicates a transform variables_file cannot be read or does not hold variables
+	ErrInvalidVariablesFile = errors.New("invalid variables_file")
+	// ErrInvalidFork indicates a target's fork cannot host a cross-repository pull request
+	ErrInvalidFork = errors.New("invalid target fork")
 )
 
+// prMetadataMarker opens the metadata block that must stay last in sync PR bodies
+const prMetadataMarker = "go-broadcast-metadata"
+
+// validatePRBodySections checks that every extra section has a title and that
+// no section embeds the metadata marker, which would confuse PR state parsing
+func validatePRBodySections(field string, sections []PRBodySection) error {
+	for i, section := range sections {
+		if strings.TrimSpace(section.Title) == "" {
+			return fmt.Errorf("%w: %s[%d] title cannot be empty", ErrInvalidPRBodySection, field, i)
+		}
+		if strings.ContainsAny(section.Title, "\r\n") {
+			return fmt.Errorf("%w: %s[%d] title must be a single line", ErrInvalidPRBodySection, field, i)
+		}
+		if strings.Contains(section.Title, prMetadataMarker) || strings.Contains(section.Markdown, prMetadataMarker) {
+			return fmt.Errorf("%w: %s[%d] cannot contain %q", ErrInvalidPRBodySection, field, i, prMetadataMarker)
+		}
+	}
+	return nil
+}
+
+// validateReviewerPool checks that a PR reviewer pool names unique reviewers,
+// a known strategy, a non-negative count and weights for pool members only
+func validateReviewerPool(pool *PRReviewerPool) error {
+	if pool == nil {
+		return nil
+	}
+	if len(pool.Reviewers) == 0 {
+		return fmt.Errorf("%w: reviewers cannot be empty", ErrInvalidReviewerPool)
+	}
+	seen := make(map[string]bool, len(pool.Reviewers))
+	for i, reviewer := range pool.Reviewers {
+		if strings.TrimSpace(reviewer) == "" {
+			return fmt.Errorf("%w: reviewers[%d] cannot be empty", ErrInvalidReviewerPool, i)
+		}
+		if seen[strings.ToLower(reviewer)] {
+			return fmt.Errorf("%w: duplicate reviewer %q", ErrInvalidReviewerPool, reviewer)
+		}
+		seen[strings.ToLower(reviewer)] = true
+	}
+	switch pool.Strategy {
+	case "", ReviewerStrategyRoundRobin, ReviewerStrategyRandomN, ReviewerStrategyLeastLoaded:
+	default:
+		return fmt.Errorf("%w: strategy must be one of: %s, %s, %s; got %q", ErrInvalidReviewerPool,
+			ReviewerStrategyRoundRobin, ReviewerStrategyRandomN, ReviewerStrategyLeastLoaded, pool.Strategy)
+	}
+	if pool.Count < 0 {
+		return fmt.Errorf("%w: count must be >= 0, got %d", ErrInvalidReviewerPool, pool.Count)
+	}
+	for reviewer, weight := range pool.Weights {
+		if !seen[strings.ToLower(reviewer)] {
+			return fmt.Errorf("%w: weight for %q, who is not in reviewers", ErrInvalidReviewerPool, reviewer)
+		}
+		if weight < 0 {
+			return fmt.Errorf("%w: weight for %q must be >= 0, got %d", ErrInvalidReviewerPool, reviewer, weight)
+		}
+	}
+	return nil
+}
+
+// validateGoModulePath checks that a configured Go module path is "auto" or
+// looks like a module path: slash-separated elements without spaces or quotes
+func validateGoModulePath(modulePath string) error {
+	if modulePath == "" || modulePath == GoModulePathAuto {
+		return nil
+	}
+	if strings.ContainsAny(modulePath, " \t\"'`\\") || strings.HasPrefix(modulePath, "/") ||
+		strings.HasSuffix(modulePath, "/") || strings.Contains(modulePath, "//") {
+		return fmt.Errorf("%w: got %q", ErrInvalidGoModulePath, modulePath)
+	}
+	return nil
+}
+
+// validateGoSourceModulePath checks an explicit source module path; unlike the
+// target path it has no "auto" form since the default already derives from the source repo
+func validateGoSourceModulePath(modulePath string) error {
+	if modulePath == GoModulePathAuto {
+		return fmt.Errorf("%w: go_source_module_path cannot be %q", ErrInvalidGoModulePath, GoModulePathAuto)
+	}
+	return validateGoModulePath(modulePath)
+}
+
+// validateCopyrightYear checks that a configured copyright year is a
+// four-digit year; zero selects the current year
+func validateCopyrightYear(year int) error {
+	if year != 0 && (year < 1000 || year > 9999) {
+		return fmt.Errorf("%w: got %d", ErrInvalidCopyrightYear, year)
+	}
+	return nil
+}
+
+// validateManagedHeader checks that a transform does not both write and
+// strip the managed header
+func validateManagedHeader(transform Transform) error {
+	if transform.ManagedHeader != "" && transform.StripManagedHeader {
+		return ErrManagedHeaderConflict
+	}
+	return nil
+}
+
+// validateLineEndings checks that a transform's line ending mode is supported
+func validateLineEndings(transform Transform) error {
+	switch transform.LineEndings {
+	case "", LineEndingsPreserve, LineEndingsLF, LineEndingsCRLF:
+		return nil
+	default:
+		return fmt.Errorf("%w: got %q", ErrInvalidLineEndings, transform.LineEndings)
+	}
+}
+
+// validateSecretScrub checks that a transform's secret_scrub patterns compile
+// and cannot match empty text, which would write the replacement between
+// every character
+func validateSecretScrub(transform Transform) error {
+	if transform.SecretScrub == nil {
+		return nil
+	}
+	for _, pattern := range transform.SecretScrub.Patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("%w %q: %w", ErrInvalidSecretScrub, pattern, err)
+		}
+		if re.MatchString("") {
+			return fmt.Errorf("%w %q: matches empty text", ErrInvalidSecretScrub, pattern)
+		}
+	}
+	return nil
+}
+
+// validateCommitIdentity checks that the commit author and committer each set
+// a name and a valid email together, or neither
+func (c *Config) validateCommitIdentity() error {
+	if (c.CommitAuthorName == "") != (c.CommitAuthorEmail == "") {
+		return fmt.Errorf("%w: commit_author_name and commit_author_email", ErrIncompleteCommitIdentity)
+	}
+	if (c.CommitterName == "") != (c.CommitterEmail == "") {
+		return fmt.Errorf("%w: committer_name and committer_email", ErrIncompleteCommitIdentity)
+	}
+	if err := validation.ValidateEmail(c.CommitAuthorEmail, "commit_author_email"); err != nil {
+		return err
+	}
+	return validation.ValidateEmail(c.CommitterEmail, "committer_email")
+}
+
+// validateTransformPipeline checks that a transform pipeline only names
+// existing transforms, each at most once
+func validateTransformPipeline(pipeline []string) error {
+	known := DefaultTransformPipeline()
+	seen := make(map[string]bool, len(pipeline))
+	for _, step := range pipeline {
+		if !slices.Contains(known, step) {
+			return fmt.Errorf("%w: %q (valid: %s)", ErrUnknownTransformStep, step, strings.Join(known, ", "))
+		}
+		if seen[step] {
+			return fmt.Errorf("%w: %q", ErrDuplicateTransformStep, step)
+		}
+		seen[step] = true
+	}
+	return nil
+}
+
+// validateFileCondition checks that a file mapping condition sets at least one
+// predicate and that its repo pattern compiles
+func validateFileCondition(condition *FileCondition) error {
+	if condition == nil {
+		return nil
+	}
+	if condition.RepoMatches == "" && strings.TrimSpace(condition.HasTopic) == "" {
+		return fmt.Errorf("%w: set repo_matches or has_topic", ErrInvalidFileCondition)
+	}
+	if condition.RepoMatches != "" {
+		if _, err := regexp.Compile(condition.RepoMatches); err != nil {
+			return fmt.Errorf("%w: repo_matches %q: %w", ErrInvalidFileCondition, condition.RepoMatches, err)
+		}
+	}
+	return nil
+}
+
+// validateFork checks that a target's fork is a repository of another owner,
+// so the pull request head "owner:branch" is unambiguous
+func validateFork(repo, fork string) error {
+	if fork == "" {
+		return nil
+	}
+	if err := validation.ValidateRepoName(fork); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidFork, err)
+	}
+	forkOwner, _, _ := strings.Cut(fork, "/")
+	repoOwner, _, _ := strings.Cut(repo, "/")
+	if strings.EqualFold(forkOwner, repoOwner) {
+		return fmt.Errorf("%w: fork %s must belong to a different owner than %s", ErrInvalidFork, fork, repo)
+	}
+	return nil
+}
+
+// validateFileMarkers checks that a managed block mapping sets both markers as
+// distinct single lines and does not also delete the file
+func validateFileMarkers(file FileMapping) error {
+	if !file.IsManagedBlock() {
+		return nil
+	}
+	if strings.TrimSpace(file.MarkerStart) == "" || strings.TrimSpace(file.MarkerEnd) == "" {
+		return fmt.Errorf("%w: set both marker_start and marker_end", ErrInvalidFileMarkers)
+	}
+	if strings.ContainsAny(file.MarkerStart+file.MarkerEnd, "\r\n") {
+		return fmt.Errorf("%w: markers must be single lines", ErrInvalidFileMarkers)
+	}
+	if strings.TrimSpace(file.MarkerStart) == strings.TrimSpace(file.MarkerEnd) {
+		return fmt.Errorf("%w: marker_start and marker_end must differ", ErrInvalidFileMarkers)
+	}
+	if file.Delete {
+		return fmt.Errorf("%w: cannot be combined with delete", ErrInvalidFileMarkers)
+	}
+	return nil
+}
+
+// validateTemplateSuffix checks that a configured template suffix is a plain
+// file suffix such as ".tmpl"; an empty suffix selects the default
+func validateTemplateSuffix(suffix string) error {
+	if suffix == "" {
+		return nil
+	}
+	if !strings.HasPrefix(suffix, ".") || len(suffix) < 2 || strings.ContainsAny(suffix, `/\`) {
+		return fmt.Errorf("%w: got %q", ErrInvalidTemplateSuffix, suffix)
+	}
+	return nil
+}
+
 // containsPathTraversal checks if a path contains path traversal sequences.
 // It uses filepath.Clean to normalize the path and checks if it escapes the current directory.
 // Absolute paths are allowed as they don't represent directory traversal attacks.
@@ -132,6 +439,35 @@ func (c *Config) ValidateWithLogging(ctx context.Context, logConfig *logging.Log
 		return err
 	}
 
+	if c.MaxParallelGroups < 0 {
+		return fmt.Errorf("%w: got %d", ErrInvalidMaxParallelGroups, c.MaxParallelGroups)
+	}
+
+	switch c.Provider {
+	case "", ProviderGitHub, ProviderBitbucket:
+	default:
+		return fmt.Errorf("%w: got %q", ErrInvalidProvider, c.Provider)
+	}
+
+	switch c.CommitSigning.Format {
+	case "", SigningFormatGPG, SigningFormatSSH:
+	default:
+		return fmt.Errorf("%w: got %q", ErrInvalidSigningFormat, c.CommitSigning.Format)
+	}
+	if c.CommitSigning.Format != "" && c.CommitSigning.Key == "" {
+		return ErrSigningKeyRequired
+	}
+	if err := c.validateCommitIdentity(); err != nil {
+		return err
+	}
+
+	// Validate global exclusion patterns
+	for _, pattern := range c.GlobalExclude {
+		if _, err := filepath.Match(pattern, "test"); err != nil {
+			return fmt.Errorf("global_exclude: invalid exclusion pattern %q: %w", pattern, err)
+		}
+	}
+
 	// Validate file lists if present
 	if len(c.FileLists) > 0 {
 		if logConfig != nil && logConfig.Debug.Config {
@@ -324,6 +660,51 @@ func (c *Config) validateRateLimitPreflight() error {
 	return nil
 }
 
+// ValidateAutomergeMethod checks that method is a merge method GitHub accepts for
+// auto-merge. An empty method is valid and resolves to DefaultAutomergeMethod.
+func ValidateAutomergeMethod(method string) error {
+	switch method {
+	case "", "merge", "squash", "rebase":
+		return nil
+	default:
+		return fmt.Errorf("%w: got %q", ErrInvalidAutomergeMethod, method)
+	}
+}
+
+// ValidatePRLabelsMode checks that mode is a supported way of combining CLI PR
+// overrides with configuration. An empty mode is valid and means replace.
+func ValidatePRLabelsMode(mode string) error {
+	switch mode {
+	case "", PRLabelsModeReplace, PRLabelsModeMerge:
+		return nil
+	default:
+		return fmt.Errorf("%w: got %q", ErrInvalidPRLabelsMode, mode)
+	}
+}
+
+// ValidateBinaryTransformPolicy checks that policy is a supported way of
+// handling binary files with transforms configured. An empty policy is valid
+// and means skip.
+func ValidateBinaryTransformPolicy(policy string) error {
+	switch policy {
+	case "", BinaryTransformPolicySkip, BinaryTransformPolicyFail:
+		return nil
+	default:
+		return fmt.Errorf("%w: got %q", ErrInvalidBinaryTransformPolicy, policy)
+	}
+}
+
+// ValidateHookFailurePolicy checks that policy is a supported post-sync hook
+// failure policy. An empty policy is valid and means warn.
+func ValidateHookFailurePolicy(policy string) error {
+	switch policy {
+	case "", HookFailurePolicyWarn, HookFailurePolicyFail:
+		return nil
+	default:
+		return fmt.Errorf("%w: got %q", ErrInvalidHookFailurePolicy, policy)
+	}
+}
+
 // validateGroupSourceWithLogging validates group source configuration with debug logging support.
 func (c *Config) validateGroupSourceWithLogging(ctx context.Context, logConfig *logging.LogConfig, group Group) error {
 	logger := logging.WithStandardFields(logrus.StandardLogger(), logConfig, "config-group-source")
@@ -344,8 +725,15 @@ func (c *Config) validateGroupSourceWithLogging(ctx context.Context, logConfig *
 	default:
 	}
 
+	// A multi-branch source is validated once per branch
+	if len(group.Source.Branches) > 0 {
+		if err := validateSourceBranches(group.Source); err != nil {
+			return fmt.Errorf("group %q: %w", group.Name, err)
+		}
+	}
+
 	// Use centralized validation for source configuration
-	if err := validation.ValidateSourceConfig(group.Source.Repo, group.Source.Branch); err != nil {
+	if err := validateSourceRepo(group.Source); err != nil {
 		if logConfig != nil && logConfig.Debug.Config {
 			logger.WithFields(logrus.Fields{
 				logging.StandardFields.RepoName:   group.Source.Repo,
@@ -390,6 +778,48 @@ func (c *Config) validateGroupSourceWithLogging(ctx context.Context, logConfig *
 	return nil
 }
 
+// validateSourceBranches checks a source's branches list: it cannot be combined
+// with branch, and every branch must be a valid, unique branch name
+func validateSourceBranches(source SourceConfig) error {
+	if source.Branch != "" {
+		return ErrSourceBranchConflict
+	}
+	seen := make(map[string]bool, len(source.Branches))
+	for _, branch := range source.Branches {
+		if err := validation.ValidateBranchName(branch); err != nil {
+			return err
+		}
+		if seen[branch] {
+			return fmt.Errorf("%w: %q", ErrDuplicateSourceBranch, branch)
+		}
+		seen[branch] = true
+	}
+	return nil
+}
+
+// validateSourceRepo validates the source repository and branch. A local
+// source is a directory on disk, so only its path and branch are checked.
+func validateSourceRepo(source SourceConfig) error {
+	if !source.IsLocal() {
+		return validation.ValidateSourceConfig(source.Repo, firstSourceBranch(source))
+	}
+	if len(source.Branches) > 0 {
+		return ErrLocalSourceBranches
+	}
+	if LocalSourcePath(source.Repo) == "" {
+		return ErrEmptyLocalSource
+	}
+	return validation.ValidateBranchName(source.Branch)
+}
+
+// firstSourceBranch returns the source branch, or the first of its branches
+func firstSourceBranch(source SourceConfig) string {
+	if source.Branch == "" && len(source.Branches) > 0 {
+		return source.Branches[0]
+	}
+	return source.Branch
+}
+
 // validateGroupGlobalWithLogging validates group global configuration with debug logging support.
 func (c *Config) validateGroupGlobalWithLogging(ctx context.Context, logConfig *logging.LogConfig, group Group) error {
 	logger := logging.WithStandardFields(logrus.StandardLogger(), logConfig, "config-group-global")
@@ -450,6 +880,13 @@ func (c *Config) validateGroupGlobalWithLogging(ctx context.Context, logConfig *
 		}
 	}
 
+	if err := validateBranchNameTemplate("global.branch_name_template", group.Global.BranchNameTemplate); err != nil {
+		if logConfig != nil && logConfig.Debug.Config {
+			logger.WithField("branch_name_template", group.Global.BranchNameTemplate).Error("Invalid group global branch name template")
+		}
+		return err
+	}
+
 	if logConfig != nil && logConfig.Debug.Config {
 		logger.Debug("Group global configuration validation completed successfully")
 	}
@@ -485,6 +922,14 @@ func (c *Config) validateGroupDefaultsWithLogging(ctx context.Context, logConfig
 		return err
 	}
 
+	// Validate the branch name template
+	if err := validateBranchNameTemplate("defaults.branch_name_template", group.Defaults.BranchNameTemplate); err != nil {
+		if logConfig != nil && logConfig.Debug.Config {
+			logger.WithField("branch_name_template", group.Defaults.BranchNameTemplate).Error("Invalid group branch name template")
+		}
+		return err
+	}
+
 	// Validate PR labels
 	for i, label := range group.Defaults.PRLabels {
 		if err := validation.ValidateNonEmpty("group PR label", label); err != nil {
@@ -495,6 +940,70 @@ func (c *Config) validateGroupDefaultsWithLogging(ctx context.Context, logConfig
 		}
 	}
 
+	// Validate automerge method (empty falls back to squash)
+	if err := ValidateAutomergeMethod(group.Defaults.AutomergeMethod); err != nil {
+		if logConfig != nil && logConfig.Debug.Config {
+			logger.WithField("automerge_method", group.Defaults.AutomergeMethod).Error("Invalid automerge method")
+		}
+		return err
+	}
+
+	// Validate PR update retries (unset falls back to DefaultPRUpdateRetries, zero disables retries)
+	if retries := group.Defaults.PRUpdateRetries; retries != nil && *retries < 0 {
+		if logConfig != nil && logConfig.Debug.Config {
+			logger.WithField("pr_update_retries", *retries).Error("Invalid PR update retries")
+		}
+		return fmt.Errorf("%w: got %d", ErrInvalidPRUpdateRetries, *retries)
+	}
+
+	// Validate the PR file checklist maximum (unset falls back to DefaultPRFileChecklistMax, zero disables the checklist)
+	if checklistMax := group.Defaults.PRFileChecklistMax; checklistMax != nil && *checklistMax < 0 {
+		if logConfig != nil && logConfig.Debug.Config {
+			logger.WithField("pr_file_checklist_max", *checklistMax).Error("Invalid PR file checklist maximum")
+		}
+		return fmt.Errorf("%w: got %d", ErrInvalidPRFileChecklistMax, *checklistMax)
+	}
+
+	// Validate extra PR body sections
+	if err := validatePRBodySections("defaults.pr_body_extra_sections", group.Defaults.PRBodyExtraSections); err != nil {
+		if logConfig != nil && logConfig.Debug.Config {
+			logger.WithField("section_count", len(group.Defaults.PRBodyExtraSections)).Error("Invalid PR body extra section")
+		}
+		return err
+	}
+
+	// Validate the reviewer pool
+	if err := validateReviewerPool(group.Defaults.PRReviewerPool); err != nil {
+		if logConfig != nil && logConfig.Debug.Config {
+			logger.WithError(err).Error("Invalid PR reviewer pool")
+		}
+		return err
+	}
+
+	// Validate path-based PR labels
+	for glob, label := range group.Defaults.AutoLabels {
+		if strings.TrimSpace(glob) == "" || strings.TrimSpace(label) == "" {
+			if logConfig != nil && logConfig.Debug.Config {
+				logger.WithFields(logrus.Fields{"glob": glob, "label": label}).Error("Invalid auto label")
+			}
+			return fmt.Errorf("%w: %q: %q", ErrInvalidAutoLabel, glob, label)
+		}
+	}
+
+	// Validate post-sync hook settings
+	if err := ValidateHookFailurePolicy(group.Defaults.HookFailurePolicy); err != nil {
+		if logConfig != nil && logConfig.Debug.Config {
+			logger.WithField("hook_failure_policy", group.Defaults.HookFailurePolicy).Error("Invalid hook failure policy")
+		}
+		return err
+	}
+	if group.Defaults.HookTimeoutSeconds < 0 {
+		if logConfig != nil && logConfig.Debug.Config {
+			logger.WithField("hook_timeout_seconds", group.Defaults.HookTimeoutSeconds).Error("Invalid hook timeout")
+		}
+		return fmt.Errorf("%w: got %d", ErrInvalidHookTimeout, group.Defaults.HookTimeoutSeconds)
+	}
+
 	if logConfig != nil && logConfig.Debug.Config {
 		logger.Debug("Group defaults configuration validation completed successfully")
 	}
@@ -538,7 +1047,19 @@ func (t *TargetConfig) validateWithLogging(ctx context.Context, logConfig *loggi
 
 	// Convert file mappings to validation format
 	fileMappings := make([]validation.FileMapping, 0, len(t.Files))
-	for _, file := range t.Files {
+	for i, file := range t.Files {
+		if err := validateFileCondition(file.When); err != nil {
+			return fmt.Errorf("file[%d] (%s): %w", i, file.Dest, err)
+		}
+		if err := validateFileMarkers(file); err != nil {
+			return fmt.Errorf("file[%d] (%s): %w", i, file.Dest, err)
+		}
+		if err := validateDestTemplate(file.Dest); err != nil {
+			return fmt.Errorf("file[%d]: %w", i, err)
+		}
+		if err := validateFileGlob(file); err != nil {
+			return fmt.Errorf("file[%d]: %w", i, err)
+		}
 		fileMappings = append(fileMappings, validation.FileMapping{
 			Src:    file.Src,
 			Dest:   file.Dest,
@@ -572,12 +1093,45 @@ func (t *TargetConfig) validateWithLogging(ctx context.Context, logConfig *loggi
 		logger.WithField("file_count", len(t.Files)).Debug("File mappings validated via centralized validation")
 	}
 
+	if err := validateTemplateSuffix(t.Transform.TemplateSuffix); err != nil {
+		return err
+	}
+	if err := validateGoModulePath(t.Transform.GoModulePath); err != nil {
+		return err
+	}
+	if err := validateGoSourceModulePath(t.Transform.GoSourceModulePath); err != nil {
+		return err
+	}
+	if err := validateCopyrightYear(t.Transform.CopyrightYear); err != nil {
+		return err
+	}
+	if err := validateManagedHeader(t.Transform); err != nil {
+		return err
+	}
+	if err := validateLineEndings(t.Transform); err != nil {
+		return err
+	}
+	if err := validateSecretScrub(t.Transform); err != nil {
+		return err
+	}
+	if err := validateTransformPipeline(t.Transform.Pipeline); err != nil {
+		return err
+	}
+	if err := validateFork(t.Repo, t.Fork); err != nil {
+		return err
+	}
+
 	// Log transform configuration if present
 	if logConfig != nil && logConfig.Debug.Config {
-		if t.Transform.RepoName || len(t.Transform.Variables) > 0 {
+		if !t.Transform.IsEmpty() {
 			logger.WithFields(logrus.Fields{
 				"repo_name_transform": t.Transform.RepoName,
 				"variable_count":      len(t.Transform.Variables),
+				"template_suffix":     t.Transform.RenderSuffix(),
+				"go_module_path":      t.Transform.GoModulePath,
+				"go_source_module":    t.Transform.GoSourceModulePath,
+				"copyright_year":      t.Transform.TargetCopyrightYear(),
+				"managed_header":      t.Transform.ManagedHeader != "",
 			}).Debug("Transform configuration detected")
 
 			if len(t.Transform.Variables) > 0 {
@@ -612,6 +1166,22 @@ func (t *TargetConfig) validateWithLogging(ctx context.Context, logConfig *loggi
 		}
 	}
 
+	// Validate extra PR body sections for this target
+	if err := validatePRBodySections("pr_body_extra_sections", t.PRBodyExtraSections); err != nil {
+		if logConfig != nil && logConfig.Debug.Config {
+			logger.WithField("section_count", len(t.PRBodyExtraSections)).Error("Invalid target PR body extra section")
+		}
+		return err
+	}
+
+	// Validate the post-sync hook failure policy override
+	if err := ValidateHookFailurePolicy(t.HookFailurePolicy); err != nil {
+		if logConfig != nil && logConfig.Debug.Config {
+			logger.WithField("hook_failure_policy", t.HookFailurePolicy).Error("Invalid target hook failure policy")
+		}
+		return err
+	}
+
 	// Validate email addresses if configured
 	if err := validation.ValidateEmail(t.SecurityEmail, "target security_email"); err != nil {
 		if logConfig != nil && logConfig.Debug.Config {
@@ -662,6 +1232,27 @@ func (t *TargetConfig) validateWithLogging(ctx context.Context, logConfig *loggi
 		}
 	}
 
+	if err := validateBranchNameTemplate("branch_name_template", t.BranchNameTemplate); err != nil {
+		if logConfig != nil && logConfig.Debug.Config {
+			logger.WithField("branch_name_template", t.BranchNameTemplate).Error("Invalid target branch name template")
+		}
+		return err
+	}
+
+	if err := t.validateFixedBranch(); err != nil {
+		if logConfig != nil && logConfig.Debug.Config {
+			logger.WithField("fixed_branch", t.FixedBranch).Error("Invalid target fixed branch")
+		}
+		return err
+	}
+
+	if t.SourceRoot != "" && !filepath.IsLocal(filepath.FromSlash(t.SourceRoot)) {
+		if logConfig != nil && logConfig.Debug.Config {
+			logger.WithField("source_root", t.SourceRoot).Error("Invalid target source root")
+		}
+		return fmt.Errorf("%w: got %q", ErrInvalidSourceRoot, t.SourceRoot)
+	}
+
 	if logConfig != nil && logConfig.Debug.Config {
 		logger.Debug("Target configuration validation completed successfully")
 	}
@@ -669,6 +1260,21 @@ func (t *TargetConfig) validateWithLogging(ctx context.Context, logConfig *loggi
 	return nil
 }
 
+// validateFixedBranch checks that a set fixed_branch is a valid branch name
+// other than the PR base branch, which every sync force-pushes over
+func (t *TargetConfig) validateFixedBranch() error {
+	if t.FixedBranch == "" {
+		return nil
+	}
+	if err := validation.ValidateBranchName(t.FixedBranch); err != nil {
+		return fmt.Errorf("%w %q: %w", ErrInvalidFixedBranch, t.FixedBranch, err)
+	}
+	if t.FixedBranch == t.Branch {
+		return fmt.Errorf("%w %q: must differ from the target branch the PR is opened against", ErrInvalidFixedBranch, t.FixedBranch)
+	}
+	return nil
+}
+
 // validateDirectories validates directory mappings
 func (t *TargetConfig) validateDirectories(_ context.Context, _ *logrus.Entry) error {
 	// Check for empty directories
@@ -684,6 +1290,34 @@ func (t *TargetConfig) validateDirectories(_ context.Context, _ *logrus.Entry) e
 		if containsPathTraversal(dir.Src) || containsPathTraversal(dir.Dest) {
 			return fmt.Errorf("directory[%d]: %w", i, ErrPathTraversal)
 		}
+		if err := validateDestTemplate(dir.Dest); err != nil {
+			return fmt.Errorf("directory[%d]: %w", i, err)
+		}
+
+		if err := validateTemplateSuffix(dir.Transform.TemplateSuffix); err != nil {
+			return fmt.Errorf("directory[%d]: %w", i, err)
+		}
+		if err := validateGoModulePath(dir.Transform.GoModulePath); err != nil {
+			return fmt.Errorf("directory[%d]: %w", i, err)
+		}
+		if err := validateGoSourceModulePath(dir.Transform.GoSourceModulePath); err != nil {
+			return fmt.Errorf("directory[%d]: %w", i, err)
+		}
+		if err := validateCopyrightYear(dir.Transform.CopyrightYear); err != nil {
+			return fmt.Errorf("directory[%d]: %w", i, err)
+		}
+		if err := validateManagedHeader(dir.Transform); err != nil {
+			return fmt.Errorf("directory[%d]: %w", i, err)
+		}
+		if err := validateLineEndings(dir.Transform); err != nil {
+			return fmt.Errorf("directory[%d]: %w", i, err)
+		}
+		if err := validateSecretScrub(dir.Transform); err != nil {
+			return fmt.Errorf("directory[%d]: %w", i, err)
+		}
+		if err := validateTransformPipeline(dir.Transform.Pipeline); err != nil {
+			return fmt.Errorf("directory[%d]: %w", i, err)
+		}
 
 		// Validate exclusion patterns
 		for _, pattern := range dir.Exclude {
@@ -776,6 +1410,19 @@ func (c *Config) validateFileLists(ctx context.Context, logConfig *logging.LogCo
 			if containsPathTraversal(file.Src) || containsPathTraversal(file.Dest) {
 				return fmt.Errorf("file_list[%d] (%s) file[%d]: %w", i, list.ID, j, ErrPathTraversal)
 			}
+			if err := validateDestTemplate(file.Dest); err != nil {
+				return fmt.Errorf("file_list[%d] (%s) file[%d]: %w", i, list.ID, j, err)
+			}
+			if err := validateFileGlob(file); err != nil {
+				return fmt.Errorf("file_list[%d] (%s) file[%d]: %w", i, list.ID, j, err)
+			}
+
+			if err := validateFileCondition(file.When); err != nil {
+				return fmt.Errorf("file_list[%d] (%s) file[%d]: %w", i, list.ID, j, err)
+			}
+			if err := validateFileMarkers(file); err != nil {
+				return fmt.Errorf("file_list[%d] (%s) file[%d]: %w", i, list.ID, j, err)
+			}
 		}
 	}
 
@@ -834,6 +1481,9 @@ func (c *Config) validateDirectoryLists(ctx context.Context, logConfig *logging.
 			if containsPathTraversal(dir.Src) || containsPathTraversal(dir.Dest) {
 				return fmt.Errorf("directory_list[%d] (%s) directory[%d]: %w", i, list.ID, j, ErrPathTraversal)
 			}
+			if err := validateDestTemplate(dir.Dest); err != nil {
+				return fmt.Errorf("directory_list[%d] (%s) directory[%d]: %w", i, list.ID, j, err)
+			}
 
 			// Validate exclusion patterns
 			for k, pattern := range dir.Exclude {
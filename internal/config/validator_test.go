@@ -923,3 +923,884 @@ func TestValidate_ThreeWayCircularDependency(t *testing.T) {
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrCircularDependency)
 }
+
+// TestValidate_AutomergeMethod verifies group defaults reject unknown automerge methods
+func TestValidate_AutomergeMethod(t *testing.T) {
+	newConfig := func(method string) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:     "test",
+				ID:       "test",
+				Source:   SourceConfig{Repo: "org/source", Branch: "main"},
+				Defaults: DefaultConfig{AutomergeMethod: method},
+				Targets:  []TargetConfig{{Repo: "org/target", Files: []FileMapping{{Src: "a", Dest: "a"}}}},
+			}},
+		}
+	}
+
+	for _, method := range []string{"", "merge", "squash", "rebase"} {
+		t.Run("valid "+method, func(t *testing.T) {
+			require.NoError(t, newConfig(method).Validate())
+		})
+	}
+
+	t.Run("invalid method", func(t *testing.T) {
+		err := newConfig("fast-forward").Validate()
+		require.Error(t, err)
+		require.ErrorIs(t, err, ErrInvalidAutomergeMethod)
+		assert.Contains(t, err.Error(), "fast-forward")
+	})
+}
+
+func TestValidate_PRUpdateRetries(t *testing.T) {
+	newConfig := func(retries *int) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:     "test",
+				ID:       "test",
+				Source:   SourceConfig{Repo: "org/source", Branch: "main"},
+				Defaults: DefaultConfig{PRUpdateRetries: retries},
+				Targets:  []TargetConfig{{Repo: "org/target", Files: []FileMapping{{Src: "a", Dest: "a"}}}},
+			}},
+		}
+	}
+	retries := func(n int) *int { return &n }
+
+	require.NoError(t, newConfig(nil).Validate())
+	require.NoError(t, newConfig(retries(0)).Validate())
+	require.NoError(t, newConfig(retries(5)).Validate())
+
+	err := newConfig(retries(-1)).Validate()
+	require.ErrorIs(t, err, ErrInvalidPRUpdateRetries)
+}
+
+func TestValidate_PRFileChecklistMax(t *testing.T) {
+	newConfig := func(checklistMax *int) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:     "test",
+				ID:       "test",
+				Source:   SourceConfig{Repo: "org/source", Branch: "main"},
+				Defaults: DefaultConfig{PRFileChecklistMax: checklistMax},
+				Targets:  []TargetConfig{{Repo: "org/target", Files: []FileMapping{{Src: "a", Dest: "a"}}}},
+			}},
+		}
+	}
+	checklistMax := func(n int) *int { return &n }
+
+	require.NoError(t, newConfig(nil).Validate())
+	require.NoError(t, newConfig(checklistMax(0)).Validate())
+	require.NoError(t, newConfig(checklistMax(10)).Validate())
+
+	err := newConfig(checklistMax(-1)).Validate()
+	require.ErrorIs(t, err, ErrInvalidPRFileChecklistMax)
+}
+
+func TestValidate_GlobalExclude(t *testing.T) {
+	newConfig := func(patterns ...string) *Config {
+		return &Config{
+			Version:       1,
+			GlobalExclude: patterns,
+			Groups: []Group{{
+				Name:    "test",
+				ID:      "test",
+				Source:  SourceConfig{Repo: "org/source", Branch: "main"},
+				Targets: []TargetConfig{{Repo: "org/target", Files: []FileMapping{{Src: "a", Dest: "a"}}}},
+			}},
+		}
+	}
+
+	require.NoError(t, newConfig(".DS_Store", "**/*.swp", "!keep.swp").Validate())
+
+	err := newConfig("[unclosed").Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "global_exclude")
+}
+
+func TestValidate_TemplateSuffix(t *testing.T) {
+	newConfig := func(suffix string) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:   "test",
+				ID:     "test",
+				Source: SourceConfig{Repo: "org/source", Branch: "main"},
+				Targets: []TargetConfig{{
+					Repo:      "org/target",
+					Files:     []FileMapping{{Src: "a.tmpl", Dest: "a"}},
+					Transform: Transform{TemplateRender: true, TemplateSuffix: suffix},
+				}},
+			}},
+		}
+	}
+
+	for _, suffix := range []string{"", ".tmpl", ".gotmpl"} {
+		t.Run("valid "+suffix, func(t *testing.T) {
+			require.NoError(t, newConfig(suffix).Validate())
+		})
+	}
+
+	for _, suffix := range []string{"tmpl", ".", "./x", `.a\b`} {
+		t.Run("invalid "+suffix, func(t *testing.T) {
+			require.ErrorIs(t, newConfig(suffix).Validate(), ErrInvalidTemplateSuffix)
+		})
+	}
+
+	t.Run("invalid directory suffix", func(t *testing.T) {
+		cfg := newConfig("")
+		cfg.Groups[0].Targets[0].Directories = []DirectoryMapping{{
+			Src:       "templates",
+			Dest:      "out",
+			Transform: Transform{TemplateRender: true, TemplateSuffix: "tmpl"},
+		}}
+		err := cfg.Validate()
+		require.ErrorIs(t, err, ErrInvalidTemplateSuffix)
+		assert.Contains(t, err.Error(), "directory[0]")
+	})
+}
+
+func TestValidate_PRBodyExtraSections(t *testing.T) {
+	newConfig := func(defaults, target []PRBodySection) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:     "test",
+				ID:       "test",
+				Source:   SourceConfig{Repo: "org/source", Branch: "main"},
+				Defaults: DefaultConfig{PRBodyExtraSections: defaults},
+				Targets: []TargetConfig{{
+					Repo:                "org/target",
+					Files:               []FileMapping{{Src: "a", Dest: "a"}},
+					PRBodyExtraSections: target,
+				}},
+			}},
+		}
+	}
+
+	valid := []PRBodySection{{Title: "Checklist", Markdown: "- [ ] Reviewed {{SERVICE_NAME}}"}}
+	require.NoError(t, newConfig(nil, nil).Validate())
+	require.NoError(t, newConfig(valid, valid).Validate())
+
+	tests := []struct {
+		name     string
+		defaults []PRBodySection
+		target   []PRBodySection
+		contains string
+	}{
+		{"empty default title", []PRBodySection{{Title: "  ", Markdown: "x"}}, nil, "defaults.pr_body_extra_sections[0]"},
+		{"multi-line target title", nil, []PRBodySection{valid[0], {Title: "a\nb"}}, "pr_body_extra_sections[1]"},
+		{"metadata marker in markdown", nil, []PRBodySection{{Title: "Notes", Markdown: "<!-- go-broadcast-metadata -->"}}, "go-broadcast-metadata"},
+		{"metadata marker in title", []PRBodySection{{Title: "go-broadcast-metadata"}}, nil, "go-broadcast-metadata"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newConfig(tt.defaults, tt.target).Validate()
+			require.ErrorIs(t, err, ErrInvalidPRBodySection)
+			assert.Contains(t, err.Error(), tt.contains)
+		})
+	}
+}
+
+func TestValidate_MaxParallelGroups(t *testing.T) {
+	cfg := &Config{
+		Version: 1,
+		Groups: []Group{{
+			Name:    "test",
+			ID:      "test",
+			Source:  SourceConfig{Repo: "org/source", Branch: "main"},
+			Targets: []TargetConfig{{Repo: "org/target", Files: []FileMapping{{Src: "a", Dest: "a"}}}},
+		}},
+	}
+
+	for _, limit := range []int{0, 1, 4} {
+		cfg.MaxParallelGroups = limit
+		require.NoError(t, cfg.Validate(), "limit %d", limit)
+	}
+
+	cfg.MaxParallelGroups = -1
+	require.ErrorIs(t, cfg.Validate(), ErrInvalidMaxParallelGroups)
+}
+
+func TestValidate_Provider(t *testing.T) {
+	cfg := &Config{
+		Version: 1,
+		Groups: []Group{{
+			Name:    "test",
+			ID:      "test",
+			Source:  SourceConfig{Repo: "org/source", Branch: "main"},
+			Targets: []TargetConfig{{Repo: "org/target", Files: []FileMapping{{Src: "a", Dest: "a"}}}},
+		}},
+	}
+
+	for _, provider := range []string{"", ProviderGitHub, ProviderBitbucket} {
+		cfg.Provider = provider
+		require.NoError(t, cfg.Validate(), "provider %q", provider)
+	}
+
+	cfg.Provider = "gitlab"
+	require.ErrorIs(t, cfg.Validate(), ErrInvalidProvider)
+}
+
+func TestValidate_CommitSigning(t *testing.T) {
+	cfg := &Config{
+		Version: 1,
+		Groups: []Group{{
+			Name:    "test",
+			ID:      "test",
+			Source:  SourceConfig{Repo: "org/source", Branch: "main"},
+			Targets: []TargetConfig{{Repo: "org/target", Files: []FileMapping{{Src: "a", Dest: "a"}}}},
+		}},
+	}
+
+	for _, format := range []string{"", SigningFormatGPG, SigningFormatSSH} {
+		cfg.CommitSigning = CommitSigningConfig{Key: "ABCD1234", Format: format}
+		require.NoError(t, cfg.Validate(), "format %q", format)
+	}
+
+	cfg.CommitSigning = CommitSigningConfig{Key: "ABCD1234", Format: "x509"}
+	require.ErrorIs(t, cfg.Validate(), ErrInvalidSigningFormat)
+
+	cfg.CommitSigning = CommitSigningConfig{Format: SigningFormatSSH}
+	require.ErrorIs(t, cfg.Validate(), ErrSigningKeyRequired)
+}
+
+func TestValidate_CommitIdentity(t *testing.T) {
+	newConfig := func() *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:    "test",
+				ID:      "test",
+				Source:  SourceConfig{Repo: "org/source", Branch: "main"},
+				Targets: []TargetConfig{{Repo: "org/target", Files: []FileMapping{{Src: "a", Dest: "a"}}}},
+			}},
+		}
+	}
+
+	cfg := newConfig()
+	cfg.CommitAuthorName, cfg.CommitAuthorEmail = "sync-bot", "bot@example.com"
+	require.NoError(t, cfg.Validate())
+
+	cfg.CommitterName, cfg.CommitterEmail = "release-bot", "release@example.com"
+	require.NoError(t, cfg.Validate())
+
+	cfg = newConfig()
+	cfg.CommitAuthorName = "sync-bot"
+	require.ErrorIs(t, cfg.Validate(), ErrIncompleteCommitIdentity)
+
+	cfg = newConfig()
+	cfg.CommitterEmail = "release@example.com"
+	require.ErrorIs(t, cfg.Validate(), ErrIncompleteCommitIdentity)
+
+	cfg = newConfig()
+	cfg.CommitAuthorName, cfg.CommitAuthorEmail = "sync-bot", "not-an-email"
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "commit_author_email")
+}
+
+func TestValidatePRLabelsMode(t *testing.T) {
+	for _, mode := range []string{"", PRLabelsModeReplace, PRLabelsModeMerge} {
+		require.NoError(t, ValidatePRLabelsMode(mode), "mode %q", mode)
+	}
+	require.ErrorIs(t, ValidatePRLabelsMode("append"), ErrInvalidPRLabelsMode)
+}
+
+func TestValidateBinaryTransformPolicy(t *testing.T) {
+	for _, policy := range []string{"", BinaryTransformPolicySkip, BinaryTransformPolicyFail} {
+		require.NoError(t, ValidateBinaryTransformPolicy(policy), "policy %q", policy)
+	}
+	require.ErrorIs(t, ValidateBinaryTransformPolicy("transform"), ErrInvalidBinaryTransformPolicy)
+}
+
+func TestValidate_GoModulePath(t *testing.T) {
+	newConfig := func(modulePath string) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:   "test",
+				ID:     "test",
+				Source: SourceConfig{Repo: "org/source", Branch: "main"},
+				Targets: []TargetConfig{{
+					Repo:      "org/target",
+					Files:     []FileMapping{{Src: "main.go", Dest: "main.go"}},
+					Transform: Transform{GoModulePath: modulePath},
+				}},
+			}},
+		}
+	}
+
+	for _, modulePath := range []string{"", "auto", "github.com/fork/lib", "go.example.com/lib/v2"} {
+		t.Run("valid "+modulePath, func(t *testing.T) {
+			require.NoError(t, newConfig(modulePath).Validate())
+		})
+	}
+
+	for _, modulePath := range []string{"github.com/fork lib", `"github.com/fork/lib"`, "/github.com/fork", "github.com/fork/", "github.com//lib"} {
+		t.Run("invalid "+modulePath, func(t *testing.T) {
+			require.ErrorIs(t, newConfig(modulePath).Validate(), ErrInvalidGoModulePath)
+		})
+	}
+
+	t.Run("explicit source module path", func(t *testing.T) {
+		cfg := newConfig("auto")
+		cfg.Groups[0].Targets[0].Transform.GoSourceModulePath = "go.example.com/source"
+		require.NoError(t, cfg.Validate())
+
+		cfg.Groups[0].Targets[0].Transform.GoSourceModulePath = "auto"
+		require.ErrorIs(t, cfg.Validate(), ErrInvalidGoModulePath)
+	})
+
+	t.Run("invalid directory module path", func(t *testing.T) {
+		cfg := newConfig("")
+		cfg.Groups[0].Targets[0].Directories = []DirectoryMapping{{
+			Src:       "pkg",
+			Dest:      "pkg",
+			Transform: Transform{GoModulePath: "bad path"},
+		}}
+		err := cfg.Validate()
+		require.ErrorIs(t, err, ErrInvalidGoModulePath)
+		assert.Contains(t, err.Error(), "directory[0]")
+	})
+}
+
+func TestValidate_CopyrightYear(t *testing.T) {
+	newConfig := func(transform Transform) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:   "test",
+				ID:     "test",
+				Source: SourceConfig{Repo: "org/source", Branch: "main"},
+				Targets: []TargetConfig{{
+					Repo:      "org/target",
+					Files:     []FileMapping{{Src: "LICENSE", Dest: "LICENSE"}},
+					Transform: transform,
+				}},
+			}},
+		}
+	}
+
+	require.NoError(t, newConfig(Transform{UpdateCopyrightYear: true}).Validate())
+	require.NoError(t, newConfig(Transform{UpdateCopyrightYear: true, CopyrightYear: 2025}).Validate())
+	for _, year := range []int{-1, 25, 20250} {
+		require.ErrorIs(t, newConfig(Transform{UpdateCopyrightYear: true, CopyrightYear: year}).Validate(), ErrInvalidCopyrightYear)
+	}
+
+	cfg := newConfig(Transform{})
+	cfg.Groups[0].Targets[0].Directories = []DirectoryMapping{{
+		Src:       "legal",
+		Dest:      "legal",
+		Transform: Transform{UpdateCopyrightYear: true, CopyrightYear: 99},
+	}}
+	err := cfg.Validate()
+	require.ErrorIs(t, err, ErrInvalidCopyrightYear)
+	assert.Contains(t, err.Error(), "directory[0]")
+}
+
+func TestValidate_ManagedHeader(t *testing.T) {
+	newConfig := func(transform Transform) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:   "test",
+				ID:     "test",
+				Source: SourceConfig{Repo: "org/source", Branch: "main"},
+				Targets: []TargetConfig{{
+					Repo:      "org/target",
+					Files:     []FileMapping{{Src: "Makefile", Dest: "Makefile"}},
+					Transform: transform,
+				}},
+			}},
+		}
+	}
+
+	require.NoError(t, newConfig(Transform{ManagedHeader: "Source: {{SOURCE_REPO}}"}).Validate())
+	require.NoError(t, newConfig(Transform{StripManagedHeader: true}).Validate())
+	require.ErrorIs(t, newConfig(Transform{ManagedHeader: "Source: {{SOURCE_REPO}}", StripManagedHeader: true}).Validate(), ErrManagedHeaderConflict)
+
+	cfg := newConfig(Transform{})
+	cfg.Groups[0].Targets[0].Directories = []DirectoryMapping{{
+		Src:       "scripts",
+		Dest:      "scripts",
+		Transform: Transform{ManagedHeader: "Managed", StripManagedHeader: true},
+	}}
+	err := cfg.Validate()
+	require.ErrorIs(t, err, ErrManagedHeaderConflict)
+	assert.Contains(t, err.Error(), "directory[0]")
+}
+
+func TestValidate_TransformPipeline(t *testing.T) {
+	newConfig := func(transform Transform) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:   "test",
+				ID:     "test",
+				Source: SourceConfig{Repo: "org/source", Branch: "main"},
+				Targets: []TargetConfig{{
+					Repo:      "org/target",
+					Files:     []FileMapping{{Src: "Makefile", Dest: "Makefile"}},
+					Transform: transform,
+				}},
+			}},
+		}
+	}
+
+	require.NoError(t, newConfig(Transform{Pipeline: DefaultTransformPipeline()}).Validate())
+	require.NoError(t, newConfig(Transform{Pipeline: []string{TransformStepManagedHeader, TransformStepVariables}}).Validate())
+
+	err := newConfig(Transform{Pipeline: []string{TransformStepVariables, "minify"}}).Validate()
+	require.ErrorIs(t, err, ErrUnknownTransformStep)
+	assert.Contains(t, err.Error(), "minify")
+
+	require.ErrorIs(t, newConfig(Transform{Pipeline: []string{TransformStepGoImports, TransformStepGoImports}}).Validate(), ErrDuplicateTransformStep)
+
+	cfg := newConfig(Transform{})
+	cfg.Groups[0].Targets[0].Directories = []DirectoryMapping{{
+		Src:       "scripts",
+		Dest:      "scripts",
+		Transform: Transform{Pipeline: []string{"unknown"}},
+	}}
+	err = cfg.Validate()
+	require.ErrorIs(t, err, ErrUnknownTransformStep)
+	assert.Contains(t, err.Error(), "directory[0]")
+}
+
+func TestValidate_FileCondition(t *testing.T) {
+	newConfig := func(when *FileCondition) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:   "test",
+				ID:     "test",
+				Source: SourceConfig{Repo: "org/source", Branch: "main"},
+				Targets: []TargetConfig{{
+					Repo:  "org/target",
+					Files: []FileMapping{{Src: "Makefile", Dest: "Makefile", When: when}},
+				}},
+			}},
+		}
+	}
+
+	require.NoError(t, newConfig(nil).Validate())
+	require.NoError(t, newConfig(&FileCondition{RepoMatches: "-service$"}).Validate())
+	require.NoError(t, newConfig(&FileCondition{HasTopic: "go"}).Validate())
+	require.ErrorIs(t, newConfig(&FileCondition{}).Validate(), ErrInvalidFileCondition)
+
+	err := newConfig(&FileCondition{RepoMatches: "(unclosed"}).Validate()
+	require.ErrorIs(t, err, ErrInvalidFileCondition)
+	assert.Contains(t, err.Error(), "file[0] (Makefile)")
+
+	cfg := newConfig(nil)
+	cfg.FileLists = []FileList{{
+		ID:    "services",
+		Name:  "Service files",
+		Files: []FileMapping{{Src: "a", Dest: "a", When: &FileCondition{RepoMatches: "["}}},
+	}}
+	err = cfg.Validate()
+	require.ErrorIs(t, err, ErrInvalidFileCondition)
+	assert.Contains(t, err.Error(), "file_list[0] (services) file[0]")
+}
+
+func TestValidate_TargetFork(t *testing.T) {
+	newConfig := func(fork string) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:   "test",
+				ID:     "test",
+				Source: SourceConfig{Repo: "org/source", Branch: "main"},
+				Targets: []TargetConfig{{
+					Repo:  "org/upstream",
+					Fork:  fork,
+					Files: []FileMapping{{Src: "Makefile", Dest: "Makefile"}},
+				}},
+			}},
+		}
+	}
+
+	require.NoError(t, newConfig("").Validate())
+	require.NoError(t, newConfig("myuser/upstream").Validate())
+	require.NoError(t, newConfig("myuser/upstream-fork").Validate())
+
+	for _, fork := range []string{"not-a-repo", "org/upstream", "ORG/other"} {
+		require.ErrorIs(t, newConfig(fork).Validate(), ErrInvalidFork, fork)
+	}
+}
+
+func TestValidate_FileMarkers(t *testing.T) {
+	newConfig := func(file FileMapping) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:    "test",
+				ID:      "test",
+				Source:  SourceConfig{Repo: "org/source", Branch: "main"},
+				Targets: []TargetConfig{{Repo: "org/target", Files: []FileMapping{file}}},
+			}},
+		}
+	}
+
+	require.NoError(t, newConfig(FileMapping{Src: ".gitignore", Dest: ".gitignore"}).Validate())
+	require.NoError(t, newConfig(FileMapping{Src: ".gitignore", Dest: ".gitignore", MarkerStart: "# BEGIN", MarkerEnd: "# END"}).Validate())
+
+	for name, file := range map[string]FileMapping{
+		"start only": {Src: "a", Dest: "a", MarkerStart: "# BEGIN"},
+		"end only":   {Src: "a", Dest: "a", MarkerEnd: "# END"},
+		"multiline":  {Src: "a", Dest: "a", MarkerStart: "# BEGIN\nx", MarkerEnd: "# END"},
+		"same":       {Src: "a", Dest: "a", MarkerStart: "# SYNC", MarkerEnd: "# SYNC"},
+		"delete":     {Dest: "a", Delete: true, MarkerStart: "# BEGIN", MarkerEnd: "# END"},
+	} {
+		err := newConfig(file).Validate()
+		require.ErrorIs(t, err, ErrInvalidFileMarkers, name)
+		assert.Contains(t, err.Error(), "file[0] (a)", name)
+	}
+}
+
+func TestValidate_PostSyncHook(t *testing.T) {
+	newConfig := func(defaults DefaultConfig, target TargetConfig) *Config {
+		target.Repo = "org/target"
+		target.Files = []FileMapping{{Src: "a", Dest: "a"}}
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:     "test",
+				ID:       "test",
+				Source:   SourceConfig{Repo: "org/source", Branch: "main"},
+				Defaults: defaults,
+				Targets:  []TargetConfig{target},
+			}},
+		}
+	}
+
+	require.NoError(t, newConfig(DefaultConfig{PostSyncHook: "./notify.sh"}, TargetConfig{}).Validate())
+	require.NoError(t, newConfig(
+		DefaultConfig{PostSyncHook: "./notify.sh", HookFailurePolicy: HookFailurePolicyWarn, HookTimeoutSeconds: 30},
+		TargetConfig{PostSyncHook: "./deploy.sh", HookFailurePolicy: HookFailurePolicyFail},
+	).Validate())
+
+	require.ErrorIs(t, newConfig(DefaultConfig{HookFailurePolicy: "ignore"}, TargetConfig{}).Validate(),
+		ErrInvalidHookFailurePolicy)
+	require.ErrorIs(t, newConfig(DefaultConfig{}, TargetConfig{HookFailurePolicy: "abort"}).Validate(),
+		ErrInvalidHookFailurePolicy)
+	require.ErrorIs(t, newConfig(DefaultConfig{HookTimeoutSeconds: -1}, TargetConfig{}).Validate(),
+		ErrInvalidHookTimeout)
+}
+
+func TestValidate_PRReviewerPool(t *testing.T) {
+	newConfig := func(pool *PRReviewerPool) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:     "test",
+				ID:       "test",
+				Source:   SourceConfig{Repo: "org/source", Branch: "main"},
+				Defaults: DefaultConfig{PRReviewerPool: pool},
+				Targets:  []TargetConfig{{Repo: "org/target", Files: []FileMapping{{Src: "a", Dest: "a"}}}},
+			}},
+		}
+	}
+
+	require.NoError(t, newConfig(nil).Validate())
+	require.NoError(t, newConfig(&PRReviewerPool{Reviewers: []string{"alice"}}).Validate())
+	require.NoError(t, newConfig(&PRReviewerPool{
+		Reviewers: []string{"alice", "bob"},
+		Strategy:  ReviewerStrategyRandomN,
+		Count:     2,
+		Weights:   map[string]int{"alice": 3, "bob": 0},
+	}).Validate())
+
+	for name, pool := range map[string]*PRReviewerPool{
+		"no reviewers":     {Strategy: ReviewerStrategyRoundRobin},
+		"empty reviewer":   {Reviewers: []string{"alice", " "}},
+		"duplicate":        {Reviewers: []string{"alice", "Alice"}},
+		"unknown strategy": {Reviewers: []string{"alice"}, Strategy: "everyone"},
+		"negative count":   {Reviewers: []string{"alice"}, Count: -1},
+		"stranger weight":  {Reviewers: []string{"alice"}, Weights: map[string]int{"bob": 1}},
+		"negative weight":  {Reviewers: []string{"alice"}, Weights: map[string]int{"alice": -1}},
+	} {
+		require.ErrorIs(t, newConfig(pool).Validate(), ErrInvalidReviewerPool, name)
+	}
+}
+
+func TestValidate_AutoLabels(t *testing.T) {
+	newConfig := func(autoLabels map[string]string) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:     "test",
+				ID:       "test",
+				Source:   SourceConfig{Repo: "org/source", Branch: "main"},
+				Defaults: DefaultConfig{AutoLabels: autoLabels},
+				Targets:  []TargetConfig{{Repo: "org/target", Files: []FileMapping{{Src: "a", Dest: "a"}}}},
+			}},
+		}
+	}
+
+	require.NoError(t, newConfig(nil).Validate())
+	require.NoError(t, newConfig(map[string]string{".github/**": "ci", "docs/**": "documentation"}).Validate())
+	require.ErrorIs(t, newConfig(map[string]string{"": "ci"}).Validate(), ErrInvalidAutoLabel)
+	require.ErrorIs(t, newConfig(map[string]string{"docs/**": " "}).Validate(), ErrInvalidAutoLabel)
+}
+
+func TestValidate_BranchNameTemplate(t *testing.T) {
+	newConfig := func(global, defaults, target string) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:     "test",
+				ID:       "test",
+				Source:   SourceConfig{Repo: "org/source", Branch: "main"},
+				Global:   GlobalConfig{BranchNameTemplate: global},
+				Defaults: DefaultConfig{BranchNameTemplate: defaults},
+				Targets: []TargetConfig{{
+					Repo:               "org/target",
+					Files:              []FileMapping{{Src: "a", Dest: "a"}},
+					BranchNameTemplate: target,
+				}},
+			}},
+		}
+	}
+
+	require.NoError(t, newConfig("", "", "").Validate())
+	require.NoError(t, newConfig("sync/{{.SourceCommit}}", "{{.Prefix}}-{{.Timestamp}}", "sync/{{.TargetName}}-{{.Date}}").Validate())
+
+	for name, cfg := range map[string]*Config{
+		"global unknown variable": newConfig("sync/{{.Branch}}", "", ""),
+		"defaults bad syntax":     newConfig("", "sync/{{.SourceCommit", ""),
+		"target with a space":     newConfig("", "", "sync {{.SourceCommit}}"),
+		"target with '..'":        newConfig("", "", "sync/..{{.SourceCommit}}"),
+		"target renders empty":    newConfig("", "", `{{if false}}x{{end}}`),
+	} {
+		require.ErrorIs(t, cfg.Validate(), ErrInvalidBranchNameTemplate, name)
+	}
+}
+
+func TestValidate_FixedBranch(t *testing.T) {
+	newConfig := func(branch, fixedBranch string) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:   "test",
+				ID:     "test",
+				Source: SourceConfig{Repo: "org/source", Branch: "main"},
+				Targets: []TargetConfig{{
+					Repo:        "org/target",
+					Branch:      branch,
+					Files:       []FileMapping{{Src: "a", Dest: "a"}},
+					FixedBranch: fixedBranch,
+				}},
+			}},
+		}
+	}
+
+	require.NoError(t, newConfig("", "").Validate())
+	require.NoError(t, newConfig("main", "sync/automated").Validate())
+
+	for name, cfg := range map[string]*Config{
+		"with a space":      newConfig("", "sync automated"),
+		"with '..'":         newConfig("", "sync/../automated"),
+		"the target branch": newConfig("develop", "develop"),
+	} {
+		require.ErrorIs(t, cfg.Validate(), ErrInvalidFixedBranch, name)
+	}
+}
+
+func TestValidate_SourceRoot(t *testing.T) {
+	newConfig := func(sourceRoot string) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:   "test",
+				ID:     "test",
+				Source: SourceConfig{Repo: "org/source", Branch: "main"},
+				Targets: []TargetConfig{{
+					Repo:       "org/target",
+					Files:      []FileMapping{{Src: "a", Dest: "a"}},
+					SourceRoot: sourceRoot,
+				}},
+			}},
+		}
+	}
+
+	require.NoError(t, newConfig("").Validate())
+	require.NoError(t, newConfig("templates/service").Validate())
+
+	for _, sourceRoot := range []string{"../templates", "templates/../../x", "/templates"} {
+		require.ErrorIs(t, newConfig(sourceRoot).Validate(), ErrInvalidSourceRoot, sourceRoot)
+	}
+}
+
+func TestTargetConfig_SourcePath(t *testing.T) {
+	assert.Equal(t, "docs/a.md", TargetConfig{}.SourcePath("docs/a.md"))
+	assert.Equal(t, "templates/service/docs/a.md", TargetConfig{SourceRoot: "templates/service/"}.SourcePath("docs/a.md"))
+}
+
+func TestRenderBranchName(t *testing.T) {
+	data := BranchNameData{
+		Prefix:       "chore/sync-files",
+		GroupID:      "core",
+		SourceCommit: "abc1234",
+		Date:         "20250102",
+		Time:         "150405",
+		Timestamp:    "20250102-150405",
+		TargetRepo:   "org/service",
+		TargetName:   "service",
+	}
+
+	name, err := RenderBranchName("sync/{{.SourceCommit}}", data)
+	require.NoError(t, err)
+	assert.Equal(t, "sync/abc1234", name)
+
+	name, err = RenderBranchName("{{.Prefix}}/{{.TargetRepo}}/{{.Date}}-{{.Time}}", data)
+	require.NoError(t, err)
+	assert.Equal(t, "chore/sync-files/org/service/20250102-150405", name)
+
+	_, err = RenderBranchName("sync/{{.TargetRepo}}/", data)
+	require.ErrorIs(t, err, ErrInvalidBranchNameTemplate)
+}
+
+func TestRenderDestPath(t *testing.T) {
+	data := NewDestPathData("org/template", "org/service", "configs/app.yaml")
+	assert.Equal(t, "service", data.RepoName)
+	assert.Equal(t, "org", data.Org)
+	assert.Equal(t, "app.yaml", data.SourceBase)
+	assert.Equal(t, "configs", data.SourceDir)
+
+	dest, err := RenderDestPath("{{.RepoName}}/config.yaml", data)
+	require.NoError(t, err)
+	assert.Equal(t, "service/config.yaml", dest)
+
+	dest, err = RenderDestPath("deploy/{{.Org}}/{{.SourceBase}}", data)
+	require.NoError(t, err)
+	assert.Equal(t, "deploy/org/app.yaml", dest)
+
+	dest, err = RenderDestPath("{{.SourceDir}}/{{.RepoName}}.yaml", NewDestPathData("org/template", "org/service", "app.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "service.yaml", dest)
+
+	for _, tmpl := range []string{"{{.Repo}}/x", "{{.RepoName", "../{{.RepoName}}", "/{{.RepoName}}", "{{if false}}x{{end}}"} {
+		_, err = RenderDestPath(tmpl, data)
+		require.ErrorIs(t, err, ErrInvalidDestTemplate, tmpl)
+	}
+}
+
+func TestValidate_DestTemplate(t *testing.T) {
+	newConfig := func(fileDest, dirDest string) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:   "test",
+				ID:     "test",
+				Source: SourceConfig{Repo: "org/source", Branch: "main"},
+				Targets: []TargetConfig{{
+					Repo:        "org/target",
+					Files:       []FileMapping{{Src: "a.yaml", Dest: fileDest}},
+					Directories: []DirectoryMapping{{Src: "docs", Dest: dirDest}},
+				}},
+			}},
+		}
+	}
+
+	require.NoError(t, newConfig("{{.RepoName}}/a.yaml", "docs/{{.RepoName}}").Validate())
+
+	for name, cfg := range map[string]*Config{
+		"file unknown variable":     newConfig("{{.Branch}}/a.yaml", "docs"),
+		"file escapes the repo":     newConfig("{{.SourceDir}}/../../{{.SourceBase}}", "docs"),
+		"directory bad syntax":      newConfig("a.yaml", "docs/{{.RepoName"),
+		"directory renders to root": newConfig("a.yaml", "/{{.Org}}"),
+	} {
+		require.ErrorIs(t, cfg.Validate(), ErrInvalidDestTemplate, name)
+	}
+}
+
+func TestValidate_FileGlob(t *testing.T) {
+	newConfig := func(src, dest string) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:   "test",
+				ID:     "test",
+				Source: SourceConfig{Repo: "org/source", Branch: "main"},
+				Targets: []TargetConfig{{
+					Repo:  "org/target",
+					Files: []FileMapping{{Src: src, Dest: dest}},
+				}},
+			}},
+		}
+	}
+
+	require.NoError(t, newConfig("configs/*.yaml", "deploy/{{.SourceBase}}").Validate())
+	require.NoError(t, newConfig("configs/app-?.yaml", "{{.SourcePath}}").Validate())
+
+	for name, cfg := range map[string]*Config{
+		"static dest":        newConfig("configs/*.yaml", "deploy/app.yaml"),
+		"dest ignores match": newConfig("configs/*.yaml", "deploy/{{.RepoName}}.yaml"),
+		"malformed pattern":  newConfig("configs/[a-.yaml", "deploy/{{.SourceBase}}"),
+	} {
+		require.ErrorIs(t, cfg.Validate(), ErrInvalidFileGlob, name)
+	}
+}
+
+func TestValidate_LineEndings(t *testing.T) {
+	newConfig := func(target, directory string) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:   "test",
+				ID:     "test",
+				Source: SourceConfig{Repo: "org/source", Branch: "main"},
+				Targets: []TargetConfig{{
+					Repo:        "org/target",
+					Directories: []DirectoryMapping{{Src: "scripts", Dest: "scripts", Transform: Transform{LineEndings: directory}}},
+					Transform:   Transform{LineEndings: target},
+				}},
+			}},
+		}
+	}
+
+	for _, mode := range []string{"", LineEndingsPreserve, LineEndingsLF, LineEndingsCRLF} {
+		require.NoError(t, newConfig(mode, mode).Validate(), mode)
+	}
+	require.ErrorIs(t, newConfig("cr", "").Validate(), ErrInvalidLineEndings)
+	require.ErrorIs(t, newConfig("", "LF").Validate(), ErrInvalidLineEndings)
+}
+
+func TestValidate_SecretScrub(t *testing.T) {
+	newConfig := func(target, directory *SecretScrub) *Config {
+		return &Config{
+			Version: 1,
+			Groups: []Group{{
+				Name:   "test",
+				ID:     "test",
+				Source: SourceConfig{Repo: "org/source", Branch: "main"},
+				Targets: []TargetConfig{{
+					Repo:        "org/target",
+					Directories: []DirectoryMapping{{Src: "docs", Dest: "docs", Transform: Transform{SecretScrub: directory}}},
+					Transform:   Transform{SecretScrub: target},
+				}},
+			}},
+		}
+	}
+
+	require.NoError(t, newConfig(nil, nil).Validate())
+	require.NoError(t, newConfig(&SecretScrub{}, &SecretScrub{Patterns: []string{`api_key=\w+`}}).Validate())
+
+	err := newConfig(&SecretScrub{Patterns: []string{`(unclosed`}}, nil).Validate()
+	require.ErrorIs(t, err, ErrInvalidSecretScrub)
+	assert.Contains(t, err.Error(), "(unclosed")
+
+	err = newConfig(nil, &SecretScrub{Patterns: []string{`\s*`}}).Validate()
+	require.ErrorIs(t, err, ErrInvalidSecretScrub)
+	assert.Contains(t, err.Error(), "directory[0]")
+
+	require.ErrorIs(t, newConfig(&SecretScrub{Patterns: []string{`x?`}}, nil).Validate(), ErrInvalidSecretScrub)
+
+	// The defaults must pass the same checks as configured patterns
+	require.NoError(t, newConfig(&SecretScrub{Patterns: DefaultSecretScrubPatterns()}, nil).Validate())
+}
@@ -0,0 +1,183 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// variablesFileKey is the YAML key of Transform.VariablesFile and
+// DefaultConfig.VariablesFile
+const variablesFileKey = "variables_file"
+
+// variablesFileLoader reads variables files for one config, parsing each
+// file once however many transforms reference it
+type variablesFileLoader struct {
+	baseDir string
+	files   map[string]map[string]string
+}
+
+// loadVariablesFiles merges the variables files referenced by cfg into the
+// transform variables they belong to. A transform's inline variables take
+// precedence over its variables_file, which takes precedence over the
+// group's defaults.variables_file. Relative paths are resolved against
+// baseDir, or the working directory when baseDir is empty.
+func loadVariablesFiles(cfg *Config, baseDir string) error {
+	loader := &variablesFileLoader{
+		baseDir: baseDir,
+		files:   make(map[string]map[string]string),
+	}
+
+	for i := range cfg.Groups {
+		group := &cfg.Groups[i]
+		groupVars, err := loader.load(group.Defaults.VariablesFile)
+		if err != nil {
+			return fmt.Errorf("group %s: defaults.%s: %w", group.ID, variablesFileKey, err)
+		}
+
+		for j := range group.Targets {
+			target := &group.Targets[j]
+			if err := loader.merge(&target.Transform, groupVars); err != nil {
+				return fmt.Errorf("group %s target %s: transform.%s: %w", group.ID, target.Repo, variablesFileKey, err)
+			}
+			for k := range target.Directories {
+				if err := loader.merge(&target.Directories[k].Transform, nil); err != nil {
+					return fmt.Errorf("group %s target %s directory %s: transform.%s: %w",
+						group.ID, target.Repo, target.Directories[k].Src, variablesFileKey, err)
+				}
+			}
+		}
+	}
+
+	for i := range cfg.DirectoryLists {
+		list := &cfg.DirectoryLists[i]
+		for j := range list.Directories {
+			if err := loader.merge(&list.Directories[j].Transform, nil); err != nil {
+				return fmt.Errorf("directory_list %s directory %s: transform.%s: %w",
+					list.ID, list.Directories[j].Src, variablesFileKey, err)
+			}
+		}
+	}
+	return nil
+}
+
+// merge adds the variables of t's variables file, then inherited, to
+// t.Variables without overriding keys it already has
+func (l *variablesFileLoader) merge(t *Transform, inherited map[string]string) error {
+	fileVars, err := l.load(t.VariablesFile)
+	if err != nil {
+		return err
+	}
+	if len(fileVars) == 0 && len(inherited) == 0 {
+		return nil
+	}
+
+	if t.Variables == nil {
+		t.Variables = make(map[string]string, len(fileVars)+len(inherited))
+	}
+	for _, vars := range []map[string]string{fileVars, inherited} {
+		for key, value := range vars {
+			if _, exists := t.Variables[key]; !exists {
+				t.Variables[key] = value
+			}
+		}
+	}
+	return nil
+}
+
+// load returns the variables in the file at path, or nil for an empty path
+func (l *variablesFileLoader) load(path string) (map[string]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	if !filepath.IsAbs(path) && l.baseDir != "" {
+		path = filepath.Join(l.baseDir, path)
+	}
+	if vars, ok := l.files[path]; ok {
+		return vars, nil
+	}
+
+	vars, err := readVariablesFile(path)
+	if err != nil {
+		return nil, err
+	}
+	l.files[path] = vars
+	return vars, nil
+}
+
+// readVariablesFile parses a JSON or YAML file holding a single mapping of
+// variable names to values. Numbers and booleans become their string form;
+// nested mappings, lists and null values cannot be variables and are
+// rejected, as is a key defined twice.
+func readVariablesFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path) //#nosec G304 -- Path is user-provided in the config file
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidVariablesFile, err)
+	}
+
+	// JSON is YAML, so one parser reads both formats
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrInvalidVariablesFile, path, err)
+	}
+	if len(doc.Content) == 0 {
+		return map[string]string{}, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("%w: %s: must contain a mapping of variable names to values", ErrInvalidVariablesFile, path)
+	}
+
+	vars := make(map[string]string, len(root.Content)/2)
+	var invalid []string
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		name := strings.TrimSpace(key.Value)
+		_, duplicate := vars[name]
+		switch {
+		case key.Kind != yaml.ScalarNode || name == "":
+			invalid = append(invalid, fmt.Sprintf("line %d: variable name must be a non-empty string", key.Line))
+		case duplicate:
+			invalid = append(invalid, fmt.Sprintf("%s: defined more than once", name))
+		case value.Kind != yaml.ScalarNode || value.Tag == "!!null":
+			invalid = append(invalid, fmt.Sprintf("%s: value must be a string, number or boolean", name))
+		default:
+			vars[name] = value.Value
+		}
+	}
+	if len(invalid) > 0 {
+		sort.Strings(invalid)
+		return nil, fmt.Errorf("%w: %s: %s", ErrInvalidVariablesFile, path, strings.Join(invalid, "; "))
+	}
+	return vars, nil
+}
+
+// resolveVariablesFilePaths rewrites the relative variables_file paths of
+// the transforms and group defaults in a parsed config document to be
+// relative to dir instead, so that documents from different directories can
+// be merged into one
+func resolveVariablesFilePaths(node *yaml.Node, dir string) {
+	if node == nil {
+		return
+	}
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if (key.Value == "transform" || key.Value == "defaults") && value.Kind == yaml.MappingNode {
+				if path := mappingValue(value, variablesFileKey); path != nil && path.Kind == yaml.ScalarNode &&
+					path.Value != "" && !filepath.IsAbs(path.Value) {
+					path.Value = filepath.Join(dir, path.Value)
+				}
+			}
+			resolveVariablesFilePaths(value, dir)
+		}
+		return
+	}
+	for _, child := range node.Content {
+		resolveVariablesFilePaths(child, dir)
+	}
+}
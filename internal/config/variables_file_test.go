@@ -0,0 +1,167 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeVariablesTestFile writes content to name under dir and returns its path
+func writeVariablesTestFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoad_VariablesFile(t *testing.T) {
+	dir := t.TempDir()
+	writeVariablesTestFile(t, dir, "vars/group.yaml", "ORG: acme\nTEAM: platform\nREGION: us\n")
+	writeVariablesTestFile(t, dir, "vars/service.json", `{"TEAM": "payments", "REPLICAS": 3, "PUBLIC": false}`)
+	writeVariablesTestFile(t, dir, "vars/docs.yaml", "DOCS_URL: https://docs.example.com\n")
+	configPath := writeVariablesTestFile(t, dir, "sync.yaml", `version: 1
+directory_lists:
+  - id: docs
+    name: Docs
+    directories:
+      - src: docs
+        dest: docs
+        transform:
+          variables_file: vars/docs.yaml
+groups:
+  - name: core
+    id: core
+    source:
+      repo: org/template
+    defaults:
+      variables_file: vars/group.yaml
+    targets:
+      - repo: org/service
+        directory_list_refs: [docs]
+        transform:
+          variables_file: vars/service.json
+          variables:
+            REGION: eu
+      - repo: org/other
+`)
+
+	cfg, err := Load(configPath)
+	require.NoError(t, err)
+	targets := cfg.Groups[0].Targets
+
+	// Inline variables win over the target's file, which wins over the group's
+	assert.Equal(t, map[string]string{
+		"ORG":      "acme",
+		"TEAM":     "payments",
+		"REGION":   "eu",
+		"REPLICAS": "3",
+		"PUBLIC":   "false",
+	}, targets[0].Transform.Variables)
+	assert.Equal(t, map[string]string{
+		"ORG":    "acme",
+		"TEAM":   "platform",
+		"REGION": "us",
+	}, targets[1].Transform.Variables)
+
+	// Directory lists carry their file variables into the targets using them
+	require.Len(t, targets[0].Directories, 1)
+	assert.Equal(t, map[string]string{"DOCS_URL": "https://docs.example.com"}, targets[0].Directories[0].Transform.Variables)
+}
+
+func TestLoadFiles_VariablesFileRelativeToEachFile(t *testing.T) {
+	dir := t.TempDir()
+	writeVariablesTestFile(t, dir, "base/vars.yaml", "ORG: acme\n")
+	writeVariablesTestFile(t, dir, "overlay/vars.yaml", "TEAM: payments\n")
+	base := writeVariablesTestFile(t, dir, "base/sync.yaml", `version: 1
+groups:
+  - name: core
+    id: core
+    source:
+      repo: org/template
+    defaults:
+      variables_file: vars.yaml
+    targets:
+      - repo: org/service
+`)
+	overlay := writeVariablesTestFile(t, dir, "overlay/targets.yaml", `groups:
+  - name: core
+    id: core
+    source:
+      repo: org/template
+    targets:
+      - repo: org/service
+        transform:
+          variables_file: vars.yaml
+`)
+
+	cfg, err := LoadFiles(base, overlay)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"ORG": "acme", "TEAM": "payments"}, cfg.Groups[0].Targets[0].Transform.Variables)
+}
+
+func TestLoad_InvalidVariablesFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string // Empty means the file does not exist
+		wantErr string
+	}{
+		{name: "missing file", wantErr: "no such file"},
+		{name: "not a mapping", content: "- a\n- b\n", wantErr: "must contain a mapping"},
+		{name: "unparseable", content: "{\"A\": ", wantErr: "invalid variables_file"},
+		{name: "nested value", content: "A:\n  B: c\n", wantErr: "A: value must be a string, number or boolean"},
+		{name: "list value", content: "A: [1, 2]\n", wantErr: "A: value must be a string, number or boolean"},
+		{name: "null value", content: "A:\n", wantErr: "A: value must be a string, number or boolean"},
+		{name: "duplicate key", content: "A: 1\nA: 2\n", wantErr: "A: defined more than once"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if tt.content != "" {
+				writeVariablesTestFile(t, dir, "vars.yaml", tt.content)
+			}
+			configPath := writeVariablesTestFile(t, dir, "sync.yaml", `version: 1
+groups:
+  - name: core
+    id: core
+    source:
+      repo: org/template
+    targets:
+      - repo: org/service
+        transform:
+          variables_file: vars.yaml
+`)
+
+			_, err := Load(configPath)
+			require.ErrorIs(t, err, ErrInvalidVariablesFile)
+			assert.Contains(t, err.Error(), "group core target org/service: transform.variables_file")
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestLoadFromReader_VariablesFileRelativeToWorkingDir(t *testing.T) {
+	dir := t.TempDir()
+	writeVariablesTestFile(t, dir, "vars.yaml", "ORG: acme\n")
+	t.Chdir(dir)
+
+	cfg, err := LoadFromReader(strings.NewReader(`version: 1
+groups:
+  - name: core
+    id: core
+    source:
+      repo: org/template
+    targets:
+      - repo: org/service
+        transform:
+          variables_file: vars.yaml
+`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"ORG": "acme"}, cfg.Groups[0].Targets[0].Transform.Variables)
+	assert.Equal(t, "vars.yaml", cfg.Groups[0].Targets[0].Transform.VariablesFile)
+}
@@ -86,6 +86,56 @@ func jsonToStringMap(j JSONStringMap) map[string]string {
 	return map[string]string(j)
 }
 
+// prBodySectionsToJSON converts []config.PRBodySection to JSONPRBodySections
+func prBodySectionsToJSON(sections []config.PRBodySection) JSONPRBodySections {
+	if sections == nil {
+		return nil
+	}
+	result := make(JSONPRBodySections, len(sections))
+	for i, section := range sections {
+		result[i] = JSONPRBodySection{Title: section.Title, Markdown: section.Markdown}
+	}
+	return result
+}
+
+// jsonToPRBodySections converts JSONPRBodySections to []config.PRBodySection
+func jsonToPRBodySections(j JSONPRBodySections) []config.PRBodySection {
+	if j == nil {
+		return nil
+	}
+	result := make([]config.PRBodySection, len(j))
+	for i, section := range j {
+		result[i] = config.PRBodySection{Title: section.Title, Markdown: section.Markdown}
+	}
+	return result
+}
+
+// reviewerPoolToJSON converts config.PRReviewerPool to JSONPRReviewerPool
+func reviewerPoolToJSON(p *config.PRReviewerPool) *JSONPRReviewerPool {
+	if p == nil {
+		return nil
+	}
+	return &JSONPRReviewerPool{
+		Reviewers: p.Reviewers,
+		Strategy:  p.Strategy,
+		Count:     p.Count,
+		Weights:   p.Weights,
+	}
+}
+
+// jsonToReviewerPool converts JSONPRReviewerPool to config.PRReviewerPool
+func jsonToReviewerPool(j *JSONPRReviewerPool) *config.PRReviewerPool {
+	if j == nil || len(j.Reviewers) == 0 {
+		return nil
+	}
+	return &config.PRReviewerPool{
+		Reviewers: j.Reviewers,
+		Strategy:  j.Strategy,
+		Count:     j.Count,
+		Weights:   j.Weights,
+	}
+}
+
 // moduleConfigToJSON converts config.ModuleConfig to JSONModuleConfig
 func moduleConfigToJSON(m *config.ModuleConfig) *JSONModuleConfig {
 	if m == nil {
@@ -113,6 +163,28 @@ func jsonToModuleConfig(j *JSONModuleConfig) *config.ModuleConfig {
 	}
 }
 
+// fileConditionToJSON converts config.FileCondition to JSONFileCondition
+func fileConditionToJSON(c *config.FileCondition) *JSONFileCondition {
+	if c == nil {
+		return nil
+	}
+	return &JSONFileCondition{
+		RepoMatches: c.RepoMatches,
+		HasTopic:    c.HasTopic,
+	}
+}
+
+// jsonToFileCondition converts JSONFileCondition to config.FileCondition
+func jsonToFileCondition(j *JSONFileCondition) *config.FileCondition {
+	if j == nil || (j.RepoMatches == "" && j.HasTopic == "") {
+		return nil
+	}
+	return &config.FileCondition{
+		RepoMatches: j.RepoMatches,
+		HasTopic:    j.HasTopic,
+	}
+}
+
 // validateReferences checks that all external ID references exist in the database
 func (c *Converter) validateReferences(_ context.Context, cfg *config.Config, refs *refMap) error {
 	// Validate group dependencies
@@ -214,6 +214,8 @@ func (c *Converter) exportGroupGlobal(dbGlobal GroupGlobal) config.GlobalConfig
 		PRAssignees:     jsonToStringSlice(dbGlobal.PRAssignees),
 		PRReviewers:     jsonToStringSlice(dbGlobal.PRReviewers),
 		PRTeamReviewers: jsonToStringSlice(dbGlobal.PRTeamReviewers),
+
+		BranchNameTemplate: dbGlobal.BranchNameTemplate,
 	}
 }
 
@@ -225,6 +227,20 @@ func (c *Converter) exportGroupDefault(dbDefault GroupDefault) config.DefaultCon
 		PRAssignees:     jsonToStringSlice(dbDefault.PRAssignees),
 		PRReviewers:     jsonToStringSlice(dbDefault.PRReviewers),
 		PRTeamReviewers: jsonToStringSlice(dbDefault.PRTeamReviewers),
+		AutomergeMethod: dbDefault.AutomergeMethod,
+		PRUpdateRetries: dbDefault.PRUpdateRetries,
+
+		PRFileChecklistMax: dbDefault.PRFileChecklistMax,
+
+		BranchNameTemplate: dbDefault.BranchNameTemplate,
+
+		PRBodyExtraSections: jsonToPRBodySections(dbDefault.PRBodyExtraSections),
+		PRReviewerPool:      jsonToReviewerPool(dbDefault.PRReviewerPool),
+		AutoLabels:          jsonToStringMap(dbDefault.AutoLabels),
+
+		PostSyncHook:       dbDefault.PostSyncHook,
+		HookFailurePolicy:  dbDefault.HookFailurePolicy,
+		HookTimeoutSeconds: dbDefault.HookTimeoutSeconds,
 	}
 }
 
@@ -270,6 +286,18 @@ func (c *Converter) exportTargets(dbTargets []Target, reverseRefs *reverseRefMap
 			PRAssignees:       jsonToStringSlice(dbTarget.PRAssignees),
 			PRReviewers:       jsonToStringSlice(dbTarget.PRReviewers),
 			PRTeamReviewers:   jsonToStringSlice(dbTarget.PRTeamReviewers),
+
+			PRBodyExtraSections: jsonToPRBodySections(dbTarget.PRBodyExtraSections),
+
+			PostSyncHook:      dbTarget.PostSyncHook,
+			HookFailurePolicy: dbTarget.HookFailurePolicy,
+
+			Fork: dbTarget.Fork,
+
+			BranchNameTemplate: dbTarget.BranchNameTemplate,
+			FixedBranch:        dbTarget.FixedBranch,
+
+			SourceRoot: dbTarget.SourceRoot,
 		}
 	}
 
@@ -285,9 +313,12 @@ func (c *Converter) exportFileMappings(dbFiles []FileMapping) []config.FileMappi
 	files := make([]config.FileMapping, len(dbFiles))
 	for i, dbFile := range dbFiles {
 		files[i] = config.FileMapping{
-			Src:    dbFile.Src,
-			Dest:   dbFile.Dest,
-			Delete: dbFile.DeleteFlag,
+			Src:         dbFile.Src,
+			Dest:        dbFile.Dest,
+			Delete:      dbFile.DeleteFlag,
+			When:        jsonToFileCondition(dbFile.When),
+			MarkerStart: dbFile.MarkerStart,
+			MarkerEnd:   dbFile.MarkerEnd,
 		}
 	}
 
@@ -321,13 +352,37 @@ func (c *Converter) exportDirectoryMappings(dbDirs []DirectoryMapping) []config.
 // exportTransform converts Transform model to config.Transform
 func (c *Converter) exportTransform(dbTransform Transform) config.Transform {
 	// Return empty transform if nothing is set
-	if !dbTransform.RepoName && len(dbTransform.Variables) == 0 {
+	if !dbTransform.RepoName && len(dbTransform.Variables) == 0 && !dbTransform.TemplateRender && dbTransform.GoModulePath == "" &&
+		!dbTransform.UpdateCopyrightYear && dbTransform.ManagedHeader == "" && !dbTransform.StripManagedHeader &&
+		dbTransform.LineEndings == "" && !dbTransform.SecretScrub && !dbTransform.ConditionalBlocks &&
+		len(dbTransform.Pipeline) == 0 {
 		return config.Transform{}
 	}
 
+	var secretScrub *config.SecretScrub
+	if dbTransform.SecretScrub {
+		secretScrub = &config.SecretScrub{
+			Patterns:    jsonToStringSlice(dbTransform.SecretPatterns),
+			Replacement: dbTransform.SecretReplacement,
+			FailOnMatch: dbTransform.SecretFailOnMatch,
+		}
+	}
+
 	return config.Transform{
-		RepoName:  dbTransform.RepoName,
-		Variables: jsonToStringMap(dbTransform.Variables),
+		RepoName:            dbTransform.RepoName,
+		Variables:           jsonToStringMap(dbTransform.Variables),
+		TemplateRender:      dbTransform.TemplateRender,
+		TemplateSuffix:      dbTransform.TemplateSuffix,
+		GoModulePath:        dbTransform.GoModulePath,
+		GoSourceModulePath:  dbTransform.GoSourceModulePath,
+		UpdateCopyrightYear: dbTransform.UpdateCopyrightYear,
+		CopyrightYear:       dbTransform.CopyrightYear,
+		ManagedHeader:       dbTransform.ManagedHeader,
+		StripManagedHeader:  dbTransform.StripManagedHeader,
+		LineEndings:         dbTransform.LineEndings,
+		SecretScrub:         secretScrub,
+		ConditionalBlocks:   dbTransform.ConditionalBlocks,
+		Pipeline:            jsonToStringSlice(dbTransform.Pipeline),
 	}
 }
 
@@ -127,13 +127,13 @@ func CalculateConfigMetrics(cfg *config.Config) map[string]interface{} {
 			totalDirectories += len(target.DirectoryListRefs)
 
 			// Check for target-level transforms
-			if target.Transform.RepoName || len(target.Transform.Variables) > 0 {
+			if !target.Transform.IsEmpty() {
 				hasTransforms = true
 			}
 
 			// Check for directory-level transforms and module configs
 			for _, dir := range target.Directories {
-				if dir.Transform.RepoName || len(dir.Transform.Variables) > 0 {
+				if !dir.Transform.IsEmpty() {
 					hasTransforms = true
 				}
 				if dir.Module != nil && (dir.Module.Version != "" || dir.Module.Type != "") {
@@ -559,6 +559,8 @@ func (c *Converter) importGroupGlobal(tx *gorm.DB, groupID uint, global *config.
 		PRAssignees:     stringSliceToJSON(global.PRAssignees),
 		PRReviewers:     stringSliceToJSON(global.PRReviewers),
 		PRTeamReviewers: stringSliceToJSON(global.PRTeamReviewers),
+
+		BranchNameTemplate: global.BranchNameTemplate,
 	}
 
 	var existing GroupGlobal
@@ -581,6 +583,20 @@ func (c *Converter) importGroupDefault(tx *gorm.DB, groupID uint, defaults *conf
 		PRAssignees:     stringSliceToJSON(defaults.PRAssignees),
 		PRReviewers:     stringSliceToJSON(defaults.PRReviewers),
 		PRTeamReviewers: stringSliceToJSON(defaults.PRTeamReviewers),
+		AutomergeMethod: defaults.AutomergeMethod,
+		PRUpdateRetries: defaults.PRUpdateRetries,
+
+		PRFileChecklistMax: defaults.PRFileChecklistMax,
+
+		BranchNameTemplate: defaults.BranchNameTemplate,
+
+		PRBodyExtraSections: prBodySectionsToJSON(defaults.PRBodyExtraSections),
+		PRReviewerPool:      reviewerPoolToJSON(defaults.PRReviewerPool),
+		AutoLabels:          stringMapToJSON(defaults.AutoLabels),
+
+		PostSyncHook:       defaults.PostSyncHook,
+		HookFailurePolicy:  defaults.HookFailurePolicy,
+		HookTimeoutSeconds: defaults.HookTimeoutSeconds,
 	}
 
 	var existing GroupDefault
@@ -630,6 +646,18 @@ func (c *Converter) importTargets(tx *gorm.DB, groupID uint, targets []config.Ta
 			PRReviewers:     stringSliceToJSON(target.PRReviewers),
 			PRTeamReviewers: stringSliceToJSON(target.PRTeamReviewers),
 			Position:        i,
+
+			PRBodyExtraSections: prBodySectionsToJSON(target.PRBodyExtraSections),
+
+			PostSyncHook:      target.PostSyncHook,
+			HookFailurePolicy: target.HookFailurePolicy,
+
+			Fork: target.Fork,
+
+			BranchNameTemplate: target.BranchNameTemplate,
+			FixedBranch:        target.FixedBranch,
+
+			SourceRoot: target.SourceRoot,
 		}
 
 		// Create target (we already deleted old ones in deleteGroupAssociations)
@@ -648,7 +676,7 @@ func (c *Converter) importTargets(tx *gorm.DB, groupID uint, targets []config.Ta
 		}
 
 		// Import target-level transform
-		if target.Transform.RepoName || len(target.Transform.Variables) > 0 {
+		if !target.Transform.IsEmpty() {
 			if err := c.importTransform(tx, "target", dbTarget.ID, &target.Transform); err != nil {
 				return fmt.Errorf("failed to import transform for target %q: %w", target.Repo, err)
 			}
@@ -696,12 +724,15 @@ func (c *Converter) importTargets(tx *gorm.DB, groupID uint, targets []config.Ta
 func (c *Converter) importFileMappings(tx *gorm.DB, ownerType string, ownerID uint, files []config.FileMapping) error {
 	for i, file := range files {
 		dbFile := &FileMapping{
-			OwnerType:  ownerType,
-			OwnerID:    ownerID,
-			Src:        file.Src,
-			Dest:       file.Dest,
-			DeleteFlag: file.Delete,
-			Position:   i,
+			OwnerType:   ownerType,
+			OwnerID:     ownerID,
+			Src:         file.Src,
+			Dest:        file.Dest,
+			DeleteFlag:  file.Delete,
+			When:        fileConditionToJSON(file.When),
+			MarkerStart: file.MarkerStart,
+			MarkerEnd:   file.MarkerEnd,
+			Position:    i,
 		}
 		if err := tx.Create(dbFile).Error; err != nil {
 			return fmt.Errorf("failed to create file mapping %q: %w", file.Dest, err)
@@ -732,7 +763,7 @@ func (c *Converter) importDirectoryMappings(tx *gorm.DB, ownerType string, owner
 		}
 
 		// Import directory-level transform
-		if dir.Transform.RepoName || len(dir.Transform.Variables) > 0 {
+		if !dir.Transform.IsEmpty() {
 			if err := c.importTransform(tx, "directory_mapping", dbDir.ID, &dir.Transform); err != nil {
 				return fmt.Errorf("failed to import transform for directory %q: %w", dir.Dest, err)
 			}
@@ -745,10 +776,27 @@ func (c *Converter) importDirectoryMappings(tx *gorm.DB, ownerType string, owner
 // importTransform creates a transform record
 func (c *Converter) importTransform(tx *gorm.DB, ownerType string, ownerID uint, transform *config.Transform) error {
 	dbTransform := &Transform{
-		OwnerType: ownerType,
-		OwnerID:   ownerID,
-		RepoName:  transform.RepoName,
-		Variables: stringMapToJSON(transform.Variables),
+		OwnerType:           ownerType,
+		OwnerID:             ownerID,
+		RepoName:            transform.RepoName,
+		Variables:           stringMapToJSON(transform.Variables),
+		TemplateRender:      transform.TemplateRender,
+		TemplateSuffix:      transform.TemplateSuffix,
+		GoModulePath:        transform.GoModulePath,
+		GoSourceModulePath:  transform.GoSourceModulePath,
+		UpdateCopyrightYear: transform.UpdateCopyrightYear,
+		CopyrightYear:       transform.CopyrightYear,
+		ManagedHeader:       transform.ManagedHeader,
+		StripManagedHeader:  transform.StripManagedHeader,
+		LineEndings:         transform.LineEndings,
+		ConditionalBlocks:   transform.ConditionalBlocks,
+		Pipeline:            stringSliceToJSON(transform.Pipeline),
+	}
+	if scrub := transform.SecretScrub; scrub != nil {
+		dbTransform.SecretScrub = true
+		dbTransform.SecretPatterns = stringSliceToJSON(scrub.Patterns)
+		dbTransform.SecretReplacement = scrub.Replacement
+		dbTransform.SecretFailOnMatch = scrub.FailOnMatch
 	}
 
 	return tx.Create(dbTransform).Error
@@ -31,7 +31,7 @@ func TestConverterFullRoundTrip(t *testing.T) {
 				Name:        "Comprehensive File List",
 				Description: "All file features",
 				Files: []config.FileMapping{
-					{Src: "file1.txt", Dest: "dest1.txt", Delete: false},
+					{Src: "file1.txt", Dest: "dest1.txt", Delete: false, When: &config.FileCondition{RepoMatches: "-service$", HasTopic: "go"}, MarkerStart: "# BEGIN SYNC", MarkerEnd: "# END SYNC"},
 					{Dest: "delete-me.txt", Delete: true},
 				},
 			},
@@ -86,6 +86,8 @@ func TestConverterFullRoundTrip(t *testing.T) {
 					PRAssignees:     []string{"global-assignee"},
 					PRReviewers:     []string{"global-reviewer1", "global-reviewer2"},
 					PRTeamReviewers: []string{"global-team"},
+
+					BranchNameTemplate: "sync/{{.GroupID}}-{{.SourceCommit}}",
 				},
 				Defaults: config.DefaultConfig{
 					BranchPrefix:    "feature",
@@ -93,20 +95,47 @@ func TestConverterFullRoundTrip(t *testing.T) {
 					PRAssignees:     []string{"default-assignee"},
 					PRReviewers:     []string{"default-reviewer"},
 					PRTeamReviewers: []string{"default-team"},
+					PRFileChecklistMax: func() *int {
+						n := 25
+						return &n
+					}(),
+					PRBodyExtraSections: []config.PRBodySection{
+						{Title: "Checklist", Markdown: "- [ ] Reviewed"},
+					},
+					PRReviewerPool: &config.PRReviewerPool{
+						Reviewers: []string{"alice", "bob", "carol"},
+						Strategy:  config.ReviewerStrategyLeastLoaded,
+						Count:     2,
+						Weights:   map[string]int{"carol": 2},
+					},
+					AutoLabels:         map[string]string{"docs/**": "documentation"},
+					BranchNameTemplate: "{{.Prefix}}/{{.Date}}-{{.SourceCommit}}",
+					PostSyncHook:       "./notify.sh",
+					HookFailurePolicy:  config.HookFailurePolicyFail,
+					HookTimeoutSeconds: 60,
 				},
 				Targets: []config.TargetConfig{
 					{
-						Repo:              "mrz1836/target1",
-						Branch:            "develop",
-						BlobSizeLimit:     "50MB",
-						SecurityEmail:     "sec@target1.com",
-						SupportEmail:      "sup@target1.com",
-						PRLabels:          []string{"target-label1", "target-label2"},
-						PRAssignees:       []string{"target-assignee"},
-						PRReviewers:       []string{"target-reviewer"},
-						PRTeamReviewers:   []string{"target-team1", "target-team2"},
-						FileListRefs:      []string{"comprehensive-filelist"},
-						DirectoryListRefs: []string{"comprehensive-dirlist"},
+						Repo:            "mrz1836/target1",
+						Branch:          "develop",
+						BlobSizeLimit:   "50MB",
+						SecurityEmail:   "sec@target1.com",
+						SupportEmail:    "sup@target1.com",
+						PRLabels:        []string{"target-label1", "target-label2"},
+						PRAssignees:     []string{"target-assignee"},
+						PRReviewers:     []string{"target-reviewer"},
+						PRTeamReviewers: []string{"target-team1", "target-team2"},
+						PRBodyExtraSections: []config.PRBodySection{
+							{Title: "Target Notes", Markdown: "Owned by {{TARGET_VAR}}"},
+						},
+						PostSyncHook:       "./deploy.sh",
+						HookFailurePolicy:  config.HookFailurePolicyWarn,
+						Fork:               "contributor/target1",
+						BranchNameTemplate: "sync/{{.TargetName}}/{{.SourceCommit}}",
+						FixedBranch:        "sync/automated",
+						SourceRoot:         "templates/service",
+						FileListRefs:       []string{"comprehensive-filelist"},
+						DirectoryListRefs:  []string{"comprehensive-dirlist"},
 						Files: []config.FileMapping{
 							{Src: "inline.txt", Dest: "inline-dest.txt"},
 						},
@@ -127,6 +156,17 @@ func TestConverterFullRoundTrip(t *testing.T) {
 							Variables: map[string]string{
 								"TARGET_VAR": "target_val",
 							},
+							TemplateRender:      true,
+							TemplateSuffix:      ".tpl",
+							GoModulePath:        config.GoModulePathAuto,
+							GoSourceModulePath:  "go.example.com/template",
+							UpdateCopyrightYear: true,
+							CopyrightYear:       2025,
+							ManagedHeader:       "Source: {{SOURCE_REPO}}",
+							LineEndings:         config.LineEndingsLF,
+							SecretScrub:         &config.SecretScrub{Patterns: []string{`api_key=\w+`}, Replacement: "***", FailOnMatch: true},
+							ConditionalBlocks:   true,
+							Pipeline:            []string{config.TransformStepVariables, config.TransformStepManagedHeader},
 						},
 					},
 					{
@@ -171,7 +211,11 @@ func TestConverterFullRoundTrip(t *testing.T) {
 	assert.Equal(t, "comprehensive-filelist", fileList.ID)
 	assert.Len(t, fileList.Files, 2)
 	assert.False(t, fileList.Files[0].Delete)
+	assert.Equal(t, &config.FileCondition{RepoMatches: "-service$", HasTopic: "go"}, fileList.Files[0].When)
+	assert.Equal(t, "# BEGIN SYNC", fileList.Files[0].MarkerStart)
+	assert.Equal(t, "# END SYNC", fileList.Files[0].MarkerEnd)
 	assert.True(t, fileList.Files[1].Delete)
+	assert.Nil(t, fileList.Files[1].When)
 
 	// Verify directory list
 	dirList := exported.DirectoryLists[0]
@@ -193,6 +237,7 @@ func TestConverterFullRoundTrip(t *testing.T) {
 	assert.True(t, *group1.Enabled)
 	assert.Equal(t, "100MB", group1.Source.BlobSizeLimit)
 	assert.Len(t, group1.Global.PRLabels, 2)
+	assert.Equal(t, []config.PRBodySection{{Title: "Checklist", Markdown: "- [ ] Reviewed"}}, group1.Defaults.PRBodyExtraSections)
 	assert.Len(t, group1.Targets, 2)
 
 	// Verify target 1
@@ -204,6 +249,41 @@ func TestConverterFullRoundTrip(t *testing.T) {
 	assert.Len(t, target1.Files, 1)
 	assert.Len(t, target1.Directories, 1)
 	assert.True(t, target1.Transform.RepoName)
+	assert.True(t, target1.Transform.TemplateRender)
+	assert.Equal(t, ".tpl", target1.Transform.TemplateSuffix)
+	assert.Equal(t, config.GoModulePathAuto, target1.Transform.GoModulePath)
+	assert.Equal(t, "go.example.com/template", target1.Transform.GoSourceModulePath)
+	assert.True(t, target1.Transform.UpdateCopyrightYear)
+	assert.Equal(t, 2025, target1.Transform.CopyrightYear)
+	assert.Equal(t, "Source: {{SOURCE_REPO}}", target1.Transform.ManagedHeader)
+	assert.Equal(t, config.LineEndingsLF, target1.Transform.LineEndings)
+	assert.Equal(t, &config.SecretScrub{Patterns: []string{`api_key=\w+`}, Replacement: "***", FailOnMatch: true}, target1.Transform.SecretScrub)
+	assert.Nil(t, group1.Targets[1].Transform.SecretScrub)
+	assert.True(t, target1.Transform.ConditionalBlocks)
+	assert.Equal(t, []string{config.TransformStepVariables, config.TransformStepManagedHeader}, target1.Transform.Pipeline)
+	assert.Equal(t, []config.PRBodySection{{Title: "Target Notes", Markdown: "Owned by {{TARGET_VAR}}"}}, target1.PRBodyExtraSections)
+	assert.Nil(t, group1.Targets[1].PRBodyExtraSections)
+	assert.Equal(t, "./notify.sh", group1.Defaults.PostSyncHook)
+	if assert.NotNil(t, group1.Defaults.PRFileChecklistMax) {
+		assert.Equal(t, 25, *group1.Defaults.PRFileChecklistMax)
+	}
+	assert.Equal(t, config.HookFailurePolicyFail, group1.Defaults.HookFailurePolicy)
+	assert.Equal(t, 60, group1.Defaults.HookTimeoutSeconds)
+	assert.Equal(t, &config.PRReviewerPool{
+		Reviewers: []string{"alice", "bob", "carol"},
+		Strategy:  config.ReviewerStrategyLeastLoaded,
+		Count:     2,
+		Weights:   map[string]int{"carol": 2},
+	}, group1.Defaults.PRReviewerPool)
+	assert.Equal(t, map[string]string{"docs/**": "documentation"}, group1.Defaults.AutoLabels)
+	assert.Equal(t, "./deploy.sh", target1.PostSyncHook)
+	assert.Equal(t, config.HookFailurePolicyWarn, target1.HookFailurePolicy)
+	assert.Equal(t, "contributor/target1", target1.Fork)
+	assert.Equal(t, "sync/{{.GroupID}}-{{.SourceCommit}}", group1.Global.BranchNameTemplate)
+	assert.Equal(t, "{{.Prefix}}/{{.Date}}-{{.SourceCommit}}", group1.Defaults.BranchNameTemplate)
+	assert.Equal(t, "sync/{{.TargetName}}/{{.SourceCommit}}", target1.BranchNameTemplate)
+	assert.Equal(t, "sync/automated", target1.FixedBranch)
+	assert.Equal(t, "templates/service", target1.SourceRoot)
 
 	// Verify group 2
 	group2 := exported.Groups[1]
@@ -117,6 +117,81 @@ func (j *JSONStringMap) Scan(value interface{}) error {
 	return json.Unmarshal(bytes, j)
 }
 
+// JSONPRBodySection is one custom PR body section (maps to config.PRBodySection)
+type JSONPRBodySection struct {
+	Title    string `json:"title"`
+	Markdown string `json:"markdown"`
+}
+
+// JSONPRBodySections stores []JSONPRBodySection as JSON TEXT
+//
+//nolint:recvcheck // mixed receivers required by driver.Valuer/sql.Scanner interface
+type JSONPRBodySections []JSONPRBodySection
+
+// Value implements driver.Valuer
+func (j JSONPRBodySections) Value() (driver.Value, error) {
+	if j == nil {
+		return nil, nil //nolint:nilnil // database/sql pattern for NULL values
+	}
+	return json.Marshal(j)
+}
+
+// Scan implements sql.Scanner
+func (j *JSONPRBodySections) Scan(value interface{}) error {
+	if value == nil {
+		*j = nil
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("%w for JSONPRBodySections", ErrInvalidType)
+	}
+
+	return json.Unmarshal(bytes, j)
+}
+
+// JSONPRReviewerPool stores a group's PRReviewerPool as JSON TEXT
+type JSONPRReviewerPool struct {
+	Reviewers []string       `json:"reviewers"`
+	Strategy  string         `json:"strategy,omitempty"`
+	Count     int            `json:"count,omitempty"`
+	Weights   map[string]int `json:"weights,omitempty"`
+}
+
+// Value implements driver.Valuer
+func (j *JSONPRReviewerPool) Value() (driver.Value, error) {
+	if j == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(*j)
+}
+
+// Scan implements sql.Scanner
+func (j *JSONPRReviewerPool) Scan(value interface{}) error {
+	if value == nil {
+		*j = JSONPRReviewerPool{}
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("%w for JSONPRReviewerPool", ErrInvalidType)
+	}
+
+	return json.Unmarshal(bytes, j)
+}
+
 // JSONModuleConfig stores ModuleConfig as JSON TEXT
 type JSONModuleConfig struct {
 	Type       string `json:"type,omitempty"`        // "go", "npm", "python", etc.
@@ -153,6 +228,40 @@ func (j *JSONModuleConfig) Scan(value interface{}) error {
 	return json.Unmarshal(bytes, j)
 }
 
+// JSONFileCondition stores a file mapping's FileCondition as JSON TEXT
+type JSONFileCondition struct {
+	RepoMatches string `json:"repo_matches,omitempty"` // Regular expression the target repo must match
+	HasTopic    string `json:"has_topic,omitempty"`    // Topic the target repository must have
+}
+
+// Value implements driver.Valuer
+func (j *JSONFileCondition) Value() (driver.Value, error) {
+	if j == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(*j)
+}
+
+// Scan implements sql.Scanner
+func (j *JSONFileCondition) Scan(value interface{}) error {
+	if value == nil {
+		*j = JSONFileCondition{}
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("%w for JSONFileCondition", ErrInvalidType)
+	}
+
+	return json.Unmarshal(bytes, j)
+}
+
 // =====================
 // Main Tables (18 models)
 // =====================
@@ -325,6 +434,8 @@ type GroupGlobal struct {
 	PRAssignees     JSONStringSlice `gorm:"type:text" json:"pr_assignees"`
 	PRReviewers     JSONStringSlice `gorm:"type:text" json:"pr_reviewers"`
 	PRTeamReviewers JSONStringSlice `gorm:"type:text" json:"pr_team_reviewers"`
+
+	BranchNameTemplate string `gorm:"type:text" json:"branch_name_template"`
 }
 
 // GroupDefault represents group-level default config (maps to config.DefaultConfig)
@@ -337,6 +448,20 @@ type GroupDefault struct {
 	PRAssignees     JSONStringSlice `gorm:"type:text" json:"pr_assignees"`
 	PRReviewers     JSONStringSlice `gorm:"type:text" json:"pr_reviewers"`
 	PRTeamReviewers JSONStringSlice `gorm:"type:text" json:"pr_team_reviewers"`
+	AutomergeMethod string          `gorm:"type:text" json:"automerge_method"`
+	PRUpdateRetries *int            `json:"pr_update_retries"`
+
+	PRFileChecklistMax *int `json:"pr_file_checklist_max"`
+
+	BranchNameTemplate string `gorm:"type:text" json:"branch_name_template"`
+
+	PRBodyExtraSections JSONPRBodySections  `gorm:"type:text" json:"pr_body_extra_sections"`
+	PRReviewerPool      *JSONPRReviewerPool `gorm:"type:text" json:"pr_reviewer_pool,omitempty"`
+	AutoLabels          JSONStringMap       `gorm:"type:text" json:"auto_labels"`
+
+	PostSyncHook       string `gorm:"type:text" json:"post_sync_hook"`
+	HookFailurePolicy  string `gorm:"type:text" json:"hook_failure_policy"`
+	HookTimeoutSeconds int    `json:"hook_timeout_seconds"`
 }
 
 // Target represents a target repository (maps to config.TargetConfig)
@@ -356,6 +481,18 @@ type Target struct {
 	Position        int             `gorm:"default:0" json:"position"`
 	RepoRef         Repo            `gorm:"foreignKey:RepoID" json:"repo,omitempty"`
 
+	PRBodyExtraSections JSONPRBodySections `gorm:"type:text" json:"pr_body_extra_sections"`
+
+	PostSyncHook      string `gorm:"type:text" json:"post_sync_hook"`
+	HookFailurePolicy string `gorm:"type:text" json:"hook_failure_policy"`
+
+	Fork string `gorm:"type:text" json:"fork,omitempty"`
+
+	BranchNameTemplate string `gorm:"type:text" json:"branch_name_template"`
+	FixedBranch        string `gorm:"type:text" json:"fixed_branch,omitempty"`
+
+	SourceRoot string `gorm:"type:text" json:"source_root,omitempty"`
+
 	// Polymorphic relationships
 	FileMappings      []FileMapping      `gorm:"polymorphic:Owner;polymorphicValue:target" json:"files,omitempty"`
 	DirectoryMappings []DirectoryMapping `gorm:"polymorphic:Owner;polymorphicValue:target" json:"directories,omitempty"`
@@ -394,12 +531,15 @@ type DirectoryList struct {
 type FileMapping struct {
 	BaseModel
 
-	OwnerType  string `gorm:"type:text;not null;index:idx_file_mapping_owner" json:"owner_type"` // "target" or "file_list"
-	OwnerID    uint   `gorm:"not null;index:idx_file_mapping_owner" json:"owner_id"`
-	Src        string `gorm:"type:text" json:"src"`
-	Dest       string `gorm:"type:text;not null;index" json:"dest"`
-	DeleteFlag bool   `gorm:"default:false" json:"delete"`
-	Position   int    `gorm:"default:0" json:"position"`
+	OwnerType   string             `gorm:"type:text;not null;index:idx_file_mapping_owner" json:"owner_type"` // "target" or "file_list"
+	OwnerID     uint               `gorm:"not null;index:idx_file_mapping_owner" json:"owner_id"`
+	Src         string             `gorm:"type:text" json:"src"`
+	Dest        string             `gorm:"type:text;not null;index" json:"dest"`
+	DeleteFlag  bool               `gorm:"default:false" json:"delete"`
+	When        *JSONFileCondition `gorm:"type:text" json:"when,omitempty"`
+	MarkerStart string             `gorm:"type:text" json:"marker_start,omitempty"`
+	MarkerEnd   string             `gorm:"type:text" json:"marker_end,omitempty"`
+	Position    int                `gorm:"default:0" json:"position"`
 }
 
 // DirectoryMapping represents a directory mapping (polymorphic: Target or DirectoryList)
@@ -424,10 +564,25 @@ type DirectoryMapping struct {
 type Transform struct {
 	BaseModel
 
-	OwnerType string        `gorm:"type:text;not null;uniqueIndex:idx_owner_transform" json:"owner_type"` // "target" or "directory_mapping"
-	OwnerID   uint          `gorm:"not null;uniqueIndex:idx_owner_transform" json:"owner_id"`
-	RepoName  bool          `gorm:"default:false" json:"repo_name"`
-	Variables JSONStringMap `gorm:"type:text" json:"variables"`
+	OwnerType           string          `gorm:"type:text;not null;uniqueIndex:idx_owner_transform" json:"owner_type"` // "target" or "directory_mapping"
+	OwnerID             uint            `gorm:"not null;uniqueIndex:idx_owner_transform" json:"owner_id"`
+	RepoName            bool            `gorm:"default:false" json:"repo_name"`
+	Variables           JSONStringMap   `gorm:"type:text" json:"variables"`
+	TemplateRender      bool            `gorm:"default:false" json:"template_render"`
+	TemplateSuffix      string          `gorm:"type:text" json:"template_suffix"`
+	GoModulePath        string          `gorm:"type:text" json:"go_module_path"`
+	GoSourceModulePath  string          `gorm:"type:text" json:"go_source_module_path"`
+	UpdateCopyrightYear bool            `gorm:"default:false" json:"update_copyright_year"`
+	CopyrightYear       int             `json:"copyright_year"`
+	ManagedHeader       string          `gorm:"type:text" json:"managed_header"`
+	StripManagedHeader  bool            `gorm:"default:false" json:"strip_managed_header"`
+	LineEndings         string          `gorm:"type:text" json:"line_endings"`
+	SecretScrub         bool            `gorm:"default:false" json:"secret_scrub"`
+	SecretPatterns      JSONStringSlice `gorm:"type:text" json:"secret_patterns"`
+	SecretReplacement   string          `gorm:"type:text" json:"secret_replacement"`
+	SecretFailOnMatch   bool            `gorm:"default:false" json:"secret_fail_on_match"`
+	ConditionalBlocks   bool            `gorm:"default:false" json:"conditional_blocks"`
+	Pipeline            JSONStringSlice `gorm:"type:text" json:"pipeline"`
 }
 
 // TargetFileListRef is the join table for Target <-> FileList M2M
@@ -124,6 +124,8 @@ const (
 	BroadcastSyncTargetStatusFailed    = "failed"
 	BroadcastSyncTargetStatusSkipped   = "skipped"
 	BroadcastSyncTargetStatusNoChanges = "no_changes"
+
+	BroadcastSyncTargetStatusBranchPushed = "branch_pushed"
 )
 
 // BroadcastSyncFileChange represents a single file change within a target result
@@ -0,0 +1,185 @@
+package gh
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTokenCommandTimeout bounds a TokenCommand that does not set its own timeout
+const DefaultTokenCommandTimeout = 30 * time.Second
+
+// Token source errors
+var (
+	ErrTokenUnavailable     = errors.New("GitHub token unavailable")
+	ErrMultipleTokenSources = errors.New("only one of token file or token command may be set")
+	ErrEmptyToken           = errors.New("token source returned an empty token")
+	ErrTokenCommandFailed   = errors.New("token command failed")
+)
+
+// AuthConfig selects where the GitHub token passed to the gh CLI comes from.
+// At most one source may be set; with none set the gh CLI's own
+// authentication (GH_TOKEN, GITHUB_TOKEN or `gh auth login`) is used.
+type AuthConfig struct {
+	// TokenFile is a file whose contents are the token
+	TokenFile string
+
+	// TokenCommand is run and its stdout used as the token, e.g. "gh auth token"
+	// or a secrets manager CLI. It is split on whitespace and run without a shell.
+	TokenCommand string
+
+	// TokenCommandTimeout bounds TokenCommand (default: DefaultTokenCommandTimeout)
+	TokenCommandTimeout time.Duration
+}
+
+// Enabled reports whether a token source is configured
+func (a AuthConfig) Enabled() bool {
+	return a.TokenFile != "" || a.TokenCommand != ""
+}
+
+// Validate checks that at most one token source is configured
+func (a AuthConfig) Validate() error {
+	if a.TokenFile != "" && a.TokenCommand != "" {
+		return ErrMultipleTokenSources
+	}
+	if a.TokenCommand != "" && len(strings.Fields(a.TokenCommand)) == 0 {
+		return fmt.Errorf("%w: command is blank", ErrTokenCommandFailed)
+	}
+	return nil
+}
+
+// WithAuth makes the client resolve its token from cfg instead of the
+// environment. The token is resolved on the first command and then cached.
+func WithAuth(cfg AuthConfig) ClientOption {
+	return func(g *githubClient) {
+		g.auth = cfg
+	}
+}
+
+// tokenSource resolves a token from an AuthConfig once and caches it.
+// Failed resolutions are not cached so a later command can retry.
+type tokenSource struct {
+	cfg   AuthConfig
+	mu    sync.Mutex
+	token string
+}
+
+// Token returns the cached token, resolving it on first use
+func (s *tokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" {
+		return s.token, nil
+	}
+
+	token, err := s.resolve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrTokenUnavailable, err)
+	}
+	s.token = token
+	return token, nil
+}
+
+// resolve reads the token from the configured source
+func (s *tokenSource) resolve(ctx context.Context) (string, error) {
+	if err := s.cfg.Validate(); err != nil {
+		return "", err
+	}
+
+	var (
+		raw    []byte
+		source string
+		err    error
+	)
+	switch {
+	case s.cfg.TokenFile != "":
+		source = "token file " + s.cfg.TokenFile
+		raw, err = os.ReadFile(s.cfg.TokenFile) //nolint:gosec // path is chosen by the user
+		if err != nil {
+			return "", fmt.Errorf("read %s: %w", source, err)
+		}
+	case s.cfg.TokenCommand != "":
+		source = "token command"
+		raw, err = s.runCommand(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	token := strings.TrimSpace(string(raw))
+	if token == "" {
+		return "", fmt.Errorf("%w: %s", ErrEmptyToken, source)
+	}
+	return token, nil
+}
+
+// runCommand executes TokenCommand with a timeout and returns its stdout.
+// Only the program name is included in errors so arguments never leak.
+func (s *tokenSource) runCommand(ctx context.Context) ([]byte, error) {
+	timeout := s.cfg.TokenCommandTimeout
+	if timeout <= 0 {
+		timeout = DefaultTokenCommandTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	fields := strings.Fields(s.cfg.TokenCommand)
+	cmd := exec.CommandContext(ctx, fields[0], fields[1:]...) //nolint:gosec // G204: the command is chosen by the user
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrTokenCommandFailed, fields[0], ctxErr)
+		}
+		return nil, fmt.Errorf("%w: %s: %w: %s", ErrTokenCommandFailed, fields[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+// commandEnvKey is the context key for extra environment variables passed to commands
+type commandEnvKey struct{}
+
+// withCommandEnv returns a context whose commands run with env appended to the process environment
+func withCommandEnv(ctx context.Context, env ...string) context.Context {
+	merged := append(append([]string(nil), commandEnv(ctx)...), env...)
+	return context.WithValue(ctx, commandEnvKey{}, merged)
+}
+
+// commandEnv returns the extra environment set by withCommandEnv
+func commandEnv(ctx context.Context) []string {
+	env, _ := ctx.Value(commandEnvKey{}).([]string)
+	return env
+}
+
+// tokenRunner passes the resolved token to every gh command as GH_TOKEN
+type tokenRunner struct {
+	runner CommandRunner
+	source *tokenSource
+}
+
+// Run resolves the token, then executes the command
+func (r *tokenRunner) Run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	token, err := r.source.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.runner.Run(withCommandEnv(ctx, "GH_TOKEN="+token), name, args...)
+}
+
+// RunWithInput resolves the token, then executes the command with stdin input
+func (r *tokenRunner) RunWithInput(ctx context.Context, input []byte, name string, args ...string) ([]byte, error) {
+	token, err := r.source.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.runner.RunWithInput(withCommandEnv(ctx, "GH_TOKEN="+token), input, name, args...)
+}
@@ -0,0 +1,110 @@
+package gh
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthConfig_Validate(t *testing.T) {
+	require.NoError(t, AuthConfig{}.Validate())
+	require.NoError(t, AuthConfig{TokenFile: "/tmp/token"}.Validate())
+	require.NoError(t, AuthConfig{TokenCommand: "gh auth token"}.Validate())
+	require.ErrorIs(t, AuthConfig{TokenFile: "/tmp/token", TokenCommand: "gh auth token"}.Validate(), ErrMultipleTokenSources)
+	require.ErrorIs(t, AuthConfig{TokenCommand: "   "}.Validate(), ErrTokenCommandFailed)
+
+	assert.False(t, AuthConfig{}.Enabled())
+	assert.True(t, AuthConfig{TokenFile: "/tmp/token"}.Enabled())
+}
+
+func TestTokenSource_File(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("ghp_first\n"), 0o600))
+
+	source := &tokenSource{cfg: AuthConfig{TokenFile: path}}
+	token, err := source.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "ghp_first", token, "trailing whitespace is trimmed")
+
+	// The resolved token is cached for the life of the client
+	require.NoError(t, os.WriteFile(path, []byte("ghp_second"), 0o600))
+	token, err = source.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "ghp_first", token)
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := (&tokenSource{cfg: AuthConfig{TokenFile: filepath.Join(t.TempDir(), "missing")}}).Token(ctx)
+		require.ErrorIs(t, err, ErrTokenUnavailable)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("empty file", func(t *testing.T) {
+		empty := filepath.Join(t.TempDir(), "empty")
+		require.NoError(t, os.WriteFile(empty, []byte(" \n"), 0o600))
+		_, err := (&tokenSource{cfg: AuthConfig{TokenFile: empty}}).Token(ctx)
+		require.ErrorIs(t, err, ErrEmptyToken)
+	})
+}
+
+func TestTokenSource_Command(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses POSIX echo and sleep")
+	}
+	ctx := context.Background()
+
+	t.Run("stdout is trimmed", func(t *testing.T) {
+		token, err := (&tokenSource{cfg: AuthConfig{TokenCommand: "echo ghp_from_command"}}).Token(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "ghp_from_command", token)
+	})
+
+	t.Run("failing command", func(t *testing.T) {
+		_, err := (&tokenSource{cfg: AuthConfig{TokenCommand: "false"}}).Token(ctx)
+		require.ErrorIs(t, err, ErrTokenCommandFailed)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		start := time.Now()
+		_, err := (&tokenSource{cfg: AuthConfig{TokenCommand: "sleep 5", TokenCommandTimeout: 50 * time.Millisecond}}).Token(ctx)
+		require.ErrorIs(t, err, ErrTokenCommandFailed)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+}
+
+func TestWithAuth_PassesTokenToCommands(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("ghp_secret"), 0o600))
+
+	output, err := json.Marshal([]Branch{{Name: "master"}})
+	require.NoError(t, err)
+	mockRunner := new(MockCommandRunner)
+	mockRunner.On("Run", mock.MatchedBy(func(ctx context.Context) bool {
+		env := commandEnv(ctx)
+		return len(env) == 1 && env[0] == "GH_TOKEN=ghp_secret"
+	}), "gh", mock.Anything).Return(output, nil)
+
+	client := NewClientWithRunner(mockRunner, logrus.New(), WithAuth(AuthConfig{TokenFile: path}))
+	_, err = client.ListBranches(ctx, "org/repo")
+	require.NoError(t, err)
+	mockRunner.AssertExpectations(t)
+
+	t.Run("unresolvable token fails the command", func(t *testing.T) {
+		runner := new(MockCommandRunner)
+		client := NewClientWithRunner(runner, logrus.New(), WithAuth(AuthConfig{TokenFile: filepath.Join(t.TempDir(), "missing")}))
+		_, err := client.ListBranches(ctx, "org/repo")
+		require.ErrorIs(t, err, ErrTokenUnavailable)
+		runner.AssertNotCalled(t, "Run", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
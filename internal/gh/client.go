@@ -77,10 +77,25 @@ type Client interface {
 	// AddPRComment adds a comment to a pull request (for cases where a review cannot be submitted)
 	AddPRComment(ctx context.Context, repo string, number int, comment string) error
 
+	// ListIssueComments lists every comment on an issue or pull request
+	ListIssueComments(ctx context.Context, repo string, number int) ([]IssueComment, error)
+
+	// CreateIssueComment adds a comment to an issue or pull request and returns it
+	CreateIssueComment(ctx context.Context, repo string, number int, body string) (*IssueComment, error)
+
+	// UpdateIssueComment replaces the body of an existing issue or pull request comment
+	UpdateIssueComment(ctx context.Context, repo string, commentID int64, body string) error
+
 	// GetPRCheckStatus retrieves the status of all check runs for a PR's head commit
 	// Returns a summary of check statuses including running, passed, failed, and skipped counts
 	GetPRCheckStatus(ctx context.Context, repo string, number int) (*CheckStatusSummary, error)
 
+	// GetCommitCheckStatus retrieves the status of all check runs for a commit
+	GetCommitCheckStatus(ctx context.Context, repo, ref string) (*CheckStatusSummary, error)
+
+	// GetCombinedStatus retrieves the combined commit status (legacy status API) for a commit
+	GetCombinedStatus(ctx context.Context, repo, ref string) (*CombinedStatus, error)
+
 	// DiscoverOrgRepos returns all repositories for an owner (organization or user account)
 	// Automatically detects whether the owner is an org or user account
 	// Uses REST API with pagination to fetch all repos
@@ -148,6 +163,9 @@ type Client interface {
 	// SetTopics replaces all topics for a repository
 	SetTopics(ctx context.Context, repo string, topics []string) error
 
+	// GetRepoTopics lists the topics of a repository
+	GetRepoTopics(ctx context.Context, repo string) ([]string, error)
+
 	// CloneRepository clones a GitHub repository to the specified local path
 	CloneRepository(ctx context.Context, repo, destPath string) error
 
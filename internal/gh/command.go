@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"os"
 	"os/exec"
 	"strings"
 	"time"
@@ -66,6 +67,9 @@ func (r *realCommandRunner) Run(ctx context.Context, name string, args ...string
 // - Records command timing and response size metrics
 func (r *realCommandRunner) RunWithInput(ctx context.Context, input []byte, name string, args ...string) ([]byte, error) {
 	cmd := exec.CommandContext(ctx, name, args...) //nolint:gosec // G204: name is a trusted command (gh) from the caller, args are validated
+	if env := commandEnv(ctx); len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
 
 	// Create logger entry only if logger is not nil to avoid panic
 	var logger *logrus.Entry
@@ -147,6 +151,8 @@ func (r *realCommandRunner) RunWithInput(ctx context.Context, input []byte, name
 				is404 := strings.Contains(stderrStr, "404") ||
 					strings.Contains(stderrStr, "Not Found") ||
 					strings.Contains(stderrStr, "could not resolve")
+				// 304 answers a conditional request whose cached response is still current
+				notModified := strings.Contains(stderrStr, "HTTP 304")
 
 				if r.logConfig != nil && r.logConfig.Debug.API {
 					// Error logging with timing context
@@ -160,6 +166,9 @@ func (r *realCommandRunner) RunWithInput(ctx context.Context, input []byte, name
 					if is404 {
 						fields[logging.StandardFields.Status] = "not_found"
 						logger.WithFields(fields).Debug("Resource not found (HTTP 404)")
+					} else if notModified {
+						fields[logging.StandardFields.Status] = "not_modified"
+						logger.WithFields(fields).Debug("Resource not modified (HTTP 304)")
 					} else {
 						fields[logging.StandardFields.Status] = "failed"
 						logger.WithFields(fields).Error("GitHub CLI command failed")
@@ -176,6 +185,9 @@ func (r *realCommandRunner) RunWithInput(ctx context.Context, input []byte, name
 					if is404 {
 						fields[logging.StandardFields.Status] = "not_found"
 						r.logger.WithFields(fields).Debug("Resource not found (HTTP 404)")
+					} else if notModified {
+						fields[logging.StandardFields.Status] = "not_modified"
+						r.logger.WithFields(fields).Debug("Resource not modified (HTTP 304)")
 					} else {
 						fields[logging.StandardFields.Status] = "failed"
 						r.logger.WithFields(fields).Error("Command failed")
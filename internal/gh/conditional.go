@@ -0,0 +1,183 @@
+package gh
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/mrz1836/go-broadcast/internal/cache"
+)
+
+const (
+	// conditionalCacheTTL is how long a cached response is revalidated with
+	// its ETag before it is dropped
+	conditionalCacheTTL = 30 * time.Minute
+
+	// conditionalCacheSize caps the number of cached responses
+	conditionalCacheSize = 500
+)
+
+// ConditionalRequestStats reports how the client's conditional GET requests fared
+type ConditionalRequestStats struct {
+	Conditional int64 // Requests sent with the ETag of a cached response
+	NotModified int64 // Requests answered 304 Not Modified and served from the cache
+}
+
+// ConditionalRequestReporter is implemented by clients that send conditional requests
+type ConditionalRequestReporter interface {
+	ConditionalRequestStats() ConditionalRequestStats
+}
+
+// DisableConditionalRequests turns off the ETag cache, so every GET is sent
+// unconditionally and its response is not kept
+func DisableConditionalRequests() ClientOption {
+	return func(g *githubClient) {
+		g.disableConditional = true
+	}
+}
+
+// enableConditionalRequests installs the ETag cache unless the client was
+// created with DisableConditionalRequests
+func (g *githubClient) enableConditionalRequests() {
+	if !g.disableConditional {
+		g.conditional = newConditionalCache()
+	}
+}
+
+// conditionalEntry is a cached response body and the ETag it was served with
+type conditionalEntry struct {
+	etag string
+	body []byte
+}
+
+// conditionalCache keeps GET responses by endpoint so repeated requests can be
+// sent with If-None-Match. GitHub answers an unchanged resource with 304 Not
+// Modified, which does not count against the rate limit, and the body is then
+// served from the cache. It is safe for concurrent use.
+type conditionalCache struct {
+	entries     *cache.TTLCache
+	conditional atomic.Int64
+	notModified atomic.Int64
+}
+
+// newConditionalCache creates an empty response cache. Its cleanup goroutine
+// runs for the life of the process, like the client that owns it.
+func newConditionalCache() *conditionalCache {
+	return &conditionalCache{entries: cache.NewTTLCache(conditionalCacheTTL, conditionalCacheSize)}
+}
+
+// conditionalResponse is a response read from `gh api --include` output
+type conditionalResponse struct {
+	status   int
+	etag     string
+	nextPage bool // A Link header points at another page
+	body     []byte
+}
+
+// getConditional runs `gh api endpoint` and returns the response body. With
+// the cache enabled, the request carries the ETag of the cached response and a
+// 304 Not Modified reply returns the cached body; other replies replace the
+// cached entry. A response with further pages is returned but not cached, as
+// the ETag of one page says nothing about the others, and reports paged so the
+// caller can fetch the rest.
+func (g *githubClient) getConditional(ctx context.Context, endpoint string) (body []byte, paged bool, err error) {
+	if g.conditional == nil {
+		output, runErr := g.runner.Run(ctx, "gh", "api", endpoint)
+		return output, false, runErr
+	}
+
+	args := []string{"api", endpoint, "--include"}
+	cached, hasCached := g.conditional.lookup(endpoint)
+	if hasCached {
+		args = append(args, "-H", "If-None-Match: "+cached.etag)
+		g.conditional.conditional.Add(1)
+	}
+
+	output, err := g.runner.Run(ctx, "gh", args...)
+	if err != nil {
+		if hasCached && isNotModifiedError(err) {
+			g.conditional.notModified.Add(1)
+			return cached.body, false, nil
+		}
+		return nil, false, err
+	}
+
+	response := parseConditionalResponse(output)
+	if hasCached && response.status == 304 {
+		g.conditional.notModified.Add(1)
+		return cached.body, false, nil
+	}
+	if response.nextPage {
+		g.conditional.entries.Delete(endpoint)
+		return response.body, true, nil
+	}
+	if response.etag != "" {
+		g.conditional.entries.Set(endpoint, conditionalEntry{etag: response.etag, body: response.body})
+	}
+	return response.body, false, nil
+}
+
+// lookup returns the cached response for endpoint
+func (c *conditionalCache) lookup(endpoint string) (conditionalEntry, bool) {
+	value, ok := c.entries.Get(endpoint)
+	if !ok {
+		return conditionalEntry{}, false
+	}
+	entry, ok := value.(conditionalEntry)
+	return entry, ok
+}
+
+// ConditionalRequestStats returns the client's conditional request counters.
+// Clients with conditional requests disabled report zero values.
+func (g *githubClient) ConditionalRequestStats() ConditionalRequestStats {
+	if g.conditional == nil {
+		return ConditionalRequestStats{}
+	}
+	return ConditionalRequestStats{
+		Conditional: g.conditional.conditional.Load(),
+		NotModified: g.conditional.notModified.Load(),
+	}
+}
+
+// parseConditionalResponse splits `gh api --include` output into its status,
+// ETag, pagination and body. Output without a status line is taken as a body.
+func parseConditionalResponse(output []byte) conditionalResponse {
+	if !bytes.HasPrefix(output, []byte("HTTP/")) {
+		return conditionalResponse{body: output}
+	}
+
+	head, body, found := bytes.Cut(output, []byte("\r\n\r\n"))
+	if !found {
+		head, body, _ = bytes.Cut(output, []byte("\n\n"))
+	}
+
+	var response conditionalResponse
+	response.body = body
+	lines := strings.Split(strings.ReplaceAll(string(head), "\r\n", "\n"), "\n")
+	if fields := strings.Fields(lines[0]); len(fields) >= 2 {
+		response.status, _ = strconv.Atoi(fields[1])
+	}
+	for _, line := range lines[1:] {
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "etag":
+			response.etag = value
+		case "link":
+			response.nextPage = strings.Contains(value, `rel="next"`)
+		}
+	}
+	return response
+}
+
+// isNotModifiedError checks if the error is a 304 from GitHub API, which gh
+// reports as a failed request
+func isNotModifiedError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "HTTP 304")
+}
@@ -0,0 +1,136 @@
+package gh
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newConditionalClient returns a client with conditional requests enabled over runner
+func newConditionalClient(t *testing.T, runner CommandRunner) *githubClient {
+	t.Helper()
+	client, ok := NewClientWithRunner(runner, logrus.New()).(*githubClient)
+	require.True(t, ok)
+	client.conditional = newConditionalCache()
+	t.Cleanup(client.conditional.entries.Close)
+	return client
+}
+
+func TestGetFile_ConditionalRequest(t *testing.T) {
+	ctx := context.Background()
+	mockRunner := new(MockCommandRunner)
+	client := newConditionalClient(t, mockRunner)
+
+	const endpoint = "repos/org/repo/contents/README.md?ref=main"
+	body := `{"path":"README.md","sha":"abc123","content":"` + base64.StdEncoding.EncodeToString([]byte("# Title")) + `"}`
+	mockRunner.On("Run", ctx, "gh", []string{"api", endpoint, "--include"}).
+		Return([]byte("HTTP/2.0 200 OK\r\nContent-Type: application/json\r\nEtag: \"v1\"\r\n\r\n"+body), nil).Once()
+	mockRunner.On("Run", ctx, "gh", []string{"api", endpoint, "--include", "-H", `If-None-Match: "v1"`}).
+		Return(nil, &CommandError{Command: "gh", Stderr: "gh: HTTP 304"}).Once()
+
+	for range 2 {
+		file, err := client.GetFile(ctx, "org/repo", "README.md", "main")
+		require.NoError(t, err)
+		assert.Equal(t, "# Title", string(file.Content))
+		assert.Equal(t, "abc123", file.SHA)
+	}
+
+	mockRunner.AssertExpectations(t)
+	assert.Equal(t, ConditionalRequestStats{Conditional: 1, NotModified: 1}, client.ConditionalRequestStats())
+}
+
+func TestGetGitTree_ConditionalRequestChanged(t *testing.T) {
+	ctx := context.Background()
+	mockRunner := new(MockCommandRunner)
+	client := newConditionalClient(t, mockRunner)
+
+	const endpoint = "repos/org/repo/git/trees/main?recursive=1"
+	mockRunner.On("Run", ctx, "gh", []string{"api", endpoint, "--include"}).
+		Return([]byte("HTTP/2.0 200 OK\nETag: W/\"v1\"\n\n{\"sha\":\"one\"}"), nil).Once()
+	mockRunner.On("Run", ctx, "gh", []string{"api", endpoint, "--include", "-H", `If-None-Match: W/"v1"`}).
+		Return([]byte("HTTP/2.0 200 OK\nETag: W/\"v2\"\n\n{\"sha\":\"two\"}"), nil).Once()
+	mockRunner.On("Run", ctx, "gh", []string{"api", endpoint, "--include", "-H", `If-None-Match: W/"v2"`}).
+		Return([]byte("HTTP/2.0 304 Not Modified\nETag: W/\"v2\"\n\n"), nil).Once()
+
+	for _, want := range []string{"one", "two", "two"} {
+		tree, err := client.GetGitTree(ctx, "org/repo", "main", true)
+		require.NoError(t, err)
+		assert.Equal(t, want, tree.SHA)
+	}
+
+	mockRunner.AssertExpectations(t)
+	assert.Equal(t, ConditionalRequestStats{Conditional: 2, NotModified: 1}, client.ConditionalRequestStats())
+}
+
+func TestListBranches_ConditionalRequest(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("single page is cached", func(t *testing.T) {
+		mockRunner := new(MockCommandRunner)
+		client := newConditionalClient(t, mockRunner)
+
+		const endpoint = "repos/org/repo/branches?per_page=100"
+		mockRunner.On("Run", ctx, "gh", []string{"api", endpoint, "--include"}).
+			Return([]byte("HTTP/2.0 200 OK\r\nEtag: \"b1\"\r\n\r\n[{\"name\":\"master\"}]"), nil).Once()
+		mockRunner.On("Run", ctx, "gh", []string{"api", endpoint, "--include", "-H", `If-None-Match: "b1"`}).
+			Return(nil, &CommandError{Command: "gh", Stderr: "gh: HTTP 304"}).Once()
+
+		for range 2 {
+			branches, err := client.ListBranches(ctx, "org/repo")
+			require.NoError(t, err)
+			require.Len(t, branches, 1)
+			assert.Equal(t, "master", branches[0].Name)
+		}
+		mockRunner.AssertExpectations(t)
+	})
+
+	t.Run("more pages are listed in full and not cached", func(t *testing.T) {
+		mockRunner := new(MockCommandRunner)
+		client := newConditionalClient(t, mockRunner)
+
+		mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/branches?per_page=100", "--include"}).
+			Return([]byte("HTTP/2.0 200 OK\r\nEtag: \"b1\"\r\nLink: <https://api.github.com/repositories/1/branches?page=2>; rel=\"next\"\r\n\r\n[{\"name\":\"a\"}]"), nil).Twice()
+		mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/branches?per_page=100", "--paginate"}).
+			Return([]byte(`[{"name":"a"},{"name":"b"}]`), nil).Twice()
+
+		for range 2 {
+			branches, err := client.ListBranches(ctx, "org/repo")
+			require.NoError(t, err)
+			assert.Len(t, branches, 2)
+		}
+		mockRunner.AssertExpectations(t)
+		assert.Zero(t, client.ConditionalRequestStats().Conditional)
+	})
+}
+
+func TestConditionalRequests_Disabled(t *testing.T) {
+	ctx := context.Background()
+	mockRunner := new(MockCommandRunner)
+	client, ok := NewClientWithRunner(mockRunner, logrus.New(), DisableConditionalRequests()).(*githubClient)
+	require.True(t, ok)
+	client.enableConditionalRequests()
+	require.Nil(t, client.conditional)
+
+	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/git/trees/abc"}).
+		Return([]byte(`{"sha":"abc"}`), nil).Twice()
+
+	for range 2 {
+		_, err := client.GetGitTree(ctx, "org/repo", "abc", false)
+		require.NoError(t, err)
+	}
+	mockRunner.AssertExpectations(t)
+
+	assert.Zero(t, client.ConditionalRequestStats())
+}
+
+func TestParseConditionalResponse(t *testing.T) {
+	response := parseConditionalResponse([]byte("HTTP/1.1 200 OK\r\nETAG: \"x\"\r\nLink: <u>; rel=\"last\"\r\n\r\n{}"))
+	assert.Equal(t, conditionalResponse{status: 200, etag: `"x"`, body: []byte("{}")}, response)
+
+	// Output without headers is taken as a body
+	assert.Equal(t, conditionalResponse{body: []byte("[]")}, parseConditionalResponse([]byte("[]")))
+}
@@ -0,0 +1,151 @@
+package gh
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	appErrors "github.com/mrz1836/go-broadcast/internal/errors"
+	"github.com/mrz1836/go-broadcast/internal/jsonutil"
+)
+
+var (
+	// ErrDirectoryNotFound indicates a directory does not exist at the requested ref
+	ErrDirectoryNotFound = errors.New("directory not found")
+	// ErrNotDirectory indicates a path names a file rather than a directory
+	ErrNotDirectory = errors.New("path is not a directory")
+)
+
+// DirectoryReader is implemented by clients that can read a whole directory
+// through the API, so small directories can be synced without cloning
+type DirectoryReader interface {
+	// GetDirectory returns every file under path at ref, recursively, sorted
+	// by path. Paths are relative to the repository root, as in GetFile. An
+	// empty path reads the whole repository. Submodules are skipped.
+	GetDirectory(ctx context.Context, repo, path, ref string) ([]FileContent, error)
+}
+
+// directoryEntry is an entry of a contents API directory listing
+type directoryEntry struct {
+	Path string `json:"path"`
+	Type string `json:"type"` // "file", "dir", "symlink" or "submodule"
+	SHA  string `json:"sha"`
+}
+
+// blob is a git blobs API response
+type blob struct {
+	Content  string `json:"content"`
+	Encoding string `json:"encoding"`
+}
+
+// GetDirectory lists path with the contents API, expands its subdirectories
+// with the git tree API and reads every file as a blob. Blobs are not subject
+// to the 1 MB limit of the contents API.
+func (g *githubClient) GetDirectory(ctx context.Context, repo, dirPath, ref string) ([]FileContent, error) {
+	dirPath = strings.Trim(dirPath, "/")
+	url := fmt.Sprintf("repos/%s/contents/%s", repo, dirPath)
+	if ref != "" {
+		url += fmt.Sprintf("?ref=%s", ref)
+	}
+
+	output, err := g.runner.Run(ctx, "gh", "api", url)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, fmt.Errorf("%w: %s", ErrDirectoryNotFound, dirPath)
+		}
+		return nil, appErrors.WrapWithContext(err, "list directory")
+	}
+	// The contents API answers a file path with an object instead of a listing
+	if !bytes.HasPrefix(bytes.TrimSpace(output), []byte("[")) {
+		return nil, fmt.Errorf("%w: %s", ErrNotDirectory, dirPath)
+	}
+	entries, err := jsonutil.UnmarshalJSON[[]directoryEntry](output)
+	if err != nil {
+		return nil, appErrors.WrapWithContext(err, "parse directory listing")
+	}
+
+	var blobs []GitTreeNode
+	for _, entry := range entries {
+		switch entry.Type {
+		case "file", "symlink":
+			blobs = append(blobs, GitTreeNode{Path: entry.Path, Type: "blob", SHA: entry.SHA})
+		case "dir":
+			if blobs, err = g.appendTreeBlobs(ctx, repo, entry.SHA, entry.Path, blobs); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	files := make([]FileContent, 0, len(blobs))
+	for _, node := range blobs {
+		content, err := g.getBlob(ctx, repo, node.SHA)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", node.Path, err)
+		}
+		files = append(files, FileContent{Path: node.Path, Content: content, SHA: node.SHA})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+	return files, nil
+}
+
+// appendTreeBlobs appends the blobs under the tree treeSHA, whose path in the
+// repository is prefix, to blobs. GitHub truncates recursive listings of large
+// trees; those are walked one level at a time instead.
+func (g *githubClient) appendTreeBlobs(ctx context.Context, repo, treeSHA, prefix string, blobs []GitTreeNode) ([]GitTreeNode, error) {
+	tree, err := g.GetGitTree(ctx, repo, treeSHA, true)
+	if err != nil {
+		return nil, err
+	}
+	walk := tree.Truncated
+	if walk {
+		if tree, err = g.GetGitTree(ctx, repo, treeSHA, false); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, node := range tree.Tree {
+		node.Path = path.Join(prefix, node.Path)
+		switch node.Type {
+		case "blob":
+			blobs = append(blobs, node)
+		case "tree":
+			// A recursive listing already includes the tree's entries
+			if walk {
+				if blobs, err = g.appendTreeBlobs(ctx, repo, node.SHA, node.Path, blobs); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return blobs, nil
+}
+
+// getBlob returns the decoded content of a blob
+func (g *githubClient) getBlob(ctx context.Context, repo, sha string) ([]byte, error) {
+	output, err := g.runner.Run(ctx, "gh", "api", fmt.Sprintf("repos/%s/git/blobs/%s", repo, sha))
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, fmt.Errorf("%w: blob %s", ErrFileNotFound, sha)
+		}
+		return nil, appErrors.WrapWithContext(err, "get blob")
+	}
+	b, err := jsonutil.UnmarshalJSON[blob](output)
+	if err != nil {
+		return nil, appErrors.WrapWithContext(err, "parse blob")
+	}
+	if b.Encoding != "" && b.Encoding != "base64" {
+		return []byte(b.Content), nil
+	}
+
+	// The API wraps base64 content in lines, which the decoder skips
+	content, err := base64.StdEncoding.DecodeString(b.Content)
+	if err != nil {
+		return nil, appErrors.WrapWithContext(err, "decode blob content")
+	}
+	return content, nil
+}
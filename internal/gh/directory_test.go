@@ -0,0 +1,102 @@
+package gh
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestDirectoryReader returns a client running commands through mockRunner as a DirectoryReader
+func newTestDirectoryReader(t *testing.T, mockRunner *MockCommandRunner) DirectoryReader {
+	t.Helper()
+	reader, ok := NewClientWithRunner(mockRunner, logrus.New()).(DirectoryReader)
+	require.True(t, ok)
+	return reader
+}
+
+// onBlob expects a request for the blob sha and answers it with content
+func onBlob(ctx context.Context, mockRunner *MockCommandRunner, sha, content string) {
+	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/git/blobs/" + sha}).
+		Return([]byte(`{"sha":"`+sha+`","encoding":"base64","content":"`+base64.StdEncoding.EncodeToString([]byte(content))+`\n"}`), nil)
+}
+
+func TestGetDirectory(t *testing.T) {
+	ctx := context.Background()
+	listArgs := []string{"api", "repos/org/repo/contents/docs?ref=main"}
+
+	t.Run("reads files and subdirectories", func(t *testing.T) {
+		mockRunner := new(MockCommandRunner)
+		mockRunner.On("Run", ctx, "gh", listArgs).Return([]byte(`[
+			{"path":"docs/readme.md","type":"file","sha":"b1"},
+			{"path":"docs/guides","type":"dir","sha":"t1"},
+			{"path":"docs/vendor","type":"submodule","sha":"c1"}
+		]`), nil)
+		mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/git/trees/t1?recursive=1"}).Return([]byte(`{"sha":"t1","tree":[
+			{"path":"setup.md","type":"blob","sha":"b2"},
+			{"path":"advanced","type":"tree","sha":"t2"},
+			{"path":"advanced/tuning.md","type":"blob","sha":"b3"}
+		],"truncated":false}`), nil)
+		onBlob(ctx, mockRunner, "b1", "# Docs\n")
+		onBlob(ctx, mockRunner, "b2", "setup\n")
+		onBlob(ctx, mockRunner, "b3", "tuning\n")
+
+		files, err := newTestDirectoryReader(t, mockRunner).GetDirectory(ctx, "org/repo", "docs/", "main")
+		require.NoError(t, err)
+		assert.Equal(t, []FileContent{
+			{Path: "docs/guides/advanced/tuning.md", Content: []byte("tuning\n"), SHA: "b3"},
+			{Path: "docs/guides/setup.md", Content: []byte("setup\n"), SHA: "b2"},
+			{Path: "docs/readme.md", Content: []byte("# Docs\n"), SHA: "b1"},
+		}, files)
+		mockRunner.AssertExpectations(t)
+	})
+
+	t.Run("walks truncated trees one level at a time", func(t *testing.T) {
+		mockRunner := new(MockCommandRunner)
+		mockRunner.On("Run", ctx, "gh", listArgs).Return([]byte(`[{"path":"docs/big","type":"dir","sha":"t1"}]`), nil)
+		mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/git/trees/t1?recursive=1"}).
+			Return([]byte(`{"sha":"t1","tree":[{"path":"a.md","type":"blob","sha":"b1"}],"truncated":true}`), nil)
+		mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/git/trees/t1"}).
+			Return([]byte(`{"sha":"t1","tree":[{"path":"a.md","type":"blob","sha":"b1"},{"path":"sub","type":"tree","sha":"t2"}],"truncated":false}`), nil)
+		mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/git/trees/t2?recursive=1"}).
+			Return([]byte(`{"sha":"t2","tree":[{"path":"b.md","type":"blob","sha":"b2"}],"truncated":false}`), nil)
+		onBlob(ctx, mockRunner, "b1", "a")
+		onBlob(ctx, mockRunner, "b2", "b")
+
+		files, err := newTestDirectoryReader(t, mockRunner).GetDirectory(ctx, "org/repo", "docs", "main")
+		require.NoError(t, err)
+		require.Len(t, files, 2)
+		assert.Equal(t, "docs/big/a.md", files[0].Path)
+		assert.Equal(t, "docs/big/sub/b.md", files[1].Path)
+		mockRunner.AssertExpectations(t)
+	})
+
+	t.Run("missing directory", func(t *testing.T) {
+		mockRunner := new(MockCommandRunner)
+		mockRunner.On("Run", ctx, "gh", listArgs).Return(nil, &CommandError{Stderr: "Not Found (HTTP 404)"})
+
+		_, err := newTestDirectoryReader(t, mockRunner).GetDirectory(ctx, "org/repo", "docs", "main")
+		require.ErrorIs(t, err, ErrDirectoryNotFound)
+	})
+
+	t.Run("file path", func(t *testing.T) {
+		mockRunner := new(MockCommandRunner)
+		mockRunner.On("Run", ctx, "gh", listArgs).Return([]byte(`{"path":"docs","type":"file","sha":"b1"}`), nil)
+
+		_, err := newTestDirectoryReader(t, mockRunner).GetDirectory(ctx, "org/repo", "docs", "main")
+		require.ErrorIs(t, err, ErrNotDirectory)
+	})
+
+	t.Run("missing blob names the file", func(t *testing.T) {
+		mockRunner := new(MockCommandRunner)
+		mockRunner.On("Run", ctx, "gh", listArgs).Return([]byte(`[{"path":"docs/a.md","type":"file","sha":"b1"}]`), nil)
+		mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/git/blobs/b1"}).Return(nil, &CommandError{Stderr: "Not Found (HTTP 404)"})
+
+		_, err := newTestDirectoryReader(t, mockRunner).GetDirectory(ctx, "org/repo", "docs", "main")
+		require.ErrorIs(t, err, ErrFileNotFound)
+		assert.Contains(t, err.Error(), "docs/a.md")
+	})
+}
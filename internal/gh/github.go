@@ -33,14 +33,22 @@ var (
 	ErrUserNotFound           = errors.New("user not found")
 	ErrOwnerNotFound          = errors.New("owner not found (neither org nor user)")
 	ErrGraphQLError           = errors.New("GraphQL query failed")
+	ErrPRUpdateConflict       = errors.New("pull request update conflict")
 )
 
 // githubClient implements the Client interface using gh CLI
 type githubClient struct {
 	runner      CommandRunner
 	logger      *logrus.Logger
-	currentUser *User        // Cache for current user
-	mu          sync.RWMutex // Protects currentUser
+	currentUser *User           // Cache for current user
+	mu          sync.RWMutex    // Protects currentUser
+	limiter     *requestLimiter // Shared request rate limiter (nil when unlimited)
+	auth        AuthConfig      // Token source; zero value uses the gh CLI's own authentication
+	proxy       ProxyConfig     // HTTP(S) proxy; zero value uses the environment
+	headers     HeaderConfig    // Extra request headers; zero value sends the gh CLI's own
+
+	conditional        *conditionalCache // ETag cache for conditional GETs (nil when disabled)
+	disableConditional bool              // Set by DisableConditionalRequests
 }
 
 // NewClient creates a new GitHub client using gh CLI.
@@ -49,11 +57,12 @@ type githubClient struct {
 // - ctx: Context for authentication check and cancellation
 // - logger: Logger instance for general logging
 // - logConfig: Configuration for debug logging and verbose settings
+// - opts: Optional client settings such as WithRateLimit
 //
 // Returns:
 // - GitHub client interface implementation
 // - Error if gh CLI is not available or not authenticated
-func NewClient(ctx context.Context, logger *logrus.Logger, logConfig *logging.LogConfig) (Client, error) {
+func NewClient(ctx context.Context, logger *logrus.Logger, logConfig *logging.LogConfig, opts ...ClientOption) (Client, error) {
 	// Initialize audit logger for security event tracking
 	auditLogger := logging.NewAuditLogger()
 
@@ -63,32 +72,47 @@ func NewClient(ctx context.Context, logger *logrus.Logger, logConfig *logging.Lo
 		return nil, ErrGHNotFound
 	}
 
-	runner := NewCommandRunner(logger, logConfig)
+	client := &githubClient{
+		runner:      NewCommandRunner(logger, logConfig),
+		logger:      logger,
+		currentUser: nil,
+	}
+	client.applyOptions(opts)
+	client.enableConditionalRequests()
+
+	if err := client.auth.Validate(); err != nil {
+		return nil, err
+	}
+	if err := client.proxy.Validate(); err != nil {
+		return nil, err
+	}
+	if err := client.headers.Validate(); err != nil {
+		return nil, err
+	}
 
-	// Check authentication status
-	if _, err := runner.Run(ctx, "gh", "auth", "status"); err != nil {
+	// Check authentication status (with the configured token source, if any)
+	if _, err := client.runner.Run(ctx, "gh", "auth", "status"); err != nil {
 		auditLogger.LogAuthentication("unknown", "github_cli", false)
+		if errors.Is(err, ErrTokenUnavailable) {
+			return nil, fmt.Errorf("%w: %w", ErrNotAuthenticated, err)
+		}
 		return nil, fmt.Errorf("%w: gh auth status failed", ErrNotAuthenticated)
 	}
 
 	// Log successful authentication
 	auditLogger.LogAuthentication("github_cli", "github_token", true)
 
-	return &githubClient{
-		runner:      runner,
-		logger:      logger,
-		currentUser: nil,
-	}, nil
+	return client, nil
 }
 
 // ListBranches returns all branches for a repository
 func (g *githubClient) ListBranches(ctx context.Context, repo string) ([]Branch, error) {
-	output, err := g.runner.Run(ctx, "gh", "api", fmt.Sprintf("repos/%s/branches", repo), "--paginate")
+	output, err := g.listBranchesOutput(ctx, repo)
 	if err != nil {
 		return nil, appErrors.WrapWithContext(err, "list branches")
 	}
 
-	branches, err := jsonutil.UnmarshalJSON[[]Branch](output)
+	branches, err := unmarshalPages[Branch](output)
 	if err != nil {
 		return nil, appErrors.WrapWithContext(err, "parse branches")
 	}
@@ -96,6 +120,20 @@ func (g *githubClient) ListBranches(ctx context.Context, repo string) ([]Branch,
 	return branches, nil
 }
 
+// listBranchesOutput fetches every page of the branches of repo. With
+// conditional requests enabled, the first page is requested conditionally, and
+// only repositories with more branches are listed again page by page.
+func (g *githubClient) listBranchesOutput(ctx context.Context, repo string) ([]byte, error) {
+	endpoint := fmt.Sprintf("repos/%s/branches?per_page=%d", repo, listPageSize)
+	if g.conditional != nil {
+		output, paged, err := g.getConditional(ctx, endpoint)
+		if err != nil || !paged {
+			return output, err
+		}
+	}
+	return g.runner.Run(ctx, "gh", "api", endpoint, "--paginate")
+}
+
 // GetBranch returns details for a specific branch
 func (g *githubClient) GetBranch(ctx context.Context, repo, branch string) (*Branch, error) {
 	output, err := g.runner.Run(ctx, "gh", "api", fmt.Sprintf("repos/%s/branches/%s", repo, branch))
@@ -139,6 +177,9 @@ func (g *githubClient) CreatePR(ctx context.Context, repo string, req PRRequest)
 		"head":  headRef,
 		"base":  req.Base,
 	}
+	if req.Draft {
+		prData["draft"] = true
+	}
 
 	jsonData, err := jsonutil.MarshalJSON(prData)
 	if err != nil {
@@ -280,11 +321,12 @@ func (g *githubClient) GetPR(ctx context.Context, repo string, number int) (*PR,
 	return &pr, nil
 }
 
-// ListPRs lists pull requests for a repository
+// ListPRs lists every pull request of a repository in the given state (open,
+// closed or all; GitHub lists open PRs when state is empty)
 func (g *githubClient) ListPRs(ctx context.Context, repo, state string) ([]PR, error) {
-	apiURL := fmt.Sprintf("repos/%s/pulls", repo)
-	if state != "" && state != "all" {
-		apiURL += fmt.Sprintf("?state=%s", state)
+	apiURL := fmt.Sprintf("repos/%s/pulls?per_page=%d", repo, listPageSize)
+	if state != "" {
+		apiURL += fmt.Sprintf("&state=%s", state)
 	}
 
 	args := []string{"api", apiURL, "--paginate"}
@@ -294,7 +336,7 @@ func (g *githubClient) ListPRs(ctx context.Context, repo, state string) ([]PR, e
 		return nil, appErrors.WrapWithContext(err, "list PRs")
 	}
 
-	prs, err := jsonutil.UnmarshalJSON[[]PR](output)
+	prs, err := unmarshalPages[PR](output)
 	if err != nil {
 		return nil, appErrors.WrapWithContext(err, "parse PRs")
 	}
@@ -309,7 +351,7 @@ func (g *githubClient) GetFile(ctx context.Context, repo, path, ref string) (*Fi
 		url += fmt.Sprintf("?ref=%s", ref)
 	}
 
-	output, err := g.runner.Run(ctx, "gh", "api", url)
+	output, _, err := g.getConditional(ctx, url)
 	if err != nil {
 		if isNotFoundError(err) {
 			return nil, ErrFileNotFound
@@ -386,21 +428,47 @@ func (g *githubClient) DeleteBranch(ctx context.Context, repo, branch string) er
 	return nil
 }
 
-// UpdatePR updates a pull request
+// UpdatePR updates a pull request. State and body changes are sent in one
+// PATCH; a draft change is applied afterwards.
 func (g *githubClient) UpdatePR(ctx context.Context, repo string, number int, updates PRUpdate) error {
-	jsonData, err := jsonutil.MarshalJSON(updates)
-	if err != nil {
-		return appErrors.WrapWithContext(err, "marshal PR update")
+	if updates.State != nil || updates.Body != nil {
+		jsonData, err := jsonutil.MarshalJSON(updates)
+		if err != nil {
+			return appErrors.WrapWithContext(err, "marshal PR update")
+		}
+
+		_, err = g.runner.RunWithInput(ctx, jsonData, "gh", "api", fmt.Sprintf("repos/%s/pulls/%d", repo, number), "--method", "PATCH", "--input", "-")
+		if err != nil {
+			if isNotFoundError(err) {
+				return ErrPRNotFound
+			}
+			if isConflictError(err) {
+				return fmt.Errorf("%w: %w", ErrPRUpdateConflict, err)
+			}
+			return appErrors.WrapWithContext(err, "update PR")
+		}
 	}
 
-	_, err = g.runner.RunWithInput(ctx, jsonData, "gh", "api", fmt.Sprintf("repos/%s/pulls/%d", repo, number), "--method", "PATCH", "--input", "-")
-	if err != nil {
+	if updates.Draft != nil {
+		return g.setPRDraft(ctx, repo, number, *updates.Draft)
+	}
+	return nil
+}
+
+// setPRDraft converts a pull request to a draft or marks it ready for review.
+// The REST API cannot change draft status, so this goes through `gh pr ready`.
+func (g *githubClient) setPRDraft(ctx context.Context, repo string, number int, draft bool) error {
+	args := []string{"pr", "ready", fmt.Sprintf("%d", number), "--repo", repo}
+	if draft {
+		args = append(args, "--undo")
+	}
+
+	if _, err := g.runner.Run(ctx, "gh", args...); err != nil {
 		if isNotFoundError(err) {
 			return ErrPRNotFound
 		}
-		return appErrors.WrapWithContext(err, "update PR")
+		return appErrors.WrapWithContext(err, fmt.Sprintf("set draft=%t for PR #%d", draft, number))
 	}
-
 	return nil
 }
 
@@ -423,6 +491,57 @@ func (g *githubClient) AddPRComment(ctx context.Context, repo string, number int
 	return nil
 }
 
+// ListIssueComments lists every comment on an issue or pull request
+func (g *githubClient) ListIssueComments(ctx context.Context, repo string, number int) ([]IssueComment, error) {
+	output, err := g.runner.Run(ctx, "gh", "api",
+		fmt.Sprintf("repos/%s/issues/%d/comments?per_page=%d", repo, number, listPageSize), "--paginate")
+	if err != nil {
+		return nil, appErrors.WrapWithContext(err, "list issue comments")
+	}
+
+	comments, err := unmarshalPages[IssueComment](output)
+	if err != nil {
+		return nil, appErrors.WrapWithContext(err, "parse issue comments")
+	}
+
+	return comments, nil
+}
+
+// CreateIssueComment adds a comment to an issue or pull request and returns it
+func (g *githubClient) CreateIssueComment(ctx context.Context, repo string, number int, body string) (*IssueComment, error) {
+	jsonData, err := jsonutil.MarshalJSON(map[string]string{"body": body})
+	if err != nil {
+		return nil, appErrors.WrapWithContext(err, "marshal comment")
+	}
+
+	output, err := g.runner.RunWithInput(ctx, jsonData, "gh", "api", fmt.Sprintf("repos/%s/issues/%d/comments", repo, number), "--method", "POST", "--input", "-")
+	if err != nil {
+		return nil, appErrors.WrapWithContext(err, "create issue comment")
+	}
+
+	comment, err := jsonutil.UnmarshalJSON[IssueComment](output)
+	if err != nil {
+		return nil, appErrors.WrapWithContext(err, "parse issue comment")
+	}
+
+	return &comment, nil
+}
+
+// UpdateIssueComment replaces the body of an existing issue or pull request comment
+func (g *githubClient) UpdateIssueComment(ctx context.Context, repo string, commentID int64, body string) error {
+	jsonData, err := jsonutil.MarshalJSON(map[string]string{"body": body})
+	if err != nil {
+		return appErrors.WrapWithContext(err, "marshal comment")
+	}
+
+	_, err = g.runner.RunWithInput(ctx, jsonData, "gh", "api", fmt.Sprintf("repos/%s/issues/comments/%d", repo, commentID), "--method", "PATCH", "--input", "-")
+	if err != nil {
+		return appErrors.WrapWithContext(err, "update issue comment")
+	}
+
+	return nil
+}
+
 // GetCurrentUser returns the authenticated user
 func (g *githubClient) GetCurrentUser(ctx context.Context) (*User, error) {
 	// Check cache with read lock
@@ -459,7 +578,7 @@ func (g *githubClient) GetGitTree(ctx context.Context, repo, treeSHA string, rec
 		apiURL += "?recursive=1"
 	}
 
-	output, err := g.runner.Run(ctx, "gh", "api", apiURL)
+	output, _, err := g.getConditional(ctx, apiURL)
 	if err != nil {
 		if isNotFoundError(err) {
 			return nil, fmt.Errorf("%w: %s", ErrGitTreeNotFound, treeSHA)
@@ -488,6 +607,17 @@ func isNotFoundError(err error) bool {
 		strings.Contains(errStr, "could not resolve")
 }
 
+// isConflictError checks if the error is a 409 (conflict) from GitHub API.
+// Only gh's status marker is matched: a bare "409" or "Conflict" can appear in
+// PR numbers, branch names or merge-conflict messages.
+func isConflictError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return strings.Contains(err.Error(), "HTTP 409")
+}
+
 // isValidationFailedError checks if the error is a 422 (validation failed) from GitHub API
 func isValidationFailedError(err error) bool {
 	if err == nil {
@@ -518,12 +648,15 @@ func IsBranchProtectionError(err error) bool {
 }
 
 // NewClientWithRunner creates a GitHub client with a custom command runner (for testing)
-func NewClientWithRunner(runner CommandRunner, logger *logrus.Logger) Client {
-	return &githubClient{
+func NewClientWithRunner(runner CommandRunner, logger *logrus.Logger, opts ...ClientOption) Client {
+	client := &githubClient{
 		runner:      runner,
 		logger:      logger,
 		currentUser: nil,
 	}
+	client.applyOptions(opts)
+
+	return client
 }
 
 // GetRepository retrieves repository details including merge settings
@@ -782,11 +915,19 @@ func (g *githubClient) GetPRCheckStatus(ctx context.Context, repo string, number
 		return nil, appErrors.WrapWithContext(err, fmt.Sprintf("get PR #%d for check status", number))
 	}
 
-	// Get check runs for the head commit
-	output, err := g.runner.Run(ctx, "gh", "api", fmt.Sprintf("repos/%s/commits/%s/check-runs", repo, pr.Head.SHA))
+	summary, err := g.GetCommitCheckStatus(ctx, repo, pr.Head.SHA)
 	if err != nil {
 		return nil, appErrors.WrapWithContext(err, fmt.Sprintf("get check runs for PR #%d", number))
 	}
+	return summary, nil
+}
+
+// GetCommitCheckStatus retrieves the status of all check runs for a commit
+func (g *githubClient) GetCommitCheckStatus(ctx context.Context, repo, ref string) (*CheckStatusSummary, error) {
+	output, err := g.runner.Run(ctx, "gh", "api", fmt.Sprintf("repos/%s/commits/%s/check-runs", repo, ref))
+	if err != nil {
+		return nil, appErrors.WrapWithContext(err, fmt.Sprintf("get check runs for %s", ref))
+	}
 
 	response, err := jsonutil.UnmarshalJSON[CheckRunsResponse](output)
 	if err != nil {
@@ -820,6 +961,20 @@ func (g *githubClient) GetPRCheckStatus(ctx context.Context, repo string, number
 	return summary, nil
 }
 
+// GetCombinedStatus retrieves the combined commit status (legacy status API) for a commit
+func (g *githubClient) GetCombinedStatus(ctx context.Context, repo, ref string) (*CombinedStatus, error) {
+	output, err := g.runner.Run(ctx, "gh", "api", fmt.Sprintf("repos/%s/commits/%s/status", repo, ref))
+	if err != nil {
+		return nil, appErrors.WrapWithContext(err, fmt.Sprintf("get combined status for %s", ref))
+	}
+
+	status, err := jsonutil.UnmarshalJSON[CombinedStatus](output)
+	if err != nil {
+		return nil, appErrors.WrapWithContext(err, "parse combined status response")
+	}
+	return &status, nil
+}
+
 // GraphQLResponse represents a GraphQL API response
 type GraphQLResponse struct {
 	Data   map[string]interface{} `json:"data"`
@@ -187,6 +187,34 @@ func TestGitHubClient_UpdatePR(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:   "convert to draft",
+			repo:   "owner/repo",
+			number: 123,
+			updates: PRUpdate{
+				Draft: boolPtr(true),
+			},
+			mockSetup: func(mr *MockCommandRunner) {
+				mr.On("Run", mock.Anything, "gh", []string{"pr", "ready", "123", "--repo", "owner/repo", "--undo"}).Return([]byte(""), nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:   "body and ready for review",
+			repo:   "owner/repo",
+			number: 456,
+			updates: PRUpdate{
+				Body:  stringPtr("Updated description"),
+				Draft: boolPtr(false),
+			},
+			mockSetup: func(mr *MockCommandRunner) {
+				mr.On("RunWithInput", mock.Anything, mock.MatchedBy(func(data []byte) bool {
+					return string(data) == `{"body":"Updated description"}`
+				}), "gh", []string{"api", "repos/owner/repo/pulls/456", "--method", "PATCH", "--input", "-"}).Return([]byte(""), nil)
+				mr.On("Run", mock.Anything, "gh", []string{"pr", "ready", "456", "--repo", "owner/repo"}).Return([]byte(""), nil)
+			},
+			wantErr: false,
+		},
 		{
 			name:   "PR not found",
 			repo:   "owner/repo",
@@ -230,6 +258,11 @@ func stringPtr(s string) *string {
 	return &s
 }
 
+// Helper function to create bool pointers
+func boolPtr(b bool) *bool {
+	return &b
+}
+
 // mockNotFoundError implements an error that looks like a GitHub 404
 type mockNotFoundError struct{}
 
@@ -31,7 +31,7 @@ func TestListBranches(t *testing.T) {
 	output, err := json.Marshal(branches)
 	require.NoError(t, err)
 
-	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/branches", "--paginate"}).
+	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/branches?per_page=100", "--paginate"}).
 		Return(output, nil)
 
 	result, err := client.ListBranches(ctx, "org/repo")
@@ -48,7 +48,7 @@ func TestListBranches_Error(t *testing.T) {
 	mockRunner := new(MockCommandRunner)
 	client := NewClientWithRunner(mockRunner, logrus.New())
 
-	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/branches", "--paginate"}).
+	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/branches?per_page=100", "--paginate"}).
 		Return(nil, internalerrors.ErrTest)
 
 	result, err := client.ListBranches(ctx, "org/repo")
@@ -179,6 +179,55 @@ func TestCreatePR_HeadFormatting(t *testing.T) {
 	mockRunner.AssertExpectations(t)
 }
 
+func TestCreatePR_Draft(t *testing.T) {
+	ctx := context.Background()
+	mockRunner := new(MockCommandRunner)
+	client := NewClientWithRunner(mockRunner, logrus.New())
+
+	output, err := json.Marshal(PR{Number: 42, State: "open", Draft: true})
+	require.NoError(t, err)
+
+	mockRunner.On("RunWithInput", ctx, mock.MatchedBy(func(jsonData []byte) bool {
+		var prData map[string]interface{}
+		if unmarshalErr := json.Unmarshal(jsonData, &prData); unmarshalErr != nil {
+			return false
+		}
+		return prData["draft"] == true
+	}), "gh", []string{"api", "repos/org/repo/pulls", "--method", "POST", "--input", "-"}).
+		Return(output, nil)
+
+	result, err := client.CreatePR(ctx, "org/repo", PRRequest{Title: "Test PR", Head: "feature", Base: "master", Draft: true})
+	require.NoError(t, err)
+	assert.True(t, result.Draft)
+
+	mockRunner.AssertExpectations(t)
+}
+
+func TestCreatePR_CrossRepoHead(t *testing.T) {
+	ctx := context.Background()
+	mockRunner := new(MockCommandRunner)
+	client := NewClientWithRunner(mockRunner, logrus.New())
+
+	output, err := json.Marshal(PR{Number: 7, State: "open"})
+	require.NoError(t, err)
+
+	// A head that already names its owner is sent unchanged, opening the PR from a fork
+	mockRunner.On("RunWithInput", ctx, mock.MatchedBy(func(jsonData []byte) bool {
+		var prData map[string]interface{}
+		if unmarshalErr := json.Unmarshal(jsonData, &prData); unmarshalErr != nil {
+			return false
+		}
+		return prData["head"] == "myuser:sync-branch" && prData["base"] == "main"
+	}), "gh", []string{"api", "repos/org/upstream/pulls", "--method", "POST", "--input", "-"}).
+		Return(output, nil)
+
+	result, err := client.CreatePR(ctx, "org/upstream", PRRequest{Title: "Sync", Head: "myuser:sync-branch", Base: "main"})
+	require.NoError(t, err)
+	assert.Equal(t, 7, result.Number)
+
+	mockRunner.AssertExpectations(t)
+}
+
 func TestGetPR(t *testing.T) {
 	ctx := context.Background()
 	mockRunner := new(MockCommandRunner)
@@ -216,7 +265,7 @@ func TestListPRs(t *testing.T) {
 	output, err := json.Marshal(prs)
 	require.NoError(t, err)
 
-	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/pulls?state=open", "--paginate"}).
+	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/pulls?per_page=100&state=open", "--paginate"}).
 		Return(output, nil)
 
 	result, err := client.ListPRs(ctx, "org/repo", "open")
@@ -329,7 +378,7 @@ func TestListBranches_JSONUnmarshalError(t *testing.T) {
 	client := NewClientWithRunner(mockRunner, logrus.New())
 
 	// Return invalid JSON
-	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/branches", "--paginate"}).
+	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/branches?per_page=100", "--paginate"}).
 		Return([]byte("invalid json"), nil)
 
 	result, err := client.ListBranches(ctx, "org/repo")
@@ -480,7 +529,7 @@ func TestListPRs_Error(t *testing.T) {
 	mockRunner := new(MockCommandRunner)
 	client := NewClientWithRunner(mockRunner, logrus.New())
 
-	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/pulls", "--paginate"}).
+	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/pulls?per_page=100&state=all", "--paginate"}).
 		Return(nil, internalerrors.ErrTest)
 
 	result, err := client.ListPRs(ctx, "org/repo", "all")
@@ -497,7 +546,7 @@ func TestListPRs_JSONUnmarshalError(t *testing.T) {
 	mockRunner := new(MockCommandRunner)
 	client := NewClientWithRunner(mockRunner, logrus.New())
 
-	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/pulls", "--paginate"}).
+	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/pulls?per_page=100&state=all", "--paginate"}).
 		Return([]byte("invalid json"), nil)
 
 	result, err := client.ListPRs(ctx, "org/repo", "all")
@@ -520,7 +569,7 @@ func TestListPRs_WithEmptyState(t *testing.T) {
 	output, err := json.Marshal(prs)
 	require.NoError(t, err)
 
-	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/pulls", "--paginate"}).
+	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/pulls?per_page=100", "--paginate"}).
 		Return(output, nil)
 
 	result, err := client.ListPRs(ctx, "org/repo", "")
@@ -753,6 +802,48 @@ func TestIsValidationFailedError(t *testing.T) {
 	}
 }
 
+// TestIsConflictError tests the isConflictError helper function
+func TestIsConflictError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "Nil error",
+			err:      nil,
+			expected: false,
+		},
+		{
+			name:     "HTTP 409 error",
+			err:      &CommandError{Stderr: "HTTP 409: Conflict"},
+			expected: true,
+		},
+		{
+			name:     "PR number 409",
+			err:      &CommandError{Stderr: "HTTP 404: Not Found (repos/org/repo/pulls/409)"},
+			expected: false,
+		},
+		{
+			name:     "Branch name containing 409",
+			err:      &CommandError{Stderr: "HTTP 422: Reference chore/sync-409 is invalid"},
+			expected: false,
+		},
+		{
+			name:     "Merge conflict message",
+			err:      &CommandError{Stderr: "Conflict: merge conflict between base and head"},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := isConflictError(tt.err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 // TestIsBranchProtectionError tests the IsBranchProtectionError helper function
 func TestIsBranchProtectionError(t *testing.T) {
 	tests := []struct {
@@ -2060,6 +2151,58 @@ func TestAddPRComment_Error(t *testing.T) {
 	mockRunner.AssertExpectations(t)
 }
 
+// TestIssueComments tests listing, creating and updating issue comments
+func TestIssueComments(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("list every page", func(t *testing.T) {
+		mockRunner := new(MockCommandRunner)
+		client := NewClientWithRunner(mockRunner, logrus.New())
+		mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/issues/7/comments?per_page=100", "--paginate"}).
+			Return([]byte(`[{"id": 1, "body": "first"}][{"id": 2, "body": "second"}]`), nil)
+
+		comments, err := client.ListIssueComments(ctx, "org/repo", 7)
+		require.NoError(t, err)
+		require.Len(t, comments, 2)
+		assert.Equal(t, int64(2), comments[1].ID)
+		assert.Equal(t, "second", comments[1].Body)
+	})
+
+	t.Run("create returns the comment", func(t *testing.T) {
+		mockRunner := new(MockCommandRunner)
+		client := NewClientWithRunner(mockRunner, logrus.New())
+		mockRunner.On("RunWithInput", ctx, []byte(`{"body":"status"}`), "gh",
+			[]string{"api", "repos/org/repo/issues/7/comments", "--method", "POST", "--input", "-"}).
+			Return([]byte(`{"id": 42, "body": "status", "html_url": "https://github.com/org/repo/issues/7#issuecomment-42"}`), nil)
+
+		comment, err := client.CreateIssueComment(ctx, "org/repo", 7, "status")
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), comment.ID)
+		assert.Equal(t, "https://github.com/org/repo/issues/7#issuecomment-42", comment.HTMLURL)
+	})
+
+	t.Run("update patches the comment", func(t *testing.T) {
+		mockRunner := new(MockCommandRunner)
+		client := NewClientWithRunner(mockRunner, logrus.New())
+		mockRunner.On("RunWithInput", ctx, []byte(`{"body":"new status"}`), "gh",
+			[]string{"api", "repos/org/repo/issues/comments/42", "--method", "PATCH", "--input", "-"}).
+			Return([]byte(`{"id": 42}`), nil)
+
+		require.NoError(t, client.UpdateIssueComment(ctx, "org/repo", 42, "new status"))
+		mockRunner.AssertExpectations(t)
+	})
+
+	t.Run("update error", func(t *testing.T) {
+		mockRunner := new(MockCommandRunner)
+		client := NewClientWithRunner(mockRunner, logrus.New())
+		mockRunner.On("RunWithInput", ctx, mock.Anything, "gh", mock.Anything).Return(nil, errTestAPIError)
+
+		err := client.UpdateIssueComment(ctx, "org/repo", 42, "new status")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "update issue comment")
+	})
+}
+
 // TestCreatePR_WithLabels tests PR creation with labels
 func TestCreatePR_WithLabels(t *testing.T) {
 	ctx := context.Background()
@@ -2631,6 +2774,82 @@ func TestGetPRCheckStatus_JSONUnmarshalError(t *testing.T) {
 	mockRunner.AssertExpectations(t)
 }
 
+// TestGetCommitCheckStatus tests reading check runs for a commit directly
+func TestGetCommitCheckStatus(t *testing.T) {
+	ctx := context.Background()
+	mockRunner := new(MockCommandRunner)
+	client := NewClientWithRunner(mockRunner, logrus.New())
+
+	checkRunsOutput, err := json.Marshal(CheckRunsResponse{
+		TotalCount: 2,
+		CheckRuns: []CheckRun{
+			{ID: 1, Name: "CI / Build", Status: "completed", Conclusion: "success"},
+			{ID: 2, Name: "CI / Tests", Status: "queued"},
+		},
+	})
+	require.NoError(t, err)
+
+	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/commits/abc123def456/check-runs"}).
+		Return(checkRunsOutput, nil)
+
+	result, err := client.GetCommitCheckStatus(ctx, "org/repo", "abc123def456")
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Total)
+	assert.Equal(t, 1, result.Running)
+	assert.Equal(t, []string{"CI / Tests"}, result.RunningCheckNames())
+
+	mockRunner.AssertExpectations(t)
+}
+
+// TestGetCombinedStatus tests reading the combined commit status
+func TestGetCombinedStatus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		mockRunner := new(MockCommandRunner)
+		client := NewClientWithRunner(mockRunner, logrus.New())
+		mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/commits/abc123def456/status"}).
+			Return([]byte(`{"state":"failure","total_count":3,"statuses":[`+
+				`{"context":"ci/build","state":"success"},`+
+				`{"context":"ci/deploy","state":"error","description":"deploy failed"},`+
+				`{"context":"ci/test","state":"failure","target_url":"https://ci.example.com/1"}]}`), nil)
+
+		result, err := client.GetCombinedStatus(ctx, "org/repo", "abc123def456")
+		require.NoError(t, err)
+		assert.Equal(t, "failure", result.State)
+		assert.Equal(t, 3, result.TotalCount)
+		require.Len(t, result.Statuses, 3)
+		assert.Equal(t, "https://ci.example.com/1", result.Statuses[2].TargetURL)
+		assert.Equal(t, []string{"ci/deploy", "ci/test"}, result.FailedContexts())
+
+		mockRunner.AssertExpectations(t)
+	})
+
+	t.Run("API error", func(t *testing.T) {
+		mockRunner := new(MockCommandRunner)
+		client := NewClientWithRunner(mockRunner, logrus.New())
+		mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/commits/abc123def456/status"}).
+			Return(nil, errTestAPIError)
+
+		result, err := client.GetCombinedStatus(ctx, "org/repo", "abc123def456")
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "get combined status for abc123def456")
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		mockRunner := new(MockCommandRunner)
+		client := NewClientWithRunner(mockRunner, logrus.New())
+		mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/commits/abc123def456/status"}).
+			Return([]byte("invalid json"), nil)
+
+		result, err := client.GetCombinedStatus(ctx, "org/repo", "abc123def456")
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "parse combined status response")
+	})
+}
+
 // TestCheckStatusSummary_Summary tests the Summary method
 func TestCheckStatusSummary_Summary(t *testing.T) {
 	tests := []struct {
@@ -0,0 +1,148 @@
+package gh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// ErrInvalidHeader indicates a custom request header that cannot be sent
+var ErrInvalidHeader = errors.New("invalid request header")
+
+// protectedHeaders are set by the gh CLI itself for authentication, routing
+// and framing; custom headers never replace them
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var protectedHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Host":                true,
+	"Content-Length":      true,
+	"Transfer-Encoding":   true,
+	"Connection":          true,
+}
+
+// HeaderConfig adds headers to every GitHub API request, for gateways in
+// front of GitHub that route on a header or need a descriptive User-Agent.
+// The headers are passed to each `gh api` command with -H.
+type HeaderConfig struct {
+	// UserAgent replaces the gh CLI's User-Agent when set
+	UserAgent string
+
+	// ExtraHeaders maps header names to the values sent with every request
+	ExtraHeaders map[string]string
+}
+
+// Enabled reports whether any header is configured
+func (h HeaderConfig) Enabled() bool {
+	return h.UserAgent != "" || len(h.ExtraHeaders) > 0
+}
+
+// Validate checks that every header name is a valid HTTP token, that no value
+// holds a line break, and that no header replaces Authorization or another
+// header the gh CLI owns. User-Agent is set with UserAgent, not ExtraHeaders.
+func (h HeaderConfig) Validate() error {
+	if !validHeaderValue(h.UserAgent) {
+		return fmt.Errorf("%w: User-Agent value contains control characters", ErrInvalidHeader)
+	}
+	for name, value := range h.ExtraHeaders {
+		if !validHeaderName(name) {
+			return fmt.Errorf("%w: %q is not a valid header name", ErrInvalidHeader, name)
+		}
+		canonical := http.CanonicalHeaderKey(name)
+		if protectedHeaders[canonical] {
+			return fmt.Errorf("%w: %s cannot be overridden", ErrInvalidHeader, canonical)
+		}
+		if canonical == "User-Agent" {
+			return fmt.Errorf("%w: set User-Agent with user_agent instead of a custom header", ErrInvalidHeader)
+		}
+		if !validHeaderValue(value) {
+			return fmt.Errorf("%w: value of %s contains control characters", ErrInvalidHeader, canonical)
+		}
+	}
+	return nil
+}
+
+// validHeaderName reports whether name is an RFC 9110 token
+func validHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, c := range name {
+		isAlnum := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+		if !isAlnum && !strings.ContainsRune("!#$%&'*+-.^_`|~", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// validHeaderValue reports whether value holds no control characters other
+// than tabs, so it cannot end the header line early
+func validHeaderValue(value string) bool {
+	for _, c := range value {
+		if (c < ' ' && c != '\t') || c == 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
+// args returns the -H arguments for the configured headers, sorted by name so
+// every command carries them in the same order
+func (h HeaderConfig) args() []string {
+	headers := make([]string, 0, len(h.ExtraHeaders))
+	for name, value := range h.ExtraHeaders {
+		headers = append(headers, http.CanonicalHeaderKey(name)+": "+strings.TrimSpace(value))
+	}
+	sort.Strings(headers)
+
+	args := make([]string, 0, 2*(len(headers)+1))
+	if h.UserAgent != "" {
+		args = append(args, "-H", "User-Agent: "+h.UserAgent)
+	}
+	for _, header := range headers {
+		args = append(args, "-H", header)
+	}
+	return args
+}
+
+// WithHeaders sends the headers in cfg with every GitHub API request.
+// NewClient validates them and fails before the first request.
+func WithHeaders(cfg HeaderConfig) ClientOption {
+	return func(g *githubClient) {
+		g.headers = cfg
+	}
+}
+
+// headerRunner adds the configured headers to every `gh api` command; other
+// gh commands do not accept headers and run unchanged
+type headerRunner struct {
+	runner  CommandRunner
+	headers HeaderConfig
+}
+
+// Run executes the command with the headers added
+func (r *headerRunner) Run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	return r.runner.Run(ctx, name, r.withHeaders(args)...)
+}
+
+// RunWithInput executes the command with stdin input and the headers added
+func (r *headerRunner) RunWithInput(ctx context.Context, input []byte, name string, args ...string) ([]byte, error) {
+	return r.runner.RunWithInput(ctx, input, name, r.withHeaders(args)...)
+}
+
+// withHeaders inserts the -H arguments right after "api"
+func (r *headerRunner) withHeaders(args []string) []string {
+	if len(args) == 0 || args[0] != "api" {
+		return args
+	}
+	withHeaders := make([]string, 0, len(args)+2*(len(r.headers.ExtraHeaders)+1))
+	withHeaders = append(withHeaders, args[0])
+	withHeaders = append(withHeaders, r.headers.args()...)
+	return append(withHeaders, args[1:]...)
+}
@@ -0,0 +1,67 @@
+package gh
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeaderConfig_Validate(t *testing.T) {
+	valid := []HeaderConfig{
+		{},
+		{UserAgent: "acme-broadcast/1.0 (+https://acme.example)"},
+		{ExtraHeaders: map[string]string{"X-Route-To": "github-eu", "x-request-source": "ci\tnightly"}},
+	}
+	for _, cfg := range valid {
+		require.NoError(t, cfg.Validate(), "%+v", cfg)
+	}
+
+	invalid := map[string]HeaderConfig{
+		"empty name":              {ExtraHeaders: map[string]string{"": "value"}},
+		"space in name":           {ExtraHeaders: map[string]string{"X Route": "value"}},
+		"colon in name":           {ExtraHeaders: map[string]string{"X-Route:": "value"}},
+		"line break in value":     {ExtraHeaders: map[string]string{"X-Route": "eu\r\nAuthorization: token x"}},
+		"line break in agent":     {UserAgent: "agent\nX-Other: 1"},
+		"authorization":           {ExtraHeaders: map[string]string{"Authorization": "token ghp_other"}},
+		"lowercase authorization": {ExtraHeaders: map[string]string{"authorization": "token ghp_other"}},
+		"proxy authorization":     {ExtraHeaders: map[string]string{"Proxy-Authorization": "Basic eA=="}},
+		"host":                    {ExtraHeaders: map[string]string{"Host": "evil.example"}},
+		"user agent as header":    {ExtraHeaders: map[string]string{"User-Agent": "agent"}},
+	}
+	for name, cfg := range invalid {
+		require.ErrorIs(t, cfg.Validate(), ErrInvalidHeader, name)
+	}
+}
+
+func TestWithHeaders_AddsHeadersToAPICommands(t *testing.T) {
+	ctx := context.Background()
+	output, err := json.Marshal([]Branch{{Name: "master"}})
+	require.NoError(t, err)
+
+	mockRunner := new(MockCommandRunner)
+	mockRunner.On("Run", ctx, "gh", []string{
+		"api",
+		"-H", "User-Agent: acme-broadcast/1.0",
+		"-H", "X-Request-Source: ci",
+		"-H", "X-Route-To: github-eu",
+		"repos/org/repo/branches?per_page=100", "--paginate",
+	}).Return(output, nil)
+	mockRunner.On("Run", ctx, "gh", []string{"auth", "status"}).Return([]byte{}, nil)
+
+	client := NewClientWithRunner(mockRunner, logrus.New(), WithHeaders(HeaderConfig{
+		UserAgent:    "acme-broadcast/1.0",
+		ExtraHeaders: map[string]string{"x-route-to": "github-eu", "X-Request-Source": " ci "},
+	}))
+	_, err = client.ListBranches(ctx, "org/repo")
+	require.NoError(t, err)
+
+	// Commands other than gh api take no headers
+	ghClient, ok := client.(*githubClient)
+	require.True(t, ok)
+	_, err = ghClient.runner.Run(ctx, "gh", "auth", "status")
+	require.NoError(t, err)
+	mockRunner.AssertExpectations(t)
+}
@@ -0,0 +1,176 @@
+package gh
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimitConfig configures a token bucket that caps the overall request
+// rate of a client. Every API call made through the client draws from the
+// same bucket, so concurrent repository syncs share one budget.
+type RateLimitConfig struct {
+	RequestsPerSecond float64 // Sustained request rate; 0 disables the limiter
+	Burst             int     // Requests allowed back-to-back (default: 1)
+}
+
+// Enabled reports whether the configuration limits the request rate
+func (c RateLimitConfig) Enabled() bool {
+	return c.RequestsPerSecond > 0
+}
+
+// RateLimitStats reports how often the client-side rate limiter delayed calls
+type RateLimitStats struct {
+	Throttled int64         // API calls that had to wait for a token
+	Waited    time.Duration // Total time spent waiting
+}
+
+// RateLimitReporter is implemented by clients configured with a rate limiter
+type RateLimitReporter interface {
+	RateLimitStats() RateLimitStats
+}
+
+// ThrottleCounter counts rate-limiter delays and retries for the API calls made
+// with one context, so concurrent syncs sharing a client can each report their own
+type ThrottleCounter struct {
+	throttled atomic.Int64
+	retried   atomic.Int64
+}
+
+// Throttled returns how many calls made with the counter's context were delayed
+func (c *ThrottleCounter) Throttled() int64 {
+	return c.throttled.Load()
+}
+
+// Retried returns how many calls made with the counter's context were retried
+func (c *ThrottleCounter) Retried() int64 {
+	return c.retried.Load()
+}
+
+// countRetry records a retry against the ThrottleCounter attached to ctx, if any
+func countRetry(ctx context.Context) {
+	if counter, ok := ctx.Value(throttleCounterKey{}).(*ThrottleCounter); ok && counter != nil {
+		counter.retried.Add(1)
+	}
+}
+
+// throttleCounterKey is the context key for a ThrottleCounter
+type throttleCounterKey struct{}
+
+// WithThrottleCounter returns a context whose API calls are counted by counter
+// when the rate limiter delays them or they are retried
+func WithThrottleCounter(ctx context.Context, counter *ThrottleCounter) context.Context {
+	return context.WithValue(ctx, throttleCounterKey{}, counter)
+}
+
+// ClientOption configures a client created by NewClient or NewClientWithRunner
+type ClientOption func(*githubClient)
+
+// WithRateLimit caps the client's overall request rate.
+// A configuration with RequestsPerSecond <= 0 leaves the client unlimited.
+func WithRateLimit(cfg RateLimitConfig) ClientOption {
+	return func(g *githubClient) {
+		g.limiter = newRequestLimiter(cfg)
+	}
+}
+
+// requestLimiter is a token bucket with throttle accounting
+type requestLimiter struct {
+	limiter   *rate.Limiter
+	throttled atomic.Int64
+	waitedNs  atomic.Int64
+}
+
+// newRequestLimiter returns nil when the configuration is disabled
+func newRequestLimiter(cfg RateLimitConfig) *requestLimiter {
+	if !cfg.Enabled() {
+		return nil
+	}
+	burst := cfg.Burst
+	if burst < 1 {
+		burst = 1
+	}
+	return &requestLimiter{
+		limiter: rate.NewLimiter(rate.Limit(cfg.RequestsPerSecond), burst),
+	}
+}
+
+// wait blocks until a token is available or ctx is done
+func (l *requestLimiter) wait(ctx context.Context) error {
+	if l.limiter.Allow() {
+		return nil
+	}
+
+	l.throttled.Add(1)
+	if counter, ok := ctx.Value(throttleCounterKey{}).(*ThrottleCounter); ok && counter != nil {
+		counter.throttled.Add(1)
+	}
+	start := time.Now()
+	err := l.limiter.Wait(ctx)
+	l.waitedNs.Add(int64(time.Since(start)))
+	if err != nil {
+		return fmt.Errorf("rate limiter: %w", err)
+	}
+	return nil
+}
+
+// stats returns a snapshot of the throttle counters
+func (l *requestLimiter) stats() RateLimitStats {
+	return RateLimitStats{
+		Throttled: l.throttled.Load(),
+		Waited:    time.Duration(l.waitedNs.Load()),
+	}
+}
+
+// rateLimitedRunner waits on the shared limiter before running each command
+type rateLimitedRunner struct {
+	runner  CommandRunner
+	limiter *requestLimiter
+}
+
+// Run waits for a token, then executes the command
+func (r *rateLimitedRunner) Run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	if err := r.limiter.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.runner.Run(ctx, name, args...)
+}
+
+// RunWithInput waits for a token, then executes the command with stdin input
+func (r *rateLimitedRunner) RunWithInput(ctx context.Context, input []byte, name string, args ...string) ([]byte, error) {
+	if err := r.limiter.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.runner.RunWithInput(ctx, input, name, args...)
+}
+
+// RateLimitStats returns the client's throttle counters.
+// Clients without a rate limiter report zero values.
+func (g *githubClient) RateLimitStats() RateLimitStats {
+	if g.limiter == nil {
+		return RateLimitStats{}
+	}
+	return g.limiter.stats()
+}
+
+// applyOptions applies client options and installs the proxy, token and rate-limited runners
+func (g *githubClient) applyOptions(opts []ClientOption) {
+	for _, opt := range opts {
+		opt(g)
+	}
+	if g.headers.Enabled() {
+		g.runner = &headerRunner{runner: g.runner, headers: g.headers}
+	}
+	if g.proxy.Enabled() {
+		g.runner = &proxyRunner{runner: g.runner, proxy: g.proxy}
+	}
+	if g.auth.Enabled() {
+		g.runner = &tokenRunner{runner: g.runner, source: &tokenSource{cfg: g.auth}}
+	}
+	if g.limiter != nil {
+		g.runner = &rateLimitedRunner{runner: g.runner, limiter: g.limiter}
+	}
+}
@@ -0,0 +1,118 @@
+package gh
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitConfig_Enabled(t *testing.T) {
+	assert.False(t, RateLimitConfig{}.Enabled())
+	assert.False(t, RateLimitConfig{RequestsPerSecond: -1, Burst: 5}.Enabled())
+	assert.True(t, RateLimitConfig{RequestsPerSecond: 0.5}.Enabled())
+}
+
+func TestWithRateLimit_Disabled(t *testing.T) {
+	mockRunner := new(MockCommandRunner)
+	client := NewClientWithRunner(mockRunner, logrus.New(), WithRateLimit(RateLimitConfig{}))
+
+	gc, ok := client.(*githubClient)
+	require.True(t, ok)
+	assert.Nil(t, gc.limiter)
+	assert.Same(t, mockRunner, gc.runner)
+	assert.Equal(t, RateLimitStats{}, gc.RateLimitStats())
+}
+
+func TestWithRateLimit_ThrottlesSharedClient(t *testing.T) {
+	ctx := context.Background()
+	mockRunner := new(MockCommandRunner)
+	output, err := json.Marshal([]Branch{{Name: "master"}})
+	require.NoError(t, err)
+	mockRunner.On("Run", mock.Anything, "gh", mock.Anything).Return(output, nil)
+
+	// 20 rps with a burst of 2: the first two calls pass, the rest wait ~50ms each
+	client := NewClientWithRunner(mockRunner, logrus.New(), WithRateLimit(RateLimitConfig{RequestsPerSecond: 20, Burst: 2}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, listErr := client.ListBranches(ctx, "org/repo")
+			assert.NoError(t, listErr)
+		}()
+	}
+	wg.Wait()
+
+	reporter, ok := client.(RateLimitReporter)
+	require.True(t, ok)
+	stats := reporter.RateLimitStats()
+	assert.Equal(t, int64(3), stats.Throttled)
+	assert.Positive(t, stats.Waited)
+	mockRunner.AssertNumberOfCalls(t, "Run", 5)
+}
+
+func TestWithRateLimit_ThrottleCounterPerContext(t *testing.T) {
+	mockRunner := new(MockCommandRunner)
+	output, err := json.Marshal([]Branch{{Name: "master"}})
+	require.NoError(t, err)
+	mockRunner.On("Run", mock.Anything, "gh", mock.Anything).Return(output, nil)
+
+	// 50 rps with a burst of 1: only the first call passes without waiting
+	client := NewClientWithRunner(mockRunner, logrus.New(), WithRateLimit(RateLimitConfig{RequestsPerSecond: 50, Burst: 1}))
+
+	first, second := &ThrottleCounter{}, &ThrottleCounter{}
+	firstCtx := WithThrottleCounter(context.Background(), first)
+	secondCtx := WithThrottleCounter(context.Background(), second)
+
+	for _, ctx := range []context.Context{firstCtx, firstCtx, secondCtx, context.Background()} {
+		_, listErr := client.ListBranches(ctx, "org/repo")
+		require.NoError(t, listErr)
+	}
+
+	assert.Equal(t, int64(1), first.Throttled())
+	assert.Equal(t, int64(1), second.Throttled())
+	assert.Equal(t, int64(3), client.(RateLimitReporter).RateLimitStats().Throttled)
+}
+
+func TestWithRateLimit_RespectsContext(t *testing.T) {
+	mockRunner := new(MockCommandRunner)
+	output, err := json.Marshal([]Branch{})
+	require.NoError(t, err)
+	mockRunner.On("Run", mock.Anything, "gh", mock.Anything).Return(output, nil).Once()
+
+	// One request per minute: the second call cannot get a token before the deadline
+	client := NewClientWithRunner(mockRunner, logrus.New(), WithRateLimit(RateLimitConfig{RequestsPerSecond: 1.0 / 60}))
+
+	_, err = client.ListBranches(context.Background(), "org/repo")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.ListBranches(ctx, "org/repo")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rate limiter")
+
+	mockRunner.AssertExpectations(t)
+}
+
+func TestRateLimitedRunner_RunWithInput(t *testing.T) {
+	ctx := context.Background()
+	mockRunner := new(MockCommandRunner)
+	mockRunner.On("RunWithInput", ctx, []byte("{}"), "gh", []string{"api"}).Return([]byte("ok"), nil).Once()
+
+	runner := &rateLimitedRunner{runner: mockRunner, limiter: newRequestLimiter(RateLimitConfig{RequestsPerSecond: 10})}
+	out, err := runner.RunWithInput(ctx, []byte("{}"), "gh", "api")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("ok"), out)
+	assert.Equal(t, int64(0), runner.limiter.stats().Throttled)
+
+	mockRunner.AssertExpectations(t)
+}
@@ -72,6 +72,30 @@ func (m *MockClient) DeleteBranch(ctx context.Context, repo, branch string) erro
 	return args.Error(0)
 }
 
+// CreateLockRef mock implementation
+func (m *MockClient) CreateLockRef(ctx context.Context, repo, ref, message string) error {
+	args := m.Called(ctx, repo, ref, message)
+	return args.Error(0)
+}
+
+// GetLockRef mock implementation
+func (m *MockClient) GetLockRef(ctx context.Context, repo, ref string) (*LockRef, error) {
+	args := m.Called(ctx, repo, ref)
+	return testutil.HandleTwoValueReturn[*LockRef](args)
+}
+
+// DeleteRef mock implementation
+func (m *MockClient) DeleteRef(ctx context.Context, repo, ref string) error {
+	args := m.Called(ctx, repo, ref)
+	return args.Error(0)
+}
+
+// GetDirectory mock implementation
+func (m *MockClient) GetDirectory(ctx context.Context, repo, path, ref string) ([]FileContent, error) {
+	args := m.Called(ctx, repo, path, ref)
+	return testutil.HandleTwoValueReturn[[]FileContent](args)
+}
+
 // UpdatePR mock implementation
 func (m *MockClient) UpdatePR(ctx context.Context, repo string, number int, updates PRUpdate) error {
 	args := m.Called(ctx, repo, number, updates)
@@ -156,6 +180,18 @@ func (m *MockClient) GetPRCheckStatus(ctx context.Context, repo string, number i
 	return testutil.HandleTwoValueReturn[*CheckStatusSummary](args)
 }
 
+// GetCommitCheckStatus mock implementation
+func (m *MockClient) GetCommitCheckStatus(ctx context.Context, repo, ref string) (*CheckStatusSummary, error) {
+	args := m.Called(ctx, repo, ref)
+	return testutil.HandleTwoValueReturn[*CheckStatusSummary](args)
+}
+
+// GetCombinedStatus mock implementation
+func (m *MockClient) GetCombinedStatus(ctx context.Context, repo, ref string) (*CombinedStatus, error) {
+	args := m.Called(ctx, repo, ref)
+	return testutil.HandleTwoValueReturn[*CombinedStatus](args)
+}
+
 // DiscoverOrgRepos mock implementation
 func (m *MockClient) DiscoverOrgRepos(ctx context.Context, org string) ([]RepoInfo, error) {
 	args := m.Called(ctx, org)
@@ -279,6 +315,30 @@ func (m *MockClient) SetTopics(ctx context.Context, repo string, topics []string
 	return args.Error(0)
 }
 
+// ListIssueComments mock implementation
+func (m *MockClient) ListIssueComments(ctx context.Context, repo string, number int) ([]IssueComment, error) {
+	args := m.Called(ctx, repo, number)
+	return testutil.HandleTwoValueReturn[[]IssueComment](args)
+}
+
+// CreateIssueComment mock implementation
+func (m *MockClient) CreateIssueComment(ctx context.Context, repo string, number int, body string) (*IssueComment, error) {
+	args := m.Called(ctx, repo, number, body)
+	return testutil.HandleTwoValueReturn[*IssueComment](args)
+}
+
+// UpdateIssueComment mock implementation
+func (m *MockClient) UpdateIssueComment(ctx context.Context, repo string, commentID int64, body string) error {
+	args := m.Called(ctx, repo, commentID, body)
+	return args.Error(0)
+}
+
+// GetRepoTopics mock implementation
+func (m *MockClient) GetRepoTopics(ctx context.Context, repo string) ([]string, error) {
+	args := m.Called(ctx, repo)
+	return testutil.HandleTwoValueReturn[[]string](args)
+}
+
 // CloneRepository mock implementation
 func (m *MockClient) CloneRepository(ctx context.Context, repo, destPath string) error {
 	args := m.Called(ctx, repo, destPath)
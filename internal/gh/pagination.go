@@ -0,0 +1,40 @@
+package gh
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+
+	appErrors "github.com/mrz1836/go-broadcast/internal/errors"
+)
+
+// listPageSize is the page size requested from list endpoints, the most
+// GitHub allows, so a paginated listing takes as few requests as possible
+const listPageSize = 100
+
+// unmarshalPages parses the output of a paginated `gh api --paginate` listing.
+// gh follows the Link header of every page; depending on its version the pages
+// are merged into one JSON array or written one after another, so every array
+// in the output is read and the items are returned together.
+func unmarshalPages[T any](output []byte) ([]T, error) {
+	decoder := json.NewDecoder(bytes.NewReader(output))
+	items := []T{}
+	pages := 0
+	for {
+		var page []T
+		err := decoder.Decode(&page)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, appErrors.WrapWithContext(err, "unmarshal JSON")
+		}
+		items = append(items, page...)
+		pages++
+	}
+	if pages == 0 {
+		return nil, appErrors.WrapWithContext(io.ErrUnexpectedEOF, "unmarshal JSON")
+	}
+	return items, nil
+}
@@ -0,0 +1,71 @@
+package gh
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalPages(t *testing.T) {
+	t.Run("merged array", func(t *testing.T) {
+		branches, err := unmarshalPages[Branch]([]byte(`[{"name":"a"},{"name":"b"}]`))
+		require.NoError(t, err)
+		assert.Len(t, branches, 2)
+	})
+
+	t.Run("one array per page", func(t *testing.T) {
+		branches, err := unmarshalPages[Branch]([]byte("[{\"name\":\"a\"}]\n[{\"name\":\"b\"}][]\n[{\"name\":\"c\"}]"))
+		require.NoError(t, err)
+		require.Len(t, branches, 3)
+		assert.Equal(t, "c", branches[2].Name)
+	})
+
+	t.Run("empty listing", func(t *testing.T) {
+		branches, err := unmarshalPages[Branch]([]byte("[]"))
+		require.NoError(t, err)
+		assert.Empty(t, branches)
+	})
+
+	t.Run("invalid output", func(t *testing.T) {
+		_, err := unmarshalPages[Branch]([]byte(`[{"name":"a"}] oops`))
+		require.Error(t, err)
+
+		_, err = unmarshalPages[Branch](nil)
+		require.Error(t, err)
+	})
+}
+
+func TestListBranches_Paginated(t *testing.T) {
+	ctx := context.Background()
+	mockRunner := new(MockCommandRunner)
+	client := NewClientWithRunner(mockRunner, logrus.New())
+
+	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/branches?per_page=100", "--paginate"}).
+		Return([]byte(`[{"name":"master"},{"name":"chore/sync-files-1"}][{"name":"chore/sync-files-2"}]`), nil)
+
+	branches, err := client.ListBranches(ctx, "org/repo")
+	require.NoError(t, err)
+	require.Len(t, branches, 3)
+	assert.Equal(t, "chore/sync-files-2", branches[2].Name)
+
+	mockRunner.AssertExpectations(t)
+}
+
+func TestListPRs_Paginated(t *testing.T) {
+	ctx := context.Background()
+	mockRunner := new(MockCommandRunner)
+	client := NewClientWithRunner(mockRunner, logrus.New())
+
+	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/pulls?per_page=100&state=open", "--paginate"}).
+		Return([]byte("[{\"number\":1}]\n[{\"number\":101}]\n"), nil)
+
+	prs, err := client.ListPRs(ctx, "org/repo", "open")
+	require.NoError(t, err)
+	require.Len(t, prs, 2)
+	assert.Equal(t, 101, prs[1].Number)
+
+	mockRunner.AssertExpectations(t)
+}
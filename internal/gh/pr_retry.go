@@ -0,0 +1,118 @@
+package gh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// prUpdateRetryDelay is the base wait before re-fetching a pull request after
+// an update conflict. It doubles with every retry and gets up to the same
+// amount again as random jitter, so concurrent writers do not collide on the
+// next attempt as well. Exposed as a var so tests can shorten it.
+var prUpdateRetryDelay = 250 * time.Millisecond //nolint:gochecknoglobals // tunable retry delay, override in tests
+
+// UpdatePRWithRetry applies updates to a pull request, retrying when GitHub
+// reports a conflicting concurrent update.
+//
+// On each conflict the PR is re-fetched with GetPR and the update is recomputed
+// against its current state: fields the PR already has are dropped, and when
+// nothing is left to change the concurrent writer already produced the desired
+// state and the update succeeds without another write. Retries wait a short,
+// growing and jittered backoff first, returning early when ctx is done. At
+// most maxRetries retries are made after the first attempt; a negative value
+// is treated as zero. Errors other than ErrPRUpdateConflict are returned
+// immediately.
+func UpdatePRWithRetry(ctx context.Context, client Client, repo string, number, maxRetries int, updates PRUpdate, logger *logrus.Entry) error {
+	if maxRetries < 0 {
+		maxRetries = 0
+	}
+	if logger == nil {
+		logger = logrus.NewEntry(logrus.StandardLogger())
+	}
+	log := logger.WithFields(logrus.Fields{
+		"repo":      repo,
+		"pr_number": number,
+	})
+
+	pending := updates
+	var err error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		err = client.UpdatePR(ctx, repo, number, pending)
+		if err == nil {
+			if attempt > 0 {
+				log.WithField("conflicts", attempt).Info("Resolved pull request update conflict")
+			}
+			return nil
+		}
+		if !errors.Is(err, ErrPRUpdateConflict) || attempt == maxRetries {
+			break
+		}
+
+		log.WithError(err).WithFields(logrus.Fields{
+			"attempt":     attempt + 1,
+			"max_retries": maxRetries,
+		}).Warn("Pull request update conflict, re-fetching before retry")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(prUpdateRetryBackoff(attempt)):
+		}
+		countRetry(ctx)
+
+		pr, getErr := client.GetPR(ctx, repo, number)
+		if getErr != nil {
+			return fmt.Errorf("re-fetch PR #%d after update conflict: %w", number, getErr)
+		}
+		log.WithFields(logrus.Fields{
+			"state":      pr.State,
+			"updated_at": pr.UpdatedAt,
+		}).Debug("Re-fetched pull request after conflict")
+
+		pending = pendingPRUpdate(pr, updates)
+		if pending.isEmpty() {
+			log.WithField("conflicts", attempt+1).Info("Resolved pull request update conflict: changes already present")
+			return nil
+		}
+	}
+
+	if errors.Is(err, ErrPRUpdateConflict) && maxRetries > 0 {
+		return fmt.Errorf("%w: gave up after %d retries", err, maxRetries)
+	}
+	return err
+}
+
+// prUpdateRetryBackoff returns the wait before retry attempt+1: the base delay
+// doubled per earlier retry, plus random jitter of up to the same amount
+func prUpdateRetryBackoff(attempt int) time.Duration {
+	delay := prUpdateRetryDelay << attempt
+	if delay <= 0 {
+		return 0
+	}
+	return delay + rand.N(delay) //nolint:gosec // jitter, not security sensitive
+}
+
+// pendingPRUpdate returns the part of desired that pr does not already reflect
+func pendingPRUpdate(pr *PR, desired PRUpdate) PRUpdate {
+	var pending PRUpdate
+	if desired.State != nil && *desired.State != pr.State {
+		pending.State = desired.State
+	}
+	if desired.Body != nil && *desired.Body != pr.Body {
+		pending.Body = desired.Body
+	}
+	if desired.Draft != nil && *desired.Draft != pr.Draft {
+		pending.Draft = desired.Draft
+	}
+	return pending
+}
+
+// isEmpty reports whether the update changes nothing
+func (u PRUpdate) isEmpty() bool {
+	return u.State == nil && u.Body == nil && u.Draft == nil
+}
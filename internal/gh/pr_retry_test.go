@@ -0,0 +1,157 @@
+package gh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var errTestHTTP409 = errors.New("HTTP 409: Conflict")
+
+func TestGitHubClient_UpdatePR_Conflict(t *testing.T) {
+	mockRunner := &MockCommandRunner{}
+	mockRunner.On("RunWithInput", mock.Anything, mock.Anything, "gh", []string{"api", "repos/owner/repo/pulls/7", "--method", "PATCH", "--input", "-"}).
+		Return([]byte(""), errTestHTTP409)
+
+	client := NewClientWithRunner(mockRunner, nil)
+	body := "new body"
+
+	err := client.UpdatePR(context.Background(), "owner/repo", 7, PRUpdate{Body: &body})
+	require.ErrorIs(t, err, ErrPRUpdateConflict)
+	assert.Contains(t, err.Error(), "409")
+}
+
+func TestUpdatePRWithRetry(t *testing.T) { //nolint:paralleltest // shortens the package-level retry delay
+	original := prUpdateRetryDelay
+	prUpdateRetryDelay = time.Millisecond
+	t.Cleanup(func() { prUpdateRetryDelay = original })
+
+	ctx := context.Background()
+	body := "desired body"
+	updates := PRUpdate{Body: &body}
+	conflict := fmt.Errorf("%w: %w", ErrPRUpdateConflict, errTestHTTP409)
+
+	t.Run("succeeds without conflict", func(t *testing.T) {
+		client := &MockClient{}
+		client.On("UpdatePR", mock.Anything, "org/repo", 1, updates).Return(nil).Once()
+
+		require.NoError(t, UpdatePRWithRetry(ctx, client, "org/repo", 1, 3, updates, nil))
+		client.AssertNotCalled(t, "GetPR", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("re-fetches and re-applies after conflict", func(t *testing.T) {
+		client := &MockClient{}
+		client.On("UpdatePR", mock.Anything, "org/repo", 1, updates).Return(conflict).Twice()
+		client.On("UpdatePR", mock.Anything, "org/repo", 1, updates).Return(nil).Once()
+		client.On("GetPR", mock.Anything, "org/repo", 1).Return(&PR{Number: 1, State: "open"}, nil).Twice()
+
+		require.NoError(t, UpdatePRWithRetry(ctx, client, "org/repo", 1, 3, updates, nil))
+		client.AssertExpectations(t)
+	})
+
+	t.Run("retry only sends fields the PR does not already have", func(t *testing.T) {
+		closed := "closed"
+		both := PRUpdate{State: &closed, Body: &body}
+		client := &MockClient{}
+		client.On("UpdatePR", mock.Anything, "org/repo", 1, both).Return(conflict).Once()
+		client.On("GetPR", mock.Anything, "org/repo", 1).Return(&PR{Number: 1, State: "closed", Body: "old body"}, nil).Once()
+		client.On("UpdatePR", mock.Anything, "org/repo", 1, PRUpdate{Body: &body}).Return(nil).Once()
+
+		require.NoError(t, UpdatePRWithRetry(ctx, client, "org/repo", 1, 3, both, nil))
+		client.AssertExpectations(t)
+	})
+
+	t.Run("draft status already applied is not resent", func(t *testing.T) {
+		draft := true
+		withDraft := PRUpdate{Body: &body, Draft: &draft}
+		client := &MockClient{}
+		client.On("UpdatePR", mock.Anything, "org/repo", 1, withDraft).Return(conflict).Once()
+		client.On("GetPR", mock.Anything, "org/repo", 1).Return(&PR{Number: 1, State: "open", Body: "old body", Draft: true}, nil).Once()
+		client.On("UpdatePR", mock.Anything, "org/repo", 1, PRUpdate{Body: &body}).Return(nil).Once()
+
+		require.NoError(t, UpdatePRWithRetry(ctx, client, "org/repo", 1, 3, withDraft, nil))
+		client.AssertExpectations(t)
+	})
+
+	t.Run("stops when the re-fetched PR already matches", func(t *testing.T) {
+		client := &MockClient{}
+		client.On("UpdatePR", mock.Anything, "org/repo", 1, updates).Return(conflict).Once()
+		client.On("GetPR", mock.Anything, "org/repo", 1).Return(&PR{Number: 1, State: "open", Body: body}, nil).Once()
+
+		require.NoError(t, UpdatePRWithRetry(ctx, client, "org/repo", 1, 3, updates, nil))
+		client.AssertNumberOfCalls(t, "UpdatePR", 1)
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		client := &MockClient{}
+		client.On("UpdatePR", mock.Anything, "org/repo", 1, updates).Return(conflict)
+		client.On("GetPR", mock.Anything, "org/repo", 1).Return(&PR{Number: 1, State: "open"}, nil)
+
+		counter := &ThrottleCounter{}
+		err := UpdatePRWithRetry(WithThrottleCounter(ctx, counter), client, "org/repo", 1, 2, updates, nil)
+		require.ErrorIs(t, err, ErrPRUpdateConflict)
+		assert.Contains(t, err.Error(), "gave up after 2 retries")
+		client.AssertNumberOfCalls(t, "UpdatePR", 3)
+		client.AssertNumberOfCalls(t, "GetPR", 2)
+		assert.Equal(t, int64(2), counter.Retried())
+	})
+
+	t.Run("zero retries makes a single attempt", func(t *testing.T) {
+		client := &MockClient{}
+		client.On("UpdatePR", mock.Anything, "org/repo", 1, updates).Return(conflict)
+
+		err := UpdatePRWithRetry(ctx, client, "org/repo", 1, 0, updates, nil)
+		require.ErrorIs(t, err, ErrPRUpdateConflict)
+		client.AssertNumberOfCalls(t, "UpdatePR", 1)
+	})
+
+	t.Run("other errors are not retried", func(t *testing.T) {
+		client := &MockClient{}
+		client.On("UpdatePR", mock.Anything, "org/repo", 1, updates).Return(ErrPRNotFound)
+
+		err := UpdatePRWithRetry(ctx, client, "org/repo", 1, 3, updates, nil)
+		require.ErrorIs(t, err, ErrPRNotFound)
+		client.AssertNumberOfCalls(t, "UpdatePR", 1)
+	})
+
+	t.Run("cancellation during backoff stops retrying", func(t *testing.T) {
+		prUpdateRetryDelay = time.Hour
+		defer func() { prUpdateRetryDelay = time.Millisecond }()
+
+		client := &MockClient{}
+		client.On("UpdatePR", mock.Anything, "org/repo", 1, updates).Return(conflict)
+
+		cancelCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		err := UpdatePRWithRetry(cancelCtx, client, "org/repo", 1, 3, updates, nil)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		client.AssertNumberOfCalls(t, "UpdatePR", 1)
+		client.AssertNotCalled(t, "GetPR", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("re-fetch failure stops retrying", func(t *testing.T) {
+		client := &MockClient{}
+		client.On("UpdatePR", mock.Anything, "org/repo", 1, updates).Return(conflict)
+		client.On("GetPR", mock.Anything, "org/repo", 1).Return(nil, ErrPRNotFound)
+
+		err := UpdatePRWithRetry(ctx, client, "org/repo", 1, 3, updates, nil)
+		require.ErrorIs(t, err, ErrPRNotFound)
+		client.AssertNumberOfCalls(t, "UpdatePR", 1)
+	})
+}
+
+func TestPRUpdateRetryBackoff(t *testing.T) { //nolint:paralleltest // reads the package-level retry delay
+	base := prUpdateRetryDelay
+	for attempt := 0; attempt < 3; attempt++ {
+		delay := prUpdateRetryBackoff(attempt)
+		floor := base << attempt
+		assert.GreaterOrEqual(t, delay, floor, "attempt %d", attempt)
+		assert.Less(t, delay, 2*floor, "attempt %d", attempt)
+	}
+}
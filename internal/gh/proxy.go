@@ -0,0 +1,146 @@
+package gh
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+)
+
+// Proxy configuration errors
+var (
+	ErrInvalidProxyURL    = errors.New("invalid proxy URL")
+	ErrProxyPasswordUnset = errors.New("proxy password environment variable is not set")
+	ErrInvalidCAFile      = errors.New("invalid CA bundle")
+)
+
+// ProxyConfig routes the gh CLI through an HTTP(S) proxy. The gh CLI builds
+// its http.Transport from HTTPS_PROXY, HTTP_PROXY and SSL_CERT_FILE, so the
+// settings are passed to every gh command through those variables and take
+// precedence over values already in the environment.
+type ProxyConfig struct {
+	// URL is the proxy address, e.g. "http://proxy.corp.example:8080"
+	URL string
+
+	// Username authenticates with the proxy; it overrides credentials in URL
+	Username string
+
+	// PasswordEnv names the environment variable holding the proxy password,
+	// which keeps the secret out of the config file
+	PasswordEnv string
+
+	// CAFile is a PEM bundle trusted for TLS, for proxies that intercept TLS.
+	// It replaces the system bundle, so it must also hold any public roots
+	// still needed.
+	CAFile string
+}
+
+// Enabled reports whether a proxy or CA bundle is configured
+func (p ProxyConfig) Enabled() bool {
+	return p.URL != "" || p.CAFile != ""
+}
+
+// Validate checks that the proxy URL parses, its password is available and
+// the CA bundle holds at least one certificate
+func (p ProxyConfig) Validate() error {
+	if p.URL != "" {
+		if _, err := p.proxyURL(); err != nil {
+			return err
+		}
+	} else if p.Username != "" || p.PasswordEnv != "" {
+		return fmt.Errorf("%w: credentials are set without a proxy URL", ErrInvalidProxyURL)
+	}
+
+	if p.CAFile != "" {
+		data, err := os.ReadFile(p.CAFile) //nolint:gosec // path is chosen by the user
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidCAFile, err)
+		}
+		if !x509.NewCertPool().AppendCertsFromPEM(data) {
+			return fmt.Errorf("%w: %s contains no PEM certificates", ErrInvalidCAFile, p.CAFile)
+		}
+	}
+	return nil
+}
+
+// proxyURL parses URL and applies Username and the password from PasswordEnv
+func (p ProxyConfig) proxyURL() (*url.URL, error) {
+	u, err := url.Parse(p.URL)
+	if err != nil {
+		// url.Error echoes the input, which may hold credentials
+		return nil, fmt.Errorf("%w: cannot parse URL", ErrInvalidProxyURL)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("%w: scheme must be http, https or socks5, got %q", ErrInvalidProxyURL, u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return nil, fmt.Errorf("%w: missing host", ErrInvalidProxyURL)
+	}
+
+	if p.PasswordEnv != "" {
+		password := os.Getenv(p.PasswordEnv)
+		if password == "" {
+			return nil, fmt.Errorf("%w: %s", ErrProxyPasswordUnset, p.PasswordEnv)
+		}
+		username := p.Username
+		if username == "" && u.User != nil {
+			username = u.User.Username()
+		}
+		u.User = url.UserPassword(username, password)
+	} else if p.Username != "" {
+		u.User = url.User(p.Username)
+	}
+	return u, nil
+}
+
+// env returns the environment variables that point the gh CLI at the proxy
+func (p ProxyConfig) env() ([]string, error) {
+	var env []string
+	if p.URL != "" {
+		u, err := p.proxyURL()
+		if err != nil {
+			return nil, err
+		}
+		env = append(env, "HTTPS_PROXY="+u.String(), "HTTP_PROXY="+u.String())
+	}
+	if p.CAFile != "" {
+		env = append(env, "SSL_CERT_FILE="+p.CAFile)
+	}
+	return env, nil
+}
+
+// WithProxy sends every gh command through the proxy in cfg. NewClient
+// validates the configuration and fails before the first request.
+func WithProxy(cfg ProxyConfig) ClientOption {
+	return func(g *githubClient) {
+		g.proxy = cfg
+	}
+}
+
+// proxyRunner passes the proxy settings to every gh command
+type proxyRunner struct {
+	runner CommandRunner
+	proxy  ProxyConfig
+}
+
+// Run executes the command with the proxy environment
+func (r *proxyRunner) Run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	env, err := r.proxy.env()
+	if err != nil {
+		return nil, err
+	}
+	return r.runner.Run(withCommandEnv(ctx, env...), name, args...)
+}
+
+// RunWithInput executes the command with stdin input and the proxy environment
+func (r *proxyRunner) RunWithInput(ctx context.Context, input []byte, name string, args ...string) ([]byte, error) {
+	env, err := r.proxy.env()
+	if err != nil {
+		return nil, err
+	}
+	return r.runner.RunWithInput(withCommandEnv(ctx, env...), input, name, args...)
+}
@@ -50,6 +50,7 @@ func rateLimitedDo(ctx context.Context, delay time.Duration, fn func() error) er
 			return ctx.Err()
 		case <-time.After(retryDelay):
 		}
+		countRetry(ctx)
 		retryDelay *= 2
 	}
 
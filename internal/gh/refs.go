@@ -0,0 +1,134 @@
+package gh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	appErrors "github.com/mrz1836/go-broadcast/internal/errors"
+	"github.com/mrz1836/go-broadcast/internal/jsonutil"
+)
+
+// emptyTreeSHA is the object ID git gives the empty tree in every repository
+const emptyTreeSHA = "4b825dc642cb6eb9a060e54bf8d69288fbee4904"
+
+var (
+	// ErrRefExists indicates a ref cannot be created because it already exists
+	ErrRefExists = errors.New("ref already exists")
+	// ErrRefNotFound indicates a ref does not exist
+	ErrRefNotFound = errors.New("ref not found")
+)
+
+// LockRef is the commit a lock ref points at
+type LockRef struct {
+	SHA       string    // Commit the ref points at
+	Message   string    // Message the lock was created with
+	CreatedAt time.Time // When the lock commit was created
+}
+
+// RefLocker is implemented by clients that can hold advisory locks as git
+// refs. Creating a ref fails when it exists, so only one caller holds a lock.
+type RefLocker interface {
+	// CreateLockRef creates ref, a full name such as refs/locks/sync, pointing
+	// at a new commit with message. It returns ErrRefExists when ref exists.
+	CreateLockRef(ctx context.Context, repo, ref, message string) error
+
+	// GetLockRef returns the commit ref points at, or ErrRefNotFound
+	GetLockRef(ctx context.Context, repo, ref string) (*LockRef, error)
+
+	// DeleteRef deletes ref, returning ErrRefNotFound when it does not exist
+	DeleteRef(ctx context.Context, repo, ref string) error
+}
+
+// gitObject is the part of a git commit or ref API response naming an object
+type gitObject struct {
+	SHA       string `json:"sha"`
+	Message   string `json:"message"`
+	Committer struct {
+		Date time.Time `json:"date"`
+	} `json:"committer"`
+	Object struct {
+		SHA string `json:"sha"`
+	} `json:"object"`
+}
+
+// CreateLockRef creates ref pointing at a parentless commit of the empty tree,
+// so the lock carries its message and creation time without touching any branch
+func (g *githubClient) CreateLockRef(ctx context.Context, repo, ref, message string) error {
+	commitData, err := jsonutil.MarshalJSON(map[string]interface{}{
+		"message": message,
+		"tree":    emptyTreeSHA,
+		"parents": []string{},
+	})
+	if err != nil {
+		return appErrors.WrapWithContext(err, "marshal lock commit")
+	}
+	output, err := g.runner.RunWithInput(ctx, commitData, "gh", "api", fmt.Sprintf("repos/%s/git/commits", repo), "--method", "POST", "--input", "-")
+	if err != nil {
+		return appErrors.WrapWithContext(err, "create lock commit")
+	}
+	commit, err := jsonutil.UnmarshalJSON[gitObject](output)
+	if err != nil {
+		return appErrors.WrapWithContext(err, "parse lock commit")
+	}
+
+	refData, err := jsonutil.MarshalJSON(map[string]string{"ref": ref, "sha": commit.SHA})
+	if err != nil {
+		return appErrors.WrapWithContext(err, "marshal lock ref")
+	}
+	if _, err := g.runner.RunWithInput(ctx, refData, "gh", "api", fmt.Sprintf("repos/%s/git/refs", repo), "--method", "POST", "--input", "-"); err != nil {
+		if isValidationFailedError(err) {
+			return fmt.Errorf("%w: %s", ErrRefExists, ref)
+		}
+		return appErrors.WrapWithContext(err, "create lock ref")
+	}
+	return nil
+}
+
+// GetLockRef returns the commit ref points at
+func (g *githubClient) GetLockRef(ctx context.Context, repo, ref string) (*LockRef, error) {
+	output, err := g.runner.Run(ctx, "gh", "api", fmt.Sprintf("repos/%s/git/ref/%s", repo, strings.TrimPrefix(ref, "refs/")))
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, fmt.Errorf("%w: %s", ErrRefNotFound, ref)
+		}
+		return nil, appErrors.WrapWithContext(err, "get lock ref")
+	}
+	refObject, err := jsonutil.UnmarshalJSON[gitObject](output)
+	if err != nil {
+		return nil, appErrors.WrapWithContext(err, "parse lock ref")
+	}
+
+	output, err = g.runner.Run(ctx, "gh", "api", fmt.Sprintf("repos/%s/git/commits/%s", repo, refObject.Object.SHA))
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, fmt.Errorf("%w: %s", ErrRefNotFound, ref)
+		}
+		return nil, appErrors.WrapWithContext(err, "get lock commit")
+	}
+	commit, err := jsonutil.UnmarshalJSON[gitObject](output)
+	if err != nil {
+		return nil, appErrors.WrapWithContext(err, "parse lock commit")
+	}
+
+	return &LockRef{
+		SHA:       commit.SHA,
+		Message:   commit.Message,
+		CreatedAt: commit.Committer.Date,
+	}, nil
+}
+
+// DeleteRef deletes ref from the repository
+func (g *githubClient) DeleteRef(ctx context.Context, repo, ref string) error {
+	_, err := g.runner.Run(ctx, "gh", "api", fmt.Sprintf("repos/%s/git/refs/%s", repo, strings.TrimPrefix(ref, "refs/")), "--method", "DELETE")
+	if err != nil {
+		// GitHub answers 422 "Reference does not exist" for a missing ref
+		if isNotFoundError(err) || isValidationFailedError(err) {
+			return fmt.Errorf("%w: %s", ErrRefNotFound, ref)
+		}
+		return appErrors.WrapWithContext(err, "delete ref")
+	}
+	return nil
+}
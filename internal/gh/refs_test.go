@@ -0,0 +1,87 @@
+package gh
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRefLocker returns a client running commands through mockRunner as a RefLocker
+func newTestRefLocker(t *testing.T, mockRunner *MockCommandRunner) RefLocker {
+	t.Helper()
+	locker, ok := NewClientWithRunner(mockRunner, logrus.New()).(RefLocker)
+	require.True(t, ok)
+	return locker
+}
+
+func TestCreateLockRef(t *testing.T) {
+	ctx := context.Background()
+	commitArgs := []string{"api", "repos/org/repo/git/commits", "--method", "POST", "--input", "-"}
+	refArgs := []string{"api", "repos/org/repo/git/refs", "--method", "POST", "--input", "-"}
+
+	t.Run("creates a commit and points the ref at it", func(t *testing.T) {
+		mockRunner := new(MockCommandRunner)
+		mockRunner.On("RunWithInput", ctx, []byte(`{"message":"lock","parents":[],"tree":"`+emptyTreeSHA+`"}`), "gh", commitArgs).Return([]byte(`{"sha":"abc123"}`), nil)
+		mockRunner.On("RunWithInput", ctx, []byte(`{"ref":"refs/locks/sync","sha":"abc123"}`), "gh", refArgs).Return([]byte(`{}`), nil)
+
+		require.NoError(t, newTestRefLocker(t, mockRunner).CreateLockRef(ctx, "org/repo", "refs/locks/sync", "lock"))
+		mockRunner.AssertExpectations(t)
+	})
+
+	t.Run("existing ref", func(t *testing.T) {
+		mockRunner := new(MockCommandRunner)
+		mockRunner.On("RunWithInput", ctx, mock.Anything, "gh", commitArgs).Return([]byte(`{"sha":"abc123"}`), nil)
+		mockRunner.On("RunWithInput", ctx, mock.Anything, "gh", refArgs).
+			Return(nil, &CommandError{Stderr: "Reference already exists (HTTP 422)"})
+
+		err := newTestRefLocker(t, mockRunner).CreateLockRef(ctx, "org/repo", "refs/locks/sync", "lock")
+		require.ErrorIs(t, err, ErrRefExists)
+	})
+}
+
+func TestGetLockRef(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reads the commit the ref points at", func(t *testing.T) {
+		mockRunner := new(MockCommandRunner)
+		mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/git/ref/locks/sync"}).
+			Return([]byte(`{"ref":"refs/locks/sync","object":{"sha":"abc123"}}`), nil)
+		mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/git/commits/abc123"}).
+			Return([]byte(`{"sha":"abc123","message":"lock","committer":{"date":"2025-01-02T15:04:05Z"}}`), nil)
+
+		ref, err := newTestRefLocker(t, mockRunner).GetLockRef(ctx, "org/repo", "refs/locks/sync")
+		require.NoError(t, err)
+		assert.Equal(t, &LockRef{
+			SHA:       "abc123",
+			Message:   "lock",
+			CreatedAt: time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC),
+		}, ref)
+	})
+
+	t.Run("missing ref", func(t *testing.T) {
+		mockRunner := new(MockCommandRunner)
+		mockRunner.On("Run", ctx, "gh", []string{"api", "repos/org/repo/git/ref/locks/sync"}).
+			Return(nil, &CommandError{Stderr: "Not Found (HTTP 404)"})
+
+		_, err := newTestRefLocker(t, mockRunner).GetLockRef(ctx, "org/repo", "refs/locks/sync")
+		require.ErrorIs(t, err, ErrRefNotFound)
+	})
+}
+
+func TestDeleteRef(t *testing.T) {
+	ctx := context.Background()
+	args := []string{"api", "repos/org/repo/git/refs/locks/sync", "--method", "DELETE"}
+
+	mockRunner := new(MockCommandRunner)
+	mockRunner.On("Run", ctx, "gh", args).Return([]byte{}, nil).Once()
+	mockRunner.On("Run", ctx, "gh", args).Return(nil, &CommandError{Stderr: "Reference does not exist (HTTP 422)"}).Once()
+
+	locker := newTestRefLocker(t, mockRunner)
+	require.NoError(t, locker.DeleteRef(ctx, "org/repo", "refs/locks/sync"))
+	require.ErrorIs(t, locker.DeleteRef(ctx, "org/repo", "refs/locks/sync"), ErrRefNotFound)
+}
@@ -300,6 +300,28 @@ func (g *githubClient) RenameBranch(ctx context.Context, repo, oldName, newName
 	})
 }
 
+// GetRepoTopics lists the topics of a repository
+func (g *githubClient) GetRepoTopics(ctx context.Context, repo string) ([]string, error) {
+	var output []byte
+	err := rateLimitedDo(ctx, 0, func() error {
+		var runErr error
+		output, runErr = g.runner.Run(ctx, "gh", "api", fmt.Sprintf("repos/%s/topics", repo))
+		return runErr
+	})
+	if err != nil {
+		return nil, appErrors.WrapWithContext(err, "get topics")
+	}
+
+	topics, err := jsonutil.UnmarshalJSON[struct {
+		Names []string `json:"names"`
+	}](output)
+	if err != nil {
+		return nil, appErrors.WrapWithContext(err, "parse topics")
+	}
+
+	return topics.Names, nil
+}
+
 // SetTopics replaces all topics for a repository
 func (g *githubClient) SetTopics(ctx context.Context, repo string, topics []string) error {
 	payload := map[string][]string{"names": topics}
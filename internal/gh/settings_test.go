@@ -799,6 +799,35 @@ func TestSetTopics_RunnerError(t *testing.T) {
 	mockRunner.AssertExpectations(t)
 }
 
+func TestGetRepoTopics_Success(t *testing.T) {
+	ctx := context.Background()
+	mockRunner := new(MockCommandRunner)
+	client := NewClientWithRunner(mockRunner, logrus.New())
+
+	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/owner/repo/topics"}).
+		Return([]byte(`{"names":["go","library"]}`), nil)
+
+	topics, err := client.GetRepoTopics(ctx, "owner/repo")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"go", "library"}, topics)
+	mockRunner.AssertExpectations(t)
+}
+
+func TestGetRepoTopics_RunnerError(t *testing.T) {
+	ctx := context.Background()
+	mockRunner := new(MockCommandRunner)
+	client := NewClientWithRunner(mockRunner, logrus.New())
+
+	mockRunner.On("Run", ctx, "gh", []string{"api", "repos/owner/repo/topics"}).
+		Return(nil, errTestTopicsError)
+
+	topics, err := client.GetRepoTopics(ctx, "owner/repo")
+	require.Error(t, err)
+	assert.Nil(t, topics)
+	assert.Contains(t, err.Error(), "get topics")
+	mockRunner.AssertExpectations(t)
+}
+
 func TestCloneRepository_Success(t *testing.T) {
 	ctx := context.Background()
 	mockRunner := new(MockCommandRunner)
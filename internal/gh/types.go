@@ -59,12 +59,14 @@ type PRRequest struct {
 	Assignees     []string `json:"assignees,omitempty"`      // GitHub usernames to assign
 	Reviewers     []string `json:"reviewers,omitempty"`      // GitHub usernames to request reviews from
 	TeamReviewers []string `json:"team_reviewers,omitempty"` // GitHub team slugs to request reviews from
+	Draft         bool     `json:"draft,omitempty"`          // Create the PR as a draft
 }
 
 // PRUpdate represents updates to an existing pull request
 type PRUpdate struct {
 	State *string `json:"state,omitempty"` // "open" or "closed"
 	Body  *string `json:"body,omitempty"`  // Updated body content
+	Draft *bool   `json:"-"`               // true converts to draft, false marks ready for review
 }
 
 // Commit represents a GitHub commit
@@ -86,6 +88,14 @@ type Commit struct {
 	Parents []struct {
 		SHA string `json:"sha"`
 	} `json:"parents"`
+	Files []CommitFile `json:"files,omitempty"` // Changed files, returned by GetCommit
+}
+
+// CommitFile represents a file changed by a commit
+type CommitFile struct {
+	Filename         string `json:"filename"`
+	Status           string `json:"status"`                      // added, removed, modified, renamed, copied, changed
+	PreviousFilename string `json:"previous_filename,omitempty"` // Set for renamed files
 }
 
 // File represents a file in a GitHub repository
@@ -161,6 +171,15 @@ type Repository struct {
 			Status string `json:"status"`
 		} `json:"secret_scanning_push_protection"`
 	} `json:"security_and_analysis"`
+
+	Fork   bool           `json:"fork"`
+	Parent *RepositoryRef `json:"parent,omitempty"` // Repository a fork was created from
+	Source *RepositoryRef `json:"source,omitempty"` // Root of the fork network
+}
+
+// RepositoryRef identifies a related repository, such as the parent of a fork
+type RepositoryRef struct {
+	FullName string `json:"full_name"`
 }
 
 // MergeMethod represents the type of merge to perform
@@ -199,6 +218,14 @@ type Review struct {
 	SubmittedAt *time.Time `json:"submitted_at"`
 }
 
+// IssueComment represents a comment on an issue or pull request
+type IssueComment struct {
+	ID      int64  `json:"id"`
+	User    User   `json:"user"`
+	Body    string `json:"body"`
+	HTMLURL string `json:"html_url"`
+}
+
 // AutoMerge represents auto-merge configuration for a pull request
 type AutoMerge struct {
 	EnabledBy   User        `json:"enabled_by"`
@@ -220,6 +247,32 @@ type CheckRunsResponse struct {
 	CheckRuns  []CheckRun `json:"check_runs"`
 }
 
+// CommitStatus is one status reported through the commit status API
+type CommitStatus struct {
+	Context     string `json:"context"`
+	State       string `json:"state"` // "error", "failure", "pending", "success"
+	Description string `json:"description"`
+	TargetURL   string `json:"target_url"`
+}
+
+// CombinedStatus is the combined commit status of a commit
+type CombinedStatus struct {
+	State      string         `json:"state"` // "failure", "pending", "success"
+	TotalCount int            `json:"total_count"`
+	Statuses   []CommitStatus `json:"statuses"`
+}
+
+// FailedContexts returns the contexts of all failed or errored statuses
+func (s *CombinedStatus) FailedContexts() []string {
+	var contexts []string
+	for _, status := range s.Statuses {
+		if status.State == "failure" || status.State == "error" {
+			contexts = append(contexts, status.Context)
+		}
+	}
+	return contexts
+}
+
 // CheckStatusSummary provides a summary of all check runs for a commit
 type CheckStatusSummary struct {
 	Total     int        // Total number of check runs
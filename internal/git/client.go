@@ -50,6 +50,10 @@ type Client interface {
 	// Commit creates a commit with the specified message
 	Commit(ctx context.Context, repoPath, message string) error
 
+	// CommitEmpty creates a commit with the specified message even when
+	// nothing is staged
+	CommitEmpty(ctx context.Context, repoPath, message string) error
+
 	// Push pushes the current branch to the remote
 	// If force is true, uses --force flag
 	Push(ctx context.Context, repoPath, remote, branch string, force bool) error
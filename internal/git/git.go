@@ -78,6 +78,8 @@ var sshURLPattern = regexp.MustCompile(`^git@([^:]+):([^/]+)/(.+?)(?:\.git)?$`)
 type gitClient struct {
 	logger    *logrus.Logger
 	logConfig *logging.LogConfig
+	signing   SigningConfig
+	identity  IdentityConfig
 }
 
 // NewClient creates a new Git client.
@@ -85,11 +87,12 @@ type gitClient struct {
 // Parameters:
 // - logger: Logger instance for general logging (required, cannot be nil)
 // - logConfig: Configuration for debug logging and verbose settings
+// - opts: Optional client behavior such as WithSigning or WithIdentity
 //
 // Returns:
 // - Git client interface implementation
 // - Error if logger is nil or git command is not available in PATH
-func NewClient(logger *logrus.Logger, logConfig *logging.LogConfig) (Client, error) {
+func NewClient(logger *logrus.Logger, logConfig *logging.LogConfig, opts ...ClientOption) (Client, error) {
 	// Validate logger is not nil to prevent panics in logging calls
 	if logger == nil {
 		return nil, ErrNilLogger
@@ -100,10 +103,14 @@ func NewClient(logger *logrus.Logger, logConfig *logging.LogConfig) (Client, err
 		return nil, ErrGitNotFound
 	}
 
-	return &gitClient{
+	client := &gitClient{
 		logger:    logger,
 		logConfig: logConfig,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	return client, nil
 }
 
 // Clone clones a repository to the specified path with retry logic for network errors.
@@ -357,7 +364,7 @@ func (g *gitClient) Add(ctx context.Context, repoPath string, paths ...string) e
 
 // Commit creates a commit with the given message
 func (g *gitClient) Commit(ctx context.Context, repoPath, message string) error {
-	cmd := exec.CommandContext(ctx, "git", "-C", repoPath, "commit", "-m", message) //nolint:gosec // G204: arguments are git subcommands and user-controlled repo path validated by caller
+	cmd := g.commitCommand(ctx, repoPath, message, false)
 
 	if err := g.runCommand(cmd); err != nil {
 		// Check for known error patterns
@@ -370,6 +377,36 @@ func (g *gitClient) Commit(ctx context.Context, repoPath, message string) error
 	return nil
 }
 
+// CommitEmpty creates a commit with the given message even when nothing is staged
+func (g *gitClient) CommitEmpty(ctx context.Context, repoPath, message string) error {
+	cmd := g.commitCommand(ctx, repoPath, message, true)
+
+	if err := g.runCommand(cmd); err != nil {
+		return appErrors.WrapWithContext(err, "commit")
+	}
+
+	return nil
+}
+
+// commitCommand builds the git commit command, signing it when the client
+// has a signing key and attributing it to the client's identity, if any
+func (g *gitClient) commitCommand(ctx context.Context, repoPath, message string, allowEmpty bool) *exec.Cmd {
+	args := append([]string{"-C", repoPath}, g.signing.configArgs()...)
+	args = append(args, g.identity.configArgs()...)
+	args = append(args, "commit")
+	if g.signing.Enabled() {
+		args = append(args, "-S")
+	}
+	if allowEmpty {
+		args = append(args, "--allow-empty")
+	}
+	args = append(args, "-m", message)
+
+	cmd := exec.CommandContext(ctx, "git", args...) //nolint:gosec // G204: arguments are git subcommands and user-controlled repo path validated by caller
+	cmd.Env = g.identity.env()
+	return cmd
+}
+
 // Push pushes the current branch to the remote with retry logic for network errors.
 func (g *gitClient) Push(ctx context.Context, repoPath, remote, branch string, force bool) error {
 	args := []string{"-C", repoPath, "push", remote, branch}
@@ -0,0 +1,50 @@
+package git
+
+import "os"
+
+// IdentityConfig sets the author and committer of the commits the client
+// creates. A zero value uses whatever identity git is configured with; a
+// committer left empty defaults to the author.
+type IdentityConfig struct {
+	AuthorName     string
+	AuthorEmail    string
+	CommitterName  string
+	CommitterEmail string
+}
+
+// configArgs returns the "-c" options that set the author for a single git
+// invocation. Git also uses them as the committer unless env overrides it.
+func (i IdentityConfig) configArgs() []string {
+	var args []string
+	if i.AuthorName != "" {
+		args = append(args, "-c", "user.name="+i.AuthorName)
+	}
+	if i.AuthorEmail != "" {
+		args = append(args, "-c", "user.email="+i.AuthorEmail)
+	}
+	return args
+}
+
+// env returns the process environment with the committer identity set, or
+// nil to inherit the environment unchanged
+func (i IdentityConfig) env() []string {
+	if i.CommitterName == "" && i.CommitterEmail == "" {
+		return nil
+	}
+	env := os.Environ()
+	if i.CommitterName != "" {
+		env = append(env, "GIT_COMMITTER_NAME="+i.CommitterName)
+	}
+	if i.CommitterEmail != "" {
+		env = append(env, "GIT_COMMITTER_EMAIL="+i.CommitterEmail)
+	}
+	return env
+}
+
+// WithIdentity attributes every commit the client creates to the given
+// author and committer
+func WithIdentity(cfg IdentityConfig) ClientOption {
+	return func(g *gitClient) {
+		g.identity = cfg
+	}
+}
@@ -0,0 +1,70 @@
+package git
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/logging"
+	"github.com/mrz1836/go-broadcast/internal/testutil"
+)
+
+func TestCommitCommand_Identity(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("author only", func(t *testing.T) {
+		client, err := NewClient(logrus.New(), &logging.LogConfig{},
+			WithIdentity(IdentityConfig{AuthorName: "sync-bot", AuthorEmail: "bot@example.com"}))
+		require.NoError(t, err)
+
+		cmd := client.(*gitClient).commitCommand(ctx, "/repo", "msg", false)
+		assert.Equal(t, []string{
+			"git", "-C", "/repo", "-c", "user.name=sync-bot", "-c", "user.email=bot@example.com",
+			"commit", "-m", "msg",
+		}, cmd.Args)
+		assert.Nil(t, cmd.Env)
+	})
+
+	t.Run("separate committer", func(t *testing.T) {
+		client, err := NewClient(logrus.New(), &logging.LogConfig{}, WithIdentity(IdentityConfig{
+			AuthorName:     "sync-bot",
+			AuthorEmail:    "bot@example.com",
+			CommitterName:  "release-bot",
+			CommitterEmail: "release@example.com",
+		}))
+		require.NoError(t, err)
+
+		cmd := client.(*gitClient).commitCommand(ctx, "/repo", "msg", false)
+		assert.Contains(t, cmd.Env, "GIT_COMMITTER_NAME=release-bot")
+		assert.Contains(t, cmd.Env, "GIT_COMMITTER_EMAIL=release@example.com")
+	})
+}
+
+func TestCommit_Identity(t *testing.T) {
+	ctx := context.Background()
+
+	repoPath := filepath.Join(testutil.CreateTempDir(t), "repo")
+	require.NoError(t, exec.CommandContext(ctx, "git", "init", repoPath).Run()) //nolint:gosec // Git command with safe static args
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "a.txt"), []byte("a"), 0o600))
+	require.NoError(t, exec.CommandContext(ctx, "git", "-C", repoPath, "add", "a.txt").Run()) //nolint:gosec // Git command with safe static args
+
+	client, err := NewClient(logrus.New(), &logging.LogConfig{}, WithIdentity(IdentityConfig{
+		AuthorName:     "sync-bot",
+		AuthorEmail:    "bot@example.com",
+		CommitterName:  "release-bot",
+		CommitterEmail: "release@example.com",
+	}))
+	require.NoError(t, err)
+	require.NoError(t, client.Commit(ctx, repoPath, "sync"))
+
+	out, err := exec.CommandContext(ctx, "git", "-C", repoPath, "log", "-1", "--format=%an <%ae>|%cn <%ce>").Output() //nolint:gosec // Git command with safe static args
+	require.NoError(t, err)
+	assert.Equal(t, "sync-bot <bot@example.com>|release-bot <release@example.com>", strings.TrimSpace(string(out)))
+}
@@ -60,6 +60,12 @@ func (m *MockClient) Commit(ctx context.Context, repoPath, message string) error
 	return testutil.ExtractError(args)
 }
 
+// CommitEmpty mock implementation
+func (m *MockClient) CommitEmpty(ctx context.Context, repoPath, message string) error {
+	args := m.Called(ctx, repoPath, message)
+	return testutil.ExtractError(args)
+}
+
 // Push mock implementation
 func (m *MockClient) Push(ctx context.Context, repoPath, remote, branch string, force bool) error {
 	args := m.Called(ctx, repoPath, remote, branch, force)
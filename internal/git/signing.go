@@ -0,0 +1,156 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Commit signing formats. An empty format signs with GPG.
+const (
+	SigningFormatGPG = "gpg"
+	SigningFormatSSH = "ssh"
+)
+
+// sshSigningMinVersion is the first git release able to sign with SSH keys
+//
+//nolint:gochecknoglobals // Read-only version constant
+var sshSigningMinVersion = [2]int{2, 34}
+
+// Commit signing errors
+var (
+	ErrInvalidSigningFormat  = errors.New("invalid commit signing format: must be gpg or ssh")
+	ErrSigningUnsupported    = errors.New("installed git cannot sign commits in this format")
+	ErrSigningKeyUnavailable = errors.New("commit signing key is not available")
+)
+
+// gitVersionPattern extracts the major and minor version from "git version" output
+//
+//nolint:gochecknoglobals // Compiled once, read-only
+var gitVersionPattern = regexp.MustCompile(`git version (\d+)\.(\d+)`)
+
+// SigningConfig selects the key the client signs commits with. A zero value
+// leaves commits unsigned.
+type SigningConfig struct {
+	Key    string // GPG key ID, or SSH key path or "key::" literal
+	Format string // SigningFormatGPG (default) or SigningFormatSSH
+}
+
+// Enabled reports whether commits are signed
+func (s SigningConfig) Enabled() bool {
+	return s.Key != ""
+}
+
+// format returns the signing format, defaulting to GPG
+func (s SigningConfig) format() string {
+	if s.Format == "" {
+		return SigningFormatGPG
+	}
+	return s.Format
+}
+
+// configArgs returns the "-c" options that select the signing key and format
+// for a single git invocation
+func (s SigningConfig) configArgs() []string {
+	if !s.Enabled() {
+		return nil
+	}
+	return []string{"-c", "gpg.format=" + s.format(), "-c", "user.signingkey=" + s.Key}
+}
+
+// ClientOption configures optional Git client behavior
+type ClientOption func(*gitClient)
+
+// WithSigning signs every commit the client creates with the given key.
+// Call ValidateSigning first to fail fast when the key cannot be used.
+func WithSigning(cfg SigningConfig) ClientOption {
+	return func(g *gitClient) {
+		g.signing = cfg
+	}
+}
+
+// ValidateSigning checks that the installed git supports the signing format
+// and that the key is usable, so a misconfiguration surfaces at startup
+// instead of on the first commit. A disabled config is always valid.
+func ValidateSigning(ctx context.Context, cfg SigningConfig) error {
+	if !cfg.Enabled() {
+		return nil
+	}
+
+	switch cfg.format() {
+	case SigningFormatGPG:
+		return validateGPGKey(ctx, cfg.Key)
+	case SigningFormatSSH:
+		if err := checkGitVersion(ctx, sshSigningMinVersion); err != nil {
+			return err
+		}
+		return validateSSHKey(cfg.Key)
+	default:
+		return fmt.Errorf("%w: got %q", ErrInvalidSigningFormat, cfg.Format)
+	}
+}
+
+// validateGPGKey checks that gpg is installed and holds the secret key
+func validateGPGKey(ctx context.Context, key string) error {
+	if _, err := exec.LookPath("gpg"); err != nil {
+		return fmt.Errorf("%w: gpg not found in PATH", ErrSigningKeyUnavailable)
+	}
+
+	cmd := exec.CommandContext(ctx, "gpg", "--batch", "--list-secret-keys", key) //nolint:gosec // G204: key is operator-supplied config, passed as a single argument
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%w: gpg has no secret key %q", ErrSigningKeyUnavailable, key)
+	}
+	return nil
+}
+
+// validateSSHKey checks that ssh-keygen is installed and the key file exists.
+// A literal "key::" public key is accepted as is; git resolves it through
+// the SSH agent.
+func validateSSHKey(key string) error {
+	if _, err := exec.LookPath("ssh-keygen"); err != nil {
+		return fmt.Errorf("%w: ssh-keygen not found in PATH", ErrSigningKeyUnavailable)
+	}
+	if strings.HasPrefix(key, "key::") {
+		return nil
+	}
+
+	if _, err := os.Stat(key); err != nil {
+		return fmt.Errorf("%w: %w", ErrSigningKeyUnavailable, err)
+	}
+	return nil
+}
+
+// checkGitVersion returns ErrSigningUnsupported when the installed git is
+// older than minVersion
+func checkGitVersion(ctx context.Context, minVersion [2]int) error {
+	out, err := exec.CommandContext(ctx, "git", "version").Output()
+	if err != nil {
+		return fmt.Errorf("%w: failed to read git version: %w", ErrSigningUnsupported, err)
+	}
+
+	version, ok := parseGitVersion(string(out))
+	if !ok {
+		return fmt.Errorf("%w: unrecognized git version %q", ErrSigningUnsupported, strings.TrimSpace(string(out)))
+	}
+	if version[0] < minVersion[0] || (version[0] == minVersion[0] && version[1] < minVersion[1]) {
+		return fmt.Errorf("%w: git %d.%d found, %d.%d or newer required",
+			ErrSigningUnsupported, version[0], version[1], minVersion[0], minVersion[1])
+	}
+	return nil
+}
+
+// parseGitVersion extracts the major and minor version from "git version" output
+func parseGitVersion(out string) ([2]int, bool) {
+	match := gitVersionPattern.FindStringSubmatch(out)
+	if match == nil {
+		return [2]int{}, false
+	}
+	major, _ := strconv.Atoi(match[1])
+	minor, _ := strconv.Atoi(match[2])
+	return [2]int{major, minor}, true
+}
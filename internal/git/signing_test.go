@@ -0,0 +1,123 @@
+package git
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/logging"
+	"github.com/mrz1836/go-broadcast/internal/testutil"
+)
+
+func TestParseGitVersion(t *testing.T) {
+	tests := []struct {
+		name   string
+		out    string
+		want   [2]int
+		wantOK bool
+	}{
+		{"linux", "git version 2.43.0\n", [2]int{2, 43}, true},
+		{"apple", "git version 2.39.3 (Apple Git-146)", [2]int{2, 39}, true},
+		{"windows", "git version 2.45.1.windows.1", [2]int{2, 45}, true},
+		{"garbage", "not git", [2]int{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseGitVersion(tt.out)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommitCommand_Signing(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unsigned", func(t *testing.T) {
+		client, err := NewClient(logrus.New(), &logging.LogConfig{})
+		require.NoError(t, err)
+
+		cmd := client.(*gitClient).commitCommand(ctx, "/repo", "msg", false)
+		assert.Equal(t, []string{"git", "-C", "/repo", "commit", "-m", "msg"}, cmd.Args)
+	})
+
+	t.Run("gpg by default", func(t *testing.T) {
+		client, err := NewClient(logrus.New(), &logging.LogConfig{}, WithSigning(SigningConfig{Key: "ABCD1234"}))
+		require.NoError(t, err)
+
+		cmd := client.(*gitClient).commitCommand(ctx, "/repo", "msg", false)
+		assert.Equal(t, []string{
+			"git", "-C", "/repo", "-c", "gpg.format=gpg", "-c", "user.signingkey=ABCD1234",
+			"commit", "-S", "-m", "msg",
+		}, cmd.Args)
+	})
+
+	t.Run("ssh empty commit", func(t *testing.T) {
+		client, err := NewClient(logrus.New(), &logging.LogConfig{},
+			WithSigning(SigningConfig{Key: "~/.ssh/id_ed25519.pub", Format: SigningFormatSSH}))
+		require.NoError(t, err)
+
+		cmd := client.(*gitClient).commitCommand(ctx, "/repo", "msg", true)
+		assert.Equal(t, []string{
+			"git", "-C", "/repo", "-c", "gpg.format=ssh", "-c", "user.signingkey=~/.ssh/id_ed25519.pub",
+			"commit", "-S", "--allow-empty", "-m", "msg",
+		}, cmd.Args)
+	})
+}
+
+func TestValidateSigning(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("disabled", func(t *testing.T) {
+		require.NoError(t, ValidateSigning(ctx, SigningConfig{}))
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		err := ValidateSigning(ctx, SigningConfig{Key: "key", Format: "x509"})
+		require.ErrorIs(t, err, ErrInvalidSigningFormat)
+	})
+
+	t.Run("unknown gpg key", func(t *testing.T) {
+		if _, err := exec.LookPath("gpg"); err != nil {
+			t.Skip("gpg not installed")
+		}
+		t.Setenv("GNUPGHOME", testutil.CreateTempDir(t))
+
+		err := ValidateSigning(ctx, SigningConfig{Key: "DEADBEEF00000000"})
+		require.ErrorIs(t, err, ErrSigningKeyUnavailable)
+	})
+
+	t.Run("ssh", func(t *testing.T) {
+		if _, err := exec.LookPath("ssh-keygen"); err != nil {
+			t.Skip("ssh-keygen not installed")
+		}
+		if err := checkGitVersion(ctx, sshSigningMinVersion); err != nil {
+			t.Skipf("git cannot sign with ssh: %v", err)
+		}
+
+		dir := testutil.CreateTempDir(t)
+		key := filepath.Join(dir, "id_ed25519.pub")
+		testutil.WriteTestFile(t, key, "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIExample test@example.com\n")
+
+		require.NoError(t, ValidateSigning(ctx, SigningConfig{Key: key, Format: SigningFormatSSH}))
+		require.NoError(t, ValidateSigning(ctx, SigningConfig{Key: "key::ssh-ed25519 AAAA", Format: SigningFormatSSH}))
+
+		err := ValidateSigning(ctx, SigningConfig{Key: filepath.Join(dir, "missing.pub"), Format: SigningFormatSSH})
+		require.ErrorIs(t, err, ErrSigningKeyUnavailable)
+	})
+}
+
+func TestCheckGitVersion_TooOld(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	err := checkGitVersion(context.Background(), [2]int{999, 0})
+	require.ErrorIs(t, err, ErrSigningUnsupported)
+}
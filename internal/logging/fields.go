@@ -133,6 +133,13 @@ var OperationTypes = struct {
 	ConfigValidate string
 	StateDiscover  string
 	SyncExecute    string
+
+	// Stages of a single repository sync
+	SyncClone     string
+	SyncTransform string
+	SyncCommit    string
+	SyncPush      string
+	PRCreate      string
 }{
 	GitCommand:     "git_command",
 	APIRequest:     "api_request",
@@ -140,4 +147,10 @@ var OperationTypes = struct {
 	ConfigValidate: "config_validate",
 	StateDiscover:  "state_discover",
 	SyncExecute:    "sync_execute",
+
+	SyncClone:     "clone",
+	SyncTransform: "transform",
+	SyncCommit:    "commit",
+	SyncPush:      "push",
+	PRCreate:      "pr_create",
 }
@@ -286,6 +286,11 @@ func TestOperationTypes_Uniqueness(t *testing.T) {
 		OperationTypes.ConfigValidate,
 		OperationTypes.StateDiscover,
 		OperationTypes.SyncExecute,
+		OperationTypes.SyncClone,
+		OperationTypes.SyncTransform,
+		OperationTypes.SyncCommit,
+		OperationTypes.SyncPush,
+		OperationTypes.PRCreate,
 	}
 
 	seen := make(map[string]bool)
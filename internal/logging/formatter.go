@@ -38,8 +38,13 @@ func NewStructuredFormatter() *StructuredFormatter {
 func (f *StructuredFormatter) Format(entry *logrus.Entry) ([]byte, error) {
 	data := make(logrus.Fields)
 
-	// Copy all fields from the entry
+	// Copy all fields from the entry; errors have no exported fields and
+	// would otherwise marshal as {}
 	for k, v := range entry.Data {
+		if err, ok := v.(error); ok {
+			data[k] = err.Error()
+			continue
+		}
 		data[k] = v
 	}
 
@@ -103,9 +108,7 @@ func ConfigureLogger(logger *logrus.Logger, config *LogConfig) error {
 	logger.SetLevel(level)
 
 	// Install redaction hook for automatic sensitive data protection
-	redactionService := NewRedactionService()
-	redactionHook := redactionService.CreateHook()
-	logger.AddHook(redactionHook)
+	EnsureRedactionHook(logger)
 
 	// Configure formatter based on JSON output setting
 	if config.JSONOutput || config.LogFormat == "json" {
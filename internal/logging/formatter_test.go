@@ -169,6 +169,20 @@ func TestStructuredFormatter_Format(t *testing.T) {
 	}
 }
 
+func TestStructuredFormatter_FormatErrorField(t *testing.T) {
+	output, err := NewStructuredFormatter().Format(&logrus.Entry{
+		Time:    time.Now(),
+		Level:   logrus.ErrorLevel,
+		Message: "Repository sync failed",
+		Data:    logrus.Fields{logrus.ErrorKey: errTestRedaction},
+	})
+	require.NoError(t, err)
+
+	var result map[string]interface{}
+	require.NoError(t, json.Unmarshal(output, &result))
+	assert.Equal(t, "authentication failed", result[logrus.ErrorKey], "errors are serialized by message, not as {}")
+}
+
 func TestConfigureLogger(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -292,6 +292,21 @@ func (r *RedactionService) CreateHook() logrus.Hook {
 	return &RedactionHook{service: r}
 }
 
+// EnsureRedactionHook installs a RedactionHook on logger unless one is already
+// present, so repeated logger setup (e.g. once per command run) does not stack
+// duplicate hooks.
+func EnsureRedactionHook(logger *logrus.Logger) {
+	if logger == nil {
+		return
+	}
+	for _, hook := range logger.Hooks[logrus.InfoLevel] {
+		if _, ok := hook.(*RedactionHook); ok {
+			return
+		}
+	}
+	logger.AddHook(NewRedactionService().CreateHook())
+}
+
 // RedactionHook automatically redacts sensitive data in log entries.
 //
 // This hook integrates with logrus to provide automatic redaction of
@@ -378,6 +393,9 @@ func (h *RedactionHook) redactValueWithDepth(key string, value interface{}, dept
 	case string:
 		// Apply pattern-based redaction to strings
 		return h.service.RedactSensitive(v)
+	case error:
+		// Errors often embed URLs or command lines carrying tokens
+		return h.service.RedactSensitive(v.Error())
 	case map[string]interface{}:
 		// Recursively process nested maps
 		result := make(map[string]interface{})
@@ -12,6 +12,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/mrz1836/go-broadcast/internal/cache"
+	"github.com/mrz1836/go-broadcast/internal/pool"
 	"github.com/mrz1836/go-broadcast/internal/profiling"
 )
 
@@ -53,6 +55,20 @@ type MetricsCollector struct {
 
 	// Components
 	profiler *profiling.MemoryProfiler
+
+	// Caches reported in the "caches" section, by name
+	caches map[string]CacheStatsSource
+
+	// Liveness state reported by the /healthz and /readyz probes
+	running    bool
+	startTime  time.Time
+	lastSample time.Time
+}
+
+// CacheStatsSource is a cache whose statistics the collector reports, such
+// as a *cache.TTLCache
+type CacheStatsSource interface {
+	Stats() cache.CacheStats
 }
 
 // MetricsSnapshot represents metrics at a point in time
@@ -68,6 +84,18 @@ type DashboardConfig struct {
 	RetainHistory   int           `json:"retain_history"`
 	EnableProfiling bool          `json:"enable_profiling"`
 	ProfileDir      string        `json:"profile_dir"`
+
+	// EnablePrometheus serves the collected metrics at /metrics in Prometheus text format
+	EnablePrometheus bool `json:"enable_prometheus"`
+
+	// HealthzPath and ReadyzPath are the liveness and readiness probe paths
+	// (default /healthz and /readyz)
+	HealthzPath string `json:"healthz_path"`
+	ReadyzPath  string `json:"readyz_path"`
+
+	// ProbeMaxSampleAge is how old the latest sample may be before the probes
+	// fail (default three collect intervals)
+	ProbeMaxSampleAge time.Duration `json:"probe_max_sample_age"`
 }
 
 // DefaultDashboardConfig returns default dashboard configuration
@@ -78,6 +106,8 @@ func DefaultDashboardConfig() DashboardConfig {
 		RetainHistory:   300, // 5 minutes of history at 1-second intervals
 		EnableProfiling: false,
 		ProfileDir:      "./profiles",
+		HealthzPath:     defaultHealthzPath,
+		ReadyzPath:      defaultReadyzPath,
 	}
 }
 
@@ -91,6 +121,8 @@ func NewMetricsCollector(config DashboardConfig) *MetricsCollector {
 		retainHistory:   config.RetainHistory,
 		history:         make([]MetricsSnapshot, 0, config.RetainHistory),
 		cancel:          cancel,
+		running:         true,
+		startTime:       time.Now(),
 	}
 
 	// Initialize profiler BEFORE starting collection goroutine
@@ -108,6 +140,26 @@ func NewMetricsCollector(config DashboardConfig) *MetricsCollector {
 	return mc
 }
 
+// RegisterCache adds a cache to the "caches" section of the collected
+// metrics. Registering another cache under the same name replaces it.
+func (mc *MetricsCollector) RegisterCache(name string, source CacheStatsSource) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	if mc.caches == nil {
+		mc.caches = make(map[string]CacheStatsSource)
+	}
+	mc.caches[name] = source
+}
+
+// UnregisterCache removes a cache added with RegisterCache
+func (mc *MetricsCollector) UnregisterCache(name string) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	delete(mc.caches, name)
+}
+
 // GetCurrentMetrics returns a deep copy of the current metrics.
 // The returned map is safe to modify without affecting internal state.
 func (mc *MetricsCollector) GetCurrentMetrics() map[string]interface{} {
@@ -176,6 +228,10 @@ func (mc *MetricsCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 func (mc *MetricsCollector) Stop() {
 	mc.cancel()
 
+	mc.mu.Lock()
+	mc.running = false
+	mc.mu.Unlock()
+
 	if mc.profiler != nil {
 		if err := mc.profiler.Disable(); err != nil {
 			log.Printf("Warning: failed to disable profiler: %v", err)
@@ -249,6 +305,21 @@ func (mc *MetricsCollector) updateMetrics() {
 		gcMetrics["last_gc_time"] = time.Unix(0, int64(memStats.LastGC)).Unix() //nolint:gosec // LastGC is nanoseconds since epoch, safe for int64 until year 2262
 	}
 
+	// Add buffer pool statistics
+	poolStats := pool.GetStats()
+	currentMetrics["buffer_pool"] = map[string]interface{}{
+		"small":     bufferPoolMetrics(poolStats.SmallPool),
+		"medium":    bufferPoolMetrics(poolStats.MediumPool),
+		"large":     bufferPoolMetrics(poolStats.LargePool),
+		"oversized": poolStats.Oversized,
+		"resets":    poolStats.Resets,
+	}
+
+	// Add cache statistics
+	if caches := mc.cacheMetrics(); len(caches) > 0 {
+		currentMetrics["caches"] = caches
+	}
+
 	// Add profiler statistics if available
 	if mc.profiler != nil {
 		profilerStats := mc.profiler.GetProfilerStats()
@@ -263,6 +334,7 @@ func (mc *MetricsCollector) updateMetrics() {
 	// Update current metrics
 	mc.mu.Lock()
 	mc.metrics = currentMetrics
+	mc.lastSample = now
 
 	// Add to history with deep copy to prevent mutation
 	snapshot := MetricsSnapshot{
@@ -280,6 +352,36 @@ func (mc *MetricsCollector) updateMetrics() {
 	mc.mu.Unlock()
 }
 
+// cacheMetrics converts the statistics of each registered cache to a metrics
+// map. Stats reads atomics only, so this never contends with cache users.
+func (mc *MetricsCollector) cacheMetrics() map[string]interface{} {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	caches := make(map[string]interface{}, len(mc.caches))
+	for name, source := range mc.caches {
+		stats := source.Stats()
+		caches[name] = map[string]interface{}{
+			"hits":      stats.Hits,
+			"misses":    stats.Misses,
+			"evictions": stats.Evictions,
+			"size":      stats.Size,
+			"capacity":  stats.Capacity,
+			"hit_rate":  stats.HitRate(),
+		}
+	}
+	return caches
+}
+
+// bufferPoolMetrics converts a single buffer pool tier's statistics to a metrics map
+func bufferPoolMetrics(m pool.Metrics) map[string]interface{} {
+	return map[string]interface{}{
+		"gets":         m.Gets,
+		"puts":         m.Puts,
+		"return_ratio": m.ReturnRate() / 100,
+	}
+}
+
 // Dashboard manages the HTTP dashboard server
 type Dashboard struct {
 	collector *MetricsCollector
@@ -304,6 +406,11 @@ func NewDashboard(config DashboardConfig) *Dashboard {
 	// API endpoints
 	mux.Handle("/api/metrics", collector)
 	mux.HandleFunc("/api/health", dashboard.healthHandler)
+	mux.HandleFunc(probePath(config.HealthzPath, defaultHealthzPath), dashboard.healthzHandler)
+	mux.HandleFunc(probePath(config.ReadyzPath, defaultReadyzPath), dashboard.readyzHandler)
+	if config.EnablePrometheus {
+		mux.HandleFunc("/metrics", dashboard.prometheusHandler)
+	}
 
 	// Static dashboard page
 	mux.HandleFunc("/", dashboardHandler)
@@ -1,6 +1,7 @@
 package monitoring
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -13,6 +14,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/cache"
 )
 
 // TestNewMetricsCollector tests metrics collector creation
@@ -1235,3 +1238,45 @@ func TestProfilerInitializationOrder(t *testing.T) {
 		assert.Contains(t, profiler, "enabled")
 	}
 }
+
+// TestMetricsCollectorCaches tests that registered cache statistics are collected and exported
+func TestMetricsCollectorCaches(t *testing.T) {
+	config := DefaultDashboardConfig()
+	config.CollectInterval = time.Hour
+	collector := NewMetricsCollector(config)
+	defer collector.Stop()
+
+	treeCache := cache.NewTTLCache(time.Minute, 2)
+	defer treeCache.Close()
+	treeCache.Set("a", 1)
+	treeCache.Set("b", 2)
+	treeCache.Set("c", 3)
+	_, _ = treeCache.Get("c")
+	_, _ = treeCache.Get("missing")
+
+	collector.updateMetrics()
+	assert.NotContains(t, collector.GetCurrentMetrics(), "caches")
+
+	collector.RegisterCache("github_tree", treeCache)
+	collector.updateMetrics()
+
+	caches, ok := collector.GetCurrentMetrics()["caches"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, map[string]interface{}{
+		"hits":      int64(1),
+		"misses":    int64(1),
+		"evictions": int64(1),
+		"size":      2,
+		"capacity":  2,
+		"hit_rate":  0.5,
+	}, caches["github_tree"])
+
+	var buf bytes.Buffer
+	require.NoError(t, collector.WritePrometheus(&buf))
+	assert.Contains(t, buf.String(), "# TYPE go_broadcast_cache_evictions_total counter\n")
+	assert.Contains(t, buf.String(), `go_broadcast_cache_hit_ratio{cache="github_tree"} 0.5`)
+
+	collector.UnregisterCache("github_tree")
+	collector.updateMetrics()
+	assert.NotContains(t, collector.GetCurrentMetrics(), "caches")
+}
@@ -0,0 +1,115 @@
+package monitoring
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+)
+
+// Default paths of the orchestrator probes
+const (
+	defaultHealthzPath = "/healthz"
+	defaultReadyzPath  = "/readyz"
+)
+
+// probeSampleIntervals is how many collect intervals may pass without a new
+// sample before the probes fail, when ProbeMaxSampleAge is not set
+const probeSampleIntervals = 3
+
+var (
+	// ErrCollectorStopped is reported by the probes once the metrics collector has stopped
+	ErrCollectorStopped = errors.New("metrics collector stopped")
+
+	// ErrNoRecentSamples is reported by the probes when the metrics collector
+	// has not produced a sample recently
+	ErrNoRecentSamples = errors.New("no recent metrics samples")
+)
+
+// probePath returns path, or fallback when path is empty
+func probePath(path, fallback string) string {
+	if path == "" {
+		return fallback
+	}
+	return path
+}
+
+// probeStatus returns the collector's running state, start time and the time
+// of its latest sample
+func (mc *MetricsCollector) probeStatus() (running bool, started, lastSample time.Time) {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	return mc.running, mc.startTime, mc.lastSample
+}
+
+// maxSampleAge returns how old the latest sample may be before the probes fail
+func (d *Dashboard) maxSampleAge() time.Duration {
+	if d.config.ProbeMaxSampleAge > 0 {
+		return d.config.ProbeMaxSampleAge
+	}
+	interval := d.config.CollectInterval
+	if interval <= 0 {
+		interval = time.Second // Matches the collector's fallback
+	}
+	return probeSampleIntervals * interval
+}
+
+// checkLiveness reports why the dashboard is not live: the collector has
+// stopped, or it has stopped producing samples. A collector that has not
+// finished its first interval yet counts as live.
+func (d *Dashboard) checkLiveness(now time.Time) error {
+	running, started, lastSample := d.collector.probeStatus()
+	if !running {
+		return ErrCollectorStopped
+	}
+	if lastSample.IsZero() {
+		lastSample = started
+	}
+	if age := now.Sub(lastSample); age > d.maxSampleAge() {
+		return fmt.Errorf("%w: latest sample is %s old", ErrNoRecentSamples, age.Round(time.Millisecond))
+	}
+	return nil
+}
+
+// checkReadiness reports why the dashboard is not ready to serve metrics: it
+// is not live, or no sample has been collected yet
+func (d *Dashboard) checkReadiness(now time.Time) error {
+	if err := d.checkLiveness(now); err != nil {
+		return err
+	}
+	if _, _, lastSample := d.collector.probeStatus(); lastSample.IsZero() {
+		return fmt.Errorf("%w: waiting for the first sample", ErrNoRecentSamples)
+	}
+	return nil
+}
+
+// healthzHandler handles liveness probe requests
+func (d *Dashboard) healthzHandler(w http.ResponseWriter, _ *http.Request) {
+	writeProbeResponse(w, d.checkLiveness(time.Now()))
+}
+
+// readyzHandler handles readiness probe requests
+func (d *Dashboard) readyzHandler(w http.ResponseWriter, _ *http.Request) {
+	writeProbeResponse(w, d.checkReadiness(time.Now()))
+}
+
+// writeProbeResponse writes a plain-text probe result: 200 "ok" when err is
+// nil, otherwise 503 with the reason
+func writeProbeResponse(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+
+	body := "ok\n"
+	status := http.StatusOK
+	if err != nil {
+		body = err.Error() + "\n"
+		status = http.StatusServiceUnavailable
+	}
+
+	w.WriteHeader(status)
+	if _, writeErr := w.Write([]byte(body)); writeErr != nil {
+		log.Printf("Warning: failed to write probe response: %v", writeErr)
+	}
+}
@@ -0,0 +1,85 @@
+package monitoring
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// probe issues a GET for path against the dashboard's handler
+func probe(t *testing.T, dashboard *Dashboard, path string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, path, nil)
+	w := httptest.NewRecorder()
+	dashboard.server.Handler.ServeHTTP(w, req)
+	return w
+}
+
+// TestDashboardProbes tests the /healthz and /readyz endpoints
+func TestDashboardProbes(t *testing.T) {
+	config := DefaultDashboardConfig()
+	config.CollectInterval = time.Hour
+	dashboard := NewDashboard(config)
+	defer dashboard.GetCollector().Stop()
+
+	t.Run("live but not ready before the first sample", func(t *testing.T) {
+		w := probe(t, dashboard, "/healthz")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "ok\n", w.Body.String())
+		assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+
+		w = probe(t, dashboard, "/readyz")
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.Contains(t, w.Body.String(), "waiting for the first sample")
+	})
+
+	t.Run("ready after a sample", func(t *testing.T) {
+		dashboard.GetCollector().updateMetrics()
+		assert.Equal(t, http.StatusOK, probe(t, dashboard, "/healthz").Code)
+		assert.Equal(t, http.StatusOK, probe(t, dashboard, "/readyz").Code)
+	})
+
+	t.Run("stale samples fail both probes", func(t *testing.T) {
+		later := time.Now().Add(4 * time.Hour)
+		require.ErrorIs(t, dashboard.checkLiveness(later), ErrNoRecentSamples)
+		require.ErrorIs(t, dashboard.checkReadiness(later), ErrNoRecentSamples)
+	})
+
+	t.Run("stopped collector fails both probes", func(t *testing.T) {
+		dashboard.GetCollector().Stop()
+		for _, path := range []string{"/healthz", "/readyz"} {
+			w := probe(t, dashboard, path)
+			assert.Equal(t, http.StatusServiceUnavailable, w.Code, path)
+			assert.Equal(t, ErrCollectorStopped.Error()+"\n", w.Body.String(), path)
+		}
+	})
+}
+
+// TestDashboardProbePaths tests custom probe paths and sample age
+func TestDashboardProbePaths(t *testing.T) {
+	dashboard := NewDashboard(DashboardConfig{
+		Port:              8080,
+		CollectInterval:   time.Hour,
+		RetainHistory:     10,
+		HealthzPath:       "/live",
+		ReadyzPath:        "/ready",
+		ProbeMaxSampleAge: time.Minute,
+	})
+	defer dashboard.GetCollector().Stop()
+
+	assert.Equal(t, time.Minute, dashboard.maxSampleAge())
+	assert.Equal(t, http.StatusOK, probe(t, dashboard, "/live").Code)
+	assert.Equal(t, http.StatusServiceUnavailable, probe(t, dashboard, "/ready").Code)
+	require.ErrorIs(t, dashboard.checkLiveness(time.Now().Add(2*time.Minute)), ErrNoRecentSamples)
+
+	// Unset paths fall back to the defaults
+	unset := NewDashboard(DashboardConfig{CollectInterval: 0, RetainHistory: 10})
+	defer unset.GetCollector().Stop()
+	assert.Equal(t, 3*time.Second, unset.maxSampleAge())
+	assert.Equal(t, http.StatusOK, probe(t, unset, "/healthz").Code)
+}
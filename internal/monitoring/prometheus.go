@@ -0,0 +1,229 @@
+package monitoring
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// prometheusNamespace prefixes every exported metric name
+const prometheusNamespace = "go_broadcast_"
+
+// prometheusContentType is the content type of the Prometheus text exposition format
+const prometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// bytesPerMB converts the collector's megabyte values back to bytes
+const bytesPerMB = 1024 * 1024
+
+// prometheusMetric describes how one collected value is exported
+type prometheusMetric struct {
+	name    string  // Metric name without namespace
+	help    string  // HELP text
+	kind    string  // "gauge" or "counter"
+	section string  // Top-level key in the collected metrics map
+	key     string  // Key within the section
+	scale   float64 // Multiplier converting the collected value to base units
+}
+
+// prometheusMetrics lists the exported metrics in output order.
+// Names follow Prometheus conventions: base units (bytes, seconds) and a
+// _total suffix for counters.
+//
+//nolint:gochecknoglobals // Static metric descriptors
+var prometheusMetrics = []prometheusMetric{
+	{"goroutines", "Number of goroutines that currently exist.", "gauge", "runtime", "goroutines", 1},
+	{"gomaxprocs", "Value of GOMAXPROCS.", "gauge", "runtime", "gomaxprocs", 1},
+	{"memory_alloc_bytes", "Bytes of allocated heap objects.", "gauge", "memory", "alloc_mb", bytesPerMB},
+	{"memory_alloc_bytes_total", "Cumulative bytes allocated for heap objects.", "counter", "memory", "total_alloc_mb", bytesPerMB},
+	{"memory_sys_bytes", "Total bytes of memory obtained from the OS.", "gauge", "memory", "sys_mb", bytesPerMB},
+	{"memory_heap_alloc_bytes", "Bytes of allocated heap objects.", "gauge", "memory", "heap_alloc_mb", bytesPerMB},
+	{"memory_heap_sys_bytes", "Bytes of heap memory obtained from the OS.", "gauge", "memory", "heap_sys_mb", bytesPerMB},
+	{"memory_heap_objects", "Number of allocated heap objects.", "gauge", "memory", "heap_objects", 1},
+	{"memory_stack_sys_bytes", "Bytes of stack memory obtained from the OS.", "gauge", "memory", "stack_sys_mb", bytesPerMB},
+	{"memory_next_gc_bytes", "Target heap size of the next GC cycle.", "gauge", "memory", "next_gc_mb", bytesPerMB},
+	{"gc_cycles_total", "Number of completed GC cycles.", "counter", "gc", "num_gc", 1},
+	{"gc_forced_cycles_total", "Number of GC cycles forced by the application.", "counter", "gc", "num_forced", 1},
+	{"gc_pause_seconds_total", "Cumulative GC stop-the-world pause time.", "counter", "gc", "pause_total_ms", 1e-3},
+	{"gc_last_pause_seconds", "Duration of the most recent GC pause.", "gauge", "gc", "last_pause_ms", 1e-3},
+	{"gc_avg_pause_seconds", "Average GC pause duration.", "gauge", "gc", "avg_pause_ms", 1e-3},
+}
+
+// bufferPoolNames lists the buffer pool tiers in output order
+//
+//nolint:gochecknoglobals // Static label values
+var bufferPoolNames = []string{"small", "medium", "large"}
+
+// WritePrometheus writes the current metrics in Prometheus text exposition format.
+// Metrics that have not been collected yet are omitted.
+func (mc *MetricsCollector) WritePrometheus(w io.Writer) error {
+	metrics := mc.GetCurrentMetrics()
+	bw := bufio.NewWriter(w)
+
+	for _, m := range prometheusMetrics {
+		section, ok := metrics[m.section].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		value, ok := toFloat64(section[m.key])
+		if !ok {
+			continue
+		}
+		writePrometheusHeader(bw, m.name, m.help, m.kind)
+		writePrometheusSample(bw, m.name, "", value*m.scale)
+	}
+
+	if pools, ok := metrics["buffer_pool"].(map[string]interface{}); ok {
+		writeBufferPoolMetrics(bw, pools)
+	}
+
+	if caches, ok := metrics["caches"].(map[string]interface{}); ok {
+		writeCacheMetrics(bw, caches)
+	}
+
+	if runtimeMetrics, ok := metrics["runtime"].(map[string]interface{}); ok {
+		if version, ok := runtimeMetrics["go_version"].(string); ok {
+			writePrometheusHeader(bw, "runtime_info", "Go runtime information.", "gauge")
+			writePrometheusSample(bw, "runtime_info", `go_version="`+escapeLabelValue(version)+`"`, 1)
+		}
+	}
+
+	return bw.Flush()
+}
+
+// writeBufferPoolMetrics writes per-tier buffer pool counters and return ratio
+func writeBufferPoolMetrics(w *bufio.Writer, pools map[string]interface{}) {
+	series := []struct {
+		name string
+		help string
+		kind string
+		key  string
+	}{
+		{"buffer_pool_gets_total", "Buffers retrieved from the pool.", "counter", "gets"},
+		{"buffer_pool_puts_total", "Buffers returned to the pool.", "counter", "puts"},
+		{"buffer_pool_return_ratio", "Ratio of buffers returned to buffers retrieved (hit rate).", "gauge", "return_ratio"},
+	}
+
+	for _, s := range series {
+		headerWritten := false
+		for _, name := range bufferPoolNames {
+			pool, ok := pools[name].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			value, ok := toFloat64(pool[s.key])
+			if !ok {
+				continue
+			}
+			if !headerWritten {
+				writePrometheusHeader(w, s.name, s.help, s.kind)
+				headerWritten = true
+			}
+			writePrometheusSample(w, s.name, `pool="`+name+`"`, value)
+		}
+	}
+
+	if oversized, ok := toFloat64(pools["oversized"]); ok {
+		writePrometheusHeader(w, "buffer_pool_oversized_total", "Buffers too large to be pooled.", "counter")
+		writePrometheusSample(w, "buffer_pool_oversized_total", "", oversized)
+	}
+}
+
+// writeCacheMetrics writes per-cache counters, size and hit rate, labeled by
+// cache name in sorted order
+func writeCacheMetrics(w *bufio.Writer, caches map[string]interface{}) {
+	series := []struct {
+		name string
+		help string
+		kind string
+		key  string
+	}{
+		{"cache_hits_total", "Cache lookups that found a live entry.", "counter", "hits"},
+		{"cache_misses_total", "Cache lookups that found no live entry.", "counter", "misses"},
+		{"cache_evictions_total", "Entries evicted to make room for new keys.", "counter", "evictions"},
+		{"cache_size", "Entries currently stored in the cache.", "gauge", "size"},
+		{"cache_capacity", "Maximum number of entries the cache holds.", "gauge", "capacity"},
+		{"cache_hit_ratio", "Ratio of cache lookups that were hits.", "gauge", "hit_rate"},
+	}
+
+	names := make([]string, 0, len(caches))
+	for name := range caches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, s := range series {
+		headerWritten := false
+		for _, name := range names {
+			stats, ok := caches[name].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			value, ok := toFloat64(stats[s.key])
+			if !ok {
+				continue
+			}
+			if !headerWritten {
+				writePrometheusHeader(w, s.name, s.help, s.kind)
+				headerWritten = true
+			}
+			writePrometheusSample(w, s.name, `cache="`+escapeLabelValue(name)+`"`, value)
+		}
+	}
+}
+
+// writePrometheusHeader writes the HELP and TYPE lines for a metric
+func writePrometheusHeader(w *bufio.Writer, name, help, kind string) {
+	_, _ = fmt.Fprintf(w, "# HELP %s%s %s\n", prometheusNamespace, name, help)
+	_, _ = fmt.Fprintf(w, "# TYPE %s%s %s\n", prometheusNamespace, name, kind)
+}
+
+// writePrometheusSample writes a single sample line with optional labels
+func writePrometheusSample(w *bufio.Writer, name, labels string, value float64) {
+	if labels != "" {
+		labels = "{" + labels + "}"
+	}
+	_, _ = fmt.Fprintf(w, "%s%s%s %s\n", prometheusNamespace, name, labels, strconv.FormatFloat(value, 'g', -1, 64))
+}
+
+// escapeLabelValue escapes a label value per the text exposition format
+func escapeLabelValue(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(v)
+}
+
+// toFloat64 converts a collected numeric value to float64
+func toFloat64(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
+
+// prometheusHandler serves the collector's metrics in Prometheus text format
+func (d *Dashboard) prometheusHandler(w http.ResponseWriter, _ *http.Request) {
+	var buf bytes.Buffer
+	if err := d.collector.WritePrometheus(&buf); err != nil {
+		http.Error(w, "Failed to encode metrics", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", prometheusContentType)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		log.Printf("Warning: failed to write prometheus response: %v", err)
+	}
+}
@@ -0,0 +1,118 @@
+package monitoring
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// promSampleLine matches a sample line of the text exposition format
+var promSampleLine = regexp.MustCompile(`^go_broadcast_[a-z_]+(\{[a-z_]+="[^"]*"\})? [-+0-9.eE]+$`)
+
+// TestWritePrometheus tests the Prometheus text serializer
+func TestWritePrometheus(t *testing.T) {
+	config := DefaultDashboardConfig()
+	config.CollectInterval = time.Hour
+	collector := NewMetricsCollector(config)
+	defer collector.Stop()
+
+	t.Run("EmptyBeforeCollection", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, collector.WritePrometheus(&buf))
+		assert.Empty(t, buf.String())
+	})
+
+	runtime.GC()
+	collector.updateMetrics()
+
+	var buf bytes.Buffer
+	require.NoError(t, collector.WritePrometheus(&buf))
+	out := buf.String()
+
+	t.Run("NamespacedAndWellFormed", func(t *testing.T) {
+		for _, line := range strings.Split(strings.TrimSuffix(out, "\n"), "\n") {
+			if strings.HasPrefix(line, "# HELP ") || strings.HasPrefix(line, "# TYPE ") {
+				assert.True(t, strings.HasPrefix(strings.Fields(line)[2], prometheusNamespace), line)
+				continue
+			}
+			if strings.HasPrefix(line, prometheusNamespace+"runtime_info") {
+				continue
+			}
+			assert.Regexp(t, promSampleLine, line)
+		}
+	})
+
+	t.Run("ExpectedMetrics", func(t *testing.T) {
+		assert.Contains(t, out, "# TYPE go_broadcast_goroutines gauge\n")
+		assert.Contains(t, out, "# TYPE go_broadcast_memory_heap_alloc_bytes gauge\n")
+		assert.Contains(t, out, "# TYPE go_broadcast_gc_cycles_total counter\n")
+		assert.Contains(t, out, "# TYPE go_broadcast_gc_pause_seconds_total counter\n")
+		assert.Contains(t, out, "go_broadcast_gc_last_pause_seconds ")
+		assert.Contains(t, out, `go_broadcast_buffer_pool_return_ratio{pool="small"} `)
+		assert.Contains(t, out, `go_broadcast_buffer_pool_gets_total{pool="large"} `)
+		assert.Contains(t, out, `go_broadcast_runtime_info{go_version="`+runtime.Version()+`"} 1`)
+
+		// Each metric family is declared exactly once
+		assert.Equal(t, 1, strings.Count(out, "# TYPE go_broadcast_buffer_pool_puts_total "))
+	})
+
+	t.Run("ConvertsToBaseUnits", func(t *testing.T) {
+		memory := collector.GetCurrentMetrics()["memory"].(map[string]interface{})
+		heapMB := memory["heap_alloc_mb"].(float64)
+
+		var heapBytes float64
+		for _, line := range strings.Split(out, "\n") {
+			if strings.HasPrefix(line, "go_broadcast_memory_heap_alloc_bytes ") {
+				var err error
+				heapBytes, err = strconv.ParseFloat(strings.TrimPrefix(line, "go_broadcast_memory_heap_alloc_bytes "), 64)
+				require.NoError(t, err)
+			}
+		}
+		assert.InDelta(t, heapMB*bytesPerMB, heapBytes, 1)
+	})
+}
+
+// TestPrometheusEndpoint tests that /metrics is only served when enabled
+func TestPrometheusEndpoint(t *testing.T) {
+	t.Run("Enabled", func(t *testing.T) {
+		config := DefaultDashboardConfig()
+		config.EnablePrometheus = true
+		dashboard := NewDashboard(config)
+		defer dashboard.collector.Stop()
+		dashboard.collector.updateMetrics()
+
+		req := httptest.NewRequestWithContext(context.Background(), "GET", "/metrics", nil)
+		w := httptest.NewRecorder()
+		dashboard.server.Handler.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, prometheusContentType, w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Body.String(), "go_broadcast_goroutines ")
+	})
+
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		dashboard := NewDashboard(DefaultDashboardConfig())
+		defer dashboard.collector.Stop()
+
+		req := httptest.NewRequestWithContext(context.Background(), "GET", "/metrics", nil)
+		w := httptest.NewRecorder()
+		dashboard.server.Handler.ServeHTTP(w, req)
+
+		assert.NotContains(t, w.Body.String(), "go_broadcast_goroutines")
+	})
+}
+
+// TestEscapeLabelValue tests label value escaping
+func TestEscapeLabelValue(t *testing.T) {
+	assert.Equal(t, `a\\b\"c\nd`, escapeLabelValue("a\\b\"c\nd"))
+}
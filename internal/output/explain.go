@@ -0,0 +1,72 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+)
+
+// Explain decisions of a target in the sync explanation
+const (
+	ExplainSync = "sync" // The target is synced
+	ExplainSkip = "skip" // The target is left alone
+)
+
+// ExplainRow is one target's line in the sync explanation
+type ExplainRow struct {
+	Target         string `json:"target"`
+	Group          string `json:"group,omitempty"`
+	Decision       string `json:"decision"` // ExplainSync or ExplainSkip
+	Reason         string `json:"reason"`
+	SourceCommit   string `json:"source_commit,omitempty"`
+	LastSyncCommit string `json:"last_sync_commit,omitempty"`
+}
+
+// ExplainTable prints rows to stdout as an aligned table of why each target
+// was or was not synced. The decision column is colored when color is enabled.
+func ExplainTable(rows []ExplainRow) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(rows) == 0 {
+		_, _ = fmt.Fprintln(stdout, "No targets were considered for sync")
+		return
+	}
+
+	// Both decisions are four characters with same-length color codes, so the
+	// colored first column keeps every row aligned
+	tw := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "DECISION\tTARGET\tGROUP\tREASON")
+	for _, row := range rows {
+		decision := row.Decision
+		switch row.Decision {
+		case ExplainSync:
+			decision = successColor.Sprint(row.Decision)
+		case ExplainSkip:
+			decision = warnColor.Sprint(row.Decision)
+		}
+		group := row.Group
+		if group == "" {
+			group = "-"
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", decision, row.Target, group, row.Reason)
+	}
+	_ = tw.Flush()
+}
+
+// ExplainJSON prints rows to stdout as a JSON array, the structured form of
+// ExplainTable
+func ExplainJSON(rows []ExplainRow) error {
+	if rows == nil {
+		rows = []ExplainRow{}
+	}
+	data, err := json.MarshalIndent(rows, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode sync explanation: %w", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	_, err = fmt.Fprintln(stdout, string(data))
+	return err
+}
@@ -0,0 +1,58 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// explainTestRows returns one row of each decision
+func explainTestRows() []ExplainRow {
+	return []ExplainRow{
+		{Target: "org/api", Group: "core", Decision: ExplainSync, Reason: "behind: last synced abc1234, source is at def5678", SourceCommit: "def5678", LastSyncCommit: "abc1234"},
+		{Target: "org/web", Decision: ExplainSkip, Reason: "group is disabled"},
+	}
+}
+
+func TestExplainTable(t *testing.T) {
+	originalNoColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = originalNoColor }()
+
+	scope := CaptureOutput()
+	defer scope.Restore()
+
+	ExplainTable(explainTestRows())
+
+	expected := "" +
+		"DECISION  TARGET   GROUP  REASON\n" +
+		"sync      org/api  core   behind: last synced abc1234, source is at def5678\n" +
+		"skip      org/web  -      group is disabled\n"
+	assert.Equal(t, expected, scope.Stdout.String())
+}
+
+func TestExplainTable_Empty(t *testing.T) {
+	scope := CaptureOutput()
+	defer scope.Restore()
+
+	ExplainTable(nil)
+	assert.Equal(t, "No targets were considered for sync\n", scope.Stdout.String())
+}
+
+func TestExplainJSON(t *testing.T) {
+	scope := CaptureOutput()
+	defer scope.Restore()
+
+	require.NoError(t, ExplainJSON(explainTestRows()))
+
+	var rows []ExplainRow
+	require.NoError(t, json.Unmarshal(scope.Stdout.Bytes(), &rows))
+	assert.Equal(t, explainTestRows(), rows)
+
+	scope.Stdout.Reset()
+	require.NoError(t, ExplainJSON(nil))
+	assert.JSONEq(t, "[]", scope.Stdout.String())
+}
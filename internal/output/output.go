@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/fatih/color"
+	"github.com/mattn/go-isatty"
 )
 
 // Writer defines the interface for output operations
@@ -128,6 +130,12 @@ var (
 	warnColor    = color.New(color.FgYellow)
 	errorColor   = color.New(color.FgRed, color.Bold)
 
+	// Diff line colors
+	diffHeaderColor = color.New(color.Bold)
+	diffAddColor    = color.New(color.FgGreen)
+	diffDelColor    = color.New(color.FgRed)
+	diffHunkColor   = color.New(color.FgCyan)
+
 	// Output writers
 	stdout io.Writer = os.Stdout
 	stderr io.Writer = os.Stderr
@@ -176,6 +184,9 @@ func Stderr() io.Writer {
 
 // Success prints a success message in green
 func Success(msg string) {
+	if quiet.Load() {
+		return
+	}
 	mu.Lock()
 	defer mu.Unlock()
 	_, _ = successColor.Fprintln(stdout, msg)
@@ -188,6 +199,9 @@ func Successf(format string, args ...interface{}) {
 
 // Info prints an info message in cyan
 func Info(msg string) {
+	if quiet.Load() {
+		return
+	}
 	mu.Lock()
 	defer mu.Unlock()
 	_, _ = infoColor.Fprintln(stdout, msg)
@@ -234,6 +248,48 @@ func Plainf(format string, args ...interface{}) {
 	Plain(fmt.Sprintf(format, args...))
 }
 
+// Diff prints a unified diff to stdout. The "---"/"+++" lines before the
+// first hunk are colored bold as file headers; every later line is colored
+// by its prefix alone, so a deleted line starting with "--" stays a deletion.
+func Diff(text string) {
+	inHeader := true
+	for _, line := range strings.Split(strings.TrimSuffix(text, "\n"), "\n") {
+		if strings.HasPrefix(line, "@@") {
+			inHeader = false
+		}
+		if inHeader && (strings.HasPrefix(line, "---") || strings.HasPrefix(line, "+++")) {
+			diffHeader(line)
+			continue
+		}
+		DiffLine(line)
+	}
+}
+
+// diffHeader prints a diff file header line in bold
+func diffHeader(line string) {
+	mu.Lock()
+	defer mu.Unlock()
+	_, _ = diffHeaderColor.Fprintln(stdout, line)
+}
+
+// DiffLine prints a single diff body line to stdout, coloring additions green,
+// deletions red and hunk headers cyan
+func DiffLine(line string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	switch {
+	case strings.HasPrefix(line, "+"):
+		_, _ = diffAddColor.Fprintln(stdout, line)
+	case strings.HasPrefix(line, "-"):
+		_, _ = diffDelColor.Fprintln(stdout, line)
+	case strings.HasPrefix(line, "@@"):
+		_, _ = diffHunkColor.Fprintln(stdout, line)
+	default:
+		_, _ = fmt.Fprintln(stdout, line)
+	}
+}
+
 // Scope provides test-isolated output capture.
 // It captures stdout and stderr in thread-safe buffers while the scope is active.
 // Call Restore() in defer to restore original writers.
@@ -349,3 +405,101 @@ func (p *Progress) StopWithError(msg string) {
 	p.Stop()
 	Error(msg)
 }
+
+//nolint:gochecknoglobals // Progress rendering is a process-wide setting like the output writers
+var progressDisabled atomic.Bool
+
+// SetProgressEnabled turns in-place file progress rendering on or off. The CLI
+// turns it off for JSON output so scripted runs only see structured lines.
+func SetProgressEnabled(enabled bool) {
+	progressDisabled.Store(!enabled)
+}
+
+//nolint:gochecknoglobals // Quiet mode is a process-wide setting like the output writers
+var quiet atomic.Bool
+
+// SetQuiet turns quiet mode on or off. In quiet mode Success and Info print
+// nothing, while warnings, errors and plain output still print. The CLI turns
+// it on for "sync --summary-only" so only the final summary reaches stdout.
+func SetQuiet(enabled bool) {
+	quiet.Store(enabled)
+}
+
+// IsTerminal reports whether w is a file attached to a terminal
+func IsTerminal(w io.Writer) bool {
+	file, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	fd := file.Fd()
+	return isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
+}
+
+// FileProgress renders a "file N of M" line on stderr that is rewritten in
+// place as files are processed. It renders nothing when stderr is not a
+// terminal or progress is disabled, and is safe for concurrent use.
+type FileProgress struct {
+	label   string
+	unit    string
+	total   int
+	out     io.Writer
+	enabled bool
+	drawn   bool
+	mu      sync.Mutex
+}
+
+// NewFileProgress creates a progress line for total files under label
+func NewFileProgress(label string, total int) *FileProgress {
+	out := Stderr()
+	return newFileProgress(label, total, out, !progressDisabled.Load() && IsTerminal(out))
+}
+
+// NewTargetProgress creates a "target N of M" progress line for total
+// target repositories under label
+func NewTargetProgress(label string, total int) *FileProgress {
+	p := NewFileProgress(label, total)
+	p.unit = "target"
+	return p
+}
+
+// newFileProgress creates a progress line writing to out when enabled
+func newFileProgress(label string, total int, out io.Writer, enabled bool) *FileProgress {
+	return &FileProgress{
+		label:   label,
+		unit:    "file",
+		total:   total,
+		out:     out,
+		enabled: enabled && total > 0,
+	}
+}
+
+// Update redraws the line for the current file (or target) number and path
+func (p *FileProgress) Update(current int, path string) {
+	if p == nil || !p.enabled {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	mu.Lock()
+	_, _ = fmt.Fprintf(p.out, "\r\033[K%s: %s %d of %d %s", p.label, p.unit, current, p.total, path)
+	mu.Unlock()
+	p.drawn = true
+}
+
+// Done clears the progress line. It is safe to call more than once.
+func (p *FileProgress) Done() {
+	if p == nil || !p.enabled {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.drawn {
+		return
+	}
+	mu.Lock()
+	_, _ = fmt.Fprint(p.out, "\r\033[K")
+	mu.Unlock()
+	p.drawn = false
+}
@@ -7,7 +7,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/fatih/color"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInit(_ *testing.T) {
@@ -167,6 +169,42 @@ func TestPlainf(t *testing.T) {
 	assert.Contains(t, output, "plain formatted 111")
 }
 
+func TestDiffLine(t *testing.T) {
+	scope := CaptureOutput()
+	defer scope.Restore()
+
+	lines := []string{"--- a/file.txt", "+++ b/file.txt", "@@ -1 +1 @@", "-old", "+new", " same"}
+	for _, line := range lines {
+		DiffLine(line)
+	}
+
+	output := scope.Stdout.String()
+	for _, line := range lines {
+		assert.Contains(t, output, line)
+	}
+	assert.Empty(t, scope.Stderr.String())
+}
+
+func TestDiff_ColorsHeadersOnlyBeforeFirstHunk(t *testing.T) {
+	prevNoColor := color.NoColor
+	color.NoColor = false
+	defer func() { color.NoColor = prevNoColor }()
+
+	scope := CaptureOutput()
+	defer scope.Restore()
+
+	Diff("--- a/notes.md\n+++ b/notes.md\n@@ -1,2 +1,2 @@\n--- old rule\n+++ new rule\n context\n")
+
+	lines := strings.Split(strings.TrimSuffix(scope.Stdout.String(), "\n"), "\n")
+	require.Len(t, lines, 6)
+	assert.Equal(t, diffHeaderColor.Sprint("--- a/notes.md"), lines[0])
+	assert.Equal(t, diffHeaderColor.Sprint("+++ b/notes.md"), lines[1])
+	assert.Equal(t, diffHunkColor.Sprint("@@ -1,2 +1,2 @@"), lines[2])
+	assert.Equal(t, diffDelColor.Sprint("--- old rule"), lines[3])
+	assert.Equal(t, diffAddColor.Sprint("+++ new rule"), lines[4])
+	assert.Equal(t, " context", lines[5])
+}
+
 func TestNewProgress(t *testing.T) {
 	progress := NewProgress("test message")
 
@@ -668,3 +706,88 @@ func TestCaptureOutput(t *testing.T) {
 		assert.Equal(t, originalStderr, Stderr())
 	})
 }
+
+func TestFileProgress(t *testing.T) {
+	t.Run("renders in place and clears when done", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		progress := newFileProgress("org/repo", 2, buf, true)
+
+		progress.Update(1, "a.txt")
+		progress.Update(2, "docs/b.md")
+		progress.Done()
+		progress.Done()
+
+		assert.Equal(t, "\r\033[Korg/repo: file 1 of 2 a.txt\r\033[Korg/repo: file 2 of 2 docs/b.md\r\033[K", buf.String())
+	})
+
+	t.Run("target progress counts targets", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		progress := newFileProgress("Discovering status", 200, buf, true)
+		progress.unit = "target"
+
+		progress.Update(3, "org/service-a")
+
+		assert.Equal(t, "\r\033[KDiscovering status: target 3 of 200 org/service-a", buf.String())
+		assert.Equal(t, "target", NewTargetProgress("status", 1).unit)
+	})
+
+	t.Run("disabled renders nothing", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		progress := newFileProgress("org/repo", 2, buf, false)
+
+		progress.Update(1, "a.txt")
+		progress.Done()
+
+		assert.Empty(t, buf.String())
+	})
+
+	t.Run("non-terminal stderr renders nothing", func(t *testing.T) {
+		scope := CaptureOutput()
+		defer scope.Restore()
+
+		progress := NewFileProgress("org/repo", 1)
+		progress.Update(1, "a.txt")
+		progress.Done()
+
+		assert.Empty(t, scope.Stderr.String())
+	})
+
+	t.Run("nil progress is a no-op", func(_ *testing.T) {
+		var progress *FileProgress
+		progress.Update(1, "a.txt")
+		progress.Done()
+	})
+}
+
+func TestSetProgressEnabled(t *testing.T) {
+	defer SetProgressEnabled(true)
+
+	SetProgressEnabled(false)
+	assert.True(t, progressDisabled.Load())
+
+	SetProgressEnabled(true)
+	assert.False(t, progressDisabled.Load())
+}
+
+func TestSetQuiet(t *testing.T) {
+	scope := CaptureOutput()
+	defer scope.Restore()
+	defer SetQuiet(false)
+
+	SetQuiet(true)
+	Success("quiet success")
+	Info("quiet info")
+	Plain("plain line")
+	Warn("loud warning")
+
+	assert.Equal(t, "plain line\n", scope.Stdout.String())
+	assert.Contains(t, scope.Stderr.String(), "loud warning")
+
+	SetQuiet(false)
+	Info("info again")
+	assert.Contains(t, scope.Stdout.String(), "info again")
+}
+
+func TestIsTerminal(t *testing.T) {
+	assert.False(t, IsTerminal(&bytes.Buffer{}))
+}
@@ -0,0 +1,104 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+)
+
+// Summary statuses of a target in the final sync summary
+const (
+	SummaryCreated   = "created"   // A pull request was created
+	SummaryUpdated   = "updated"   // An existing pull request was updated
+	SummaryUnchanged = "unchanged" // The target was already in sync
+	SummaryPushed    = "pushed"    // The sync branch was pushed without a pull request
+	SummaryPlanned   = "planned"   // A dry run found changes to sync
+	SummaryFailed    = "failed"    // The target failed to sync
+)
+
+// SummaryRow is one target's line in the final sync summary
+type SummaryRow struct {
+	Target       string `json:"target"`
+	Group        string `json:"group,omitempty"`
+	PRNumber     *int   `json:"pr_number,omitempty"`
+	PRURL        string `json:"pr_url,omitempty"`
+	FilesChanged int    `json:"files_changed"`
+	Status       string `json:"status"` // One of the Summary* values
+	Error        string `json:"error,omitempty"`
+}
+
+// summaryStatusColors colors the status column; other statuses print plain
+//
+//nolint:gochecknoglobals // Read-only color table like the other output colors
+var summaryStatusColors = map[string]*color.Color{
+	SummaryCreated: successColor,
+	SummaryUpdated: infoColor,
+	SummaryPushed:  infoColor,
+	SummaryPlanned: warnColor,
+	SummaryFailed:  errorColor,
+}
+
+// SummaryTable prints rows to stdout as an aligned table followed by a count
+// of targets per status. The status column is colored when color is enabled.
+func SummaryTable(rows []SummaryRow) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	// The status is the last column, so its color codes never skew the alignment
+	tw := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "TARGET\tPR\tFILES\tSTATUS")
+	counts := make(map[string]int)
+	for _, row := range rows {
+		status := row.Status
+		if c, ok := summaryStatusColors[row.Status]; ok {
+			status = c.Sprint(row.Status)
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%d\t%s\n", row.Target, summaryPR(row), row.FilesChanged, status)
+		counts[row.Status]++
+	}
+	_ = tw.Flush()
+
+	parts := make([]string, 0, len(counts))
+	for _, status := range []string{SummaryCreated, SummaryUpdated, SummaryPushed, SummaryPlanned, SummaryUnchanged, SummaryFailed} {
+		if counts[status] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[status], status))
+		}
+	}
+	summary := fmt.Sprintf("%d targets", len(rows))
+	if len(parts) > 0 {
+		summary += ": " + strings.Join(parts, ", ")
+	}
+	_, _ = fmt.Fprintf(stdout, "\n%s\n", summary)
+}
+
+// SummaryJSON prints rows to stdout as a JSON array, the structured form of
+// SummaryTable
+func SummaryJSON(rows []SummaryRow) error {
+	if rows == nil {
+		rows = []SummaryRow{}
+	}
+	data, err := json.MarshalIndent(rows, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode sync summary: %w", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	_, err = fmt.Fprintln(stdout, string(data))
+	return err
+}
+
+// summaryPR returns the PR column of row: its URL, else its number, else "-"
+func summaryPR(row SummaryRow) string {
+	switch {
+	case row.PRURL != "":
+		return row.PRURL
+	case row.PRNumber != nil:
+		return fmt.Sprintf("#%d", *row.PRNumber)
+	default:
+		return "-"
+	}
+}
@@ -0,0 +1,77 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// summaryTestRows returns one row of each PR column form
+func summaryTestRows() []SummaryRow {
+	prNumber := 12
+	return []SummaryRow{
+		{Target: "org/api", PRNumber: &prNumber, PRURL: "https://github.com/org/api/pull/12", FilesChanged: 3, Status: SummaryCreated},
+		{Target: "org/web-frontend", PRNumber: &prNumber, FilesChanged: 1, Status: SummaryUpdated},
+		{Target: "org/cli", Status: SummaryUnchanged},
+		{Target: "org/worker", Status: SummaryFailed, Error: "clone failed"},
+	}
+}
+
+func TestSummaryTable(t *testing.T) {
+	originalNoColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = originalNoColor }()
+
+	scope := CaptureOutput()
+	defer scope.Restore()
+
+	SummaryTable(summaryTestRows())
+
+	expected := "" +
+		"TARGET            PR                                  FILES  STATUS\n" +
+		"org/api           https://github.com/org/api/pull/12  3      created\n" +
+		"org/web-frontend  #12                                 1      updated\n" +
+		"org/cli           -                                   0      unchanged\n" +
+		"org/worker        -                                   0      failed\n" +
+		"\n" +
+		"4 targets: 1 created, 1 updated, 1 unchanged, 1 failed\n"
+	assert.Equal(t, expected, scope.Stdout.String())
+}
+
+func TestSummaryTable_Empty(t *testing.T) {
+	scope := CaptureOutput()
+	defer scope.Restore()
+
+	SummaryTable(nil)
+	assert.Contains(t, scope.Stdout.String(), "\n0 targets\n")
+}
+
+func TestSummaryTable_PrintsWhenQuiet(t *testing.T) {
+	scope := CaptureOutput()
+	defer scope.Restore()
+	defer SetQuiet(false)
+
+	SetQuiet(true)
+	SummaryTable(summaryTestRows())
+	assert.Contains(t, scope.Stdout.String(), "org/worker")
+}
+
+func TestSummaryJSON(t *testing.T) {
+	scope := CaptureOutput()
+	defer scope.Restore()
+
+	require.NoError(t, SummaryJSON(summaryTestRows()))
+
+	var rows []SummaryRow
+	require.NoError(t, json.Unmarshal(scope.Stdout.Bytes(), &rows))
+	assert.Equal(t, summaryTestRows(), rows)
+	assert.Contains(t, scope.Stdout.String(), `"status": "failed"`)
+	assert.Contains(t, scope.Stdout.String(), `"error": "clone failed"`)
+
+	scope.Stdout.Reset()
+	require.NoError(t, SummaryJSON(nil))
+	assert.Equal(t, "[]\n", scope.Stdout.String())
+}
@@ -0,0 +1,123 @@
+package reporting
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// runsPrometheusNamespace prefixes every metric RunSummary.WritePrometheus exports
+const runsPrometheusNamespace = "go_broadcast_"
+
+// RunSummary aggregates the metrics of many sync runs
+type RunSummary struct {
+	Runs          int                `json:"runs"`
+	FailedRuns    int                `json:"failed_runs"`
+	FailureRate   float64            `json:"failure_rate"`
+	AvgDurationMs float64            `json:"avg_duration_ms"`
+	LastRunAt     *time.Time         `json:"last_run_at,omitempty"`
+	Totals        map[string]float64 `json:"totals"`
+}
+
+// RunAggregator sums the metrics of sync runs as they are recorded. It is
+// safe for concurrent use.
+type RunAggregator struct {
+	mu        sync.Mutex
+	runs      int
+	failed    int
+	lastRunAt time.Time
+	totals    map[string]float64
+}
+
+// NewRunAggregator creates an aggregator with no runs recorded
+func NewRunAggregator() *RunAggregator {
+	return &RunAggregator{totals: make(map[string]float64)}
+}
+
+// Add records one run. values holds its numeric metrics keyed by name, as
+// returned by sync.RunMetrics.Values; each is summed into the totals, so
+// ratios should be left out. A "duration_ms" value feeds the average duration.
+func (a *RunAggregator) Add(values map[string]float64, failed bool, endedAt time.Time) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.runs++
+	if failed {
+		a.failed++
+	}
+	if endedAt.After(a.lastRunAt) {
+		a.lastRunAt = endedAt
+	}
+	for name, value := range values {
+		a.totals[name] += value
+	}
+}
+
+// Summary returns the aggregate of every run recorded so far
+func (a *RunAggregator) Summary() RunSummary {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	summary := RunSummary{
+		Runs:       a.runs,
+		FailedRuns: a.failed,
+		Totals:     make(map[string]float64, len(a.totals)),
+	}
+	for name, value := range a.totals {
+		summary.Totals[name] = value
+	}
+	if a.runs > 0 {
+		summary.FailureRate = float64(a.failed) / float64(a.runs)
+		summary.AvgDurationMs = a.totals["duration_ms"] / float64(a.runs)
+	}
+	if !a.lastRunAt.IsZero() {
+		lastRunAt := a.lastRunAt
+		summary.LastRunAt = &lastRunAt
+	}
+	return summary
+}
+
+// WritePrometheus writes the summary in Prometheus text exposition format.
+// Each total is exported as a go_broadcast_run_<name>_total counter, with
+// millisecond totals converted to seconds.
+func (s RunSummary) WritePrometheus(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	writeRunMetric(bw, "runs_total", "Sync runs recorded.", "counter", float64(s.Runs))
+	writeRunMetric(bw, "runs_failed_total", "Sync runs that ended with an error.", "counter", float64(s.FailedRuns))
+	writeRunMetric(bw, "run_failure_ratio", "Ratio of recorded sync runs that failed.", "gauge", s.FailureRate)
+	writeRunMetric(bw, "run_avg_duration_seconds", "Average duration of a sync run.", "gauge", s.AvgDurationMs/1000)
+	if s.LastRunAt != nil {
+		writeRunMetric(bw, "last_run_timestamp_seconds", "Time the most recent sync run ended.", "gauge",
+			float64(s.LastRunAt.UnixNano())/float64(time.Second))
+	}
+
+	names := make([]string, 0, len(s.Totals))
+	for name := range s.Totals {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value := s.Totals[name]
+		unit := ""
+		if base, ok := strings.CutSuffix(name, "_ms"); ok {
+			name, unit, value = base, "_seconds", value/1000
+		}
+		writeRunMetric(bw, "run_"+name+unit+"_total",
+			"Sum of "+strings.ReplaceAll(name, "_", " ")+" over all recorded sync runs.", "counter", value)
+	}
+
+	return bw.Flush()
+}
+
+// writeRunMetric writes the HELP, TYPE and sample lines of an unlabeled metric
+func writeRunMetric(w *bufio.Writer, name, help, kind string, value float64) {
+	_, _ = fmt.Fprintf(w, "# HELP %s%s %s\n", runsPrometheusNamespace, name, help)
+	_, _ = fmt.Fprintf(w, "# TYPE %s%s %s\n", runsPrometheusNamespace, name, kind)
+	_, _ = fmt.Fprintf(w, "%s%s %s\n", runsPrometheusNamespace, name, strconv.FormatFloat(value, 'g', -1, 64))
+}
@@ -0,0 +1,60 @@
+package reporting
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunAggregator(t *testing.T) {
+	aggregator := NewRunAggregator()
+	empty := aggregator.Summary()
+	require.Zero(t, empty.Runs)
+	require.Nil(t, empty.LastRunAt)
+
+	first := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	aggregator.Add(map[string]float64{"duration_ms": 1000, "pull_requests": 2}, false, first)
+	aggregator.Add(map[string]float64{"duration_ms": 3000, "pull_requests": 1}, true, first.Add(time.Hour))
+	aggregator.Add(map[string]float64{"duration_ms": 2000}, false, first.Add(-time.Hour))
+
+	summary := aggregator.Summary()
+	assert.Equal(t, 3, summary.Runs)
+	assert.Equal(t, 1, summary.FailedRuns)
+	assert.InDelta(t, 1.0/3, summary.FailureRate, 0.0001)
+	assert.InDelta(t, 2000.0, summary.AvgDurationMs, 0)
+	assert.InDelta(t, 3.0, summary.Totals["pull_requests"], 0)
+	require.NotNil(t, summary.LastRunAt)
+	assert.Equal(t, first.Add(time.Hour), *summary.LastRunAt)
+
+	// The summary is a copy
+	summary.Totals["pull_requests"] = 0
+	assert.InDelta(t, 3.0, aggregator.Summary().Totals["pull_requests"], 0)
+}
+
+func TestRunSummary_WritePrometheus(t *testing.T) {
+	lastRunAt := time.Unix(1700000000, 0)
+	summary := RunSummary{
+		Runs:          4,
+		FailedRuns:    1,
+		FailureRate:   0.25,
+		AvgDurationMs: 1500,
+		LastRunAt:     &lastRunAt,
+		Totals:        map[string]float64{"duration_ms": 6000, "pull_requests": 7},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, summary.WritePrometheus(&buf))
+	text := buf.String()
+
+	assert.Contains(t, text, "# TYPE go_broadcast_runs_total counter\ngo_broadcast_runs_total 4\n")
+	assert.Contains(t, text, "go_broadcast_runs_failed_total 1\n")
+	assert.Contains(t, text, "go_broadcast_run_failure_ratio 0.25\n")
+	assert.Contains(t, text, "go_broadcast_run_avg_duration_seconds 1.5\n")
+	assert.Contains(t, text, "go_broadcast_last_run_timestamp_seconds 1.7e+09\n")
+	assert.Contains(t, text, "# HELP go_broadcast_run_duration_seconds_total Sum of duration over all recorded sync runs.\n")
+	assert.Contains(t, text, "go_broadcast_run_duration_seconds_total 6\n")
+	assert.Contains(t, text, "go_broadcast_run_pull_requests_total 7\n")
+}
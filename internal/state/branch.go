@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 	"sync"
 	"time"
 )
@@ -13,11 +14,18 @@ var (
 	// invalidCharsPattern validates branch prefix characters
 	invalidCharsPattern = regexp.MustCompile(`[^a-zA-Z0-9/_-]`)
 
+	// defaultFormatPattern matches sync branches in the default format with any prefix
+	defaultFormatPattern = regexp.MustCompile(`-[a-zA-Z0-9_-]+-\d{8}-\d{6}-[a-fA-F0-9]+(-no-pr)?$`)
+
 	// branchPatternCache caches compiled regex patterns keyed by prefix
 	// to avoid recompilation on every parseSyncBranchNameWithPrefix call
 	branchPatternCache sync.Map //nolint:gochecknoglobals // intentional cache for performance
 )
 
+// NoPRBranchSuffix marks sync branches pushed by "sync --no-pr". Such
+// branches have no pull request by design and are not orphans.
+const NoPRBranchSuffix = "-no-pr"
+
 // Branch validation errors
 var (
 	ErrBranchPrefixEmpty   = errors.New("branch prefix cannot be empty")
@@ -33,9 +41,10 @@ func getBranchPattern(prefix string) *regexp.Regexp {
 		return cached.(*regexp.Regexp)
 	}
 
-	// Compile new pattern - Format: prefix-{groupID}-YYYYMMDD-HHMMSS-{commit}
+	// Compile new pattern - Format: prefix-{groupID}-YYYYMMDD-HHMMSS-{commit}[-no-pr]
 	escapedPrefix := regexp.QuoteMeta(prefix)
-	pattern := fmt.Sprintf(`^(%s)-([a-zA-Z0-9_-]+)-(\d{8})-(\d{6})-([a-fA-F0-9]+)$`, escapedPrefix)
+	pattern := fmt.Sprintf(`^(%s)-([a-zA-Z0-9_-]+)-(\d{8})-(\d{6})-([a-fA-F0-9]+)(%s)?$`,
+		escapedPrefix, regexp.QuoteMeta(NoPRBranchSuffix))
 	compiled := regexp.MustCompile(pattern)
 
 	// Store in cache (LoadOrStore handles race condition)
@@ -51,7 +60,7 @@ func parseSyncBranchName(name string) (*BranchMetadata, error) {
 
 // parseSyncBranchNameWithPrefix parses a branch name with a specific prefix to extract sync metadata
 func parseSyncBranchNameWithPrefix(name, prefix string) (*BranchMetadata, error) {
-	// Format: prefix-{groupID}-YYYYMMDD-HHMMSS-{commit}
+	// Format: prefix-{groupID}-YYYYMMDD-HHMMSS-{commit}[-no-pr]
 	branchPattern := getBranchPattern(prefix)
 
 	matches := branchPattern.FindStringSubmatch(name)
@@ -66,6 +75,7 @@ func parseSyncBranchNameWithPrefix(name, prefix string) (*BranchMetadata, error)
 	dateStr := matches[3]
 	timeStr := matches[4]
 	commitSHA := matches[5]
+	noPR := matches[6] != ""
 
 	// Parse timestamp
 	timestampStr := fmt.Sprintf("%s%s", dateStr, timeStr)
@@ -79,6 +89,7 @@ func parseSyncBranchNameWithPrefix(name, prefix string) (*BranchMetadata, error)
 		CommitSHA: commitSHA,
 		Prefix:    extractedPrefix,
 		GroupID:   groupID,
+		NoPR:      noPR,
 	}, nil
 }
 
@@ -93,6 +104,17 @@ func FormatSyncBranchName(prefix, groupID string, timestamp time.Time, commitSHA
 	)
 }
 
+// isDefaultFormatBranch reports whether name has the default sync branch
+// format, whatever its prefix
+func isDefaultFormatBranch(name string) bool {
+	return defaultFormatPattern.MatchString(name)
+}
+
+// IsNoPRBranch reports whether name is a sync branch pushed without a pull request
+func IsNoPRBranch(name string) bool {
+	return strings.HasSuffix(name, NoPRBranchSuffix)
+}
+
 // ValidateBranchPrefix checks if a branch prefix is valid
 func ValidateBranchPrefix(prefix string) error {
 	if prefix == "" {
@@ -281,6 +281,19 @@ func TestParseSyncBranchNameWithPrefix(t *testing.T) {
 				GroupID:   "test",
 			},
 		},
+		{
+			name:       "branch pushed without a PR",
+			branchName: "sync/deploy-prod-20240115-120530-abc123def-no-pr",
+			prefix:     "sync/deploy",
+			expectNil:  false,
+			expected: &BranchMetadata{
+				Timestamp: time.Date(2024, 1, 15, 12, 5, 30, 0, time.UTC),
+				CommitSHA: "abc123def",
+				Prefix:    "sync/deploy",
+				GroupID:   "prod",
+				NoPR:      true,
+			},
+		},
 		{
 			name:        "wrong prefix - branch doesn't match",
 			branchName:  "chore/sync-files-default-20240115-120530-abc123def",
@@ -0,0 +1,274 @@
+package state
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+// stateCacheVersion is the on-disk cache schema version. Bump it whenever
+// State or stateCacheEntry change shape; entries written under any other
+// version are treated as misses and overwritten.
+const stateCacheVersion = 2
+
+// DefaultStateCacheTTL is how long a cached state stays fresh when no TTL is given
+const DefaultStateCacheTTL = 10 * time.Minute
+
+// errStateCacheMiss indicates no usable cache entry exists for a config
+var errStateCacheMiss = errors.New("state cache miss")
+
+// CacheInvalidator is implemented by discoverers that cache state and can drop
+// the cached entry for a config, e.g. after a sync wrote to its targets
+type CacheInvalidator interface {
+	InvalidateState(cfg *config.Config) error
+}
+
+// journalingDiscoverer is implemented by discoverers that can resume from,
+// and record into, a discovery journal
+type journalingDiscoverer interface {
+	discoverState(ctx context.Context, cfg *config.Config, journal *discoveryJournal) (*State, error)
+}
+
+// stateCacheEntry is the on-disk representation of a cached discovery
+type stateCacheEntry struct {
+	Version  int       `json:"version"`
+	CachedAt time.Time `json:"cached_at"`
+	State    *State    `json:"state"`
+}
+
+// cachingDiscoverer wraps a Discoverer with an on-disk state cache keyed by
+// the discovery-relevant parts of the config. A cached state is reused while
+// it is younger than the TTL and every group source still points at the
+// commit it was discovered from; checking that costs one API call per source
+// instead of a full scan of every target.
+type cachingDiscoverer struct {
+	Discoverer
+
+	gh     gh.Client
+	dir    string
+	ttl    time.Duration
+	logger *logrus.Logger
+	now    func() time.Time
+}
+
+// NewCachingDiscoverer wraps inner with an on-disk state cache stored in dir.
+// A non-positive ttl uses DefaultStateCacheTTL.
+func NewCachingDiscoverer(inner Discoverer, ghClient gh.Client, dir string, ttl time.Duration, logger *logrus.Logger) Discoverer {
+	if ttl <= 0 {
+		ttl = DefaultStateCacheTTL
+	}
+	if logger == nil {
+		logger = logrus.StandardLogger()
+	}
+	return &cachingDiscoverer{
+		Discoverer: inner,
+		gh:         ghClient,
+		dir:        dir,
+		ttl:        ttl,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// DiscoverState returns the cached state when it is still valid, otherwise
+// discovers it through the wrapped discoverer and refreshes the cache.
+// Cache read and write failures are logged and never fail discovery.
+func (c *cachingDiscoverer) DiscoverState(ctx context.Context, cfg *config.Config) (*State, error) {
+	log := c.logger.WithField("component", "state_cache")
+
+	path, err := c.entryPath(cfg)
+	if err != nil {
+		log.WithError(err).Debug("State cache key unavailable, discovering without cache")
+		return c.Discoverer.DiscoverState(ctx, cfg)
+	}
+
+	cached, err := c.load(ctx, path)
+	if err == nil {
+		log.WithField("path", path).Info("Using cached sync state (sources unchanged)")
+		return cached, nil
+	}
+	log.WithError(err).Debug("State cache not used")
+
+	discovered, err := c.discover(ctx, cfg, path, log)
+	if err != nil {
+		return nil, err
+	}
+
+	if saveErr := c.save(path, discovered); saveErr != nil {
+		log.WithError(saveErr).Warn("Failed to write state cache")
+	}
+	return discovered, nil
+}
+
+// discover runs the wrapped discoverer. When it supports a journal, target
+// states are journaled next to the cache entry at path as they are found, so
+// a discovery that fails part way resumes from them on the next run.
+func (c *cachingDiscoverer) discover(ctx context.Context, cfg *config.Config, path string, log *logrus.Entry) (*State, error) {
+	inner, ok := c.Discoverer.(journalingDiscoverer)
+	if !ok {
+		return c.Discoverer.DiscoverState(ctx, cfg)
+	}
+
+	journal, err := openDiscoveryJournal(journalPath(path), c.ttl, c.now)
+	if err != nil {
+		log.WithError(err).Warn("Failed to open discovery journal, discovering without it")
+		return c.Discoverer.DiscoverState(ctx, cfg)
+	}
+	if resumed := len(journal.records); resumed > 0 {
+		log.WithField("targets", resumed).Info("Resuming interrupted state discovery")
+	}
+
+	discovered, err := inner.discoverState(ctx, cfg, journal)
+	if closeErr := journal.close(err == nil); closeErr != nil {
+		log.WithError(closeErr).Debug("Failed to close discovery journal")
+	}
+	return discovered, err
+}
+
+// InvalidateState removes the cached state for cfg, if any, along with the
+// journal of an interrupted discovery
+func (c *cachingDiscoverer) InvalidateState(cfg *config.Config) error {
+	path, err := c.entryPath(cfg)
+	if err != nil {
+		return err
+	}
+	for _, file := range []string{path, journalPath(path)} {
+		if err := os.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("remove state cache entry: %w", err)
+		}
+	}
+	return nil
+}
+
+// journalPath returns the discovery journal kept beside the cache entry at path
+func journalPath(path string) string {
+	return strings.TrimSuffix(path, ".json") + ".journal"
+}
+
+// load reads the cache entry at path and verifies it is current: same schema
+// version, within the TTL, and no group source has moved since it was cached.
+// State with a local source is never reused, as its files change without commits.
+func (c *cachingDiscoverer) load(ctx context.Context, path string) (*State, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // path is derived from the cache dir and a hash
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, errStateCacheMiss
+		}
+		return nil, err
+	}
+
+	var entry stateCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("%w: unreadable entry: %w", errStateCacheMiss, err)
+	}
+	if entry.Version != stateCacheVersion || entry.State == nil {
+		return nil, fmt.Errorf("%w: schema version %d, want %d", errStateCacheMiss, entry.Version, stateCacheVersion)
+	}
+	if age := c.now().Sub(entry.CachedAt); age > c.ttl {
+		return nil, fmt.Errorf("%w: entry expired %s ago", errStateCacheMiss, (age - c.ttl).Round(time.Second))
+	}
+
+	if len(entry.State.Sources) == 0 {
+		return nil, fmt.Errorf("%w: entry has no sources", errStateCacheMiss)
+	}
+	for key, source := range entry.State.Sources {
+		if config.IsLocalSource(source.Repo) {
+			return nil, fmt.Errorf("%w: source %s is a local directory", errStateCacheMiss, key)
+		}
+		branch, err := c.gh.GetBranch(ctx, source.Repo, source.Branch)
+		if err != nil {
+			return nil, fmt.Errorf("verify source %s: %w", key, err)
+		}
+		if branch == nil || branch.Commit.SHA != source.LatestCommit {
+			return nil, fmt.Errorf("%w: source %s has new commits", errStateCacheMiss, key)
+		}
+	}
+
+	return entry.State, nil
+}
+
+// save writes the discovered state to path atomically
+func (c *cachingDiscoverer) save(path string, discovered *State) error {
+	data, err := json.Marshal(stateCacheEntry{
+		Version:  stateCacheVersion,
+		CachedAt: c.now(),
+		State:    discovered,
+	})
+	if err != nil {
+		return fmt.Errorf("encode state cache entry: %w", err)
+	}
+
+	if err := os.MkdirAll(c.dir, 0o750); err != nil {
+		return fmt.Errorf("create state cache dir: %w", err)
+	}
+	tmp, err := os.CreateTemp(c.dir, ".state-*.tmp")
+	if err != nil {
+		return fmt.Errorf("create state cache file: %w", err)
+	}
+	tmpName := tmp.Name()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpName)
+		return fmt.Errorf("write state cache file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmpName)
+		return fmt.Errorf("close state cache file: %w", err)
+	}
+	if err := os.Rename(tmpName, path); err != nil {
+		_ = os.Remove(tmpName)
+		return fmt.Errorf("replace state cache file: %w", err)
+	}
+	return nil
+}
+
+// entryPath returns the cache file for cfg. The key covers everything that
+// changes what DiscoverState looks at, so editing sources, targets, branches
+// or branch prefixes starts a fresh entry.
+func (c *cachingDiscoverer) entryPath(cfg *config.Config) (string, error) {
+	type targetKey struct {
+		Repo   string `json:"repo"`
+		Branch string `json:"branch,omitempty"`
+	}
+	type groupKey struct {
+		ID           string      `json:"id"`
+		SourceRepo   string      `json:"source_repo"`
+		SourceBranch string      `json:"source_branch"`
+		BranchPrefix string      `json:"branch_prefix"`
+		Targets      []targetKey `json:"targets"`
+	}
+
+	groups := config.ExpandSourceBranches(cfg.Groups)
+	keys := make([]groupKey, 0, len(groups))
+	for _, group := range groups {
+		gk := groupKey{
+			ID:           group.ID,
+			SourceRepo:   group.Source.Repo,
+			SourceBranch: group.Source.Branch,
+			BranchPrefix: group.Defaults.BranchPrefix,
+		}
+		for _, target := range group.Targets {
+			gk.Targets = append(gk.Targets, targetKey{Repo: target.Repo, Branch: target.Branch})
+		}
+		keys = append(keys, gk)
+	}
+
+	data, err := json.Marshal(keys)
+	if err != nil {
+		return "", fmt.Errorf("encode state cache key: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return filepath.Join(c.dir, "state-"+hex.EncodeToString(sum[:8])+".json"), nil
+}
@@ -0,0 +1,279 @@
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+func cacheTestConfig() *config.Config {
+	return &config.Config{
+		Version: 1,
+		Groups: []config.Group{{
+			ID:      "core",
+			Source:  config.SourceConfig{Repo: "org/template", Branch: "master"},
+			Targets: []config.TargetConfig{{Repo: "org/service-a"}},
+		}},
+	}
+}
+
+func cacheTestState(commit string) *State {
+	source := SourceState{Repo: "org/template", Branch: "master", LatestCommit: commit}
+	pr := gh.PR{Number: 7, State: "open"}
+	pr.Head.Ref = "chore/sync-files-core-20250101-120000-abc123"
+	return &State{
+		Source:  source,
+		Sources: map[string]SourceState{SourceKey("org/template", "master"): source},
+		Targets: map[string]*TargetState{
+			"org/service-a": {Repo: "org/service-a", Status: StatusPending, OpenPRs: []gh.PR{pr}},
+		},
+	}
+}
+
+func newTestCachingDiscoverer(t *testing.T, inner Discoverer, ghClient gh.Client) *cachingDiscoverer {
+	t.Helper()
+	discoverer, ok := NewCachingDiscoverer(inner, ghClient, t.TempDir(), time.Minute, logrus.New()).(*cachingDiscoverer)
+	require.True(t, ok)
+	return discoverer
+}
+
+func mockSourceBranch(ghClient *gh.MockClient, commit string) {
+	branch := &gh.Branch{Name: "master"}
+	branch.Commit.SHA = commit
+	ghClient.On("GetBranch", mock.Anything, "org/template", "master").Return(branch, nil)
+}
+
+func TestCachingDiscoverer_ReusesStateWhileSourceUnchanged(t *testing.T) {
+	ctx := context.Background()
+	cfg := cacheTestConfig()
+
+	inner := NewMockDiscoverer()
+	inner.On("DiscoverState", ctx, cfg).Return(cacheTestState("abc123"), nil).Once()
+	ghClient := gh.NewMockClient()
+	mockSourceBranch(ghClient, "abc123")
+
+	discoverer := newTestCachingDiscoverer(t, inner, ghClient)
+
+	first, err := discoverer.DiscoverState(ctx, cfg)
+	require.NoError(t, err)
+
+	second, err := discoverer.DiscoverState(ctx, cfg)
+	require.NoError(t, err)
+
+	inner.AssertNumberOfCalls(t, "DiscoverState", 1)
+	assert.Equal(t, first.Source.LatestCommit, second.Source.LatestCommit)
+	require.Contains(t, second.Targets, "org/service-a")
+	assert.Equal(t, StatusPending, second.Targets["org/service-a"].Status)
+	require.Len(t, second.Targets["org/service-a"].OpenPRs, 1)
+	assert.Equal(t, 7, second.Targets["org/service-a"].OpenPRs[0].Number)
+}
+
+func TestCachingDiscoverer_RefreshesWhenSourceMoves(t *testing.T) {
+	ctx := context.Background()
+	cfg := cacheTestConfig()
+
+	inner := NewMockDiscoverer()
+	inner.On("DiscoverState", ctx, cfg).Return(cacheTestState("abc123"), nil).Once()
+	inner.On("DiscoverState", ctx, cfg).Return(cacheTestState("def456"), nil).Once()
+	ghClient := gh.NewMockClient()
+	mockSourceBranch(ghClient, "def456")
+
+	discoverer := newTestCachingDiscoverer(t, inner, ghClient)
+
+	_, err := discoverer.DiscoverState(ctx, cfg)
+	require.NoError(t, err)
+	refreshed, err := discoverer.DiscoverState(ctx, cfg)
+	require.NoError(t, err)
+
+	inner.AssertNumberOfCalls(t, "DiscoverState", 2)
+	assert.Equal(t, "def456", refreshed.Source.LatestCommit)
+}
+
+func TestCachingDiscoverer_Expiry(t *testing.T) {
+	ctx := context.Background()
+	cfg := cacheTestConfig()
+
+	inner := NewMockDiscoverer()
+	inner.On("DiscoverState", ctx, cfg).Return(cacheTestState("abc123"), nil)
+	ghClient := gh.NewMockClient()
+	mockSourceBranch(ghClient, "abc123")
+
+	discoverer := newTestCachingDiscoverer(t, inner, ghClient)
+	now := time.Now()
+	discoverer.now = func() time.Time { return now }
+
+	_, err := discoverer.DiscoverState(ctx, cfg)
+	require.NoError(t, err)
+
+	now = now.Add(2 * time.Minute)
+	_, err = discoverer.DiscoverState(ctx, cfg)
+	require.NoError(t, err)
+
+	inner.AssertNumberOfCalls(t, "DiscoverState", 2)
+	ghClient.AssertNotCalled(t, "GetBranch", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCachingDiscoverer_IgnoresOtherSchemaVersions(t *testing.T) {
+	ctx := context.Background()
+	cfg := cacheTestConfig()
+
+	inner := NewMockDiscoverer()
+	inner.On("DiscoverState", ctx, cfg).Return(cacheTestState("abc123"), nil)
+	ghClient := gh.NewMockClient()
+	mockSourceBranch(ghClient, "abc123")
+
+	discoverer := newTestCachingDiscoverer(t, inner, ghClient)
+	path, err := discoverer.entryPath(cfg)
+	require.NoError(t, err)
+
+	stale, err := json.Marshal(stateCacheEntry{Version: stateCacheVersion + 1, CachedAt: time.Now(), State: cacheTestState("abc123")})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, stale, 0o600))
+
+	_, err = discoverer.DiscoverState(ctx, cfg)
+	require.NoError(t, err)
+	inner.AssertNumberOfCalls(t, "DiscoverState", 1)
+
+	// The entry was rewritten under the current version
+	data, err := os.ReadFile(path) //nolint:gosec // test temp dir
+	require.NoError(t, err)
+	var entry stateCacheEntry
+	require.NoError(t, json.Unmarshal(data, &entry))
+	assert.Equal(t, stateCacheVersion, entry.Version)
+}
+
+func TestCachingDiscoverer_InvalidateState(t *testing.T) {
+	ctx := context.Background()
+	cfg := cacheTestConfig()
+
+	inner := NewMockDiscoverer()
+	inner.On("DiscoverState", ctx, cfg).Return(cacheTestState("abc123"), nil)
+	ghClient := gh.NewMockClient()
+	mockSourceBranch(ghClient, "abc123")
+
+	discoverer := newTestCachingDiscoverer(t, inner, ghClient)
+
+	_, err := discoverer.DiscoverState(ctx, cfg)
+	require.NoError(t, err)
+	require.NoError(t, discoverer.InvalidateState(cfg))
+	require.NoError(t, discoverer.InvalidateState(cfg), "missing entry is not an error")
+
+	_, err = discoverer.DiscoverState(ctx, cfg)
+	require.NoError(t, err)
+	inner.AssertNumberOfCalls(t, "DiscoverState", 2)
+}
+
+func TestCachingDiscoverer_EntryPathTracksConfig(t *testing.T) {
+	discoverer := newTestCachingDiscoverer(t, NewMockDiscoverer(), gh.NewMockClient())
+
+	base, err := discoverer.entryPath(cacheTestConfig())
+	require.NoError(t, err)
+	assert.Equal(t, discoverer.dir, filepath.Dir(base))
+
+	same, err := discoverer.entryPath(cacheTestConfig())
+	require.NoError(t, err)
+	assert.Equal(t, base, same)
+
+	moreTargets := cacheTestConfig()
+	moreTargets.Groups[0].Targets = append(moreTargets.Groups[0].Targets, config.TargetConfig{Repo: "org/service-b"})
+	other, err := discoverer.entryPath(moreTargets)
+	require.NoError(t, err)
+	assert.NotEqual(t, base, other)
+
+	newPrefix := cacheTestConfig()
+	newPrefix.Groups[0].Defaults.BranchPrefix = "chore/custom"
+	other, err = discoverer.entryPath(newPrefix)
+	require.NoError(t, err)
+	assert.NotEqual(t, base, other)
+}
+
+func TestCachingDiscoverer_ResumesInterruptedDiscovery(t *testing.T) {
+	ctx := context.Background()
+	cfg := cacheTestConfig()
+	cfg.Groups[0].Targets = append(cfg.Groups[0].Targets, config.TargetConfig{Repo: "org/service-b"})
+
+	ghClient := gh.NewMockClient()
+	mockSourceBranch(ghClient, "abc123")
+	ghClient.On("ListBranches", mock.Anything, "org/service-a").Return([]gh.Branch{{Name: "master"}}, nil).Once()
+	ghClient.On("ListPRs", mock.Anything, "org/service-a", "open").Return([]gh.PR{}, nil).Once()
+	ghClient.On("ListBranches", mock.Anything, "org/service-b").Return(nil, gh.ErrRateLimited).Once()
+	ghClient.On("ListBranches", mock.Anything, "org/service-b").Return([]gh.Branch{{Name: "master"}}, nil).Once()
+	ghClient.On("ListPRs", mock.Anything, "org/service-b", "open").Return([]gh.PR{}, nil).Once()
+
+	discoverer := newTestCachingDiscoverer(t, NewDiscoverer(ghClient, logrus.New(), nil), ghClient)
+	entry, err := discoverer.entryPath(cfg)
+	require.NoError(t, err)
+
+	_, err = discoverer.DiscoverState(ctx, cfg)
+	require.ErrorIs(t, err, gh.ErrRateLimited)
+	assert.FileExists(t, journalPath(entry))
+
+	// The second run only queries the target the first run did not finish
+	discovered, err := discoverer.DiscoverState(ctx, cfg)
+	require.NoError(t, err)
+	assert.Len(t, discovered.Targets, 2)
+	ghClient.AssertExpectations(t)
+	assert.NoFileExists(t, journalPath(entry))
+	assert.FileExists(t, entry)
+}
+
+func TestCachingDiscoverer_InvalidateStateDropsJournal(t *testing.T) {
+	ctx := context.Background()
+	cfg := cacheTestConfig()
+
+	ghClient := gh.NewMockClient()
+	mockSourceBranch(ghClient, "abc123")
+	ghClient.On("ListBranches", mock.Anything, "org/service-a").Return(nil, gh.ErrRateLimited)
+
+	discoverer := newTestCachingDiscoverer(t, NewDiscoverer(ghClient, logrus.New(), nil), ghClient)
+	entry, err := discoverer.entryPath(cfg)
+	require.NoError(t, err)
+
+	_, err = discoverer.DiscoverState(ctx, cfg)
+	require.Error(t, err)
+	require.FileExists(t, journalPath(entry))
+
+	require.NoError(t, discoverer.InvalidateState(cfg))
+	assert.NoFileExists(t, journalPath(entry))
+}
+
+func TestDiscoveryJournal_SkipsExpiredAndCorruptRecords(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.journal")
+	now := time.Now()
+	clock := func() time.Time { return now }
+
+	journal, err := openDiscoveryJournal(path, time.Minute, clock)
+	require.NoError(t, err)
+	require.NoError(t, journal.record("old", &TargetState{Repo: "org/old"}))
+	now = now.Add(2 * time.Minute)
+	require.NoError(t, journal.record("new", &TargetState{Repo: "org/new"}))
+	_, err = journal.file.WriteString(`{"version":`) // Cut short by an interrupted write
+	require.NoError(t, err)
+	require.NoError(t, journal.close(false))
+
+	reopened, err := openDiscoveryJournal(path, time.Minute, clock)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = reopened.close(true) })
+
+	_, ok := reopened.lookup("old")
+	assert.False(t, ok)
+	target, ok := reopened.lookup("new")
+	require.True(t, ok)
+	assert.Equal(t, "org/new", target.Repo)
+
+	var nilJournal *discoveryJournal
+	_, ok = nilJournal.lookup("new")
+	assert.False(t, ok)
+	assert.NoError(t, nilJournal.record("new", target))
+}
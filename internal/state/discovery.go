@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/mrz1836/go-broadcast/internal/config"
 	"github.com/mrz1836/go-broadcast/internal/gh"
@@ -22,9 +24,41 @@ var ErrSourceStateNotFound = errors.New("internal error: source state not found"
 
 // discoveryService implements the Discoverer interface
 type discoveryService struct {
-	gh        gh.Client
-	logger    *logrus.Logger
-	logConfig *logging.LogConfig
+	gh          gh.Client
+	logger      *logrus.Logger
+	logConfig   *logging.LogConfig
+	concurrency int
+	progress    func(done, total int, repo string)
+	secret      []byte
+}
+
+// DiscovererOption configures a discoverer created by NewDiscoverer
+type DiscovererOption func(*discoveryService)
+
+// WithDiscoveryConcurrency discovers up to n target repositories at once.
+// Values below 2 discover targets one at a time, which is the default.
+func WithDiscoveryConcurrency(n int) DiscovererOption {
+	return func(d *discoveryService) {
+		d.concurrency = n
+	}
+}
+
+// WithDiscoveryProgress calls fn each time a target repository has been
+// discovered, with the number of targets done so far and the total. Calls
+// are serialized, so fn need not be safe for concurrent use.
+func WithDiscoveryProgress(fn func(done, total int, repo string)) DiscovererOption {
+	return func(d *discoveryService) {
+		d.progress = fn
+	}
+}
+
+// WithMetadataSecret verifies the signature of each sync PR's metadata block
+// with secret. Metadata that is unsigned or does not match its signature is
+// recorded in TargetState.UntrustedPRs and not used for the sync state.
+func WithMetadataSecret(secret []byte) DiscovererOption {
+	return func(d *discoveryService) {
+		d.secret = secret
+	}
 }
 
 // NewDiscoverer creates a new state discoverer.
@@ -33,20 +67,25 @@ type discoveryService struct {
 // - ghClient: GitHub client for API operations (must not be nil)
 // - logger: Logger instance for general logging
 // - logConfig: Configuration for debug logging and verbose settings
+// - opts: Optional settings such as WithDiscoveryConcurrency
 //
 // Returns:
 // - Discoverer interface implementation for state discovery operations
 //
 // Panics if ghClient is nil to catch programming errors early.
-func NewDiscoverer(ghClient gh.Client, logger *logrus.Logger, logConfig *logging.LogConfig) Discoverer {
+func NewDiscoverer(ghClient gh.Client, logger *logrus.Logger, logConfig *logging.LogConfig, opts ...DiscovererOption) Discoverer {
 	if ghClient == nil {
 		panic("state.NewDiscoverer: ghClient cannot be nil")
 	}
-	return &discoveryService{
+	d := &discoveryService{
 		gh:        ghClient,
 		logger:    logger,
 		logConfig: logConfig,
 	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
 }
 
 // DiscoverState discovers the complete sync state by examining GitHub with comprehensive debug logging support.
@@ -67,6 +106,13 @@ func NewDiscoverer(ghClient gh.Client, logger *logrus.Logger, logConfig *logging
 // - Logs detailed discovery progress when --debug-state flag is enabled
 // - Records discovery timing and repository analysis metrics
 func (d *discoveryService) DiscoverState(ctx context.Context, cfg *config.Config) (*State, error) {
+	return d.discoverState(ctx, cfg, nil)
+}
+
+// discoverState implements DiscoverState. Targets found in journal are
+// reused instead of queried again, and newly discovered ones are recorded in
+// it; a nil journal queries every target.
+func (d *discoveryService) discoverState(ctx context.Context, cfg *config.Config, journal *discoveryJournal) (*State, error) {
 	logger := logging.WithStandardFields(d.logger, d.logConfig, logging.ComponentNames.State)
 	start := time.Now()
 
@@ -74,7 +120,7 @@ func (d *discoveryService) DiscoverState(ctx context.Context, cfg *config.Config
 	if len(cfg.Groups) == 0 {
 		return nil, ErrNoGroupsFound
 	}
-	groups := cfg.Groups
+	groups := config.ExpandSourceBranches(cfg.Groups)
 
 	// Debug logging when --debug-state flag is enabled
 	totalTargets := 0
@@ -112,11 +158,26 @@ func (d *discoveryService) DiscoverState(ctx context.Context, cfg *config.Config
 		logger.WithField(logging.StandardFields.TargetCount, totalTargets).Debug("Starting target repository discovery")
 	}
 
-	targetStates := make(map[string]*TargetState)
+	// Each group's targets are discovered as soon as its source is known, with
+	// up to d.concurrency at once. Results are kept in config order so that a
+	// repository targeted by several groups ends up with the last group's state.
+	results := make([]*TargetState, totalTargets)
+	var progressMu sync.Mutex
+	done := 0
 	targetIndex := 0
 
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(max(d.concurrency, 1))
+	// Never leave target discovery running when returning early
+	defer func() { _ = g.Wait() }()
+
 	// Iterate through all groups to find all targets
 	for groupIdx, group := range groups {
+		// Stop submitting targets once one has failed; g.Wait reports why
+		if gctx.Err() != nil && ctx.Err() == nil {
+			break
+		}
+
 		// Check context before each group to avoid unnecessary API calls
 		if err := ctx.Err(); err != nil {
 			return nil, fmt.Errorf("context canceled before processing group %s: %w", group.ID, err)
@@ -134,7 +195,7 @@ func (d *discoveryService) DiscoverState(ctx context.Context, cfg *config.Config
 		}
 
 		// Discover source state for this group if not already done
-		sourceKey := group.Source.Repo + ":" + group.Source.Branch
+		sourceKey := SourceKey(group.Source.Repo, group.Source.Branch)
 		if _, exists := sourceMap[sourceKey]; !exists {
 			if d.logConfig != nil && d.logConfig.Debug.State {
 				logger.WithFields(logrus.Fields{
@@ -145,7 +206,17 @@ func (d *discoveryService) DiscoverState(ctx context.Context, cfg *config.Config
 			}
 
 			sourceStart := time.Now()
-			sourceBranch, err := d.gh.GetBranch(ctx, group.Source.Repo, group.Source.Branch)
+			var sourceBranch *gh.Branch
+			var err error
+			if group.Source.IsLocal() {
+				logger.WithFields(logrus.Fields{
+					"source_path": config.LocalSourcePath(group.Source.Repo),
+					"group_name":  group.Name,
+				}).Info("Using local source directory instead of a GitHub repository")
+				sourceBranch, err = localSourceBranch(ctx, group.Source.Repo)
+			} else {
+				sourceBranch, err = d.gh.GetBranch(ctx, group.Source.Repo, group.Source.Branch)
+			}
 			sourceDuration := time.Since(sourceStart)
 
 			if err != nil {
@@ -198,72 +269,62 @@ func (d *discoveryService) DiscoverState(ctx context.Context, cfg *config.Config
 			}
 		}
 
-		for i, target := range group.Targets {
-			// Check for context cancellation
-			select {
-			case <-ctx.Done():
-				return nil, fmt.Errorf("target discovery canceled: %w", ctx.Err())
-			default:
-			}
-
-			targetLogger := logger
-			if d.logConfig != nil && d.logConfig.Debug.State {
-				targetLogger = logger.WithFields(logrus.Fields{
-					"target_index":                    targetIndex,
-					"group_index":                     groupIdx,
-					"group_target_index":              i,
-					"group_name":                      group.Name,
-					logging.StandardFields.TargetRepo: target.Repo,
-				})
-				targetLogger.Trace("Discovering target repository state")
-			} else {
-				logger.WithField("repo", target.Repo).Debug("Discovering target state")
-			}
+		// Determine sync status based on this group's source and target state
+		groupSourceState, exists := sourceMap[sourceKey]
+		if !exists {
+			// This indicates a programming error - source should have been discovered
+			return nil, fmt.Errorf("key %q in group %q: %w", sourceKey, group.Name, ErrSourceStateNotFound)
+		}
 
-			targetStart := time.Now()
-			branchPrefix := group.Defaults.BranchPrefix
-			if branchPrefix == "" {
-				branchPrefix = "chore/sync-files" // Default fallback
+		branchPrefix := group.Defaults.BranchPrefix
+		if branchPrefix == "" {
+			branchPrefix = "chore/sync-files" // Default fallback
+		}
+		for i, target := range group.Targets {
+			job := targetJob{
+				index:        targetIndex,
+				groupIndex:   groupIdx,
+				groupTarget:  i,
+				group:        group,
+				source:       groupSourceState,
+				repo:         target.Repo,
+				branchPrefix: branchPrefix,
+				targetBranch: target.Branch,
 			}
-			targetState, err := d.DiscoverTargetState(ctx, target.Repo, branchPrefix, target.Branch)
-			targetDuration := time.Since(targetStart)
+			targetIndex++
 
-			if err != nil {
-				if d.logConfig != nil && d.logConfig.Debug.State {
-					targetLogger.WithFields(logrus.Fields{
-						logging.StandardFields.Error:      err.Error(),
-						logging.StandardFields.DurationMs: targetDuration.Milliseconds(),
-						logging.StandardFields.Status:     "failed",
-					}).Error("Failed to discover target repository state")
+			g.Go(func() error {
+				targetState, err := d.discoverTarget(gctx, logger, job, journal)
+				if err != nil {
+					return err
 				}
-				return nil, fmt.Errorf("failed to discover state for %s: %w", target.Repo, err)
-			}
+				results[job.index] = targetState
 
-			// Determine sync status based on this group's source and target state
-			groupSourceState, exists := sourceMap[sourceKey]
-			if !exists {
-				// This indicates a programming error - source should have been discovered
-				return nil, fmt.Errorf("key %q in group %q: %w", sourceKey, group.Name, ErrSourceStateNotFound)
-			}
-			targetState.Status = d.determineSyncStatus(groupSourceState, targetState)
-
-			if d.logConfig != nil && d.logConfig.Debug.State {
-				targetLogger.WithFields(logrus.Fields{
-					"sync_branches":                   len(targetState.SyncBranches),
-					"open_prs":                        len(targetState.OpenPRs),
-					"last_sync_commit":                targetState.LastSyncCommit,
-					logging.StandardFields.SyncStatus: string(targetState.Status),
-					logging.StandardFields.DurationMs: targetDuration.Milliseconds(),
-					logging.StandardFields.Status:     "discovered",
-				}).Debug("Target repository state discovered")
-			}
+				if d.progress != nil {
+					progressMu.Lock()
+					done++
+					d.progress(done, totalTargets, job.repo)
+					progressMu.Unlock()
+				}
+				return nil
+			})
+		}
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
 
-			targetStates[target.Repo] = targetState
+	targetStates := make(map[string]*TargetState, totalTargets)
+	targetIndex = 0
+	for _, group := range groups {
+		for _, target := range group.Targets {
+			targetStates[target.Repo] = results[targetIndex]
 			targetIndex++
 		}
 	}
 
 	state.Targets = targetStates
+	state.Sources = sourceMap
 
 	// Log successful discovery completion
 	duration := time.Since(start)
@@ -279,6 +340,87 @@ func (d *discoveryService) DiscoverState(ctx context.Context, cfg *config.Config
 	return state, nil
 }
 
+// targetJob is one target repository of one group to discover
+type targetJob struct {
+	index        int // Position of the target across all groups
+	groupIndex   int
+	groupTarget  int // Position of the target within its group
+	group        config.Group
+	source       SourceState // The group's source, to determine the sync status against
+	repo         string
+	branchPrefix string
+	targetBranch string
+}
+
+// discoverTarget discovers the state of job's target and its sync status
+// against the group's source
+func (d *discoveryService) discoverTarget(ctx context.Context, logger *logrus.Entry, job targetJob, journal *discoveryJournal) (*TargetState, error) {
+	// Check for context cancellation
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("target discovery canceled: %w", ctx.Err())
+	default:
+	}
+
+	targetLogger := logger
+	if d.logConfig != nil && d.logConfig.Debug.State {
+		targetLogger = logger.WithFields(logrus.Fields{
+			"target_index":                    job.index,
+			"group_index":                     job.groupIndex,
+			"group_target_index":              job.groupTarget,
+			"group_name":                      job.group.Name,
+			logging.StandardFields.TargetRepo: job.repo,
+		})
+		targetLogger.Trace("Discovering target repository state")
+	} else {
+		logger.WithField("repo", job.repo).Debug("Discovering target state")
+	}
+
+	targetStart := time.Now()
+	journalKey := job.repo + "|" + job.branchPrefix + "|" + job.targetBranch
+	targetState, resumed := journal.lookup(journalKey)
+	if !resumed {
+		var err error
+		targetState, err = d.DiscoverTargetState(ctx, job.repo, job.branchPrefix, job.targetBranch)
+		if err != nil {
+			if d.logConfig != nil && d.logConfig.Debug.State {
+				targetLogger.WithFields(logrus.Fields{
+					logging.StandardFields.Error:      err.Error(),
+					logging.StandardFields.DurationMs: time.Since(targetStart).Milliseconds(),
+					logging.StandardFields.Status:     "failed",
+				}).Error("Failed to discover target repository state")
+			}
+			return nil, fmt.Errorf("failed to discover state for %s: %w", job.repo, err)
+		}
+		if err := journal.record(journalKey, targetState); err != nil {
+			targetLogger.WithError(err).Debug("Failed to record target state in discovery journal")
+		}
+	}
+	targetDuration := time.Since(targetStart)
+
+	// Groups expanded from a multi-branch source share the target's sync
+	// branches with their siblings; only this group's syncs count
+	if job.group.ExpandedFrom != "" {
+		targetState = targetState.ForGroup(job.group.ID)
+	}
+
+	targetState.Status = d.determineSyncStatus(job.source, targetState)
+
+	if d.logConfig != nil && d.logConfig.Debug.State {
+		targetLogger.WithFields(logrus.Fields{
+			"sync_branches":                   len(targetState.SyncBranches),
+			"open_prs":                        len(targetState.OpenPRs),
+			"last_sync_commit":                targetState.LastSyncCommit,
+			"resumed":                         resumed,
+			logging.StandardFields.SyncStatus: string(targetState.Status),
+			logging.StandardFields.DurationMs: targetDuration.Milliseconds(),
+			logging.StandardFields.Status:     "discovered",
+		}).Debug("Target repository state discovered")
+	}
+
+	return targetState, nil
+}
+
 // DiscoverTargetState discovers the state of a specific target repository with comprehensive debug logging support.
 //
 // This method provides detailed visibility into target repository analysis when debug logging is enabled,
@@ -474,12 +616,28 @@ func (d *discoveryService) DiscoverTargetState(ctx context.Context, repo, branch
 	}
 
 	syncPrCount := 0
+	var lastHashTime, lastSHAsTime time.Time
 	for _, pr := range prs {
-		// Check if PR is from a sync branch
-		if strings.HasPrefix(pr.Head.Ref, syncBranchPrefix) {
+		// Check if PR is from a sync branch, in the default format or named by a
+		// branch_name_template
+		if strings.HasPrefix(pr.Head.Ref, syncBranchPrefix) || d.addTemplatedSyncBranch(targetState, pr) {
 			syncPrCount++
 			targetState.OpenPRs = append(targetState.OpenPRs, pr)
 
+			// Keep the content hash and file blob SHAs from the most recently
+			// synced PR. Untrusted metadata must not decide what is in sync.
+			metadata, err := ExtractEnhancedPRMetadata(pr)
+			if d.metadataTrusted(targetState, pr, logger) && err == nil {
+				if metadata.SyncMetadata.ContentHash != "" && !metadata.SyncMetadata.SyncTime.Before(lastHashTime) {
+					lastHashTime = metadata.SyncMetadata.SyncTime
+					targetState.LastSyncContentHash = metadata.SyncMetadata.ContentHash
+				}
+				if shas := metadata.FileBlobSHAs(); len(shas) > 0 && !metadata.SyncMetadata.SyncTime.Before(lastSHAsTime) {
+					lastSHAsTime = metadata.SyncMetadata.SyncTime
+					targetState.LastSyncFileSHAs = shas
+				}
+			}
+
 			if d.logConfig != nil && d.logConfig.Debug.State {
 				logger.WithFields(logrus.Fields{
 					"pr_number":   pr.Number,
@@ -511,6 +669,42 @@ func (d *discoveryService) DiscoverTargetState(ctx context.Context, repo, branch
 	return targetState, nil
 }
 
+// addTemplatedSyncBranch recognizes pr as a sync PR by its metadata block when
+// its head branch does not have the default sync branch format, as with
+// branches named by a branch_name_template. The branch is added to target with
+// the group and source commit from the metadata, and counts toward the last
+// sync. Metadata that fails signature verification does not identify a sync PR.
+func (d *discoveryService) addTemplatedSyncBranch(target *TargetState, pr gh.PR) bool {
+	if isDefaultFormatBranch(pr.Head.Ref) {
+		return false
+	}
+	metadata, err := ExtractEnhancedPRMetadata(pr)
+	if err != nil || metadata.Group == nil || metadata.Group.ID == "" || metadata.SyncMetadata.SourceCommit == "" {
+		return false
+	}
+	if metadata.SyncMetadata.TargetRepo != "" && metadata.SyncMetadata.TargetRepo != target.Repo {
+		return false
+	}
+	if len(d.secret) > 0 && VerifyPRMetadata(pr.Body, d.secret) != MetadataVerified {
+		return false
+	}
+
+	timestamp := metadata.SyncMetadata.SyncTime
+	target.SyncBranches = append(target.SyncBranches, SyncBranch{
+		Name: pr.Head.Ref,
+		Metadata: &BranchMetadata{
+			Timestamp: timestamp,
+			CommitSHA: metadata.SyncMetadata.SourceCommit,
+			GroupID:   metadata.Group.ID,
+		},
+	})
+	if target.LastSyncTime == nil || timestamp.After(*target.LastSyncTime) {
+		target.LastSyncTime = &timestamp
+		target.LastSyncCommit = metadata.SyncMetadata.SourceCommit
+	}
+	return true
+}
+
 // ParseBranchName parses a branch name to extract sync metadata.
 // Delegates to parseSyncBranchName in branch.go.
 func (d *discoveryService) ParseBranchName(name string) (*BranchMetadata, error) {
@@ -522,6 +716,29 @@ func (d *discoveryService) ParseBranchNameWithPrefix(name, branchPrefix string)
 	return parseSyncBranchNameWithPrefix(name, branchPrefix)
 }
 
+// metadataTrusted reports whether pr's metadata can be used. Without a
+// metadata secret all metadata is trusted; otherwise metadata that fails
+// signature verification is recorded in target.UntrustedPRs.
+func (d *discoveryService) metadataTrusted(target *TargetState, pr gh.PR, logger *logrus.Entry) bool {
+	if len(d.secret) == 0 {
+		return true
+	}
+	trust := VerifyPRMetadata(pr.Body, d.secret)
+	if trust == MetadataVerified {
+		return true
+	}
+
+	if target.UntrustedPRs == nil {
+		target.UntrustedPRs = make(map[int]MetadataTrust)
+	}
+	target.UntrustedPRs[pr.Number] = trust
+	logger.WithFields(logrus.Fields{
+		"pr_number": pr.Number,
+		"metadata":  trust,
+	}).Warn("Ignoring untrusted sync PR metadata")
+	return false
+}
+
 // determineSyncStatus determines the sync status based on source and target state
 func (d *discoveryService) determineSyncStatus(source SourceState, target *TargetState) SyncStatus {
 	// No sync history
@@ -534,11 +751,32 @@ func (d *discoveryService) determineSyncStatus(source SourceState, target *Targe
 		return StatusPending
 	}
 
-	// Check if target is up to date with source
-	if target.LastSyncCommit == source.LatestCommit {
+	// Latest sync was pushed as a branch without a PR (sync --no-pr)
+	if latest := latestSyncBranch(target); latest != nil && latest.Metadata.NoPR {
+		return StatusBranchOnly
+	}
+
+	// Check if target is up to date with source. A local source may hold
+	// uncommitted changes, so its commit alone cannot prove the target is current.
+	if target.LastSyncCommit == source.LatestCommit && !config.IsLocalSource(source.Repo) {
 		return StatusUpToDate
 	}
 
 	// Target is behind source
 	return StatusBehind
 }
+
+// latestSyncBranch returns the target's most recent sync branch, or nil when it has none
+func latestSyncBranch(target *TargetState) *SyncBranch {
+	var latest *SyncBranch
+	for i := range target.SyncBranches {
+		branch := &target.SyncBranches[i]
+		if branch.Metadata == nil {
+			continue
+		}
+		if latest == nil || branch.Metadata.Timestamp.After(latest.Metadata.Timestamp) {
+			latest = branch
+		}
+	}
+	return latest
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -250,6 +251,129 @@ func TestDiscoveryService_DiscoverTargetState(t *testing.T) {
 
 		mockGH.AssertExpectations(t)
 	})
+
+	t.Run("content hash from newest sync PR metadata", func(t *testing.T) {
+		mockGH := &gh.MockClient{}
+		discoverer := NewDiscoverer(mockGH, logger, nil)
+
+		mockGH.On("ListBranches", mock.Anything, "org/service").Return([]gh.Branch{}, nil)
+
+		syncPR := func(number int, syncTime, hash string) gh.PR {
+			pr := gh.PR{Number: number, State: "open", Body: "<!-- go-broadcast-metadata\nsync_metadata:\n" +
+				"  source_commit: abc123\n  sync_time: " + syncTime + "\n  content_hash: " + hash + "\n-->"}
+			pr.Head.Ref = "chore/sync-files-default-20240115-120000-abc123"
+			return pr
+		}
+		mockGH.On("ListPRs", mock.Anything, "org/service", "open").Return([]gh.PR{
+			syncPR(11, "2024-01-16T10:00:00Z", "newer"),
+			syncPR(10, "2024-01-15T10:00:00Z", "older"),
+		}, nil)
+
+		state, err := discoverer.DiscoverTargetState(ctx, "org/service", "chore/sync-files", "")
+		require.NoError(t, err)
+		assert.Equal(t, "newer", state.LastSyncContentHash)
+	})
+
+	t.Run("untrusted sync PR metadata is ignored", func(t *testing.T) {
+		secret := []byte("s3cret")
+		mockGH := &gh.MockClient{}
+		discoverer := NewDiscoverer(mockGH, logger, nil, WithMetadataSecret(secret))
+
+		mockGH.On("ListBranches", mock.Anything, "org/service").Return([]gh.Branch{}, nil)
+
+		syncPR := func(number int, syncTime, hash string, sign bool) gh.PR {
+			content := "sync_metadata:\n  source_commit: abc123\n  sync_time: " + syncTime + "\n  content_hash: " + hash + "\n"
+			if sign {
+				content += MetadataSignatureLine(content, secret)
+			}
+			pr := gh.PR{Number: number, State: "open", Body: "<!-- go-broadcast-metadata\n" + content + "-->"}
+			pr.Head.Ref = "chore/sync-files-default-20240115-120000-abc123"
+			return pr
+		}
+		tampered := syncPR(12, "2024-01-17T10:00:00Z", "forged", true)
+		tampered.Body = strings.Replace(tampered.Body, "forged", "forged-again", 1)
+		mockGH.On("ListPRs", mock.Anything, "org/service", "open").Return([]gh.PR{
+			tampered,
+			syncPR(11, "2024-01-16T10:00:00Z", "unsigned", false),
+			syncPR(10, "2024-01-15T10:00:00Z", "trusted", true),
+		}, nil)
+
+		state, err := discoverer.DiscoverTargetState(ctx, "org/service", "chore/sync-files", "")
+		require.NoError(t, err)
+		assert.Equal(t, "trusted", state.LastSyncContentHash)
+		assert.Equal(t, map[int]MetadataTrust{12: MetadataTampered, 11: MetadataUnsigned}, state.UntrustedPRs)
+		assert.Len(t, state.OpenPRs, 3)
+	})
+
+	t.Run("file blob SHAs from newest sync PR metadata", func(t *testing.T) {
+		mockGH := &gh.MockClient{}
+		discoverer := NewDiscoverer(mockGH, logger, nil)
+
+		mockGH.On("ListBranches", mock.Anything, "org/service").Return([]gh.Branch{}, nil)
+
+		syncPR := func(number int, syncTime, sha string) gh.PR {
+			pr := gh.PR{Number: number, State: "open", Body: "<!-- go-broadcast-metadata\nsync_metadata:\n" +
+				"  source_commit: abc123\n  sync_time: " + syncTime + "\nfiles:\n" +
+				"  - src: Makefile\n    dest: Makefile\n    blob_sha: " + sha + "\n" +
+				"  - src: new.txt\n    dest: new.txt\n-->"}
+			pr.Head.Ref = "chore/sync-files-default-20240115-120000-abc123"
+			return pr
+		}
+		mockGH.On("ListPRs", mock.Anything, "org/service", "open").Return([]gh.PR{
+			syncPR(10, "2024-01-15T10:00:00Z", "older"),
+			syncPR(11, "2024-01-16T10:00:00Z", "newer"),
+		}, nil)
+
+		state, err := discoverer.DiscoverTargetState(ctx, "org/service", "chore/sync-files", "")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"Makefile": "newer"}, state.LastSyncFileSHAs)
+	})
+
+	t.Run("templated sync branch recognized by PR metadata", func(t *testing.T) {
+		mockGH := &gh.MockClient{}
+		discoverer := NewDiscoverer(mockGH, logger, nil)
+
+		mockGH.On("ListBranches", mock.Anything, "org/service").Return([]gh.Branch{
+			{Name: "sync/core-20240116-100000"},
+		}, nil)
+
+		templated := gh.PR{Number: 12, State: "open", Body: "<!-- go-broadcast-metadata\ngroup:\n  id: core\n  name: Core\n" +
+			"sync_metadata:\n  source_repo: org/template\n  source_commit: def4567890\n  target_repo: org/service\n" +
+			"  sync_time: 2024-01-16T10:00:00Z\n-->"}
+		templated.Head.Ref = "sync/core-20240116-100000"
+		unrelated := gh.PR{Number: 13, State: "open", Body: "Fix a typo"}
+		unrelated.Head.Ref = "fix/typo"
+		otherPrefix := gh.PR{Number: 14, State: "open", Body: templated.Body}
+		otherPrefix.Head.Ref = "chore/other-core-20240116-100000-def4567"
+		mockGH.On("ListPRs", mock.Anything, "org/service", "open").Return([]gh.PR{templated, unrelated, otherPrefix}, nil)
+
+		state, err := discoverer.DiscoverTargetState(ctx, "org/service", "chore/sync-files", "")
+		require.NoError(t, err)
+		require.Len(t, state.OpenPRs, 1)
+		assert.Equal(t, 12, state.OpenPRs[0].Number)
+		require.Len(t, state.SyncBranches, 1)
+		assert.Equal(t, "sync/core-20240116-100000", state.SyncBranches[0].Name)
+		assert.Equal(t, "core", state.SyncBranches[0].Metadata.GroupID)
+		assert.Equal(t, "def4567890", state.LastSyncCommit)
+		require.NotNil(t, state.LastSyncTime)
+		assert.Equal(t, time.Date(2024, 1, 16, 10, 0, 0, 0, time.UTC), *state.LastSyncTime)
+	})
+
+	t.Run("templated sync branch needs trusted metadata", func(t *testing.T) {
+		mockGH := &gh.MockClient{}
+		discoverer := NewDiscoverer(mockGH, logger, nil, WithMetadataSecret([]byte("s3cret")))
+
+		mockGH.On("ListBranches", mock.Anything, "org/service").Return([]gh.Branch{}, nil)
+		unsigned := gh.PR{Number: 12, State: "open", Body: "<!-- go-broadcast-metadata\ngroup:\n  id: core\n" +
+			"sync_metadata:\n  source_commit: def4567890\n  sync_time: 2024-01-16T10:00:00Z\n-->"}
+		unsigned.Head.Ref = "sync/core-20240116-100000"
+		mockGH.On("ListPRs", mock.Anything, "org/service", "open").Return([]gh.PR{unsigned}, nil)
+
+		state, err := discoverer.DiscoverTargetState(ctx, "org/service", "chore/sync-files", "")
+		require.NoError(t, err)
+		assert.Empty(t, state.OpenPRs)
+		assert.Empty(t, state.LastSyncCommit)
+	})
 }
 
 func TestDiscoveryService_ParseBranchName(t *testing.T) {
@@ -323,6 +447,28 @@ func TestDetermineSyncStatus(t *testing.T) {
 			},
 			expected: StatusBehind,
 		},
+		{
+			name: "latest branch pushed without a PR",
+			target: &TargetState{
+				LastSyncCommit: "abc123",
+				SyncBranches: []SyncBranch{
+					{Name: "old", Metadata: &BranchMetadata{Timestamp: time.Unix(100, 0)}},
+					{Name: "new", Metadata: &BranchMetadata{Timestamp: time.Unix(200, 0), NoPR: true}},
+				},
+			},
+			expected: StatusBranchOnly,
+		},
+		{
+			name: "older branch pushed without a PR",
+			target: &TargetState{
+				LastSyncCommit: "abc123",
+				SyncBranches: []SyncBranch{
+					{Name: "old", Metadata: &BranchMetadata{Timestamp: time.Unix(100, 0), NoPR: true}},
+					{Name: "new", Metadata: &BranchMetadata{Timestamp: time.Unix(200, 0)}},
+				},
+			},
+			expected: StatusUpToDate,
+		},
 	}
 
 	for _, tt := range tests {
@@ -920,6 +1066,10 @@ func TestDiscoveryService_MultiGroupDiscovery(t *testing.T) {
 		assert.Equal(t, "main", state.Source.Branch)
 		assert.Equal(t, "src1123", state.Source.LatestCommit)
 
+		// Every group's source is tracked for state cache validation
+		assert.Len(t, state.Sources, 4)
+		assert.Equal(t, "src1123", state.Sources[SourceKey("org/source-1", "main")].LatestCommit)
+
 		mockGH.AssertExpectations(t)
 	})
 
@@ -1394,3 +1544,56 @@ func TestDiscoveryService_LargeScaleMultiGroup(t *testing.T) {
 		assert.Contains(t, err.Error(), "canceled")
 	})
 }
+
+// TestDiscoveryService_ConcurrentDiscovery tests target discovery with bounded parallelism
+func TestDiscoveryService_ConcurrentDiscovery(t *testing.T) {
+	ctx := context.Background()
+	logger := logrus.New()
+
+	t.Run("matches sequential discovery and reports progress", func(t *testing.T) {
+		cfg := createMultiGroupConfig(4)
+		mockGH := &gh.MockClient{}
+		mockMultiGroupSources(mockGH, 4)
+		mockMultiGroupTargets(mockGH, 4, true)
+
+		sequential, err := NewDiscoverer(mockGH, logger, nil).DiscoverState(ctx, cfg)
+		require.NoError(t, err)
+
+		var progress []int
+		concurrent, err := NewDiscoverer(mockGH, logger, nil,
+			WithDiscoveryConcurrency(4),
+			WithDiscoveryProgress(func(done, total int, _ string) {
+				assert.Equal(t, 8, total)
+				progress = append(progress, done)
+			}),
+		).DiscoverState(ctx, cfg)
+		require.NoError(t, err)
+
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, progress)
+		require.Len(t, concurrent.Targets, len(sequential.Targets))
+		for repo, want := range sequential.Targets {
+			got := concurrent.Targets[repo]
+			require.NotNil(t, got, repo)
+			assert.Equal(t, want.Status, got.Status, repo)
+			assert.Equal(t, want.OpenPRs, got.OpenPRs, repo)
+		}
+		require.Len(t, concurrent.Sources, len(sequential.Sources))
+		for key, want := range sequential.Sources {
+			assert.Equal(t, want.LatestCommit, concurrent.Sources[key].LatestCommit, key)
+		}
+	})
+
+	t.Run("target failure fails discovery", func(t *testing.T) {
+		cfg := createMultiGroupConfig(2)
+		mockGH := &gh.MockClient{}
+		mockMultiGroupSources(mockGH, 2)
+		mockGH.On("ListBranches", mock.Anything, "org/target-1-2").Return(nil, ErrRepositoryNotFound)
+		mockGH.On("ListBranches", mock.Anything, mock.Anything).Return([]gh.Branch{}, nil)
+		mockGH.On("ListPRs", mock.Anything, mock.Anything, "open").Return([]gh.PR{}, nil)
+
+		state, err := NewDiscoverer(mockGH, logger, nil, WithDiscoveryConcurrency(3)).DiscoverState(ctx, cfg)
+		require.Error(t, err)
+		assert.Nil(t, state)
+		assert.Contains(t, err.Error(), "failed to discover state for org/target-1-2")
+	})
+}
@@ -0,0 +1,142 @@
+package state
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// journalRecord is one line of a discovery journal
+type journalRecord struct {
+	Version    int             `json:"version"`
+	Key        string          `json:"key"`
+	RecordedAt time.Time       `json:"recorded_at"`
+	Target     json.RawMessage `json:"target"`
+}
+
+// discoveryJournal records target states as a discovery finds them, so that
+// an interrupted discovery (rate limit, Ctrl-C, network failure) resumes
+// where it stopped instead of querying every target again. The journal is an
+// append-only JSON lines file; a nil journal records and finds nothing.
+type discoveryJournal struct {
+	mu      sync.Mutex
+	path    string
+	file    *os.File
+	now     func() time.Time
+	records map[string]json.RawMessage
+}
+
+// openDiscoveryJournal opens the journal at path, loading the targets an
+// earlier discovery recorded within ttl. A journal with nothing left to
+// reuse is started over.
+func openDiscoveryJournal(path string, ttl time.Duration, now func() time.Time) (*discoveryJournal, error) {
+	j := &discoveryJournal{
+		path:    path,
+		now:     now,
+		records: make(map[string]json.RawMessage),
+	}
+	j.load(now().Add(-ttl))
+
+	flags := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	if len(j.records) == 0 {
+		flags |= os.O_TRUNC
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, fmt.Errorf("create discovery journal dir: %w", err)
+	}
+	file, err := os.OpenFile(path, flags, 0o600) //nolint:gosec // path is derived from the cache dir and a hash
+	if err != nil {
+		return nil, fmt.Errorf("open discovery journal: %w", err)
+	}
+	j.file = file
+	return j, nil
+}
+
+// load reads the journal's records made after since, skipping lines from
+// other schema versions and a last line cut short by an interrupted write
+func (j *discoveryJournal) load(since time.Time) {
+	file, err := os.Open(j.path)
+	if err != nil {
+		return
+	}
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var record journalRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil || record.Version != stateCacheVersion || record.RecordedAt.Before(since) {
+			continue
+		}
+		j.records[record.Key] = record.Target
+	}
+}
+
+// lookup returns a fresh copy of the target state recorded under key, so
+// callers may modify it
+func (j *discoveryJournal) lookup(key string) (*TargetState, bool) {
+	if j == nil {
+		return nil, false
+	}
+	j.mu.Lock()
+	raw, ok := j.records[key]
+	j.mu.Unlock()
+	if !ok {
+		return nil, false
+	}
+
+	var target TargetState
+	if err := json.Unmarshal(raw, &target); err != nil {
+		return nil, false
+	}
+	return &target, true
+}
+
+// record appends target to the journal under key
+func (j *discoveryJournal) record(key string, target *TargetState) error {
+	if j == nil {
+		return nil
+	}
+	raw, err := json.Marshal(target)
+	if err != nil {
+		return fmt.Errorf("encode journal target: %w", err)
+	}
+	line, err := json.Marshal(journalRecord{
+		Version:    stateCacheVersion,
+		Key:        key,
+		RecordedAt: j.now(),
+		Target:     raw,
+	})
+	if err != nil {
+		return fmt.Errorf("encode journal record: %w", err)
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.records[key] = raw
+	if _, err := j.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("write discovery journal: %w", err)
+	}
+	return nil
+}
+
+// close closes the journal. A complete discovery no longer needs it, so it
+// is removed; otherwise it is kept for the next discovery to resume from.
+func (j *discoveryJournal) close(complete bool) error {
+	if j == nil {
+		return nil
+	}
+	err := j.file.Close()
+	if complete {
+		if removeErr := os.Remove(j.path); removeErr != nil && !errors.Is(removeErr, os.ErrNotExist) {
+			err = errors.Join(err, removeErr)
+		}
+	}
+	return err
+}
@@ -0,0 +1,41 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+// ErrLocalSourceNotDirectory indicates a local source path is not a directory
+var ErrLocalSourceNotDirectory = errors.New("local source is not a directory")
+
+// localSourceBranch returns the branch information of a local source directory.
+// The directory must be a git work tree: its HEAD commit stands in for the
+// latest source commit in sync branch names and PR metadata.
+func localSourceBranch(ctx context.Context, repo string) (*gh.Branch, error) {
+	dir := config.LocalSourcePath(repo)
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to access local source %s: %w", dir, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%w: %s", ErrLocalSourceNotDirectory, dir)
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "-C", dir, "rev-parse", "HEAD") //nolint:gosec // G204: exec uses trusted git command with controlled arguments
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("local source %s must be a git work tree with at least one commit: %w", dir, err)
+	}
+
+	branch := &gh.Branch{Name: "HEAD"}
+	branch.Commit.SHA = strings.TrimSpace(string(output))
+	return branch, nil
+}
@@ -0,0 +1,112 @@
+package state
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+// initLocalSource creates a git work tree with one commit and returns its
+// path and HEAD commit
+func initLocalSource(t *testing.T) (string, string) {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# template\n"), 0o600))
+
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"add", "."},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "init"},
+	} {
+		out, err := exec.CommandContext(context.Background(), "git", append([]string{"-C", dir}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	out, err := exec.CommandContext(context.Background(), "git", "-C", dir, "rev-parse", "HEAD").Output()
+	require.NoError(t, err)
+	return dir, strings.TrimSpace(string(out))
+}
+
+func TestLocalSourceBranch(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("git work tree", func(t *testing.T) {
+		dir, head := initLocalSource(t)
+
+		for _, repo := range []string{dir, config.LocalSourcePrefix + dir} {
+			branch, err := localSourceBranch(ctx, repo)
+			require.NoError(t, err)
+			assert.Equal(t, head, branch.Commit.SHA)
+		}
+	})
+
+	t.Run("missing directory", func(t *testing.T) {
+		_, err := localSourceBranch(ctx, filepath.Join(t.TempDir(), "missing"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("file instead of directory", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "file.txt")
+		require.NoError(t, os.WriteFile(file, []byte("x"), 0o600))
+
+		_, err := localSourceBranch(ctx, file)
+		require.ErrorIs(t, err, ErrLocalSourceNotDirectory)
+	})
+
+	t.Run("directory without commits", func(t *testing.T) {
+		_, err := localSourceBranch(ctx, t.TempDir())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be a git work tree")
+	})
+}
+
+func TestDiscoveryService_LocalSource(t *testing.T) {
+	dir, head := initLocalSource(t)
+
+	cfg := &config.Config{
+		Version: 1,
+		Groups: []config.Group{
+			{
+				Name:   "local",
+				ID:     "local",
+				Source: config.SourceConfig{Repo: dir, Branch: "master"},
+				Targets: []config.TargetConfig{
+					{Repo: "org/service-a"},
+				},
+				Defaults: config.DefaultConfig{BranchPrefix: "chore/sync-files"},
+			},
+		},
+	}
+
+	// The target already carries a sync branch for the local HEAD commit
+	mockGH := &gh.MockClient{}
+	mockGH.On("ListBranches", mock.Anything, "org/service-a").
+		Return([]gh.Branch{{Name: "chore/sync-files-local-20240115-120000-" + head}}, nil)
+	mockGH.On("ListPRs", mock.Anything, "org/service-a", "open").
+		Return([]gh.PR{}, nil)
+
+	discovered, err := NewDiscoverer(mockGH, logrus.New(), nil).DiscoverState(context.Background(), cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, dir, discovered.Source.Repo)
+	assert.Equal(t, head, discovered.Source.LatestCommit)
+
+	target := discovered.Targets["org/service-a"]
+	require.NotNil(t, target)
+	assert.Equal(t, head, target.LastSyncCommit)
+	assert.Equal(t, StatusBehind, target.Status, "a local source may have uncommitted changes")
+
+	mockGH.AssertNotCalled(t, "GetBranch", mock.Anything, mock.Anything, mock.Anything)
+	mockGH.AssertExpectations(t)
+}
@@ -22,6 +22,9 @@ var (
 
 // EnhancedPRMetadata represents the metadata format with directory sync support
 type EnhancedPRMetadata struct {
+	// Group identifies the group that opened the PR
+	Group *GroupInfo `yaml:"group,omitempty"`
+
 	// SyncMetadata contains core sync information
 	SyncMetadata *SyncMetadataInfo `yaml:"sync_metadata"`
 
@@ -35,6 +38,12 @@ type EnhancedPRMetadata struct {
 	Performance *PerformanceInfo `yaml:"performance,omitempty"`
 }
 
+// GroupInfo identifies the group that opened a sync PR
+type GroupInfo struct {
+	ID   string `yaml:"id"`
+	Name string `yaml:"name"`
+}
+
 // SyncMetadataInfo contains core sync metadata
 type SyncMetadataInfo struct {
 	SourceRepo   string    `yaml:"source_repo"`
@@ -42,12 +51,14 @@ type SyncMetadataInfo struct {
 	TargetRepo   string    `yaml:"target_repo"`
 	SyncCommit   string    `yaml:"sync_commit"`
 	SyncTime     time.Time `yaml:"sync_time"`
+	ContentHash  string    `yaml:"content_hash,omitempty"`
 }
 
 // FileMapping represents a file sync mapping
 type FileMapping struct {
 	Source      string `yaml:"src"`
 	Destination string `yaml:"dest"`
+	BlobSHA     string `yaml:"blob_sha,omitempty"` // Blob SHA of the target file when the sync ran
 }
 
 // DirectoryMapping represents a directory sync mapping with metrics
@@ -98,6 +109,22 @@ func ExtractEnhancedPRMetadata(pr gh.PR) (*EnhancedPRMetadata, error) {
 	return &enhanced, nil
 }
 
+// FileBlobSHAs returns the recorded blob SHA of each file mapping keyed by
+// destination path, or nil when none were recorded
+func (m *EnhancedPRMetadata) FileBlobSHAs() map[string]string {
+	var shas map[string]string
+	for _, file := range m.Files {
+		if file.Destination == "" || file.BlobSHA == "" {
+			continue
+		}
+		if shas == nil {
+			shas = make(map[string]string, len(m.Files))
+		}
+		shas[file.Destination] = file.BlobSHA
+	}
+	return shas
+}
+
 // extractMetadataYAML extracts the YAML content from a metadata block
 func extractMetadataYAML(body, marker string) (string, error) {
 	// Find the start of the metadata block
@@ -0,0 +1,62 @@
+package state
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// metadataSignaturePrefix starts the signature line written as the last line
+// of a signed go-broadcast-metadata block
+const metadataSignaturePrefix = "signature: hmac-sha256:"
+
+// MetadataTrust reports whether a PR's metadata block can be trusted
+type MetadataTrust string
+
+const (
+	// MetadataVerified indicates the block's signature matches its contents
+	MetadataVerified MetadataTrust = "verified"
+
+	// MetadataUnsigned indicates a signing secret is configured but the block
+	// has no signature, so it may have been written or replaced by hand
+	MetadataUnsigned MetadataTrust = "unsigned"
+
+	// MetadataTampered indicates the block's signature does not match its
+	// contents, or the block could not be found
+	MetadataTampered MetadataTrust = "tampered"
+)
+
+// MetadataSignatureLine returns the signature line that closes a metadata
+// block whose YAML lines are content, keyed by secret
+func MetadataSignatureLine(content string, secret []byte) string {
+	return metadataSignaturePrefix + metadataHMAC(content, secret) + "\n"
+}
+
+// VerifyPRMetadata checks the signature of the go-broadcast-metadata block in
+// body against secret
+func VerifyPRMetadata(body string, secret []byte) MetadataTrust {
+	content, err := extractMetadataYAML(body, "<!-- go-broadcast-metadata")
+	if err != nil {
+		return MetadataTampered
+	}
+
+	idx := strings.LastIndex(content, metadataSignaturePrefix)
+	if idx == -1 || (idx > 0 && content[idx-1] != '\n') {
+		return MetadataUnsigned
+	}
+	signature := strings.TrimSpace(content[idx+len(metadataSignaturePrefix):])
+	if !hmac.Equal([]byte(signature), []byte(metadataHMAC(content[:idx], secret))) {
+		return MetadataTampered
+	}
+	return MetadataVerified
+}
+
+// metadataHMAC returns the hex HMAC-SHA256 of content keyed by secret. Line
+// endings are normalized first, as editing a PR body on GitHub can turn them
+// into CRLF.
+func metadataHMAC(content string, secret []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(strings.ReplaceAll(content, "\r\n", "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,44 @@
+package state
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+func TestVerifyPRMetadata(t *testing.T) {
+	secret := []byte("s3cret")
+	content := "sync_metadata:\n  source_commit: abc123\n  content_hash: hash\n"
+	signed := "Summary\n\n<!-- go-broadcast-metadata\n" + content + MetadataSignatureLine(content, secret) + "-->\n"
+
+	assert.Equal(t, MetadataVerified, VerifyPRMetadata(signed, secret))
+	assert.Equal(t, MetadataVerified, VerifyPRMetadata(strings.ReplaceAll(signed, "\n", "\r\n"), secret),
+		"bodies re-saved with CRLF line endings still verify")
+
+	t.Run("edited metadata", func(t *testing.T) {
+		edited := strings.Replace(signed, "content_hash: hash", "content_hash: forged", 1)
+		assert.Equal(t, MetadataTampered, VerifyPRMetadata(edited, secret))
+	})
+
+	t.Run("other secret", func(t *testing.T) {
+		assert.Equal(t, MetadataTampered, VerifyPRMetadata(signed, []byte("other")))
+	})
+
+	t.Run("unsigned block", func(t *testing.T) {
+		assert.Equal(t, MetadataUnsigned, VerifyPRMetadata("<!-- go-broadcast-metadata\n"+content+"-->", secret))
+	})
+
+	t.Run("no block", func(t *testing.T) {
+		assert.Equal(t, MetadataTampered, VerifyPRMetadata("Summary only", secret))
+	})
+
+	t.Run("signed metadata still parses", func(t *testing.T) {
+		metadata, err := ExtractEnhancedPRMetadata(gh.PR{Body: signed})
+		require.NoError(t, err)
+		assert.Equal(t, "hash", metadata.SyncMetadata.ContentHash)
+	})
+}
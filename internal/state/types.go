@@ -12,10 +12,18 @@ type State struct {
 	// Source contains the state of the source repository
 	Source SourceState
 
+	// Sources contains the state of every group source, keyed by SourceKey
+	Sources map[string]SourceState
+
 	// Targets contains the state of each target repository
 	Targets map[string]*TargetState
 }
 
+// SourceKey identifies a source repository branch in State.Sources
+func SourceKey(repo, branch string) string {
+	return repo + ":" + branch
+}
+
 // SourceState represents the state of the source repository
 type SourceState struct {
 	// Repo is the repository name (e.g., "org/template-repo")
@@ -48,6 +56,18 @@ type TargetState struct {
 	// LastSyncCommit is the SHA of the last synced commit
 	LastSyncCommit string
 
+	// LastSyncContentHash is the mapped content hash recorded in the newest
+	// open sync PR's metadata, empty when unknown
+	LastSyncContentHash string `json:"last_sync_content_hash,omitempty"`
+
+	// LastSyncFileSHAs maps each mapped destination path to the blob SHA the
+	// target file had when the newest open sync PR was synced, empty when unknown
+	LastSyncFileSHAs map[string]string `json:"last_sync_file_shas,omitempty"`
+
+	// UntrustedPRs maps the number of each open sync PR whose metadata failed
+	// signature verification to why, empty when no metadata secret is set
+	UntrustedPRs map[int]MetadataTrust `json:"untrusted_prs,omitempty"`
+
 	// LastSyncTime is when the last sync occurred
 	LastSyncTime *time.Time
 
@@ -58,6 +78,59 @@ type TargetState struct {
 	DirectorySync *DirectorySyncInfo `json:"directory_sync,omitempty"`
 }
 
+// ForGroup returns a copy of the target state limited to the sync branches and
+// open PRs created by groupID, with the last sync recomputed from them. It is
+// used when several groups sync the same target with the same branch prefix.
+func (t *TargetState) ForGroup(groupID string) *TargetState {
+	scoped := *t
+	scoped.SyncBranches = nil
+	scoped.OpenPRs = nil
+	scoped.LastSyncCommit = ""
+	scoped.LastSyncContentHash = ""
+	scoped.LastSyncFileSHAs = nil
+	scoped.LastSyncTime = nil
+
+	groupBranches := make(map[string]bool)
+	for _, branch := range t.SyncBranches {
+		if branch.Metadata == nil || branch.Metadata.GroupID != groupID {
+			continue
+		}
+		scoped.SyncBranches = append(scoped.SyncBranches, branch)
+		groupBranches[branch.Name] = true
+
+		if scoped.LastSyncTime == nil || branch.Metadata.Timestamp.After(*scoped.LastSyncTime) {
+			timestamp := branch.Metadata.Timestamp
+			scoped.LastSyncTime = &timestamp
+			scoped.LastSyncCommit = branch.Metadata.CommitSHA
+		}
+	}
+
+	var lastHashTime, lastSHAsTime time.Time
+	for _, pr := range t.OpenPRs {
+		if !groupBranches[pr.Head.Ref] {
+			continue
+		}
+		scoped.OpenPRs = append(scoped.OpenPRs, pr)
+		if _, untrusted := t.UntrustedPRs[pr.Number]; untrusted {
+			continue
+		}
+
+		metadata, err := ExtractEnhancedPRMetadata(pr)
+		if err != nil {
+			continue
+		}
+		if metadata.SyncMetadata.ContentHash != "" && !metadata.SyncMetadata.SyncTime.Before(lastHashTime) {
+			lastHashTime = metadata.SyncMetadata.SyncTime
+			scoped.LastSyncContentHash = metadata.SyncMetadata.ContentHash
+		}
+		if shas := metadata.FileBlobSHAs(); len(shas) > 0 && !metadata.SyncMetadata.SyncTime.Before(lastSHAsTime) {
+			lastSHAsTime = metadata.SyncMetadata.SyncTime
+			scoped.LastSyncFileSHAs = shas
+		}
+	}
+	return &scoped
+}
+
 // SyncBranch represents a sync branch with parsed metadata
 type SyncBranch struct {
 	// Name is the full branch name
@@ -68,7 +141,9 @@ type SyncBranch struct {
 }
 
 // BranchMetadata contains information parsed from sync branch names
-// Format: chore/sync-files-{groupID}-YYYYMMDD-HHMMSS-{commit}
+// Format: chore/sync-files-{groupID}-YYYYMMDD-HHMMSS-{commit}[-no-pr]
+// Branches named by a branch_name_template take it from their PR's metadata
+// block instead, and have no Prefix.
 type BranchMetadata struct {
 	// Timestamp is when this sync branch was created
 	Timestamp time.Time
@@ -81,6 +156,9 @@ type BranchMetadata struct {
 
 	// GroupID is the group identifier that created this sync
 	GroupID string
+
+	// NoPR is true when the branch was pushed without a pull request
+	NoPR bool
 }
 
 // SyncStatus represents the status of a sync operation
@@ -101,6 +179,9 @@ const (
 
 	// StatusConflict indicates there are conflicts preventing sync
 	StatusConflict SyncStatus = "conflict"
+
+	// StatusBranchOnly indicates the latest sync branch was pushed without a PR
+	StatusBranchOnly SyncStatus = "branch-only"
 )
 
 // DirectorySyncInfo holds directory-specific sync metadata and performance metrics
@@ -717,3 +717,44 @@ func TestBackwardCompatibility_ExistingStateStillWorks(t *testing.T) {
 	require.NotNil(t, target.DirectorySync)
 	require.Len(t, target.DirectorySync.DirectoryMappings, 1)
 }
+
+func TestTargetState_ForGroup(t *testing.T) {
+	older := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+	branch := func(name, groupID, commit string, ts time.Time) SyncBranch {
+		return SyncBranch{Name: name, Metadata: &BranchMetadata{GroupID: groupID, CommitSHA: commit, Timestamp: ts}}
+	}
+	pr := func(number int, head string) gh.PR {
+		var p gh.PR
+		p.Number = number
+		p.Head.Ref = head
+		return p
+	}
+
+	target := &TargetState{
+		Repo: "org/service",
+		SyncBranches: []SyncBranch{
+			branch("chore/sync-files-ci-main-20250101-120000-aaa", "ci-main", "aaa", older),
+			branch("chore/sync-files-ci-release-20250101-130000-bbb", "ci-release", "bbb", newer),
+		},
+		OpenPRs: []gh.PR{
+			pr(1, "chore/sync-files-ci-main-20250101-120000-aaa"),
+			pr(2, "chore/sync-files-ci-release-20250101-130000-bbb"),
+		},
+		LastSyncCommit: "bbb",
+	}
+
+	scoped := target.ForGroup("ci-main")
+	require.Len(t, scoped.SyncBranches, 1)
+	require.Len(t, scoped.OpenPRs, 1)
+	require.Equal(t, 1, scoped.OpenPRs[0].Number)
+	require.Equal(t, "aaa", scoped.LastSyncCommit)
+	require.Equal(t, older, *scoped.LastSyncTime)
+	require.Equal(t, "bbb", target.LastSyncCommit, "original state is not modified")
+
+	none := target.ForGroup("docs")
+	require.Empty(t, none.SyncBranches)
+	require.Empty(t, none.OpenPRs)
+	require.Empty(t, none.LastSyncCommit)
+	require.Nil(t, none.LastSyncTime)
+}
@@ -0,0 +1,240 @@
+package sync
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/mrz1836/go-broadcast/internal/state"
+)
+
+// summaryArtifactName is the run-wide artifact written next to the per-target files
+const summaryArtifactName = "summary.json"
+
+// PR actions recorded in SyncResult.PRAction
+const (
+	PRActionCreated = "created" // The sync opened a new pull request
+	PRActionUpdated = "updated" // The sync updated an existing pull request
+)
+
+// artifactSlugPattern matches characters that are not safe in artifact file names
+var artifactSlugPattern = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// SyncResult is the outcome of syncing one target repository. With
+// Options.OutputDir set, each result is written as <owner>_<repo>.json and
+// all results are collected in summary.json.
+type SyncResult struct {
+	Repo         string    `json:"repo"`
+	Group        string    `json:"group,omitempty"`
+	Status       string    `json:"status"` // One of the TargetStatus* values
+	DryRun       bool      `json:"dry_run,omitempty"`
+	SourceCommit string    `json:"source_commit"`
+	Branch       string    `json:"branch,omitempty"`
+	CommitSHA    string    `json:"commit_sha,omitempty"`
+	PRNumber     *int      `json:"pr_number,omitempty"`
+	PRURL        string    `json:"pr_url,omitempty"`
+	PRAction     string    `json:"pr_action,omitempty"` // PRActionCreated or PRActionUpdated
+	FilesChanged []string  `json:"files_changed"`
+	StartedAt    time.Time `json:"started_at"`
+	DurationMs   int64     `json:"duration_ms"`
+	Error        string    `json:"error,omitempty"`
+
+	sourceRepo string // Source repository the target was synced from
+}
+
+// SyncSummary is the run-wide artifact written to summary.json
+type SyncSummary struct {
+	StartedAt  time.Time    `json:"started_at"`
+	EndedAt    time.Time    `json:"ended_at"`
+	DurationMs int64        `json:"duration_ms"`
+	DryRun     bool         `json:"dry_run,omitempty"`
+	Targets    int          `json:"targets"`
+	Succeeded  int          `json:"succeeded"`
+	Failed     int          `json:"failed"`
+	Results    []SyncResult `json:"results"`
+}
+
+// artifactFileName returns the artifact file name for a target repository
+func artifactFileName(repo string) string {
+	return artifactSlugPattern.ReplaceAllString(repo, "_") + ".json"
+}
+
+// newSyncResult builds the artifact for this target from the values Execute
+// finished with
+func (rs *RepositorySync) newSyncResult(branchName, commitSHA string, allChanges []FileChange, actualChangedFiles []string, syncErr error, statusOverride string) *SyncResult {
+	result := &SyncResult{
+		Repo:         rs.target.Repo,
+		Status:       statusOverride,
+		DryRun:       rs.engine.options.DryRun,
+		SourceCommit: rs.sourceState.LatestCommit,
+		Branch:       branchName,
+		CommitSHA:    commitSHA,
+		PRNumber:     rs.lastPRNumber,
+		PRURL:        rs.lastPRURL,
+		PRAction:     rs.lastPRAction,
+		FilesChanged: actualChangedFiles,
+		sourceRepo:   rs.sourceState.Repo,
+	}
+	if currentGroup := rs.engine.GetCurrentGroup(); currentGroup != nil {
+		result.Group = currentGroup.ID
+	}
+	if len(result.FilesChanged) == 0 {
+		result.FilesChanged = make([]string, 0, len(allChanges))
+		for _, change := range allChanges {
+			result.FilesChanged = append(result.FilesChanged, change.Path)
+		}
+	}
+	if rs.syncMetrics != nil {
+		result.StartedAt = rs.syncMetrics.StartTime
+		result.DurationMs = time.Since(rs.syncMetrics.StartTime).Milliseconds()
+	}
+	result.setError(syncErr)
+	if result.Status == "" {
+		result.Status = TargetStatusSuccess
+	}
+	return result
+}
+
+// setError marks the result failed with err; a nil err leaves it unchanged
+func (r *SyncResult) setError(err error) {
+	if err == nil {
+		return
+	}
+	r.Status = TargetStatusFailed
+	r.Error = err.Error()
+}
+
+// prepareOutputDir creates Options.OutputDir so a misconfigured directory
+// fails the run before any target is synced
+func (e *Engine) prepareOutputDir() error {
+	if e.options.OutputDir == "" {
+		return nil
+	}
+	run := e.shared()
+	run.artifactsStart = time.Now()
+	if err := os.MkdirAll(e.options.OutputDir, 0o750); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	return nil
+}
+
+// collectsResults reports whether a SyncResult is kept for every target,
+// for the OutputDir artifacts, the SummaryOnly summary or the source status
+// comment
+func (e *Engine) collectsResults() bool {
+	return e.options.OutputDir != "" || e.options.SummaryOnly || e.options.SourceStatusIssue > 0
+}
+
+// recordSyncResult keeps a target's result for summary.json and SyncResults,
+// and writes its artifact when OutputDir is set. Safe for concurrent use by
+// the target worker pool. Write failures are logged and never fail the sync.
+func (e *Engine) recordSyncResult(result *SyncResult, log *logrus.Entry) {
+	if !e.collectsResults() || result == nil {
+		return
+	}
+
+	e.appendSyncResult(*result)
+
+	if e.options.OutputDir == "" {
+		return
+	}
+	path := filepath.Join(e.options.OutputDir, artifactFileName(result.Repo))
+	if err := writeJSONArtifact(path, result); err != nil {
+		log.WithError(err).WithField("path", path).Warn("Failed to write sync result artifact")
+	}
+}
+
+// recordSkippedTarget keeps a skipped result for a target that is not synced
+// because needsSync found nothing to do, with its newest open sync PR if any.
+// Only SummaryOnly and the source status comment report such targets; no
+// artifact is written for them.
+func (e *Engine) recordSkippedTarget(repo string, source state.SourceState, targetState *state.TargetState) {
+	if !e.options.SummaryOnly && e.options.SourceStatusIssue <= 0 {
+		return
+	}
+	result := SyncResult{
+		Repo:         repo,
+		Status:       TargetStatusSkipped,
+		DryRun:       e.options.DryRun,
+		SourceCommit: source.LatestCommit,
+		FilesChanged: []string{},
+		sourceRepo:   source.Repo,
+	}
+	if pr := newestOpenPR(targetState); pr != nil {
+		number := pr.Number
+		result.PRNumber = &number
+		result.PRURL = e.pullRequestURL(repo, number)
+	}
+	if currentGroup := e.GetCurrentGroup(); currentGroup != nil {
+		result.Group = currentGroup.ID
+	}
+	e.appendSyncResult(result)
+}
+
+// appendSyncResult keeps result in the run state shared by every group
+func (e *Engine) appendSyncResult(result SyncResult) {
+	run := e.shared()
+	run.artifactsMu.Lock()
+	run.syncResults = append(run.syncResults, result)
+	run.artifactsMu.Unlock()
+}
+
+// SyncResults returns the result of every target of the last Sync, in the
+// order they finished. Results are only collected with Options.OutputDir or
+// Options.SummaryOnly set.
+func (e *Engine) SyncResults() []SyncResult {
+	run := e.shared()
+	run.artifactsMu.Lock()
+	defer run.artifactsMu.Unlock()
+	return append([]SyncResult(nil), run.syncResults...)
+}
+
+// writeSyncSummary writes summary.json once all groups have finished
+func (e *Engine) writeSyncSummary(log *logrus.Entry) {
+	if e.options.OutputDir == "" {
+		return
+	}
+	run := e.shared()
+	run.artifactsMu.Lock()
+	results := append([]SyncResult(nil), run.syncResults...)
+	run.artifactsMu.Unlock()
+
+	endedAt := time.Now()
+	summary := SyncSummary{
+		StartedAt:  run.artifactsStart,
+		EndedAt:    endedAt,
+		DurationMs: endedAt.Sub(run.artifactsStart).Milliseconds(),
+		DryRun:     e.options.DryRun,
+		Targets:    len(results),
+		Results:    results,
+	}
+	if summary.Results == nil {
+		summary.Results = []SyncResult{}
+	}
+	for _, result := range results {
+		if result.Status == TargetStatusFailed {
+			summary.Failed++
+		} else {
+			summary.Succeeded++
+		}
+	}
+
+	path := filepath.Join(e.options.OutputDir, summaryArtifactName)
+	if err := writeJSONArtifact(path, summary); err != nil {
+		log.WithError(err).WithField("path", path).Warn("Failed to write sync summary artifact")
+	}
+}
+
+// writeJSONArtifact writes v as indented JSON to path
+func writeJSONArtifact(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o600)
+}
@@ -0,0 +1,139 @@
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/git"
+	"github.com/mrz1836/go-broadcast/internal/state"
+	"github.com/mrz1836/go-broadcast/internal/transform"
+)
+
+func TestArtifactFileName(t *testing.T) {
+	assert.Equal(t, "org_repo.json", artifactFileName("org/repo"))
+	assert.Equal(t, "my-org_my.repo.json", artifactFileName("my-org/my.repo"))
+	assert.Equal(t, "org_bad_name.json", artifactFileName("org/bad name"))
+}
+
+// readArtifact decodes a JSON artifact from the output directory
+func readArtifact(t *testing.T, path string, v interface{}) {
+	t.Helper()
+	data, err := os.ReadFile(path) //nolint:gosec // test-controlled path
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, v))
+}
+
+func TestEngine_SyncResultArtifacts(t *testing.T) {
+	outputDir := filepath.Join(t.TempDir(), "artifacts")
+
+	group := config.Group{
+		Name:   "core",
+		ID:     "core-id",
+		Source: config.SourceConfig{Repo: "org/template", Branch: "master"},
+		Targets: []config.TargetConfig{{
+			Repo:  "org/target",
+			Files: []config.FileMapping{{Src: "file.txt", Dest: "file.txt"}},
+		}},
+	}
+	currentState := &state.State{
+		Source: state.SourceState{Repo: "org/template", Branch: "master", LatestCommit: "abc123"},
+		Targets: map[string]*state.TargetState{
+			"org/target": {Repo: "org/target", LastSyncCommit: "old", Status: state.StatusBehind},
+		},
+	}
+
+	ghClient := &gh.MockClient{}
+	ghClient.On("ListBranches", mock.Anything, mock.Anything).Return([]gh.Branch{}, nil).Maybe()
+	ghClient.On("GetFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, gh.ErrFileNotFound).Maybe()
+	gitClient := &git.MockClient{}
+	gitClient.On("Clone", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errGitCloneFailed)
+
+	engine := NewEngine(context.Background(), &config.Config{Groups: []config.Group{group}}, ghClient, gitClient,
+		&state.MockDiscoverer{}, &transform.MockChain{}, DefaultOptions().WithOutputDir(outputDir))
+	engine.SetLogger(logrus.New())
+	require.NoError(t, engine.prepareOutputDir())
+	require.DirExists(t, outputDir)
+
+	// Results recorded by a per-group view land on the root engine
+	groupEngine := engine.forGroup(engine.config, &group)
+	err := groupEngine.syncRepository(context.Background(), group.Targets[0], currentState, NewProgressTracker(1, false))
+	require.Error(t, err)
+
+	var result SyncResult
+	readArtifact(t, filepath.Join(outputDir, "org_target.json"), &result)
+	assert.Equal(t, "org/target", result.Repo)
+	assert.Equal(t, "core-id", result.Group)
+	assert.Equal(t, TargetStatusFailed, result.Status)
+	assert.Equal(t, "abc123", result.SourceCommit)
+	assert.Contains(t, result.Error, errGitCloneFailed.Error())
+	assert.NotNil(t, result.FilesChanged)
+
+	engine.writeSyncSummary(logrus.NewEntry(logrus.New()))
+
+	var summary SyncSummary
+	readArtifact(t, filepath.Join(outputDir, summaryArtifactName), &summary)
+	assert.Equal(t, 1, summary.Targets)
+	assert.Equal(t, 1, summary.Failed)
+	assert.Equal(t, 0, summary.Succeeded)
+	require.Len(t, summary.Results, 1)
+	assert.Equal(t, "org/target", summary.Results[0].Repo)
+}
+
+func TestEngine_SyncResultArtifacts_Disabled(t *testing.T) {
+	engine := NewEngine(context.Background(), &config.Config{}, nil, nil, nil, nil, DefaultOptions())
+	require.NoError(t, engine.prepareOutputDir())
+
+	engine.recordSyncResult(&SyncResult{Repo: "org/target"}, logrus.NewEntry(logrus.New()))
+	engine.writeSyncSummary(logrus.NewEntry(logrus.New()))
+	assert.Empty(t, engine.shared().syncResults)
+}
+
+func TestEngine_Sync_OutputDirCreationFails(t *testing.T) {
+	blocker := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(blocker, []byte("x"), 0o600))
+
+	engine := NewEngine(context.Background(), &config.Config{}, nil, nil, nil, nil,
+		DefaultOptions().WithOutputDir(filepath.Join(blocker, "artifacts")))
+	engine.SetLogger(logrus.New())
+
+	err := engine.Sync(context.Background(), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to create output directory")
+}
+
+func TestEngine_SyncResults_SummaryOnly(t *testing.T) {
+	group := config.Group{ID: "core-id", Targets: []config.TargetConfig{{Repo: "org/current"}, {Repo: "org/behind"}}}
+	currentState := &state.State{
+		Source: state.SourceState{Repo: "org/template", LatestCommit: "abc123"},
+		Targets: map[string]*state.TargetState{
+			"org/current": {Repo: "org/current", LastSyncCommit: "abc123", Status: state.StatusUpToDate},
+			"org/behind":  {Repo: "org/behind", LastSyncCommit: "old", Status: state.StatusBehind},
+		},
+	}
+
+	engine := NewEngine(context.Background(), &config.Config{Groups: []config.Group{group}}, nil, nil, nil, nil,
+		DefaultOptions().WithSummaryOnly(true))
+	engine.SetLogger(logrus.New())
+	groupEngine := engine.forGroup(engine.config, &group)
+
+	targets, err := groupEngine.filterTargetsFromList(group.Targets, currentState)
+	require.NoError(t, err)
+	require.Len(t, targets, 1)
+	groupEngine.recordSyncResult(&SyncResult{Repo: "org/behind", Status: TargetStatusSuccess, PRAction: PRActionCreated}, logrus.NewEntry(logrus.New()))
+
+	results := engine.SyncResults()
+	require.Len(t, results, 2)
+	assert.Equal(t, SyncResult{Repo: "org/current", Group: "core-id", Status: TargetStatusSkipped, SourceCommit: "abc123", FilesChanged: []string{}, sourceRepo: "org/template"}, results[0])
+	assert.Equal(t, PRActionCreated, results[1].PRAction)
+	assert.Equal(t, results, groupEngine.SyncResults())
+}
@@ -0,0 +1,48 @@
+package sync
+
+import (
+	"sort"
+)
+
+// getAutoLabels returns the group's auto_labels: path glob -> label
+func (rs *RepositorySync) getAutoLabels() map[string]string {
+	if currentGroup := rs.engine.GetCurrentGroup(); currentGroup != nil {
+		return currentGroup.Defaults.AutoLabels
+	}
+	if len(rs.engine.config.Groups) > 0 {
+		return rs.engine.config.Groups[0].Defaults.AutoLabels
+	}
+	return nil
+}
+
+// autoLabels returns the labels whose globs match a path in changes, once
+// each, ordered by glob. Globs use the same gitignore-style syntax as
+// directory exclusions.
+func (rs *RepositorySync) autoLabels(changes []FileChange) []string {
+	autoLabels := rs.getAutoLabels()
+	if len(autoLabels) == 0 || len(changes) == 0 {
+		return nil
+	}
+
+	globs := make([]string, 0, len(autoLabels))
+	for glob := range autoLabels {
+		globs = append(globs, glob)
+	}
+	sort.Strings(globs)
+
+	var matcher ExclusionEngine
+	labels := make([]string, 0, len(globs))
+	for _, glob := range globs {
+		pattern := matcher.compilePattern(glob)
+		if pattern.regex == nil {
+			continue
+		}
+		for _, change := range changes {
+			if pattern.regex.MatchString(change.Path) {
+				labels = append(labels, autoLabels[glob])
+				break
+			}
+		}
+	}
+	return labels
+}
@@ -0,0 +1,70 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+func TestRepositorySync_getPRLabels_AutoLabels(t *testing.T) {
+	engine := &Engine{
+		config: &config.Config{Groups: []config.Group{{
+			Global: config.GlobalConfig{PRLabels: []string{"sync", "ci"}},
+			Defaults: config.DefaultConfig{AutoLabels: map[string]string{
+				".github/**":              "ci",
+				".github/workflows/*.yml": "workflows",
+				"docs/**":                 "documentation",
+				"**/*.md":                 "documentation",
+				"Makefile":                "build",
+			}},
+		}}},
+		options: DefaultOptions(),
+	}
+	rs := &RepositorySync{engine: engine, target: config.TargetConfig{Repo: "org/target"}}
+
+	testCases := []struct {
+		name     string
+		paths    []string
+		expected []string
+	}{
+		{
+			name:     "no changes keeps the configured labels",
+			expected: []string{"sync", "ci"},
+		},
+		{
+			name:     "overlapping globs add each label once",
+			paths:    []string{".github/workflows/test.yml", "docs/guide.md", "README.md"},
+			expected: []string{"sync", "ci", "documentation", "workflows"},
+		},
+		{
+			name:     "a nested path matches a ** glob only",
+			paths:    []string{".github/workflows/nested/deploy.yml"},
+			expected: []string{"sync", "ci"},
+		},
+		{
+			name:     "deletions count as changes",
+			paths:    []string{"Makefile"},
+			expected: []string{"sync", "ci", "build"},
+		},
+		{
+			name:     "unmatched paths add nothing",
+			paths:    []string{"main.go"},
+			expected: []string{"sync", "ci"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			changes := make([]FileChange, 0, len(tc.paths))
+			for _, path := range tc.paths {
+				changes = append(changes, FileChange{Path: path, IsDeleted: path == "Makefile"})
+			}
+			assert.Equal(t, tc.expected, rs.getPRLabels(changes))
+		})
+	}
+
+	// Auto labels never leak into the settings shared with other targets
+	assert.Equal(t, []string{"sync", "ci"}, rs.getPRLabels(nil))
+}
@@ -26,6 +26,8 @@ type BatchProcessor struct {
 	sourceState *state.SourceState
 	logger      *logrus.Entry
 	workerCount int
+	// gitAttributes holds the source's binary declarations, loaded per batch
+	gitAttributes *transform.GitAttributes
 }
 
 // FileJob represents a file processing job
@@ -64,6 +66,7 @@ func (bp *BatchProcessor) ProcessFiles(ctx context.Context, sourcePath string, j
 	}
 
 	bp.logger.WithField("job_count", len(jobs)).Info("Starting batch file processing")
+	bp.gitAttributes = loadSourceGitAttributes(bp.engine, sourcePath, bp.logger)
 
 	// Create channels for job distribution.
 	// Cap buffer to prevent unbounded allocation for large job lists (CWE-400).
@@ -118,7 +121,7 @@ func (bp *BatchProcessor) ProcessFiles(ctx context.Context, sourcePath string, j
 	}
 
 	// Collect results
-	return bp.collectResults(resultChan), nil
+	return bp.collectResults(resultChan)
 }
 
 // fileProcessResult represents the result of processing a single file
@@ -207,13 +210,24 @@ func (bp *BatchProcessor) processFileJobWithReporter(ctx context.Context, source
 		logger.Debug("Processing regular file job")
 	}
 
-	// Build full source path
-	fullSourcePath := filepath.Join(sourcePath, job.SourcePath)
+	// Build full source path, resolving symlinks but never leaving the source tree
+	fullSourcePath, statErr := resolveSourcePath(sourcePath, job.SourcePath)
+	if errors.Is(statErr, ErrSourcePathEscapes) {
+		logger.WithError(statErr).Warn("Source file resolves outside the source tree, skipping")
+		return fileProcessResult{
+			Change: nil,
+			Error:  internalerrors.ErrFileNotFound,
+			Job:    job,
+		}
+	}
 	logger.WithField("full_source_path", fullSourcePath).Debug("Reading source file")
 
 	// Check if source file exists and enforce size limit before reading (CWE-400).
 	const maxFileSizeBytes = 10 * 1024 * 1024 // 10 MB
-	fi, statErr := os.Stat(fullSourcePath)
+	var fi os.FileInfo
+	if statErr == nil {
+		fi, statErr = os.Stat(fullSourcePath)
+	}
 	if statErr != nil {
 		if os.IsNotExist(statErr) {
 			logger.Debug("Source file not found, skipping")
@@ -239,7 +253,7 @@ func (bp *BatchProcessor) processFileJobWithReporter(ctx context.Context, source
 		}
 	}
 
-	srcContent, err := os.ReadFile(fullSourcePath) //nolint:gosec // Path is constructed from trusted configuration
+	srcContent, err := os.ReadFile(fullSourcePath) //nolint:gosec // Resolved inside the source tree
 	if err != nil {
 		logger.WithError(err).Error("Failed to read source file")
 		return fileProcessResult{
@@ -253,7 +267,16 @@ func (bp *BatchProcessor) processFileJobWithReporter(ctx context.Context, source
 	logger.WithField("content_size", len(srcContent)).Debug("Source file content loaded")
 
 	// Check for binary content before applying transformations
-	if transform.IsBinary(job.SourcePath, srcContent) {
+	if IsBinarySource(bp.gitAttributes, job.SourcePath, srcContent) {
+		if !job.Transform.IsEmpty() {
+			if err := bp.engine.checkBinaryTransform(logger, job.SourcePath); err != nil {
+				return fileProcessResult{
+					Change: nil,
+					Error:  err,
+					Job:    job,
+				}
+			}
+		}
 		metrics.BinaryFilesSkipped++
 
 		// Report binary file metrics to progress reporter
@@ -310,7 +333,7 @@ func (bp *BatchProcessor) processFileJobWithReporter(ctx context.Context, source
 
 	// Apply transformations with enhanced context and error isolation
 	transformedContent := srcContent
-	if job.Transform.RepoName || len(job.Transform.Variables) > 0 {
+	if !job.Transform.IsEmpty() {
 		transformStart := time.Now()
 		logger.WithFields(logrus.Fields{
 			"repo_name_transform": job.Transform.RepoName,
@@ -318,47 +341,33 @@ func (bp *BatchProcessor) processFileJobWithReporter(ctx context.Context, source
 			"variables":           job.Transform.Variables,
 		}).Debug("Starting content transformation")
 
-		// Create appropriate transform context based on job type
-		var transformContext transform.Context
-		if job.IsFromDirectory && job.DirectoryMapping != nil {
-			// Use DirectoryTransformContext for directory-aware transformations
-			baseCtx := transform.Context{
-				SourceRepo: bp.sourceState.Repo,
-				TargetRepo: bp.target.Repo,
-				FilePath:   job.DestPath,
-				Variables:  job.Transform.Variables,
-				LogConfig: &logging.LogConfig{
-					Debug: logging.DebugFlags{
-						Transform: bp.logger.Level >= logrus.DebugLevel,
-					},
-					Verbose: func() int {
-						if bp.logger.Level >= logrus.DebugLevel {
-							return 2
-						}
-						return 0
-					}(),
-				},
+		topics, topicsErr := bp.engine.conditionalBlockTopics(ctx, bp.target)
+		if topicsErr != nil {
+			return fileProcessResult{
+				Change: nil,
+				Error:  topicsErr,
+				Job:    job,
 			}
+		}
 
-			// Add email configuration if available
-			if currentGroup := bp.engine.GetCurrentGroup(); currentGroup != nil {
-				baseCtx.SourceSecurityEmail = currentGroup.Source.SecurityEmail
-				baseCtx.SourceSupportEmail = currentGroup.Source.SupportEmail
-				// Use target-specific emails if set, otherwise use source emails
-				if bp.target.SecurityEmail != "" {
-					baseCtx.TargetSecurityEmail = bp.target.SecurityEmail
-				} else {
-					baseCtx.TargetSecurityEmail = currentGroup.Source.SecurityEmail
-				}
-				if bp.target.SupportEmail != "" {
-					baseCtx.TargetSupportEmail = bp.target.SupportEmail
-				} else {
-					baseCtx.TargetSupportEmail = currentGroup.Source.SupportEmail
+		// Create appropriate transform context based on job type
+		transformContext := NewTransformContext(bp.engine.GetCurrentGroup(), bp.sourceState.Repo, bp.target, job.Transform,
+			job.SourcePath, job.DestPath, topics)
+		transformContext.LogConfig = &logging.LogConfig{
+			Debug: logging.DebugFlags{
+				Transform: bp.logger.Level >= logrus.DebugLevel,
+			},
+			Verbose: func() int {
+				if bp.logger.Level >= logrus.DebugLevel {
+					return 2
 				}
-			}
-
+				return 0
+			}(),
+		}
+		if job.IsFromDirectory && job.DirectoryMapping != nil {
+			// Use DirectoryTransformContext for directory-aware transformations
 			dirTransformCtx := transform.NewDirectoryTransformContext(
-				baseCtx,
+				transformContext,
 				job.DirectoryMapping,
 				job.RelativePath,
 				job.FileIndex,
@@ -374,47 +383,19 @@ func (bp *BatchProcessor) processFileJobWithReporter(ctx context.Context, source
 			// DirectoryTransformContext embeds Context, so we can use it directly
 			transformContext = dirTransformCtx.Context
 		} else {
-			// Use regular Context for single file transformations
-			transformContext = transform.Context{
-				SourceRepo: bp.sourceState.Repo,
-				TargetRepo: bp.target.Repo,
-				FilePath:   job.DestPath,
-				Variables:  job.Transform.Variables,
-				LogConfig: &logging.LogConfig{
-					Debug: logging.DebugFlags{
-						Transform: bp.logger.Level >= logrus.DebugLevel,
-					},
-					Verbose: func() int {
-						if bp.logger.Level >= logrus.DebugLevel {
-							return 2
-						}
-						return 0
-					}(),
-				},
-			}
-
-			// Add email configuration if available
-			if currentGroup := bp.engine.GetCurrentGroup(); currentGroup != nil {
-				transformContext.SourceSecurityEmail = currentGroup.Source.SecurityEmail
-				transformContext.SourceSupportEmail = currentGroup.Source.SupportEmail
-				// Use target-specific emails if set, otherwise use source emails
-				if bp.target.SecurityEmail != "" {
-					transformContext.TargetSecurityEmail = bp.target.SecurityEmail
-				} else {
-					transformContext.TargetSecurityEmail = currentGroup.Source.SecurityEmail
-				}
-				if bp.target.SupportEmail != "" {
-					transformContext.TargetSupportEmail = bp.target.SupportEmail
-				} else {
-					transformContext.TargetSupportEmail = currentGroup.Source.SupportEmail
-				}
-			}
-
 			logger.WithField("transform_context", fmt.Sprintf("%+v", transformContext)).Debug("Using regular TransformContext")
 		}
 
 		// Apply transformation with error isolation - don't fail entire batch on transform errors
-		transformedContent, err = bp.engine.transform.Transform(ctx, srcContent, transformContext)
+		chain, chainErr := bp.engine.transformChainFor(job.Transform)
+		if chainErr != nil {
+			return fileProcessResult{
+				Change: nil,
+				Error:  chainErr,
+				Job:    job,
+			}
+		}
+		transformedContent, err = chain.Transform(ctx, srcContent, transformContext)
 		transformDuration := time.Since(transformStart)
 
 		logger.WithFields(logrus.Fields{
@@ -432,6 +413,20 @@ func (bp *BatchProcessor) processFileJobWithReporter(ctx context.Context, source
 				progressReporter.RecordTransformError()
 			}
 
+			// A template that fails to render has no meaningful fallback, and
+			// falling back would sync a file configured for scrubbing with its
+			// secrets intact, or one with conditional blocks with regions meant
+			// for other targets; fail this file
+			if errors.Is(err, transform.ErrTemplateRender) || job.Transform.SecretScrub != nil ||
+				errors.Is(err, transform.ErrInvalidConditionalBlock) {
+				logger.WithError(err).Error("Template rendering failed")
+				return fileProcessResult{
+					Change: nil,
+					Error:  err,
+					Job:    job,
+				}
+			}
+
 			// Log error but don't fail the entire batch - use original content as fallback
 			logger.WithError(err).WithFields(logrus.Fields{
 				"fallback_strategy":     "use_original_content",
@@ -512,9 +507,12 @@ func (bp *BatchProcessor) processFileJobWithReporter(ctx context.Context, source
 	}
 }
 
-// collectResults collects and filters results from the result channel
-func (bp *BatchProcessor) collectResults(resultChan <-chan fileProcessResult) []FileChange {
+// collectResults collects and filters results from the result channel. Files
+// that fail are logged and left out, except that a binary file refused by the
+// binary transform policy fails the whole batch.
+func (bp *BatchProcessor) collectResults(resultChan <-chan fileProcessResult) ([]FileChange, error) {
 	var changes []FileChange
+	var binaryErr error
 	var errorCount int
 	var skipCount int
 	var directoryFilesCount int
@@ -552,6 +550,9 @@ func (bp *BatchProcessor) collectResults(resultChan <-chan fileProcessResult) []
 
 			// For other errors, log but continue processing other files
 			errorCount++
+			if binaryErr == nil && errors.Is(result.Error, ErrBinaryTransform) {
+				binaryErr = result.Error
+			}
 			bp.logger.WithError(result.Error).WithFields(logrus.Fields{
 				"file":              result.Job.SourcePath,
 				"is_from_directory": result.Job.IsFromDirectory,
@@ -572,7 +573,10 @@ func (bp *BatchProcessor) collectResults(resultChan <-chan fileProcessResult) []
 		"regular_files":   len(changes) + skipCount + errorCount - directoryFilesCount,
 	}).Info("Batch processing completed with enhanced metrics")
 
-	return changes
+	if binaryErr != nil {
+		return nil, binaryErr
+	}
+	return changes, nil
 }
 
 // getExistingFileContent retrieves the current content of a file from the target repo
@@ -659,6 +663,7 @@ func (bp *BatchProcessor) ProcessFilesWithProgress(ctx context.Context, sourcePa
 	}
 
 	bp.logger.WithField("job_count", len(jobs)).Info("Starting batch file processing with progress reporting")
+	bp.gitAttributes = loadSourceGitAttributes(bp.engine, sourcePath, bp.logger)
 
 	// Create channels for job distribution.
 	// Cap buffer to prevent unbounded allocation for large job lists (CWE-400).
@@ -725,7 +730,7 @@ func (bp *BatchProcessor) ProcessFilesWithProgress(ctx context.Context, sourcePa
 	}
 
 	// Collect results
-	return bp.collectResults(resultChan), nil
+	return bp.collectResults(resultChan)
 }
 
 // workerWithProgress processes files with progress updates
@@ -733,8 +738,9 @@ func (bp *BatchProcessor) workerWithProgress(ctx context.Context, workerID int,
 	workerLogger := bp.logger.WithField("worker_id", workerID)
 	workerLogger.Debug("Starting batch processor worker with progress tracking")
 
-	// Try to cast to enhanced progress reporter
+	// Try to cast to enhanced and per-file progress reporters
 	enhancedReporter, _ := progressReporter.(EnhancedProgressReporter)
+	fileReporter, _ := progressReporter.(FileProgressReporter)
 
 	for {
 		select {
@@ -767,6 +773,9 @@ func (bp *BatchProcessor) workerWithProgress(ctx context.Context, workerID int,
 				mu.Unlock()
 
 				progressReporter.UpdateProgress(currentCount, totalJobs, fmt.Sprintf("Processing files... (%d/%d)", currentCount, totalJobs))
+				if fileReporter != nil {
+					fileReporter.FileProcessed(currentCount, totalJobs, job.DestPath)
+				}
 			}
 
 		case <-ctx.Done():
@@ -789,3 +798,10 @@ type EnhancedProgressReporter interface {
 	RecordTransformSuccess(duration time.Duration)
 	RecordFileChanged()
 }
+
+// FileProgressReporter extends ProgressReporter with the path of each
+// processed file, for reporters that show which file is being worked on
+type FileProgressReporter interface {
+	ProgressReporter
+	FileProcessed(current, total int, path string)
+}
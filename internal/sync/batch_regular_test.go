@@ -446,7 +446,8 @@ func TestBatchProcessor_CollectResults(t *testing.T) {
 
 	close(resultChan)
 
-	changes := processor.collectResults(resultChan)
+	changes, err := processor.collectResults(resultChan)
+	require.NoError(t, err)
 
 	// Should only have success results
 	require.Len(t, changes, 2)
@@ -1169,7 +1169,8 @@ func (suite *BatchProcessorTestSuite) TestCollectResults() {
 
 		close(resultChan)
 
-		changes := processor.collectResults(resultChan)
+		changes, err := processor.collectResults(resultChan)
+		require.NoError(t, err)
 
 		// Should only have success results
 		require.Len(t, changes, 2)
@@ -0,0 +1,43 @@
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/mrz1836/go-broadcast/internal/algorithms"
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/transform"
+)
+
+// ErrBinaryTransform indicates a binary source file has content transforms
+// configured and the binary transform policy is fail
+var ErrBinaryTransform = errors.New("binary file has transforms configured")
+
+// IsBinarySource reports whether a source file is binary and must never be
+// transformed. attrs are the source's root .gitattributes, nil when absent or
+// ignored. A .gitattributes declaration wins; otherwise the file is binary
+// when its extension or content says so, or when algorithms.IsBinaryOptimized
+// flags content that is not valid UTF-8, which catches binaries behind a text
+// extension without taking non-ASCII text for one.
+func IsBinarySource(attrs *transform.GitAttributes, filePath string, content []byte) bool {
+	if binary, declared := attrs.Binary(filePath); declared {
+		return binary
+	}
+	return transform.IsBinary(filePath, content) ||
+		(algorithms.IsBinaryOptimized(content) && !utf8.Valid(content))
+}
+
+// checkBinaryTransform applies the binary transform policy to a binary source
+// file whose target has transforms configured. Under the default skip policy
+// it warns and returns nil so the file is synced untransformed; under the fail
+// policy it returns ErrBinaryTransform.
+func (e *Engine) checkBinaryTransform(logger *logrus.Entry, filePath string) error {
+	if e != nil && e.options != nil && e.options.BinaryTransformPolicy == config.BinaryTransformPolicyFail {
+		return fmt.Errorf("%w: %s", ErrBinaryTransform, filePath)
+	}
+	logger.WithField("file", filePath).Warn("Binary file has transforms configured, syncing it untransformed")
+	return nil
+}
@@ -0,0 +1,120 @@
+package sync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	internalerrors "github.com/mrz1836/go-broadcast/internal/errors"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/state"
+	"github.com/mrz1836/go-broadcast/internal/transform"
+)
+
+// binaryWithRepoName is PNG-like content that embeds the source repository
+// name, which the repo name transform would rewrite and corrupt
+var binaryWithRepoName = []byte("\x89PNG\r\n\x1a\n\x00\x00org/template\x00\xff\xfe")
+
+// writeBinarySource creates a source checkout holding binaryWithRepoName both
+// as logo.png and behind a text extension as notes.txt, plus a text file
+func writeBinarySource(t *testing.T) string {
+	t.Helper()
+	sourceDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "logo.png"), binaryWithRepoName, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "notes.txt"), binaryWithRepoName, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "README.md"), []byte("see org/template"), 0o600))
+	return sourceDir
+}
+
+// newRepoNameChain returns a real transform chain that renames the repository
+func newRepoNameChain() transform.Chain {
+	return transform.NewChain(logrus.New()).Add(transform.NewRepoTransformer())
+}
+
+func TestIsBinarySource(t *testing.T) {
+	cjk := []byte(strings.Repeat("日本語のテキスト\n", 20))
+
+	assert.True(t, IsBinarySource(nil, "logo.png", []byte("plain")))
+	assert.True(t, IsBinarySource(nil, "notes.txt", binaryWithRepoName), "binary content behind a text extension")
+	assert.False(t, IsBinarySource(nil, "README.md", cjk), "non-ASCII UTF-8 text is not binary")
+	assert.False(t, IsBinarySource(nil, "README.md", []byte("see org/template")))
+}
+
+func TestBatchProcessor_BinaryTransformPolicy(t *testing.T) {
+	sourceDir := writeBinarySource(t)
+	jobs := []FileJob{
+		NewFileJob("logo.png", "logo.png", config.Transform{RepoName: true}),
+		NewFileJob("notes.txt", "notes.txt", config.Transform{RepoName: true}),
+		NewFileJob("README.md", "README.md", config.Transform{RepoName: true}),
+	}
+
+	run := func(t *testing.T, policy string) ([]FileChange, error) {
+		t.Helper()
+		mockGH := &gh.MockClient{}
+		mockGH.On("GetFile", mock.Anything, "org/target", mock.Anything, "").Return(nil, internalerrors.ErrFileNotFound)
+		engine := &Engine{
+			gh:        mockGH,
+			transform: newRepoNameChain(),
+			options:   DefaultOptions().WithBinaryTransformPolicy(policy),
+		}
+		processor := NewBatchProcessor(engine, config.TargetConfig{Repo: "org/target"},
+			&state.SourceState{Repo: "org/template", LatestCommit: "abc123"}, logrus.NewEntry(logrus.New()), 1)
+		return processor.ProcessFiles(context.Background(), sourceDir, jobs)
+	}
+
+	t.Run("skip passes binary files through untouched", func(t *testing.T) {
+		changes, err := run(t, config.BinaryTransformPolicySkip)
+		require.NoError(t, err)
+		content := make(map[string][]byte, len(changes))
+		for _, change := range changes {
+			content[change.Path] = change.Content
+		}
+		assert.Equal(t, binaryWithRepoName, content["logo.png"])
+		assert.Equal(t, binaryWithRepoName, content["notes.txt"])
+		assert.Equal(t, []byte("see org/target"), content["README.md"])
+	})
+
+	t.Run("fail refuses binary files with transforms", func(t *testing.T) {
+		_, err := run(t, config.BinaryTransformPolicyFail)
+		require.ErrorIs(t, err, ErrBinaryTransform)
+	})
+}
+
+func TestRepositorySync_BinaryTransformPolicy(t *testing.T) {
+	target := config.TargetConfig{
+		Repo:      "org/target",
+		Files:     []config.FileMapping{{Src: "logo.png", Dest: "logo.png"}, {Src: "notes.txt", Dest: "notes.txt"}},
+		Transform: config.Transform{RepoName: true},
+	}
+
+	run := func(t *testing.T, policy string) ([]FileChange, error) {
+		t.Helper()
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/target", mock.Anything, "").Return(nil, gh.ErrFileNotFound)
+		rs := newTestRepoSync(ghClient, newRepoNameChain(), target, DefaultOptions().WithBinaryTransformPolicy(policy))
+		rs.tempDir = t.TempDir()
+		require.NoError(t, os.Rename(writeBinarySource(t), filepath.Join(rs.tempDir, "source")))
+		return rs.processFiles(context.Background())
+	}
+
+	t.Run("skip passes binary files through untouched", func(t *testing.T) {
+		changes, err := run(t, "")
+		require.NoError(t, err)
+		require.Len(t, changes, 2)
+		assert.Equal(t, binaryWithRepoName, changes[0].Content)
+		assert.Equal(t, binaryWithRepoName, changes[1].Content)
+	})
+
+	t.Run("fail refuses binary files with transforms", func(t *testing.T) {
+		_, err := run(t, config.BinaryTransformPolicyFail)
+		require.ErrorIs(t, err, ErrBinaryTransform)
+	})
+}
@@ -0,0 +1,179 @@
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ErrInvalidCheckpoint indicates the checkpoint file could not be parsed
+var ErrInvalidCheckpoint = errors.New("invalid checkpoint file")
+
+// Checkpoint is the resume state written to Options.CheckpointFile
+type Checkpoint struct {
+	UpdatedAt time.Time          `json:"updated_at"`
+	Completed []CheckpointTarget `json:"completed"`
+}
+
+// CheckpointTarget is a target a run synced successfully. A rerun only skips
+// it while its group's source is still at SourceCommit.
+type CheckpointTarget struct {
+	Group        string    `json:"group,omitempty"`
+	Repo         string    `json:"repo"`
+	SourceCommit string    `json:"source_commit"`
+	CompletedAt  time.Time `json:"completed_at"`
+}
+
+// checkpointKey identifies a target within its group
+func checkpointKey(group, repo string) string {
+	return group + "\x00" + repo
+}
+
+// currentGroupID returns the ID of the group being synced, or an empty string
+func (e *Engine) currentGroupID() string {
+	if group := e.GetCurrentGroup(); group != nil {
+		return group.ID
+	}
+	return ""
+}
+
+// loadCheckpoint reads Options.CheckpointFile. A missing file starts an empty
+// checkpoint; an unreadable one fails the run before any target is synced.
+func (e *Engine) loadCheckpoint(log *logrus.Entry) error {
+	if e.options.CheckpointFile == "" {
+		return nil
+	}
+	run := e.shared()
+	run.checkpointMu.Lock()
+	defer run.checkpointMu.Unlock()
+	run.checkpoint = make(map[string]CheckpointTarget)
+
+	data, err := os.ReadFile(e.options.CheckpointFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+
+	var checkpoint Checkpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrInvalidCheckpoint, e.options.CheckpointFile, err)
+	}
+	for _, target := range checkpoint.Completed {
+		run.checkpoint[checkpointKey(target.Group, target.Repo)] = target
+	}
+	if len(checkpoint.Completed) > 0 {
+		log.WithFields(logrus.Fields{
+			"path":    e.options.CheckpointFile,
+			"targets": len(checkpoint.Completed),
+		}).Info("Resuming from checkpoint")
+	}
+	return nil
+}
+
+// checkpointCompleted reports whether the checkpoint records repo as synced
+// from sourceCommit in the current group
+func (e *Engine) checkpointCompleted(repo, sourceCommit string) bool {
+	run := e.shared()
+	group := e.currentGroupID()
+	run.checkpointMu.Lock()
+	defer run.checkpointMu.Unlock()
+	target, ok := run.checkpoint[checkpointKey(group, repo)]
+	return ok && sourceCommit != "" && target.SourceCommit == sourceCommit
+}
+
+// recordCheckpoint marks repo as completed and rewrites the checkpoint file
+// right away, so an interruption at any point loses no finished target. Dry
+// runs change nothing and record nothing. Write failures are logged and never
+// fail the sync.
+func (e *Engine) recordCheckpoint(repo, sourceCommit string, log *logrus.Entry) {
+	run := e.shared()
+	group := e.currentGroupID()
+	if e.options.CheckpointFile == "" || e.options.DryRun {
+		return
+	}
+	run.checkpointMu.Lock()
+	defer run.checkpointMu.Unlock()
+	if run.checkpoint == nil {
+		run.checkpoint = make(map[string]CheckpointTarget)
+	}
+	run.checkpoint[checkpointKey(group, repo)] = CheckpointTarget{
+		Group:        group,
+		Repo:         repo,
+		SourceCommit: sourceCommit,
+		CompletedAt:  time.Now(),
+	}
+	if err := e.writeCheckpointLocked(); err != nil {
+		log.WithError(err).WithField("path", e.options.CheckpointFile).Warn("Failed to write checkpoint")
+	}
+}
+
+// writeCheckpointLocked writes the checkpoint through a temporary file, so an
+// interruption mid-write never leaves a truncated checkpoint. The caller holds
+// checkpointMu.
+func (e *Engine) writeCheckpointLocked() error {
+	run := e.shared()
+	checkpoint := Checkpoint{
+		UpdatedAt: time.Now(),
+		Completed: make([]CheckpointTarget, 0, len(run.checkpoint)),
+	}
+	for _, target := range run.checkpoint {
+		checkpoint.Completed = append(checkpoint.Completed, target)
+	}
+	sort.Slice(checkpoint.Completed, func(i, j int) bool {
+		if checkpoint.Completed[i].Group != checkpoint.Completed[j].Group {
+			return checkpoint.Completed[i].Group < checkpoint.Completed[j].Group
+		}
+		return checkpoint.Completed[i].Repo < checkpoint.Completed[j].Repo
+	})
+
+	path := e.options.CheckpointFile
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := writeJSONArtifact(tmp, checkpoint); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// finishCheckpoint settles the checkpoint once the run ends. A run that
+// finished without failures removes it; an interrupted or failed run flushes
+// it so the next run with the same checkpoint file resumes where this one
+// stopped.
+func (e *Engine) finishCheckpoint(ctx context.Context, log *logrus.Entry, syncErr error) {
+	if e.options.CheckpointFile == "" || e.options.DryRun {
+		return
+	}
+	run := e.shared()
+	path := e.options.CheckpointFile
+	run.checkpointMu.Lock()
+	defer run.checkpointMu.Unlock()
+
+	if syncErr == nil && ctx.Err() == nil {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.WithError(err).WithField("path", path).Warn("Failed to remove checkpoint")
+		}
+		return
+	}
+	if len(run.checkpoint) == 0 {
+		return
+	}
+	if err := e.writeCheckpointLocked(); err != nil {
+		log.WithError(err).WithField("path", path).Warn("Failed to write checkpoint")
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"path":    path,
+		"targets": len(run.checkpoint),
+	}).Warn("Sync did not finish; rerun with the same checkpoint file to skip completed targets")
+}
@@ -0,0 +1,107 @@
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/state"
+)
+
+var errCheckpointSyncFailed = errors.New("sync failed")
+
+// newCheckpointEngine returns an engine keeping its checkpoint in path
+func newCheckpointEngine(path string) *Engine {
+	engine := NewEngine(context.Background(), &config.Config{}, nil, nil, nil, nil, DefaultOptions().WithCheckpointFile(path))
+	engine.SetLogger(logrus.New())
+	return engine
+}
+
+func TestEngine_Checkpoint(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "checkpoint.json")
+	log := logrus.NewEntry(logrus.New())
+	group := config.Group{ID: "core"}
+
+	engine := newCheckpointEngine(path)
+	require.NoError(t, engine.loadCheckpoint(log))
+
+	// Completions recorded by a per-group view are written right away
+	groupEngine := engine.forGroup(engine.config, &group)
+	groupEngine.recordCheckpoint("org/done", "abc123", log)
+
+	var checkpoint Checkpoint
+	data, err := os.ReadFile(path) //nolint:gosec // test file in a temp dir
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &checkpoint))
+	require.Len(t, checkpoint.Completed, 1)
+	assert.Equal(t, "core", checkpoint.Completed[0].Group)
+	assert.Equal(t, "org/done", checkpoint.Completed[0].Repo)
+	assert.Equal(t, "abc123", checkpoint.Completed[0].SourceCommit)
+
+	// A new run resumes: completed targets are skipped at the same source commit only
+	resumed := newCheckpointEngine(path)
+	require.NoError(t, resumed.loadCheckpoint(log))
+	view := resumed.forGroup(resumed.config, &group)
+	assert.True(t, view.checkpointCompleted("org/done", "abc123"))
+	assert.False(t, view.checkpointCompleted("org/done", "def456"), "a new source commit invalidates the checkpoint")
+	assert.False(t, view.checkpointCompleted("org/pending", "abc123"))
+	assert.False(t, resumed.forGroup(resumed.config, &config.Group{ID: "other"}).checkpointCompleted("org/done", "abc123"))
+
+	targets := []config.TargetConfig{{Repo: "org/done"}, {Repo: "org/pending"}}
+	currentState := &state.State{Source: state.SourceState{LatestCommit: "abc123"}}
+	filtered, err := view.filterTargetsFromList(targets, currentState)
+	require.NoError(t, err)
+	assert.Equal(t, []config.TargetConfig{{Repo: "org/pending"}}, filtered)
+
+	resumed.options.Force = true
+	filtered, err = view.filterTargetsFromList(targets, currentState)
+	require.NoError(t, err)
+	assert.Equal(t, targets, filtered, "--force syncs completed targets again")
+
+	// A failed run keeps the checkpoint, a successful one removes it
+	resumed.finishCheckpoint(context.Background(), log, errCheckpointSyncFailed)
+	require.FileExists(t, path)
+	resumed.finishCheckpoint(context.Background(), log, nil)
+	assert.NoFileExists(t, path)
+}
+
+func TestEngine_Checkpoint_Interrupted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	log := logrus.NewEntry(logrus.New())
+
+	engine := newCheckpointEngine(path)
+	require.NoError(t, engine.loadCheckpoint(log))
+	engine.recordCheckpoint("org/done", "abc123", log)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	engine.finishCheckpoint(ctx, log, nil)
+	assert.FileExists(t, path, "an interrupted run keeps its checkpoint")
+}
+
+func TestEngine_Checkpoint_DryRun(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	log := logrus.NewEntry(logrus.New())
+
+	engine := newCheckpointEngine(path)
+	engine.options.DryRun = true
+	require.NoError(t, engine.loadCheckpoint(log))
+	engine.recordCheckpoint("org/done", "abc123", log)
+	assert.NoFileExists(t, path)
+}
+
+func TestEngine_Checkpoint_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	require.NoError(t, os.WriteFile(path, []byte("{not json"), 0o600))
+
+	err := newCheckpointEngine(path).loadCheckpoint(logrus.NewEntry(logrus.New()))
+	require.ErrorIs(t, err, ErrInvalidCheckpoint)
+}
@@ -0,0 +1,184 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// DefaultChecksTimeout bounds the wait for PR checks when Options.ChecksTimeout is zero
+const DefaultChecksTimeout = 30 * time.Minute
+
+var (
+	// ErrChecksFailed indicates a check run or commit status on the PR head failed
+	ErrChecksFailed = errors.New("PR checks failed")
+
+	// ErrChecksTimeout indicates the PR checks were still running when the
+	// checks timeout elapsed
+	ErrChecksTimeout = errors.New("timed out waiting for PR checks")
+)
+
+// checksPolling is the poll timing used while waiting for PR checks
+type checksPolling struct {
+	initial  time.Duration // Delay before the second poll
+	maxDelay time.Duration // Cap on the delay as it backs off
+	grace    time.Duration // How long checks get to appear on a head that has none
+}
+
+// defaultChecksPolling starts at 10s and backs off to one poll a minute. CI
+// usually reports a queued check within seconds of a push, so a head still
+// without checks after a minute is taken to have none configured.
+var defaultChecksPolling = checksPolling{initial: 10 * time.Second, maxDelay: time.Minute, grace: time.Minute}
+
+// checksState is the outcome of one poll of the PR checks
+type checksState int
+
+const (
+	checksPending checksState = iota // Some checks are still queued or running
+	checksPassed                     // Every check finished without failing
+	checksFailed                     // At least one check failed
+	checksNone                       // No checks or statuses reported yet
+)
+
+// checksPollTiming returns the poll timing for WaitForChecks
+func (e *Engine) checksPollTiming() checksPolling {
+	if e.checksPoll.initial <= 0 {
+		return defaultChecksPolling
+	}
+	return e.checksPoll
+}
+
+// waitsForChecks reports whether auto-merge waits for the PR checks to pass
+// instead of being enabled as soon as the PR is created
+func (rs *RepositorySync) waitsForChecks() bool {
+	opts := rs.engine.options
+	return opts != nil && opts.Automerge && opts.WaitForChecks && !opts.DryRun
+}
+
+// awaitChecksAndAutoMerge waits for the checks on headSHA, the head of this
+// target's PR, and enables auto-merge on the PR once they pass. This covers
+// updated PRs as well as new ones.
+func (rs *RepositorySync) awaitChecksAndAutoMerge(ctx context.Context, headSHA string) error {
+	if rs.lastPRNumber == nil {
+		return nil
+	}
+	prNumber := *rs.lastPRNumber
+
+	if rs.engine.provider() != config.ProviderGitHub {
+		rs.logger.WithField("pr_number", prNumber).Warn("Waiting for PR checks is only supported on GitHub; enabling auto-merge now")
+		rs.enableAutoMerge(ctx, prNumber)
+		return nil
+	}
+
+	if err := rs.waitForChecks(ctx, headSHA); err != nil {
+		return err
+	}
+	rs.enableAutoMerge(ctx, prNumber)
+	return nil
+}
+
+// waitForChecks polls the check runs and commit statuses of headSHA until
+// they finish, backing off from the initial poll delay to its cap. It returns
+// ErrChecksFailed when any fail and ErrChecksTimeout when some are still
+// running after the checks timeout. A head that reports no checks within the
+// grace period counts as passed. Errors reading the checks are retried until
+// the timeout; a canceled ctx stops the wait with its error.
+func (rs *RepositorySync) waitForChecks(ctx context.Context, headSHA string) error {
+	timeout := rs.engine.options.ChecksTimeout
+	if timeout <= 0 {
+		timeout = DefaultChecksTimeout
+	}
+	polling := rs.engine.checksPollTiming()
+	start := time.Now()
+	deadline := start.Add(timeout)
+	delay := polling.initial
+
+	logger := rs.logger.WithFields(logrus.Fields{
+		"commit_sha": headSHA,
+		"timeout":    timeout.String(),
+	})
+	logger.Info("Waiting for PR checks before enabling auto-merge")
+
+	for {
+		state, detail, err := rs.pollChecks(ctx, headSHA)
+		switch {
+		case err != nil:
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			logger.WithError(err).Warn("Failed to read PR checks, retrying")
+		case state == checksFailed:
+			return fmt.Errorf("%w for %s: %s", ErrChecksFailed, rs.target.Repo, detail)
+		case state == checksPassed:
+			logger.WithField("checks", detail).Info("PR checks passed")
+			return nil
+		case state == checksNone && time.Since(start) >= polling.grace:
+			logger.Info("No PR checks reported, continuing without waiting")
+			return nil
+		default:
+			logger.WithField("checks", detail).Debug("PR checks still running")
+		}
+
+		wait := min(delay, time.Until(deadline))
+		if wait <= 0 {
+			if err != nil {
+				return fmt.Errorf("%w for %s after %s: %w", ErrChecksTimeout, rs.target.Repo, timeout, err)
+			}
+			return fmt.Errorf("%w for %s after %s", ErrChecksTimeout, rs.target.Repo, timeout)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		delay = min(delay*3/2, polling.maxDelay)
+	}
+}
+
+// pollChecks reads the check runs and the combined commit status of headSHA
+// once. detail names the failed checks, or summarizes them otherwise.
+func (rs *RepositorySync) pollChecks(ctx context.Context, headSHA string) (checksState, string, error) {
+	rs.TrackAPIRequest()
+	runs, err := rs.engine.gh.GetCommitCheckStatus(ctx, rs.target.Repo, headSHA)
+	if err != nil {
+		return checksPending, "", err
+	}
+	rs.TrackAPIRequest()
+	status, err := rs.engine.gh.GetCombinedStatus(ctx, rs.target.Repo, headSHA)
+	if err != nil {
+		return checksPending, "", err
+	}
+
+	failed := runs.FailedCheckNames()
+	failed = append(failed, status.FailedContexts()...)
+	if len(failed) > 0 {
+		return checksFailed, strings.Join(failed, ", "), nil
+	}
+	if runs.HasFailedChecks() {
+		return checksFailed, runs.Summary(), nil
+	}
+	if runs.NoChecks() && len(status.Statuses) == 0 {
+		return checksNone, "no checks reported", nil
+	}
+
+	pendingStatuses := 0
+	for _, commitStatus := range status.Statuses {
+		if commitStatus.State == "pending" {
+			pendingStatuses++
+		}
+	}
+	detail := fmt.Sprintf("check runs: %s; statuses: %d of %d pending", runs.Summary(), pendingStatuses, len(status.Statuses))
+	if runs.HasRunningChecks() || pendingStatuses > 0 {
+		return checksPending, detail, nil
+	}
+	return checksPassed, detail, nil
+}
@@ -0,0 +1,134 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+// newChecksRepoSync returns a RepositorySync for PR #7 on org/target that
+// waits for checks with millisecond polling
+func newChecksRepoSync(ghClient *gh.MockClient, timeout time.Duration) *RepositorySync {
+	prNumber := 7
+	return &RepositorySync{
+		engine: &Engine{
+			config:     &config.Config{},
+			gh:         ghClient,
+			options:    DefaultOptions().WithAutomerge(true).WithWaitForChecks(true, timeout),
+			checksPoll: checksPolling{initial: time.Millisecond, maxDelay: 2 * time.Millisecond, grace: 20 * time.Millisecond},
+		},
+		target:       config.TargetConfig{Repo: "org/target"},
+		logger:       logrus.NewEntry(logrus.New()),
+		lastPRNumber: &prNumber,
+	}
+}
+
+func checkRuns(runs ...gh.CheckRun) *gh.CheckStatusSummary {
+	summary := &gh.CheckStatusSummary{Total: len(runs), Checks: runs}
+	for _, run := range runs {
+		switch {
+		case run.Status != "completed":
+			summary.Running++
+		case run.Conclusion == "failure":
+			summary.Completed++
+			summary.Failed++
+		default:
+			summary.Completed++
+			summary.Passed++
+		}
+	}
+	return summary
+}
+
+func TestRepositorySync_awaitChecksAndAutoMerge(t *testing.T) {
+	ctx := context.Background()
+	running := gh.CheckRun{Name: "test", Status: "in_progress"}
+	passed := gh.CheckRun{Name: "test", Status: "completed", Conclusion: "success"}
+	failed := gh.CheckRun{Name: "lint", Status: "completed", Conclusion: "failure"}
+
+	t.Run("enables auto-merge once running checks pass", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetCommitCheckStatus", ctx, "org/target", "abc123").Return(checkRuns(running), nil).Twice()
+		ghClient.On("GetCommitCheckStatus", ctx, "org/target", "abc123").Return(checkRuns(passed), nil).Once()
+		ghClient.On("GetCombinedStatus", ctx, "org/target", "abc123").Return(&gh.CombinedStatus{}, nil).Times(3)
+		ghClient.On("EnableAutoMergePR", ctx, "org/target", 7, gh.MergeMethodSquash).Return(nil).Once()
+
+		require.NoError(t, newChecksRepoSync(ghClient, time.Minute).awaitChecksAndAutoMerge(ctx, "abc123"))
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("waits for pending commit statuses", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetCommitCheckStatus", ctx, "org/target", "abc123").Return(checkRuns(passed), nil).Twice()
+		ghClient.On("GetCombinedStatus", ctx, "org/target", "abc123").
+			Return(&gh.CombinedStatus{Statuses: []gh.CommitStatus{{Context: "ci/legacy", State: "pending"}}}, nil).Once()
+		ghClient.On("GetCombinedStatus", ctx, "org/target", "abc123").
+			Return(&gh.CombinedStatus{Statuses: []gh.CommitStatus{{Context: "ci/legacy", State: "success"}}}, nil).Once()
+		ghClient.On("EnableAutoMergePR", ctx, "org/target", 7, gh.MergeMethodSquash).Return(nil).Once()
+
+		require.NoError(t, newChecksRepoSync(ghClient, time.Minute).awaitChecksAndAutoMerge(ctx, "abc123"))
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("failed checks fail without enabling auto-merge", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetCommitCheckStatus", ctx, "org/target", "abc123").Return(checkRuns(passed, failed), nil).Once()
+		ghClient.On("GetCombinedStatus", ctx, "org/target", "abc123").
+			Return(&gh.CombinedStatus{Statuses: []gh.CommitStatus{{Context: "ci/legacy", State: "error"}}}, nil).Once()
+
+		err := newChecksRepoSync(ghClient, time.Minute).awaitChecksAndAutoMerge(ctx, "abc123")
+		require.ErrorIs(t, err, ErrChecksFailed)
+		assert.Contains(t, err.Error(), "lint, ci/legacy")
+		ghClient.AssertExpectations(t)
+		ghClient.AssertNotCalled(t, "EnableAutoMergePR")
+	})
+
+	t.Run("times out while checks keep running", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetCommitCheckStatus", ctx, "org/target", "abc123").Return(checkRuns(running), nil)
+		ghClient.On("GetCombinedStatus", ctx, "org/target", "abc123").Return(&gh.CombinedStatus{}, nil)
+
+		err := newChecksRepoSync(ghClient, 10*time.Millisecond).awaitChecksAndAutoMerge(ctx, "abc123")
+		require.ErrorIs(t, err, ErrChecksTimeout)
+		ghClient.AssertNotCalled(t, "EnableAutoMergePR")
+	})
+
+	t.Run("retries errors reading checks", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetCommitCheckStatus", ctx, "org/target", "abc123").Return(nil, errTestAutoMergeDisabled).Once()
+		ghClient.On("GetCommitCheckStatus", ctx, "org/target", "abc123").Return(checkRuns(passed), nil).Once()
+		ghClient.On("GetCombinedStatus", ctx, "org/target", "abc123").Return(&gh.CombinedStatus{}, nil).Once()
+		ghClient.On("EnableAutoMergePR", ctx, "org/target", 7, gh.MergeMethodSquash).Return(nil).Once()
+
+		require.NoError(t, newChecksRepoSync(ghClient, time.Minute).awaitChecksAndAutoMerge(ctx, "abc123"))
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("a head without checks passes after the grace period", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetCommitCheckStatus", ctx, "org/target", "abc123").Return(checkRuns(), nil)
+		ghClient.On("GetCombinedStatus", ctx, "org/target", "abc123").Return(&gh.CombinedStatus{State: "pending"}, nil)
+		ghClient.On("EnableAutoMergePR", ctx, "org/target", 7, gh.MergeMethodSquash).Return(nil).Once()
+
+		require.NoError(t, newChecksRepoSync(ghClient, time.Minute).awaitChecksAndAutoMerge(ctx, "abc123"))
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("stops when the context is canceled", func(t *testing.T) {
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetCommitCheckStatus", canceled, "org/target", "abc123").Return(nil, context.Canceled)
+
+		err := newChecksRepoSync(ghClient, time.Minute).awaitChecksAndAutoMerge(canceled, "abc123")
+		require.ErrorIs(t, err, context.Canceled)
+		ghClient.AssertNotCalled(t, "EnableAutoMergePR")
+	})
+}
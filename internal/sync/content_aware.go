@@ -0,0 +1,115 @@
+package sync
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+// Per-file markers for mappings that contribute no content to the hash
+const (
+	contentHashDeleted = "deleted"
+	contentHashMissing = "missing"
+)
+
+// contentHashes maps each destination path to the hash of its transformed
+// content, or to one of the contentHash* markers
+type contentHashes map[string]string
+
+// sum combines the per-file hashes into one hash that does not depend on the
+// order of the file mappings
+func (h contentHashes) sum() string {
+	dests := make([]string, 0, len(h))
+	for dest := range h {
+		dests = append(dests, dest)
+	}
+	sort.Strings(dests)
+
+	digest := sha256.New()
+	for _, dest := range dests {
+		fmt.Fprintf(digest, "%s\x00%s\n", dest, h[dest])
+	}
+	return fmt.Sprintf("%x", digest.Sum(nil))
+}
+
+// contentHashSupported reports whether the target's mapped content can be
+// hashed. Directory and glob file mappings are excluded because their file set
+// is only known after cloning the source.
+func (rs *RepositorySync) contentHashSupported() bool {
+	return len(rs.target.Directories) == 0 && len(rs.target.Files) > 0 && !hasFileGlobs(rs.target.Files)
+}
+
+// recordContentHash records the per-file hash of a processed mapping
+func (rs *RepositorySync) recordContentHash(dest, hash string) {
+	if rs.contentHashes == nil {
+		rs.contentHashes = make(contentHashes, len(rs.target.Files))
+	}
+	rs.contentHashes[dest] = hash
+}
+
+// syncedContentHash returns the content hash of the files processed by this
+// sync for the PR metadata block, or "" when not every mapping was recorded
+func (rs *RepositorySync) syncedContentHash() string {
+	if !rs.contentHashSupported() || len(rs.contentHashes) != len(rs.target.Files) {
+		return ""
+	}
+	return rs.contentHashes.sum()
+}
+
+// currentContentHash computes the content hash of the source commit through the
+// GitHub API without cloning. ok is false when any file cannot be read or
+// transformed, in which case the target must be synced normally.
+func (rs *RepositorySync) currentContentHash(ctx context.Context) (hash string, ok bool) {
+	if !rs.contentHashSupported() {
+		return "", false
+	}
+
+	hashes := make(contentHashes, len(rs.target.Files))
+	for _, fileMapping := range rs.target.Files {
+		fileMapping = rs.renderedFileMapping(fileMapping)
+		if fileMapping.Delete {
+			hashes[fileMapping.Dest] = contentHashDeleted
+			continue
+		}
+
+		transformed, err := rs.transformedSourceContent(ctx, fileMapping)
+		switch {
+		case errors.Is(err, gh.ErrFileNotFound):
+			hashes[fileMapping.Dest] = contentHashMissing
+		case err != nil:
+			rs.logger.WithError(err).WithField("file", fileMapping.Src).Debug("Content-aware sync: source content unavailable")
+			return "", false
+		default:
+			hashes[fileMapping.Dest] = contentHash(transformed)
+		}
+	}
+	return hashes.sum(), true
+}
+
+// mappedContentUnchanged reports whether the transformed content of the source
+// commit hashes the same as the content recorded in the open sync PR, meaning
+// the new commit only touched files this target does not receive
+func (rs *RepositorySync) mappedContentUnchanged(ctx context.Context) bool {
+	if !rs.engine.options.ContentAwareSync || rs.targetState == nil || rs.targetState.LastSyncContentHash == "" {
+		return false
+	}
+
+	hash, ok := rs.currentContentHash(ctx)
+	if !ok {
+		return false
+	}
+
+	unchanged := hash == rs.targetState.LastSyncContentHash
+	rs.logger.WithFields(logrus.Fields{
+		"content_hash":      hash,
+		"last_content_hash": rs.targetState.LastSyncContentHash,
+		"unchanged":         unchanged,
+	}).Debug("Content-aware sync: compared mapped content hash")
+	return unchanged
+}
@@ -0,0 +1,118 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/state"
+)
+
+var errSourceUnavailable = errors.New("source unavailable")
+
+func TestContentHashes_sum(t *testing.T) {
+	a := contentHashes{"a.txt": contentHash([]byte("alpha")), "b.txt": contentHashDeleted}
+	b := contentHashes{"b.txt": contentHashDeleted, "a.txt": contentHash([]byte("alpha"))}
+	assert.Equal(t, a.sum(), b.sum(), "sum does not depend on insertion order")
+
+	c := contentHashes{"a.txt": contentHash([]byte("alpha2")), "b.txt": contentHashDeleted}
+	assert.NotEqual(t, a.sum(), c.sum())
+}
+
+func TestRepositorySync_ContentHashMatchesProcessedFiles(t *testing.T) {
+	ctx := context.Background()
+	target := config.TargetConfig{
+		Repo: "org/target",
+		Files: []config.FileMapping{
+			{Src: "a.txt", Dest: "a.txt"},
+			{Src: "gone.txt", Dest: "gone.txt"},
+			{Dest: "old.txt", Delete: true},
+		},
+	}
+
+	ghClient := &gh.MockClient{}
+	ghClient.On("GetFile", mock.Anything, "org/target", mock.Anything, "").Return(nil, gh.ErrFileNotFound)
+	ghClient.On("GetFile", mock.Anything, "org/template", "a.txt", "abc123").
+		Return(&gh.FileContent{Content: []byte("alpha")}, nil)
+	ghClient.On("GetFile", mock.Anything, "org/template", "gone.txt", "abc123").Return(nil, gh.ErrFileNotFound)
+
+	// The hash written by a sync from the cloned source...
+	rs := newTestRepoSync(ghClient, nil, target, nil)
+	rs.tempDir = t.TempDir()
+	sourceDir := filepath.Join(rs.tempDir, "source")
+	require.NoError(t, os.MkdirAll(sourceDir, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "a.txt"), []byte("alpha"), 0o600))
+	_, err := rs.processFiles(ctx)
+	require.NoError(t, err)
+	synced := rs.syncedContentHash()
+	require.NotEmpty(t, synced)
+
+	// ...equals the hash computed later through the API
+	current, ok := newTestRepoSync(ghClient, nil, target, nil).currentContentHash(ctx)
+	require.True(t, ok)
+	assert.Equal(t, synced, current)
+
+	var sb strings.Builder
+	rs.writeMetadataBlock(&sb, "def456", nil, false)
+	assert.Contains(t, sb.String(), "  content_hash: "+synced+"\n")
+}
+
+func TestRepositorySync_needsSync_ContentAware(t *testing.T) {
+	ctx := context.Background()
+	target := config.TargetConfig{
+		Repo:  "org/target",
+		Files: []config.FileMapping{{Src: "a.txt", Dest: "a.txt"}},
+	}
+	recorded := contentHashes{"a.txt": contentHash([]byte("alpha"))}.sum()
+
+	newRepoSync := func(ghClient *gh.MockClient, enabled bool, lastHash string) *RepositorySync {
+		rs := newTestRepoSync(ghClient, nil, target, DefaultOptions().WithContentAwareSync(enabled))
+		rs.targetState = &state.TargetState{LastSyncCommit: "old123", LastSyncContentHash: lastHash}
+		return rs
+	}
+
+	t.Run("unchanged content skips the new commit", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/template", "a.txt", "abc123").
+			Return(&gh.FileContent{Content: []byte("alpha")}, nil).Once()
+		assert.False(t, newRepoSync(ghClient, true, recorded).needsSync(ctx))
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("changed content syncs", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/template", "a.txt", "abc123").
+			Return(&gh.FileContent{Content: []byte("alpha v2")}, nil)
+		assert.True(t, newRepoSync(ghClient, true, recorded).needsSync(ctx))
+	})
+
+	t.Run("unreadable source syncs", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/template", "a.txt", "abc123").Return(nil, errSourceUnavailable)
+		assert.True(t, newRepoSync(ghClient, true, recorded).needsSync(ctx))
+	})
+
+	t.Run("disabled or no recorded hash compares commits only", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		assert.True(t, newRepoSync(ghClient, false, recorded).needsSync(ctx))
+		assert.True(t, newRepoSync(ghClient, true, "").needsSync(ctx))
+		ghClient.AssertNotCalled(t, "GetFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("directory mappings are never hashed", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		rs := newRepoSync(ghClient, true, recorded)
+		rs.target.Directories = []config.DirectoryMapping{{Src: "docs", Dest: "docs"}}
+		assert.True(t, rs.needsSync(ctx))
+		assert.Empty(t, rs.syncedContentHash())
+	})
+}
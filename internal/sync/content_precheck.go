@@ -0,0 +1,116 @@
+package sync
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// contentHash returns the hex-encoded SHA-256 of content
+func contentHash(content []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(content))
+}
+
+// targetContentInSync reports whether every file mapping for this target
+// already matches the target repository, so the clone/transform/commit pipeline
+// can be skipped entirely.
+//
+// Source content is read through the GitHub API at the source commit and put
+// through the same transformation (and binary detection) as processFile,
+// except that the source .gitattributes is only consulted after cloning. The
+// check is conservative: it returns false as soon as anything differs or cannot
+// be determined, and the normal pipeline makes the final decision. Targets with
+// directory or glob file mappings are never pre-checked because their file
+// set is only known after cloning the source. Targets with an open sync pull
+// request are not pre-checked either, so the existing pull request is still
+// handled by the normal pipeline.
+//
+// The check costs up to two GetFile calls per mapped file (target and source)
+// on every run; the target content is kept and reused by processFile.
+func (rs *RepositorySync) targetContentInSync(ctx context.Context) bool {
+	if rs.engine.options.Force || len(rs.target.Directories) > 0 || len(rs.target.Files) == 0 || hasFileGlobs(rs.target.Files) {
+		return false
+	}
+
+	// Discovery only records pull requests from sync branches
+	if rs.targetState != nil && len(rs.targetState.OpenPRs) > 0 {
+		rs.logger.Debug("Content pre-check: open sync pull request exists")
+		return false
+	}
+
+	if rs.existingContent == nil {
+		rs.existingContent = make(map[string][]byte, len(rs.target.Files))
+	}
+
+	for _, fileMapping := range rs.target.Files {
+		select {
+		case <-ctx.Done():
+			return false
+		default:
+		}
+
+		fileMapping = rs.renderedFileMapping(fileMapping)
+
+		existing, err := rs.getExistingFileContent(ctx, fileMapping.Dest)
+		if fileMapping.Delete {
+			if err == nil {
+				rs.logger.WithField("file", fileMapping.Dest).Debug("Content pre-check: file pending deletion")
+				return false
+			}
+			continue
+		}
+		if err != nil {
+			rs.logger.WithField("file", fileMapping.Dest).Debug("Content pre-check: target file missing")
+			return false
+		}
+		rs.existingContent[fileMapping.Dest] = existing
+
+		transformed, err := rs.transformedSourceContent(ctx, fileMapping)
+		if err != nil {
+			rs.logger.WithError(err).WithField("file", fileMapping.Src).Debug("Content pre-check: source content unavailable")
+			return false
+		}
+		if fileMapping.IsManagedBlock() {
+			if transformed, err = SpliceManagedBlock(existing, transformed, fileMapping.MarkerStart, fileMapping.MarkerEnd); err != nil {
+				rs.logger.WithError(err).WithField("file", fileMapping.Dest).Debug("Content pre-check: managed block cannot be spliced")
+				return false
+			}
+		}
+
+		sourceHash, targetHash := contentHash(transformed), contentHash(existing)
+		if sourceHash != targetHash {
+			rs.logger.WithFields(logrus.Fields{
+				"file":        fileMapping.Dest,
+				"source_hash": sourceHash,
+				"target_hash": targetHash,
+			}).Debug("Content pre-check: file differs")
+			return false
+		}
+	}
+
+	return true
+}
+
+// transformedSourceContent reads a mapped source file through the GitHub API at
+// the source commit, or from disk for a local source, and applies the same
+// transformation as processFile
+func (rs *RepositorySync) transformedSourceContent(ctx context.Context, fileMapping config.FileMapping) ([]byte, error) {
+	if rs.isLocalSource() {
+		content, err := rs.readLocalSourceFile(fileMapping.Src)
+		if err != nil {
+			return nil, err
+		}
+		return rs.transformFileContent(ctx, fileMapping, content)
+	}
+
+	rs.TrackAPIRequest()
+	source, err := rs.engine.gh.GetFile(ctx, rs.sourceState.Repo, rs.target.SourcePath(fileMapping.Src), rs.sourceState.LatestCommit)
+	if err != nil {
+		return nil, err
+	}
+	return rs.transformFileContent(ctx, fileMapping, source.Content)
+}
@@ -0,0 +1,197 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/state"
+	"github.com/mrz1836/go-broadcast/internal/transform"
+)
+
+func TestRepositorySync_targetContentInSync(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("all files match", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/target", "a.txt", "").
+			Return(&gh.FileContent{Content: []byte("alpha")}, nil).Once()
+		ghClient.On("GetFile", mock.Anything, "org/template", "a.txt", "abc123").
+			Return(&gh.FileContent{Content: []byte("alpha")}, nil).Once()
+		ghClient.On("GetFile", mock.Anything, "org/target", "b.txt", "").
+			Return(&gh.FileContent{Content: []byte("beta")}, nil).Once()
+		ghClient.On("GetFile", mock.Anything, "org/template", "b.txt", "abc123").
+			Return(&gh.FileContent{Content: []byte("beta")}, nil).Once()
+
+		target := config.TargetConfig{
+			Repo:  "org/target",
+			Files: []config.FileMapping{{Src: "a.txt", Dest: "a.txt"}, {Src: "b.txt", Dest: "b.txt"}},
+		}
+		rs := newTestRepoSync(ghClient, nil, target, nil)
+
+		assert.True(t, rs.targetContentInSync(ctx))
+		ghClient.AssertExpectations(t)
+
+		// Fetched target content is reused instead of calling the API again
+		content, err := rs.getExistingFileContent(ctx, "a.txt")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("alpha"), content)
+		ghClient.AssertNumberOfCalls(t, "GetFile", 4)
+	})
+
+	t.Run("transformed content is compared", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/target", "README.md", "").
+			Return(&gh.FileContent{Content: []byte("org/target readme")}, nil)
+		ghClient.On("GetFile", mock.Anything, "org/template", "README.md", "abc123").
+			Return(&gh.FileContent{Content: []byte("org/template readme")}, nil)
+
+		chain := &transform.MockChain{}
+		chain.On("Transform", mock.Anything, []byte("org/template readme"), mock.Anything).
+			Return([]byte("org/target readme"), nil)
+
+		target := config.TargetConfig{
+			Repo:      "org/target",
+			Files:     []config.FileMapping{{Src: "README.md", Dest: "README.md"}},
+			Transform: config.Transform{RepoName: true},
+		}
+		rs := newTestRepoSync(ghClient, chain, target, nil)
+
+		assert.True(t, rs.targetContentInSync(ctx))
+		chain.AssertExpectations(t)
+	})
+
+	t.Run("binary content bypasses transformations", func(t *testing.T) {
+		binary := []byte{0x89, 'P', 'N', 'G', 0x00, 0x01, 0x02}
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/target", "logo.png", "").
+			Return(&gh.FileContent{Content: binary}, nil)
+		ghClient.On("GetFile", mock.Anything, "org/template", "logo.png", "abc123").
+			Return(&gh.FileContent{Content: binary}, nil)
+
+		chain := &transform.MockChain{}
+		target := config.TargetConfig{
+			Repo:      "org/target",
+			Files:     []config.FileMapping{{Src: "logo.png", Dest: "logo.png"}},
+			Transform: config.Transform{RepoName: true},
+		}
+		rs := newTestRepoSync(ghClient, chain, target, nil)
+
+		assert.True(t, rs.targetContentInSync(ctx))
+		chain.AssertNotCalled(t, "Transform", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("content differs", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/target", "a.txt", "").
+			Return(&gh.FileContent{Content: []byte("old")}, nil)
+		ghClient.On("GetFile", mock.Anything, "org/template", "a.txt", "abc123").
+			Return(&gh.FileContent{Content: []byte("new")}, nil)
+
+		target := config.TargetConfig{
+			Repo:  "org/target",
+			Files: []config.FileMapping{{Src: "a.txt", Dest: "a.txt"}, {Src: "b.txt", Dest: "b.txt"}},
+		}
+		rs := newTestRepoSync(ghClient, nil, target, nil)
+
+		assert.False(t, rs.targetContentInSync(ctx))
+		ghClient.AssertNotCalled(t, "GetFile", mock.Anything, "org/target", "b.txt", "")
+	})
+
+	t.Run("missing target file", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/target", "a.txt", "").
+			Return(nil, gh.ErrFileNotFound)
+
+		target := config.TargetConfig{
+			Repo:  "org/target",
+			Files: []config.FileMapping{{Src: "a.txt", Dest: "a.txt"}},
+		}
+		rs := newTestRepoSync(ghClient, nil, target, nil)
+
+		assert.False(t, rs.targetContentInSync(ctx))
+		ghClient.AssertNotCalled(t, "GetFile", mock.Anything, "org/template", mock.Anything, mock.Anything)
+	})
+
+	t.Run("pending deletion", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/target", "old.txt", "").
+			Return(&gh.FileContent{Content: []byte("stale")}, nil)
+
+		target := config.TargetConfig{
+			Repo:  "org/target",
+			Files: []config.FileMapping{{Dest: "old.txt", Delete: true}},
+		}
+		rs := newTestRepoSync(ghClient, nil, target, nil)
+
+		assert.False(t, rs.targetContentInSync(ctx))
+	})
+
+	t.Run("deletion already applied", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/target", "old.txt", "").
+			Return(nil, gh.ErrFileNotFound)
+
+		target := config.TargetConfig{
+			Repo:  "org/target",
+			Files: []config.FileMapping{{Dest: "old.txt", Delete: true}},
+		}
+		rs := newTestRepoSync(ghClient, nil, target, nil)
+
+		assert.True(t, rs.targetContentInSync(ctx))
+	})
+
+	t.Run("rendered template compared against stripped destination", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/target", "ci.yml", "").
+			Return(&gh.FileContent{Content: []byte("go: 1.24")}, nil).Once()
+		ghClient.On("GetFile", mock.Anything, "org/template", "ci.yml.tmpl", "abc123").
+			Return(&gh.FileContent{Content: []byte("go: {{.GO_VERSION}}")}, nil).Once()
+
+		chain := transform.NewChain(logrus.New())
+		chain.Add(transform.NewTemplateRenderTransformer())
+
+		target := config.TargetConfig{
+			Repo:  "org/target",
+			Files: []config.FileMapping{{Src: "ci.yml.tmpl", Dest: "ci.yml.tmpl"}},
+			Transform: config.Transform{
+				TemplateRender: true,
+				Variables:      map[string]string{"GO_VERSION": "1.24"},
+			},
+		}
+
+		assert.True(t, newTestRepoSync(ghClient, chain, target, nil).targetContentInSync(ctx))
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("skipped for directories, force, and open sync PRs", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+
+		withDirs := config.TargetConfig{
+			Repo:        "org/target",
+			Files:       []config.FileMapping{{Src: "a.txt", Dest: "a.txt"}},
+			Directories: []config.DirectoryMapping{{Src: "docs", Dest: "docs"}},
+		}
+		assert.False(t, newTestRepoSync(ghClient, nil, withDirs, nil).targetContentInSync(ctx))
+
+		filesOnly := config.TargetConfig{
+			Repo:  "org/target",
+			Files: []config.FileMapping{{Src: "a.txt", Dest: "a.txt"}},
+		}
+		assert.False(t, newTestRepoSync(ghClient, nil, filesOnly, DefaultOptions().WithForce(true)).targetContentInSync(ctx))
+
+		withOpenPR := newTestRepoSync(ghClient, nil, filesOnly, nil)
+		openPR := gh.PR{Number: 7}
+		openPR.Head.Ref = "chore/sync-files-default-20240101-000000-abc123"
+		withOpenPR.targetState = &state.TargetState{Repo: "org/target", OpenPRs: []gh.PR{openPR}}
+		assert.False(t, withOpenPR.targetContentInSync(ctx))
+
+		ghClient.AssertNotCalled(t, "GetFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -161,13 +161,7 @@ func (r *DependencyResolver) topologicalSort() ([]config.Group, error) {
 
 	// Sort initial queue by priority for deterministic ordering
 	sort.Slice(queue, func(i, j int) bool {
-		gi := r.groups[queue[i]]
-		gj := r.groups[queue[j]]
-		if gi.Priority != gj.Priority {
-			return gi.Priority < gj.Priority
-		}
-		// If same priority, sort by ID for deterministic order
-		return gi.ID < gj.ID
+		return r.runsBefore(queue[i], queue[j])
 	})
 
 	// Process queue
@@ -200,12 +194,7 @@ func (r *DependencyResolver) topologicalSort() ([]config.Group, error) {
 		// Sort queue by priority for next iteration
 		if len(queue) > 1 {
 			sort.Slice(queue, func(i, j int) bool {
-				gi := r.groups[queue[i]]
-				gj := r.groups[queue[j]]
-				if gi.Priority != gj.Priority {
-					return gi.Priority < gj.Priority
-				}
-				return gi.ID < gj.ID
+				return r.runsBefore(queue[i], queue[j])
 			})
 		}
 	}
@@ -219,6 +208,55 @@ func (r *DependencyResolver) topologicalSort() ([]config.Group, error) {
 	return result, nil
 }
 
+// runsBefore orders two ready groups: lower priority first, then group ID as a
+// deterministic tiebreak so equal-priority groups never depend on map order
+func (r *DependencyResolver) runsBefore(a, b string) bool {
+	ga := r.groups[a]
+	gb := r.groups[b]
+	if ga.Priority != gb.Priority {
+		return ga.Priority < gb.Priority
+	}
+	return ga.ID < gb.ID
+}
+
+// ResolveLevels resolves dependencies and partitions the groups into levels.
+// A group's level is one past its deepest dependency, so groups in the same
+// level never depend on each other and may run concurrently. Levels are
+// returned in execution order and each level is sorted by priority, then ID.
+func (r *DependencyResolver) ResolveLevels() ([][]config.Group, error) {
+	executionOrder, err := r.Resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	// Dependencies always precede their dependents in executionOrder, so a
+	// single pass sees every dependency's level before it is needed
+	levelOf := make(map[string]int, len(executionOrder))
+	var levels [][]config.Group
+	for _, group := range executionOrder {
+		level := 0
+		for _, depID := range r.dependencies[group.ID] {
+			if depLevel := levelOf[depID] + 1; depLevel > level {
+				level = depLevel
+			}
+		}
+		levelOf[group.ID] = level
+
+		if level == len(levels) {
+			levels = append(levels, nil)
+		}
+		levels[level] = append(levels[level], group)
+	}
+
+	for _, level := range levels {
+		sort.SliceStable(level, func(i, j int) bool {
+			return r.runsBefore(level[i].ID, level[j].ID)
+		})
+	}
+
+	return levels, nil
+}
+
 // sortByPriority sorts groups by priority within dependency levels
 func (r *DependencyResolver) sortByPriority(groups []config.Group) {
 	// Groups are already sorted by priority within dependency levels
@@ -453,3 +453,46 @@ func TestDependencyResolver_MultipleErrorPaths(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "circular dependency")
 }
+
+func TestDependencyResolver_ResolveLevels(t *testing.T) {
+	resolver := NewDependencyResolver(logrus.New())
+
+	// base ← (api, web) ← deploy, plus an independent docs group
+	groups := []config.Group{
+		{ID: "deploy", Priority: 0, DependsOn: []string{"api", "web"}},
+		{ID: "web", Priority: 1, DependsOn: []string{"base"}},
+		{ID: "api", Priority: 1, DependsOn: []string{"base"}},
+		{ID: "docs", Priority: 5},
+		{ID: "base", Priority: 2},
+	}
+	for _, g := range groups {
+		resolver.AddGroup(g)
+	}
+
+	levels, err := resolver.ResolveLevels()
+	require.NoError(t, err)
+
+	ids := make([][]string, 0, len(levels))
+	for _, level := range levels {
+		var levelIDs []string
+		for _, g := range level {
+			levelIDs = append(levelIDs, g.ID)
+		}
+		ids = append(ids, levelIDs)
+	}
+
+	assert.Equal(t, [][]string{
+		{"base", "docs"}, // priority order
+		{"api", "web"},   // equal priority, ID tiebreak
+		{"deploy"},
+	}, ids)
+}
+
+func TestDependencyResolver_ResolveLevels_Cycle(t *testing.T) {
+	resolver := NewDependencyResolver(logrus.New())
+	resolver.AddGroup(config.Group{ID: "a", DependsOn: []string{"b"}})
+	resolver.AddGroup(config.Group{ID: "b", DependsOn: []string{"a"}})
+
+	_, err := resolver.ResolveLevels()
+	require.ErrorIs(t, err, ErrCircularDependency)
+}
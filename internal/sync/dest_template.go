@@ -0,0 +1,58 @@
+package sync
+
+import (
+	"fmt"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// RenderDestPaths returns target with the templated dests of its file and
+// directory mappings rendered for a sync from sourceRepo. The mappings are
+// copied before rendering, so the configured target is left untouched.
+func RenderDestPaths(sourceRepo string, target config.TargetConfig) (config.TargetConfig, error) {
+	files, directories := target.Files, target.Directories
+	filesCopied, directoriesCopied := false, false
+	for i, fileMapping := range target.Files {
+		// Glob dests are rendered per match once the source is cloned
+		if !config.IsDestTemplate(fileMapping.Dest) || fileMapping.IsGlob() {
+			continue
+		}
+		if !filesCopied {
+			files, filesCopied = append([]config.FileMapping(nil), target.Files...), true
+		}
+		dest, err := config.RenderDestPath(fileMapping.Dest, config.NewDestPathData(sourceRepo, target.Repo, fileMapping.Src))
+		if err != nil {
+			return target, fmt.Errorf("file %s: %w", fileMapping.Src, err)
+		}
+		files[i].Dest = dest
+	}
+
+	for i, dirMapping := range target.Directories {
+		if !config.IsDestTemplate(dirMapping.Dest) {
+			continue
+		}
+		if !directoriesCopied {
+			directories, directoriesCopied = append([]config.DirectoryMapping(nil), target.Directories...), true
+		}
+		dest, err := config.RenderDestPath(dirMapping.Dest, config.NewDestPathData(sourceRepo, target.Repo, dirMapping.Src))
+		if err != nil {
+			return target, fmt.Errorf("directory %s: %w", dirMapping.Src, err)
+		}
+		directories[i].Dest = dest
+	}
+
+	target.Files = files
+	target.Directories = directories
+	return target, nil
+}
+
+// applyDestTemplates renders this target's templated dests, so every later
+// step sees the paths the files are synced to
+func (rs *RepositorySync) applyDestTemplates() error {
+	target, err := RenderDestPaths(rs.sourceState.Repo, rs.target)
+	if err != nil {
+		return err
+	}
+	rs.target = target
+	return nil
+}
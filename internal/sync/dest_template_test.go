@@ -0,0 +1,71 @@
+package sync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/transform"
+)
+
+func TestRenderDestPaths(t *testing.T) {
+	target := config.TargetConfig{
+		Repo: "org/service",
+		Files: []config.FileMapping{
+			{Src: "configs/app.yaml", Dest: "{{.RepoName}}/{{.SourceBase}}"},
+			{Src: "README.md", Dest: "README.md"},
+		},
+		Directories: []config.DirectoryMapping{
+			{Src: "deploy/base", Dest: "deploy/{{.Org}}/{{.RepoName}}"},
+		},
+	}
+
+	rendered, err := RenderDestPaths("org/template", target)
+	require.NoError(t, err)
+	assert.Equal(t, "service/app.yaml", rendered.Files[0].Dest)
+	assert.Equal(t, "README.md", rendered.Files[1].Dest)
+	assert.Equal(t, "deploy/org/service", rendered.Directories[0].Dest)
+
+	// The configured mappings keep their templates for the next target
+	assert.Equal(t, "{{.RepoName}}/{{.SourceBase}}", target.Files[0].Dest)
+	assert.Equal(t, "deploy/{{.Org}}/{{.RepoName}}", target.Directories[0].Dest)
+
+	t.Run("rendered path escaping the repository", func(t *testing.T) {
+		escaping := config.TargetConfig{
+			Repo:  "org/service",
+			Files: []config.FileMapping{{Src: "app.yaml", Dest: "{{.SourceDir}}/../{{.SourceBase}}"}},
+		}
+		_, err := RenderDestPaths("org/template", escaping)
+		require.ErrorIs(t, err, config.ErrInvalidDestTemplate)
+	})
+}
+
+func TestRepositorySync_TemplatedFileDest(t *testing.T) {
+	ghClient := &gh.MockClient{}
+	ghClient.On("GetFile", mock.Anything, "org/target", "target/app.yaml", "").Return(nil, gh.ErrFileNotFound)
+
+	target := config.TargetConfig{
+		Repo:  "org/target",
+		Files: []config.FileMapping{{Src: "configs/app.yaml", Dest: "{{.RepoName}}/{{.SourceBase}}"}},
+	}
+	rs := newTestRepoSync(ghClient, &transform.MockChain{}, target, nil)
+	rs.tempDir = t.TempDir()
+	sourceDir := filepath.Join(rs.tempDir, "source", "configs")
+	require.NoError(t, os.MkdirAll(sourceDir, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "app.yaml"), []byte("name: app\n"), 0o600))
+
+	require.NoError(t, rs.applyDestTemplates())
+	changes, err := rs.processFiles(context.Background())
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "target/app.yaml", changes[0].Path)
+	assert.True(t, changes[0].IsNew)
+	ghClient.AssertExpectations(t)
+}
@@ -17,7 +17,9 @@ import (
 	internalerrors "github.com/mrz1836/go-broadcast/internal/errors"
 	"github.com/mrz1836/go-broadcast/internal/git"
 	"github.com/mrz1836/go-broadcast/internal/metrics"
+	"github.com/mrz1836/go-broadcast/internal/output"
 	"github.com/mrz1836/go-broadcast/internal/state"
+	"github.com/mrz1836/go-broadcast/internal/transform"
 )
 
 // Static error variables
@@ -45,6 +47,7 @@ type DirectoryProcessor struct {
 	tempDir              string
 	moduleUpdates        []ModuleUpdateInfo // Tracks module updates for go.mod
 	moduleUpdatesMu      sync.Mutex         // Protects moduleUpdates access
+	sourceRoot           string             // Tree that symlinks found by discoverFiles must resolve into
 }
 
 // ModuleSyncResult contains the result of module-aware sync preparation
@@ -151,10 +154,7 @@ func (rs *RepositorySync) processDirectories(ctx context.Context) ([]FileChange,
 	rs.logger.WithField("directory_count", len(rs.target.Directories)).Info("Processing directories")
 
 	// Construct source repo URL for module-aware sync
-	sourceRepoURL := ""
-	if rs.sourceState != nil && rs.sourceState.Repo != "" {
-		sourceRepoURL = fmt.Sprintf("https://github.com/%s", rs.sourceState.Repo)
-	}
+	sourceRepoURL := rs.sourceRepoURL()
 
 	// Build directory processor options
 	var opts *DirectoryProcessorOptions
@@ -175,7 +175,7 @@ func (rs *RepositorySync) processDirectories(ctx context.Context) ([]FileChange,
 	processor := NewDirectoryProcessor(rs.logger, 10, opts)
 	defer processor.Close()
 
-	sourcePath := filepath.Join(rs.tempDir, "source")
+	sourcePath := rs.mappingSourcePath()
 
 	// Verify source path exists
 	if _, err := os.Stat(sourcePath); os.IsNotExist(err) {
@@ -213,6 +213,14 @@ func (rs *RepositorySync) processDirectories(ctx context.Context) ([]FileChange,
 	return allChanges, nil
 }
 
+// globalExcludes returns the config's global_exclude patterns
+func (e *Engine) globalExcludes() []string {
+	if e == nil || e.config == nil {
+		return nil
+	}
+	return e.config.GlobalExclude
+}
+
 // ProcessDirectoryMapping processes a single directory mapping
 func (dp *DirectoryProcessor) ProcessDirectoryMapping(ctx context.Context, sourcePath string, dirMapping config.DirectoryMapping, target config.TargetConfig, sourceState *state.SourceState, engine *Engine) ([]FileChange, error) {
 	logger := dp.logger.WithFields(logrus.Fields{
@@ -228,17 +236,27 @@ func (dp *DirectoryProcessor) ProcessDirectoryMapping(ctx context.Context, sourc
 		return dp.processDirectoryDeletion(ctx, dirMapping, target, engine, logger)
 	}
 
-	// Create exclusion engine with directory-specific patterns
-	dp.exclusionEngine = NewExclusionEngineWithIncludes(dirMapping.Exclude, dirMapping.IncludeOnly)
+	// Create exclusion engine with the global and directory-specific patterns
+	dp.exclusionEngine = NewExclusionEngineWithIncludes(mergeExcludes(engine.globalExcludes(), dirMapping.Exclude), dirMapping.IncludeOnly)
 
-	// Build full source directory path
-	fullSourceDir := filepath.Join(sourcePath, dirMapping.Src)
+	// Build full source directory path, following a symlinked directory as
+	// long as it stays inside the source tree
+	fullSourceDir, err := resolveSourcePath(sourcePath, dirMapping.Src)
+	if errors.Is(err, ErrSourcePathEscapes) {
+		logger.WithError(err).Warn("Source directory resolves outside the source tree, skipping")
+		return nil, internalerrors.ErrFileNotFound
+	}
 
 	// Check if source directory exists
-	if _, err := os.Stat(fullSourceDir); os.IsNotExist(err) {
+	if os.IsNotExist(err) {
 		logger.Warn("Source directory not found, skipping")
 		return nil, internalerrors.ErrFileNotFound
 	}
+	if err != nil {
+		// Any other error surfaces when the directory is walked
+		fullSourceDir = filepath.Join(sourcePath, dirMapping.Src)
+	}
+	dp.sourceRoot = sourcePath
 
 	// Track the effective source directory (may change if module versioning is used)
 	effectiveSourceDir := fullSourceDir
@@ -273,6 +291,7 @@ func (dp *DirectoryProcessor) ProcessDirectoryMapping(ctx context.Context, sourc
 
 			if result.SourcePath != "" && result.SourcePath != fullSourceDir {
 				effectiveSourceDir = result.SourcePath
+				dp.sourceRoot = result.SourcePath
 				logger.WithFields(logrus.Fields{
 					"module_type":      dirMapping.Module.Type,
 					"resolved_version": result.ResolvedVersion,
@@ -308,8 +327,10 @@ func (dp *DirectoryProcessor) ProcessDirectoryMapping(ctx context.Context, sourc
 	batchProcessor := NewBatchProcessor(engine, target, sourceState, logger, dp.workerCount)
 
 	// Use progress wrapper for batch processing
-	progressWrapper := NewBatchProgressWrapper(progressReporter)
+	fileProgress := output.NewFileProgress(target.Repo+" "+dirMapping.Src, len(jobs))
+	progressWrapper := NewBatchProgressWrapper(progressReporter).WithFileProgress(fileProgress)
 	changes, err := batchProcessor.ProcessFilesWithProgress(ctx, sourcePath, jobs, progressWrapper)
+	fileProgress.Done()
 	if err != nil {
 		progressReporter.Complete()
 		return nil, fmt.Errorf("failed to process files in directory %s: %w", dirMapping.Src, err)
@@ -354,6 +375,16 @@ func (dp *DirectoryProcessor) discoverFiles(ctx context.Context, sourceDir strin
 		includeHidden = *dirMapping.IncludeHidden
 	}
 
+	// Symlinks must resolve into the source tree, or the walked directory
+	// when it is discovered on its own
+	root := dp.sourceRoot
+	if root == "" {
+		root = sourceDir
+	}
+	if realRoot, err := filepath.EvalSymlinks(root); err == nil {
+		root = realRoot
+	}
+
 	// Walk the directory tree
 	err := filepath.WalkDir(sourceDir, func(path string, d fs.DirEntry, err error) error {
 		// Handle context cancellation
@@ -406,9 +437,14 @@ func (dp *DirectoryProcessor) discoverFiles(ctx context.Context, sourceDir strin
 			return nil
 		}
 
-		// Get file info for size
-		info, err := d.Info()
-		if err != nil {
+		// Get file info for size, of the file a symlink resolves to
+		var info fs.FileInfo
+		if d.Type()&fs.ModeSymlink != 0 {
+			var ok bool
+			if info, ok = dp.symlinkTarget(path, relPath, root); !ok {
+				return nil
+			}
+		} else if info, err = d.Info(); err != nil {
 			dp.logger.WithError(err).WithField("file", relPath).Warn("Failed to get file info")
 			return nil
 		}
@@ -442,6 +478,35 @@ func (dp *DirectoryProcessor) discoverFiles(ctx context.Context, sourceDir strin
 	return files, nil
 }
 
+// symlinkTarget returns the file info of the file the symlink at path
+// resolves to. Symlinks that are dangling, resolve outside root or point at a
+// directory, which is never walked, are logged and reported as not ok.
+func (dp *DirectoryProcessor) symlinkTarget(path, relPath, root string) (fs.FileInfo, bool) {
+	logger := dp.logger.WithField("file", relPath)
+
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to resolve symlink, skipping")
+		return nil, false
+	}
+	if !withinDir(root, resolved) {
+		logger.WithError(fmt.Errorf("%w: %s resolves to %s", ErrSourcePathEscapes, relPath, resolved)).
+			Warn("Symlink resolves outside the source tree, skipping")
+		return nil, false
+	}
+
+	info, err := os.Stat(resolved)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get file info")
+		return nil, false
+	}
+	if info.IsDir() {
+		logger.Warn("Symlinked directory inside a mapped directory is not followed, skipping")
+		return nil, false
+	}
+	return info, true
+}
+
 // createFileJobs converts discovered files into processing jobs with directory-specific metadata
 func (dp *DirectoryProcessor) createFileJobs(files []DiscoveredFile, dirMapping config.DirectoryMapping) []FileJob {
 	// First, count non-directory files to get total count
@@ -463,6 +528,7 @@ func (dp *DirectoryProcessor) createFileJobs(files []DiscoveredFile, dirMapping
 
 		// Determine destination path
 		destPath := dp.calculateDestinationPath(file.RelativePath, dirMapping)
+		destPath = transform.RenderedPath(file.RelativePath, destPath, dirMapping.Transform.RenderSuffix())
 
 		// Create directory-aware job using the new helper function
 		job := NewDirectoryFileJob(
@@ -706,10 +772,7 @@ func (rs *RepositorySync) ProcessDirectoriesWithOptions(ctx context.Context, opt
 	}).Info("Processing directories with custom options")
 
 	// Construct source repo URL for module-aware sync
-	sourceRepoURL := ""
-	if rs.sourceState != nil && rs.sourceState.Repo != "" {
-		sourceRepoURL = fmt.Sprintf("https://github.com/%s", rs.sourceState.Repo)
-	}
+	sourceRepoURL := rs.sourceRepoURL()
 
 	// Build directory processor options
 	var dpOpts *DirectoryProcessorOptions
@@ -730,7 +793,7 @@ func (rs *RepositorySync) ProcessDirectoriesWithOptions(ctx context.Context, opt
 	processor := NewDirectoryProcessor(rs.logger, workerCount, dpOpts)
 	defer processor.Close()
 
-	sourcePath := filepath.Join(rs.tempDir, "source")
+	sourcePath := rs.mappingSourcePath()
 	var allChanges []FileChange
 
 	// Process each directory mapping with options
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+
+	"github.com/mrz1836/go-broadcast/internal/output"
 )
 
 // DirectoryProgressReporter handles progress reporting for directory operations
@@ -311,6 +313,7 @@ func NewDirectoryProgressReporterWithOptions(logger *logrus.Entry, directoryPath
 // BatchProgressWrapper wraps the DirectoryProgressReporter to implement ProgressReporter interface
 type BatchProgressWrapper struct {
 	reporter *DirectoryProgressReporter
+	files    *output.FileProgress
 }
 
 // NewBatchProgressWrapper creates a wrapper for batch processing progress reporting
@@ -320,6 +323,17 @@ func NewBatchProgressWrapper(reporter *DirectoryProgressReporter) *BatchProgress
 	}
 }
 
+// WithFileProgress renders each processed file on the given progress line
+func (bpw *BatchProgressWrapper) WithFileProgress(files *output.FileProgress) *BatchProgressWrapper {
+	bpw.files = files
+	return bpw
+}
+
+// FileProcessed implements FileProgressReporter interface for batch processing
+func (bpw *BatchProgressWrapper) FileProcessed(current, _ int, path string) {
+	bpw.files.Update(current, path)
+}
+
 // UpdateProgress implements ProgressReporter interface for batch processing
 func (bpw *BatchProgressWrapper) UpdateProgress(current, total int, message string) {
 	if bpw.reporter != nil {
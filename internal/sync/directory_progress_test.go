@@ -12,6 +12,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+
+	"github.com/mrz1836/go-broadcast/internal/output"
 )
 
 // DirectoryProgressTestSuite provides comprehensive directory progress testing
@@ -557,6 +559,14 @@ func (suite *DirectoryProgressTestSuite) TestBatchProgressWrapper() {
 		wrapper.RecordBinaryFileSkipped(1024)
 		wrapper.RecordTransformError()
 		wrapper.RecordTransformSuccess(100 * time.Millisecond)
+		wrapper.FileProcessed(5, 10, "docs/readme.md")
+	})
+
+	t.Run("reports processed files", func(t *testing.T) {
+		var wrapper ProgressReporter = NewBatchProgressWrapper(nil).WithFileProgress(output.NewFileProgress("org/repo", 10))
+
+		_, ok := wrapper.(FileProgressReporter)
+		assert.True(t, ok)
 	})
 }
 
@@ -200,6 +200,36 @@ func (suite *DirectoryTestSuite) TestDirectoryDiscoveryWithExclusions() {
 	}
 }
 
+// TestDirectoryDiscoveryWithNegatedExclusions tests that ordered negation patterns
+// re-include files during the directory walk
+func (suite *DirectoryTestSuite) TestDirectoryDiscoveryWithNegatedExclusions() {
+	ctx := context.Background()
+
+	dirMapping := config.DirectoryMapping{
+		Src:     "",
+		Dest:    "dest",
+		Exclude: []string{"**/*.yml", "!.github/workflows/ci.yml", "docs/**", "!docs/api.md", "vendor/"},
+	}
+
+	suite.processor.exclusionEngine = NewExclusionEngine(dirMapping.Exclude)
+
+	files, err := suite.processor.discoverFiles(ctx, suite.sourceDir, dirMapping)
+	suite.Require().NoError(err)
+
+	found := make(map[string]bool, len(files))
+	for _, file := range files {
+		found[file.RelativePath] = true
+	}
+
+	suite.True(found[".github/workflows/ci.yml"], "negation should re-include ci.yml")
+	suite.False(found[".github/workflows/deploy.yml"], "deploy.yml should be excluded by **/*.yml")
+	suite.False(found["docker-compose.yml"], "**/*.yml should match root-level files")
+	suite.True(found["docs/api.md"], "negation should re-include docs/api.md")
+	suite.False(found["docs/deployment.md"], "docs/** should exclude docs/deployment.md")
+	suite.False(found["vendor/lib/example.go"], "vendor/ should exclude the whole directory")
+	suite.True(found["README.md"], "unmatched files should be kept")
+}
+
 // TestDirectoryDiscoveryHiddenFiles tests hidden file inclusion/exclusion
 func (suite *DirectoryTestSuite) TestDirectoryDiscoveryHiddenFiles() {
 	ctx := context.Background()
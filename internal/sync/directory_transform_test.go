@@ -992,6 +992,14 @@ func (m *DirectoryMockGHClient) GetPRCheckStatus(_ context.Context, _ string, _
 	return &gh.CheckStatusSummary{}, nil
 }
 
+func (m *DirectoryMockGHClient) GetCommitCheckStatus(_ context.Context, _, _ string) (*gh.CheckStatusSummary, error) {
+	return &gh.CheckStatusSummary{}, nil
+}
+
+func (m *DirectoryMockGHClient) GetCombinedStatus(_ context.Context, _, _ string) (*gh.CombinedStatus, error) {
+	return &gh.CombinedStatus{}, nil
+}
+
 func (m *DirectoryMockGHClient) ClosePR(_ context.Context, _ string, _ int, _ string) error {
 	return nil
 }
@@ -1059,6 +1067,18 @@ func (m *DirectoryMockGHClient) AddPRComment(_ context.Context, _ string, _ int,
 	return nil
 }
 
+func (m *DirectoryMockGHClient) ListIssueComments(_ context.Context, _ string, _ int) ([]gh.IssueComment, error) {
+	return nil, nil
+}
+
+func (m *DirectoryMockGHClient) CreateIssueComment(_ context.Context, _ string, _ int, _ string) (*gh.IssueComment, error) {
+	return &gh.IssueComment{ID: 1}, nil
+}
+
+func (m *DirectoryMockGHClient) UpdateIssueComment(_ context.Context, _ string, _ int64, _ string) error {
+	return nil
+}
+
 func (m *DirectoryMockGHClient) DiscoverOrgRepos(_ context.Context, _ string) ([]gh.RepoInfo, error) {
 	return nil, nil
 }
@@ -1151,6 +1171,10 @@ func (m *DirectoryMockGHClient) SetTopics(_ context.Context, _ string, _ []strin
 	return nil
 }
 
+func (m *DirectoryMockGHClient) GetRepoTopics(_ context.Context, _ string) ([]string, error) {
+	return nil, nil
+}
+
 // DirectoryMockFileContent represents mock file content
 type DirectoryMockFileContent struct {
 	Content []byte
@@ -0,0 +1,163 @@
+package sync
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Actions recorded for files and pull requests in a dry-run plan
+const (
+	PlanActionCreate = "create"
+	PlanActionUpdate = "update"
+	PlanActionDelete = "delete"
+)
+
+// DryRunPlan is the machine-readable dry-run plan written to
+// Options.DryRunPlanFile. It carries the same information as the boxed
+// dry-run output, so plans from two runs can be diffed.
+type DryRunPlan struct {
+	GeneratedAt time.Time          `json:"generated_at"`
+	Totals      DryRunTotals       `json:"totals"`
+	Targets     []DryRunTargetPlan `json:"targets"`
+}
+
+// DryRunTargetPlan is what a sync would do to one target repository
+type DryRunTargetPlan struct {
+	Repo         string           `json:"repo"`
+	Group        string           `json:"group,omitempty"`
+	Status       string           `json:"status"` // One of the TargetStatus* values
+	SourceRepo   string           `json:"source_repo"`
+	SourceCommit string           `json:"source_commit"`
+	Branch       string           `json:"branch,omitempty"`
+	Files        []DryRunFilePlan `json:"files"`
+	PullRequest  *DryRunPRPlan    `json:"pull_request,omitempty"`
+	Error        string           `json:"error,omitempty"`
+}
+
+// DryRunFilePlan is one file that would be created, updated or deleted.
+// Hashes are hex-encoded SHA-256 of the target content before and after the
+// sync; a new file has no before hash and a deletion no after hash.
+type DryRunFilePlan struct {
+	Path       string `json:"path"`
+	Action     string `json:"action"`
+	BeforeHash string `json:"before_sha256,omitempty"`
+	AfterHash  string `json:"after_sha256,omitempty"`
+}
+
+// DryRunPRPlan is the pull request that would be opened or updated
+type DryRunPRPlan struct {
+	Action string `json:"action"`
+	Number int    `json:"number,omitempty"` // Existing pull request being updated
+	Title  string `json:"title"`
+	Body   string `json:"body,omitempty"`
+}
+
+// newDryRunFilePlans lists the planned file changes with their content hashes
+func newDryRunFilePlans(changes []FileChange) []DryRunFilePlan {
+	files := make([]DryRunFilePlan, 0, len(changes))
+	for _, change := range changes {
+		file := DryRunFilePlan{Path: change.Path, Action: PlanActionUpdate}
+		switch {
+		case change.IsDeleted:
+			file.Action = PlanActionDelete
+		case change.IsNew:
+			file.Action = PlanActionCreate
+		}
+		if !change.IsNew {
+			file.BeforeHash = contentHash(change.OriginalContent)
+		}
+		if !change.IsDeleted {
+			file.AfterHash = contentHash(change.Content)
+		}
+		files = append(files, file)
+	}
+	return files
+}
+
+// newDryRunTargetPlan builds this target's plan from the values Execute
+// finished with. Changes are only listed once the target reached the PR
+// preview; skipped and failed targets are recorded without files.
+func (rs *RepositorySync) newDryRunTargetPlan(branchName string, previewed []FileChange, syncErr error, status string) DryRunTargetPlan {
+	plan := DryRunTargetPlan{
+		Repo:         rs.target.Repo,
+		Status:       status,
+		SourceRepo:   rs.sourceState.Repo,
+		SourceCommit: rs.sourceState.LatestCommit,
+		Branch:       branchName,
+		Files:        newDryRunFilePlans(previewed),
+		PullRequest:  rs.plannedPR,
+	}
+	if currentGroup := rs.engine.GetCurrentGroup(); currentGroup != nil {
+		plan.Group = currentGroup.ID
+	}
+	if syncErr != nil {
+		plan.Status = TargetStatusFailed
+		plan.Error = syncErr.Error()
+	}
+	if plan.Status == "" {
+		plan.Status = TargetStatusSuccess
+	}
+	return plan
+}
+
+// recordDryRunPlan keeps a target's plan for the plan file. Safe for
+// concurrent use by the target worker pool; every group adds to the one run
+// state.
+func (e *Engine) recordDryRunPlan(plan DryRunTargetPlan) {
+	run := e.shared()
+	run.dryRunMu.Lock()
+	defer run.dryRunMu.Unlock()
+	run.dryRunPlan = append(run.dryRunPlan, plan)
+}
+
+// DryRunPlan returns the dry-run plan accumulated so far. Targets are
+// ordered by group and repository, not by completion, so plans diff cleanly.
+func (e *Engine) DryRunPlan() DryRunPlan {
+	run := e.shared()
+	run.dryRunMu.Lock()
+	defer run.dryRunMu.Unlock()
+
+	targets := append([]DryRunTargetPlan{}, run.dryRunPlan...)
+	sort.SliceStable(targets, func(i, j int) bool {
+		if targets[i].Group != targets[j].Group {
+			return targets[i].Group < targets[j].Group
+		}
+		return targets[i].Repo < targets[j].Repo
+	})
+	return DryRunPlan{
+		GeneratedAt: time.Now(),
+		Totals:      run.dryRunTotals,
+		Targets:     targets,
+	}
+}
+
+// prepareDryRunPlanFile creates the directory of Options.DryRunPlanFile so a
+// misconfigured path fails the run before any target is synced
+func (e *Engine) prepareDryRunPlanFile() error {
+	if e.options.DryRunPlanFile == "" || !e.options.DryRun {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(e.options.DryRunPlanFile), 0o750); err != nil {
+		return fmt.Errorf("failed to create dry-run output directory: %w", err)
+	}
+	return nil
+}
+
+// writeDryRunPlan writes the plan file once all groups have finished. Write
+// failures are logged and never fail the dry run.
+func (e *Engine) writeDryRunPlan(log *logrus.Entry) {
+	if e.options.DryRunPlanFile == "" || !e.options.DryRun {
+		return
+	}
+	path := e.options.DryRunPlanFile
+	if err := writeJSONArtifact(path, e.DryRunPlan()); err != nil {
+		log.WithError(err).WithField("path", path).Warn("Failed to write dry-run plan")
+		return
+	}
+	log.WithField("path", path).Info("Dry-run plan written")
+}
@@ -0,0 +1,87 @@
+package sync
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+func TestNewDryRunFilePlans(t *testing.T) {
+	files := newDryRunFilePlans([]FileChange{
+		{Path: "README.md", OriginalContent: []byte("old"), Content: []byte("new")},
+		{Path: "new.txt", IsNew: true, Content: []byte("new")},
+		{Path: "old.txt", IsDeleted: true, OriginalContent: []byte("old")},
+	})
+
+	assert.Equal(t, []DryRunFilePlan{
+		{Path: "README.md", Action: PlanActionUpdate, BeforeHash: contentHash([]byte("old")), AfterHash: contentHash([]byte("new"))},
+		{Path: "new.txt", Action: PlanActionCreate, AfterHash: contentHash([]byte("new"))},
+		{Path: "old.txt", Action: PlanActionDelete, BeforeHash: contentHash([]byte("old"))},
+	}, files)
+	assert.NotNil(t, newDryRunFilePlans(nil), "targets without changes list no files, not null")
+}
+
+func TestRepositorySync_newDryRunTargetPlan(t *testing.T) {
+	target := config.TargetConfig{Repo: "org/target"}
+	rs := newTestRepoSync(&gh.MockClient{}, nil, target, nil)
+	rs.plannedPR = &DryRunPRPlan{Action: PlanActionCreate, Title: "Sync files", Body: "body"}
+
+	plan := rs.newDryRunTargetPlan("chore/sync-files-1", []FileChange{{Path: "a.txt", IsNew: true}}, nil, "")
+	assert.Equal(t, "org/target", plan.Repo)
+	assert.Equal(t, TargetStatusSuccess, plan.Status)
+	assert.Equal(t, "org/template", plan.SourceRepo)
+	assert.Equal(t, "abc123", plan.SourceCommit)
+	assert.Equal(t, "chore/sync-files-1", plan.Branch)
+	assert.Len(t, plan.Files, 1)
+	assert.Equal(t, rs.plannedPR, plan.PullRequest)
+
+	plan = rs.newDryRunTargetPlan("", nil, assert.AnError, "")
+	assert.Equal(t, TargetStatusFailed, plan.Status)
+	assert.Equal(t, assert.AnError.Error(), plan.Error)
+
+	plan = rs.newDryRunTargetPlan("", nil, nil, TargetStatusSkipped)
+	assert.Equal(t, TargetStatusSkipped, plan.Status)
+}
+
+func TestEngine_writeDryRunPlan(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plans", "plan.json")
+	root := &Engine{options: DefaultOptions().WithDryRun(true).WithDryRunPlanFile(path)}
+	view := &Engine{options: root.options, run: root.shared()}
+
+	require.NoError(t, root.prepareDryRunPlanFile())
+	view.recordDryRunTarget(FileProcessingMetrics{FilesChanged: 1}, []FileChange{{Path: "a.txt", IsNew: true}})
+	view.recordDryRunPlan(DryRunTargetPlan{Repo: "org/b", Group: "core", Status: TargetStatusSuccess})
+	root.recordDryRunPlan(DryRunTargetPlan{Repo: "org/a", Group: "core", Status: TargetStatusSkipped})
+
+	root.writeDryRunPlan(logrus.NewEntry(logrus.New()))
+
+	data, err := os.ReadFile(path) //nolint:gosec // test file in a temp dir
+	require.NoError(t, err)
+
+	var plan DryRunPlan
+	require.NoError(t, json.Unmarshal(data, &plan))
+	assert.Equal(t, 1, plan.Totals.Targets)
+	require.Len(t, plan.Targets, 2)
+	assert.Equal(t, "org/a", plan.Targets[0].Repo, "targets are sorted for stable diffs")
+	assert.Equal(t, "org/b", plan.Targets[1].Repo)
+	assert.False(t, plan.GeneratedAt.IsZero())
+}
+
+func TestEngine_writeDryRunPlan_Disabled(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plan.json")
+	engine := &Engine{options: DefaultOptions().WithDryRunPlanFile(path)}
+
+	require.NoError(t, engine.prepareDryRunPlanFile())
+	engine.writeDryRunPlan(logrus.NewEntry(logrus.New()))
+
+	_, err := os.Stat(path)
+	require.ErrorIs(t, err, os.ErrNotExist, "no plan is written outside dry-run mode")
+}
@@ -0,0 +1,87 @@
+package sync
+
+import (
+	"fmt"
+)
+
+// DryRunTotals rolls up dry-run results across every target in a sync run.
+// The JSON tags match the keys printed on the DRY-RUN TOTALS line so the
+// totals can be embedded in machine-readable output such as status --json.
+type DryRunTotals struct {
+	Targets      int `json:"targets"`       // Targets evaluated, including skipped and up-to-date ones
+	FilesChanged int `json:"files_changed"` // Files that would change across all targets
+	NewFiles     int `json:"new_files"`     // Files that would be created
+	Deletions    int `json:"deletions"`     // Files that would be deleted
+	EstimatedPRs int `json:"estimated_prs"` // Pull requests that would be created or updated
+}
+
+// String formats the totals as space-separated key=value pairs
+func (t DryRunTotals) String() string {
+	return fmt.Sprintf("targets=%d files_changed=%d new_files=%d deletions=%d estimated_prs=%d",
+		t.Targets, t.FilesChanged, t.NewFiles, t.Deletions, t.EstimatedPRs)
+}
+
+// addTarget folds one target's dry-run result into the totals. A target
+// without changes counts towards Targets only.
+func (t *DryRunTotals) addTarget(fileMetrics FileProcessingMetrics, changes []FileChange) {
+	t.Targets++
+	if len(changes) == 0 {
+		return
+	}
+
+	t.EstimatedPRs++
+	t.FilesChanged += fileMetrics.FilesChanged
+	t.Deletions += fileMetrics.FilesDeleted
+	for _, change := range changes {
+		if change.IsNew && !change.IsDeleted {
+			t.NewFiles++
+		}
+	}
+}
+
+// recordDryRunTarget adds a target's dry-run result to the engine totals.
+// Safe for concurrent use by the target worker pool. All groups share the
+// run state, so the summary covers every group.
+func (e *Engine) recordDryRunTarget(fileMetrics FileProcessingMetrics, changes []FileChange) {
+	run := e.shared()
+	run.dryRunMu.Lock()
+	defer run.dryRunMu.Unlock()
+	run.dryRunTotals.addTarget(fileMetrics, changes)
+}
+
+// DryRunTotals returns the dry-run totals accumulated so far
+func (e *Engine) DryRunTotals() DryRunTotals {
+	run := e.shared()
+	run.dryRunMu.Lock()
+	defer run.dryRunMu.Unlock()
+	return run.dryRunTotals
+}
+
+// PlanHasChanges reports whether the dry run found any target that would get
+// a pull request, i.e. whether the targets are out of sync
+func (e *Engine) PlanHasChanges() bool {
+	return e.DryRunTotals().EstimatedPRs > 0
+}
+
+// printDryRunSummary prints the dry-run roll-up once all groups have finished
+func (e *Engine) printDryRunSummary() {
+	if !e.options.DryRun {
+		return
+	}
+	if totals := e.DryRunTotals(); totals.Targets > 0 {
+		NewDryRunOutput(nil).Summary(totals)
+	}
+}
+
+// Summary prints the dry-run totals box followed by a single
+// machine-parseable "DRY-RUN TOTALS:" line
+func (d *DryRunOutput) Summary(totals DryRunTotals) {
+	d.Header("DRY-RUN TOTALS")
+	d.Field("Targets", fmt.Sprintf("%d", totals.Targets))
+	d.Field("Files that would change", fmt.Sprintf("%d", totals.FilesChanged))
+	d.Field("New files", fmt.Sprintf("%d", totals.NewFiles))
+	d.Field("Deletions", fmt.Sprintf("%d", totals.Deletions))
+	d.Field("Estimated PRs", fmt.Sprintf("%d", totals.EstimatedPRs))
+	d.Footer()
+	_, _ = fmt.Fprintf(d.writer, "DRY-RUN TOTALS: %s\n", totals)
+}
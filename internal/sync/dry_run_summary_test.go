@@ -0,0 +1,83 @@
+package sync
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRunTotals_addTarget(t *testing.T) {
+	var totals DryRunTotals
+
+	totals.addTarget(FileProcessingMetrics{FilesChanged: 3, FilesDeleted: 1}, []FileChange{
+		{Path: "README.md"},
+		{Path: "new.txt", IsNew: true},
+		{Path: "old.txt", IsDeleted: true},
+	})
+	// Up-to-date target: counted, but no files or PR
+	totals.addTarget(FileProcessingMetrics{FilesProcessed: 4}, nil)
+	totals.addTarget(FileProcessingMetrics{FilesChanged: 1}, []FileChange{{Path: "a.go", IsNew: true}})
+
+	assert.Equal(t, DryRunTotals{
+		Targets:      3,
+		FilesChanged: 4,
+		NewFiles:     2,
+		Deletions:    1,
+		EstimatedPRs: 2,
+	}, totals)
+}
+
+func TestEngine_recordDryRunTarget_Concurrent(t *testing.T) {
+	engine := &Engine{}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			engine.recordDryRunTarget(FileProcessingMetrics{FilesChanged: 2}, []FileChange{{Path: "a"}, {Path: "b", IsNew: true}})
+		}()
+	}
+	wg.Wait()
+
+	totals := engine.DryRunTotals()
+	assert.Equal(t, 20, totals.Targets)
+	assert.Equal(t, 40, totals.FilesChanged)
+	assert.Equal(t, 20, totals.NewFiles)
+	assert.Equal(t, 20, totals.EstimatedPRs)
+}
+
+func TestEngine_PlanHasChanges(t *testing.T) {
+	engine := &Engine{}
+	engine.recordDryRunTarget(FileProcessingMetrics{}, nil)
+	assert.False(t, engine.PlanHasChanges())
+
+	view := &Engine{run: engine.shared()}
+	view.recordDryRunTarget(FileProcessingMetrics{FilesChanged: 1}, []FileChange{{Path: "a"}})
+	assert.True(t, view.PlanHasChanges())
+	assert.True(t, engine.PlanHasChanges())
+}
+
+func TestDryRunOutput_Summary(t *testing.T) {
+	buf := &bytes.Buffer{}
+	totals := DryRunTotals{Targets: 3, FilesChanged: 7, NewFiles: 2, Deletions: 1, EstimatedPRs: 2}
+
+	NewDryRunOutput(buf).Summary(totals)
+
+	out := buf.String()
+	assert.Contains(t, out, "DRY-RUN TOTALS")
+	assert.Contains(t, out, "│ Targets: 3")
+	assert.Contains(t, out, "│ Files that would change: 7")
+	assert.Contains(t, out, "│ Estimated PRs: 2")
+	assert.Contains(t, out, "DRY-RUN TOTALS: targets=3 files_changed=7 new_files=2 deletions=1 estimated_prs=2\n")
+}
+
+func TestDryRunTotals_JSON(t *testing.T) {
+	data, err := json.Marshal(DryRunTotals{Targets: 1, FilesChanged: 2, NewFiles: 1, EstimatedPRs: 1})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"targets":1,"files_changed":2,"new_files":1,"deletions":0,"estimated_prs":1}`, string(data))
+}
@@ -0,0 +1,106 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	internalerrors "github.com/mrz1836/go-broadcast/internal/errors"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/git"
+	"github.com/mrz1836/go-broadcast/internal/state"
+)
+
+// newEmptyResyncRepoSync returns a RepositorySync whose target clone, branch
+// and staging succeed, with the given staged diff
+func newEmptyResyncRepoSync(t *testing.T, opts *Options, stagedDiff string) (*RepositorySync, *git.MockClient) {
+	t.Helper()
+	gitClient := &git.MockClient{}
+	gitClient.On("Clone", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	gitClient.On("CreateBranch", mock.Anything, mock.Anything, "sync-branch").Return(nil)
+	gitClient.On("Checkout", mock.Anything, mock.Anything, "sync-branch").Return(nil)
+	gitClient.On("Add", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	gitClient.On("Diff", mock.Anything, mock.Anything, true).Return(stagedDiff, nil).Maybe()
+	gitClient.On("GetCurrentCommitSHA", mock.Anything, mock.Anything).Return("empty-sha", nil).Maybe()
+	gitClient.On("GetChangedFiles", mock.Anything, mock.Anything).Return([]string{}, nil).Maybe()
+
+	rs := &RepositorySync{
+		engine:      &Engine{git: gitClient, gh: &gh.MockClient{}, options: opts},
+		tempDir:     t.TempDir(),
+		target:      config.TargetConfig{Repo: "org/target"},
+		logger:      logrus.NewEntry(logrus.New()),
+		sourceState: &state.SourceState{Repo: "org/template", LatestCommit: "abc123def456"},
+		targetState: &state.TargetState{},
+	}
+	return rs, gitClient
+}
+
+func TestRepositorySync_CommitChanges_EmptyResync(t *testing.T) {
+	forced := func() *Options { return DefaultOptions().WithForce(true).WithAllowEmptyCommit(true) }
+
+	t.Run("no changed files commits empty", func(t *testing.T) {
+		rs, gitClient := newEmptyResyncRepoSync(t, forced(), "")
+		gitClient.On("CommitEmpty", mock.Anything, mock.Anything, mock.MatchedBy(func(msg string) bool {
+			return assert.Contains(t, msg, "empty resync") && assert.Contains(t, msg, "abc123d")
+		})).Return(nil).Once()
+
+		sha, files, err := rs.commitChanges(context.Background(), "sync-branch", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "empty-sha", sha)
+		assert.Empty(t, files)
+		assert.True(t, rs.emptyCommit)
+		gitClient.AssertNotCalled(t, "Commit", mock.Anything, mock.Anything, mock.Anything)
+		gitClient.AssertExpectations(t)
+	})
+
+	t.Run("identical content commits empty", func(t *testing.T) {
+		rs, gitClient := newEmptyResyncRepoSync(t, forced(), "  \n")
+		gitClient.On("CommitEmpty", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		_, _, err := rs.commitChanges(context.Background(), "sync-branch", []FileChange{{Path: "README.md", Content: []byte("same")}})
+		require.NoError(t, err)
+		gitClient.AssertNotCalled(t, "Commit", mock.Anything, mock.Anything, mock.Anything)
+		gitClient.AssertExpectations(t)
+	})
+
+	t.Run("real changes commit normally", func(t *testing.T) {
+		rs, gitClient := newEmptyResyncRepoSync(t, forced(), "diff --git a/README.md b/README.md")
+		gitClient.On("Commit", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		_, _, err := rs.commitChanges(context.Background(), "sync-branch", []FileChange{{Path: "README.md", Content: []byte("new")}})
+		require.NoError(t, err)
+		assert.False(t, rs.emptyCommit)
+		gitClient.AssertNotCalled(t, "CommitEmpty", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("dry-run previews the empty commit", func(t *testing.T) {
+		rs, gitClient := newEmptyResyncRepoSync(t, forced().WithDryRun(true), "")
+
+		sha, files, err := rs.commitChanges(context.Background(), "sync-branch", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "dry-run-commit-sha", sha)
+		assert.Empty(t, files)
+		assert.True(t, rs.emptyCommit)
+		gitClient.AssertNotCalled(t, "CommitEmpty", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("requires force", func(t *testing.T) {
+		rs, gitClient := newEmptyResyncRepoSync(t, DefaultOptions().WithAllowEmptyCommit(true), "")
+
+		_, _, err := rs.commitChanges(context.Background(), "sync-branch", nil)
+		require.ErrorIs(t, err, internalerrors.ErrNoFilesToCommit)
+		gitClient.AssertNotCalled(t, "Clone", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestOptions_EmptyResync(t *testing.T) {
+	assert.False(t, DefaultOptions().emptyResync())
+	assert.False(t, DefaultOptions().WithAllowEmptyCommit(true).emptyResync())
+	assert.False(t, DefaultOptions().WithForce(true).emptyResync())
+	assert.True(t, DefaultOptions().WithForce(true).WithAllowEmptyCommit(true).emptyResync())
+}
@@ -5,22 +5,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand/v2"
 	"strings"
 	"sync"
-	"sync/atomic"
 	"time"
 
 	"github.com/sirupsen/logrus"
-	"golang.org/x/sync/errgroup"
 
 	"github.com/mrz1836/go-broadcast/internal/ai"
 	"github.com/mrz1836/go-broadcast/internal/config"
 	appErrors "github.com/mrz1836/go-broadcast/internal/errors"
 	"github.com/mrz1836/go-broadcast/internal/gh"
 	"github.com/mrz1836/go-broadcast/internal/git"
+	"github.com/mrz1836/go-broadcast/internal/logging"
 	"github.com/mrz1836/go-broadcast/internal/output"
 	"github.com/mrz1836/go-broadcast/internal/state"
 	"github.com/mrz1836/go-broadcast/internal/transform"
+	"github.com/mrz1836/go-broadcast/internal/worker"
 )
 
 // Engine orchestrates the complete synchronization process
@@ -35,6 +36,7 @@ type Engine struct {
 	options        *Options
 	logger         *logrus.Logger
 	scopeConfirmer ScopeConfirmer // Blast-radius interactive confirmer (injectable for tests)
+	prApprover     PRApprover     // Per-target PR approval prompt for options.Interactive (injectable for tests)
 
 	// AI text generation (optional, nil when disabled)
 	prGenerator     *ai.PRBodyGenerator
@@ -46,6 +48,16 @@ type Engine struct {
 	syncRepo     SyncMetricsRecorder
 	currentRun   *BroadcastSyncRun
 	currentRunMu sync.RWMutex // Protects currentRun access
+
+	// Reviewer pick source for the random-n strategy (nil uses the global source)
+	reviewerRand *rand.Rand
+
+	// Poll timing while waiting for PR checks (zero uses defaultChecksPolling)
+	checksPoll checksPolling
+
+	// Run-wide state shared with every per-group view (see shared)
+	run     *runState
+	runOnce sync.Once
 }
 
 // NewEngine creates a new sync engine with the provided dependencies
@@ -71,6 +83,12 @@ func NewEngine(
 		options:        opts,
 		logger:         logrus.StandardLogger(),
 		scopeConfirmer: newTerminalScopeConfirmer(),
+		prApprover:     newTerminalPRApprover(),
+	}
+
+	// Reuse discovered state across runs while sources are unchanged
+	if opts.StateCacheDir != "" && stateDiscoverer != nil && ghClient != nil {
+		e.state = state.NewCachingDiscoverer(stateDiscoverer, ghClient, opts.StateCacheDir, opts.StateCacheTTL, e.logger)
 	}
 
 	// Initialize AI components (non-fatal if it fails)
@@ -113,6 +131,44 @@ func (e *Engine) GetCurrentGroup() *config.Group {
 	return e.currentGroup
 }
 
+// RateLimitStats returns the GitHub client's rate limiter counters.
+// Clients without a rate limiter report zero values.
+func (e *Engine) RateLimitStats() gh.RateLimitStats {
+	if reporter, ok := e.gh.(gh.RateLimitReporter); ok {
+		return reporter.RateLimitStats()
+	}
+	return gh.RateLimitStats{}
+}
+
+// logRateLimitStats reports how often the client rate limiter delayed API calls
+func (e *Engine) logRateLimitStats(log *logrus.Entry) {
+	stats := e.RateLimitStats()
+	if stats.Throttled == 0 {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"throttled_calls": stats.Throttled,
+		"throttle_wait":   stats.Waited.Round(time.Millisecond).String(),
+	}).Info("GitHub API rate limiter delayed requests")
+}
+
+// logConditionalRequestStats reports how many API calls were answered from
+// the client's ETag cache with 304 Not Modified
+func (e *Engine) logConditionalRequestStats(log *logrus.Entry) {
+	reporter, ok := e.gh.(gh.ConditionalRequestReporter)
+	if !ok {
+		return
+	}
+	stats := reporter.ConditionalRequestStats()
+	if stats.Conditional == 0 {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"conditional_requests": stats.Conditional,
+		"not_modified":         stats.NotModified,
+	}).Info("GitHub API conditional requests served from cache")
+}
+
 // SetCurrentGroup sets the current group being processed (thread-safe).
 func (e *Engine) SetCurrentGroup(group *config.Group) {
 	e.currentGroupMu.Lock()
@@ -120,6 +176,33 @@ func (e *Engine) SetCurrentGroup(group *config.Group) {
 	e.currentGroup = group
 }
 
+// forGroup returns an engine view scoped to a single group. The view shares
+// clients, options, the metrics recorder and the run state with e but owns its
+// config, current group and sync run, so the orchestrator can execute
+// independent groups concurrently without swapping state on the shared engine.
+func (e *Engine) forGroup(cfg *config.Config, group *config.Group) *Engine {
+	return &Engine{
+		config:          cfg,
+		currentGroup:    group,
+		gh:              e.gh,
+		git:             e.git,
+		state:           e.state,
+		transform:       e.transform,
+		options:         e.options,
+		logger:          e.logger,
+		scopeConfirmer:  e.scopeConfirmer,
+		prApprover:      e.prApprover,
+		prGenerator:     e.prGenerator,
+		commitGenerator: e.commitGenerator,
+		responseCache:   e.responseCache,
+		diffTruncator:   e.diffTruncator,
+		syncRepo:        e.syncRepo,
+		reviewerRand:    e.reviewerRand,
+		checksPoll:      e.checksPoll,
+		run:             e.shared(),
+	}
+}
+
 // SetScopeConfirmer sets a custom blast-radius scope confirmer. Tests inject a
 // non-TTY fake so the guard's interactive branch is exercised without a terminal.
 func (e *Engine) SetScopeConfirmer(c ScopeConfirmer) {
@@ -242,14 +325,33 @@ func (e *Engine) initializeAI(ctx context.Context) {
 }
 
 // Sync orchestrates the complete synchronization process
-func (e *Engine) Sync(ctx context.Context, targetFilter []string) error {
+func (e *Engine) Sync(ctx context.Context, targetFilter []string) (syncErr error) {
 	log := e.logger.WithField("component", "sync_engine")
 
+	e.startRunMetrics()
+	defer func() { e.writeRunMetrics(log, syncErr) }()
+
 	log.Info("Starting sync operation")
 	if e.options.DryRun {
 		log.Warn("DRY-RUN MODE: No changes will be made")
 	}
 
+	if err := e.prepareOutputDir(); err != nil {
+		return err
+	}
+	defer e.writeSyncSummary(log)
+	defer e.postSourceStatus(ctx, log)
+
+	if err := e.prepareDryRunPlanFile(); err != nil {
+		return err
+	}
+	defer e.writeDryRunPlan(log)
+
+	if err := e.loadCheckpoint(log); err != nil {
+		return err
+	}
+	defer func() { e.finishCheckpoint(ctx, log, syncErr) }()
+
 	if len(e.config.Groups) == 0 {
 		log.Info("No groups found in configuration")
 		return nil
@@ -263,11 +365,24 @@ func (e *Engine) Sync(ctx context.Context, targetFilter []string) error {
 	if err != nil {
 		return err
 	}
+	if len(scope.SkippedDependents) > 0 {
+		log.WithField("groups", scope.SkippedDependents).
+			Warn("Skipping groups whose dependencies have no targets matching the target filter")
+	}
 	if scope.RepoCount == 0 {
 		log.Info("Nothing to sync after applying scope filters")
 		return nil
 	}
 
+	// Expand groups with several source branches into one group per branch so
+	// each branch runs as an independent unit with its own sync branches and PRs.
+	// Filters above match the configured group, so this happens after scoping.
+	if config.HasSourceBranches(scope.Config.Groups) {
+		expanded := config.ExpandSourceBranches(scope.Config.Groups)
+		log.WithField("group_count", len(expanded)).Debug("Expanded multi-branch source groups")
+		scope.Config = cloneConfigWithGroups(scope.Config, expanded)
+	}
+
 	// Scope this run's config to exactly what will be written, so state
 	// discovery, the rate-limit estimate, and the execution paths all operate on
 	// the resolved scope (mirrors cancel's filter-before-discovery and the
@@ -293,6 +408,13 @@ func (e *Engine) Sync(ctx context.Context, targetFilter []string) error {
 		return err
 	}
 
+	// --interactive needs a terminal to prompt on; refuse now rather than
+	// hang on the first target's prompt
+	if err := e.checkInteractive(); err != nil {
+		output.Error("--interactive needs a terminal to prompt on; run without it in CI and other non-interactive contexts")
+		return err
+	}
+
 	// Rate-limit preflight gate (whole-run, before any write). This runs once at
 	// the single chokepoint both the single-group and multi-group paths flow
 	// through, so the "all-or-nothing, no partial state" guarantee holds for every
@@ -302,6 +424,10 @@ func (e *Engine) Sync(ctx context.Context, targetFilter []string) error {
 		return err
 	}
 
+	defer e.logRateLimitStats(log)
+	defer e.logConditionalRequestStats(log)
+	defer e.printDryRunSummary()
+
 	// Branch on the resolved group count. Targets are already narrowed in the
 	// scoped config, so both paths run with no further target filtering.
 	groups := scope.Config.Groups
@@ -487,54 +613,35 @@ func (e *Engine) executeSingleGroup(ctx context.Context, group config.Group, tar
 	// 5. Create progress tracker
 	progress := NewProgressTrackerWithGroup(len(syncTargets), e.options.DryRun, group.Name, group.ID)
 
-	// 6. Process repositories concurrently with error collection
-	var g errgroup.Group
-	g.SetLimit(e.options.MaxConcurrency)
-
-	// Collect all errors instead of failing fast
-	errorCollector := make(chan error, len(syncTargets))
-	var hasContextError atomic.Bool
-
-	for _, target := range syncTargets {
-		g.Go(func() error {
-			if err := e.syncRepository(ctx, target, currentState, progress); err != nil {
-				// Check if this is a context cancellation error
-				if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
-					hasContextError.Store(true)
-				}
-
-				// Send error to collector but don't return it (prevents context cancellation)
-				select {
-				case errorCollector <- err:
-				default:
-					// Channel full, log the error
-					e.logger.WithError(err).WithField("repo", target.Repo).Error("Failed to collect sync error")
-				}
-			}
-			return nil // Always return nil to prevent errgroup from canceling context
-		})
+	// 6. Process repositories concurrently on a bounded worker pool. Each target
+	// runs to completion independently; a failure never cancels its siblings.
+	targetErrors, abortCause, err := e.runTargetPool(ctx, syncTargets, currentState, progress)
+	e.invalidateStateCache(log)
+	if err != nil {
+		return err
 	}
 
-	// 7. Wait for all syncs to complete
-	_ = g.Wait() // Always returns nil since we handle errors via errorCollector
-	close(errorCollector)
-
-	// Collect and log all errors
-	collectedErrors := make([]error, 0, len(syncTargets))
-	for err := range errorCollector {
-		collectedErrors = append(collectedErrors, err)
-		progress.SetError(err)
-
-		// Check if this is a context error (by type or string content)
-		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
-			hasContextError.Store(true)
+	// 7. Collect errors in target configuration order for deterministic output
+	collectedErrors := make([]error, 0, len(targetErrors))
+	var hasContextError bool
+	for _, targetErr := range targetErrors {
+		collectedErrors = append(collectedErrors, targetErr)
+		progress.SetError(targetErr)
+
+		// Check if this is a context error (by type or string content);
+		// configured timeouts are target failures, not a canceled run
+		if isTimeout(targetErr) {
+			continue
+		}
+		if errors.Is(targetErr, context.Canceled) || errors.Is(targetErr, context.DeadlineExceeded) {
+			hasContextError = true
 		} else {
 			// Also check error message for context-related terms
-			errMsg := err.Error()
+			errMsg := targetErr.Error()
 			if strings.Contains(errMsg, "context canceled") ||
 				strings.Contains(errMsg, "context deadline exceeded") ||
 				strings.Contains(errMsg, "deadline exceeded") {
-				hasContextError.Store(true)
+				hasContextError = true
 			}
 		}
 	}
@@ -555,38 +662,131 @@ func (e *Engine) executeSingleGroup(ctx context.Context, group config.Group, tar
 	}
 
 	// Log individual errors for debugging
-	for i, err := range collectedErrors {
-		log.WithError(err).WithField("error_index", i+1).Error("Individual sync failure")
+	for i, targetErr := range targetErrors {
+		log.WithError(targetErr.Err).WithFields(logrus.Fields{
+			"error_index": i + 1,
+			"target_repo": targetErr.Repo,
+		}).Error("Individual sync failure")
+	}
+
+	if abortCause != nil {
+		if errors.Is(abortCause.Err, ErrMaxPRsExceeded) {
+			return fmt.Errorf("%w: %s: %w", appErrors.ErrSyncFailed, abortCause.Repo, abortCause.Err)
+		}
+		return fmt.Errorf("%w: %w: %s: %w", appErrors.ErrSyncFailed, ErrFailFastAborted, abortCause.Repo, abortCause.Err)
 	}
 
-	if results.Failed > 0 {
+	if results.Failed > 0 || len(targetErrors) > 0 {
 		// If context was canceled/timeout, include context information in the error
-		if hasContextError.Load() {
+		if hasContextError {
 			if ctx.Err() != nil {
 				return fmt.Errorf("%w: %w", appErrors.ErrSyncFailed, ctx.Err())
 			}
 			return fmt.Errorf("%w: context canceled", appErrors.ErrSyncFailed)
 		}
 
-		// Include details from the first few errors to provide better context
-		var errorDetails []string
-		maxDetailsToInclude := 3 // Limit to first 3 errors to keep message readable
-		for i, err := range collectedErrors {
-			if i >= maxDetailsToInclude {
-				break
-			}
-			errorDetails = append(errorDetails, err.Error())
+		return &GroupSyncError{Total: len(syncTargets), Errors: targetErrors}
+	}
+
+	return nil
+}
+
+// invalidateStateCache drops the cached state for this engine's config once
+// targets have been synced: branches and PRs created by the run would
+// otherwise be missing from the next run's cached view. Dry runs write
+// nothing and keep the cache.
+func (e *Engine) invalidateStateCache(log *logrus.Entry) {
+	if e.options.DryRun {
+		return
+	}
+	invalidator, ok := e.state.(state.CacheInvalidator)
+	if !ok {
+		return
+	}
+	if err := invalidator.InvalidateState(e.config); err != nil {
+		log.WithError(err).Warn("Failed to invalidate state cache")
+	}
+}
+
+// runTargetPool syncs the given targets on a worker pool bounded by MaxConcurrency
+// and returns the failures in the same order as targets.
+//
+// With FailFast set, the first failure cancels the pool context: in-flight
+// targets observe the cancellation, queued targets never start, and that
+// failure is returned as abortCause. Reaching the MaxPRs limit always aborts
+// the same way. All workers have returned (and cleaned up
+// their temp directories) before runTargetPool returns.
+func (e *Engine) runTargetPool(ctx context.Context, targets []config.TargetConfig, currentState *state.State, progress *ProgressTracker) (targetErrors []*TargetError, abortCause *TargetError, err error) {
+	workers := e.options.MaxConcurrency
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(targets) {
+		workers = len(targets)
+	}
+
+	pool, err := worker.NewPool(workers, len(targets))
+	if err != nil {
+		return nil, nil, appErrors.WrapWithContext(err, "create target worker pool")
+	}
+
+	poolCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	pool.Start(poolCtx)
+
+	tasks := make([]*targetSyncTask, 0, len(targets))
+	for _, target := range targets {
+		task := &targetSyncTask{
+			engine:       e,
+			target:       target,
+			currentState: currentState,
+			progress:     progress,
 		}
+		tasks = append(tasks, task)
+		if err := pool.Submit(task); err != nil {
+			pool.Shutdown()
+			return nil, nil, appErrors.WrapWithContext(err, fmt.Sprintf("queue sync for %s", target.Repo))
+		}
+	}
 
-		if len(errorDetails) > 0 {
-			detailsStr := strings.Join(errorDetails, "; ")
-			return fmt.Errorf("%w: completed with %d failures out of %d targets (%s)", appErrors.ErrSyncFailed, results.Failed, len(syncTargets), detailsStr)
+	// Every task produces exactly one result; drain them all before shutdown,
+	// which cancels the pool context
+	failures := make(map[string]error, len(targets))
+	for range tasks {
+		result := <-pool.Results()
+		if result.Error == nil {
+			continue
+		}
+		failures[result.TaskName] = result.Error
+
+		// Only a failure seen before any cancellation can trigger fail-fast;
+		// errors from the parent context are reported as context errors instead
+		if e.abortsRun(result.Error) && abortCause == nil && poolCtx.Err() == nil {
+			abortCause = &TargetError{Repo: result.TaskName, Err: result.Error}
+			message := "Fail-fast: aborting remaining targets after first failure"
+			if errors.Is(result.Error, ErrMaxPRsExceeded) {
+				message = "Pull request limit reached: aborting remaining targets"
+			}
+			e.logger.WithError(result.Error).WithField("target_repo", result.TaskName).Warn(message)
+			cancel()
 		}
+	}
+	pool.Shutdown()
 
-		return fmt.Errorf("%w: completed with %d failures out of %d targets", appErrors.ErrSyncFailed, results.Failed, len(syncTargets))
+	targetErrors = make([]*TargetError, 0, len(failures))
+	for _, task := range tasks {
+		err, failed := failures[task.target.Repo]
+		if !failed {
+			continue
+		}
+		// Tasks dequeued after cancellation never reach syncRepository
+		if !task.started {
+			progress.RecordError(task.target.Repo, err)
+		}
+		targetErrors = append(targetErrors, &TargetError{Repo: task.target.Repo, Err: err})
 	}
 
-	return nil
+	return targetErrors, abortCause, nil
 }
 
 // filterGroupTargets determines which targets need to be synced based on filters, group, and current state
@@ -662,14 +862,26 @@ func (e *Engine) filterTargetsFromList(targets []config.TargetConfig, currentSta
 		var syncNeeded []config.TargetConfig
 
 		for _, target := range targets {
+			sourceCommit := currentState.Source.LatestCommit
+			if e.checkpointCompleted(target.Repo, sourceCommit) {
+				e.logger.WithField("repo", target.Repo).Info("Target completed by an interrupted run at this source commit, skipping")
+				e.explainTarget(target.Repo, false, "completed by an interrupted run at this source commit",
+					currentState.Targets[target.Repo], sourceCommit)
+				continue
+			}
 			if e.needsSync(target, currentState) {
 				syncNeeded = append(syncNeeded, target)
 			} else {
 				e.logger.WithField("repo", target.Repo).Info("Target is up-to-date, skipping")
+				e.recordSkippedTarget(target.Repo, currentState.Source, currentState.Targets[target.Repo])
 			}
 		}
 
 		targets = syncNeeded
+	} else {
+		for _, target := range targets {
+			e.explainTarget(target.Repo, true, "--force syncs every target", currentState.Targets[target.Repo], currentState.Source.LatestCommit)
+		}
 	}
 
 	return targets, nil
@@ -677,35 +889,25 @@ func (e *Engine) filterTargetsFromList(targets []config.TargetConfig, currentSta
 
 // needsSync determines if a target repository needs synchronization
 func (e *Engine) needsSync(target config.TargetConfig, currentState *state.State) bool {
-	targetState, exists := currentState.Targets[target.Repo]
-	if !exists {
-		// No state found, sync needed
-		return true
-	}
-
-	switch targetState.Status {
-	case state.StatusUpToDate:
-		return false
-	case state.StatusBehind:
-		return true
-	case state.StatusPending:
-		// PR is open, check if we should update it
-		return e.options.UpdateExistingPRs
-	case state.StatusConflict:
+	needed, reason := e.syncDecision(target, currentState)
+	if targetState, exists := currentState.Targets[target.Repo]; exists && targetState.Status == state.StatusConflict {
 		// Conflicts require manual intervention
 		e.logger.WithField("repo", target.Repo).Warn("Repository has conflicts, skipping automatic sync")
-		return false
-	default:
-		// Unknown status, err on the side of caution and sync
-		return true
 	}
+	e.explainTarget(target.Repo, needed, reason, currentState.Targets[target.Repo], currentState.Source.LatestCommit)
+	return needed
 }
 
 // syncRepository handles synchronization for a single repository
 func (e *Engine) syncRepository(ctx context.Context, target config.TargetConfig, currentState *state.State, progress *ProgressTracker) error {
+	// Every RepositorySync log line carries these fields so structured (JSON)
+	// logs can be filtered per group, target and source commit
 	fields := logrus.Fields{
-		"target_repo": target.Repo,
-		"component":   "repository_sync",
+		logging.StandardFields.TargetRepo: target.Repo,
+		logging.StandardFields.SourceRepo: currentState.Source.Repo,
+		logging.StandardFields.CommitSHA:  currentState.Source.LatestCommit,
+		logging.StandardFields.Component:  "repository_sync",
+		logging.StandardFields.Operation:  "repository_sync",
 	}
 	// Add group context if available
 	if currentGroup := e.GetCurrentGroup(); currentGroup != nil {
@@ -717,6 +919,14 @@ func (e *Engine) syncRepository(ctx context.Context, target config.TargetConfig,
 	progress.StartRepository(target.Repo)
 	defer progress.FinishRepository(target.Repo)
 
+	// Once the operator quits at an --interactive prompt, later targets are
+	// skipped before any work is done for them
+	if e.options.Interactive && e.interactiveQuitRequested() {
+		progress.RecordSkipped(target.Repo, "quit at the interactive prompt")
+		e.recordInteractiveSkip(target.Repo)
+		return nil
+	}
+
 	log.Info("Starting repository sync")
 
 	// Get target state
@@ -736,15 +946,26 @@ func (e *Engine) syncRepository(ctx context.Context, target config.TargetConfig,
 	}
 
 	// Execute sync
-	err := repoSync.Execute(ctx)
+	err := repoSync.executeWithTimeout(ctx)
+	if repoSync.result != nil {
+		repoSync.result.setError(err)
+		e.recordSyncResult(repoSync.result, log)
+	}
 	if err != nil {
-		log.WithError(err).Error("Repository sync failed")
+		// repoSync.logger carries the operation of the stage that failed
+		repoSync.logger.WithError(err).Error("Repository sync failed")
 		progress.RecordError(target.Repo, err)
 		return appErrors.WrapWithContext(err, fmt.Sprintf("sync %s", target.Repo))
 	}
+	if repoSync.skipReason != "" {
+		progress.RecordSkipped(target.Repo, repoSync.skipReason)
+		e.recordInteractiveSkip(target.Repo)
+		return nil
+	}
 
 	log.Info("Repository sync completed successfully")
 	progress.RecordSuccess(target.Repo)
+	e.recordCheckpoint(target.Repo, currentState.Source.LatestCommit, log)
 	return nil
 }
 
@@ -768,7 +989,7 @@ func (e *Engine) recordSyncRunStart(ctx context.Context, group config.Group, cur
 	}
 
 	// Resolve group ID from external ID string to DB uint
-	if groupID, err := e.syncRepo.LookupGroupID(ctx, group.ID); err == nil {
+	if groupID, err := e.syncRepo.LookupGroupID(ctx, group.ConfiguredID()); err == nil {
 		run.GroupID = &groupID
 	} else {
 		log.WithError(err).Debug("Could not resolve group DB ID, field will be nil")
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -1602,3 +1603,49 @@ func TestEngineSyncRateLimitPreflightZeroWrites(t *testing.T) {
 	stateDiscoverer.AssertNotCalled(t, "DiscoverState", mock.Anything, mock.Anything)
 	ghClient.AssertNotCalled(t, "CreatePR", mock.Anything, mock.Anything, mock.Anything)
 }
+
+func TestEngine_syncRepository_StructuredLogFields(t *testing.T) {
+	group := config.Group{
+		Name:   "core",
+		ID:     "core-id",
+		Source: config.SourceConfig{Repo: "org/template", Branch: "master"},
+		Targets: []config.TargetConfig{{
+			Repo:  "org/target",
+			Files: []config.FileMapping{{Src: "file.txt", Dest: "file.txt"}},
+		}},
+	}
+	currentState := &state.State{
+		Source: state.SourceState{Repo: "org/template", Branch: "master", LatestCommit: "abc123"},
+		Targets: map[string]*state.TargetState{
+			"org/target": {Repo: "org/target", LastSyncCommit: "old", Status: state.StatusBehind},
+		},
+	}
+
+	ghClient := &gh.MockClient{}
+	ghClient.On("ListBranches", mock.Anything, mock.Anything).Return([]gh.Branch{}, nil).Maybe()
+	ghClient.On("GetFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, gh.ErrFileNotFound).Maybe()
+	gitClient := &git.MockClient{}
+	gitClient.On("Clone", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errGitCloneFailed)
+
+	logger, hook := logtest.NewNullLogger()
+	engine := NewEngine(context.Background(), &config.Config{Groups: []config.Group{group}}, ghClient, gitClient,
+		&state.MockDiscoverer{}, &transform.MockChain{}, DefaultOptions())
+	engine.SetLogger(logger)
+	engine.SetCurrentGroup(&group)
+
+	err := engine.syncRepository(context.Background(), group.Targets[0], currentState, NewProgressTracker(1, false))
+	require.Error(t, err)
+
+	var failed *logrus.Entry
+	for _, entry := range hook.AllEntries() {
+		if entry.Message == "Repository sync failed" {
+			failed = entry
+		}
+	}
+	require.NotNil(t, failed)
+	assert.Equal(t, "core-id", failed.Data["group_id"])
+	assert.Equal(t, "org/target", failed.Data["target_repo"])
+	assert.Equal(t, "org/template", failed.Data["source_repo"])
+	assert.Equal(t, "abc123", failed.Data["commit_sha"])
+	assert.Equal(t, "clone", failed.Data["operation"], "failure is tagged with the failing stage")
+}
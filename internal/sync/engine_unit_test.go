@@ -10,6 +10,10 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/state"
 )
 
 // Static error variables for err113 linter compliance.
@@ -231,3 +235,54 @@ func TestEngine_RecordTargetStats(t *testing.T) {
 		assert.Equal(t, goroutines, run.TotalLinesRemoved)
 	})
 }
+
+// invalidatingDiscoverer records state cache invalidations
+type invalidatingDiscoverer struct {
+	state.Discoverer
+
+	invalidated []*config.Config
+}
+
+func (d *invalidatingDiscoverer) InvalidateState(cfg *config.Config) error {
+	d.invalidated = append(d.invalidated, cfg)
+	return nil
+}
+
+func TestEngine_invalidateStateCache(t *testing.T) {
+	cfg := &config.Config{Version: 1}
+	log := logrus.NewEntry(logrus.New())
+
+	t.Run("sync run invalidates", func(t *testing.T) {
+		discoverer := &invalidatingDiscoverer{}
+		engine := &Engine{config: cfg, state: discoverer, options: DefaultOptions()}
+
+		engine.invalidateStateCache(log)
+		require.Len(t, discoverer.invalidated, 1)
+		assert.Same(t, cfg, discoverer.invalidated[0])
+	})
+
+	t.Run("dry run keeps the cache", func(t *testing.T) {
+		discoverer := &invalidatingDiscoverer{}
+		engine := &Engine{config: cfg, state: discoverer, options: DefaultOptions().WithDryRun(true)}
+
+		engine.invalidateStateCache(log)
+		assert.Empty(t, discoverer.invalidated)
+	})
+
+	t.Run("non-caching discoverer is ignored", func(t *testing.T) {
+		engine := &Engine{config: cfg, state: state.NewMockDiscoverer(), options: DefaultOptions()}
+		assert.NotPanics(t, func() { engine.invalidateStateCache(log) })
+	})
+}
+
+func TestNewEngine_StateCache(t *testing.T) {
+	discoverer := state.NewMockDiscoverer()
+	ghClient := gh.NewMockClient()
+
+	plain := NewEngine(context.Background(), &config.Config{}, ghClient, nil, discoverer, nil, DefaultOptions())
+	assert.Same(t, discoverer, plain.state)
+
+	cached := NewEngine(context.Background(), &config.Config{}, ghClient, nil, discoverer, nil,
+		DefaultOptions().WithStateCache(t.TempDir(), time.Minute))
+	assert.Implements(t, (*state.CacheInvalidator)(nil), cached.state)
+}
@@ -136,9 +136,10 @@ func filterEstimateEnabledGroups(groups []config.Group) []config.Group {
 
 // matchesGroupPattern reports whether the group's name or ID matches any of the
 // given patterns (exact match), matching the orchestrator's filter comparison.
+// Groups expanded from a multi-branch source also match the configured ID.
 func matchesGroupPattern(group config.Group, patterns []string) bool {
 	for _, pattern := range patterns {
-		if group.Name == pattern || group.ID == pattern {
+		if group.Name == pattern || group.ID == pattern || group.ConfiguredID() == pattern {
 			return true
 		}
 	}
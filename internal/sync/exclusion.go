@@ -1,6 +1,7 @@
 package sync
 
 import (
+	"path"
 	"path/filepath"
 	"regexp"
 	"strings"
@@ -77,7 +78,22 @@ func NewExclusionEngineWithIncludes(excludePatterns, includePatterns []string) *
 	return engine
 }
 
-// IsExcluded checks if a file path should be excluded based on the configured patterns
+// mergeExcludes returns the global exclusion patterns followed by a directory
+// mapping's own. Patterns are evaluated in order, so a mapping pattern can only
+// add exclusions; a globally excluded file is synced again only when the
+// mapping explicitly negates it with "!pattern".
+func mergeExcludes(global, mapping []string) []string {
+	if len(global) == 0 {
+		return mapping
+	}
+	merged := make([]string, 0, len(global)+len(mapping))
+	merged = append(merged, global...)
+	return append(merged, mapping...)
+}
+
+// IsExcluded checks if a file path should be excluded based on the configured patterns.
+// Patterns are evaluated in order, so a later "!pattern" re-includes a path matched
+// by an earlier exclusion unless one of the path's parent directories is excluded.
 func (e *ExclusionEngine) IsExcluded(filePath string) bool {
 	// Normalize path separators
 	normalizedPath := filepath.ToSlash(filePath)
@@ -91,7 +107,7 @@ func (e *ExclusionEngine) IsExcluded(filePath string) bool {
 	}
 
 	// Evaluate patterns
-	excluded := e.evaluatePatterns(normalizedPath)
+	excluded := e.hasExcludedParent(normalizedPath) || e.evaluatePatterns(normalizedPath)
 
 	// Cache the result
 	e.cache.Store(normalizedPath, excluded)
@@ -126,6 +142,35 @@ func (e *ExclusionEngine) IsDirectoryExcluded(dirPath string) bool {
 	return excluded
 }
 
+// hasExcludedParent reports whether any parent directory of filePath is excluded.
+// As with gitignore, a negation pattern cannot re-include a file whose parent
+// directory is excluded; the directory walk never descends into it. Allowlist
+// (include) mode evaluates files on their own.
+func (e *ExclusionEngine) hasExcludedParent(filePath string) bool {
+	e.mu.RLock()
+	allowlist := len(e.includePatterns) > 0
+	e.mu.RUnlock()
+	if allowlist {
+		return false
+	}
+
+	for dir := parentDir(filePath); dir != ""; dir = parentDir(dir) {
+		if e.IsDirectoryExcluded(dir) {
+			return true
+		}
+	}
+	return false
+}
+
+// parentDir returns the slash-separated parent of p, or "" at the top level
+func parentDir(p string) string {
+	dir := path.Dir(strings.TrimSuffix(p, "/"))
+	if dir == "." || dir == "/" {
+		return ""
+	}
+	return dir
+}
+
 // ClearCache clears the pattern matching cache
 func (e *ExclusionEngine) ClearCache() {
 	e.cache.Range(func(key, _ interface{}) bool {
@@ -202,10 +247,14 @@ func (e *ExclusionEngine) compilePattern(pattern string) exclusionPattern {
 	// Escape regex special characters except * and ?
 	pattern = regexp.QuoteMeta(pattern)
 
-	// Convert gitignore wildcards to regex
-	pattern = strings.ReplaceAll(pattern, `\*\*`, `.*`)  // ** matches any number of directories
+	// Convert doublestar/gitignore wildcards to regex
+	pattern = strings.ReplaceAll(pattern, `/\*\*/`, `/(.*/)?`) // a/**/b matches zero or more directories
+	if strings.HasPrefix(pattern, `\*\*/`) {
+		pattern = `(.*/)?` + strings.TrimPrefix(pattern, `\*\*/`) // **/x matches x at any depth, including the root
+	}
+	pattern = strings.ReplaceAll(pattern, `\*\*`, `.*`)  // remaining ** (e.g. dir/**) matches anything below
 	pattern = strings.ReplaceAll(pattern, `\*`, `[^/]*`) // * matches any character except /
-	pattern = strings.ReplaceAll(pattern, `\?`, `.`)     // ? matches any single character
+	pattern = strings.ReplaceAll(pattern, `\?`, `[^/]`)  // ? matches any single character except /
 
 	// Handle different pattern types
 	var regexPattern string
@@ -332,17 +381,17 @@ func (e *ExclusionEngine) evaluateDirectoryPatterns(dirPath string) bool {
 	// Otherwise, use exclusion logic
 	excluded := false
 
+	// Match the directory itself, not its contents: "dir/**" excludes everything
+	// below dir but leaves dir walkable so later negations can re-include files
+	pathToCheck := strings.TrimSuffix(dirPath, "/")
+
 	// Process patterns in order
 	for _, pattern := range e.patterns {
 		if pattern.regex == nil {
 			continue
 		}
 
-		// Check both with and without trailing slash
-		pathToCheck := strings.TrimSuffix(dirPath, "/")
-		matches := pattern.regex.MatchString(pathToCheck) || pattern.regex.MatchString(dirPath)
-
-		if matches {
+		if pattern.regex.MatchString(pathToCheck) {
 			if pattern.negate {
 				excluded = false // Negation overrides previous exclusions
 			} else {
@@ -76,6 +76,128 @@ func TestExclusionEngineDirectories(t *testing.T) {
 	}
 }
 
+// TestExclusionEngineDoublestar tests recursive ** patterns
+func TestExclusionEngineDoublestar(t *testing.T) {
+	engine := NewExclusionEngine([]string{"**/*.log", "build/**/cache", "fixture?.json"})
+
+	testCases := []struct {
+		path     string
+		excluded bool
+	}{
+		{"app.log", true},               // **/ matches zero directories
+		{"logs/app.log", true},          // one directory
+		{"a/b/c/app.log", true},         // many directories
+		{"app.log.txt", false},          // suffix must match the whole name
+		{"build/cache", true},           // /**/ matches zero directories
+		{"build/x/y/cache", true},       // /**/ matches many directories
+		{"build/cachefile", false},      // segment must match exactly
+		{"fixture1.json", true},         // ? matches one character
+		{"fixture/.json", false},        // ? does not match a separator
+		{"data/fixtureA.json", true},    // basename pattern at any depth
+		{"data/fixtures/a.json", false}, // unrelated file
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			assert.Equal(t, tc.excluded, engine.IsExcluded(tc.path))
+		})
+	}
+}
+
+// TestExclusionEngineNegationPrecedence tests that patterns are evaluated in order
+// and that directory exclusions take precedence over file re-inclusion
+func TestExclusionEngineNegationPrecedence(t *testing.T) {
+	t.Run("later negation re-includes a file", func(t *testing.T) {
+		engine := NewExclusionEngine([]string{"**/*.log", "!logs/keep.log"})
+		assert.True(t, engine.IsExcluded("logs/drop.log"))
+		assert.False(t, engine.IsExcluded("logs/keep.log"))
+		assert.False(t, engine.IsDirectoryExcluded("logs"))
+	})
+
+	t.Run("later exclusion overrides an earlier negation", func(t *testing.T) {
+		engine := NewExclusionEngine([]string{"!logs/keep.log", "**/*.log"})
+		assert.True(t, engine.IsExcluded("logs/keep.log"))
+	})
+
+	t.Run("negation re-excluded by a more specific pattern", func(t *testing.T) {
+		engine := NewExclusionEngine([]string{"*.log", "!logs/*.log", "logs/debug-*.log"})
+		assert.False(t, engine.IsExcluded("logs/app.log"))
+		assert.True(t, engine.IsExcluded("logs/debug-1.log"))
+		assert.True(t, engine.IsExcluded("other/app.log"))
+	})
+
+	t.Run("dir/** keeps the directory walkable for negations", func(t *testing.T) {
+		engine := NewExclusionEngine([]string{"build/**", "!build/keep.txt"})
+		assert.False(t, engine.IsDirectoryExcluded("build"))
+		assert.True(t, engine.IsDirectoryExcluded("build/tmp"))
+		assert.True(t, engine.IsExcluded("build/out.bin"))
+		assert.False(t, engine.IsExcluded("build/keep.txt"))
+	})
+
+	t.Run("excluded directory cannot be re-entered by a file negation", func(t *testing.T) {
+		engine := NewExclusionEngine([]string{"build/", "!build/keep.txt"})
+		assert.True(t, engine.IsDirectoryExcluded("build"))
+		assert.True(t, engine.IsExcluded("build/keep.txt"))
+		assert.True(t, engine.IsExcluded("build/nested/file.txt"))
+	})
+
+	t.Run("directory-only pattern does not match files of the same name", func(t *testing.T) {
+		engine := NewExclusionEngine([]string{"dist/"})
+		assert.True(t, engine.IsDirectoryExcluded("dist"))
+		assert.True(t, engine.IsDirectoryExcluded("pkg/dist"))
+		assert.False(t, engine.IsExcluded("dist"))
+		assert.True(t, engine.IsExcluded("pkg/dist/bundle.js"))
+	})
+
+	t.Run("negated directory re-includes its contents", func(t *testing.T) {
+		engine := NewExclusionEngine([]string{"generated/", "!generated/"})
+		assert.False(t, engine.IsDirectoryExcluded("generated"))
+		assert.False(t, engine.IsExcluded("generated/types.go"))
+	})
+}
+
+// TestMergeExcludesPrecedence tests that global exclusions apply to every
+// directory mapping and only an explicit mapping negation re-includes a file
+func TestMergeExcludesPrecedence(t *testing.T) {
+	global := []string{"**/*.swp", "scratch/"}
+
+	t.Run("mapping patterns add to the global ones", func(t *testing.T) {
+		engine := NewExclusionEngine(mergeExcludes(global, []string{"*.log"}))
+		assert.True(t, engine.IsExcluded("docs/notes.swp"))
+		assert.True(t, engine.IsExcluded("app.log"))
+		assert.False(t, engine.IsExcluded("docs/readme.md"))
+	})
+
+	t.Run("mapping patterns cannot accidentally re-include", func(t *testing.T) {
+		engine := NewExclusionEngine(mergeExcludes(global, []string{"*.md", "**/*.swp"}))
+		assert.True(t, engine.IsExcluded("docs/notes.swp"))
+	})
+
+	t.Run("explicit mapping negation re-includes", func(t *testing.T) {
+		engine := NewExclusionEngine(mergeExcludes(global, []string{"!keep/*.swp"}))
+		assert.False(t, engine.IsExcluded("keep/session.swp"))
+		assert.True(t, engine.IsExcluded("other/session.swp"))
+	})
+
+	t.Run("globally excluded directory stays excluded", func(t *testing.T) {
+		engine := NewExclusionEngine(mergeExcludes(global, []string{"!scratch/keep.txt"}))
+		assert.True(t, engine.IsDirectoryExcluded("scratch"))
+		assert.True(t, engine.IsExcluded("scratch/keep.txt"))
+	})
+
+	t.Run("include_only cannot re-include", func(t *testing.T) {
+		engine := NewExclusionEngineWithIncludes(mergeExcludes(global, nil), []string{"**/*"})
+		assert.True(t, engine.IsExcluded("docs/notes.swp"))
+		assert.False(t, engine.IsExcluded("docs/readme.md"))
+	})
+
+	t.Run("no global patterns", func(t *testing.T) {
+		mapping := []string{"*.log"}
+		assert.Equal(t, mapping, mergeExcludes(nil, mapping))
+		assert.Equal(t, global, mergeExcludes(global, nil))
+	})
+}
+
 // TestExclusionEngineCache tests cache functionality
 func TestExclusionEngineCache(t *testing.T) {
 	patterns := []string{"*.log", "temp/**"}
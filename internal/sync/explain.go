@@ -0,0 +1,126 @@
+package sync
+
+import (
+	"fmt"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/state"
+)
+
+// Decisions reported by Options.Explain
+const (
+	DecisionSync = "sync" // The target is synced
+	DecisionSkip = "skip" // The target is left alone
+)
+
+// TargetDecision explains why a target was or was not synced
+type TargetDecision struct {
+	Group          string `json:"group,omitempty"`
+	Target         string `json:"target"`
+	Decision       string `json:"decision"` // DecisionSync or DecisionSkip
+	Reason         string `json:"reason"`
+	SourceCommit   string `json:"source_commit,omitempty"`
+	LastSyncCommit string `json:"last_sync_commit,omitempty"`
+}
+
+// explains reports whether sync decisions are recorded
+func (e *Engine) explains() bool {
+	return e.options != nil && e.options.Explain
+}
+
+// explainTarget records the decision for target in the current group. A later
+// decision for the same group and target replaces an earlier one, so the
+// per-repository check refines the decision made when filtering targets.
+func (e *Engine) explainTarget(target string, syncIt bool, reason string, targetState *state.TargetState, sourceCommit string) {
+	if !e.explains() {
+		return
+	}
+
+	decision := TargetDecision{
+		Target:       target,
+		Decision:     DecisionSkip,
+		Reason:       reason,
+		SourceCommit: sourceCommit,
+	}
+	if syncIt {
+		decision.Decision = DecisionSync
+	}
+	if targetState != nil {
+		decision.LastSyncCommit = targetState.LastSyncCommit
+	}
+	if group := e.GetCurrentGroup(); group != nil {
+		decision.Group = group.ID
+	}
+	e.appendDecision(decision)
+}
+
+// explainGroup records the same skip decision for every target of group
+func (e *Engine) explainGroup(group config.Group, reason string) {
+	if !e.explains() {
+		return
+	}
+	for _, target := range group.Targets {
+		e.appendDecision(TargetDecision{
+			Group:    group.ID,
+			Target:   target.Repo,
+			Decision: DecisionSkip,
+			Reason:   reason,
+		})
+	}
+}
+
+// appendDecision keeps decision in the run state shared by every group
+func (e *Engine) appendDecision(decision TargetDecision) {
+	run := e.shared()
+	run.explainMu.Lock()
+	defer run.explainMu.Unlock()
+	for i, existing := range run.decisions {
+		if existing.Group == decision.Group && existing.Target == decision.Target {
+			run.decisions[i] = decision
+			return
+		}
+	}
+	run.decisions = append(run.decisions, decision)
+}
+
+// Explanations returns the decision made for every target of the last Sync,
+// in the order they were first decided. It returns nil unless Options.Explain
+// is set, and an empty slice when it is set but no target was considered.
+func (e *Engine) Explanations() []TargetDecision {
+	if !e.explains() {
+		return nil
+	}
+	run := e.shared()
+	run.explainMu.Lock()
+	defer run.explainMu.Unlock()
+	return append([]TargetDecision{}, run.decisions...)
+}
+
+// syncDecision decides from the discovered state whether target needs a sync
+// and explains why
+func (e *Engine) syncDecision(target config.TargetConfig, currentState *state.State) (bool, string) {
+	targetState, exists := currentState.Targets[target.Repo]
+	if !exists {
+		return true, "no state was discovered for the target"
+	}
+
+	switch targetState.Status {
+	case state.StatusUpToDate:
+		return false, fmt.Sprintf("up to date: last sync commit %s matches the source", shortSHA(targetState.LastSyncCommit))
+	case state.StatusBehind:
+		if targetState.LastSyncCommit == "" {
+			return true, "never synced"
+		}
+		return true, fmt.Sprintf("behind: last synced %s, source is at %s",
+			shortSHA(targetState.LastSyncCommit), shortSHA(currentState.Source.LatestCommit))
+	case state.StatusPending:
+		if e.options.UpdateExistingPRs {
+			return true, "an open sync PR will be updated"
+		}
+		return false, "a sync PR is open and updating existing PRs is disabled"
+	case state.StatusConflict:
+		return false, "conflict: the target needs manual intervention"
+	default:
+		return true, fmt.Sprintf("state %q is unknown, syncing to be safe", targetState.Status)
+	}
+}
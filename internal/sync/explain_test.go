@@ -0,0 +1,108 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/state"
+)
+
+func TestEngineSyncDecision(t *testing.T) {
+	engine := &Engine{options: DefaultOptions(), logger: logrus.New()}
+	target := config.TargetConfig{Repo: "org/target"}
+	source := state.SourceState{LatestCommit: "0123456789abcdef"}
+
+	tests := []struct {
+		name        string
+		targetState *state.TargetState
+		wantSync    bool
+		wantReason  string
+	}{
+		{"no state", nil, true, "no state was discovered for the target"},
+		{"up to date", &state.TargetState{Status: state.StatusUpToDate, LastSyncCommit: "0123456789abcdef"}, false, "up to date: last sync commit 0123456 matches the source"},
+		{"never synced", &state.TargetState{Status: state.StatusBehind}, true, "never synced"},
+		{"behind", &state.TargetState{Status: state.StatusBehind, LastSyncCommit: "fedcba9876543210"}, true, "behind: last synced fedcba9, source is at 0123456"},
+		{"pending", &state.TargetState{Status: state.StatusPending}, true, "an open sync PR will be updated"},
+		{"conflict", &state.TargetState{Status: state.StatusConflict}, false, "conflict: the target needs manual intervention"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			currentState := &state.State{Source: source, Targets: map[string]*state.TargetState{}}
+			if tt.targetState != nil {
+				currentState.Targets[target.Repo] = tt.targetState
+			}
+
+			syncIt, reason := engine.syncDecision(target, currentState)
+			assert.Equal(t, tt.wantSync, syncIt)
+			assert.Equal(t, tt.wantReason, reason)
+			assert.Equal(t, syncIt, engine.needsSync(target, currentState), "needsSync follows the decision")
+		})
+	}
+}
+
+func TestEngineExplanations(t *testing.T) {
+	target := config.TargetConfig{Repo: "org/target"}
+	currentState := &state.State{
+		Source: state.SourceState{LatestCommit: "abc123"},
+		Targets: map[string]*state.TargetState{
+			"org/target": {Status: state.StatusBehind, LastSyncCommit: "def456"},
+		},
+	}
+
+	t.Run("nil when disabled", func(t *testing.T) {
+		engine := &Engine{options: DefaultOptions(), logger: logrus.New()}
+		engine.needsSync(target, currentState)
+		assert.Nil(t, engine.Explanations())
+	})
+
+	t.Run("later decision replaces earlier one", func(t *testing.T) {
+		engine := &Engine{options: DefaultOptions().WithExplain(true), logger: logrus.New()}
+		assert.NotNil(t, engine.Explanations(), "empty but not nil before any decision")
+
+		assert.True(t, engine.needsSync(target, currentState))
+		engine.explainTarget(target.Repo, false, "content unchanged", nil, "abc123")
+
+		assert.Equal(t, []TargetDecision{{
+			Target:       "org/target",
+			Decision:     DecisionSkip,
+			Reason:       "content unchanged",
+			SourceCommit: "abc123",
+		}}, engine.Explanations())
+	})
+
+	t.Run("group views record on the parent", func(t *testing.T) {
+		engine := &Engine{options: DefaultOptions().WithExplain(true), logger: logrus.New()}
+		view := &Engine{options: engine.options, logger: engine.logger, run: engine.shared()}
+
+		view.explainTarget(target.Repo, true, "never synced", nil, "abc123")
+		require.Len(t, engine.Explanations(), 1)
+		assert.Equal(t, engine.Explanations(), view.Explanations())
+	})
+}
+
+func TestGroupOrchestrator_Explain(t *testing.T) {
+	cfg := &config.Config{Version: 1}
+	engine := &Engine{config: cfg, options: DefaultOptions().WithExplain(true), logger: logrus.New()}
+	orch := NewGroupOrchestrator(cfg, engine, logrus.New())
+	executor := &testGroupExecutor{
+		errorsToReturn: map[string]error{"base": ErrGroupFailed},
+	}
+	orch.executeGroup = executor.executeGroup
+
+	groups := []config.Group{
+		{ID: "off", Enabled: boolPtr(false), Source: config.SourceConfig{Repo: "org/source"}, Targets: []config.TargetConfig{{Repo: "org/a"}}},
+		{ID: "base", Priority: 1, Enabled: boolPtr(true), Source: config.SourceConfig{Repo: "org/source"}, Targets: []config.TargetConfig{{Repo: "org/b"}}},
+		{ID: "child", Priority: 2, DependsOn: []string{"base"}, Enabled: boolPtr(true), Source: config.SourceConfig{Repo: "org/source"}, Targets: []config.TargetConfig{{Repo: "org/c"}}},
+	}
+
+	require.Error(t, orch.ExecuteGroups(context.Background(), groups))
+	assert.Equal(t, []TargetDecision{
+		{Group: "off", Target: "org/a", Decision: DecisionSkip, Reason: "group is disabled"},
+		{Group: "child", Target: "org/c", Decision: DecisionSkip, Reason: "dependency did not succeed: base (failed)"},
+	}, engine.Explanations())
+}
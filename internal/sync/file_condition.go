@@ -0,0 +1,85 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// MatchingFileMappings returns the file mappings of target whose when
+// condition the target matches, in their configured order. The target's
+// topics are looked up with topicsOf once, and only when a condition tests
+// for a topic.
+func MatchingFileMappings(ctx context.Context, topicsOf func(ctx context.Context, repo string) ([]string, error), target config.TargetConfig) ([]config.FileMapping, error) {
+	var topics []string
+	for _, fileMapping := range target.Files {
+		if !fileMapping.When.NeedsTopics() {
+			continue
+		}
+		var err error
+		if topics, err = topicsOf(ctx, target.Repo); err != nil {
+			return nil, fmt.Errorf("failed to get topics of %s: %w", target.Repo, err)
+		}
+		break
+	}
+
+	files := make([]config.FileMapping, 0, len(target.Files))
+	for _, fileMapping := range target.Files {
+		if fileMapping.When.Matches(target.Repo, topics) {
+			files = append(files, fileMapping)
+		}
+	}
+	return files, nil
+}
+
+// applyFileConditions drops the file mappings whose when condition this
+// target does not match, so every later step only sees the files it syncs
+func (rs *RepositorySync) applyFileConditions(ctx context.Context) error {
+	files, err := MatchingFileMappings(ctx, rs.engine.repoTopics, rs.target)
+	if err != nil {
+		return err
+	}
+	if skipped := len(rs.target.Files) - len(files); skipped > 0 {
+		rs.logger.WithField("skipped_files", skipped).Info("Skipping file mappings whose conditions this target does not match")
+		rs.target.Files = files
+	}
+	return nil
+}
+
+// conditionalBlockTopics returns the topics the conditional blocks of target
+// are matched against. Targets that resolve no conditional blocks get nil.
+func (e *Engine) conditionalBlockTopics(ctx context.Context, target config.TargetConfig) ([]string, error) {
+	if !usesConditionalBlocks(target) {
+		return nil, nil
+	}
+	topics, err := e.repoTopics(ctx, target.Repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get topics of %s: %w", target.Repo, err)
+	}
+	return topics, nil
+}
+
+// repoTopics returns the topics of repo, fetched once per run and shared by
+// file conditions and conditional blocks
+func (e *Engine) repoTopics(ctx context.Context, repo string) ([]string, error) {
+	run := e.shared()
+	run.targetTopicsMu.Lock()
+	topics, ok := run.targetTopics[repo]
+	run.targetTopicsMu.Unlock()
+	if ok {
+		return topics, nil
+	}
+
+	topics, err := e.gh.GetRepoTopics(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	run.targetTopicsMu.Lock()
+	defer run.targetTopicsMu.Unlock()
+	if run.targetTopics == nil {
+		run.targetTopics = make(map[string][]string)
+	}
+	run.targetTopics[repo] = topics
+	return topics, nil
+}
@@ -0,0 +1,130 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+var errTopicsUnavailable = errors.New("topics unavailable")
+
+func TestMatchingFileMappings(t *testing.T) {
+	ctx := context.Background()
+	target := config.TargetConfig{
+		Repo: "org/billing-service",
+		Files: []config.FileMapping{
+			{Src: "README.md", Dest: "README.md"},
+			{Src: "service.mk", Dest: "Makefile", When: &config.FileCondition{RepoMatches: "-service$"}},
+			{Src: "lib.mk", Dest: "lib.mk", When: &config.FileCondition{RepoMatches: "^org/lib-"}},
+			{Src: ".golangci.yml", Dest: ".golangci.yml", When: &config.FileCondition{HasTopic: "go"}},
+			{Src: "package.json", Dest: "package.json", When: &config.FileCondition{HasTopic: "node"}},
+		},
+	}
+
+	t.Run("topics fetched once when a condition needs them", func(t *testing.T) {
+		client := &gh.MockClient{}
+		client.On("GetRepoTopics", ctx, "org/billing-service").Return([]string{"Go", "payments"}, nil).Once()
+
+		files, err := MatchingFileMappings(ctx, client.GetRepoTopics, target)
+		require.NoError(t, err)
+		assert.Equal(t, []config.FileMapping{target.Files[0], target.Files[1], target.Files[3]}, files)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("topics not fetched without topic conditions", func(t *testing.T) {
+		client := &gh.MockClient{}
+		noTopics := target
+		noTopics.Files = target.Files[:3]
+
+		files, err := MatchingFileMappings(ctx, client.GetRepoTopics, noTopics)
+		require.NoError(t, err)
+		assert.Equal(t, []config.FileMapping{target.Files[0], target.Files[1]}, files)
+		client.AssertNotCalled(t, "GetRepoTopics")
+	})
+
+	t.Run("topic lookup failure is returned", func(t *testing.T) {
+		client := &gh.MockClient{}
+		client.On("GetRepoTopics", ctx, "org/billing-service").Return(nil, errTopicsUnavailable)
+
+		_, err := MatchingFileMappings(ctx, client.GetRepoTopics, target)
+		require.ErrorIs(t, err, errTopicsUnavailable)
+	})
+}
+
+func TestRepositorySync_applyFileConditions(t *testing.T) {
+	files := []config.FileMapping{
+		{Src: "README.md", Dest: "README.md"},
+		{Src: "service.mk", Dest: "Makefile", When: &config.FileCondition{RepoMatches: "-service$"}},
+	}
+	rs := newTestRepoSync(&gh.MockClient{}, nil, config.TargetConfig{Repo: "org/web", Files: files}, nil)
+
+	require.NoError(t, rs.applyFileConditions(context.Background()))
+	assert.Equal(t, files[:1], rs.target.Files)
+	assert.Len(t, files, 2, "the configured mappings are not modified")
+}
+
+func TestRepositorySync_TopicsFetchedOnce(t *testing.T) {
+	ctx := context.Background()
+	client := &gh.MockClient{}
+	client.On("GetRepoTopics", ctx, "org/billing-service").Return([]string{"go"}, nil).Once()
+	target := config.TargetConfig{
+		Repo: "org/billing-service",
+		Files: []config.FileMapping{
+			{Src: "README.md", Dest: "README.md"},
+			{Src: ".golangci.yml", Dest: ".golangci.yml", When: &config.FileCondition{HasTopic: "go"}},
+		},
+		Transform: config.Transform{ConditionalBlocks: true},
+	}
+	rs := newTestRepoSync(client, nil, target, nil)
+
+	// File conditions and conditional blocks share one topics lookup
+	require.NoError(t, rs.applyFileConditions(ctx))
+	topics, err := rs.engine.conditionalBlockTopics(ctx, rs.target)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"go"}, topics)
+	assert.Len(t, rs.target.Files, 2)
+	client.AssertExpectations(t)
+}
+
+func TestEngine_conditionalBlockTopics(t *testing.T) {
+	ctx := context.Background()
+	target := config.TargetConfig{
+		Repo:        "org/billing-service",
+		Directories: []config.DirectoryMapping{{Src: "docs", Dest: "docs", Transform: config.Transform{ConditionalBlocks: true}}},
+	}
+
+	t.Run("fetched once per run and shared with group views", func(t *testing.T) {
+		client := &gh.MockClient{}
+		client.On("GetRepoTopics", ctx, "org/billing-service").Return([]string{"go"}, nil).Once()
+		root := &Engine{gh: client}
+		view := &Engine{gh: client, run: root.shared()}
+
+		for _, engine := range []*Engine{root, view, root} {
+			topics, err := engine.conditionalBlockTopics(ctx, target)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"go"}, topics)
+		}
+		client.AssertExpectations(t)
+	})
+
+	t.Run("not fetched without conditional blocks", func(t *testing.T) {
+		client := &gh.MockClient{}
+		topics, err := (&Engine{gh: client}).conditionalBlockTopics(ctx, config.TargetConfig{Repo: "org/billing-service"})
+		require.NoError(t, err)
+		assert.Nil(t, topics)
+		client.AssertNotCalled(t, "GetRepoTopics", ctx, "org/billing-service")
+	})
+
+	t.Run("fetch failure", func(t *testing.T) {
+		client := &gh.MockClient{}
+		client.On("GetRepoTopics", ctx, "org/billing-service").Return(nil, errTopicsUnavailable)
+		_, err := (&Engine{gh: client}).conditionalBlockTopics(ctx, target)
+		require.ErrorIs(t, err, errTopicsUnavailable)
+	})
+}
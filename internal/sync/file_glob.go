@@ -0,0 +1,105 @@
+package sync
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// hasFileGlobs reports whether any file mapping has a glob src, meaning the
+// mapped file set is only known after cloning the source
+func hasFileGlobs(files []config.FileMapping) bool {
+	return slices.ContainsFunc(files, config.FileMapping.IsGlob)
+}
+
+// expandFileGlobs replaces each glob file mapping with one mapping per
+// matching file in the cloned source, its dest rendered for that file.
+// Explicit mappings win over glob matches for the same dest, and a glob that
+// matches nothing is skipped with a warning.
+func (rs *RepositorySync) expandFileGlobs() error {
+	if !hasFileGlobs(rs.target.Files) {
+		return nil
+	}
+
+	explicit := make(map[string]bool, len(rs.target.Files))
+	for _, fileMapping := range rs.target.Files {
+		if !fileMapping.IsGlob() {
+			explicit[fileMapping.Dest] = true
+		}
+	}
+
+	root := rs.mappingSourcePath()
+	files := make([]config.FileMapping, 0, len(rs.target.Files))
+	matchedBy := make(map[string]string)
+	for _, fileMapping := range rs.target.Files {
+		if !fileMapping.IsGlob() {
+			files = append(files, fileMapping)
+			continue
+		}
+
+		matches, err := sourceGlobMatches(root, fileMapping.Src)
+		if err != nil {
+			return fmt.Errorf("file %s: %w", fileMapping.Src, err)
+		}
+		if len(matches) == 0 {
+			rs.logger.WithField("file", fileMapping.Src).Warn("Glob matched no source files, skipping")
+			continue
+		}
+
+		for _, match := range matches {
+			dest, err := config.RenderDestPath(fileMapping.Dest, config.NewDestPathData(rs.sourceState.Repo, rs.target.Repo, match))
+			if err != nil {
+				return fmt.Errorf("file %s: %w", match, err)
+			}
+			if explicit[dest] {
+				rs.logger.WithField("file", match).WithField("dest", dest).Debug("Glob match overridden by an explicit mapping, skipping")
+				continue
+			}
+			if other, exists := matchedBy[dest]; exists {
+				return fmt.Errorf("%w: %s and %s both map to %q", config.ErrDuplicateDestPath, other, match, dest)
+			}
+			matchedBy[dest] = match
+
+			expanded := fileMapping
+			expanded.Src = match
+			expanded.Dest = dest
+			files = append(files, expanded)
+		}
+		rs.logger.WithField("file", fileMapping.Src).WithField("matches", len(matches)).Debug("Expanded glob file mapping")
+	}
+
+	rs.target.Files = files
+	return nil
+}
+
+// sourceGlobMatches returns the slash-separated paths, relative to root, of
+// the files matching pattern. Directories and anything under .git are left out.
+func sourceGlobMatches(root, pattern string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(root, filepath.FromSlash(pattern)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %w", config.ErrInvalidFileGlob, pattern, err)
+	}
+
+	matches := make([]string, 0, len(paths))
+	for _, match := range paths {
+		info, statErr := os.Stat(match)
+		if statErr != nil || info.IsDir() {
+			continue
+		}
+		rel, relErr := filepath.Rel(root, match)
+		if relErr != nil {
+			continue
+		}
+		rel = filepath.ToSlash(rel)
+		if rel == ".git" || strings.HasPrefix(rel, ".git/") || strings.Contains(rel, "/.git/") {
+			continue
+		}
+		matches = append(matches, rel)
+	}
+	slices.Sort(matches)
+	return matches, nil
+}
@@ -0,0 +1,90 @@
+package sync
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+func TestRepositorySync_expandFileGlobs(t *testing.T) {
+	newRepoSync := func(t *testing.T, files ...config.FileMapping) *RepositorySync {
+		t.Helper()
+		rs := newTestRepoSync(&gh.MockClient{}, nil, config.TargetConfig{Repo: "org/service", Files: files}, nil)
+		rs.tempDir = t.TempDir()
+		for _, name := range []string{"configs/app.yaml", "configs/db.yaml", "configs/notes.txt", "configs/nested/deep.yaml", ".git/config"} {
+			sourceFile := filepath.Join(rs.tempDir, "source", filepath.FromSlash(name))
+			require.NoError(t, os.MkdirAll(filepath.Dir(sourceFile), 0o750))
+			require.NoError(t, os.WriteFile(sourceFile, []byte(name), 0o600))
+		}
+		return rs
+	}
+
+	t.Run("each match gets its own rendered dest", func(t *testing.T) {
+		rs := newRepoSync(t,
+			config.FileMapping{Src: "README.md", Dest: "README.md"},
+			config.FileMapping{Src: "configs/*.yaml", Dest: "{{.RepoName}}/{{.SourceBase}}", MarkerStart: "# start", MarkerEnd: "# end"},
+		)
+		require.NoError(t, rs.expandFileGlobs())
+		assert.Equal(t, []config.FileMapping{
+			{Src: "README.md", Dest: "README.md"},
+			{Src: "configs/app.yaml", Dest: "service/app.yaml", MarkerStart: "# start", MarkerEnd: "# end"},
+			{Src: "configs/db.yaml", Dest: "service/db.yaml", MarkerStart: "# start", MarkerEnd: "# end"},
+		}, rs.target.Files)
+	})
+
+	t.Run("no match is skipped with a warning", func(t *testing.T) {
+		rs := newRepoSync(t, config.FileMapping{Src: "configs/*.json", Dest: "{{.SourceBase}}"})
+		var logs bytes.Buffer
+		logger := logrus.New()
+		logger.SetOutput(&logs)
+		rs.logger = logrus.NewEntry(logger)
+
+		require.NoError(t, rs.expandFileGlobs())
+		assert.Empty(t, rs.target.Files)
+		assert.Contains(t, logs.String(), "Glob matched no source files")
+	})
+
+	t.Run("explicit mapping wins over a glob match", func(t *testing.T) {
+		rs := newRepoSync(t,
+			config.FileMapping{Src: "configs/*.yaml", Dest: "{{.SourceBase}}"},
+			config.FileMapping{Src: "overrides/app.yaml", Dest: "app.yaml"},
+		)
+		require.NoError(t, rs.expandFileGlobs())
+		assert.Equal(t, []config.FileMapping{
+			{Src: "configs/db.yaml", Dest: "db.yaml"},
+			{Src: "overrides/app.yaml", Dest: "app.yaml"},
+		}, rs.target.Files)
+	})
+
+	t.Run("matches mapping to one dest", func(t *testing.T) {
+		rs := newRepoSync(t,
+			config.FileMapping{Src: "configs/*.yaml", Dest: "{{.SourceBase}}"},
+			config.FileMapping{Src: "configs/a*.yaml", Dest: "{{.SourceBase}}"},
+		)
+		require.ErrorIs(t, rs.expandFileGlobs(), config.ErrDuplicateDestPath)
+	})
+
+	t.Run("git metadata is never matched", func(t *testing.T) {
+		rs := newRepoSync(t, config.FileMapping{Src: "*/config", Dest: "{{.SourcePath}}"})
+		require.NoError(t, rs.expandFileGlobs())
+		assert.Empty(t, rs.target.Files)
+	})
+}
+
+func TestRenderDestPaths_SkipsGlobs(t *testing.T) {
+	target := config.TargetConfig{
+		Repo:  "org/service",
+		Files: []config.FileMapping{{Src: "configs/*.yaml", Dest: "{{.RepoName}}/{{.SourceBase}}"}},
+	}
+	rendered, err := RenderDestPaths("org/template", target)
+	require.NoError(t, err)
+	assert.Equal(t, "{{.RepoName}}/{{.SourceBase}}", rendered.Files[0].Dest)
+}
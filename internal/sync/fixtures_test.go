@@ -0,0 +1,34 @@
+package sync
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/state"
+	"github.com/mrz1836/go-broadcast/internal/transform"
+)
+
+// newTestRepoSync builds a RepositorySync for target wired to the given mocks,
+// syncing from org/template at master@abc123
+func newTestRepoSync(ghClient *gh.MockClient, chain transform.Chain, target config.TargetConfig, opts *Options) *RepositorySync {
+	if opts == nil {
+		opts = DefaultOptions()
+	}
+	return &RepositorySync{
+		engine: &Engine{
+			config:    &config.Config{},
+			gh:        ghClient,
+			transform: chain,
+			options:   opts,
+			logger:    logrus.New(),
+		},
+		target: target,
+		sourceState: &state.SourceState{
+			Repo:         "org/template",
+			Branch:       "master",
+			LatestCommit: "abc123",
+		},
+		logger: logrus.NewEntry(logrus.New()),
+	}
+}
@@ -0,0 +1,42 @@
+package sync
+
+import (
+	"fmt"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// Web hosts of the supported forges
+const (
+	githubWebURL    = "https://github.com"
+	bitbucketWebURL = "https://bitbucket.org"
+)
+
+// provider returns the configured forge provider, GitHub when unset
+func (e *Engine) provider() string {
+	if e == nil || e.config == nil || e.config.Provider == "" {
+		return config.ProviderGitHub
+	}
+	return e.config.Provider
+}
+
+// repoWebURL returns the browser URL of repo on the configured forge
+func (e *Engine) repoWebURL(repo string) string {
+	if e.provider() == config.ProviderBitbucket {
+		return fmt.Sprintf("%s/%s", bitbucketWebURL, repo)
+	}
+	return fmt.Sprintf("%s/%s", githubWebURL, repo)
+}
+
+// repoCloneURL returns the HTTPS clone URL of repo on the configured forge
+func (e *Engine) repoCloneURL(repo string) string {
+	return e.repoWebURL(repo) + ".git"
+}
+
+// pullRequestURL returns the browser URL of a pull request on the configured forge
+func (e *Engine) pullRequestURL(repo string, number int) string {
+	if e.provider() == config.ProviderBitbucket {
+		return fmt.Sprintf("%s/pull-requests/%d", e.repoWebURL(repo), number)
+	}
+	return fmt.Sprintf("%s/pull/%d", e.repoWebURL(repo), number)
+}
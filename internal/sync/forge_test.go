@@ -0,0 +1,24 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+func TestEngine_ForgeURLs(t *testing.T) {
+	t.Parallel()
+
+	var nilEngine *Engine
+	assert.Equal(t, "https://github.com/org/repo.git", nilEngine.repoCloneURL("org/repo"))
+
+	github := &Engine{config: &config.Config{}}
+	assert.Equal(t, "https://github.com/org/repo", github.repoWebURL("org/repo"))
+	assert.Equal(t, "https://github.com/org/repo/pull/42", github.pullRequestURL("org/repo", 42))
+
+	bitbucket := &Engine{config: &config.Config{Provider: config.ProviderBitbucket}}
+	assert.Equal(t, "https://bitbucket.org/ws/repo.git", bitbucket.repoCloneURL("ws/repo"))
+	assert.Equal(t, "https://bitbucket.org/ws/repo/pull-requests/42", bitbucket.pullRequestURL("ws/repo", 42))
+}
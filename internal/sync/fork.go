@@ -0,0 +1,91 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+// forkRemote names the remote the sync branch is pushed to when the target
+// has a fork
+const forkRemote = "fork"
+
+var (
+	// ErrForkNotRelated indicates a target's fork is not a fork of the target repository
+	ErrForkNotRelated = errors.New("fork is not a fork of the target repository")
+
+	// ErrForkUnsupportedProvider indicates forks were configured for a forge
+	// without cross-repository pull request support
+	ErrForkUnsupportedProvider = errors.New("target forks are only supported on GitHub")
+)
+
+// branchRepo returns the repository holding the sync branch: the target's
+// fork when configured, otherwise the target itself
+func (rs *RepositorySync) branchRepo() string {
+	if rs.target.Fork != "" {
+		return rs.target.Fork
+	}
+	return rs.target.Repo
+}
+
+// prHeadRef returns the pull request head for branchName. Branches pushed to
+// a fork are qualified with the fork owner ("owner:branch") so the pull
+// request is opened across repositories.
+func (rs *RepositorySync) prHeadRef(branchName string) string {
+	if rs.target.Fork == "" {
+		return branchName
+	}
+	owner, _, _ := strings.Cut(rs.target.Fork, "/")
+	return owner + ":" + branchName
+}
+
+// verifyFork confirms that the target's fork, if any, belongs to the target
+// repository's fork network, so the branch is never pushed to an unrelated
+// repository
+func (rs *RepositorySync) verifyFork(ctx context.Context) error {
+	if rs.target.Fork == "" {
+		return nil
+	}
+	if rs.engine.provider() != config.ProviderGitHub {
+		return fmt.Errorf("%w: %s", ErrForkUnsupportedProvider, rs.target.Repo)
+	}
+
+	rs.TrackAPIRequest()
+	fork, err := rs.engine.gh.GetRepository(ctx, rs.target.Fork)
+	if err != nil {
+		return fmt.Errorf("failed to get fork %s: %w", rs.target.Fork, err)
+	}
+	if !fork.Fork || !forkOf(fork, rs.target.Repo) {
+		return fmt.Errorf("%w: %s is not a fork of %s", ErrForkNotRelated, rs.target.Fork, rs.target.Repo)
+	}
+
+	rs.logger.WithField("fork", rs.target.Fork).Debug("Verified target fork")
+	return nil
+}
+
+// forkOf reports whether fork was forked from repo, directly or as the root
+// of its fork network
+func forkOf(fork *gh.Repository, repo string) bool {
+	for _, related := range []*gh.RepositoryRef{fork.Parent, fork.Source} {
+		if related != nil && strings.EqualFold(related.FullName, repo) {
+			return true
+		}
+	}
+	return false
+}
+
+// pushRemote returns the remote of the target clone at targetPath that the
+// sync branch is pushed to, adding the fork remote when the target has one
+func (rs *RepositorySync) pushRemote(ctx context.Context, targetPath string) (string, error) {
+	if rs.target.Fork == "" {
+		return "origin", nil
+	}
+	if err := rs.engine.git.AddRemote(ctx, targetPath, forkRemote, rs.engine.repoCloneURL(rs.target.Fork)); err != nil {
+		return "", fmt.Errorf("failed to add fork remote %s: %w", rs.target.Fork, err)
+	}
+	return forkRemote, nil
+}
@@ -0,0 +1,101 @@
+package sync
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/git"
+)
+
+func TestRepositorySync_ForkRefs(t *testing.T) {
+	rs := &RepositorySync{target: config.TargetConfig{Repo: "org/upstream"}}
+	assert.Equal(t, "org/upstream", rs.branchRepo())
+	assert.Equal(t, "chore/sync-files", rs.prHeadRef("chore/sync-files"))
+
+	rs.target.Fork = "myuser/fork"
+	assert.Equal(t, "myuser/fork", rs.branchRepo())
+	assert.Equal(t, "myuser:chore/sync-files", rs.prHeadRef("chore/sync-files"))
+}
+
+func TestRepositorySync_verifyFork(t *testing.T) {
+	ctx := context.Background()
+	target := config.TargetConfig{Repo: "org/upstream", Fork: "myuser/fork"}
+
+	testCases := []struct {
+		name     string
+		fork     *gh.Repository
+		expected error
+	}{
+		{
+			name: "direct fork",
+			fork: &gh.Repository{FullName: "myuser/fork", Fork: true, Parent: &gh.RepositoryRef{FullName: "org/upstream"}},
+		},
+		{
+			name: "fork of a fork in the same network",
+			fork: &gh.Repository{
+				FullName: "myuser/fork", Fork: true,
+				Parent: &gh.RepositoryRef{FullName: "other/fork"},
+				Source: &gh.RepositoryRef{FullName: "Org/Upstream"},
+			},
+		},
+		{
+			name:     "fork of another repository",
+			fork:     &gh.Repository{FullName: "myuser/fork", Fork: true, Parent: &gh.RepositoryRef{FullName: "org/other"}},
+			expected: ErrForkNotRelated,
+		},
+		{
+			name:     "not a fork",
+			fork:     &gh.Repository{FullName: "myuser/fork"},
+			expected: ErrForkNotRelated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ghClient := &gh.MockClient{}
+			ghClient.On("GetRepository", mock.Anything, "myuser/fork").Return(tc.fork, nil).Once()
+			rs := newTestRepoSync(ghClient, nil, target, nil)
+
+			err := rs.verifyFork(ctx)
+			if tc.expected == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expected)
+			}
+			ghClient.AssertExpectations(t)
+		})
+	}
+
+	t.Run("no fork makes no API call", func(t *testing.T) {
+		rs := newTestRepoSync(&gh.MockClient{}, nil, config.TargetConfig{Repo: "org/upstream"}, nil)
+		require.NoError(t, rs.verifyFork(ctx))
+	})
+
+	t.Run("unsupported provider", func(t *testing.T) {
+		rs := newTestRepoSync(&gh.MockClient{}, nil, target, nil)
+		rs.engine.config.Provider = config.ProviderBitbucket
+		require.ErrorIs(t, rs.verifyFork(ctx), ErrForkUnsupportedProvider)
+	})
+}
+
+func TestRepositorySync_pushChanges_Fork(t *testing.T) {
+	ctx := context.Background()
+	gitClient := &git.MockClient{}
+	rs := newTestRepoSync(&gh.MockClient{}, nil, config.TargetConfig{Repo: "org/upstream", Fork: "myuser/fork"}, nil)
+	rs.engine.git = gitClient
+	rs.tempDir = t.TempDir()
+	targetPath := filepath.Join(rs.tempDir, "target")
+
+	gitClient.On("AddRemote", mock.Anything, targetPath, forkRemote, "https://github.com/myuser/fork.git").Return(nil).Once()
+	gitClient.On("Push", mock.Anything, targetPath, forkRemote, "chore/sync-files", false).Return(nil).Once()
+
+	require.NoError(t, rs.pushChanges(ctx, "chore/sync-files"))
+	gitClient.AssertExpectations(t)
+}
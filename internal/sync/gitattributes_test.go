@@ -0,0 +1,101 @@
+package sync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	internalerrors "github.com/mrz1836/go-broadcast/internal/errors"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/state"
+	"github.com/mrz1836/go-broadcast/internal/transform"
+)
+
+// writeGitAttributesSource creates a source checkout holding a .gitattributes
+// that declares *.strings text and *.cfg binary, plus one file of each
+func writeGitAttributesSource(t *testing.T, encoded []byte) string {
+	t.Helper()
+	sourceDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, transform.GitAttributesFile), []byte("*.strings text\n*.cfg binary\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "app.strings"), encoded, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "app.cfg"), []byte("name=org/template"), 0o600))
+	return sourceDir
+}
+
+func TestBatchProcessor_GitAttributesOverrideBinaryDetection(t *testing.T) {
+	encoded := []byte{0xff, 0xfe, 'o', 0x00, 'r', 0x00, 'g'}
+	sourceDir := writeGitAttributesSource(t, encoded)
+	jobs := []FileJob{
+		NewFileJob("app.strings", "app.strings", config.Transform{RepoName: true}),
+		NewFileJob("app.cfg", "app.cfg", config.Transform{RepoName: true}),
+	}
+
+	run := func(t *testing.T, group *config.Group) (*transform.MockChain, map[string][]byte) {
+		t.Helper()
+		mockGH := &gh.MockClient{}
+		mockGH.On("GetFile", mock.Anything, "target/repo", mock.Anything, "").Return(nil, internalerrors.ErrFileNotFound)
+		mockTransform := &transform.MockChain{}
+		mockTransform.On("Transform", mock.Anything, mock.Anything, mock.Anything).Return([]byte("transformed"), nil)
+
+		engine := &Engine{gh: mockGH, transform: mockTransform, currentGroup: group}
+		processor := NewBatchProcessor(engine, config.TargetConfig{Repo: "target/repo"},
+			&state.SourceState{Repo: "org/template", LatestCommit: "abc123"}, logrus.NewEntry(logrus.New()), 1)
+
+		changes, err := processor.ProcessFiles(context.Background(), sourceDir, jobs)
+		require.NoError(t, err)
+		content := make(map[string][]byte, len(changes))
+		for _, change := range changes {
+			content[change.Path] = change.Content
+		}
+		return mockTransform, content
+	}
+
+	t.Run("declarations win over content detection", func(t *testing.T) {
+		mockTransform, content := run(t, nil)
+		assert.Equal(t, []byte("transformed"), content["app.strings"])
+		assert.Equal(t, []byte("name=org/template"), content["app.cfg"])
+		mockTransform.AssertNumberOfCalls(t, "Transform", 1)
+	})
+
+	t.Run("ignore_gitattributes falls back to content detection", func(t *testing.T) {
+		group := &config.Group{Source: config.SourceConfig{IgnoreGitAttributes: true}}
+		mockTransform, content := run(t, group)
+		assert.Equal(t, encoded, content["app.strings"])
+		assert.Equal(t, []byte("transformed"), content["app.cfg"])
+		mockTransform.AssertNumberOfCalls(t, "Transform", 1)
+	})
+}
+
+func TestRepositorySync_ProcessFileHonorsGitAttributes(t *testing.T) {
+	encoded := []byte{0xff, 0xfe, 'o', 0x00, 'r', 0x00, 'g'}
+
+	ghClient := &gh.MockClient{}
+	ghClient.On("GetFile", mock.Anything, "org/target", mock.Anything, "").Return(nil, gh.ErrFileNotFound)
+	chain := &transform.MockChain{}
+	chain.On("Transform", mock.Anything, encoded, mock.Anything).Return([]byte("transformed"), nil).Once()
+
+	target := config.TargetConfig{
+		Repo:      "org/target",
+		Files:     []config.FileMapping{{Src: "app.strings", Dest: "app.strings"}, {Src: "app.cfg", Dest: "app.cfg"}},
+		Transform: config.Transform{RepoName: true},
+	}
+	rs := newTestRepoSync(ghClient, chain, target, nil)
+	rs.tempDir = t.TempDir()
+	sourceDir := filepath.Join(rs.tempDir, "source")
+	require.NoError(t, os.Rename(writeGitAttributesSource(t, encoded), sourceDir))
+	rs.gitAttributes = loadSourceGitAttributes(rs.engine, sourceDir, rs.logger)
+
+	changes, err := rs.processFiles(context.Background())
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, []byte("transformed"), changes[0].Content)
+	assert.Equal(t, []byte("name=org/template"), changes[1].Content)
+	chain.AssertExpectations(t)
+}
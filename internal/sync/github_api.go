@@ -310,7 +310,8 @@ func (api *GitHubAPI) InvalidateCache(repo, ref string) {
 
 // GetCacheStats returns cache statistics
 func (api *GitHubAPI) GetCacheStats() (hits, misses int64, size int, hitRate float64) {
-	return api.cache.Stats()
+	stats := api.cache.Stats()
+	return stats.Hits, stats.Misses, stats.Size, stats.HitRate()
 }
 
 // GetAPIStats returns API call statistics
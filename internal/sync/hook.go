@@ -0,0 +1,162 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// ErrPostSyncHookFailed indicates a post-sync hook exited with an error or
+// timed out under the fail policy
+var ErrPostSyncHookFailed = errors.New("post-sync hook failed")
+
+// hookOutputLimit caps how much hook output is kept for logging
+const hookOutputLimit = 4096
+
+// hookWaitDelay bounds how long a killed hook's child processes may hold its
+// output open before the hook is abandoned
+const hookWaitDelay = 5 * time.Second
+
+// postSyncHook is the hook resolved for one target
+type postSyncHook struct {
+	command string
+	policy  string
+	timeout time.Duration
+}
+
+// groupDefaults returns the defaults of the current group, or of the first
+// configured group when no group is set
+func (rs *RepositorySync) groupDefaults() config.DefaultConfig {
+	if rs.engine == nil {
+		return config.DefaultConfig{}
+	}
+	if currentGroup := rs.engine.GetCurrentGroup(); currentGroup != nil {
+		return currentGroup.Defaults
+	}
+	if rs.engine.config != nil && len(rs.engine.config.Groups) > 0 {
+		return rs.engine.config.Groups[0].Defaults
+	}
+	return config.DefaultConfig{}
+}
+
+// getPostSyncHook resolves the post-sync hook for this target: the target's
+// command and policy override the group defaults, the timeout comes from the
+// group defaults or config.DefaultHookTimeoutSeconds
+func (rs *RepositorySync) getPostSyncHook() postSyncHook {
+	defaults := rs.groupDefaults()
+	hook := postSyncHook{
+		command: defaults.PostSyncHook,
+		policy:  defaults.HookFailurePolicy,
+		timeout: time.Duration(config.DefaultHookTimeoutSeconds) * time.Second,
+	}
+	if rs.target.PostSyncHook != "" {
+		hook.command = rs.target.PostSyncHook
+	}
+	if rs.target.HookFailurePolicy != "" {
+		hook.policy = rs.target.HookFailurePolicy
+	}
+	if hook.policy == "" {
+		hook.policy = config.HookFailurePolicyWarn
+	}
+	if defaults.HookTimeoutSeconds > 0 {
+		hook.timeout = time.Duration(defaults.HookTimeoutSeconds) * time.Second
+	}
+	return hook
+}
+
+// hookEnv returns the environment of a post-sync hook: the process
+// environment plus the target, pull request and commit of this sync
+func (rs *RepositorySync) hookEnv(commitSHA string) []string {
+	prNumber := ""
+	if rs.lastPRNumber != nil {
+		prNumber = strconv.Itoa(*rs.lastPRNumber)
+	}
+	return append(os.Environ(),
+		"TARGET_REPO="+rs.target.Repo,
+		"PR_NUMBER="+prNumber,
+		"PR_URL="+rs.lastPRURL,
+		"COMMIT_SHA="+commitSHA,
+	)
+}
+
+// hookShell returns the shell a hook command string is run with
+func hookShell(command string) (string, []string) {
+	if runtime.GOOS == "windows" {
+		return "cmd", []string{"/C", command}
+	}
+	return "sh", []string{"-c", command}
+}
+
+// runPostSyncHook runs the configured post-sync hook once the target's pull
+// request was created or updated. The hook runs through the shell with a
+// timeout and TARGET_REPO, PR_NUMBER, PR_URL and COMMIT_SHA in its
+// environment. Its output is logged. A failing hook is logged and only
+// returns an error under the fail policy. Hooks never run in dry-run mode.
+func (rs *RepositorySync) runPostSyncHook(ctx context.Context, commitSHA string) error {
+	hook := rs.getPostSyncHook()
+	if hook.command == "" {
+		return nil
+	}
+	log := rs.logger.WithFields(logrus.Fields{
+		"hook_policy":  hook.policy,
+		"hook_timeout": hook.timeout.String(),
+	})
+	if rs.engine.options.DryRun {
+		log.Debug("DRY-RUN: Skipping post-sync hook")
+		return nil
+	}
+
+	hookCtx, cancel := context.WithTimeout(ctx, hook.timeout)
+	defer cancel()
+
+	name, args := hookShell(hook.command)
+	cmd := exec.CommandContext(hookCtx, name, args...) //nolint:gosec // G204: the hook command is chosen by the user
+	cmd.Env = rs.hookEnv(commitSHA)
+	cmd.WaitDelay = hookWaitDelay
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+
+	log.Info("Running post-sync hook")
+	start := time.Now()
+	err := cmd.Run()
+	log = log.WithFields(logrus.Fields{
+		"duration_ms": time.Since(start).Milliseconds(),
+		"hook_output": truncateHookOutput(output.String()),
+	})
+
+	if err == nil {
+		log.Info("Post-sync hook completed")
+		return nil
+	}
+	if errors.Is(hookCtx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("timed out after %s: %w", hook.timeout, err)
+	}
+	if hook.policy == config.HookFailurePolicyFail {
+		log.WithError(err).Error("Post-sync hook failed, marking target failed")
+		return fmt.Errorf("%w: %w", ErrPostSyncHookFailed, err)
+	}
+	log.WithError(err).Warn("Post-sync hook failed")
+	return nil
+}
+
+// truncateHookOutput trims hook output for logging, keeping its end where
+// errors are usually reported
+func truncateHookOutput(output string) string {
+	output = strings.TrimSpace(output)
+	if len(output) <= hookOutputLimit {
+		return output
+	}
+	return "...[truncated]" + output[len(output)-hookOutputLimit:]
+}
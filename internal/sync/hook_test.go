@@ -0,0 +1,109 @@
+package sync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+// newHookRepoSync builds a RepositorySync whose current group has defaults
+func newHookRepoSync(defaults config.DefaultConfig, target config.TargetConfig) *RepositorySync {
+	rs := newTestRepoSync(&gh.MockClient{}, nil, target, nil)
+	rs.engine.currentGroup = &config.Group{ID: "core", Defaults: defaults}
+	return rs
+}
+
+func TestRepositorySync_getPostSyncHook(t *testing.T) {
+	rs := newHookRepoSync(config.DefaultConfig{}, config.TargetConfig{})
+	hook := rs.getPostSyncHook()
+	assert.Empty(t, hook.command)
+	assert.Equal(t, config.HookFailurePolicyWarn, hook.policy)
+	assert.Equal(t, time.Duration(config.DefaultHookTimeoutSeconds)*time.Second, hook.timeout)
+
+	rs = newHookRepoSync(
+		config.DefaultConfig{PostSyncHook: "./notify.sh", HookFailurePolicy: config.HookFailurePolicyFail, HookTimeoutSeconds: 10},
+		config.TargetConfig{},
+	)
+	assert.Equal(t, postSyncHook{command: "./notify.sh", policy: config.HookFailurePolicyFail, timeout: 10 * time.Second}, rs.getPostSyncHook())
+
+	rs.target = config.TargetConfig{PostSyncHook: "./deploy.sh", HookFailurePolicy: config.HookFailurePolicyWarn}
+	assert.Equal(t, postSyncHook{command: "./deploy.sh", policy: config.HookFailurePolicyWarn, timeout: 10 * time.Second}, rs.getPostSyncHook())
+}
+
+func TestRepositorySync_runPostSyncHook(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("hook tests use POSIX shell commands")
+	}
+	ctx := context.Background()
+
+	t.Run("environment is injected", func(t *testing.T) {
+		out := filepath.Join(t.TempDir(), "env.txt")
+		rs := newHookRepoSync(config.DefaultConfig{
+			PostSyncHook: `printf '%s %s %s %s' "$TARGET_REPO" "$PR_NUMBER" "$PR_URL" "$COMMIT_SHA" > ` + out,
+		}, config.TargetConfig{Repo: "org/target"})
+		prNumber := 42
+		rs.lastPRNumber = &prNumber
+		rs.lastPRURL = "https://github.com/org/target/pull/42"
+
+		require.NoError(t, rs.runPostSyncHook(ctx, "def456"))
+
+		data, err := os.ReadFile(out) //nolint:gosec // test file in a temp dir
+		require.NoError(t, err)
+		assert.Equal(t, "org/target 42 https://github.com/org/target/pull/42 def456", string(data))
+	})
+
+	t.Run("failure is logged under the warn policy", func(t *testing.T) {
+		rs := newHookRepoSync(config.DefaultConfig{PostSyncHook: "echo broken >&2; exit 3"}, config.TargetConfig{Repo: "org/target"})
+		require.NoError(t, rs.runPostSyncHook(ctx, "def456"))
+	})
+
+	t.Run("failure fails the target under the fail policy", func(t *testing.T) {
+		rs := newHookRepoSync(config.DefaultConfig{PostSyncHook: "exit 3"},
+			config.TargetConfig{Repo: "org/target", HookFailurePolicy: config.HookFailurePolicyFail})
+		require.ErrorIs(t, rs.runPostSyncHook(ctx, "def456"), ErrPostSyncHookFailed)
+	})
+
+	t.Run("timeout kills the hook", func(t *testing.T) {
+		rs := newHookRepoSync(config.DefaultConfig{
+			PostSyncHook:       "exec sleep 10",
+			HookFailurePolicy:  config.HookFailurePolicyFail,
+			HookTimeoutSeconds: 1,
+		}, config.TargetConfig{Repo: "org/target"})
+
+		start := time.Now()
+		err := rs.runPostSyncHook(ctx, "def456")
+		require.ErrorIs(t, err, ErrPostSyncHookFailed)
+		assert.Contains(t, err.Error(), "timed out")
+		assert.Less(t, time.Since(start), 8*time.Second)
+	})
+
+	t.Run("dry-run skips the hook", func(t *testing.T) {
+		out := filepath.Join(t.TempDir(), "ran.txt")
+		rs := newHookRepoSync(config.DefaultConfig{PostSyncHook: "touch " + out}, config.TargetConfig{Repo: "org/target"})
+		rs.engine.options.DryRun = true
+
+		require.NoError(t, rs.runPostSyncHook(ctx, "def456"))
+		_, err := os.Stat(out)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestTruncateHookOutput(t *testing.T) {
+	assert.Equal(t, "done", truncateHookOutput("  done\n"))
+
+	long := strings.Repeat("a", hookOutputLimit) + "tail"
+	truncated := truncateHookOutput(long)
+	assert.True(t, strings.HasPrefix(truncated, "...[truncated]"))
+	assert.True(t, strings.HasSuffix(truncated, "tail"))
+	assert.Len(t, truncated, hookOutputLimit+len("...[truncated]"))
+}
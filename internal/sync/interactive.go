@@ -0,0 +1,213 @@
+package sync
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/mattn/go-isatty"
+)
+
+// ErrInteractiveRequiresTerminal indicates --interactive was requested without
+// a terminal to prompt on, so the run refuses to start instead of hanging
+var ErrInteractiveRequiresTerminal = errors.New("interactive mode requires a terminal on stdin")
+
+// PRDecision is the operator's answer when asked to approve a target's PR
+type PRDecision int
+
+const (
+	// PRDecisionApprove pushes the target's branch and opens or updates its PR
+	PRDecisionApprove PRDecision = iota
+
+	// PRDecisionSkip leaves the target untouched and moves on to the next one
+	PRDecisionSkip
+
+	// PRDecisionQuit skips this target and every target not yet approved
+	PRDecisionQuit
+)
+
+// PRReview is what the operator is shown before approving a target's PR
+type PRReview struct {
+	Repo   string       // Target repository
+	Branch string       // Sync branch that would be pushed
+	Action string       // What approval does, e.g. "create a pull request"
+	Files  []FileChange // Files the sync changes
+}
+
+// PRApprover asks the operator to approve each target's PR in --interactive
+// mode. Like ScopeConfirmer it keeps TTY detection and stdin reads out of the
+// engine so tests can inject a fake.
+type PRApprover interface {
+	// Interactive reports whether a prompt is possible (a real TTY is attached)
+	Interactive() bool
+
+	// Review shows the target's changes and reads the operator's decision
+	Review(review PRReview) (PRDecision, error)
+}
+
+// terminalPRApprover is the default PRApprover. It prompts on stderr and reads
+// answers from stdin, one line each.
+type terminalPRApprover struct {
+	in     *os.File
+	out    io.Writer
+	reader *bufio.Reader
+}
+
+// newTerminalPRApprover returns the default approver wired to stdin/stderr
+func newTerminalPRApprover() *terminalPRApprover {
+	return &terminalPRApprover{in: os.Stdin, out: os.Stderr, reader: bufio.NewReader(os.Stdin)}
+}
+
+// Interactive reports whether stdin is attached to a real terminal
+func (a *terminalPRApprover) Interactive() bool {
+	if a.in == nil {
+		return false
+	}
+	fd := a.in.Fd()
+	return isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
+}
+
+// Review lists the target's changed files and asks until the operator answers
+// approve, skip or quit. End of input quits, so a closed stdin never approves.
+func (a *terminalPRApprover) Review(review PRReview) (PRDecision, error) {
+	writePRReview(a.out, review)
+	for {
+		_, _ = fmt.Fprint(a.out, "[a]pprove, [s]kip, or [q]uit? ")
+		line, err := a.reader.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return PRDecisionQuit, err
+		}
+		if decision, ok := parsePRDecision(line); ok {
+			return decision, nil
+		}
+		if errors.Is(err, io.EOF) {
+			_, _ = fmt.Fprintln(a.out)
+			return PRDecisionQuit, nil
+		}
+	}
+}
+
+// writePRReview prints the target, branch and changed files of review
+func writePRReview(w io.Writer, review PRReview) {
+	_, _ = fmt.Fprintf(w, "\nTarget:  %s\n", review.Repo)
+	_, _ = fmt.Fprintf(w, "Branch:  %s\n", review.Branch)
+	_, _ = fmt.Fprintf(w, "Action:  %s\n", review.Action)
+	_, _ = fmt.Fprintf(w, "Changes: %d file(s)\n", len(review.Files))
+	for _, change := range review.Files {
+		_, _ = fmt.Fprintf(w, "  %-8s %s\n", fileChangeType(change), change.Path)
+	}
+}
+
+// parsePRDecision reads an answer to the approval prompt
+func parsePRDecision(answer string) (PRDecision, bool) {
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "a", "approve", "y", "yes":
+		return PRDecisionApprove, true
+	case "s", "skip", "n", "no":
+		return PRDecisionSkip, true
+	case "q", "quit":
+		return PRDecisionQuit, true
+	default:
+		return PRDecisionSkip, false
+	}
+}
+
+// SetPRApprover sets a custom approver for --interactive mode. Tests inject a
+// fake so no real TTY or stdin is needed.
+func (e *Engine) SetPRApprover(a PRApprover) {
+	e.prApprover = a
+}
+
+// checkInteractive refuses an --interactive run when there is no terminal to
+// prompt on. Dry runs never prompt and are not checked.
+func (e *Engine) checkInteractive() error {
+	if !e.options.Interactive || e.options.DryRun {
+		return nil
+	}
+	if e.prApprover == nil || !e.prApprover.Interactive() {
+		return ErrInteractiveRequiresTerminal
+	}
+	return nil
+}
+
+// reviewPR asks the operator to approve review. Prompts are asked one at a
+// time across all targets, and once the operator quits every later review is
+// answered with PRDecisionQuit without prompting.
+func (e *Engine) reviewPR(review PRReview) (PRDecision, error) {
+	run := e.shared()
+	run.interactiveMu.Lock()
+	defer run.interactiveMu.Unlock()
+
+	if run.interactiveQuit {
+		return PRDecisionQuit, nil
+	}
+	decision, err := e.prApprover.Review(review)
+	if err != nil || decision == PRDecisionQuit {
+		run.interactiveQuit = true
+	}
+	return decision, err
+}
+
+// interactiveQuitRequested reports whether the operator quit at a prompt
+func (e *Engine) interactiveQuitRequested() bool {
+	run := e.shared()
+	run.interactiveMu.Lock()
+	defer run.interactiveMu.Unlock()
+	return run.interactiveQuit
+}
+
+// recordInteractiveSkip keeps repo as a target the operator did not approve
+func (e *Engine) recordInteractiveSkip(repo string) {
+	run := e.shared()
+	run.interactiveMu.Lock()
+	defer run.interactiveMu.Unlock()
+	run.interactiveSkips = append(run.interactiveSkips, repo)
+}
+
+// InteractiveSkips returns the targets of the last Sync that were skipped at
+// the --interactive prompt or after the operator quit, in the order they were
+// skipped
+func (e *Engine) InteractiveSkips() []string {
+	run := e.shared()
+	run.interactiveMu.Lock()
+	defer run.interactiveMu.Unlock()
+	return append([]string{}, run.interactiveSkips...)
+}
+
+// approvePR asks the operator whether the sync of this target may push
+// branchName and open or update its PR. It returns the reason the target is
+// skipped, or an empty string when it is approved or --interactive is off.
+func (rs *RepositorySync) approvePR(branchName string, changedFiles []FileChange, actualChangedFiles []string) (string, error) {
+	if !rs.engine.options.Interactive || rs.engine.options.DryRun {
+		return "", nil
+	}
+
+	action := "create a pull request"
+	if rs.engine.options.NoPR {
+		action = "push the branch without a pull request"
+	} else if existingPR := rs.findExistingPR(branchName); existingPR != nil {
+		action = fmt.Sprintf("update pull request #%d", existingPR.Number)
+	}
+
+	decision, err := rs.engine.reviewPR(PRReview{
+		Repo:   rs.target.Repo,
+		Branch: branchName,
+		Action: action,
+		Files:  actualFileChanges(changedFiles, actualChangedFiles),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to read interactive approval: %w", err)
+	}
+
+	switch decision {
+	case PRDecisionApprove:
+		return "", nil
+	case PRDecisionQuit:
+		return "quit at the interactive prompt", nil
+	default:
+		return "skipped at the interactive prompt", nil
+	}
+}
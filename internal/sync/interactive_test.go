@@ -0,0 +1,141 @@
+package sync
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/state"
+)
+
+// fakeApprover is an injectable PRApprover answering with fixed decisions
+type fakeApprover struct {
+	interactive bool
+	decisions   []PRDecision
+	reviews     []PRReview
+}
+
+func (f *fakeApprover) Interactive() bool { return f.interactive }
+
+func (f *fakeApprover) Review(review PRReview) (PRDecision, error) {
+	f.reviews = append(f.reviews, review)
+	decision := f.decisions[0]
+	f.decisions = f.decisions[1:]
+	return decision, nil
+}
+
+func TestCheckInteractive(t *testing.T) {
+	e := &Engine{options: &Options{Interactive: true}, prApprover: &fakeApprover{interactive: false}}
+	require.ErrorIs(t, e.checkInteractive(), ErrInteractiveRequiresTerminal)
+
+	e.prApprover = &fakeApprover{interactive: true}
+	require.NoError(t, e.checkInteractive())
+
+	// Dry runs never prompt, so they need no terminal
+	e = &Engine{options: &Options{Interactive: true, DryRun: true}}
+	require.NoError(t, e.checkInteractive())
+}
+
+func TestApprovePR(t *testing.T) {
+	newRepoSync := func(approver PRApprover) *RepositorySync {
+		existing := gh.PR{Number: 9}
+		existing.Head.Ref = "chore/sync-existing"
+		engine := &Engine{options: &Options{Interactive: true}, prApprover: approver}
+		return &RepositorySync{
+			engine:      engine.forGroup(&config.Config{}, &config.Group{}),
+			target:      config.TargetConfig{Repo: "org/target"},
+			targetState: &state.TargetState{OpenPRs: []gh.PR{existing}},
+		}
+	}
+	changes := []FileChange{{Path: "README.md", IsNew: true}, {Path: "skipped.md"}}
+
+	approver := &fakeApprover{decisions: []PRDecision{PRDecisionApprove, PRDecisionSkip, PRDecisionQuit}}
+	rs := newRepoSync(approver)
+
+	reason, err := rs.approvePR("chore/sync-new", changes, []string{"README.md"})
+	require.NoError(t, err)
+	assert.Empty(t, reason)
+	assert.Equal(t, PRReview{
+		Repo:   "org/target",
+		Branch: "chore/sync-new",
+		Action: "create a pull request",
+		Files:  []FileChange{{Path: "README.md", IsNew: true}},
+	}, approver.reviews[0])
+
+	reason, err = rs.approvePR("chore/sync-existing", changes, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "skipped at the interactive prompt", reason)
+	assert.Equal(t, "update pull request #9", approver.reviews[1].Action)
+
+	reason, err = rs.approvePR("chore/sync-new", changes, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "quit at the interactive prompt", reason)
+
+	// After quitting, later targets are skipped without another prompt
+	reason, err = rs.approvePR("chore/sync-new", changes, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "quit at the interactive prompt", reason)
+	assert.Len(t, approver.reviews, 3)
+	assert.True(t, rs.engine.interactiveQuitRequested())
+}
+
+func TestApprovePR_Disabled(t *testing.T) {
+	rs := &RepositorySync{engine: &Engine{options: &Options{}}}
+	reason, err := rs.approvePR("chore/sync", nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, reason)
+}
+
+func TestInteractiveSkips(t *testing.T) {
+	e := &Engine{options: &Options{Interactive: true}}
+	view := e.forGroup(&config.Config{}, &config.Group{})
+
+	view.recordInteractiveSkip("org/a")
+	e.recordInteractiveSkip("org/b")
+	assert.Equal(t, []string{"org/a", "org/b"}, view.InteractiveSkips())
+}
+
+func TestTerminalPRApprover_Review(t *testing.T) {
+	review := PRReview{
+		Repo:   "org/target",
+		Branch: "chore/sync",
+		Action: "create a pull request",
+		Files:  []FileChange{{Path: "README.md", IsNew: true}, {Path: "old.txt", IsDeleted: true}},
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  PRDecision
+	}{
+		{name: "approve", input: "a\n", want: PRDecisionApprove},
+		{name: "skip", input: "S\n", want: PRDecisionSkip},
+		{name: "quit", input: "quit\n", want: PRDecisionQuit},
+		{name: "asks again after an unknown answer", input: "maybe\ny\n", want: PRDecisionApprove},
+		{name: "end of input quits", input: "", want: PRDecisionQuit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			approver := &terminalPRApprover{out: &out, reader: bufio.NewReader(strings.NewReader(tt.input))}
+
+			decision, err := approver.Review(review)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, decision)
+			assert.Contains(t, out.String(), "Target:  org/target")
+			assert.Contains(t, out.String(), "  new      README.md\n")
+			assert.Contains(t, out.String(), "  deleted  old.txt\n")
+			assert.Contains(t, out.String(), "[a]pprove, [s]kip, or [q]uit?")
+		})
+	}
+
+	// Without a stdin file there is no terminal to prompt on
+	assert.False(t, (&terminalPRApprover{}).Interactive())
+}
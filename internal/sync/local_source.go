@@ -0,0 +1,104 @@
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+var (
+	// ErrLocalSourceNotFound indicates the local source directory does not exist
+	ErrLocalSourceNotFound = errors.New("local source directory not found")
+	// ErrSourceRootNotFound indicates a target's source_root is not a directory of the source tree
+	ErrSourceRootNotFound = errors.New("source_root directory not found in source")
+)
+
+// isLocalSource reports whether the source tree is a local directory used
+// in place of a clone
+func (rs *RepositorySync) isLocalSource() bool {
+	return rs.sourceState != nil && config.IsLocalSource(rs.sourceState.Repo)
+}
+
+// sourcePath returns the directory holding the source tree: the local source
+// directory itself, or the source clone inside the temp dir
+func (rs *RepositorySync) sourcePath() string {
+	if rs.isLocalSource() {
+		return config.LocalSourcePath(rs.sourceState.Repo)
+	}
+	return filepath.Join(rs.tempDir, "source")
+}
+
+// mappingSourcePath returns the directory mapping src paths are resolved
+// against: the target's source_root within the source tree, or the source
+// tree itself
+func (rs *RepositorySync) mappingSourcePath() string {
+	if rs.target.SourceRoot == "" {
+		return rs.sourcePath()
+	}
+	return filepath.Join(rs.sourcePath(), filepath.FromSlash(rs.target.SourceRoot))
+}
+
+// verifySourceRoot checks that the target's source_root is a directory inside
+// the source tree, so a typo fails the sync instead of skipping every file
+func (rs *RepositorySync) verifySourceRoot() error {
+	if rs.target.SourceRoot == "" {
+		return nil
+	}
+	root, err := resolveSourcePath(rs.sourcePath(), rs.target.SourceRoot)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrSourceRootNotFound, rs.target.SourceRoot, err)
+	}
+	if info, err := os.Stat(root); err != nil || !info.IsDir() {
+		return fmt.Errorf("%w: %s is not a directory", ErrSourceRootNotFound, rs.target.SourceRoot)
+	}
+	return nil
+}
+
+// sourceRepoURL returns the web URL of the source repository used by
+// module-aware sync, or an empty string when there is no remote source
+func (rs *RepositorySync) sourceRepoURL() string {
+	if rs.sourceState == nil || rs.sourceState.Repo == "" || rs.isLocalSource() {
+		return ""
+	}
+	return rs.engine.repoWebURL(rs.sourceState.Repo)
+}
+
+// useLocalSource prepares a local source directory in place of a clone. The
+// directory is read as is, including uncommitted changes, and never checked
+// out or modified.
+func (rs *RepositorySync) useLocalSource() error {
+	sourcePath := rs.sourcePath()
+	info, err := os.Stat(sourcePath)
+	if err != nil || !info.IsDir() {
+		return fmt.Errorf("%w: %s", ErrLocalSourceNotFound, sourcePath)
+	}
+
+	rs.logger.WithField("source_path", sourcePath).
+		Info("Using local source directory (no clone)")
+
+	rs.gitAttributes = loadSourceGitAttributes(rs.engine, sourcePath, rs.logger)
+	return rs.verifySourceRoot()
+}
+
+// readLocalSourceFile reads a mapped file from the local source directory,
+// reporting a missing file as gh.ErrFileNotFound like the GitHub API does. A
+// file that resolves outside the directory is skipped as missing.
+func (rs *RepositorySync) readLocalSourceFile(src string) ([]byte, error) {
+	path, err := resolveSourcePath(rs.mappingSourcePath(), src)
+	if errors.Is(err, ErrSourcePathEscapes) {
+		rs.logger.WithError(err).WithField("file", src).Warn("Source file resolves outside the source tree, skipping")
+		return nil, fmt.Errorf("%w: %s", gh.ErrFileNotFound, src)
+	}
+	var content []byte
+	if err == nil {
+		content, err = os.ReadFile(path) //nolint:gosec // Resolved inside the source directory
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", gh.ErrFileNotFound, src)
+	}
+	return content, err
+}
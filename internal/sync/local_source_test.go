@@ -0,0 +1,83 @@
+package sync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/git"
+	"github.com/mrz1836/go-broadcast/internal/state"
+)
+
+func TestRepositorySync_sourcePath(t *testing.T) {
+	rs := newTestRepoSync(&gh.MockClient{}, nil, config.TargetConfig{}, nil)
+	rs.tempDir = "/tmp/sync-123"
+
+	assert.Equal(t, filepath.Join("/tmp/sync-123", "source"), rs.sourcePath())
+	assert.False(t, rs.isLocalSource())
+
+	rs.sourceState = &state.SourceState{Repo: "file:///srv/templates"}
+	assert.Equal(t, "/srv/templates", rs.sourcePath())
+	assert.True(t, rs.isLocalSource())
+	assert.Empty(t, rs.sourceRepoURL(), "module-aware sync needs a remote source")
+}
+
+func TestRepositorySync_cloneSourceLocal(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".gitattributes"), []byte("*.dat binary\n"), 0o600))
+
+	gitClient := &git.MockClient{}
+	rs := newTestRepoSync(&gh.MockClient{}, nil, config.TargetConfig{}, nil)
+	rs.engine.git = gitClient
+	rs.tempDir = t.TempDir()
+	rs.sourceState = &state.SourceState{Repo: dir, Branch: "master", LatestCommit: "abc123"}
+
+	require.NoError(t, rs.cloneSource(ctx))
+	assert.NotNil(t, rs.gitAttributes, "the local .gitattributes is loaded")
+	gitClient.AssertNotCalled(t, "Clone", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	gitClient.AssertNotCalled(t, "Checkout", mock.Anything, mock.Anything, mock.Anything)
+
+	rs.sourceState.Repo = filepath.Join(dir, "missing")
+	require.ErrorIs(t, rs.cloneSource(ctx), ErrLocalSourceNotFound)
+}
+
+func TestRepositorySync_transformedSourceContentLocal(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("uncommitted edit"), 0o600))
+
+	ghClient := &gh.MockClient{}
+	rs := newTestRepoSync(ghClient, nil, config.TargetConfig{}, nil)
+	rs.sourceState = &state.SourceState{Repo: dir, Branch: "master", LatestCommit: "abc123"}
+
+	content, err := rs.transformedSourceContent(ctx, config.FileMapping{Src: "a.txt", Dest: "a.txt"})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("uncommitted edit"), content)
+
+	_, err = rs.transformedSourceContent(ctx, config.FileMapping{Src: "missing.txt", Dest: "missing.txt"})
+	require.ErrorIs(t, err, gh.ErrFileNotFound)
+
+	ghClient.AssertNotCalled(t, "GetFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRepositorySync_needsSyncLocalSource(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("new"), 0o600))
+
+	ghClient := &gh.MockClient{}
+	target := config.TargetConfig{Repo: "org/target", Files: []config.FileMapping{{Src: "a.txt", Dest: "a.txt"}}}
+	rs := newTestRepoSync(ghClient, nil, target, nil)
+	rs.sourceState = &state.SourceState{Repo: dir, Branch: "master", LatestCommit: "abc123"}
+	rs.targetState = &state.TargetState{Repo: "org/target", LastSyncCommit: "abc123"}
+
+	assert.True(t, rs.needsSync(ctx), "the same commit does not prove a local source is unchanged")
+}
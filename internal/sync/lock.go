@@ -0,0 +1,267 @@
+package sync
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+// DefaultLockTTL is the age after which a target lock left behind by a
+// crashed run is reclaimed
+const DefaultLockTTL = time.Hour
+
+var (
+	// ErrTargetLocked indicates another sync run holds the advisory lock of a target
+	ErrTargetLocked = errors.New("target is locked by another sync run")
+	// ErrRefLockUnsupported indicates the GitHub client cannot hold Options.LockRef locks
+	ErrRefLockUnsupported = errors.New("GitHub client does not support ref locks")
+)
+
+// lockInfo identifies the run holding a target lock
+type lockInfo struct {
+	Owner      string    `json:"owner"` // Host and process of the holding run
+	Token      string    `json:"token"` // Unique to one acquisition, so a run only releases its own lock
+	AcquiredAt time.Time `json:"acquired_at"`
+}
+
+// targetLocker holds advisory locks on target repositories
+type targetLocker interface {
+	// tryLock takes the lock of repo for info. It returns nil once the lock
+	// is taken, or the holder when another run has it.
+	tryLock(ctx context.Context, repo string, info lockInfo) (*lockInfo, error)
+
+	// unlock releases the lock of repo when holder still has it
+	unlock(ctx context.Context, repo string, holder lockInfo) error
+}
+
+// newTargetLocker returns the locker configured by the options, or nil when
+// targets are not locked
+func (e *Engine) newTargetLocker() (targetLocker, error) {
+	switch {
+	case e.options.LockDir != "":
+		return fileLocker{dir: e.options.LockDir}, nil
+	case e.options.LockRef != "":
+		client, ok := e.gh.(gh.RefLocker)
+		if !ok {
+			return nil, ErrRefLockUnsupported
+		}
+		return refLocker{client: client, ref: e.options.LockRef}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// lockTarget takes the advisory lock of repo for the rest of its sync and
+// returns the func releasing it. A lock older than the lock TTL was left by a
+// run that died and is reclaimed; a younger one fails with ErrTargetLocked.
+// Dry runs push nothing and take no lock.
+func (e *Engine) lockTarget(ctx context.Context, repo string, log *logrus.Entry) (func(), error) {
+	if e.options.DryRun {
+		return func() {}, nil
+	}
+	locker, err := e.newTargetLocker()
+	if err != nil || locker == nil {
+		return func() {}, err
+	}
+
+	ttl := e.options.LockTTL
+	if ttl <= 0 {
+		ttl = DefaultLockTTL
+	}
+	info := newLockInfo()
+
+	for reclaimed := false; ; reclaimed = true {
+		holder, err := locker.tryLock(ctx, repo, info)
+		if err != nil {
+			return nil, fmt.Errorf("failed to lock %s: %w", repo, err)
+		}
+		if holder == nil {
+			break
+		}
+		if reclaimed || time.Since(holder.AcquiredAt) < ttl {
+			return nil, fmt.Errorf("%w: %s has held it since %s", ErrTargetLocked, holder.Owner, holder.AcquiredAt.Format(time.RFC3339))
+		}
+
+		log.WithFields(logrus.Fields{
+			"lock_owner":       holder.Owner,
+			"lock_acquired_at": holder.AcquiredAt,
+		}).Warn("Reclaiming stale target lock")
+		if err := locker.unlock(ctx, repo, *holder); err != nil {
+			return nil, fmt.Errorf("failed to reclaim stale lock of %s: %w", repo, err)
+		}
+	}
+
+	log.Debug("Acquired target lock")
+	return func() {
+		// Release the lock even when the sync was canceled
+		releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+		defer cancel()
+		if err := locker.unlock(releaseCtx, repo, info); err != nil {
+			log.WithError(err).Warn("Failed to release target lock")
+		}
+	}, nil
+}
+
+// newLockInfo describes a lock taken now by this process
+func newLockInfo() lockInfo {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	token := make([]byte, 8)
+	if _, err := rand.Read(token); err != nil {
+		token = []byte(time.Now().String())
+	}
+	return lockInfo{
+		Owner:      fmt.Sprintf("%s:%d", host, os.Getpid()),
+		Token:      hex.EncodeToString(token),
+		AcquiredAt: time.Now().UTC(),
+	}
+}
+
+// fileLocker locks each target with a file in dir, created exclusively so
+// only one run holds it. Runs must share dir, so this locks runs on one host
+// or sharing a filesystem.
+type fileLocker struct {
+	dir string
+}
+
+// path returns the lock file of repo
+func (l fileLocker) path(repo string) string {
+	return filepath.Join(l.dir, artifactSlugPattern.ReplaceAllString(repo, "_")+".lock")
+}
+
+func (l fileLocker) tryLock(_ context.Context, repo string, info lockInfo) (*lockInfo, error) {
+	if err := os.MkdirAll(l.dir, 0o750); err != nil {
+		return nil, fmt.Errorf("create lock dir: %w", err)
+	}
+	data, err := json.Marshal(info)
+	if err != nil {
+		return nil, fmt.Errorf("encode lock: %w", err)
+	}
+
+	file, err := os.OpenFile(l.path(repo), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if errors.Is(err, os.ErrExist) {
+		return l.holder(repo)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create lock file: %w", err)
+	}
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(l.path(repo))
+		return nil, fmt.Errorf("write lock file: %w", err)
+	}
+	return nil, nil
+}
+
+// holder reads the lock file of repo. A file another run is still writing,
+// or one it left unreadable, is dated by its modification time.
+func (l fileLocker) holder(repo string) (*lockInfo, error) {
+	path := l.path(repo)
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("read lock file: %w", err)
+	}
+
+	var holder lockInfo
+	data, err := os.ReadFile(path) //#nosec G304 -- Path is built from the lock dir and a sanitized repo name
+	if err != nil || json.Unmarshal(data, &holder) != nil || holder.AcquiredAt.IsZero() {
+		holder = lockInfo{Owner: "unknown", AcquiredAt: stat.ModTime()}
+	}
+	return &holder, nil
+}
+
+func (l fileLocker) unlock(_ context.Context, repo string, holder lockInfo) error {
+	current, err := l.holder(repo)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if current.Token != holder.Token {
+		return nil
+	}
+	if err := os.Remove(l.path(repo)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove lock file: %w", err)
+	}
+	return nil
+}
+
+// refLocker locks each target with a ref in the target repository, so runs on
+// any host exclude each other. The ref points at a commit outside every
+// branch whose message records the lock.
+type refLocker struct {
+	client gh.RefLocker
+	ref    string
+}
+
+func (l refLocker) tryLock(ctx context.Context, repo string, info lockInfo) (*lockInfo, error) {
+	message, err := json.Marshal(info)
+	if err != nil {
+		return nil, fmt.Errorf("encode lock: %w", err)
+	}
+
+	// A lock released between a failed create and reading it is taken again once
+	for attempt := 0; attempt < 2; attempt++ {
+		err := l.client.CreateLockRef(ctx, repo, l.ref, string(message))
+		if err == nil {
+			return nil, nil
+		}
+		if !errors.Is(err, gh.ErrRefExists) {
+			return nil, err
+		}
+
+		holder, err := l.holder(ctx, repo)
+		if errors.Is(err, gh.ErrRefNotFound) {
+			continue
+		}
+		return holder, err
+	}
+	return nil, fmt.Errorf("%w: %s is repeatedly created and released", ErrTargetLocked, l.ref)
+}
+
+// holder reads the lock recorded by the lock ref of repo. A ref not created by
+// a run is dated by its commit.
+func (l refLocker) holder(ctx context.Context, repo string) (*lockInfo, error) {
+	ref, err := l.client.GetLockRef(ctx, repo, l.ref)
+	if err != nil {
+		return nil, err
+	}
+	var holder lockInfo
+	if json.Unmarshal([]byte(ref.Message), &holder) != nil || holder.AcquiredAt.IsZero() {
+		holder = lockInfo{Owner: "unknown", AcquiredAt: ref.CreatedAt}
+	}
+	return &holder, nil
+}
+
+func (l refLocker) unlock(ctx context.Context, repo string, holder lockInfo) error {
+	current, err := l.holder(ctx, repo)
+	if errors.Is(err, gh.ErrRefNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if current.Token != holder.Token {
+		return nil
+	}
+	if err := l.client.DeleteRef(ctx, repo, l.ref); err != nil && !errors.Is(err, gh.ErrRefNotFound) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+func TestEngine_lockTarget_File(t *testing.T) {
+	ctx := context.Background()
+	log := logrus.NewEntry(logrus.New())
+	dir := t.TempDir()
+	newEngine := func() *Engine {
+		return &Engine{options: DefaultOptions().WithTargetLock(dir, "", 0)}
+	}
+	lockPath := filepath.Join(dir, "org_repo.lock")
+
+	t.Run("second run is refused until the first releases", func(t *testing.T) {
+		unlock, err := newEngine().lockTarget(ctx, "org/repo", log)
+		require.NoError(t, err)
+		assert.FileExists(t, lockPath)
+
+		_, err = newEngine().lockTarget(ctx, "org/repo", log)
+		require.ErrorIs(t, err, ErrTargetLocked)
+
+		// Other targets are not affected
+		unlockOther, err := newEngine().lockTarget(ctx, "org/other", log)
+		require.NoError(t, err)
+		unlockOther()
+
+		unlock()
+		assert.NoFileExists(t, lockPath)
+
+		unlock, err = newEngine().lockTarget(ctx, "org/repo", log)
+		require.NoError(t, err)
+		unlock()
+	})
+
+	t.Run("stale lock is reclaimed", func(t *testing.T) {
+		stale, err := json.Marshal(lockInfo{Owner: "crashed:1", Token: "old", AcquiredAt: time.Now().Add(-2 * DefaultLockTTL)})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(lockPath, stale, 0o600))
+
+		unlock, err := newEngine().lockTarget(ctx, "org/repo", log)
+		require.NoError(t, err)
+		unlock()
+		assert.NoFileExists(t, lockPath)
+	})
+
+	t.Run("unreadable lock is dated by its file", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(lockPath, []byte("{"), 0o600))
+
+		_, err := newEngine().lockTarget(ctx, "org/repo", log)
+		require.ErrorIs(t, err, ErrTargetLocked)
+		assert.Contains(t, err.Error(), "unknown")
+
+		old := time.Now().Add(-2 * DefaultLockTTL)
+		require.NoError(t, os.Chtimes(lockPath, old, old))
+		unlock, err := newEngine().lockTarget(ctx, "org/repo", log)
+		require.NoError(t, err)
+		unlock()
+	})
+
+	t.Run("release keeps a lock another run took over", func(t *testing.T) {
+		unlock, err := newEngine().lockTarget(ctx, "org/repo", log)
+		require.NoError(t, err)
+
+		other, err := json.Marshal(lockInfo{Owner: "other:2", Token: "other", AcquiredAt: time.Now()})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(lockPath, other, 0o600))
+
+		unlock()
+		assert.FileExists(t, lockPath)
+		require.NoError(t, os.Remove(lockPath))
+	})
+
+	t.Run("dry run takes no lock", func(t *testing.T) {
+		engine := newEngine()
+		engine.options.DryRun = true
+		unlock, err := engine.lockTarget(ctx, "org/repo", log)
+		require.NoError(t, err)
+		assert.NoFileExists(t, lockPath)
+		unlock()
+	})
+}
+
+func TestEngine_lockTarget_Ref(t *testing.T) {
+	ctx := context.Background()
+	log := logrus.NewEntry(logrus.New())
+	const ref = "refs/go-broadcast/lock"
+	newEngine := func(client gh.Client) *Engine {
+		return &Engine{gh: client, options: DefaultOptions().WithTargetLock("", ref, 0)}
+	}
+	lockMessage := func(t *testing.T, info lockInfo) string {
+		data, err := json.Marshal(info)
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	t.Run("acquire and release", func(t *testing.T) {
+		client := gh.NewMockClient()
+		var message string
+		client.On("CreateLockRef", mock.Anything, "org/repo", ref, mock.AnythingOfType("string")).Return(nil).
+			Run(func(args mock.Arguments) { message = args.String(3) })
+
+		unlock, err := newEngine(client).lockTarget(ctx, "org/repo", log)
+		require.NoError(t, err)
+
+		client.On("GetLockRef", mock.Anything, "org/repo", ref).Return(&gh.LockRef{Message: message}, nil)
+		client.On("DeleteRef", mock.Anything, "org/repo", ref).Return(nil)
+		unlock()
+		client.AssertExpectations(t)
+	})
+
+	t.Run("held lock refuses the target", func(t *testing.T) {
+		client := gh.NewMockClient()
+		client.On("CreateLockRef", mock.Anything, "org/repo", ref, mock.AnythingOfType("string")).Return(gh.ErrRefExists)
+		client.On("GetLockRef", mock.Anything, "org/repo", ref).
+			Return(&gh.LockRef{Message: lockMessage(t, lockInfo{Owner: "ci:7", Token: "ci", AcquiredAt: time.Now()})}, nil)
+
+		_, err := newEngine(client).lockTarget(ctx, "org/repo", log)
+		require.ErrorIs(t, err, ErrTargetLocked)
+		assert.Contains(t, err.Error(), "ci:7")
+		client.AssertNotCalled(t, "DeleteRef", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("stale lock is reclaimed", func(t *testing.T) {
+		client := gh.NewMockClient()
+		client.On("CreateLockRef", mock.Anything, "org/repo", ref, mock.AnythingOfType("string")).Return(gh.ErrRefExists).Once()
+		client.On("GetLockRef", mock.Anything, "org/repo", ref).
+			Return(&gh.LockRef{Message: "not a lock", CreatedAt: time.Now().Add(-2 * DefaultLockTTL)}, nil).Twice()
+		client.On("DeleteRef", mock.Anything, "org/repo", ref).Return(nil).Once()
+		client.On("CreateLockRef", mock.Anything, "org/repo", ref, mock.AnythingOfType("string")).Return(nil).Once()
+
+		unlock, err := newEngine(client).lockTarget(ctx, "org/repo", log)
+		require.NoError(t, err)
+		client.AssertExpectations(t)
+
+		// Release finds the lock gone and deletes nothing
+		client.On("GetLockRef", mock.Anything, "org/repo", ref).Return(nil, gh.ErrRefNotFound)
+		unlock()
+		client.AssertNumberOfCalls(t, "DeleteRef", 1)
+	})
+
+	t.Run("client without ref support", func(t *testing.T) {
+		_, err := newEngine(&TestValidationMockGHClient{}).lockTarget(ctx, "org/repo", log)
+		require.ErrorIs(t, err, ErrRefLockUnsupported)
+	})
+}
@@ -0,0 +1,96 @@
+package sync
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidManagedBlock indicates a file does not hold exactly one well-formed
+// managed block, so go-broadcast cannot tell which lines it owns
+var ErrInvalidManagedBlock = errors.New("invalid managed block")
+
+// managedBlockBounds locates the marker lines of the managed block in
+// content. Markers match whole lines, ignoring surrounding whitespace and
+// carriage returns. found is false when neither marker is present; a single
+// marker, repeated markers or an end before the start are errors.
+func managedBlockBounds(lines [][]byte, markerStart, markerEnd string) (start, end int, found bool, err error) {
+	start, end = -1, -1
+	for i, line := range lines {
+		switch string(bytes.TrimSpace(line)) {
+		case markerStart:
+			if start >= 0 {
+				return 0, 0, false, fmt.Errorf("%w: %q appears more than once", ErrInvalidManagedBlock, markerStart)
+			}
+			start = i
+		case markerEnd:
+			if end >= 0 {
+				return 0, 0, false, fmt.Errorf("%w: %q appears more than once", ErrInvalidManagedBlock, markerEnd)
+			}
+			end = i
+		}
+	}
+
+	switch {
+	case start < 0 && end < 0:
+		return 0, 0, false, nil
+	case start < 0:
+		return 0, 0, false, fmt.Errorf("%w: %q without %q", ErrInvalidManagedBlock, markerEnd, markerStart)
+	case end < 0:
+		return 0, 0, false, fmt.Errorf("%w: %q without %q", ErrInvalidManagedBlock, markerStart, markerEnd)
+	case end < start:
+		return 0, 0, false, fmt.Errorf("%w: %q comes before %q", ErrInvalidManagedBlock, markerEnd, markerStart)
+	}
+	return start, end, true, nil
+}
+
+// SpliceManagedBlock returns the target content with the block between the
+// markers replaced by the block from source, leaving every other line of the
+// target untouched. When the source holds the markers only the lines between
+// them are synced, otherwise the whole source is. A target without the markers
+// gets the block appended, and a missing (nil) target becomes just the block.
+// Splicing is idempotent: splicing the same source into the result again
+// returns it unchanged.
+func SpliceManagedBlock(target, source []byte, markerStart, markerEnd string) ([]byte, error) {
+	body := source
+	sourceLines := bytes.SplitAfter(source, []byte("\n"))
+	start, end, found, err := managedBlockBounds(sourceLines, markerStart, markerEnd)
+	if err != nil {
+		return nil, fmt.Errorf("source: %w", err)
+	}
+	if found {
+		body = bytes.Join(sourceLines[start+1:end], nil)
+	}
+	if len(body) > 0 && !bytes.HasSuffix(body, []byte("\n")) {
+		body = append(append([]byte{}, body...), '\n')
+	}
+
+	targetLines := bytes.SplitAfter(target, []byte("\n"))
+	start, end, found, err = managedBlockBounds(targetLines, markerStart, markerEnd)
+	if err != nil {
+		return nil, fmt.Errorf("target: %w", err)
+	}
+
+	var out bytes.Buffer
+	out.Grow(len(target) + len(body) + len(markerStart) + len(markerEnd) + 3)
+	if !found {
+		out.Write(target)
+		if len(target) > 0 && !bytes.HasSuffix(target, []byte("\n")) {
+			out.WriteByte('\n')
+		}
+		out.WriteString(markerStart + "\n")
+		out.Write(body)
+		out.WriteString(markerEnd + "\n")
+		return out.Bytes(), nil
+	}
+
+	// Keep the target's own marker lines, including their indentation
+	for _, line := range targetLines[:start+1] {
+		out.Write(line)
+	}
+	out.Write(body)
+	for _, line := range targetLines[end:] {
+		out.Write(line)
+	}
+	return out.Bytes(), nil
+}
@@ -0,0 +1,149 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+func TestSpliceManagedBlock(t *testing.T) {
+	const (
+		begin = "# BEGIN MANAGED"
+		end   = "# END MANAGED"
+	)
+
+	testCases := []struct {
+		name     string
+		target   string
+		source   string
+		expected string
+	}{
+		{
+			name:     "replaces the block and keeps surrounding content",
+			target:   "local-a\n# BEGIN MANAGED\nold\n# END MANAGED\nlocal-b\n",
+			source:   "new-1\nnew-2\n",
+			expected: "local-a\n# BEGIN MANAGED\nnew-1\nnew-2\n# END MANAGED\nlocal-b\n",
+		},
+		{
+			name:     "inserts the block when the target has no markers",
+			target:   "local-a\nlocal-b",
+			source:   "new",
+			expected: "local-a\nlocal-b\n# BEGIN MANAGED\nnew\n# END MANAGED\n",
+		},
+		{
+			name:     "missing target becomes the block",
+			target:   "",
+			source:   "new\n",
+			expected: "# BEGIN MANAGED\nnew\n# END MANAGED\n",
+		},
+		{
+			name:     "syncs only the marked lines of the source",
+			target:   "# BEGIN MANAGED\nold\n# END MANAGED\n",
+			source:   "source-only\n# BEGIN MANAGED\nshared\n# END MANAGED\nsource-tail\n",
+			expected: "# BEGIN MANAGED\nshared\n# END MANAGED\n",
+		},
+		{
+			name:     "keeps indented and CRLF target markers",
+			target:   "a:\r\n  # BEGIN MANAGED\r\n  old\r\n  # END MANAGED\r\nb\r\n",
+			source:   "  new\n",
+			expected: "a:\r\n  # BEGIN MANAGED\r\n  new\n  # END MANAGED\r\nb\r\n",
+		},
+		{
+			name:     "empty block",
+			target:   "x\n# BEGIN MANAGED\nold\n# END MANAGED\n",
+			source:   "",
+			expected: "x\n# BEGIN MANAGED\n# END MANAGED\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var target []byte
+			if tc.target != "" {
+				target = []byte(tc.target)
+			}
+			result, err := SpliceManagedBlock(target, []byte(tc.source), begin, end)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, string(result))
+
+			again, err := SpliceManagedBlock(result, []byte(tc.source), begin, end)
+			require.NoError(t, err)
+			assert.Equal(t, string(result), string(again), "splicing must be idempotent")
+		})
+	}
+
+	t.Run("rejects malformed markers", func(t *testing.T) {
+		for name, content := range map[string]string{
+			"start only":     "# BEGIN MANAGED\nx\n",
+			"end only":       "x\n# END MANAGED\n",
+			"end first":      "# END MANAGED\nx\n# BEGIN MANAGED\n",
+			"multiple pairs": "# BEGIN MANAGED\na\n# END MANAGED\n# BEGIN MANAGED\nb\n# END MANAGED\n",
+		} {
+			_, err := SpliceManagedBlock([]byte(content), []byte("new\n"), begin, end)
+			require.ErrorIs(t, err, ErrInvalidManagedBlock, name)
+			assert.Contains(t, err.Error(), "target", name)
+
+			_, err = SpliceManagedBlock(nil, []byte(content), begin, end)
+			require.ErrorIs(t, err, ErrInvalidManagedBlock, name)
+			assert.Contains(t, err.Error(), "source", name)
+		}
+	})
+}
+
+func TestRepositorySync_processFile_ManagedBlock(t *testing.T) {
+	ctx := context.Background()
+	mapping := config.FileMapping{Src: "gitignore", Dest: ".gitignore", MarkerStart: "# BEGIN MANAGED", MarkerEnd: "# END MANAGED"}
+	target := config.TargetConfig{Repo: "org/target", Files: []config.FileMapping{mapping}}
+
+	newRepoSync := func(t *testing.T, ghClient *gh.MockClient) (*RepositorySync, string) {
+		rs := newTestRepoSync(ghClient, nil, target, nil)
+		sourceDir := filepath.Join(t.TempDir(), "source")
+		require.NoError(t, os.MkdirAll(sourceDir, 0o750))
+		require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "gitignore"), []byte("*.log\n"), 0o600))
+		return rs, sourceDir
+	}
+
+	t.Run("splices into the existing target", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/target", ".gitignore", "").
+			Return(&gh.FileContent{Content: []byte("bin/\n# BEGIN MANAGED\n*.tmp\n# END MANAGED\n")}, nil).Once()
+		rs, sourceDir := newRepoSync(t, ghClient)
+
+		change, err := rs.processFile(ctx, sourceDir, mapping)
+		require.NoError(t, err)
+		assert.Equal(t, "bin/\n# BEGIN MANAGED\n*.log\n# END MANAGED\n", string(change.Content))
+		assert.False(t, change.IsNew)
+	})
+
+	t.Run("unreadable target is an error rather than an overwrite", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/target", ".gitignore", "").
+			Return(nil, errors.New("boom")).Once() //nolint:err113 // test-only error
+		rs, sourceDir := newRepoSync(t, ghClient)
+
+		_, err := rs.processFile(ctx, sourceDir, mapping)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "managed block")
+	})
+
+	t.Run("missing target creates the block", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/target", ".gitignore", "").
+			Return(nil, gh.ErrFileNotFound).Once()
+		rs, sourceDir := newRepoSync(t, ghClient)
+
+		change, err := rs.processFile(ctx, sourceDir, mapping)
+		require.NoError(t, err)
+		assert.Equal(t, "# BEGIN MANAGED\n*.log\n# END MANAGED\n", string(change.Content))
+		assert.True(t, change.IsNew)
+	})
+}
@@ -0,0 +1,40 @@
+package sync
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/state"
+)
+
+func TestRepositorySync_writeMetadataBlock_Signature(t *testing.T) {
+	target := config.TargetConfig{Repo: "org/target", Files: []config.FileMapping{{Src: "a.txt", Dest: "a.txt"}}}
+
+	t.Run("signed with the metadata secret", func(t *testing.T) {
+		secret := []byte("s3cret")
+		rs := newTestRepoSync(&gh.MockClient{}, nil, target, DefaultOptions().WithMetadataSecret(secret))
+
+		var sb strings.Builder
+		rs.writeMetadataBlock(&sb, "def456", nil, false)
+		body := "Summary\n\n" + sb.String()
+		assert.Contains(t, body, "signature: hmac-sha256:")
+		assert.Equal(t, state.MetadataVerified, state.VerifyPRMetadata(body, secret))
+
+		metadata, err := state.ExtractEnhancedPRMetadata(gh.PR{Body: body})
+		require.NoError(t, err)
+		assert.Equal(t, "def456", metadata.SyncMetadata.SyncCommit)
+	})
+
+	t.Run("unsigned without a secret", func(t *testing.T) {
+		rs := newTestRepoSync(&gh.MockClient{}, nil, target, nil)
+
+		var sb strings.Builder
+		rs.writeMetadataBlock(&sb, "def456", nil, false)
+		assert.NotContains(t, sb.String(), "signature:")
+	})
+}
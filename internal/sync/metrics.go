@@ -115,6 +115,9 @@ const (
 	TargetStatusFailed    = "failed"
 	TargetStatusSkipped   = "skipped"
 	TargetStatusNoChanges = "no_changes"
+
+	// TargetStatusBranchPushed is a sync pushed without a pull request (--no-pr)
+	TargetStatusBranchPushed = "branch_pushed"
 )
 
 // Change type constants for file changes
@@ -0,0 +1,155 @@
+package sync
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// RunMetrics is the performance record of one sync run, summed over every
+// target synced. With Options.MetricsFile set, one record is appended to the
+// file per run as a line of JSON.
+type RunMetrics struct {
+	StartedAt          time.Time `json:"started_at"`
+	EndedAt            time.Time `json:"ended_at"`
+	DurationMs         int64     `json:"duration_ms"`
+	DryRun             bool      `json:"dry_run,omitempty"`
+	Targets            int       `json:"targets"`
+	FailedTargets      int       `json:"failed_targets"`
+	PullRequests       int       `json:"pull_requests"`
+	APICalls           int       `json:"api_calls"`
+	APICallsSaved      int       `json:"api_calls_saved"`
+	FilesProcessed     int       `json:"files_processed"`
+	FilesChanged       int       `json:"files_changed"`
+	FilesSkipped       int       `json:"files_skipped"`
+	CacheHits          int       `json:"cache_hits"`
+	CacheMisses        int       `json:"cache_misses"`
+	CacheHitRate       float64   `json:"cache_hit_rate"`
+	Retries            int       `json:"retries"`
+	RateLimitThrottles int       `json:"rate_limit_throttles"`
+	Error              string    `json:"error,omitempty"`
+}
+
+// Values returns the record's numeric metrics keyed by their JSON names, in
+// the form reporting.PerformanceReporter.GenerateReport compares to a baseline
+func (m RunMetrics) Values() map[string]float64 {
+	return map[string]float64{
+		"duration_ms":          float64(m.DurationMs),
+		"targets":              float64(m.Targets),
+		"failed_targets":       float64(m.FailedTargets),
+		"pull_requests":        float64(m.PullRequests),
+		"api_calls":            float64(m.APICalls),
+		"api_calls_saved":      float64(m.APICallsSaved),
+		"files_processed":      float64(m.FilesProcessed),
+		"files_changed":        float64(m.FilesChanged),
+		"files_skipped":        float64(m.FilesSkipped),
+		"cache_hits":           float64(m.CacheHits),
+		"cache_misses":         float64(m.CacheMisses),
+		"cache_hit_rate":       m.CacheHitRate,
+		"retries":              float64(m.Retries),
+		"rate_limit_throttles": float64(m.RateLimitThrottles),
+	}
+}
+
+// add sums a target's performance metrics into the run totals. prAction is
+// the target's PRAction* value, empty when no pull request was created or updated.
+func (m *RunMetrics) add(pm *PerformanceMetrics, prAction string, failed bool) {
+	m.Targets++
+	if failed {
+		m.FailedTargets++
+	}
+	if prAction != "" {
+		m.PullRequests++
+	}
+	if pm == nil {
+		return
+	}
+	m.APICalls += pm.TotalAPIRequests
+	m.APICallsSaved += pm.APICallsSaved
+	m.FilesProcessed += pm.FileMetrics.FilesProcessed
+	m.FilesChanged += pm.FileMetrics.FilesChanged
+	m.FilesSkipped += pm.FileMetrics.FilesSkipped
+	m.CacheHits += pm.CacheHits
+	m.CacheMisses += pm.CacheMisses
+	m.Retries += pm.Retries
+	m.RateLimitThrottles += pm.RateLimitThrottles
+}
+
+// startRunMetrics resets the run totals at the start of Sync
+func (e *Engine) startRunMetrics() {
+	if e.options.MetricsFile == "" {
+		return
+	}
+	run := e.shared()
+	run.runMetricsMu.Lock()
+	defer run.runMetricsMu.Unlock()
+	run.runMetrics = RunMetrics{StartedAt: time.Now(), DryRun: e.options.DryRun}
+}
+
+// recordRunMetrics adds a finished target to the run totals. Safe for
+// concurrent use by the target worker pool.
+func (e *Engine) recordRunMetrics(pm *PerformanceMetrics, prAction string, syncErr error) {
+	if e.options.MetricsFile == "" {
+		return
+	}
+	run := e.shared()
+	run.runMetricsMu.Lock()
+	defer run.runMetricsMu.Unlock()
+	run.runMetrics.add(pm, prAction, syncErr != nil)
+}
+
+// writeRunMetrics appends the run's record to Options.MetricsFile. It runs
+// when Sync returns, so failed runs are recorded with their error. Write
+// failures are logged and never fail the sync.
+func (e *Engine) writeRunMetrics(log *logrus.Entry, syncErr error) {
+	if e.options.MetricsFile == "" {
+		return
+	}
+	run := e.shared()
+	run.runMetricsMu.Lock()
+	record := run.runMetrics
+	run.runMetricsMu.Unlock()
+
+	record.EndedAt = time.Now()
+	record.DurationMs = record.EndedAt.Sub(record.StartedAt).Milliseconds()
+	if lookups := record.CacheHits + record.CacheMisses; lookups > 0 {
+		record.CacheHitRate = float64(record.CacheHits) / float64(lookups)
+	}
+	if syncErr != nil {
+		record.Error = syncErr.Error()
+	}
+
+	if err := appendJSONLine(e.options.MetricsFile, record); err != nil {
+		log.WithError(err).WithField("path", e.options.MetricsFile).Warn("Failed to write run metrics")
+	}
+}
+
+// appendJSONLine appends v to path as a single line of JSON and syncs the
+// file, creating it and its directory when missing
+func appendJSONLine(path string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create metrics directory: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) //nolint:gosec // path is operator-supplied
+	if err != nil {
+		return err
+	}
+	if _, err = file.Write(append(data, '\n')); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err = file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
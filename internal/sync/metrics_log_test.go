@@ -0,0 +1,108 @@
+package sync
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/state"
+	"github.com/mrz1836/go-broadcast/internal/transform"
+)
+
+var errTestRunFailed = errors.New("run failed")
+
+// readRunMetrics decodes every line of a metrics log
+func readRunMetrics(t *testing.T, path string) []RunMetrics {
+	t.Helper()
+	file, err := os.Open(path) //nolint:gosec // test-controlled path
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+
+	var records []RunMetrics
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record RunMetrics
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
+
+func TestEngine_RunMetricsFile(t *testing.T) {
+	metricsFile := filepath.Join(t.TempDir(), "metrics", "runs.jsonl")
+	group := config.Group{ID: "core", Source: config.SourceConfig{Repo: "org/template", Branch: "master"}}
+
+	engine := NewEngine(context.Background(), &config.Config{Groups: []config.Group{group}}, nil, nil,
+		&state.MockDiscoverer{}, &transform.MockChain{}, DefaultOptions().WithMetricsFile(metricsFile).WithDryRun(true))
+	engine.SetLogger(logrus.New())
+	log := logrus.NewEntry(engine.logger)
+
+	// Targets recorded by a per-group view are summed on the root engine
+	engine.startRunMetrics()
+	groupEngine := engine.forGroup(engine.config, &group)
+	groupEngine.recordRunMetrics(&PerformanceMetrics{
+		TotalAPIRequests: 4,
+		CacheHits:        3,
+		CacheMisses:      1,
+		Retries:          2,
+		FileMetrics:      FileProcessingMetrics{FilesProcessed: 5, FilesChanged: 2, FilesSkipped: 3},
+	}, PRActionCreated, nil)
+	groupEngine.recordRunMetrics(&PerformanceMetrics{TotalAPIRequests: 1, Retries: 1}, "", errTestRunFailed)
+	engine.writeRunMetrics(log, nil)
+
+	// A failed run is still appended, with its error
+	engine.startRunMetrics()
+	engine.writeRunMetrics(log, errTestRunFailed)
+
+	records := readRunMetrics(t, metricsFile)
+	require.Len(t, records, 2)
+
+	first := records[0]
+	assert.True(t, first.DryRun)
+	assert.Equal(t, 2, first.Targets)
+	assert.Equal(t, 1, first.FailedTargets)
+	assert.Equal(t, 1, first.PullRequests)
+	assert.Equal(t, 5, first.APICalls)
+	assert.Equal(t, 5, first.FilesProcessed)
+	assert.Equal(t, 2, first.FilesChanged)
+	assert.Equal(t, 3, first.FilesSkipped)
+	assert.Equal(t, 3, first.Retries)
+	assert.InDelta(t, 0.75, first.CacheHitRate, 0.0001)
+	assert.Empty(t, first.Error)
+	assert.False(t, first.EndedAt.Before(first.StartedAt))
+
+	second := records[1]
+	assert.Equal(t, 0, second.Targets)
+	assert.Equal(t, errTestRunFailed.Error(), second.Error)
+}
+
+func TestEngine_RunMetricsDisabled(t *testing.T) {
+	engine := NewEngine(context.Background(), &config.Config{}, nil, nil,
+		&state.MockDiscoverer{}, &transform.MockChain{}, DefaultOptions())
+	engine.SetLogger(logrus.New())
+
+	engine.startRunMetrics()
+	engine.recordRunMetrics(&PerformanceMetrics{TotalAPIRequests: 1}, PRActionUpdated, nil)
+	engine.writeRunMetrics(logrus.NewEntry(engine.logger), nil)
+
+	assert.Equal(t, RunMetrics{}, engine.shared().runMetrics)
+}
+
+func TestRunMetrics_Values(t *testing.T) {
+	values := RunMetrics{DurationMs: 1500, APICalls: 7, CacheHitRate: 0.5}.Values()
+
+	assert.InDelta(t, 1500.0, values["duration_ms"], 0)
+	assert.InDelta(t, 7.0, values["api_calls"], 0)
+	assert.InDelta(t, 0.5, values["cache_hit_rate"], 0)
+	assert.Contains(t, values, "retries")
+}
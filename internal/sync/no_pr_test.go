@@ -0,0 +1,48 @@
+package sync
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/state"
+)
+
+func TestRepositorySync_createSyncBranch_NoPR(t *testing.T) {
+	newRepoSync := func(noPR bool) *RepositorySync {
+		return &RepositorySync{
+			engine: &Engine{
+				config:  &config.Config{Groups: []config.Group{{ID: "core", Defaults: config.DefaultConfig{BranchPrefix: "chore/sync-files"}}}},
+				options: DefaultOptions().WithNoPR(noPR),
+			},
+			sourceState: &state.SourceState{LatestCommit: "abc123def456"},
+			logger:      logrus.NewEntry(logrus.New()),
+		}
+	}
+
+	branch := newRepoSync(true).createSyncBranch(context.Background())
+	assert.True(t, strings.HasSuffix(branch, "-abc123d"+state.NoPRBranchSuffix), branch)
+	assert.True(t, state.IsNoPRBranch(branch))
+
+	branch = newRepoSync(false).createSyncBranch(context.Background())
+	assert.True(t, strings.HasSuffix(branch, "-abc123d"), branch)
+	assert.False(t, state.IsNoPRBranch(branch))
+}
+
+func TestRepositorySync_needsSync_BranchOnly(t *testing.T) {
+	newRepoSync := func(noPR bool) *RepositorySync {
+		return &RepositorySync{
+			engine:      &Engine{options: DefaultOptions().WithNoPR(noPR)},
+			sourceState: &state.SourceState{Repo: "org/template", LatestCommit: "abc123"},
+			targetState: &state.TargetState{LastSyncCommit: "abc123", Status: state.StatusBranchOnly},
+			logger:      logrus.NewEntry(logrus.New()),
+		}
+	}
+
+	assert.True(t, newRepoSync(false).needsSync(context.Background()), "a later sync opens the missing PR")
+	assert.False(t, newRepoSync(true).needsSync(context.Background()), "--no-pr does not push the same commit again")
+}
@@ -1,6 +1,7 @@
 package sync
 
 import (
+	"runtime"
 	"time"
 
 	"github.com/mrz1836/go-broadcast/internal/config"
@@ -14,7 +15,14 @@ type Options struct {
 	// Force indicates whether to sync even if targets appear up-to-date
 	Force bool
 
-	// MaxConcurrency controls how many repositories can be synced simultaneously
+	// AllowEmptyCommit, together with Force, commits and opens or updates the
+	// sync PR even when the synced content is identical to the target, to
+	// re-trigger target CI. Without Force it has no effect.
+	AllowEmptyCommit bool
+
+	// MaxConcurrency bounds how many target repositories of a group are synced
+	// simultaneously; 1 syncs targets sequentially in configuration order.
+	// Groups run one at a time unless config max_parallel_groups allows more.
 	MaxConcurrency int
 
 	// UpdateExistingPRs indicates whether to update existing sync PRs
@@ -26,6 +34,14 @@ type Options struct {
 	// CleanupTempFiles indicates whether to clean up temporary files after sync
 	CleanupTempFiles bool
 
+	// TempDir is the base directory target working trees are cloned into.
+	// Empty uses the system temporary directory.
+	TempDir string
+
+	// KeepTempOnFailure keeps the working tree of a failed target for
+	// inspection and logs its path; successful targets are still cleaned up
+	KeepTempOnFailure bool
+
 	// GroupFilter specifies which groups to sync (by name or ID)
 	// Empty means sync all groups
 	GroupFilter []string
@@ -39,6 +55,24 @@ type Options struct {
 	// AutomergeLabels specifies the labels to add when automerge is enabled
 	AutomergeLabels []string
 
+	// AutomergeMethod is the merge method used when enabling auto-merge on
+	// created PRs ("merge", "squash", or "rebase"). Empty defers to the group
+	// defaults, then to config.DefaultAutomergeMethod.
+	AutomergeMethod string
+
+	// Draft creates every PR as a draft, overriding the pr_draft config
+	Draft bool
+
+	// PRLabels, PRAssignees and PRReviewers override the PR labels, assignees
+	// and reviewers resolved from configuration when non-empty
+	PRLabels    []string
+	PRAssignees []string
+	PRReviewers []string
+
+	// PRLabelsMode controls how the PR overrides combine with configuration:
+	// config.PRLabelsModeReplace (the default when empty) or config.PRLabelsModeMerge
+	PRLabelsMode string
+
 	// AIEnabled indicates whether AI text generation is enabled (master switch)
 	AIEnabled bool
 
@@ -51,6 +85,10 @@ type Options struct {
 	// ClearModuleCache indicates whether to clear the module version cache before sync
 	ClearModuleCache bool
 
+	// FailFast aborts the whole sync on the first target failure: in-flight
+	// targets are canceled, queued targets and remaining groups never start
+	FailFast bool
+
 	// RateLimitPreflightEnabled enables the pre-sync rate-limit gate
 	RateLimitPreflightEnabled bool
 
@@ -75,6 +113,133 @@ type Options struct {
 	// nil means the flag was not provided. The value must equal the resolved repo
 	// count; a boolean always-pass token is intentionally not accepted (Q7=A).
 	ConfirmScope *int
+
+	// StateCacheDir enables the on-disk state discovery cache in this
+	// directory. Empty disables the cache and discovers state on every run.
+	StateCacheDir string
+
+	// StateCacheTTL is how long a cached state is reused while its sources are
+	// unchanged. Zero uses state.DefaultStateCacheTTL.
+	StateCacheTTL time.Duration
+
+	// OutputDir, when set, receives one JSON result file per synced target
+	// plus a run-wide summary.json. Empty writes no artifacts.
+	OutputDir string
+
+	// MetricsFile, when set, gets one line-delimited JSON RunMetrics record
+	// appended per sync run. Empty writes no metrics log.
+	MetricsFile string
+
+	// ContentAwareSync skips a target whose source commit changed when the
+	// mapped and transformed content hashes the same as in its open sync PR
+	ContentAwareSync bool
+
+	// SigningKey, when set, is the key sync commits are signed with; the git
+	// client does the signing. Empty leaves commits unsigned.
+	SigningKey string
+
+	// SigningFormat is the SigningKey format: gpg (default) or ssh
+	SigningFormat string
+
+	// MetadataSecret, when set, is the HMAC key the PR metadata block is
+	// signed with, so status can detect hand-edited metadata. Empty leaves
+	// the block unsigned.
+	MetadataSecret []byte
+
+	// PRStagger is the minimum delay between opening two pull requests across
+	// all targets, spreading review notifications and target CI load. Zero
+	// opens pull requests as soon as they are ready.
+	PRStagger time.Duration
+
+	// PRStaggerJitter adds a random delay of up to this duration to each
+	// PRStagger gap
+	PRStaggerJitter time.Duration
+
+	// MaxPRs caps how many pull requests one run may create or update across
+	// all groups; the run aborts before exceeding it. Zero means unlimited.
+	MaxPRs int
+
+	// DryRunPlanFile, when set with DryRun, receives the full dry-run plan
+	// as JSON once the run finishes. Empty writes no plan.
+	DryRunPlanFile string
+
+	// CheckpointFile records the targets a run completed, keyed by source
+	// commit, so a rerun after an interruption skips them unless Force is
+	// set. It is removed once a run finishes without failures. Empty keeps
+	// no checkpoint.
+	CheckpointFile string
+
+	// PlanOnly runs as a dry run whose outcome is reported through
+	// Engine.PlanHasChanges, so callers can exit non-zero on pending changes
+	PlanOnly bool
+
+	// NoPR pushes the sync branch without creating or updating a pull
+	// request. Such branches are named with state.NoPRBranchSuffix so later
+	// runs do not delete them as orphans.
+	NoPR bool
+
+	// WaitForChecks, with Automerge, waits for the checks on the PR head to
+	// finish before enabling auto-merge. Failed checks fail the target.
+	WaitForChecks bool
+
+	// ChecksTimeout bounds the WaitForChecks wait per target. Zero uses
+	// DefaultChecksTimeout.
+	ChecksTimeout time.Duration
+
+	// SummaryOnly collects a SyncResult for every target, including those
+	// skipped as up to date, for Engine.SyncResults to report once the run ends
+	SummaryOnly bool
+
+	// Explain records why every target was or was not synced, for
+	// Engine.Explanations to report once the run ends. It never changes
+	// which targets are synced.
+	Explain bool
+
+	// LockDir, when set, holds a lock file per target while it is synced, so
+	// overlapping runs sharing the directory do not push over each other.
+	// A target locked by another run fails with ErrTargetLocked.
+	LockDir string
+
+	// LockRef, when set and LockDir is not, locks each target with this ref
+	// (such as refs/go-broadcast/lock) in the target repository instead, so
+	// runs on different hosts exclude each other. It needs push access.
+	LockRef string
+
+	// LockTTL is the age after which a lock left by a crashed run is
+	// reclaimed. Zero uses DefaultLockTTL.
+	LockTTL time.Duration
+
+	// CloseSupersededPRs closes the older open sync PRs of a target once a
+	// new sync PR is created for it, commenting with a link to the new PR,
+	// and deletes their branches
+	CloseSupersededPRs bool
+
+	// Interactive asks the operator to approve, skip or quit before each
+	// target's branch is pushed and its PR opened or updated. It needs a
+	// terminal; dry runs never prompt.
+	Interactive bool
+
+	// BinaryTransformPolicy decides what happens to a binary source file
+	// whose target has content transforms configured:
+	// config.BinaryTransformPolicySkip (the default when empty) syncs it
+	// untransformed with a warning, config.BinaryTransformPolicyFail fails
+	// the target with ErrBinaryTransform
+	BinaryTransformPolicy string
+
+	// TargetTimeout bounds each target's whole sync. A target running
+	// longer fails with ErrTargetTimeout while the run moves on to the
+	// other targets, unless FailFast is set. Zero means no limit.
+	TargetTimeout time.Duration
+
+	// OperationTimeouts bounds the clone, push and PR operations of each
+	// target separately; an operation running longer fails the target with
+	// ErrOperationTimeout
+	OperationTimeouts OperationTimeouts
+
+	// SourceStatusIssue is the issue or pull request number in each source
+	// repository that receives a comment summarizing the run's targets.
+	// The same comment is updated by every later run. Zero disables it.
+	SourceStatusIssue int
 }
 
 // DefaultOptions returns the default sync options
@@ -82,7 +247,7 @@ func DefaultOptions() *Options {
 	return &Options{
 		DryRun:                        false,
 		Force:                         false,
-		MaxConcurrency:                5,
+		MaxConcurrency:                runtime.NumCPU(),
 		UpdateExistingPRs:             true,
 		Timeout:                       10 * time.Minute,
 		CleanupTempFiles:              true,
@@ -104,6 +269,17 @@ func (o *Options) WithForce(force bool) *Options {
 	return o
 }
 
+// WithAllowEmptyCommit sets whether a forced sync may create an empty commit
+func (o *Options) WithAllowEmptyCommit(allow bool) *Options {
+	o.AllowEmptyCommit = allow
+	return o
+}
+
+// emptyResync reports whether a sync without content changes still commits
+func (o *Options) emptyResync() bool {
+	return o.Force && o.AllowEmptyCommit
+}
+
 // WithMaxConcurrency sets the maximum concurrency
 func (o *Options) WithMaxConcurrency(maxConcurrency int) *Options {
 	if maxConcurrency <= 0 {
@@ -119,6 +295,20 @@ func (o *Options) WithTimeout(timeout time.Duration) *Options {
 	return o
 }
 
+// WithTempDir sets the base directory for target working trees
+func (o *Options) WithTempDir(dir string) *Options {
+	o.TempDir = dir
+	return o
+}
+
+// WithKeepTemp sets whether target working trees are kept after every sync
+// (always) or only after a failed one (onFailure)
+func (o *Options) WithKeepTemp(always, onFailure bool) *Options {
+	o.CleanupTempFiles = !always
+	o.KeepTempOnFailure = onFailure
+	return o
+}
+
 // WithGroupFilter sets the groups to sync
 func (o *Options) WithGroupFilter(groups []string) *Options {
 	o.GroupFilter = groups
@@ -143,6 +333,42 @@ func (o *Options) WithAutomergeLabels(labels []string) *Options {
 	return o
 }
 
+// WithAutomergeMethod sets the merge method used when enabling auto-merge
+func (o *Options) WithAutomergeMethod(method string) *Options {
+	o.AutomergeMethod = method
+	return o
+}
+
+// WithDraft sets whether created PRs are drafts
+func (o *Options) WithDraft(draft bool) *Options {
+	o.Draft = draft
+	return o
+}
+
+// WithPRLabels sets the PR labels that override configuration
+func (o *Options) WithPRLabels(labels []string) *Options {
+	o.PRLabels = labels
+	return o
+}
+
+// WithPRAssignees sets the PR assignees that override configuration
+func (o *Options) WithPRAssignees(assignees []string) *Options {
+	o.PRAssignees = assignees
+	return o
+}
+
+// WithPRReviewers sets the PR reviewers that override configuration
+func (o *Options) WithPRReviewers(reviewers []string) *Options {
+	o.PRReviewers = reviewers
+	return o
+}
+
+// WithPRLabelsMode sets how the PR overrides combine with configuration
+func (o *Options) WithPRLabelsMode(mode string) *Options {
+	o.PRLabelsMode = mode
+	return o
+}
+
 // WithAIEnabled sets the AI generation master switch
 func (o *Options) WithAIEnabled(enabled bool) *Options {
 	o.AIEnabled = enabled
@@ -167,6 +393,12 @@ func (o *Options) WithClearModuleCache(enabled bool) *Options {
 	return o
 }
 
+// WithFailFast sets whether to abort the sync on the first target failure
+func (o *Options) WithFailFast(failFast bool) *Options {
+	o.FailFast = failFast
+	return o
+}
+
 // WithRateLimitPreflight enables or disables the pre-sync rate-limit gate
 func (o *Options) WithRateLimitPreflight(enabled bool) *Options {
 	o.RateLimitPreflightEnabled = enabled
@@ -201,3 +433,157 @@ func (o *Options) WithConfirmScope(n *int) *Options {
 	o.ConfirmScope = n
 	return o
 }
+
+// WithStateCache enables the on-disk state discovery cache in dir with the
+// given TTL. An empty dir disables the cache.
+func (o *Options) WithStateCache(dir string, ttl time.Duration) *Options {
+	o.StateCacheDir = dir
+	o.StateCacheTTL = ttl
+	return o
+}
+
+// WithOutputDir sets the directory that receives per-target JSON result artifacts
+func (o *Options) WithOutputDir(dir string) *Options {
+	o.OutputDir = dir
+	return o
+}
+
+// WithMetricsFile sets the file that gets a RunMetrics record appended per run
+func (o *Options) WithMetricsFile(path string) *Options {
+	o.MetricsFile = path
+	return o
+}
+
+// WithCommitSigning sets the key and format sync commits are signed with
+func (o *Options) WithCommitSigning(key, format string) *Options {
+	o.SigningKey = key
+	o.SigningFormat = format
+	return o
+}
+
+// WithMetadataSecret sets the key the PR metadata block is signed with
+func (o *Options) WithMetadataSecret(secret []byte) *Options {
+	o.MetadataSecret = secret
+	return o
+}
+
+// WithPRStagger sets the delay between pull request creations and its
+// random jitter. Negative values are treated as zero.
+func (o *Options) WithPRStagger(delay, jitter time.Duration) *Options {
+	o.PRStagger = max(delay, 0)
+	o.PRStaggerJitter = max(jitter, 0)
+	return o
+}
+
+// WithMaxPRs sets the run-wide pull request limit; zero or less is unlimited
+func (o *Options) WithMaxPRs(maxPRs int) *Options {
+	o.MaxPRs = max(maxPRs, 0)
+	return o
+}
+
+// WithDryRunPlanFile sets the file that receives the JSON dry-run plan
+func (o *Options) WithDryRunPlanFile(path string) *Options {
+	o.DryRunPlanFile = path
+	return o
+}
+
+// WithCheckpointFile sets the file that records completed targets for resuming
+func (o *Options) WithCheckpointFile(path string) *Options {
+	o.CheckpointFile = path
+	return o
+}
+
+// WithNoPR sets whether sync branches are pushed without a pull request
+func (o *Options) WithNoPR(noPR bool) *Options {
+	o.NoPR = noPR
+	return o
+}
+
+// WithWaitForChecks sets whether to wait for PR checks before enabling
+// auto-merge, and how long to wait. A timeout of zero or less uses
+// DefaultChecksTimeout.
+func (o *Options) WithWaitForChecks(wait bool, timeout time.Duration) *Options {
+	o.WaitForChecks = wait
+	o.ChecksTimeout = max(timeout, 0)
+	return o
+}
+
+// WithSummaryOnly sets whether per-target results are collected for a final summary
+func (o *Options) WithSummaryOnly(summaryOnly bool) *Options {
+	o.SummaryOnly = summaryOnly
+	return o
+}
+
+// WithExplain sets whether the reason for each target's sync decision is recorded
+func (o *Options) WithExplain(explain bool) *Options {
+	o.Explain = explain
+	return o
+}
+
+// WithTargetLock sets how targets are locked while synced: with lock files
+// in dir, or else with the ref in each target repository. Locks older than
+// ttl are reclaimed; zero or less uses DefaultLockTTL.
+func (o *Options) WithTargetLock(dir, ref string, ttl time.Duration) *Options {
+	o.LockDir = dir
+	o.LockRef = ref
+	o.LockTTL = max(ttl, 0)
+	return o
+}
+
+// WithCloseSupersededPRs sets whether a new sync PR closes the older ones
+func (o *Options) WithCloseSupersededPRs(closeSuperseded bool) *Options {
+	o.CloseSupersededPRs = closeSuperseded
+	return o
+}
+
+// WithInteractive sets whether each target's PR waits for the operator's approval
+func (o *Options) WithInteractive(interactive bool) *Options {
+	o.Interactive = interactive
+	return o
+}
+
+// WithBinaryTransformPolicy sets how binary files with transforms configured are handled
+func (o *Options) WithBinaryTransformPolicy(policy string) *Options {
+	o.BinaryTransformPolicy = policy
+	return o
+}
+
+// WithTargetTimeout sets the limit on each target's sync. Zero or less
+// means no limit.
+func (o *Options) WithTargetTimeout(timeout time.Duration) *Options {
+	o.TargetTimeout = max(timeout, 0)
+	return o
+}
+
+// WithOperationTimeouts sets the limits on the clone, push and PR
+// operations of each target. Zero or less leaves an operation unlimited.
+func (o *Options) WithOperationTimeouts(timeouts OperationTimeouts) *Options {
+	o.OperationTimeouts = OperationTimeouts{
+		Clone:    max(timeouts.Clone, 0),
+		Push:     max(timeouts.Push, 0),
+		PRCreate: max(timeouts.PRCreate, 0),
+	}
+	return o
+}
+
+// WithSourceStatusIssue sets the source repository issue whose comment tracks
+// the broadcast status. Zero or less disables the comment.
+func (o *Options) WithSourceStatusIssue(number int) *Options {
+	o.SourceStatusIssue = max(number, 0)
+	return o
+}
+
+// WithPlanOnly sets the plan-only option. Enabling it also enables DryRun.
+func (o *Options) WithPlanOnly(planOnly bool) *Options {
+	o.PlanOnly = planOnly
+	if planOnly {
+		o.DryRun = true
+	}
+	return o
+}
+
+// WithContentAwareSync sets whether unchanged mapped content skips a target
+func (o *Options) WithContentAwareSync(enabled bool) *Options {
+	o.ContentAwareSync = enabled
+	return o
+}
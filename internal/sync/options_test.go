@@ -1,6 +1,7 @@
 package sync
 
 import (
+	"runtime"
 	"testing"
 	"time"
 
@@ -13,7 +14,7 @@ func TestDefaultOptions(t *testing.T) {
 	assert.NotNil(t, opts)
 	assert.False(t, opts.DryRun)
 	assert.False(t, opts.Force)
-	assert.Equal(t, 5, opts.MaxConcurrency)
+	assert.Equal(t, runtime.NumCPU(), opts.MaxConcurrency)
 	assert.True(t, opts.UpdateExistingPRs)
 	assert.Equal(t, 10*time.Minute, opts.Timeout)
 	assert.True(t, opts.CleanupTempFiles)
@@ -68,6 +69,25 @@ func TestOptionsWithDryRun(t *testing.T) {
 	assert.False(t, opts.DryRun)
 }
 
+func TestOptionsWithPlanOnly(t *testing.T) {
+	opts := DefaultOptions().WithPlanOnly(true)
+	assert.True(t, opts.PlanOnly)
+	assert.True(t, opts.DryRun, "plan-only implies dry-run")
+
+	opts = DefaultOptions().WithDryRun(true).WithPlanOnly(false)
+	assert.False(t, opts.PlanOnly)
+	assert.True(t, opts.DryRun, "disabling plan-only leaves dry-run alone")
+}
+
+func TestOptionsWithNoPR(t *testing.T) {
+	opts := DefaultOptions()
+	assert.False(t, opts.NoPR)
+
+	opts = opts.WithNoPR(true)
+	assert.True(t, opts.NoPR)
+	assert.False(t, opts.DryRun)
+}
+
 func TestOptionsWithForce(t *testing.T) {
 	opts := DefaultOptions().WithForce(true)
 
@@ -111,6 +131,14 @@ func TestOptionsWithAutomerge(t *testing.T) {
 	assert.False(t, opts.Automerge)
 }
 
+func TestOptionsWithAutomergeMethod(t *testing.T) {
+	opts := DefaultOptions()
+	assert.Empty(t, opts.AutomergeMethod, "empty method defers to config defaults")
+
+	opts = opts.WithAutomergeMethod("rebase")
+	assert.Equal(t, "rebase", opts.AutomergeMethod)
+}
+
 func TestOptionsWithAutomergeLabels(t *testing.T) {
 	labels := []string{"automerge", "ready-to-merge"}
 	opts := DefaultOptions().WithAutomergeLabels(labels)
@@ -163,3 +191,14 @@ func TestOptionsChaining(t *testing.T) {
 	assert.Equal(t, groups, opts.GroupFilter)
 	assert.Equal(t, []string{"experimental"}, opts.SkipGroups)
 }
+
+func TestOptionsWithTempDirAndKeepTemp(t *testing.T) {
+	opts := DefaultOptions().WithTempDir("/var/tmp/broadcast").WithKeepTemp(false, true)
+	assert.Equal(t, "/var/tmp/broadcast", opts.TempDir)
+	assert.True(t, opts.CleanupTempFiles)
+	assert.True(t, opts.KeepTempOnFailure)
+
+	opts.WithKeepTemp(true, false)
+	assert.False(t, opts.CleanupTempFiles)
+	assert.False(t, opts.KeepTempOnFailure)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -31,6 +33,7 @@ type GroupOrchestrator struct {
 	engine       *Engine
 	logger       *logrus.Logger
 	groupStatus  map[string]GroupStatus                              // Track group execution status by group ID
+	statusMu     sync.RWMutex                                        // Protects groupStatus when groups run in parallel
 	executeGroup func(ctx context.Context, group config.Group) error // Function field for testing
 }
 
@@ -74,6 +77,17 @@ func (o *GroupOrchestrator) ExecuteGroups(ctx context.Context, groups []config.G
 		return nil
 	}
 
+	// With group parallelism enabled, run independent groups of the same
+	// dependency level concurrently
+	if limit := o.maxParallelGroups(); limit > 1 {
+		levels, err := o.resolveDependencyLevels(enabledGroups)
+		if err != nil {
+			return fmt.Errorf("failed to resolve dependencies: %w", err)
+		}
+		o.initializeGroupStatus(enabledGroups)
+		return o.executeLevels(ctx, levels, limit)
+	}
+
 	// Resolve dependencies and get execution order
 	executionOrder, err := o.resolveDependencies(enabledGroups)
 	if err != nil {
@@ -85,7 +99,7 @@ func (o *GroupOrchestrator) ExecuteGroups(ctx context.Context, groups []config.G
 
 	// Execute groups in resolved order
 	var hasFailures bool
-	for _, group := range executionOrder {
+	for i, group := range executionOrder {
 		// Check context cancellation
 		select {
 		case <-ctx.Done():
@@ -96,57 +110,235 @@ func (o *GroupOrchestrator) ExecuteGroups(ctx context.Context, groups []config.G
 
 		// Check if dependencies completed successfully
 		if !o.areDependenciesSatisfied(group) {
-			o.logger.WithField("group_id", group.ID).Info("Skipping group due to failed dependencies")
-			o.groupStatus[group.ID] = GroupStatus{
-				State:   "skipped",
-				Message: "Dependencies failed",
+			o.skipForFailedDependencies(group)
+			continue
+		}
+
+		if err := o.runGroup(ctx, group); err != nil {
+			hasFailures = true
+
+			if o.abortsRun(err) {
+				o.skipGroups(executionOrder[i+1:], abortReason(err))
+				return o.abortError(group, err)
+			}
+			// Continue with groups that don't depend on this one
+		}
+	}
+
+	// Report final status
+	return o.reportFinalStatus(hasFailures)
+}
+
+// groupFailure records the group whose failure aborted a fail-fast run
+type groupFailure struct {
+	group config.Group
+	err   error
+}
+
+// executeLevels runs dependency levels in order, syncing up to limit groups of
+// a level at once. A failed group only affects its dependents, which are
+// skipped in later levels; its siblings in the same level keep running.
+func (o *GroupOrchestrator) executeLevels(ctx context.Context, levels [][]config.Group, limit int) error {
+	var hasFailures bool
+	for i, level := range levels {
+		if ctx.Err() != nil {
+			o.logger.Info("Context canceled, stopping group execution")
+			return ctx.Err()
+		}
+
+		levelFailed, abortCause := o.executeLevel(ctx, level, limit)
+		hasFailures = hasFailures || levelFailed
+
+		if abortCause != nil {
+			for _, remaining := range levels[i+1:] {
+				o.skipGroups(remaining, abortReason(abortCause.err))
 			}
+			return o.abortError(abortCause.group, abortCause.err)
+		}
+	}
+
+	if ctx.Err() != nil {
+		o.logger.Info("Context canceled, stopping group execution")
+		return ctx.Err()
+	}
+
+	return o.reportFinalStatus(hasFailures)
+}
+
+// executeLevel syncs the groups of one dependency level on a pool of at most
+// limit workers and reports whether any of them failed. With fail-fast, the
+// first failure cancels the groups still running, marks the groups not yet
+// started as skipped, and is returned as abortCause.
+func (o *GroupOrchestrator) executeLevel(ctx context.Context, level []config.Group, limit int) (hasFailures bool, abortCause *groupFailure) {
+	levelCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex // Protects hasFailures and abortCause
+	)
+	slots := make(chan struct{}, limit)
+
+	for _, group := range level {
+		if !o.areDependenciesSatisfied(group) {
+			o.skipForFailedDependencies(group)
 			continue
 		}
 
-		// Enhanced group start message with visual separation
-		o.logger.WithFields(logrus.Fields{
-			"group_name": group.Name,
-			"group_id":   group.ID,
-			"priority":   group.Priority,
-			"depends_on": group.DependsOn,
-		}).Info("━━━ Starting group sync ━━━")
+		slots <- struct{}{}
 
-		o.groupStatus[group.ID] = GroupStatus{
-			State:     "running",
-			StartTime: time.Now(),
+		mu.Lock()
+		aborted := abortCause != nil
+		mu.Unlock()
+		if aborted {
+			<-slots
+			o.skipGroups([]config.Group{group}, abortReason(abortCause.err))
+			continue
+		}
+		if ctx.Err() != nil {
+			<-slots
+			break
 		}
 
-		// Execute the group
-		if err := o.executeGroup(ctx, group); err != nil {
-			o.groupStatus[group.ID] = GroupStatus{
-				State:     "failed",
-				EndTime:   time.Now(),
-				Error:     err,
-				StartTime: o.groupStatus[group.ID].StartTime,
+		wg.Add(1)
+		go func(group config.Group) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			err := o.runGroup(levelCtx, group)
+			if err == nil {
+				return
 			}
-			o.logger.WithError(err).WithFields(logrus.Fields{
-				"group_id":   group.ID,
-				"group_name": group.Name,
-			}).Error("━━━ Group sync failed ━━━")
+
+			mu.Lock()
+			defer mu.Unlock()
 			hasFailures = true
-			// Continue with groups that don't depend on this one
-		} else {
-			o.groupStatus[group.ID] = GroupStatus{
-				State:     "success",
-				EndTime:   time.Now(),
-				StartTime: o.groupStatus[group.ID].StartTime,
+			if o.abortsRun(err) && abortCause == nil {
+				abortCause = &groupFailure{group: group, err: err}
+				cancel()
 			}
-			o.logger.WithFields(logrus.Fields{
-				"group_id":   group.ID,
-				"group_name": group.Name,
-				"duration":   time.Since(o.groupStatus[group.ID].StartTime),
-			}).Info("━━━ Group sync completed successfully ━━━")
+		}(group)
+	}
+
+	wg.Wait()
+	return hasFailures, abortCause
+}
+
+// runGroup executes a single group and records its outcome in the group status
+func (o *GroupOrchestrator) runGroup(ctx context.Context, group config.Group) error {
+	// Enhanced group start message with visual separation
+	o.logger.WithFields(logrus.Fields{
+		"group_name": group.Name,
+		"group_id":   group.ID,
+		"priority":   group.Priority,
+		"depends_on": group.DependsOn,
+	}).Info("━━━ Starting group sync ━━━")
+
+	startTime := time.Now()
+	o.setGroupStatus(group.ID, GroupStatus{
+		State:     "running",
+		StartTime: startTime,
+	})
+
+	// Execute the group
+	if err := o.executeGroup(ctx, group); err != nil {
+		o.setGroupStatus(group.ID, GroupStatus{
+			State:     "failed",
+			EndTime:   time.Now(),
+			Error:     err,
+			StartTime: startTime,
+		})
+		o.logger.WithError(err).WithFields(logrus.Fields{
+			"group_id":   group.ID,
+			"group_name": group.Name,
+		}).Error("━━━ Group sync failed ━━━")
+		return err
+	}
+
+	o.setGroupStatus(group.ID, GroupStatus{
+		State:     "success",
+		EndTime:   time.Now(),
+		StartTime: startTime,
+	})
+	o.logger.WithFields(logrus.Fields{
+		"group_id":   group.ID,
+		"group_name": group.Name,
+		"duration":   time.Since(startTime),
+	}).Info("━━━ Group sync completed successfully ━━━")
+	return nil
+}
+
+// abortError reports the final status and wraps the error of the group that
+// aborted the run
+func (o *GroupOrchestrator) abortError(group config.Group, err error) error {
+	_ = o.reportFinalStatus(true)
+	if !errors.Is(err, ErrFailFastAborted) && !errors.Is(err, ErrMaxPRsExceeded) {
+		err = fmt.Errorf("%w: %w", ErrFailFastAborted, err)
+	}
+	return fmt.Errorf("group %s: %w", group.ID, err)
+}
+
+// maxParallelGroups returns the configured group parallelism limit
+func (o *GroupOrchestrator) maxParallelGroups() int {
+	if o.config == nil {
+		return 0
+	}
+	return o.config.MaxParallelGroups
+}
+
+// abortsRun reports whether a group failure stops the remaining groups
+func (o *GroupOrchestrator) abortsRun(err error) bool {
+	return o.engine != nil && o.engine.abortsRun(err)
+}
+
+// skipGroups marks groups that will not run as skipped with the given reason
+func (o *GroupOrchestrator) skipGroups(groups []config.Group, reason string) {
+	for _, group := range groups {
+		o.logger.WithField("group_id", group.ID).Info("Skipping group: " + reason)
+		o.explainGroup(group, reason)
+		o.setGroupStatus(group.ID, GroupStatus{
+			State:   "skipped",
+			Message: reason,
+		})
+	}
+}
+
+// skipForFailedDependencies marks a group whose dependencies did not succeed as skipped
+func (o *GroupOrchestrator) skipForFailedDependencies(group config.Group) {
+	o.logger.WithField("group_id", group.ID).Info("Skipping group due to failed dependencies")
+	o.explainGroup(group, o.failedDependencyReason(group))
+	o.setGroupStatus(group.ID, GroupStatus{
+		State:   "skipped",
+		Message: "Dependencies failed",
+	})
+}
+
+// failedDependencyReason names the dependencies of group that did not succeed
+func (o *GroupOrchestrator) failedDependencyReason(group config.Group) string {
+	o.statusMu.RLock()
+	defer o.statusMu.RUnlock()
+
+	var failed []string
+	for _, depID := range group.DependsOn {
+		if status, exists := o.groupStatus[depID]; exists && status.State != "success" {
+			failed = append(failed, fmt.Sprintf("%s (%s)", depID, status.State))
 		}
 	}
+	return "dependency did not succeed: " + strings.Join(failed, ", ")
+}
 
-	// Report final status
-	return o.reportFinalStatus(hasFailures)
+// explainGroup records why no target of group was synced
+func (o *GroupOrchestrator) explainGroup(group config.Group, reason string) {
+	if o.engine != nil {
+		o.engine.explainGroup(group, reason)
+	}
+}
+
+// setGroupStatus records a group's status (thread-safe)
+func (o *GroupOrchestrator) setGroupStatus(groupID string, status GroupStatus) {
+	o.statusMu.Lock()
+	defer o.statusMu.Unlock()
+	o.groupStatus[groupID] = status
 }
 
 // filterEnabledGroups returns only enabled groups
@@ -158,6 +350,7 @@ func (o *GroupOrchestrator) filterEnabledGroups(groups []config.Group) []config.
 			enabled = append(enabled, group)
 		} else {
 			o.logger.WithField("group_id", group.ID).Debug("Group is disabled, skipping")
+			o.explainGroup(group, "group is disabled")
 		}
 	}
 	return enabled
@@ -175,11 +368,12 @@ func (o *GroupOrchestrator) filterGroupsByOptions(groups []config.Group, options
 		// Check if group should be skipped
 		shouldSkip := false
 		for _, skipPattern := range options.SkipGroups {
-			if group.Name == skipPattern || group.ID == skipPattern {
+			if matchesGroupPattern(group, []string{skipPattern}) {
 				o.logger.WithFields(logrus.Fields{
 					"group_name": group.Name,
 					"group_id":   group.ID,
 				}).Debug("Group matches skip pattern, excluding from sync")
+				o.explainGroup(group, "group excluded by --skip-groups")
 				shouldSkip = true
 				break
 			}
@@ -192,7 +386,7 @@ func (o *GroupOrchestrator) filterGroupsByOptions(groups []config.Group, options
 		if len(options.GroupFilter) > 0 {
 			matchesFilter := false
 			for _, filterPattern := range options.GroupFilter {
-				if group.Name == filterPattern || group.ID == filterPattern {
+				if matchesGroupPattern(group, []string{filterPattern}) {
 					matchesFilter = true
 					break
 				}
@@ -202,6 +396,7 @@ func (o *GroupOrchestrator) filterGroupsByOptions(groups []config.Group, options
 					"group_name": group.Name,
 					"group_id":   group.ID,
 				}).Debug("Group doesn't match filter pattern, excluding from sync")
+				o.explainGroup(group, "group not selected by --groups")
 				continue
 			}
 		}
@@ -231,6 +426,16 @@ func (o *GroupOrchestrator) resolveDependencies(groups []config.Group) ([]config
 	return executionOrder, nil
 }
 
+// resolveDependencyLevels resolves dependencies and returns the groups
+// partitioned into dependency levels
+func (o *GroupOrchestrator) resolveDependencyLevels(groups []config.Group) ([][]config.Group, error) {
+	resolver := NewDependencyResolver(o.logger)
+	for _, group := range groups {
+		resolver.AddGroup(group)
+	}
+	return resolver.ResolveLevels()
+}
+
 // initializeGroupStatus initializes status tracking for all groups
 func (o *GroupOrchestrator) initializeGroupStatus(groups []config.Group) {
 	for _, group := range groups {
@@ -242,6 +447,8 @@ func (o *GroupOrchestrator) initializeGroupStatus(groups []config.Group) {
 
 // areDependenciesSatisfied checks if all dependencies of a group completed successfully
 func (o *GroupOrchestrator) areDependenciesSatisfied(group config.Group) bool {
+	o.statusMu.RLock()
+	defer o.statusMu.RUnlock()
 	for _, depID := range group.DependsOn {
 		if status, exists := o.groupStatus[depID]; exists {
 			if status.State != "success" {
@@ -261,30 +468,24 @@ func (o *GroupOrchestrator) areDependenciesSatisfied(group config.Group) bool {
 
 // executeGroupImpl is the actual implementation of executing a single group's sync operations
 func (o *GroupOrchestrator) executeGroupImpl(ctx context.Context, group config.Group) error {
-	// Set the current group in the engine
-	o.engine.SetCurrentGroup(&group)
-	defer func() {
-		o.engine.SetCurrentGroup(nil)
-	}()
-
 	// Create a temporary config for this group
 	groupConfig := &config.Config{
-		Version: o.config.Version,
-		Name:    o.config.Name,
-		ID:      o.config.ID,
-		Groups:  []config.Group{group},
+		Version:  o.config.Version,
+		Name:     o.config.Name,
+		ID:       o.config.ID,
+		Provider: o.config.Provider,
+		Groups:   []config.Group{group},
+
+		GlobalExclude: o.config.GlobalExclude,
 	}
 
-	// Store original config and replace with group config
-	originalConfig := o.engine.config
-	o.engine.config = groupConfig
-	defer func() {
-		o.engine.config = originalConfig
-	}()
+	// Run the group on its own engine view so groups executing in parallel
+	// never share the current group or config
+	groupEngine := o.engine.forGroup(groupConfig, &group)
 
 	// Execute the sync for this group using the single group execution method
 	// Pass empty target filter since the group already has its targets defined
-	return o.engine.executeSingleGroup(ctx, group, []string{})
+	return groupEngine.executeSingleGroup(ctx, group, []string{})
 }
 
 // reportFinalStatus reports the final execution status
@@ -344,6 +545,8 @@ func (o *GroupOrchestrator) reportFinalStatus(hasFailures bool) error {
 
 // GetGroupStatus returns the status of all groups (for testing and monitoring)
 func (o *GroupOrchestrator) GetGroupStatus() map[string]GroupStatus {
+	o.statusMu.RLock()
+	defer o.statusMu.RUnlock()
 	// Return a copy to prevent external modification
 	statusCopy := make(map[string]GroupStatus)
 	for k, v := range o.groupStatus {
@@ -354,6 +557,8 @@ func (o *GroupOrchestrator) GetGroupStatus() map[string]GroupStatus {
 
 // GetGroupStatusByID returns the status of a specific group
 func (o *GroupOrchestrator) GetGroupStatusByID(groupID string) (GroupStatus, bool) {
+	o.statusMu.RLock()
+	defer o.statusMu.RUnlock()
 	status, exists := o.groupStatus[groupID]
 	return status, exists
 }
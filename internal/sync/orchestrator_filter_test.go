@@ -302,3 +302,23 @@ func TestFilterGroupsByOptions(t *testing.T) {
 		assert.Equal(t, "staging", filtered[1].Name)
 	})
 }
+
+// TestFilterGroupsByOptions_ExpandedGroups tests that filters naming a
+// multi-branch group match every group expanded from it
+func TestFilterGroupsByOptions_ExpandedGroups(t *testing.T) {
+	groups := config.ExpandSourceBranches([]config.Group{
+		{Name: "CI", ID: "ci", Source: config.SourceConfig{Branches: []string{"main", "release"}}},
+		{Name: "Docs", ID: "docs"},
+	})
+	o := &GroupOrchestrator{logger: logrus.New()}
+
+	filtered := o.filterGroupsByOptions(groups, &Options{GroupFilter: []string{"ci"}})
+	assert.Len(t, filtered, 2)
+
+	filtered = o.filterGroupsByOptions(groups, &Options{GroupFilter: []string{"ci-release"}})
+	assert.Len(t, filtered, 1)
+
+	filtered = o.filterGroupsByOptions(groups, &Options{SkipGroups: []string{"ci"}})
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "docs", filtered[0].ID)
+}
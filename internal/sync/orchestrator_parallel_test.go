@@ -0,0 +1,203 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// parallelGroupExecutor records group executions from concurrent workers
+type parallelGroupExecutor struct {
+	mu             sync.Mutex
+	started        []string
+	finished       map[string]time.Time
+	errorsToReturn map[string]error
+	delay          time.Duration
+	inFlight       atomic.Int32
+	peak           atomic.Int32
+}
+
+func (e *parallelGroupExecutor) executeGroup(ctx context.Context, group config.Group) error {
+	e.mu.Lock()
+	e.started = append(e.started, group.ID)
+	e.mu.Unlock()
+
+	current := e.inFlight.Add(1)
+	defer e.inFlight.Add(-1)
+	for {
+		peak := e.peak.Load()
+		if current <= peak || e.peak.CompareAndSwap(peak, current) {
+			break
+		}
+	}
+
+	select {
+	case <-time.After(e.delay):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.finished == nil {
+		e.finished = make(map[string]time.Time)
+	}
+	e.finished[group.ID] = time.Now()
+	return e.errorsToReturn[group.ID]
+}
+
+func newParallelOrchestrator(t *testing.T, maxParallel int, opts *Options) (*GroupOrchestrator, *parallelGroupExecutor) {
+	t.Helper()
+	if opts == nil {
+		opts = DefaultOptions()
+	}
+	cfg := &config.Config{Version: 1, MaxParallelGroups: maxParallel}
+	engine := &Engine{config: cfg, logger: logrus.New(), options: opts}
+
+	orch := NewGroupOrchestrator(cfg, engine, logrus.New())
+	executor := &parallelGroupExecutor{delay: 20 * time.Millisecond}
+	orch.executeGroup = executor.executeGroup
+	return orch, executor
+}
+
+func parallelTestGroup(id string, dependsOn ...string) config.Group {
+	return config.Group{
+		ID:        id,
+		Name:      id,
+		DependsOn: dependsOn,
+		Enabled:   boolPtr(true),
+		Source:    config.SourceConfig{Repo: "test/source"},
+		Targets:   []config.TargetConfig{{Repo: "test/target"}},
+	}
+}
+
+func TestGroupOrchestrator_Parallel_BoundedConcurrency(t *testing.T) {
+	orch, executor := newParallelOrchestrator(t, 2, nil)
+
+	groups := []config.Group{
+		parallelTestGroup("a"),
+		parallelTestGroup("b"),
+		parallelTestGroup("c"),
+		parallelTestGroup("d"),
+	}
+
+	require.NoError(t, orch.ExecuteGroups(context.Background(), groups))
+
+	assert.Equal(t, int32(2), executor.peak.Load(), "independent groups should run up to the limit")
+	assert.Len(t, executor.started, 4)
+	for id, status := range orch.GetGroupStatus() {
+		assert.Equal(t, "success", status.State, id)
+	}
+}
+
+func TestGroupOrchestrator_Parallel_RespectsDependencyLevels(t *testing.T) {
+	orch, executor := newParallelOrchestrator(t, 4, nil)
+
+	groups := []config.Group{
+		parallelTestGroup("deploy", "api", "web"),
+		parallelTestGroup("api", "base"),
+		parallelTestGroup("web", "base"),
+		parallelTestGroup("base"),
+	}
+
+	require.NoError(t, orch.ExecuteGroups(context.Background(), groups))
+
+	require.Len(t, executor.started, 4)
+	assert.Equal(t, "base", executor.started[0])
+	assert.ElementsMatch(t, []string{"api", "web"}, executor.started[1:3])
+	assert.Equal(t, "deploy", executor.started[3])
+
+	assert.True(t, executor.finished["api"].Before(executor.finished["deploy"]))
+	assert.True(t, executor.finished["web"].Before(executor.finished["deploy"]))
+}
+
+func TestGroupOrchestrator_Parallel_FailureIsolation(t *testing.T) {
+	orch, executor := newParallelOrchestrator(t, 2, nil)
+	executor.errorsToReturn = map[string]error{"a": ErrGroupFailed}
+
+	groups := []config.Group{
+		parallelTestGroup("a"),
+		parallelTestGroup("b"),
+		parallelTestGroup("after-a", "a"),
+		parallelTestGroup("after-b", "b"),
+	}
+
+	err := orch.ExecuteGroups(context.Background(), groups)
+	require.ErrorIs(t, err, ErrOrchestrationFailures)
+
+	statuses := orch.GetGroupStatus()
+	assert.Equal(t, "failed", statuses["a"].State)
+	assert.Equal(t, "success", statuses["b"].State, "sibling in the same level is unaffected")
+	assert.Equal(t, "skipped", statuses["after-a"].State)
+	assert.Equal(t, "Dependencies failed", statuses["after-a"].Message)
+	assert.Equal(t, "success", statuses["after-b"].State)
+}
+
+func TestGroupOrchestrator_Parallel_FailFast(t *testing.T) {
+	orch, executor := newParallelOrchestrator(t, 2, DefaultOptions().WithFailFast(true))
+	executor.delay = time.Minute // "b" only returns once fail-fast cancels it
+
+	// "a" fails immediately by short-circuiting its delay
+	orch.executeGroup = func(ctx context.Context, group config.Group) error {
+		if group.ID == "a" {
+			return ErrGroupFailed
+		}
+		return executor.executeGroup(ctx, group)
+	}
+
+	groups := []config.Group{
+		parallelTestGroup("a"),
+		parallelTestGroup("b"),
+		parallelTestGroup("c"),
+		parallelTestGroup("later", "b"),
+	}
+
+	err := orch.ExecuteGroups(context.Background(), groups)
+	require.ErrorIs(t, err, ErrFailFastAborted)
+	require.ErrorIs(t, err, ErrGroupFailed)
+	assert.Contains(t, err.Error(), "group a")
+
+	statuses := orch.GetGroupStatus()
+	assert.Equal(t, "failed", statuses["b"].State)
+	require.ErrorIs(t, statuses["b"].Error, context.Canceled)
+	assert.Equal(t, "skipped", statuses["c"].State)
+	assert.Equal(t, "Aborted by fail-fast", statuses["c"].Message)
+	assert.Equal(t, "skipped", statuses["later"].State)
+}
+
+func TestGroupOrchestrator_Parallel_DisabledBySequentialLimit(t *testing.T) {
+	for _, limit := range []int{0, 1} {
+		orch, executor := newParallelOrchestrator(t, limit, nil)
+
+		groups := []config.Group{parallelTestGroup("b"), parallelTestGroup("a"), parallelTestGroup("c")}
+		require.NoError(t, orch.ExecuteGroups(context.Background(), groups))
+
+		assert.Equal(t, int32(1), executor.peak.Load(), "limit %d runs groups one at a time", limit)
+		assert.Equal(t, []string{"a", "b", "c"}, executor.started)
+	}
+}
+
+func TestEngine_forGroup(t *testing.T) {
+	parent := &Engine{config: &config.Config{Version: 1}, logger: logrus.New(), options: DefaultOptions()}
+	group := config.Group{ID: "docs"}
+	groupConfig := &config.Config{Version: 1, Groups: []config.Group{group}}
+
+	view := parent.forGroup(groupConfig, &group)
+
+	assert.Same(t, groupConfig, view.config)
+	assert.Equal(t, "docs", view.GetCurrentGroup().ID)
+	assert.Nil(t, parent.GetCurrentGroup(), "parent engine state is untouched")
+	assert.Same(t, parent.options, view.Options())
+
+	view.recordDryRunTarget(FileProcessingMetrics{FilesChanged: 1}, []FileChange{{Path: "a"}})
+	assert.Equal(t, 1, parent.DryRunTotals().Targets, "dry-run totals roll up to the parent")
+	assert.Equal(t, parent.DryRunTotals(), view.DryRunTotals())
+}
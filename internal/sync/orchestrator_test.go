@@ -271,6 +271,55 @@ func TestGroupOrchestrator_ExecuteGroups_FailedDependency(t *testing.T) {
 	assert.Equal(t, "success", status3.State)
 }
 
+func TestGroupOrchestrator_ExecuteGroups_FailFast(t *testing.T) {
+	cfg := &config.Config{Version: 1}
+	engine := &Engine{
+		config:  cfg,
+		logger:  logrus.New(),
+		options: DefaultOptions().WithFailFast(true),
+	}
+
+	orch := NewGroupOrchestrator(cfg, engine, logrus.New())
+
+	executor := &testGroupExecutor{
+		errorsToReturn: map[string]error{
+			"group-1": ErrGroupFailed,
+		},
+	}
+	orch.executeGroup = executor.executeGroup
+
+	groups := []config.Group{
+		{
+			ID:       "group-1",
+			Name:     "Group 1",
+			Priority: 1,
+			Enabled:  boolPtr(true),
+			Source:   config.SourceConfig{Repo: "test/source"},
+			Targets:  []config.TargetConfig{{Repo: "test/target"}},
+		},
+		{
+			ID:       "group-2",
+			Name:     "Group 2",
+			Priority: 2,
+			Enabled:  boolPtr(true), // Independent, but fail-fast stops the run
+			Source:   config.SourceConfig{Repo: "test/source"},
+			Targets:  []config.TargetConfig{{Repo: "test/target"}},
+		},
+	}
+
+	err := orch.ExecuteGroups(context.Background(), groups)
+	require.Error(t, err)
+	require.ErrorIs(t, err, ErrFailFastAborted)
+	require.ErrorIs(t, err, ErrGroupFailed)
+	assert.Contains(t, err.Error(), "group group-1")
+
+	assert.Equal(t, []string{"group-1"}, executor.executedGroups)
+
+	status2, _ := orch.GetGroupStatusByID("group-2")
+	assert.Equal(t, "skipped", status2.State)
+	assert.Equal(t, "Aborted by fail-fast", status2.Message)
+}
+
 func TestGroupOrchestrator_ExecuteGroups_DisabledGroup(t *testing.T) {
 	cfg := &config.Config{Version: 1}
 	engine := &Engine{
@@ -0,0 +1,122 @@
+package sync
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// getPRFileChecklistMax returns how many files the changed file checklist
+// lists, taken from the group defaults or config.DefaultPRFileChecklistMax
+// when unset. An explicit zero disables the checklist.
+func (rs *RepositorySync) getPRFileChecklistMax() int {
+	if rs.engine == nil {
+		return config.DefaultPRFileChecklistMax
+	}
+
+	var configured *int
+	if currentGroup := rs.engine.GetCurrentGroup(); currentGroup != nil {
+		configured = currentGroup.Defaults.PRFileChecklistMax
+	} else if rs.engine.config != nil && len(rs.engine.config.Groups) > 0 {
+		configured = rs.engine.config.Groups[0].Defaults.PRFileChecklistMax
+	}
+
+	if configured != nil {
+		return *configured
+	}
+	return config.DefaultPRFileChecklistMax
+}
+
+// compareFilesURL returns the GitHub compare view of branchName against the
+// target's base branch, where a new PR's files can be linked before the PR
+// has a number. Other forges have no per-file anchors and get no links.
+func (rs *RepositorySync) compareFilesURL(branchName string) string {
+	if rs.engine == nil || rs.engine.provider() != config.ProviderGitHub {
+		return ""
+	}
+	head := rs.prHeadRef(branchName)
+	if rs.targetState != nil && rs.targetState.Branch != "" {
+		head = rs.targetState.Branch + "..." + head
+	}
+	return rs.engine.repoWebURL(rs.target.Repo) + "/compare/" + head
+}
+
+// pullRequestFilesURL returns the "Files changed" page of an existing PR on GitHub
+func (rs *RepositorySync) pullRequestFilesURL(number int) string {
+	if rs.engine == nil || rs.engine.provider() != config.ProviderGitHub {
+		return ""
+	}
+	return rs.engine.pullRequestURL(rs.target.Repo, number) + "/files"
+}
+
+// fileChangeType names the kind of change a FileChange makes
+func fileChangeType(change FileChange) string {
+	switch {
+	case change.IsDeleted:
+		return "deleted"
+	case change.IsNew:
+		return "new"
+	default:
+		return "modified"
+	}
+}
+
+// actualFileChanges returns the changes whose files actually changed in git,
+// or every change when actualChangedFiles is not known
+func actualFileChanges(changedFiles []FileChange, actualChangedFiles []string) []FileChange {
+	if actualChangedFiles == nil {
+		return changedFiles
+	}
+	actual := make(map[string]bool, len(actualChangedFiles))
+	for _, path := range actualChangedFiles {
+		actual[path] = true
+	}
+	files := make([]FileChange, 0, len(actualChangedFiles))
+	for _, change := range changedFiles {
+		if actual[change.Path] {
+			files = append(files, change)
+		}
+	}
+	return files
+}
+
+// fileDiffAnchor returns the anchor GitHub gives a file's diff on the
+// "Files changed" and compare pages
+func fileDiffAnchor(path string) string {
+	sum := sha256.Sum256([]byte(path))
+	return "#diff-" + hex.EncodeToString(sum[:])
+}
+
+// writeChangedFilesChecklist writes a collapsible checklist of the files the
+// sync changes with each file's change type, linked to its diff when
+// rs.changedFilesURL is set. Only files that actually changed in git are
+// listed when actualChangedFiles is known, and the list stops at the
+// configured maximum with a line counting the rest.
+func (rs *RepositorySync) writeChangedFilesChecklist(sb *strings.Builder, changedFiles []FileChange, actualChangedFiles []string) {
+	limit := rs.getPRFileChecklistMax()
+	if limit <= 0 {
+		return
+	}
+
+	files := actualFileChanges(changedFiles, actualChangedFiles)
+	if len(files) == 0 {
+		return
+	}
+
+	fmt.Fprintf(sb, "<details>\n<summary>Changed files (%d)</summary>\n\n", len(files))
+	for i, change := range files {
+		if i == limit {
+			fmt.Fprintf(sb, "- …and %d more\n", len(files)-limit)
+			break
+		}
+		entry := "`" + change.Path + "`"
+		if rs.changedFilesURL != "" {
+			entry = "[" + entry + "](" + rs.changedFilesURL + fileDiffAnchor(change.Path) + ")"
+		}
+		fmt.Fprintf(sb, "- [ ] %s (%s)\n", entry, fileChangeType(change))
+	}
+	sb.WriteString("\n</details>\n\n")
+}
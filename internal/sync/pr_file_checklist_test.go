@@ -0,0 +1,102 @@
+package sync
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/state"
+)
+
+// newChecklistRepoSync returns a RepositorySync for org/target with the given group defaults
+func newChecklistRepoSync(defaults config.DefaultConfig) *RepositorySync {
+	return &RepositorySync{
+		engine: &Engine{
+			config:  &config.Config{Groups: []config.Group{{Defaults: defaults}}},
+			logger:  logrus.New(),
+			options: DefaultOptions(),
+		},
+		target:      config.TargetConfig{Repo: "org/target"},
+		logger:      logrus.NewEntry(logrus.New()),
+		sourceState: &state.SourceState{Repo: "org/template", LatestCommit: "abc123"},
+		targetState: &state.TargetState{},
+	}
+}
+
+func TestWriteChangedFilesChecklist(t *testing.T) {
+	changes := []FileChange{
+		{Path: "README.md", IsNew: true},
+		{Path: "Makefile"},
+		{Path: "old.txt", IsDeleted: true},
+		{Path: "unchanged.yml"},
+	}
+	actual := []string{"README.md", "Makefile", "old.txt"}
+
+	t.Run("lists actual changes with type and link", func(t *testing.T) {
+		rs := newChecklistRepoSync(config.DefaultConfig{})
+		rs.changedFilesURL = rs.pullRequestFilesURL(7)
+
+		var sb strings.Builder
+		rs.writeChangedFilesChecklist(&sb, changes, actual)
+		body := sb.String()
+
+		assert.Contains(t, body, "<details>\n<summary>Changed files (3)</summary>\n\n")
+		assert.Contains(t, body, "- [ ] [`README.md`](https://github.com/org/target/pull/7/files"+fileDiffAnchor("README.md")+") (new)\n")
+		assert.Contains(t, body, "- [ ] [`Makefile`](https://github.com/org/target/pull/7/files"+fileDiffAnchor("Makefile")+") (modified)\n")
+		assert.Contains(t, body, "(deleted)\n")
+		assert.NotContains(t, body, "unchanged.yml")
+		assert.True(t, strings.HasSuffix(body, "</details>\n\n"))
+	})
+
+	t.Run("caps the list at the configured maximum", func(t *testing.T) {
+		rs := newChecklistRepoSync(config.DefaultConfig{PRFileChecklistMax: intPtr(1)})
+
+		var sb strings.Builder
+		rs.writeChangedFilesChecklist(&sb, changes, actual)
+		body := sb.String()
+
+		assert.Contains(t, body, "- [ ] `README.md` (new)\n- …and 2 more\n")
+		assert.NotContains(t, body, "Makefile")
+	})
+
+	t.Run("zero disables the checklist", func(t *testing.T) {
+		rs := newChecklistRepoSync(config.DefaultConfig{PRFileChecklistMax: intPtr(0)})
+
+		var sb strings.Builder
+		rs.writeChangedFilesChecklist(&sb, changes, actual)
+		assert.Empty(t, sb.String())
+	})
+
+	t.Run("no changes writes nothing", func(t *testing.T) {
+		rs := newChecklistRepoSync(config.DefaultConfig{})
+
+		var sb strings.Builder
+		rs.writeChangedFilesChecklist(&sb, changes, []string{})
+		assert.Empty(t, sb.String())
+	})
+}
+
+func TestChangedFilesURLs(t *testing.T) {
+	rs := newChecklistRepoSync(config.DefaultConfig{})
+	assert.Equal(t, "https://github.com/org/target/compare/chore/sync", rs.compareFilesURL("chore/sync"))
+
+	rs.targetState.Branch = "develop"
+	rs.target.Fork = "bot/target"
+	assert.Equal(t, "https://github.com/org/target/compare/develop...bot:chore/sync", rs.compareFilesURL("chore/sync"))
+
+	// Bitbucket has no per-file diff anchors
+	rs.engine.config.Provider = config.ProviderBitbucket
+	assert.Empty(t, rs.compareFilesURL("chore/sync"))
+	assert.Empty(t, rs.pullRequestFilesURL(7))
+}
+
+func TestGeneratePRBody_IncludesChangedFilesChecklist(t *testing.T) {
+	rs := newChecklistRepoSync(config.DefaultConfig{})
+
+	body, _ := rs.generatePRBody(t.Context(), "abc123", []FileChange{{Path: "README.md", IsNew: true}}, []string{"README.md"})
+	assert.Contains(t, body, "<summary>Changed files (1)</summary>")
+	assert.Contains(t, body, "- [ ] `README.md` (new)")
+}
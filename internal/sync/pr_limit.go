@@ -0,0 +1,55 @@
+package sync
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrMaxPRsExceeded indicates the run reached its MaxPRs limit and was stopped
+// before opening or updating another pull request
+var ErrMaxPRsExceeded = errors.New("pull request limit reached (--max-prs)")
+
+// reservePR claims one pull request from the run-wide MaxPRs budget before a
+// target's changes are committed and pushed. It returns ErrMaxPRsExceeded, stating how many
+// pull requests were already created or updated, when the budget is spent. It
+// is a no-op without MaxPRs or in dry-run mode.
+func (e *Engine) reservePR(repo string) error {
+	if e.options == nil || e.options.MaxPRs <= 0 || e.options.DryRun {
+		return nil
+	}
+	run := e.shared()
+	limit := int64(e.options.MaxPRs)
+	if n := run.prCount.Add(1); n > limit {
+		run.prCount.Add(-1)
+		return fmt.Errorf("%w: %d of %d pull request(s) already created or updated, not opening one for %s",
+			ErrMaxPRsExceeded, limit, limit, repo)
+	}
+	return nil
+}
+
+// releasePR returns a reservation taken by reservePR when the pull request
+// could not be created or updated
+func (e *Engine) releasePR() {
+	if e.options == nil || e.options.MaxPRs <= 0 || e.options.DryRun {
+		return
+	}
+	run := e.shared()
+	run.prCount.Add(-1)
+}
+
+// abortsRun reports whether a failure stops the remaining targets and groups:
+// any failure with FailFast, and reaching the MaxPRs limit in every run
+func (e *Engine) abortsRun(err error) bool {
+	if errors.Is(err, ErrMaxPRsExceeded) {
+		return true
+	}
+	return e.options != nil && e.options.FailFast
+}
+
+// abortReason is the status message of groups skipped after err aborted the run
+func abortReason(err error) string {
+	if errors.Is(err, ErrMaxPRsExceeded) {
+		return "Aborted: pull request limit reached"
+	}
+	return "Aborted by fail-fast"
+}
@@ -0,0 +1,150 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	gosync "sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/git"
+	"github.com/mrz1836/go-broadcast/internal/state"
+	"github.com/mrz1836/go-broadcast/internal/transform"
+)
+
+func TestEngine_ReservePR(t *testing.T) {
+	t.Run("unlimited and dry-run never refuse", func(t *testing.T) {
+		for _, opts := range []*Options{DefaultOptions(), DefaultOptions().WithMaxPRs(1).WithDryRun(true)} {
+			engine := &Engine{options: opts}
+			for i := range 5 {
+				require.NoError(t, engine.reservePR(fmt.Sprintf("org/repo-%d", i)))
+			}
+		}
+	})
+
+	t.Run("concurrent targets share one limit", func(t *testing.T) {
+		engine := &Engine{options: DefaultOptions().WithMaxPRs(5)}
+		groupA := engine.forGroup(&config.Config{}, &config.Group{ID: "a"})
+		groupB := engine.forGroup(&config.Config{}, &config.Group{ID: "b"})
+
+		var (
+			wg      gosync.WaitGroup
+			granted atomic.Int32
+		)
+		for i := range 40 {
+			view := groupA
+			if i%2 == 0 {
+				view = groupB
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if view.reservePR("org/repo") == nil {
+					granted.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, int32(5), granted.Load())
+	})
+
+	t.Run("error states how many were created", func(t *testing.T) {
+		engine := &Engine{options: DefaultOptions().WithMaxPRs(2)}
+		require.NoError(t, engine.reservePR("org/one"))
+		require.NoError(t, engine.reservePR("org/two"))
+
+		err := engine.reservePR("org/three")
+		require.ErrorIs(t, err, ErrMaxPRsExceeded)
+		assert.Contains(t, err.Error(), "2 of 2 pull request(s) already created or updated")
+		assert.Contains(t, err.Error(), "org/three")
+
+		engine.releasePR()
+		require.NoError(t, engine.reservePR("org/three"), "a failed PR frees its slot")
+	})
+}
+
+func TestRepositorySync_Execute_MaxPRs(t *testing.T) {
+	group := config.Group{
+		ID:     "core",
+		Source: config.SourceConfig{Repo: "org/template", Branch: "master"},
+		Targets: []config.TargetConfig{{
+			Repo:  "org/target",
+			Files: []config.FileMapping{{Src: "file.txt", Dest: "file.txt"}},
+		}},
+	}
+	currentState := &state.State{
+		Source: state.SourceState{Repo: "org/template", Branch: "master", LatestCommit: "abc123"},
+		Targets: map[string]*state.TargetState{
+			"org/target": {Repo: "org/target", LastSyncCommit: "old", Status: state.StatusBehind},
+		},
+	}
+	newEngine := func(gitClient *git.MockClient) *Engine {
+		ghClient := &gh.MockClient{}
+		ghClient.On("ListBranches", mock.Anything, mock.Anything).Return([]gh.Branch{}, nil).Maybe()
+		ghClient.On("GetFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, gh.ErrFileNotFound).Maybe()
+		engine := NewEngine(context.Background(), &config.Config{Groups: []config.Group{group}}, ghClient, gitClient,
+			&state.MockDiscoverer{}, &transform.MockChain{}, DefaultOptions().WithMaxPRs(1))
+		engine.SetLogger(logrus.New())
+		return engine
+	}
+
+	t.Run("limit reached before anything is cloned or pushed", func(t *testing.T) {
+		gitClient := &git.MockClient{}
+		engine := newEngine(gitClient)
+		require.NoError(t, engine.reservePR("org/other"))
+
+		groupEngine := engine.forGroup(engine.config, &group)
+		err := groupEngine.syncRepository(context.Background(), group.Targets[0], currentState, NewProgressTracker(1, false))
+		require.ErrorIs(t, err, ErrMaxPRsExceeded)
+		gitClient.AssertNotCalled(t, "Clone", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		gitClient.AssertNotCalled(t, "Push", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("failed target frees its slot", func(t *testing.T) {
+		gitClient := &git.MockClient{}
+		gitClient.On("Clone", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errGitCloneFailed)
+		engine := newEngine(gitClient)
+
+		groupEngine := engine.forGroup(engine.config, &group)
+		err := groupEngine.syncRepository(context.Background(), group.Targets[0], currentState, NewProgressTracker(1, false))
+		require.ErrorIs(t, err, errGitCloneFailed)
+		require.NoError(t, engine.reservePR("org/other"))
+	})
+}
+
+func TestGroupOrchestrator_ExecuteGroups_MaxPRsAborts(t *testing.T) {
+	cfg := &config.Config{Version: 1}
+	engine := &Engine{
+		config:  cfg,
+		logger:  logrus.New(),
+		options: DefaultOptions().WithMaxPRs(1),
+	}
+
+	orch := NewGroupOrchestrator(cfg, engine, logrus.New())
+	limitErr := fmt.Errorf("%w: 1 of 1 pull request(s) already created or updated", ErrMaxPRsExceeded)
+	executor := &testGroupExecutor{
+		errorsToReturn: map[string]error{"group-1": limitErr},
+	}
+	orch.executeGroup = executor.executeGroup
+
+	groups := []config.Group{
+		{ID: "group-1", Name: "Group 1", Priority: 1, Enabled: boolPtr(true), Targets: []config.TargetConfig{{Repo: "test/a"}}},
+		{ID: "group-2", Name: "Group 2", Priority: 2, Enabled: boolPtr(true), Targets: []config.TargetConfig{{Repo: "test/b"}}},
+	}
+
+	err := orch.ExecuteGroups(context.Background(), groups)
+	require.ErrorIs(t, err, ErrMaxPRsExceeded)
+	require.NotErrorIs(t, err, ErrFailFastAborted, "the limit aborts without --fail-fast")
+	assert.Equal(t, []string{"group-1"}, executor.executedGroups)
+
+	status2, _ := orch.GetGroupStatusByID("group-2")
+	assert.Equal(t, "skipped", status2.State)
+	assert.Equal(t, "Aborted: pull request limit reached", status2.Message)
+}
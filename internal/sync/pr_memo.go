@@ -0,0 +1,131 @@
+package sync
+
+import (
+	"slices"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// prSettings holds the PR labels and reviewers resolved for one target
+// configuration. The slices are shared between targets and must not be modified.
+type prSettings struct {
+	targetLists   [4][]string // Target PR settings the entry was resolved from
+	labels        []string
+	assignees     []string
+	reviewers     []string
+	teamReviewers []string
+}
+
+// prSettingsKey identifies the inputs of PR settings resolution that vary
+// between targets of one engine: the current group and a hash of the target's
+// own PR settings. Options and config are fixed for the engine's lifetime.
+type prSettingsKey struct {
+	group  *config.Group
+	target uint64
+}
+
+// prTargetLists returns the target fields PR settings resolution reads
+func prTargetLists(target config.TargetConfig) [4][]string {
+	return [4][]string{target.PRLabels, target.PRAssignees, target.PRReviewers, target.PRTeamReviewers}
+}
+
+// prTargetHash returns the FNV-1a hash of the target's PR settings, with list
+// and item boundaries included so ["a","b"] and ["a"],["b"] differ
+func prTargetHash(lists [4][]string) uint64 {
+	const (
+		offset64 = 14695981039346656037
+		prime64  = 1099511628211
+	)
+	hash := uint64(offset64)
+	mix := func(b byte) {
+		hash ^= uint64(b)
+		hash *= prime64
+	}
+	for _, list := range lists {
+		for _, item := range list {
+			for i := 0; i < len(item); i++ {
+				mix(item[i])
+			}
+			mix(0x1f)
+		}
+		mix(0)
+	}
+	return hash
+}
+
+// resolvedPRSettings returns the PR settings of this target. They are
+// resolved on the first call for the target's PR configuration and reused by
+// every later target of the engine with the same configuration, which avoids
+// repeating the merge work across large groups. Safe for concurrent use by
+// the target worker pool.
+func (rs *RepositorySync) resolvedPRSettings() *prSettings {
+	lists := prTargetLists(rs.target)
+	if rs.prSettings != nil && rs.prSettings.matches(lists) {
+		return rs.prSettings
+	}
+
+	run := rs.engine.shared()
+	key := prSettingsKey{group: rs.engine.GetCurrentGroup(), target: prTargetHash(lists)}
+
+	run.prSettingsMu.Lock()
+	defer run.prSettingsMu.Unlock()
+	if settings, ok := run.prSettings[key]; ok && settings.matches(lists) {
+		rs.prSettings = settings
+		return settings
+	}
+
+	settings := &prSettings{
+		targetLists:   lists,
+		labels:        slices.Clip(rs.resolvePRLabels()),
+		assignees:     slices.Clip(rs.resolvePRAssignees()),
+		reviewers:     slices.Clip(rs.resolvePRReviewers()),
+		teamReviewers: slices.Clip(rs.resolvePRTeamReviewers()),
+	}
+	if run.prSettings == nil {
+		run.prSettings = make(map[prSettingsKey]*prSettings)
+	}
+	// On a hash collision the newer configuration takes the slot
+	run.prSettings[key] = settings
+	rs.prSettings = settings
+	return settings
+}
+
+// matches reports whether the settings were resolved from these target lists
+func (s *prSettings) matches(lists [4][]string) bool {
+	for i := range lists {
+		if !slices.Equal(s.targetLists[i], lists[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// getPRLabels returns the labels to use for a PR making changes: the
+// configured labels plus the auto_labels matching the changed paths
+func (rs *RepositorySync) getPRLabels(changes []FileChange) []string {
+	labels := rs.resolvedPRSettings().labels
+	if matched := rs.autoLabels(changes); len(matched) > 0 {
+		return rs.mergeUniqueStrings(labels, matched)
+	}
+	return labels
+}
+
+// getPRAssignees returns the assignees to use for PRs
+func (rs *RepositorySync) getPRAssignees() []string {
+	return rs.resolvedPRSettings().assignees
+}
+
+// getPRReviewers returns the reviewers to use for PRs opened by author: the
+// configured reviewers plus those picked from the group's reviewer pool
+func (rs *RepositorySync) getPRReviewers(author string) []string {
+	reviewers := rs.resolvedPRSettings().reviewers
+	if picks := rs.poolReviewers(author); len(picks) > 0 {
+		return rs.mergeUniqueStrings(reviewers, picks)
+	}
+	return reviewers
+}
+
+// getPRTeamReviewers returns the team reviewers to use for PRs
+func (rs *RepositorySync) getPRTeamReviewers() []string {
+	return rs.resolvedPRSettings().teamReviewers
+}
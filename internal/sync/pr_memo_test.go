@@ -0,0 +1,147 @@
+package sync
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// newPRSettingsEngine returns an engine whose group sets every PR setting
+func newPRSettingsEngine() *Engine {
+	return &Engine{
+		config: &config.Config{Groups: []config.Group{{
+			Global: config.GlobalConfig{
+				PRLabels:    []string{"sync"},
+				PRReviewers: []string{"lead"},
+			},
+			Defaults: config.DefaultConfig{
+				PRAssignees:     []string{"owner"},
+				PRTeamReviewers: []string{"platform"},
+			},
+		}}},
+		options: DefaultOptions(),
+	}
+}
+
+func TestRepositorySync_resolvedPRSettings(t *testing.T) {
+	engine := newPRSettingsEngine()
+	newRS := func(target config.TargetConfig) *RepositorySync {
+		return &RepositorySync{engine: engine, target: target}
+	}
+
+	t.Run("targets with the same PR configuration share settings", func(t *testing.T) {
+		first := newRS(config.TargetConfig{Repo: "org/a", PRLabels: []string{"service"}}).resolvedPRSettings()
+		second := newRS(config.TargetConfig{Repo: "org/b", PRLabels: []string{"service"}}).resolvedPRSettings()
+		assert.Same(t, first, second)
+		assert.Equal(t, []string{"sync", "service"}, first.labels)
+		assert.Equal(t, []string{"owner"}, first.assignees)
+		assert.Equal(t, []string{"lead"}, first.reviewers)
+		assert.Equal(t, []string{"platform"}, first.teamReviewers)
+	})
+
+	t.Run("different PR configuration resolves separately", func(t *testing.T) {
+		rs := newRS(config.TargetConfig{Repo: "org/c", PRReviewers: []string{"alice"}})
+		assert.Equal(t, []string{"sync"}, rs.getPRLabels(nil))
+		assert.Equal(t, []string{"lead", "alice"}, rs.getPRReviewers(""))
+	})
+
+	t.Run("list boundaries are part of the hash", func(t *testing.T) {
+		assert.NotEqual(t,
+			prTargetHash(prTargetLists(config.TargetConfig{PRLabels: []string{"a", "b"}})),
+			prTargetHash(prTargetLists(config.TargetConfig{PRLabels: []string{"a"}, PRAssignees: []string{"b"}})))
+		assert.NotEqual(t,
+			prTargetHash(prTargetLists(config.TargetConfig{PRLabels: []string{"ab"}})),
+			prTargetHash(prTargetLists(config.TargetConfig{PRLabels: []string{"a", "b"}})))
+	})
+
+	t.Run("changed target settings resolve again", func(t *testing.T) {
+		rs := newRS(config.TargetConfig{Repo: "org/f"})
+		assert.Equal(t, []string{"sync"}, rs.getPRLabels(nil))
+		rs.target.PRLabels = []string{"changed"}
+		assert.Equal(t, []string{"sync", "changed"}, rs.getPRLabels(nil))
+	})
+
+	t.Run("switching the current group resolves again", func(t *testing.T) {
+		group := config.Group{Global: config.GlobalConfig{PRLabels: []string{"other"}}}
+		engine.SetCurrentGroup(&group)
+		defer engine.SetCurrentGroup(nil)
+
+		assert.Equal(t, []string{"other"}, newRS(config.TargetConfig{Repo: "org/a"}).getPRLabels(nil))
+	})
+
+	t.Run("appending to shared settings does not leak between targets", func(t *testing.T) {
+		labels := newRS(config.TargetConfig{Repo: "org/d"}).getPRLabels(nil)
+		_ = append(labels, "extra")
+		assert.Equal(t, []string{"sync"}, newRS(config.TargetConfig{Repo: "org/e"}).getPRLabels(nil))
+	})
+}
+
+func TestRepositorySync_resolvedPRSettings_Concurrent(t *testing.T) {
+	engine := newPRSettingsEngine()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			rs := &RepositorySync{engine: engine, target: config.TargetConfig{
+				Repo:     fmt.Sprintf("org/repo-%d", i),
+				PRLabels: []string{fmt.Sprintf("tier-%d", i%3)},
+			}}
+			assert.Equal(t, []string{"sync", fmt.Sprintf("tier-%d", i%3)}, rs.getPRLabels(nil))
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Len(t, engine.shared().prSettings, 3)
+}
+
+// BenchmarkRepositorySync_PRSettings compares resolving PR labels and
+// reviewers for every target against the memoized settings, for a group whose
+// targets share their PR configuration
+func BenchmarkRepositorySync_PRSettings(b *testing.B) {
+	const targetCount = 500
+
+	newTargets := func(engine *Engine) []*RepositorySync {
+		targets := make([]*RepositorySync, targetCount)
+		for i := range targets {
+			targets[i] = &RepositorySync{engine: engine, target: config.TargetConfig{
+				Repo:     fmt.Sprintf("org/repo-%d", i),
+				PRLabels: []string{"service", "automated"},
+			}}
+		}
+		return targets
+	}
+
+	b.Run("Resolve", func(b *testing.B) {
+		targets := newTargets(newPRSettingsEngine())
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, rs := range targets {
+				_ = rs.resolvePRLabels()
+				_ = rs.resolvePRAssignees()
+				_ = rs.resolvePRReviewers()
+				_ = rs.resolvePRTeamReviewers()
+			}
+		}
+	})
+
+	b.Run("Memoized", func(b *testing.B) {
+		targets := newTargets(newPRSettingsEngine())
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, rs := range targets {
+				_ = rs.getPRLabels(nil)
+				_ = rs.getPRAssignees()
+				_ = rs.getPRReviewers("")
+				_ = rs.getPRTeamReviewers()
+			}
+		}
+	})
+}
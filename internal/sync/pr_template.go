@@ -0,0 +1,108 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Placeholders a target PR template may contain to pull in the sections of
+// the generated PR body
+const (
+	prTemplateWhatChanged = "{{GO_BROADCAST_WHAT_CHANGED}}"
+	prTemplateDetails     = "{{GO_BROADCAST_DETAILS}}"
+	prTemplateWhy         = "{{GO_BROADCAST_WHY}}"
+	prTemplateTesting     = "{{GO_BROADCAST_TESTING}}"
+	prTemplateImpact      = "{{GO_BROADCAST_IMPACT}}"
+)
+
+// targetPRTemplatePaths lists where GitHub looks for a repository's pull
+// request template, in the order they are tried
+//
+//nolint:gochecknoglobals // This is a read-only lookup table
+var targetPRTemplatePaths = []string{
+	".github/pull_request_template.md",
+	".github/PULL_REQUEST_TEMPLATE.md",
+	"pull_request_template.md",
+	"PULL_REQUEST_TEMPLATE.md",
+	"docs/pull_request_template.md",
+	"docs/PULL_REQUEST_TEMPLATE.md",
+}
+
+// getUseTargetPRTemplate reports whether PR bodies start from the target's
+// own pull request template: the target setting wins over the group defaults
+func (rs *RepositorySync) getUseTargetPRTemplate() bool {
+	if rs.target.UseTargetPRTemplate != nil {
+		return *rs.target.UseTargetPRTemplate
+	}
+	if rs.engine == nil {
+		return false
+	}
+	if currentGroup := rs.engine.GetCurrentGroup(); currentGroup != nil {
+		return currentGroup.Defaults.UseTargetPRTemplate
+	}
+	if rs.engine.config != nil && len(rs.engine.config.Groups) > 0 {
+		return rs.engine.config.Groups[0].Defaults.UseTargetPRTemplate
+	}
+	return false
+}
+
+// targetPRTemplate returns the target repository's pull request template, or
+// "" when the option is off or the target has none. The lookup runs once per
+// target since a sync may render the PR body more than once.
+func (rs *RepositorySync) targetPRTemplate(ctx context.Context) string {
+	if rs.prTemplateFetched || !rs.getUseTargetPRTemplate() || rs.engine == nil || rs.engine.gh == nil {
+		return rs.prTemplate
+	}
+	rs.prTemplateFetched = true
+
+	for _, path := range targetPRTemplatePaths {
+		rs.TrackAPIRequest()
+		file, err := rs.engine.gh.GetFile(ctx, rs.target.Repo, path, rs.target.Branch)
+		if err != nil || file == nil || strings.TrimSpace(string(file.Content)) == "" {
+			continue
+		}
+		if rs.logger != nil {
+			rs.logger.WithField("path", path).Debug("Using target repository PR template")
+		}
+		rs.prTemplate = string(file.Content)
+		return rs.prTemplate
+	}
+
+	if rs.logger != nil {
+		rs.logger.Debug("Target repository has no PR template, using generated PR body")
+	}
+	return ""
+}
+
+// renderTargetPRTemplate replaces the go-broadcast placeholders in the target
+// PR template with the matching generated sections. Text outside the
+// placeholders is kept as the target wrote it.
+func (rs *RepositorySync) renderTargetPRTemplate(template, commitSHA string, changedFiles []FileChange, actualChangedFiles []string) string {
+	var details strings.Builder
+	if len(rs.target.Directories) > 0 {
+		rs.writeDirectorySyncDetails(&details)
+	}
+	rs.writePerformanceMetrics(&details)
+
+	replacer := strings.NewReplacer(
+		prTemplateWhatChanged, strings.TrimSuffix(rs.whatChangedSection(commitSHA, changedFiles, actualChangedFiles), "\n"),
+		prTemplateDetails, strings.TrimSpace(details.String()),
+		prTemplateWhy, strings.TrimSuffix(prBodyWhySection, "\n"),
+		prTemplateTesting, strings.TrimSuffix(prBodyTestingSection, "\n"),
+		prTemplateImpact, strings.TrimSuffix(prBodyImpactSection, "\n"),
+	)
+	return strings.TrimRight(replacer.Replace(template), "\n") + "\n\n"
+}
+
+// whatChangedSection returns the bullet list of the What Changed section
+func (rs *RepositorySync) whatChangedSection(commitSHA string, changedFiles []FileChange, actualChangedFiles []string) string {
+	var sb strings.Builder
+	rs.writeChangeSummary(&sb, changedFiles, actualChangedFiles)
+	shortSHA := commitSHA
+	if len(commitSHA) > 7 {
+		shortSHA = commitSHA[:7]
+	}
+	fmt.Fprintf(&sb, "* Brought target repository in line with source repository state at commit %s\n", shortSHA)
+	return sb.String()
+}
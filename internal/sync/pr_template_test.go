@@ -0,0 +1,82 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+)
+
+func TestRepositorySync_getUseTargetPRTemplate(t *testing.T) {
+	enabled, disabled := true, false
+	newRS := func(defaults config.DefaultConfig, target config.TargetConfig) *RepositorySync {
+		return &RepositorySync{
+			engine: &Engine{config: &config.Config{Groups: []config.Group{{Defaults: defaults}}}},
+			target: target,
+		}
+	}
+
+	assert.False(t, newRS(config.DefaultConfig{}, config.TargetConfig{}).getUseTargetPRTemplate())
+	assert.True(t, newRS(config.DefaultConfig{UseTargetPRTemplate: true}, config.TargetConfig{}).getUseTargetPRTemplate())
+	assert.True(t, newRS(config.DefaultConfig{}, config.TargetConfig{UseTargetPRTemplate: &enabled}).getUseTargetPRTemplate())
+	assert.False(t, newRS(config.DefaultConfig{UseTargetPRTemplate: true}, config.TargetConfig{UseTargetPRTemplate: &disabled}).getUseTargetPRTemplate())
+}
+
+func TestRepositorySync_generatePRBody_TargetPRTemplate(t *testing.T) {
+	ctx := context.Background()
+	enabled := true
+	target := config.TargetConfig{
+		Repo:                "org/target",
+		Files:               []config.FileMapping{{Src: "a.txt", Dest: "a.txt"}},
+		UseTargetPRTemplate: &enabled,
+	}
+	changes := []FileChange{{Path: "a.txt", Content: []byte("alpha")}}
+
+	t.Run("fills placeholders and keeps the metadata block", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/target", ".github/pull_request_template.md", "").
+			Return(nil, gh.ErrFileNotFound).Once()
+		ghClient.On("GetFile", mock.Anything, "org/target", ".github/PULL_REQUEST_TEMPLATE.md", "").
+			Return(&gh.FileContent{Content: []byte("## Summary\n{{GO_BROADCAST_WHAT_CHANGED}}\n\n## Checklist\n- [x] Tests\n\n## Risk\n{{GO_BROADCAST_IMPACT}}\n")}, nil).Once()
+		rs := newTestRepoSync(ghClient, nil, target, nil)
+
+		body, aiGenerated := rs.generatePRBody(ctx, "abc123def", changes, []string{"a.txt"})
+		assert.False(t, aiGenerated)
+		assert.Contains(t, body, "## Summary\n* Updated 1 individual file(s)")
+		assert.Contains(t, body, "at commit abc123d\n\n## Checklist\n- [x] Tests\n")
+		assert.Contains(t, body, "## Risk\n* **Low Risk**")
+		assert.NotContains(t, body, "{{GO_BROADCAST_")
+		assert.NotContains(t, body, "## Why It Was Necessary")
+		assert.Contains(t, body, "<!-- go-broadcast-metadata")
+
+		// The template is fetched once per target
+		rs.generatePRBody(ctx, "abc123def", changes, []string{"a.txt"})
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("falls back to the generated body without a template", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/target", mock.Anything, "").
+			Return(nil, gh.ErrFileNotFound).Times(len(targetPRTemplatePaths))
+		rs := newTestRepoSync(ghClient, nil, target, nil)
+
+		body, _ := rs.generatePRBody(ctx, "abc123def", changes, []string{"a.txt"})
+		assert.Contains(t, body, "## What Changed\n")
+		assert.Contains(t, body, "## Why It Was Necessary\n")
+		assert.Contains(t, body, "<!-- go-broadcast-metadata")
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("disabled does not fetch", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		rs := newTestRepoSync(ghClient, nil, config.TargetConfig{Repo: "org/target"}, nil)
+
+		body, _ := rs.generatePRBody(ctx, "abc123def", changes, nil)
+		assert.Contains(t, body, "## What Changed\n")
+		ghClient.AssertNotCalled(t, "GetFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -1,6 +1,7 @@
 package sync
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -20,6 +21,7 @@ import (
 	"github.com/mrz1836/go-broadcast/internal/git"
 	"github.com/mrz1836/go-broadcast/internal/logging"
 	"github.com/mrz1836/go-broadcast/internal/metrics"
+	"github.com/mrz1836/go-broadcast/internal/output"
 	"github.com/mrz1836/go-broadcast/internal/state"
 	"github.com/mrz1836/go-broadcast/internal/transform"
 )
@@ -49,12 +51,45 @@ type RepositorySync struct {
 	syncMetrics *PerformanceMetrics
 	// commitAIGenerated tracks if commit message was AI-generated (for PR metadata)
 	commitAIGenerated bool
+	// emptyCommit tracks if a forced resync committed without content changes
+	emptyCommit bool
 	// moduleUpdates tracks module version updates for go.mod references
 	moduleUpdates []ModuleUpdateInfo
 	// lastPRNumber stores the PR number after creation/update for metrics recording
 	lastPRNumber *int
 	// lastPRURL stores the PR URL after creation/update for metrics recording
 	lastPRURL string
+	// lastPRAction records whether the PR was created or updated (PRAction* values)
+	lastPRAction string
+	// result is this target's result, built when options.OutputDir or options.SummaryOnly is set
+	result *SyncResult
+	// plannedPR is the pull request a dry run would open or update
+	plannedPR *DryRunPRPlan
+	// existingContent caches target file content fetched by the content pre-check
+	existingContent map[string][]byte
+	// contentHashes records the transformed content of each processed mapping
+	contentHashes contentHashes
+	// targetBlobSHAs records the blob SHA of each mapped target file, from GetFile or the last sync
+	targetBlobSHAs map[string]string
+	// targetTreeSHAs holds the blob SHA of each file on the target branch once targetTreeFetched is set
+	targetTreeSHAs    map[string]string
+	targetTreeFetched bool
+	// prSettings caches the PR labels and reviewers shared through the engine
+	prSettings *prSettings
+	// poolPicks holds the reviewers picked from the reviewer pool once poolPicked is set
+	poolPicks  []string
+	poolPicked bool
+	// prTemplate caches the target's PR template once prTemplateFetched is set
+	prTemplate        string
+	prTemplateFetched bool
+	// skipReason is why the operator did not approve this target's PR (--interactive)
+	skipReason string
+	// changedFilesURL is the page the PR body's changed file checklist links into
+	changedFilesURL string
+	// throttles counts the rate-limiter delays of this sync's own API calls
+	throttles *gh.ThrottleCounter
+	// gitAttributes holds the binary declarations of the cloned source's .gitattributes
+	gitAttributes *transform.GitAttributes
 }
 
 // PerformanceMetrics tracks performance metrics for the entire sync operation
@@ -68,6 +103,8 @@ type PerformanceMetrics struct {
 	CacheHits          int // Number of cache hits
 	CacheMisses        int // Number of cache misses
 	TotalAPIRequests   int // Total API requests made
+	RateLimitThrottles int // API calls delayed by the shared client rate limiter
+	Retries            int // API calls retried after a transient failure or conflict
 }
 
 // GetDirectoryMetric returns a copy of the directory metrics for the given path (thread-safe).
@@ -113,6 +150,8 @@ func (rs *RepositorySync) Execute(ctx context.Context) error {
 		StartTime:        time.Now(),
 		DirectoryMetrics: make(map[string]DirectoryMetrics),
 	}
+	rs.throttles = &gh.ThrottleCounter{}
+	ctx = gh.WithThrottleCounter(ctx, rs.throttles)
 
 	// Track variables for deferred metrics recording
 	var (
@@ -121,11 +160,31 @@ func (rs *RepositorySync) Execute(ctx context.Context) error {
 		finalAllChanges    []FileChange
 		finalActualChanges []string
 		finalErr           error
-		finalStatus        string // explicit override for early returns (skipped, no_changes)
+		finalStatus        string // explicit override for early returns (skipped, no_changes) and --no-pr
+		dryRunPreviewed    bool   // dry-run reached the PR preview for this target
+		prOpened           bool   // the PR claimed from --max-prs was created or updated
 	)
 
 	// Defer metrics recording (captures success or failure)
 	defer func() {
+		if rs.engine.collectsResults() {
+			rs.result = rs.newSyncResult(finalBranchName, finalCommitSHA,
+				finalAllChanges, finalActualChanges, finalErr, finalStatus)
+		}
+		if rs.engine.options.DryRun {
+			var previewed []FileChange
+			if dryRunPreviewed {
+				previewed = finalAllChanges
+			}
+			rs.engine.recordDryRunTarget(rs.syncMetrics.FileMetrics, previewed)
+			if rs.engine.options.DryRunPlanFile != "" {
+				rs.engine.recordDryRunPlan(rs.newDryRunTargetPlan(finalBranchName, previewed, finalErr, finalStatus))
+			}
+		}
+		if rs.engine.options.MetricsFile != "" {
+			rs.trackRateLimitThrottles()
+			rs.engine.recordRunMetrics(rs.syncMetrics, rs.lastPRAction, finalErr)
+		}
 		if rs.engine.syncRepo != nil {
 			metricsCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
 			defer cancel()
@@ -149,9 +208,31 @@ func (rs *RepositorySync) Execute(ctx context.Context) error {
 			AddField("group_id", currentGroup.ID)
 	}
 
+	// 0. Drop file mappings whose conditions this target does not match
+	if err := rs.applyFileConditions(ctx); err != nil {
+		syncTimer.StopWithError(err)
+		finalErr = err
+		return rs.syncError(PhasePrepare, err)
+	}
+
+	// 0a. Render templated dests for this target
+	if err := rs.applyDestTemplates(); err != nil {
+		syncTimer.StopWithError(err)
+		finalErr = err
+		return rs.syncError(PhasePrepare, err)
+	}
+
+	// 0b. Fetch the topics conditional blocks are matched against, so a failed
+	// lookup stops the target before any file is transformed
+	if _, err := rs.engine.conditionalBlockTopics(ctx, rs.target); err != nil {
+		syncTimer.StopWithError(err)
+		finalErr = err
+		return rs.syncError(PhasePrepare, err)
+	}
+
 	// 1. Check if sync is actually needed
 	syncCheckTimer := metrics.StartTimer(ctx, rs.logger, "sync_check")
-	needsSync := rs.engine.options.Force || rs.needsSync()
+	needsSync := rs.engine.options.Force || rs.needsSync(ctx)
 	syncCheckTimer.AddField("force_sync", rs.engine.options.Force).
 		AddField("needs_sync", needsSync).Stop()
 
@@ -162,6 +243,22 @@ func (rs *RepositorySync) Execute(ctx context.Context) error {
 		return nil
 	}
 
+	// 1b. Refuse to push to a fork outside the target's fork network
+	if err := rs.verifyFork(ctx); err != nil {
+		syncTimer.StopWithError(err)
+		finalErr = err
+		return rs.syncError(PhasePrepare, err)
+	}
+
+	// 1c. Hold the target's lock so an overlapping run cannot push over this one
+	unlock, err := rs.engine.lockTarget(ctx, rs.target.Repo, rs.logger)
+	if err != nil {
+		syncTimer.StopWithError(err)
+		finalErr = err
+		return rs.syncError(PhasePrepare, err)
+	}
+	defer unlock()
+
 	// 2. Pre-sync validation and cleanup
 	validationTimer := metrics.StartTimer(ctx, rs.logger, "pre_sync_validation")
 	if err := rs.validateAndCleanupOrphanedBranches(ctx); err != nil {
@@ -172,32 +269,71 @@ func (rs *RepositorySync) Execute(ctx context.Context) error {
 		validationTimer.Stop()
 	}
 
+	// 2b. Skip targets whose mapped files already match the transformed source
+	precheckTimer := metrics.StartTimer(ctx, rs.logger, "content_precheck").
+		AddField("file_count", len(rs.target.Files))
+	inSync := rs.targetContentInSync(ctx)
+	precheckTimer.AddField("in_sync", inSync).Stop()
+
+	if inSync {
+		rs.logger.Info("Target content already matches source, skipping sync")
+		syncTimer.AddField(logging.StandardFields.Status, "no_changes").Stop()
+		finalStatus = TargetStatusNoChanges
+		return nil
+	}
+
+	// 2c. Claim a pull request from the run-wide --max-prs limit before any
+	// work, so a target over the limit never pushes a branch it cannot open a
+	// PR for. The claim is returned unless the PR is created or updated.
+	if !rs.engine.options.NoPR {
+		if err := rs.engine.reservePR(rs.target.Repo); err != nil {
+			syncTimer.StopWithError(err)
+			finalErr = err
+			return rs.syncError(PhasePR, err)
+		}
+		defer func() {
+			if !prOpened {
+				rs.engine.releasePR()
+			}
+		}()
+	}
+
 	// 3. Create temporary directory
 	tempDirTimer := metrics.StartTimer(ctx, rs.logger, "temp_dir_creation")
 	if err := rs.createTempDir(); err != nil {
 		tempDirTimer.StopWithError(err)
 		syncTimer.StopWithError(err)
 		finalErr = err
-		return fmt.Errorf("failed to create temp directory: %w", err)
+		return rs.syncError(PhasePrepare, fmt.Errorf("failed to create temp directory: %w", err))
 	}
 	tempDirTimer.AddField("temp_dir", rs.tempDir).Stop()
-	defer rs.cleanup()
+	defer func() { rs.cleanup(finalErr != nil) }()
 
 	// 3. Clone source repository
+	rs.setOperation(logging.OperationTypes.SyncClone)
 	cloneTimer := metrics.StartTimer(ctx, rs.logger, "source_clone").
 		AddField(logging.StandardFields.SourceRepo, rs.sourceState.Repo).
 		AddField("source_branch", rs.sourceState.Branch).
 		AddField("commit_sha", rs.sourceState.LatestCommit)
 
-	if err := rs.cloneSource(ctx); err != nil {
+	timeouts := rs.engine.options.OperationTimeouts
+	if err := rs.withOperationTimeout(ctx, "clone", timeouts.Clone, rs.cloneSource); err != nil {
 		cloneTimer.StopWithError(err)
 		syncTimer.StopWithError(err)
 		finalErr = err
-		return fmt.Errorf("failed to clone source: %w", err)
+		return rs.syncError(PhaseClone, fmt.Errorf("failed to clone source: %w", err))
 	}
 	cloneTimer.Stop()
 
+	// 3b. Expand glob file mappings against the cloned source
+	if err := rs.expandFileGlobs(); err != nil {
+		syncTimer.StopWithError(err)
+		finalErr = err
+		return rs.syncError(PhasePrepare, err)
+	}
+
 	// 4. Process and transform files
+	rs.setOperation(logging.OperationTypes.SyncTransform)
 	processTimer := metrics.StartTimer(ctx, rs.logger, "file_processing").
 		AddField("file_count", len(rs.target.Files))
 
@@ -207,7 +343,7 @@ func (rs *RepositorySync) Execute(ctx context.Context) error {
 		processTimer.StopWithError(err)
 		syncTimer.StopWithError(err)
 		finalErr = err
-		return fmt.Errorf("failed to process files: %w", err)
+		return rs.syncError(PhaseTransform, fmt.Errorf("failed to process files: %w", err))
 	}
 	fileProcessingDuration := time.Since(fileProcessingStart)
 
@@ -235,7 +371,7 @@ func (rs *RepositorySync) Execute(ctx context.Context) error {
 	if err != nil {
 		syncTimer.StopWithError(err)
 		finalErr = err
-		return fmt.Errorf("failed to process directories: %w", err)
+		return rs.syncError(PhaseTransform, fmt.Errorf("failed to process directories: %w", err))
 	}
 
 	// Store directory metrics for PR metadata
@@ -266,10 +402,13 @@ func (rs *RepositorySync) Execute(ctx context.Context) error {
 	}).Info("File and directory processing completed")
 
 	if len(allChanges) == 0 {
-		rs.logger.Info("No file or directory changes detected, skipping sync")
-		syncTimer.AddField(logging.StandardFields.Status, "no_changes").Stop()
-		finalStatus = TargetStatusNoChanges
-		return nil
+		if !rs.engine.options.emptyResync() {
+			rs.logger.Info("No file or directory changes detected, skipping sync")
+			syncTimer.AddField(logging.StandardFields.Status, "no_changes").Stop()
+			finalStatus = TargetStatusNoChanges
+			return nil
+		}
+		rs.logger.Info("No file or directory changes detected, forcing an empty resync commit")
 	}
 
 	// 6. Create sync branch (or use existing one)
@@ -278,6 +417,7 @@ func (rs *RepositorySync) Execute(ctx context.Context) error {
 	branchTimer.AddField(logging.StandardFields.BranchName, branchName).Stop()
 
 	// 7. Commit changes
+	rs.setOperation(logging.OperationTypes.SyncCommit)
 	commitTimer := metrics.StartTimer(ctx, rs.logger, "commit_creation").
 		AddField(logging.StandardFields.BranchName, branchName).
 		AddField("changed_files", len(allChanges))
@@ -303,7 +443,7 @@ func (rs *RepositorySync) Execute(ctx context.Context) error {
 		}
 		syncTimer.StopWithError(err)
 		finalErr = err
-		return fmt.Errorf("failed to commit changes: %w", err)
+		return rs.syncError(PhaseCommit, fmt.Errorf("failed to commit changes: %w", err))
 	}
 	commitTimer.AddField("commit_sha", commitSHA).Stop()
 
@@ -328,8 +468,24 @@ func (rs *RepositorySync) Execute(ctx context.Context) error {
 	// Update directory metrics with actual git changes
 	rs.updateDirectoryMetricsWithActualChanges(actualChangedFiles)
 
+	// 7b. Ask the operator to approve the PR before anything is pushed (--interactive)
+	skipReason, err := rs.approvePR(branchName, allChanges, actualChangedFiles)
+	if err != nil {
+		syncTimer.StopWithError(err)
+		finalErr = err
+		return rs.syncError(PhasePR, err)
+	}
+	if skipReason != "" {
+		rs.logger.WithField("reason", skipReason).Info("Target not approved, skipping its PR")
+		syncTimer.AddField(logging.StandardFields.Status, "skipped").Stop()
+		rs.skipReason = skipReason
+		finalStatus = TargetStatusSkipped
+		return nil
+	}
+
 	// 8. Push changes (unless dry-run)
 	if !rs.engine.options.DryRun {
+		rs.setOperation(logging.OperationTypes.SyncPush)
 		pushTimer := metrics.StartTimer(ctx, rs.logger, "branch_push").
 			AddField(logging.StandardFields.BranchName, branchName).
 			AddField("commit_sha", commitSHA)
@@ -337,36 +493,67 @@ func (rs *RepositorySync) Execute(ctx context.Context) error {
 		if rs.logger != nil {
 			rs.logger.Info("Pushing changes to remote...")
 		}
-		if err := rs.pushChanges(ctx, branchName); err != nil {
+		err := rs.withOperationTimeout(ctx, "push", timeouts.Push, func(ctx context.Context) error {
+			return rs.pushChanges(ctx, branchName)
+		})
+		if err != nil {
 			pushTimer.StopWithError(err)
 			syncTimer.StopWithError(err)
 			finalErr = err
-			return fmt.Errorf("failed to push changes: %w", err)
+			return rs.syncError(PhasePush, fmt.Errorf("failed to push changes: %w", err))
 		}
 		pushTimer.Stop()
 	} else {
 		rs.logger.Debug("DRY-RUN: Skipping branch push")
 	}
 
-	// 9. Create or update pull request
-	prTimer := metrics.StartTimer(ctx, rs.logger, "pr_management").
-		AddField(logging.StandardFields.BranchName, branchName).
-		AddField("commit_sha", commitSHA).
-		AddField("changed_files", len(allChanges))
+	// 9. Create or update pull request, unless only the branch is wanted
+	if rs.engine.options.NoPR {
+		finalStatus = TargetStatusBranchPushed
+		rs.reportPushedBranch(branchName)
+	} else {
+		rs.setOperation(logging.OperationTypes.PRCreate)
+		prTimer := metrics.StartTimer(ctx, rs.logger, "pr_management").
+			AddField(logging.StandardFields.BranchName, branchName).
+			AddField("commit_sha", commitSHA).
+			AddField("changed_files", len(allChanges))
+
+		rs.trackRateLimitThrottles()
+		err := rs.withOperationTimeout(ctx, "pr_create", timeouts.PRCreate, func(ctx context.Context) error {
+			return rs.createOrUpdatePR(ctx, branchName, commitSHA, allChanges, actualChangedFiles)
+		})
+		if err != nil {
+			prTimer.StopWithError(err)
+			syncTimer.StopWithError(err)
+			finalErr = err
+			return rs.syncError(PhasePR, fmt.Errorf("failed to create/update PR: %w", err))
+		}
+		prTimer.Stop()
+		prOpened = true
 
-	if err := rs.createOrUpdatePR(ctx, branchName, commitSHA, allChanges, actualChangedFiles); err != nil {
-		prTimer.StopWithError(err)
+		if rs.waitsForChecks() {
+			if err := rs.awaitChecksAndAutoMerge(ctx, commitSHA); err != nil {
+				syncTimer.StopWithError(err)
+				finalErr = err
+				return rs.syncError(PhasePR, err)
+			}
+		}
+	}
+
+	// 10. Run the post-sync hook
+	if err := rs.runPostSyncHook(ctx, commitSHA); err != nil {
 		syncTimer.StopWithError(err)
 		finalErr = err
-		return fmt.Errorf("failed to create/update PR: %w", err)
+		return rs.syncError(PhaseHook, err)
 	}
-	prTimer.Stop()
 
 	// Finalize performance metrics
 	rs.syncMetrics.EndTime = time.Now()
+	rs.trackRateLimitThrottles()
 
 	if rs.engine.options.DryRun {
 		rs.logger.Debug("Dry-run completed successfully")
+		dryRunPreviewed = true
 
 		out := NewDryRunOutput(nil)
 		out.Success("DRY-RUN SUMMARY: Repository sync preview completed successfully")
@@ -378,6 +565,9 @@ func (rs *RepositorySync) Execute(ctx context.Context) error {
 		out.Info(fmt.Sprintf("🌿 Branch: %s", branchName))
 		out.Info(fmt.Sprintf("📝 Files: %d would be changed", len(allChanges)))
 		out.Info(fmt.Sprintf("🔗 Commit: %s", commitSHA))
+		if rs.engine.options.NoPR {
+			out.Info("🚫 Pull request: none (--no-pr pushes the branch only)")
+		}
 		out.Info("💡 Run without --dry-run to execute these changes")
 		_, _ = fmt.Fprintln(out.writer)
 	} else {
@@ -392,22 +582,51 @@ func (rs *RepositorySync) Execute(ctx context.Context) error {
 	return nil
 }
 
-// needsSync determines if this repository actually needs synchronization
-func (rs *RepositorySync) needsSync() bool {
+// setOperation tags subsequent log lines with the current sync stage
+func (rs *RepositorySync) setOperation(operation string) {
+	rs.logger = rs.logger.WithField(logging.StandardFields.Operation, operation)
+}
+
+// needsSync determines if this repository actually needs synchronization.
+// With content-aware sync a new source commit is ignored when the mapped
+// content still matches what the open sync PR already carries.
+func (rs *RepositorySync) needsSync(ctx context.Context) bool {
 	if rs.targetState == nil {
 		return true // No state means never synced
 	}
 
-	// Check if source commit is different from last synced commit
-	return rs.targetState.LastSyncCommit != rs.sourceState.LatestCommit
+	// The last sync pushed a branch without a PR; sync again to open one
+	if rs.targetState.Status == state.StatusBranchOnly && !rs.engine.options.NoPR {
+		return true
+	}
+
+	// Check if source commit is different from last synced commit. A local
+	// source can change without a new commit, so its content decides.
+	if rs.targetState.LastSyncCommit == rs.sourceState.LatestCommit && !rs.isLocalSource() {
+		rs.explainSkip("last sync commit matches the source commit")
+		return false
+	}
+
+	if rs.mappedContentUnchanged(ctx) {
+		rs.logger.WithField("source_commit", rs.sourceState.LatestCommit).
+			Info("Source commit changed but mapped content is unchanged, skipping sync")
+		rs.explainSkip("content-aware: the source commit changed but the mapped content matches the open sync PR")
+		return false
+	}
+	return true
+}
+
+// explainSkip records why needsSync skipped this repository
+func (rs *RepositorySync) explainSkip(reason string) {
+	rs.engine.explainTarget(rs.target.Repo, false, reason, rs.targetState, rs.sourceState.LatestCommit)
 }
 
 // validateAndCleanupOrphanedBranches checks for and cleans up orphaned sync branches
 func (rs *RepositorySync) validateAndCleanupOrphanedBranches(ctx context.Context) error {
 	rs.logger.Debug("Running pre-sync validation for orphaned branches")
 
-	// List all branches in the target repository
-	branches, err := rs.engine.gh.ListBranches(ctx, rs.target.Repo)
+	// List all branches in the repository holding sync branches (the target or its fork)
+	branches, err := rs.engine.gh.ListBranches(ctx, rs.branchRepo())
 	if err != nil {
 		return fmt.Errorf("failed to list branches: %w", err)
 	}
@@ -417,6 +636,12 @@ func (rs *RepositorySync) validateAndCleanupOrphanedBranches(ctx context.Context
 	syncBranchPrefix := rs.getBranchPrefix()
 
 	for _, branch := range branches {
+		// Branches pushed with --no-pr have no PR by design, and fixed
+		// branches are reused by the next sync even when their PR is gone
+		if state.IsNoPRBranch(branch.Name) || rs.isFixedBranch(branch.Name) {
+			continue
+		}
+
 		// Check if this is a sync branch (matches our prefix pattern)
 		if strings.HasPrefix(branch.Name, syncBranchPrefix) {
 			// Check if there's an existing PR for this branch
@@ -432,7 +657,7 @@ func (rs *RepositorySync) validateAndCleanupOrphanedBranches(ctx context.Context
 
 		for _, branchName := range orphanedBranches {
 			rs.logger.WithField("branch_name", branchName).Debug("Deleting orphaned sync branch")
-			if err := rs.engine.gh.DeleteBranch(ctx, rs.target.Repo, branchName); err != nil {
+			if err := rs.engine.gh.DeleteBranch(ctx, rs.branchRepo(), branchName); err != nil {
 				if !errors.Is(err, gh.ErrBranchNotFound) {
 					rs.logger.WithError(err).WithField("branch_name", branchName).Warn("Failed to delete orphaned branch")
 				}
@@ -445,6 +670,25 @@ func (rs *RepositorySync) validateAndCleanupOrphanedBranches(ctx context.Context
 	return nil
 }
 
+// isFixedBranch reports whether name is the fixed_branch of any target synced
+// to the same repository, so one target never deletes another's fixed branch
+func (rs *RepositorySync) isFixedBranch(name string) bool {
+	if rs.target.FixedBranch == name {
+		return true
+	}
+	if rs.engine.config == nil {
+		return false
+	}
+	for _, group := range rs.engine.config.Groups {
+		for _, target := range group.Targets {
+			if target.Repo == rs.target.Repo && target.FixedBranch == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // findExistingPRForBranch finds an existing PR for the specified branch name
 func (rs *RepositorySync) findExistingPRForBranch(branchName string) *gh.PR {
 	if rs.targetState == nil {
@@ -475,9 +719,17 @@ func (rs *RepositorySync) getBranchPrefix() string {
 	return "chore/sync-files"
 }
 
-// createTempDir creates a temporary directory for the sync operation
+// createTempDir creates a temporary directory for the sync operation under
+// the configured base directory, creating the base directory when needed
 func (rs *RepositorySync) createTempDir() error {
-	tempDir, err := os.MkdirTemp("", "go-broadcast-sync-*")
+	baseDir := rs.engine.options.TempDir
+	if baseDir != "" {
+		if err := os.MkdirAll(baseDir, 0o750); err != nil {
+			return err
+		}
+	}
+
+	tempDir, err := os.MkdirTemp(baseDir, "go-broadcast-sync-*")
 	if err != nil {
 		return err
 	}
@@ -487,9 +739,18 @@ func (rs *RepositorySync) createTempDir() error {
 	return nil
 }
 
-// cleanup removes temporary files unless configured otherwise
-func (rs *RepositorySync) cleanup() {
-	if !rs.engine.options.CleanupTempFiles || rs.tempDir == "" {
+// cleanup removes temporary files unless configured otherwise. A failed
+// target keeps its working tree when KeepTempOnFailure is set.
+func (rs *RepositorySync) cleanup(failed bool) {
+	if rs.tempDir == "" {
+		return
+	}
+	if failed && rs.engine.options.KeepTempOnFailure {
+		rs.logger.WithField("temp_dir", rs.tempDir).Warn("Sync failed, keeping temporary directory for inspection")
+		return
+	}
+	if !rs.engine.options.CleanupTempFiles {
+		rs.logger.WithField("temp_dir", rs.tempDir).Info("Keeping temporary directory")
 		return
 	}
 
@@ -567,6 +828,10 @@ func (rs *RepositorySync) forceCleanup() error {
 
 // cloneSource clones the source repository at the specific commit
 func (rs *RepositorySync) cloneSource(ctx context.Context) error {
+	if rs.isLocalSource() {
+		return rs.useLocalSource()
+	}
+
 	rs.logger.WithFields(logrus.Fields{
 		"source_repo":   rs.sourceState.Repo,
 		"source_branch": rs.sourceState.Branch,
@@ -574,8 +839,8 @@ func (rs *RepositorySync) cloneSource(ctx context.Context) error {
 	}).Info("Cloning source repository")
 
 	// Clone the repository
-	sourceURL := fmt.Sprintf("https://github.com/%s.git", rs.sourceState.Repo)
-	sourcePath := filepath.Join(rs.tempDir, "source")
+	sourceURL := rs.engine.repoCloneURL(rs.sourceState.Repo)
+	sourcePath := rs.sourcePath()
 
 	// Get blob size limit from current group config
 	var opts *git.CloneOptions
@@ -593,7 +858,34 @@ func (rs *RepositorySync) cloneSource(ctx context.Context) error {
 	}
 
 	rs.logger.Debug("Source repository cloned successfully")
-	return nil
+
+	rs.gitAttributes = loadSourceGitAttributes(rs.engine, sourcePath, rs.logger)
+	return rs.verifySourceRoot()
+}
+
+// ignoreGitAttributes reports whether the current group sets source.ignore_gitattributes
+func ignoreGitAttributes(engine *Engine) bool {
+	if engine == nil {
+		return false
+	}
+	currentGroup := engine.GetCurrentGroup()
+	return currentGroup != nil && currentGroup.Source.IgnoreGitAttributes
+}
+
+// loadSourceGitAttributes reads the .gitattributes at the root of a source
+// checkout so its binary declarations take precedence over content detection.
+// It returns nil when the group sets source.ignore_gitattributes or the file
+// is missing or unreadable.
+func loadSourceGitAttributes(engine *Engine, sourcePath string, logger *logrus.Entry) *transform.GitAttributes {
+	if ignoreGitAttributes(engine) {
+		return nil
+	}
+	attrs, err := transform.LoadGitAttributes(sourcePath)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to read source .gitattributes, using content detection")
+		return nil
+	}
+	return attrs
 }
 
 // processFiles processes all configured files and applies transformations
@@ -601,9 +893,14 @@ func (rs *RepositorySync) processFiles(ctx context.Context) ([]FileChange, error
 	rs.logger.WithField("file_count", len(rs.target.Files)).Info("Processing files")
 
 	var changedFiles []FileChange
-	sourcePath := filepath.Join(rs.tempDir, "source")
+	sourcePath := rs.mappingSourcePath()
+
+	progress := output.NewFileProgress(rs.target.Repo, len(rs.target.Files))
+	defer progress.Done()
 
-	for _, fileMapping := range rs.target.Files {
+	for i, fileMapping := range rs.target.Files {
+		fileMapping = rs.renderedFileMapping(fileMapping)
+		progress.Update(i+1, fileMapping.Dest)
 		change, err := rs.processFile(ctx, sourcePath, fileMapping)
 		if err != nil {
 			// Handle recoverable errors gracefully
@@ -632,61 +929,62 @@ func (rs *RepositorySync) processFiles(ctx context.Context) ([]FileChange, error
 func (rs *RepositorySync) processFile(ctx context.Context, sourcePath string, fileMapping config.FileMapping) (*FileChange, error) {
 	// Handle file deletion
 	if fileMapping.Delete {
+		rs.recordContentHash(fileMapping.Dest, contentHashDeleted)
 		return rs.processFileDeletion(ctx, fileMapping)
 	}
 
-	srcPath := filepath.Join(sourcePath, fileMapping.Src)
+	// Resolve symlinks, refusing any that lead out of the source tree
+	srcPath, err := resolveSourcePath(sourcePath, fileMapping.Src)
+	if errors.Is(err, ErrSourcePathEscapes) {
+		rs.logger.WithError(err).WithField("file", fileMapping.Src).Warn("Source file resolves outside the source tree, skipping")
+		rs.recordContentHash(fileMapping.Dest, contentHashMissing)
+		return nil, internalerrors.ErrFileNotFound
+	}
 
 	// Check if source file exists
-	srcContent, err := os.ReadFile(srcPath) //nolint:gosec // Path is constructed from trusted configuration
+	var srcContent []byte
+	if err == nil {
+		srcContent, err = os.ReadFile(srcPath) //nolint:gosec // Resolved inside the source tree
+	}
 	if err != nil {
 		if os.IsNotExist(err) {
 			rs.logger.WithField("file", fileMapping.Src).Warn("Source file not found, skipping")
+			rs.recordContentHash(fileMapping.Dest, contentHashMissing)
 			return nil, internalerrors.ErrFileNotFound
 		}
 		return nil, err
 	}
 
-	// Apply transformations
-	transformCtx := transform.Context{
-		SourceRepo: rs.sourceState.Repo,
-		TargetRepo: rs.target.Repo,
-		FilePath:   fileMapping.Dest,
-		Variables:  rs.target.Transform.Variables,
-	}
-
-	// Add email configuration if available
-	if currentGroup := rs.engine.GetCurrentGroup(); currentGroup != nil {
-		transformCtx.SourceSecurityEmail = currentGroup.Source.SecurityEmail
-		transformCtx.SourceSupportEmail = currentGroup.Source.SupportEmail
-		// Use target-specific emails if set, otherwise use source emails
-		if rs.target.SecurityEmail != "" {
-			transformCtx.TargetSecurityEmail = rs.target.SecurityEmail
-		} else {
-			transformCtx.TargetSecurityEmail = currentGroup.Source.SecurityEmail
-		}
-		if rs.target.SupportEmail != "" {
-			transformCtx.TargetSupportEmail = rs.target.SupportEmail
-		} else {
-			transformCtx.TargetSupportEmail = currentGroup.Source.SupportEmail
-		}
+	// Apply transformations (binary content is synced verbatim)
+	transformedContent, err := rs.transformFileContent(ctx, fileMapping, srcContent)
+	if err != nil {
+		return nil, err
 	}
+	rs.recordContentHash(fileMapping.Dest, contentHash(transformedContent))
 
-	transformedContent := srcContent
-	if rs.target.Transform.RepoName || len(rs.target.Transform.Variables) > 0 {
-		transformedContent, err = rs.engine.transform.Transform(ctx, srcContent, transformCtx)
-		if err != nil {
-			return nil, fmt.Errorf("transformation failed: %w", err)
-		}
+	// Skip fetching target files the last sync already found with this content.
+	// A managed block's file content also depends on the target, so it is
+	// always fetched.
+	if !fileMapping.IsManagedBlock() && rs.targetFileUnchanged(ctx, fileMapping.Dest, transformedContent) {
+		return nil, internalerrors.ErrTransformNotFound
 	}
 
 	// Check if content actually changed (for existing files)
 	existingContent, err := rs.getExistingFileContent(ctx, fileMapping.Dest)
+	if fileMapping.IsManagedBlock() {
+		// Splicing into a file that could not be read would drop its content
+		if err != nil && !errors.Is(err, gh.ErrFileNotFound) {
+			return nil, fmt.Errorf("failed to read %s for its managed block: %w", fileMapping.Dest, err)
+		}
+		spliced, spliceErr := SpliceManagedBlock(existingContent, transformedContent, fileMapping.MarkerStart, fileMapping.MarkerEnd)
+		if spliceErr != nil {
+			return nil, fmt.Errorf("%s: %w", fileMapping.Dest, spliceErr)
+		}
+		transformedContent = spliced
+	}
 	if err == nil {
 		// Enhanced logging for content comparison
-		existingStr := string(existingContent)
-		transformedStr := string(transformedContent)
-		contentMatches := existingStr == transformedStr
+		contentMatches := bytes.Equal(existingContent, transformedContent)
 
 		rs.logger.WithFields(logrus.Fields{
 			"file":                     fileMapping.Dest,
@@ -718,8 +1016,56 @@ func (rs *RepositorySync) processFile(ctx context.Context, sourcePath string, fi
 	}, nil
 }
 
+// renderedFileMapping returns the mapping with the template suffix stripped from
+// its destination when the source file is rendered as a template
+func (rs *RepositorySync) renderedFileMapping(fileMapping config.FileMapping) config.FileMapping {
+	fileMapping.Dest = transform.RenderedPath(fileMapping.Src, fileMapping.Dest, rs.target.Transform.RenderSuffix())
+	return fileMapping
+}
+
+// transformFileContent applies the configured transformations to the content of
+// a single file mapping. Binary content is returned unchanged, matching the
+// directory batch processor, so every comparison against the target sees the
+// same bytes regardless of which path produced them; the binary transform
+// policy decides whether that is a warning or an error.
+func (rs *RepositorySync) transformFileContent(ctx context.Context, fileMapping config.FileMapping, srcContent []byte) ([]byte, error) {
+	if rs.target.Transform.IsEmpty() {
+		return srcContent, nil
+	}
+
+	if IsBinarySource(rs.gitAttributes, rs.target.SourcePath(fileMapping.Src), srcContent) {
+		if err := rs.engine.checkBinaryTransform(rs.logger, fileMapping.Src); err != nil {
+			return nil, err
+		}
+		return srcContent, nil
+	}
+
+	topics, err := rs.engine.conditionalBlockTopics(ctx, rs.target)
+	if err != nil {
+		return nil, fmt.Errorf("transformation failed: %w", err)
+	}
+
+	transformCtx := NewTransformContext(rs.engine.GetCurrentGroup(), rs.sourceState.Repo, rs.target, rs.target.Transform,
+		fileMapping.Src, fileMapping.Dest, topics)
+
+	chain, err := rs.engine.transformChainFor(rs.target.Transform)
+	if err != nil {
+		return nil, fmt.Errorf("transformation failed: %w", err)
+	}
+	transformedContent, err := chain.Transform(ctx, srcContent, transformCtx)
+	if err != nil {
+		return nil, fmt.Errorf("transformation failed: %w", err)
+	}
+	return transformedContent, nil
+}
+
 // getExistingFileContent retrieves the current content of a file from the target repo
 func (rs *RepositorySync) getExistingFileContent(ctx context.Context, filePath string) ([]byte, error) {
+	// Reuse content already fetched by the content pre-check
+	if content, ok := rs.existingContent[filePath]; ok {
+		return content, nil
+	}
+
 	// Track API request
 	rs.TrackAPIRequest()
 
@@ -728,6 +1074,7 @@ func (rs *RepositorySync) getExistingFileContent(ctx context.Context, filePath s
 	if err != nil {
 		return nil, err
 	}
+	rs.recordTargetBlobSHA(filePath, fileContent.SHA)
 	return fileContent.Content, nil
 }
 
@@ -759,6 +1106,12 @@ func (rs *RepositorySync) processFileDeletion(ctx context.Context, fileMapping c
 
 // createSyncBranch creates a new sync branch or returns existing one
 func (rs *RepositorySync) createSyncBranch(_ context.Context) string {
+	// A fixed branch is reused as is by every sync
+	if rs.target.FixedBranch != "" {
+		rs.logger.WithField("branch_name", rs.target.FixedBranch).Info("Using fixed sync branch")
+		return rs.target.FixedBranch
+	}
+
 	// Generate branch name: chore/sync-files-{groupID}-YYYYMMDD-HHMMSS-{commit}
 	now := time.Now()
 	timestamp := now.Format("20060102-150405")
@@ -789,6 +1142,27 @@ func (rs *RepositorySync) createSyncBranch(_ context.Context) string {
 	}
 
 	branchName := fmt.Sprintf("%s-%s-%s-%s", branchPrefix, groupID, timestamp, commitSHA)
+	if tmpl := rs.getBranchNameTemplate(); tmpl != "" {
+		_, targetName, _ := strings.Cut(rs.target.Repo, "/")
+		rendered, err := config.RenderBranchName(tmpl, config.BranchNameData{
+			Prefix:       branchPrefix,
+			GroupID:      groupID,
+			SourceCommit: commitSHA,
+			Date:         now.Format("20060102"),
+			Time:         now.Format("150405"),
+			Timestamp:    timestamp,
+			TargetRepo:   rs.target.Repo,
+			TargetName:   targetName,
+		})
+		if err != nil {
+			rs.logger.WithError(err).Warn("Failed to render branch name template, using the default branch name")
+		} else {
+			branchName = rendered
+		}
+	}
+	if rs.engine.options.NoPR {
+		branchName += state.NoPRBranchSuffix
+	}
 
 	rs.logger.WithField("branch_name", branchName).Info("Creating sync branch")
 
@@ -802,17 +1176,36 @@ func (rs *RepositorySync) createSyncBranch(_ context.Context) string {
 	return branchName
 }
 
+// getBranchNameTemplate returns the sync branch name template for this
+// target: its own, else the group's global template, else the group default
+func (rs *RepositorySync) getBranchNameTemplate() string {
+	if rs.target.BranchNameTemplate != "" {
+		return rs.target.BranchNameTemplate
+	}
+	group := rs.engine.GetCurrentGroup()
+	if group == nil && len(rs.engine.config.Groups) > 0 {
+		group = &rs.engine.config.Groups[0]
+	}
+	if group == nil {
+		return ""
+	}
+	if group.Global.BranchNameTemplate != "" {
+		return group.Global.BranchNameTemplate
+	}
+	return group.Defaults.BranchNameTemplate
+}
+
 // commitChanges creates a commit with the changed files and returns commit SHA and actual changed files.
 // Even in dry-run mode, this clones the repo and stages files to generate accurate AI content.
 func (rs *RepositorySync) commitChanges(ctx context.Context, branchName string, changedFiles []FileChange) (string, []string, error) {
-	if len(changedFiles) == 0 {
+	if len(changedFiles) == 0 && !rs.engine.options.emptyResync() {
 		return "", nil, internalerrors.ErrNoFilesToCommit
 	}
 
 	// Clone the target repository for making changes
 	// We do this even in dry-run mode to get accurate diffs for AI generation
 	targetPath := filepath.Join(rs.tempDir, "target")
-	targetURL := fmt.Sprintf("https://github.com/%s.git", rs.target.Repo)
+	targetURL := rs.engine.repoCloneURL(rs.target.Repo)
 
 	// Disable partial clone for target repo - we need full blob content for accurate diffs.
 	// Partial clone with lazy blob fetching can cause git diff to show wrong base content
@@ -931,31 +1324,9 @@ func (rs *RepositorySync) commitChanges(ctx context.Context, branchName string,
 	}
 
 	// Apply file changes to the target repository
-	var filesToDelete []string
-	for _, fileChange := range changedFiles {
-		destPath := filepath.Join(targetPath, fileChange.Path)
-
-		if fileChange.IsDeleted {
-			// Handle file deletion
-			rs.logger.WithField("file", fileChange.Path).Debug("Marking file for deletion")
-			filesToDelete = append(filesToDelete, fileChange.Path)
-
-			// Remove the file from filesystem if it exists
-			if err := os.Remove(destPath); err != nil && !os.IsNotExist(err) {
-				return "", nil, fmt.Errorf("failed to remove file %s: %w", fileChange.Path, err)
-			}
-		} else {
-			// Handle file creation/modification
-			// Ensure parent directory exists
-			if err := os.MkdirAll(filepath.Dir(destPath), 0o750); err != nil {
-				return "", nil, fmt.Errorf("failed to create directory for %s: %w", fileChange.Path, err)
-			}
-
-			// Write the file content
-			if err := os.WriteFile(destPath, fileChange.Content, 0o600); err != nil {
-				return "", nil, fmt.Errorf("failed to write file %s: %w", fileChange.Path, err)
-			}
-		}
+	filesToDelete, err := applyFileChanges(targetPath, changedFiles)
+	if err != nil {
+		return "", nil, err
 	}
 
 	// Remove deleted files from git tracking
@@ -975,8 +1346,21 @@ func (rs *RepositorySync) commitChanges(ctx context.Context, branchName string,
 	// Store the target path for AI diff generation
 	rs.stagedRepoPath = targetPath
 
+	// A forced resync whose staged content is identical commits empty
+	emptyCommit, err := rs.isEmptyResync(ctx, targetPath, changedFiles)
+	if err != nil {
+		return "", nil, err
+	}
+	rs.emptyCommit = emptyCommit
+
 	// Generate commit message AFTER staging so we have the real git diff
-	commitMsg, aiGenerated := rs.generateCommitMessage(ctx, changedFiles)
+	var commitMsg string
+	var aiGenerated bool
+	if emptyCommit {
+		commitMsg = rs.generateEmptyResyncCommitMessage()
+	} else {
+		commitMsg, aiGenerated = rs.generateCommitMessage(ctx, changedFiles)
+	}
 	rs.commitAIGenerated = aiGenerated // Store for PR metadata block
 
 	// Log AI usage for commit message
@@ -991,6 +1375,7 @@ func (rs *RepositorySync) commitChanges(ctx context.Context, branchName string,
 		"files":        len(changedFiles),
 		"commit_msg":   commitMsg,
 		"ai_generated": aiGenerated,
+		"empty_commit": emptyCommit,
 	}).Info("Creating commit")
 
 	// For dry-run: show preview and return without committing
@@ -1006,7 +1391,11 @@ func (rs *RepositorySync) commitChanges(ctx context.Context, branchName string,
 	}
 
 	// Create the commit
-	if err := rs.engine.git.Commit(ctx, targetPath, commitMsg); err != nil {
+	if emptyCommit {
+		if err := rs.engine.git.CommitEmpty(ctx, targetPath, commitMsg); err != nil {
+			return "", nil, fmt.Errorf("failed to create empty resync commit: %w", err)
+		}
+	} else if err := rs.engine.git.Commit(ctx, targetPath, commitMsg); err != nil {
 		// Check if it's because there are no changes to commit
 		if errors.Is(err, git.ErrNoChanges) {
 			rs.logger.WithFields(logrus.Fields{
@@ -1041,14 +1430,73 @@ func (rs *RepositorySync) commitChanges(ctx context.Context, branchName string,
 	return commitSHA, actualChangedFiles, nil
 }
 
+// signingFormatLabel names the commit signature format shown in dry-run
+func signingFormatLabel(format string) string {
+	if format == "" {
+		format = config.SigningFormatGPG
+	}
+	return "yes (" + format + ")"
+}
+
+// isEmptyResync reports whether a forced resync (Options.AllowEmptyCommit
+// with Options.Force) has nothing staged and must create an empty commit
+func (rs *RepositorySync) isEmptyResync(ctx context.Context, targetPath string, changedFiles []FileChange) (bool, error) {
+	if !rs.engine.options.emptyResync() {
+		return false, nil
+	}
+	if len(changedFiles) == 0 {
+		return true, nil
+	}
+
+	staged, err := rs.engine.git.Diff(ctx, targetPath, true)
+	if err != nil {
+		return false, fmt.Errorf("failed to check staged changes: %w", err)
+	}
+	return strings.TrimSpace(staged) == "", nil
+}
+
+// generateEmptyResyncCommitMessage creates the message of an empty resync
+// commit, noting that the content is unchanged
+func (rs *RepositorySync) generateEmptyResyncCommitMessage() string {
+	commitSHA := rs.sourceState.LatestCommit
+	if len(commitSHA) > 7 {
+		commitSHA = commitSHA[:7]
+	}
+	return fmt.Sprintf("sync: empty resync from source repository (%s)\n\n"+
+		"Content is unchanged; this empty commit was forced to re-trigger checks.", commitSHA)
+}
+
+// reportPushedBranch prints the branch a --no-pr sync pushed, since no pull
+// request will point anyone at it
+func (rs *RepositorySync) reportPushedBranch(branchName string) {
+	rs.logger.WithField("branch", branchName).Info("Skipping pull request (--no-pr)")
+	if rs.engine.options.DryRun {
+		return
+	}
+	output.Info(fmt.Sprintf("%s: pushed branch %s to %s (no pull request)", rs.target.Repo, branchName, rs.branchRepo()))
+}
+
 // pushChanges pushes the branch to the target repository
 func (rs *RepositorySync) pushChanges(ctx context.Context, branchName string) error {
 	rs.logger.WithField("branch", branchName).Info("Pushing changes to target repository")
 
 	targetPath := filepath.Join(rs.tempDir, "target")
 
-	// Push the branch to the origin remote (which is the target repository)
-	if err := rs.engine.git.Push(ctx, targetPath, "origin", branchName, false); err != nil {
+	// Push the branch to the target repository, or to its fork when configured
+	remote, err := rs.pushRemote(ctx, targetPath)
+	if err != nil {
+		return err
+	}
+	// A fixed branch is rebuilt from the target branch by every sync, so it
+	// always replaces what the previous sync pushed
+	if rs.target.FixedBranch != "" {
+		if err := rs.engine.git.Push(ctx, targetPath, remote, branchName, true); err != nil {
+			return fmt.Errorf("failed to force push fixed branch %s to target repository: %w", branchName, err)
+		}
+		return nil
+	}
+
+	if err := rs.engine.git.Push(ctx, targetPath, remote, branchName, false); err != nil {
 		// Check if it's a branch already exists error
 		if errors.Is(err, git.ErrBranchAlreadyExists) {
 			rs.logger.WithFields(logrus.Fields{
@@ -1057,7 +1505,7 @@ func (rs *RepositorySync) pushChanges(ctx context.Context, branchName string) er
 			}).Warn("Branch already exists on remote, attempting force push to recover from partial sync")
 
 			// Try force push to overwrite the existing branch
-			if forceErr := rs.engine.git.Push(ctx, targetPath, "origin", branchName, true); forceErr != nil {
+			if forceErr := rs.engine.git.Push(ctx, targetPath, remote, branchName, true); forceErr != nil {
 				return fmt.Errorf("failed to force push branch %s after detecting existing branch: %w", branchName, forceErr)
 			}
 
@@ -1074,6 +1522,9 @@ func (rs *RepositorySync) pushChanges(ctx context.Context, branchName string) er
 func (rs *RepositorySync) createOrUpdatePR(ctx context.Context, branchName, commitSHA string, changedFiles []FileChange, actualChangedFiles []string) error {
 	// Check if PR already exists for this branch
 	existingPR := rs.findExistingPR(branchName)
+	if existingPR == nil && rs.target.FixedBranch != "" {
+		existingPR = rs.findFixedBranchPR(ctx, branchName)
+	}
 
 	if existingPR != nil {
 		return rs.updateExistingPR(ctx, existingPR, commitSHA, changedFiles, actualChangedFiles)
@@ -1097,6 +1548,20 @@ func (rs *RepositorySync) findExistingPR(branchName string) *gh.PR {
 	return nil
 }
 
+// findFixedBranchPR looks up the open PR of a fixed branch through the API.
+// State discovery only recognizes PRs from timestamped sync branches, so a
+// fixed branch's PR from an earlier sync is not in the target state.
+func (rs *RepositorySync) findFixedBranchPR(ctx context.Context, branchName string) *gh.PR {
+	pr, err := rs.checkForExistingPRViAPI(ctx, branchName)
+	if err != nil {
+		if !errors.Is(err, internalerrors.ErrPRNotFound) {
+			rs.logger.WithError(err).WithField("branch_name", branchName).Warn("Failed to look up the PR of the fixed branch")
+		}
+		return nil
+	}
+	return pr
+}
+
 // checkForExistingPRViAPI checks for existing PRs via direct GitHub API call
 // This is a fallback when state discovery might have missed PRs
 func (rs *RepositorySync) checkForExistingPRViAPI(ctx context.Context, branchName string) (*gh.PR, error) {
@@ -1124,6 +1589,7 @@ func (rs *RepositorySync) checkForExistingPRViAPI(ctx context.Context, branchNam
 // createNewPR creates a new pull request
 func (rs *RepositorySync) createNewPR(ctx context.Context, branchName, commitSHA string, changedFiles []FileChange, actualChangedFiles []string) error {
 	title := rs.generatePRTitle()
+	rs.changedFilesURL = rs.compareFilesURL(branchName)
 	body, aiGenerated := rs.generatePRBody(ctx, commitSHA, changedFiles, actualChangedFiles)
 
 	// Log AI usage for PR body
@@ -1140,7 +1606,8 @@ func (rs *RepositorySync) createNewPR(ctx context.Context, branchName, commitSHA
 	}).Info("Creating new pull request")
 
 	if rs.engine.options.DryRun {
-		rs.showDryRunPRPreview(ctx, branchName, title, body, aiGenerated)
+		rs.plannedPR = &DryRunPRPlan{Action: PlanActionCreate, Title: title, Body: body}
+		rs.showDryRunPRPreview(ctx, branchName, title, body, changedFiles, aiGenerated)
 		return nil
 	}
 
@@ -1190,7 +1657,11 @@ func (rs *RepositorySync) createNewPR(ctx context.Context, branchName, commitSHA
 	}
 
 	// Filter author from reviewers
-	reviewers := rs.getPRReviewers()
+	var author string
+	if currentUser != nil {
+		author = currentUser.Login
+	}
+	reviewers := rs.getPRReviewers(author)
 	if currentUser != nil && len(reviewers) > 0 {
 		filteredReviewers := make([]string, 0, len(reviewers))
 		for _, reviewer := range reviewers {
@@ -1206,12 +1677,18 @@ func (rs *RepositorySync) createNewPR(ctx context.Context, branchName, commitSHA
 	prRequest := gh.PRRequest{
 		Title:         title,
 		Body:          body,
-		Head:          branchName,
+		Head:          rs.prHeadRef(branchName),
 		Base:          baseBranch,
-		Labels:        rs.getPRLabels(),
+		Labels:        rs.getPRLabels(changedFiles),
 		Assignees:     rs.getPRAssignees(),
 		Reviewers:     reviewers,
 		TeamReviewers: rs.getPRTeamReviewers(),
+		Draft:         rs.getPRDraft(),
+	}
+
+	// Space pull request creation out across targets (--stagger)
+	if err := rs.engine.waitForPRSlot(ctx, rs.logger); err != nil {
+		return fmt.Errorf("canceled while waiting to open pull request: %w", err)
 	}
 
 	if rs.logger != nil {
@@ -1243,7 +1720,7 @@ func (rs *RepositorySync) createNewPR(ctx context.Context, branchName, commitSHA
 
 			// If no existing PR found, try branch cleanup and retry
 			rs.logger.Debug("No existing PR found, attempting branch cleanup and retry")
-			if deleteErr := rs.engine.gh.DeleteBranch(ctx, rs.target.Repo, branchName); deleteErr != nil {
+			if deleteErr := rs.engine.gh.DeleteBranch(ctx, rs.branchRepo(), branchName); deleteErr != nil {
 				rs.logger.WithError(deleteErr).Debug("Failed to delete orphaned branch (may not exist)")
 			}
 
@@ -1267,14 +1744,87 @@ func (rs *RepositorySync) createNewPR(ctx context.Context, branchName, commitSHA
 
 	// Capture PR info for metrics recording
 	rs.lastPRNumber = &pr.Number
-	rs.lastPRURL = fmt.Sprintf("https://github.com/%s/pull/%d", rs.target.Repo, pr.Number)
+	rs.lastPRURL = rs.engine.pullRequestURL(rs.target.Repo, pr.Number)
+	rs.lastPRAction = PRActionCreated
+
+	// With WaitForChecks, auto-merge is enabled once the PR checks pass
+	if !rs.waitsForChecks() {
+		rs.enableAutoMerge(ctx, pr.Number)
+	}
+
+	if rs.engine.options.CloseSupersededPRs {
+		rs.closeSupersededPRs(ctx, pr, branchName, baseBranch)
+	}
 
 	return nil
 }
 
+// enableAutoMerge turns on GitHub auto-merge for a newly created PR when
+// automerge is enabled. Failures are logged but never fail the sync: the
+// target repository may not allow auto-merge, and the automerge labels still
+// apply in that case.
+func (rs *RepositorySync) enableAutoMerge(ctx context.Context, prNumber int) {
+	if rs.engine.options == nil || !rs.engine.options.Automerge {
+		return
+	}
+
+	method := rs.getAutomergeMethod()
+	rs.TrackAPIRequest()
+	if err := rs.engine.gh.EnableAutoMergePR(ctx, rs.target.Repo, prNumber, method); err != nil {
+		rs.logger.WithError(err).WithFields(logrus.Fields{
+			"pr_number":    prNumber,
+			"merge_method": method,
+		}).Warn("Failed to enable auto-merge for pull request")
+		return
+	}
+
+	rs.logger.WithFields(logrus.Fields{
+		"pr_number":    prNumber,
+		"merge_method": method,
+	}).Info("Auto-merge enabled for pull request")
+}
+
+// getAutomergeMethod returns the merge method to use for auto-merge, preferring
+// the CLI option, then the group defaults, then config.DefaultAutomergeMethod
+func (rs *RepositorySync) getAutomergeMethod() gh.MergeMethod {
+	if rs.engine.options != nil && rs.engine.options.AutomergeMethod != "" {
+		return gh.MergeMethod(rs.engine.options.AutomergeMethod)
+	}
+
+	var configured string
+	if currentGroup := rs.engine.GetCurrentGroup(); currentGroup != nil {
+		configured = currentGroup.Defaults.AutomergeMethod
+	} else if rs.engine.config != nil && len(rs.engine.config.Groups) > 0 {
+		configured = rs.engine.config.Groups[0].Defaults.AutomergeMethod
+	}
+
+	if configured != "" {
+		return gh.MergeMethod(configured)
+	}
+	return gh.MergeMethod(config.DefaultAutomergeMethod)
+}
+
+// getPRUpdateRetries returns how many times a conflicting PR update is retried,
+// taken from the group defaults or config.DefaultPRUpdateRetries when unset.
+// An explicit zero disables retries.
+func (rs *RepositorySync) getPRUpdateRetries() int {
+	var configured *int
+	if currentGroup := rs.engine.GetCurrentGroup(); currentGroup != nil {
+		configured = currentGroup.Defaults.PRUpdateRetries
+	} else if rs.engine.config != nil && len(rs.engine.config.Groups) > 0 {
+		configured = rs.engine.config.Groups[0].Defaults.PRUpdateRetries
+	}
+
+	if configured != nil {
+		return *configured
+	}
+	return config.DefaultPRUpdateRetries
+}
+
 // updateExistingPR updates an existing pull request
 func (rs *RepositorySync) updateExistingPR(ctx context.Context, pr *gh.PR, commitSHA string, changedFiles []FileChange, actualChangedFiles []string) error {
 	rs.logger.WithField("pr_number", pr.Number).Info("Updating existing pull request")
+	rs.changedFilesURL = rs.pullRequestFilesURL(pr.Number)
 
 	if rs.engine.options.DryRun {
 		out := NewDryRunOutput(nil)
@@ -1291,6 +1841,11 @@ func (rs *RepositorySync) updateExistingPR(ctx context.Context, pr *gh.PR, commi
 
 		// Show the files that would be updated
 		rs.showDryRunFileChanges(changedFiles)
+
+		if rs.engine.options.DryRunPlanFile != "" {
+			body, _ := rs.generatePRBody(ctx, commitSHA, changedFiles, actualChangedFiles)
+			rs.plannedPR = &DryRunPRPlan{Action: PlanActionUpdate, Number: pr.Number, Title: pr.Title, Body: body}
+		}
 		return nil
 	}
 
@@ -1303,7 +1858,7 @@ func (rs *RepositorySync) updateExistingPR(ctx context.Context, pr *gh.PR, commi
 	}
 
 	rs.TrackAPIRequest()
-	if err := rs.engine.gh.UpdatePR(ctx, rs.target.Repo, pr.Number, updates); err != nil {
+	if err := gh.UpdatePRWithRetry(ctx, rs.engine.gh, rs.target.Repo, pr.Number, rs.getPRUpdateRetries(), updates, rs.logger); err != nil {
 		return fmt.Errorf("failed to update PR: %w", err)
 	}
 
@@ -1311,7 +1866,8 @@ func (rs *RepositorySync) updateExistingPR(ctx context.Context, pr *gh.PR, commi
 
 	// Capture PR info for metrics recording
 	rs.lastPRNumber = &pr.Number
-	rs.lastPRURL = fmt.Sprintf("https://github.com/%s/pull/%d", rs.target.Repo, pr.Number)
+	rs.lastPRURL = rs.engine.pullRequestURL(rs.target.Repo, pr.Number)
+	rs.lastPRAction = PRActionUpdated
 
 	return nil
 }
@@ -1514,8 +2070,29 @@ func (rs *RepositorySync) generatePRTitle() string {
 	return fmt.Sprintf("[Sync] Update project files from source repository (%s)", commitSHA)
 }
 
+// Static sections of generated PR bodies, also used to fill target PR templates
+const (
+	prBodyWhySection = "This synchronization ensures the target repository stays up-to-date with the latest changes from the configured source repository. " +
+		"The sync operation identifies and applies only the necessary file changes while maintaining consistency across repositories.\n"
+	prBodyTestingSection = "* Validated sync configuration and file mappings\n" +
+		"* Verified file transformations applied correctly\n" +
+		"* Confirmed no unintended changes were introduced\n" +
+		"* All automated checks and linters passed\n"
+	prBodyImpactSection = "* **Low Risk**: Standard sync operation with established patterns\n" +
+		"* **No Breaking Changes**: File updates maintain backward compatibility\n" +
+		"* **Performance**: No impact on application performance\n" +
+		"* **Dependencies**: No dependency changes included in this sync\n"
+
+	// prBodyStaticSections is the part of every static PR body that does not
+	// depend on the target, built once instead of per target
+	prBodyStaticSections = "## Why It Was Necessary\n" + prBodyWhySection + "\n" +
+		"## Testing Performed\n" + prBodyTestingSection + "\n" +
+		"## Impact / Risk\n" + prBodyImpactSection + "\n"
+)
+
 // generatePRBody creates a detailed PR description with metadata including directory sync info.
-// Tries AI generation first if enabled, falls back to static template.
+// Tries AI generation first if enabled, then the target's PR template when
+// configured, and falls back to the static template.
 // Returns (body, aiGenerated) where aiGenerated indicates if AI successfully generated the body.
 func (rs *RepositorySync) generatePRBody(ctx context.Context, commitSHA string, changedFiles []FileChange, actualChangedFiles []string) (string, bool) {
 	var sb strings.Builder
@@ -1571,6 +2148,8 @@ func (rs *RepositorySync) generatePRBody(ctx context.Context, commitSHA string,
 			if aiGenerated {
 				sb.WriteString(aiBody)
 				sb.WriteString("\n\n")
+				rs.writeChangedFilesChecklist(&sb, filteredChanges, nil)
+				rs.writeExtraSections(&sb)
 				// CRITICAL: Metadata is NEVER AI-generated - always append static metadata
 				// Use filteredChanges so metadata reflects what AI actually saw
 				rs.writeMetadataBlock(&sb, commitSHA, filteredChanges, true) // PR body was AI-generated
@@ -1582,16 +2161,24 @@ func (rs *RepositorySync) generatePRBody(ctx context.Context, commitSHA string,
 		}
 	}
 
+	// The target's own PR template, with go-broadcast sections filled in
+	if template := rs.targetPRTemplate(ctx); template != "" {
+		sb.WriteString(rs.renderTargetPRTemplate(template, commitSHA, changedFiles, actualChangedFiles))
+		rs.writeChangedFilesChecklist(&sb, changedFiles, actualChangedFiles)
+		rs.writeExtraSections(&sb)
+		rs.writeMetadataBlock(&sb, commitSHA, changedFiles, false)
+		return sb.String(), false
+	}
+
 	// Existing static generation (fallback)
 
 	// What Changed section with enhanced details
 	sb.WriteString("## What Changed\n")
-	rs.writeChangeSummary(&sb, changedFiles, actualChangedFiles)
-	shortSHA := commitSHA
-	if len(commitSHA) > 7 {
-		shortSHA = commitSHA[:7]
-	}
-	fmt.Fprintf(&sb, "* Brought target repository in line with source repository state at commit %s\n\n", shortSHA)
+	sb.WriteString(rs.whatChangedSection(commitSHA, changedFiles, actualChangedFiles))
+	sb.WriteString("\n")
+
+	// Collapsible checklist of the changed files
+	rs.writeChangedFilesChecklist(&sb, changedFiles, actualChangedFiles)
 
 	// Directory synchronization details (if directories are configured)
 	if len(rs.target.Directories) > 0 {
@@ -1601,24 +2188,11 @@ func (rs *RepositorySync) generatePRBody(ctx context.Context, commitSHA string,
 	// Performance metrics section
 	rs.writePerformanceMetrics(&sb)
 
-	// Why It Was Necessary section
-	sb.WriteString("## Why It Was Necessary\n")
-	sb.WriteString("This synchronization ensures the target repository stays up-to-date with the latest changes from the configured source repository. ")
-	sb.WriteString("The sync operation identifies and applies only the necessary file changes while maintaining consistency across repositories.\n\n")
-
-	// Testing Performed section
-	sb.WriteString("## Testing Performed\n")
-	sb.WriteString("* Validated sync configuration and file mappings\n")
-	sb.WriteString("* Verified file transformations applied correctly\n")
-	sb.WriteString("* Confirmed no unintended changes were introduced\n")
-	sb.WriteString("* All automated checks and linters passed\n\n")
-
-	// Impact / Risk section
-	sb.WriteString("## Impact / Risk\n")
-	sb.WriteString("* **Low Risk**: Standard sync operation with established patterns\n")
-	sb.WriteString("* **No Breaking Changes**: File updates maintain backward compatibility\n")
-	sb.WriteString("* **Performance**: No impact on application performance\n")
-	sb.WriteString("* **Dependencies**: No dependency changes included in this sync\n\n")
+	// Why It Was Necessary, Testing Performed and Impact / Risk sections
+	sb.WriteString(prBodyStaticSections)
+
+	// Custom sections from config render after the standard ones
+	rs.writeExtraSections(&sb)
 
 	// Add enhanced metadata as YAML block
 	rs.writeMetadataBlock(&sb, commitSHA, changedFiles, false) // PR body was NOT AI-generated
@@ -1744,6 +2318,9 @@ func (rs *RepositorySync) writePerformanceMetrics(sb *strings.Builder) {
 	if rs.syncMetrics.APICallsSaved > 0 {
 		fmt.Fprintf(sb, "* **API calls saved**: %d (through optimization)\n", rs.syncMetrics.APICallsSaved)
 	}
+	if rs.syncMetrics.RateLimitThrottles > 0 {
+		fmt.Fprintf(sb, "* **API calls throttled**: %d (client rate limit)\n", rs.syncMetrics.RateLimitThrottles)
+	}
 
 	// Cache performance
 	if rs.syncMetrics.CacheHits > 0 || rs.syncMetrics.CacheMisses > 0 {
@@ -1756,9 +2333,65 @@ func (rs *RepositorySync) writePerformanceMetrics(sb *strings.Builder) {
 	sb.WriteString("\n")
 }
 
+// getPRBodyExtraSections returns the custom PR body sections for this target,
+// preferring the target's own sections over the group defaults
+func (rs *RepositorySync) getPRBodyExtraSections() []config.PRBodySection {
+	if len(rs.target.PRBodyExtraSections) > 0 {
+		return rs.target.PRBodyExtraSections
+	}
+	if rs.engine == nil {
+		return nil
+	}
+	if currentGroup := rs.engine.GetCurrentGroup(); currentGroup != nil {
+		return currentGroup.Defaults.PRBodyExtraSections
+	}
+	if rs.engine.config != nil && len(rs.engine.config.Groups) > 0 {
+		return rs.engine.config.Groups[0].Defaults.PRBodyExtraSections
+	}
+	return nil
+}
+
+// writeExtraSections writes the configured custom PR body sections, replacing
+// transform variables in each section's markdown
+func (rs *RepositorySync) writeExtraSections(sb *strings.Builder) {
+	sections := rs.getPRBodyExtraSections()
+	if len(sections) == 0 {
+		return
+	}
+
+	logger := logrus.StandardLogger()
+	if rs.logger != nil {
+		logger = rs.logger.Logger
+	}
+	renderer := transform.NewTemplateTransformer(logger, nil)
+	transformCtx := transform.Context{
+		SourceRepo: rs.sourceState.Repo,
+		TargetRepo: rs.target.Repo,
+		Variables:  rs.target.Transform.Variables,
+	}
+
+	for _, section := range sections {
+		markdown := section.Markdown
+		rendered, err := renderer.Transform([]byte(markdown), transformCtx)
+		if err != nil {
+			if rs.logger != nil {
+				rs.logger.WithError(err).WithField("section_title", section.Title).
+					Warn("Failed to render PR body extra section, using raw markdown")
+			}
+		} else {
+			markdown = string(rendered)
+		}
+
+		fmt.Fprintf(sb, "## %s\n", strings.TrimSpace(section.Title))
+		sb.WriteString(strings.TrimRight(markdown, "\n"))
+		sb.WriteString("\n\n")
+	}
+}
+
 // writeMetadataBlock writes the machine-parseable metadata block
 func (rs *RepositorySync) writeMetadataBlock(sb *strings.Builder, commitSHA string, changedFiles []FileChange, prBodyAIGenerated bool) {
 	sb.WriteString("<!-- go-broadcast-metadata\n")
+	contentStart := sb.Len()
 
 	// Add group information if available
 	if rs.engine != nil {
@@ -1792,6 +2425,10 @@ func (rs *RepositorySync) writeMetadataBlock(sb *strings.Builder, commitSHA stri
 	fmt.Fprintf(sb, "  target_repo: %s\n", rs.target.Repo)
 	fmt.Fprintf(sb, "  sync_commit: %s\n", commitSHA)
 	fmt.Fprintf(sb, "  sync_time: %s\n", time.Now().Format(time.RFC3339))
+	if hash := rs.syncedContentHash(); hash != "" {
+		fmt.Fprintf(sb, "  content_hash: %s\n", hash)
+	}
+	rs.writeFileBlobSHAs(sb)
 
 	// Add AI generation status
 	sb.WriteString("ai_generated:\n")
@@ -1848,14 +2485,31 @@ func (rs *RepositorySync) writeMetadataBlock(sb *strings.Builder, commitSHA stri
 			fmt.Fprintf(sb, "  api_calls_saved: %d\n", rs.syncMetrics.APICallsSaved)
 		}
 
+		if rs.syncMetrics.RateLimitThrottles > 0 {
+			fmt.Fprintf(sb, "  rate_limit_throttles: %d\n", rs.syncMetrics.RateLimitThrottles)
+		}
+
 		if rs.syncMetrics.CacheHits > 0 {
 			fmt.Fprintf(sb, "  cache_hits: %d\n", rs.syncMetrics.CacheHits)
 		}
 	}
 
+	// Sign everything above so status can tell hand-edited metadata apart
+	if secret := rs.metadataSecret(); len(secret) > 0 {
+		sb.WriteString(state.MetadataSignatureLine(sb.String()[contentStart:], secret))
+	}
+
 	sb.WriteString("-->\n")
 }
 
+// metadataSecret returns the key the metadata block is signed with, nil when unset
+func (rs *RepositorySync) metadataSecret() []byte {
+	if rs.engine == nil || rs.engine.options == nil {
+		return nil
+	}
+	return rs.engine.options.MetadataSecret
+}
+
 // isDirectoryFile determines if a file change is from directory processing
 func (rs *RepositorySync) isDirectoryFile(filePath string) bool {
 	// Check if the file path matches any of the configured directory destinations
@@ -1885,10 +2539,7 @@ func (rs *RepositorySync) processDirectoriesWithMetrics(ctx context.Context) ([]
 	rs.logger.WithField("directory_count", len(rs.target.Directories)).Info("Processing directories with metrics collection")
 
 	// Construct source repo URL for module-aware sync
-	sourceRepoURL := ""
-	if rs.sourceState != nil && rs.sourceState.Repo != "" {
-		sourceRepoURL = fmt.Sprintf("https://github.com/%s", rs.sourceState.Repo)
-	}
+	sourceRepoURL := rs.sourceRepoURL()
 
 	// Build directory processor options
 	var opts *DirectoryProcessorOptions
@@ -1919,7 +2570,7 @@ func (rs *RepositorySync) processDirectoriesWithMetrics(ctx context.Context) ([]
 
 		// Build the source path using the same logic as processDirectories
 		// This should match the pattern used in regular directory processing
-		sourcePath := filepath.Join(rs.tempDir, "source")
+		sourcePath := rs.mappingSourcePath()
 		fullSourceDir := filepath.Join(sourcePath, dirMapping.Src)
 
 		// Verify source directory exists
@@ -2009,6 +2660,17 @@ func (rs *RepositorySync) TrackCacheMiss() {
 	}
 }
 
+// trackRateLimitThrottles records how many of this sync's API calls the shared
+// rate limiter has delayed and how many were retried. Calls made by other
+// concurrent targets are counted by their own syncs; the run-wide total is
+// logged by the engine.
+func (rs *RepositorySync) trackRateLimitThrottles() {
+	if rs.syncMetrics != nil && rs.throttles != nil {
+		rs.syncMetrics.RateLimitThrottles = int(rs.throttles.Throttled())
+		rs.syncMetrics.Retries = int(rs.throttles.Retried())
+	}
+}
+
 // TrackAPIRequest increments the total API requests counter
 func (rs *RepositorySync) TrackAPIRequest() {
 	if rs.syncMetrics != nil {
@@ -2111,9 +2773,9 @@ func (rs *RepositorySync) recordTargetResult(
 	// Resolve group DB ID
 	var groupExternalID string
 	if currentGroup := rs.engine.GetCurrentGroup(); currentGroup != nil {
-		groupExternalID = currentGroup.ID
+		groupExternalID = currentGroup.ConfiguredID()
 	} else if len(rs.engine.config.Groups) > 0 {
-		groupExternalID = rs.engine.config.Groups[0].ID
+		groupExternalID = rs.engine.config.Groups[0].ConfiguredID()
 	}
 
 	var targetID uint
@@ -2214,7 +2876,7 @@ func (rs *RepositorySync) recordTargetResult(
 	}
 
 	// Update repo's last broadcast sync timestamp on success
-	if status == TargetStatusSuccess || status == TargetStatusNoChanges {
+	if status == TargetStatusSuccess || status == TargetStatusNoChanges || status == TargetStatusBranchPushed {
 		if tsErr := rs.engine.syncRepo.UpdateRepoSyncTimestamp(ctx, repoID, endTime, run.ID); tsErr != nil {
 			log.WithError(tsErr).Warn("Failed to update repo broadcast sync timestamp")
 		}
@@ -2253,6 +2915,36 @@ type FileChange struct {
 	IsDeleted       bool
 }
 
+// applyFileChanges writes changed files into the working tree at repoPath and
+// removes deleted ones, returning the deleted paths so they can be untracked
+func applyFileChanges(repoPath string, changedFiles []FileChange) ([]string, error) {
+	var filesToDelete []string
+	for _, fileChange := range changedFiles {
+		destPath := filepath.Join(repoPath, fileChange.Path)
+
+		if fileChange.IsDeleted {
+			filesToDelete = append(filesToDelete, fileChange.Path)
+
+			// Remove the file from filesystem if it exists
+			if err := os.Remove(destPath); err != nil && !os.IsNotExist(err) {
+				return nil, fmt.Errorf("failed to remove file %s: %w", fileChange.Path, err)
+			}
+			continue
+		}
+
+		// Ensure parent directory exists
+		if err := os.MkdirAll(filepath.Dir(destPath), 0o750); err != nil {
+			return nil, fmt.Errorf("failed to create directory for %s: %w", fileChange.Path, err)
+		}
+
+		// Write the file content
+		if err := os.WriteFile(destPath, fileChange.Content, 0o600); err != nil {
+			return nil, fmt.Errorf("failed to write file %s: %w", fileChange.Path, err)
+		}
+	}
+	return filesToDelete, nil
+}
+
 // showDryRunCommitInfo displays commit information preview for dry-run.
 // Accepts pre-generated commit message to avoid redundant AI calls.
 // aiGenerated indicates whether the message was actually generated by AI (not fallback).
@@ -2272,6 +2964,12 @@ func (rs *RepositorySync) showDryRunCommitInfo(commitMsg string, changedFiles []
 		out.Field("Source", "📝 Static template")
 	}
 	out.Field("Files", fmt.Sprintf("%d changed", len(changedFiles)))
+	if rs.emptyCommit {
+		out.Field("Empty", "yes (forced resync, content unchanged)")
+	}
+	if rs.engine != nil && rs.engine.options != nil && rs.engine.options.SigningKey != "" {
+		out.Field("Signed", signingFormatLabel(rs.engine.options.SigningFormat))
+	}
 
 	// Show file summary
 	fileNames := make([]string, 0, len(changedFiles))
@@ -2349,7 +3047,7 @@ func (rs *RepositorySync) formatReviewersWithFiltering(reviewers []string, curre
 // showDryRunPRPreview displays full PR preview with formatting.
 // Accepts pre-generated title and body to avoid redundant AI calls.
 // aiGenerated indicates whether the body was actually generated by AI (not fallback).
-func (rs *RepositorySync) showDryRunPRPreview(ctx context.Context, branchName, title, body string, aiGenerated bool) {
+func (rs *RepositorySync) showDryRunPRPreview(ctx context.Context, branchName, title, body string, changedFiles []FileChange, aiGenerated bool) {
 	rs.logger.WithFields(logrus.Fields{
 		"branch": branchName,
 	}).Debug("Showing PR preview")
@@ -2384,9 +3082,15 @@ func (rs *RepositorySync) showDryRunPRPreview(ctx context.Context, branchName, t
 	// Show PR assignment details
 	out.Content("Assignment Details:")
 	out.Content(fmt.Sprintf("• Assignees: %s", rs.formatAssignmentList(rs.getPRAssignees())))
-	out.Content(fmt.Sprintf("• Labels: %s", rs.formatAssignmentList(rs.getPRLabels())))
-	out.Content(fmt.Sprintf("• Reviewers: %s", rs.formatReviewersWithFiltering(rs.getPRReviewers(), currentUserLogin)))
+	out.Content(fmt.Sprintf("• Labels: %s", rs.formatAssignmentList(rs.getPRLabels(changedFiles))))
+	out.Content(fmt.Sprintf("• Reviewers: %s", rs.formatReviewersWithFiltering(rs.getPRReviewers(currentUserLogin), currentUserLogin)))
 	out.Content(fmt.Sprintf("• Team Reviewers: %s", rs.formatAssignmentList(rs.getPRTeamReviewers())))
+	if rs.engine.options != nil && rs.engine.options.Automerge {
+		out.Content(fmt.Sprintf("• Auto-merge: enabled (%s)", rs.getAutomergeMethod()))
+	}
+	if rs.getPRDraft() {
+		out.Content("• Draft: yes (would be created as a draft PR)")
+	}
 	out.Separator()
 
 	// Split body into lines and display with proper formatting
@@ -2430,8 +3134,8 @@ func (rs *RepositorySync) mergeUniqueStrings(slice1, slice2 []string) []string {
 	return result
 }
 
-// getPRAssignees returns the assignees to use for PRs, merging global + target assignments
-func (rs *RepositorySync) getPRAssignees() []string {
+// resolvePRAssignees resolves the assignees to use for PRs, merging global + target assignments
+func (rs *RepositorySync) resolvePRAssignees() []string {
 	var global []string
 	var defaults []string
 
@@ -2453,13 +3157,18 @@ func (rs *RepositorySync) getPRAssignees() []string {
 
 	// Fall back to defaults if no assignments
 	if len(combined) == 0 {
-		return defaults
+		combined = defaults
 	}
-	return combined
+
+	var override []string
+	if rs.engine.options != nil {
+		override = rs.engine.options.PRAssignees
+	}
+	return rs.applyPROverride(combined, override)
 }
 
-// getPRReviewers returns the reviewers to use for PRs, merging global + target assignments
-func (rs *RepositorySync) getPRReviewers() []string {
+// resolvePRReviewers resolves the reviewers to use for PRs, merging global + target assignments
+func (rs *RepositorySync) resolvePRReviewers() []string {
 	var global []string
 	var defaults []string
 
@@ -2481,13 +3190,18 @@ func (rs *RepositorySync) getPRReviewers() []string {
 
 	// Fall back to defaults if no assignments
 	if len(combined) == 0 {
-		return defaults
+		combined = defaults
 	}
-	return combined
+
+	var override []string
+	if rs.engine.options != nil {
+		override = rs.engine.options.PRReviewers
+	}
+	return rs.applyPROverride(combined, override)
 }
 
-// getPRLabels returns the labels to use for PRs, merging global + target assignments
-func (rs *RepositorySync) getPRLabels() []string {
+// resolvePRLabels resolves the labels to use for PRs, merging global + target assignments
+func (rs *RepositorySync) resolvePRLabels() []string {
 	var global []string
 	var defaults []string
 
@@ -2512,6 +3226,10 @@ func (rs *RepositorySync) getPRLabels() []string {
 		combined = defaults
 	}
 
+	if rs.engine.options != nil {
+		combined = rs.applyPROverride(combined, rs.engine.options.PRLabels)
+	}
+
 	// Add automerge labels if automerge is enabled
 	if rs.engine.options != nil && rs.engine.options.Automerge && len(rs.engine.options.AutomergeLabels) > 0 {
 		combined = rs.mergeUniqueStrings(combined, rs.engine.options.AutomergeLabels)
@@ -2520,8 +3238,8 @@ func (rs *RepositorySync) getPRLabels() []string {
 	return combined
 }
 
-// getPRTeamReviewers returns the team reviewers to use for PRs, merging global + target assignments
-func (rs *RepositorySync) getPRTeamReviewers() []string {
+// resolvePRTeamReviewers resolves the team reviewers to use for PRs, merging global + target assignments
+func (rs *RepositorySync) resolvePRTeamReviewers() []string {
 	var global []string
 	var defaults []string
 
@@ -2548,6 +3266,39 @@ func (rs *RepositorySync) getPRTeamReviewers() []string {
 	return combined
 }
 
+// applyPROverride applies a CLI override to values resolved from configuration.
+// An empty override keeps the resolved values; otherwise the override replaces
+// them, or is merged in after them when the PR labels mode is merge.
+func (rs *RepositorySync) applyPROverride(resolved, override []string) []string {
+	if len(override) == 0 {
+		return resolved
+	}
+	if rs.engine.options != nil && rs.engine.options.PRLabelsMode == config.PRLabelsModeMerge {
+		return rs.mergeUniqueStrings(resolved, override)
+	}
+	return rs.mergeUniqueStrings(nil, override)
+}
+
+// getPRDraft reports whether PRs are created as drafts: the CLI option forces
+// drafts, then the target setting wins, then global or group defaults enable it
+func (rs *RepositorySync) getPRDraft() bool {
+	if rs.engine.options != nil && rs.engine.options.Draft {
+		return true
+	}
+	if rs.target.PRDraft != nil {
+		return *rs.target.PRDraft
+	}
+
+	if currentGroup := rs.engine.GetCurrentGroup(); currentGroup != nil {
+		return currentGroup.Global.PRDraft || currentGroup.Defaults.PRDraft
+	}
+	// Get from the first group (since we have a single group in temporary config)
+	if rs.engine.config != nil && len(rs.engine.config.Groups) > 0 {
+		return rs.engine.config.Groups[0].Global.PRDraft || rs.engine.config.Groups[0].Defaults.PRDraft
+	}
+	return false
+}
+
 // updateDirectoryMetricsWithActualChanges updates directory metrics with the files that actually changed in git
 func (rs *RepositorySync) updateDirectoryMetricsWithActualChanges(actualChangedFiles []string) {
 	if rs.syncMetrics == nil || rs.syncMetrics.DirectoryMetrics == nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
 	"github.com/mrz1836/go-broadcast/internal/state"
 )
 
@@ -223,6 +224,13 @@ func TestRepositorySync_writePerformanceMetricsEdgeCases(t *testing.T) {
 			expected:    []string{"## Performance Metrics"},
 			notExpected: []string{"**Files processed**", "**API calls saved**"},
 		},
+		{
+			name: "rate limit throttles",
+			syncMetrics: &PerformanceMetrics{
+				RateLimitThrottles: 7,
+			},
+			expected: []string{"**API calls throttled**: 7 (client rate limit)"},
+		},
 		{
 			name: "only file metrics",
 			syncMetrics: &PerformanceMetrics{
@@ -330,3 +338,43 @@ func TestRepositorySync_writePerformanceMetricsEdgeCases(t *testing.T) {
 		})
 	}
 }
+
+// rateLimitedMockClient is a mock client that reports rate limiter activity
+type rateLimitedMockClient struct {
+	*gh.MockClient
+
+	throttled int64
+}
+
+func (c *rateLimitedMockClient) RateLimitStats() gh.RateLimitStats {
+	return gh.RateLimitStats{Throttled: c.throttled}
+}
+
+func TestRepositorySync_trackRateLimitThrottles(t *testing.T) {
+	t.Run("client without limiter", func(t *testing.T) {
+		engine := &Engine{gh: &gh.MockClient{}}
+		assert.Equal(t, gh.RateLimitStats{}, engine.RateLimitStats())
+
+		repoSync := &RepositorySync{engine: engine, syncMetrics: &PerformanceMetrics{}}
+		repoSync.trackRateLimitThrottles()
+		assert.Zero(t, repoSync.syncMetrics.RateLimitThrottles)
+	})
+
+	t.Run("counts only this sync's throttles", func(t *testing.T) {
+		client := &rateLimitedMockClient{MockClient: &gh.MockClient{}, throttled: 8}
+		repoSync := &RepositorySync{
+			engine:      &Engine{gh: client},
+			syncMetrics: &PerformanceMetrics{},
+			throttles:   &gh.ThrottleCounter{},
+		}
+
+		repoSync.trackRateLimitThrottles()
+		assert.Zero(t, repoSync.syncMetrics.RateLimitThrottles)
+		assert.Equal(t, int64(8), repoSync.engine.RateLimitStats().Throttled)
+	})
+
+	t.Run("nil metrics", func(t *testing.T) {
+		repoSync := &RepositorySync{engine: &Engine{gh: &gh.MockClient{}}}
+		assert.NotPanics(t, repoSync.trackRateLimitThrottles)
+	})
+}
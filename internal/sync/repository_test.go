@@ -28,6 +28,8 @@ var (
 	errTestGetSHA          = errors.New("failed to get SHA")
 	errTestForcePushFailed = errors.New("force push failed")
 	errTestGitCommand      = errors.New("git command failed: permission denied")
+
+	errTestAutoMergeDisabled = errors.New("auto-merge is not allowed for this repository")
 )
 
 func TestRepositorySync_Execute(t *testing.T) {
@@ -108,6 +110,10 @@ func TestRepositorySync_Execute(t *testing.T) {
 			Return(&gh.FileContent{Content: []byte("old content 1")}, nil)
 		ghClient.On("GetFile", mock.Anything, "org/target", "file2.txt", "").
 			Return(&gh.FileContent{Content: []byte("old content 2")}, nil)
+		ghClient.On("GetFile", mock.Anything, "org/template", "file1.txt", "abc123").
+			Return(&gh.FileContent{Content: []byte("content 1")}, nil).Maybe()
+		ghClient.On("GetFile", mock.Anything, "org/template", "file2.txt", "abc123").
+			Return(&gh.FileContent{Content: []byte("content 2")}, nil).Maybe()
 
 		// Mock target repository git operations
 		gitClient.On("CreateBranch", mock.Anything, mock.Anything, mock.AnythingOfType("string")).Return(nil)
@@ -207,6 +213,10 @@ func TestRepositorySync_Execute(t *testing.T) {
 		// Setup default expectations for pre-sync validation
 		ghClient.On("ListBranches", mock.Anything, mock.Anything).Return([]gh.Branch{}, nil).Maybe()
 
+		// Target files are missing, so the content pre-check falls through to cloning
+		ghClient.On("GetFile", mock.Anything, "org/target", mock.Anything, "").
+			Return(nil, gh.ErrFileNotFound).Maybe()
+
 		// Mock git clone failure
 		gitClient.On("Clone", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(internalerrors.ErrTest)
@@ -240,6 +250,11 @@ func TestRepositorySync_Execute(t *testing.T) {
 		// Should fail
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to clone source")
+		require.ErrorIs(t, err, internalerrors.ErrTest)
+		var syncErr *SyncError
+		require.ErrorAs(t, err, &syncErr)
+		assert.Equal(t, PhaseClone, syncErr.Phase)
+		assert.Equal(t, target.Repo, syncErr.Target)
 		gitClient.AssertExpectations(t)
 	})
 
@@ -307,6 +322,10 @@ func TestRepositorySync_Execute(t *testing.T) {
 			Return(&gh.FileContent{Content: []byte("old content 1")}, nil)
 		ghClient.On("GetFile", mock.Anything, "org/target", "file2.txt", "").
 			Return(&gh.FileContent{Content: []byte("old content 2")}, nil)
+		ghClient.On("GetFile", mock.Anything, "org/template", "file1.txt", "abc123").
+			Return(&gh.FileContent{Content: []byte("content 1")}, nil).Maybe()
+		ghClient.On("GetFile", mock.Anything, "org/template", "file2.txt", "abc123").
+			Return(&gh.FileContent{Content: []byte("content 2")}, nil).Maybe()
 
 		// Mock transformations
 		transformChain.On("Transform", mock.Anything, []byte("content 1"), mock.Anything).
@@ -359,26 +378,27 @@ func TestRepositorySync_needsSync(t *testing.T) {
 	}
 
 	repoSync := &RepositorySync{
+		engine:      &Engine{options: DefaultOptions()},
 		sourceState: sourceState,
 	}
 
 	t.Run("no target state", func(t *testing.T) {
 		repoSync.targetState = nil
-		assert.True(t, repoSync.needsSync())
+		assert.True(t, repoSync.needsSync(context.Background()))
 	})
 
 	t.Run("different commits", func(t *testing.T) {
 		repoSync.targetState = &state.TargetState{
 			LastSyncCommit: "old123",
 		}
-		assert.True(t, repoSync.needsSync())
+		assert.True(t, repoSync.needsSync(context.Background()))
 	})
 
 	t.Run("same commits", func(t *testing.T) {
 		repoSync.targetState = &state.TargetState{
 			LastSyncCommit: "abc123",
 		}
-		assert.False(t, repoSync.needsSync())
+		assert.False(t, repoSync.needsSync(context.Background()))
 	})
 }
 
@@ -460,6 +480,61 @@ func TestRepositorySync_generatePRBody(t *testing.T) {
 	assert.Contains(t, body, "sync_commit: commit456")
 }
 
+func TestRepositorySync_generatePRBody_ExtraSections(t *testing.T) {
+	defaults := config.DefaultConfig{PRBodyExtraSections: []config.PRBodySection{
+		{Title: "Release Checklist", Markdown: "- [ ] Notify {{TEAM}} about ${SERVICE_NAME}\n"},
+		{Title: "Owners", Markdown: "Maintained by the platform team"},
+	}}
+
+	newRepoSync := func(target config.TargetConfig) *RepositorySync {
+		return &RepositorySync{
+			engine: &Engine{
+				config: &config.Config{Groups: []config.Group{{Defaults: defaults}}},
+				logger: logrus.New(),
+			},
+			sourceState: &state.SourceState{Repo: "org/template", LatestCommit: "abc123"},
+			target:      target,
+			logger:      logrus.NewEntry(logrus.New()),
+		}
+	}
+
+	t.Run("group default sections render before metadata", func(t *testing.T) {
+		rs := newRepoSync(config.TargetConfig{
+			Repo: "org/target",
+			Transform: config.Transform{Variables: map[string]string{
+				"TEAM":         "@org/platform",
+				"SERVICE_NAME": "target-service",
+			}},
+		})
+
+		body, _ := rs.generatePRBody(context.Background(), "commit456", nil, nil)
+
+		assert.Contains(t, body, "## Release Checklist\n- [ ] Notify @org/platform about target-service\n\n")
+		assert.Contains(t, body, "## Owners\nMaintained by the platform team\n\n")
+
+		impact := strings.Index(body, "## Impact / Risk")
+		checklist := strings.Index(body, "## Release Checklist")
+		owners := strings.Index(body, "## Owners")
+		metadata := strings.Index(body, "<!-- go-broadcast-metadata")
+		assert.Less(t, impact, checklist)
+		assert.Less(t, checklist, owners)
+		assert.Less(t, owners, metadata)
+	})
+
+	t.Run("target sections override group defaults", func(t *testing.T) {
+		rs := newRepoSync(config.TargetConfig{
+			Repo:                "org/target",
+			PRBodyExtraSections: []config.PRBodySection{{Title: "Target Notes", Markdown: "{{UNSET}}"}},
+		})
+
+		body, _ := rs.generatePRBody(context.Background(), "commit456", nil, nil)
+
+		assert.Contains(t, body, "## Target Notes\n{{UNSET}}\n\n")
+		assert.NotContains(t, body, "## Release Checklist")
+		assert.Less(t, strings.Index(body, "## Target Notes"), strings.Index(body, "<!-- go-broadcast-metadata"))
+	})
+}
+
 func TestRepositorySync_findExistingPR(t *testing.T) {
 	branchName := "chore/sync-files-20240115-120530-abc123"
 
@@ -949,7 +1024,7 @@ func TestRepositorySync_getPRReviewers(t *testing.T) {
 			logger: logger,
 		}
 
-		reviewers := rs.getPRReviewers()
+		reviewers := rs.getPRReviewers("")
 		assert.Equal(t, []string{"target-reviewer1", "target-reviewer2"}, reviewers)
 	})
 
@@ -972,7 +1047,7 @@ func TestRepositorySync_getPRReviewers(t *testing.T) {
 			logger: logger,
 		}
 
-		reviewers := rs.getPRReviewers()
+		reviewers := rs.getPRReviewers("")
 		assert.Equal(t, []string{"reviewer1", "reviewer2"}, reviewers)
 	})
 }
@@ -1029,6 +1104,84 @@ func TestRepositorySync_getPRTeamReviewers(t *testing.T) {
 	})
 }
 
+func TestRepositorySync_getPRDraft(t *testing.T) {
+	logger := logrus.NewEntry(logrus.New())
+	enabled, disabled := true, false
+
+	newRS := func(group config.Group, target config.TargetConfig, opts *Options) *RepositorySync {
+		return &RepositorySync{
+			engine: &Engine{config: &config.Config{Groups: []config.Group{group}}, options: opts},
+			target: target,
+			logger: logger,
+		}
+	}
+
+	assert.False(t, newRS(config.Group{}, config.TargetConfig{}, DefaultOptions()).getPRDraft())
+	assert.True(t, newRS(config.Group{Global: config.GlobalConfig{PRDraft: true}}, config.TargetConfig{}, DefaultOptions()).getPRDraft())
+	assert.True(t, newRS(config.Group{Defaults: config.DefaultConfig{PRDraft: true}}, config.TargetConfig{}, DefaultOptions()).getPRDraft())
+	assert.True(t, newRS(config.Group{}, config.TargetConfig{PRDraft: &enabled}, DefaultOptions()).getPRDraft())
+
+	// A target can opt out of group-wide drafts, but not out of the CLI option
+	optOut := config.TargetConfig{PRDraft: &disabled}
+	assert.False(t, newRS(config.Group{Defaults: config.DefaultConfig{PRDraft: true}}, optOut, DefaultOptions()).getPRDraft())
+	assert.True(t, newRS(config.Group{}, optOut, DefaultOptions().WithDraft(true)).getPRDraft())
+}
+
+func TestRepositorySync_PROverrides(t *testing.T) {
+	logger := logrus.NewEntry(logrus.New())
+	group := config.Group{
+		Global:   config.GlobalConfig{PRLabels: []string{"sync"}, PRReviewers: []string{"lead"}},
+		Defaults: config.DefaultConfig{PRAssignees: []string{"owner"}},
+	}
+	target := config.TargetConfig{Repo: "org/target", PRLabels: []string{"service"}}
+
+	newRS := func(opts *Options) *RepositorySync {
+		return &RepositorySync{
+			engine: &Engine{config: &config.Config{Groups: []config.Group{group}}, options: opts},
+			target: target,
+			logger: logger,
+		}
+	}
+
+	t.Run("no overrides keep configuration", func(t *testing.T) {
+		rs := newRS(DefaultOptions())
+		assert.Equal(t, []string{"sync", "service"}, rs.getPRLabels(nil))
+		assert.Equal(t, []string{"owner"}, rs.getPRAssignees())
+		assert.Equal(t, []string{"lead"}, rs.getPRReviewers(""))
+	})
+
+	t.Run("replace mode", func(t *testing.T) {
+		rs := newRS(DefaultOptions().
+			WithPRLabels([]string{"hotfix", "hotfix"}).
+			WithPRAssignees([]string{"alice"}).
+			WithPRReviewers([]string{"bob"}))
+		assert.Equal(t, []string{"hotfix"}, rs.getPRLabels(nil))
+		assert.Equal(t, []string{"alice"}, rs.getPRAssignees())
+		assert.Equal(t, []string{"bob"}, rs.getPRReviewers(""))
+	})
+
+	t.Run("merge mode", func(t *testing.T) {
+		rs := newRS(DefaultOptions().
+			WithPRLabels([]string{"hotfix", "sync"}).
+			WithPRAssignees([]string{"alice"}).
+			WithPRReviewers([]string{"bob"}).
+			WithPRLabelsMode(config.PRLabelsModeMerge))
+		assert.Equal(t, []string{"sync", "service", "hotfix"}, rs.getPRLabels(nil))
+		assert.Equal(t, []string{"owner", "alice"}, rs.getPRAssignees())
+		assert.Equal(t, []string{"lead", "bob"}, rs.getPRReviewers(""))
+	})
+
+	t.Run("automerge labels still apply and reviewers are still filtered", func(t *testing.T) {
+		rs := newRS(DefaultOptions().
+			WithPRLabels([]string{"hotfix"}).
+			WithPRReviewers([]string{"me", "bob"}).
+			WithAutomerge(true).
+			WithAutomergeLabels([]string{"automerge"}))
+		assert.Equal(t, []string{"hotfix", "automerge"}, rs.getPRLabels(nil))
+		assert.Equal(t, "me (author - will be filtered), bob", rs.formatReviewersWithFiltering(rs.getPRReviewers(""), "me"))
+	})
+}
+
 // TestRepositorySync_getPRLabels tests the PR labels resolution logic
 func TestRepositorySync_getPRLabels(t *testing.T) {
 	logger := logrus.NewEntry(logrus.New())
@@ -1053,7 +1206,7 @@ func TestRepositorySync_getPRLabels(t *testing.T) {
 			logger: logger,
 		}
 
-		labels := rs.getPRLabels()
+		labels := rs.getPRLabels(nil)
 		assert.Equal(t, []string{"target-label1", "target-label2"}, labels)
 	})
 
@@ -1076,7 +1229,7 @@ func TestRepositorySync_getPRLabels(t *testing.T) {
 			logger: logger,
 		}
 
-		labels := rs.getPRLabels()
+		labels := rs.getPRLabels(nil)
 		assert.Equal(t, []string{"automated-sync", "maintenance"}, labels)
 	})
 
@@ -1097,7 +1250,7 @@ func TestRepositorySync_getPRLabels(t *testing.T) {
 			logger: logger,
 		}
 
-		labels := rs.getPRLabels()
+		labels := rs.getPRLabels(nil)
 		assert.Empty(t, labels)
 	})
 
@@ -1121,7 +1274,7 @@ func TestRepositorySync_getPRLabels(t *testing.T) {
 			logger: logger,
 		}
 
-		labels := rs.getPRLabels()
+		labels := rs.getPRLabels(nil)
 		assert.Equal(t, []string{"default-label"}, labels) // Should use defaults since target slice is empty
 	})
 
@@ -1145,7 +1298,7 @@ func TestRepositorySync_getPRLabels(t *testing.T) {
 			logger: logger,
 		}
 
-		labels := rs.getPRLabels()
+		labels := rs.getPRLabels(nil)
 		assert.Equal(t, []string{"custom-label"}, labels)
 	})
 }
@@ -1182,7 +1335,7 @@ func TestRepositorySync_getPRLabels_WithAutomerge(t *testing.T) {
 			logger: logger,
 		}
 
-		labels := rs.getPRLabels()
+		labels := rs.getPRLabels(nil)
 		expected := []string{"target-label", "automerge", "ready-to-merge"}
 		assert.Equal(t, expected, labels)
 	})
@@ -1215,7 +1368,7 @@ func TestRepositorySync_getPRLabels_WithAutomerge(t *testing.T) {
 			logger: logger,
 		}
 
-		labels := rs.getPRLabels()
+		labels := rs.getPRLabels(nil)
 		expected := []string{"target-label"}
 		assert.Equal(t, expected, labels)
 	})
@@ -1248,7 +1401,7 @@ func TestRepositorySync_getPRLabels_WithAutomerge(t *testing.T) {
 			logger: logger,
 		}
 
-		labels := rs.getPRLabels()
+		labels := rs.getPRLabels(nil)
 		expected := []string{"target-label"}
 		assert.Equal(t, expected, labels)
 	})
@@ -1281,7 +1434,7 @@ func TestRepositorySync_getPRLabels_WithAutomerge(t *testing.T) {
 			logger: logger,
 		}
 
-		labels := rs.getPRLabels()
+		labels := rs.getPRLabels(nil)
 		expected := []string{"default-label", "automerge"}
 		assert.Equal(t, expected, labels)
 	})
@@ -1314,7 +1467,7 @@ func TestRepositorySync_getPRLabels_WithAutomerge(t *testing.T) {
 			logger: logger,
 		}
 
-		labels := rs.getPRLabels()
+		labels := rs.getPRLabels(nil)
 		// Should not have duplicate "automerge" labels
 		expected := []string{"automerge", "target-label", "ready-to-merge"}
 		assert.Equal(t, expected, labels)
@@ -1497,6 +1650,139 @@ func TestCreateNewPR_GetCurrentUserFailure(t *testing.T) {
 	ghClient.AssertExpectations(t)
 }
 
+// TestCreateNewPR_EnablesAutoMerge verifies auto-merge is enabled with the
+// resolved merge method after the PR is created, and that failures do not fail the sync
+func TestUpdateExistingPR_RetriesOnConflict(t *testing.T) {
+	ctx := context.Background()
+	conflict := gh.ErrPRUpdateConflict
+
+	newRepoSync := func(ghClient *gh.MockClient, defaults config.DefaultConfig) *RepositorySync {
+		return &RepositorySync{
+			engine: &Engine{
+				config:  &config.Config{Groups: []config.Group{{Defaults: defaults}}},
+				gh:      ghClient,
+				logger:  logrus.New(),
+				options: DefaultOptions(),
+			},
+			target:      config.TargetConfig{Repo: "org/target"},
+			logger:      logrus.NewEntry(logrus.New()),
+			sourceState: &state.SourceState{Repo: "org/template", LatestCommit: "abc123"},
+		}
+	}
+
+	t.Run("conflict is resolved by re-fetching", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("UpdatePR", ctx, "org/target", 42, mock.Anything).Return(conflict).Once()
+		ghClient.On("UpdatePR", ctx, "org/target", 42, mock.Anything).Return(nil).Once()
+		ghClient.On("GetPR", ctx, "org/target", 42).Return(&gh.PR{Number: 42, State: "open"}, nil).Once()
+
+		rs := newRepoSync(ghClient, config.DefaultConfig{})
+		require.NoError(t, rs.updateExistingPR(ctx, &gh.PR{Number: 42}, "abc123", nil, nil))
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("retry count comes from group defaults", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("UpdatePR", ctx, "org/target", 42, mock.Anything).Return(conflict)
+		ghClient.On("GetPR", ctx, "org/target", 42).Return(&gh.PR{Number: 42, State: "open"}, nil)
+
+		rs := newRepoSync(ghClient, config.DefaultConfig{PRUpdateRetries: intPtr(1)})
+		err := rs.updateExistingPR(ctx, &gh.PR{Number: 42}, "abc123", nil, nil)
+		require.ErrorIs(t, err, gh.ErrPRUpdateConflict)
+		ghClient.AssertNumberOfCalls(t, "UpdatePR", 2)
+	})
+
+	t.Run("defaults to DefaultPRUpdateRetries", func(t *testing.T) {
+		rs := newRepoSync(&gh.MockClient{}, config.DefaultConfig{})
+		assert.Equal(t, config.DefaultPRUpdateRetries, rs.getPRUpdateRetries())
+	})
+
+	t.Run("explicit zero disables retries", func(t *testing.T) {
+		rs := newRepoSync(&gh.MockClient{}, config.DefaultConfig{PRUpdateRetries: intPtr(0)})
+		assert.Equal(t, 0, rs.getPRUpdateRetries())
+	})
+}
+
+func TestCreateNewPR_EnablesAutoMerge(t *testing.T) {
+	ctx := context.Background()
+
+	newRepoSync := func(ghClient *gh.MockClient, opts *Options, defaults config.DefaultConfig) *RepositorySync {
+		ghClient.On("GetCurrentUser", ctx).Return(&gh.User{Login: "authoruser"}, nil)
+		ghClient.On("ListBranches", ctx, "org/target").Return([]gh.Branch{{Name: "master"}}, nil)
+		ghClient.On("CreatePR", ctx, "org/target", mock.Anything).Return(&gh.PR{Number: 42}, nil)
+
+		return &RepositorySync{
+			engine: &Engine{
+				config:  &config.Config{Groups: []config.Group{{Defaults: defaults}}},
+				gh:      ghClient,
+				logger:  logrus.New(),
+				options: opts,
+			},
+			target:      config.TargetConfig{Repo: "org/target"},
+			logger:      logrus.NewEntry(logrus.New()),
+			sourceState: &state.SourceState{LatestCommit: "abc123"},
+			targetState: &state.TargetState{},
+		}
+	}
+
+	t.Run("defaults to squash", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("EnableAutoMergePR", ctx, "org/target", 42, gh.MergeMethodSquash).Return(nil)
+		rs := newRepoSync(ghClient, DefaultOptions().WithAutomerge(true), config.DefaultConfig{})
+
+		require.NoError(t, rs.createNewPR(ctx, "test-branch", "abc123", []FileChange{}, nil))
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("uses group default method", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("EnableAutoMergePR", ctx, "org/target", 42, gh.MergeMethodMerge).Return(nil)
+		rs := newRepoSync(ghClient, DefaultOptions().WithAutomerge(true), config.DefaultConfig{AutomergeMethod: "merge"})
+
+		require.NoError(t, rs.createNewPR(ctx, "test-branch", "abc123", []FileChange{}, nil))
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("option overrides group default", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("EnableAutoMergePR", ctx, "org/target", 42, gh.MergeMethodRebase).Return(nil)
+		opts := DefaultOptions().WithAutomerge(true).WithAutomergeMethod("rebase")
+		rs := newRepoSync(ghClient, opts, config.DefaultConfig{AutomergeMethod: "merge"})
+
+		require.NoError(t, rs.createNewPR(ctx, "test-branch", "abc123", []FileChange{}, nil))
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("waits for checks before enabling", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		opts := DefaultOptions().WithAutomerge(true).WithWaitForChecks(true, 0)
+		rs := newRepoSync(ghClient, opts, config.DefaultConfig{})
+
+		require.NoError(t, rs.createNewPR(ctx, "test-branch", "abc123", []FileChange{}, nil))
+		ghClient.AssertNotCalled(t, "EnableAutoMergePR")
+	})
+
+	t.Run("failure is not fatal", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("EnableAutoMergePR", ctx, "org/target", 42, gh.MergeMethodSquash).
+			Return(errTestAutoMergeDisabled)
+		rs := newRepoSync(ghClient, DefaultOptions().WithAutomerge(true), config.DefaultConfig{})
+
+		require.NoError(t, rs.createNewPR(ctx, "test-branch", "abc123", []FileChange{}, nil))
+		require.NotNil(t, rs.lastPRNumber)
+		assert.Equal(t, 42, *rs.lastPRNumber)
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("skipped when automerge disabled", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		rs := newRepoSync(ghClient, DefaultOptions(), config.DefaultConfig{AutomergeMethod: "merge"})
+
+		require.NoError(t, rs.createNewPR(ctx, "test-branch", "abc123", []FileChange{}, nil))
+		ghClient.AssertNotCalled(t, "EnableAutoMergePR", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
 func TestRepositorySync_commitChanges_NoChanges(t *testing.T) {
 	t.Skip("Temporarily disabled - error message assertion issue")
 	ctx := context.Background()
@@ -1860,10 +2146,30 @@ func (m *TestValidationMockGHClient) AddPRComment(_ context.Context, _ string, _
 	return ErrMockNotImplemented
 }
 
+func (m *TestValidationMockGHClient) ListIssueComments(_ context.Context, _ string, _ int) ([]gh.IssueComment, error) {
+	return nil, ErrMockNotImplemented
+}
+
+func (m *TestValidationMockGHClient) CreateIssueComment(_ context.Context, _ string, _ int, _ string) (*gh.IssueComment, error) {
+	return nil, ErrMockNotImplemented
+}
+
+func (m *TestValidationMockGHClient) UpdateIssueComment(_ context.Context, _ string, _ int64, _ string) error {
+	return ErrMockNotImplemented
+}
+
 func (m *TestValidationMockGHClient) GetPRCheckStatus(_ context.Context, _ string, _ int) (*gh.CheckStatusSummary, error) {
 	return nil, ErrMockNotImplemented
 }
 
+func (m *TestValidationMockGHClient) GetCommitCheckStatus(_ context.Context, _, _ string) (*gh.CheckStatusSummary, error) {
+	return nil, ErrMockNotImplemented
+}
+
+func (m *TestValidationMockGHClient) GetCombinedStatus(_ context.Context, _, _ string) (*gh.CombinedStatus, error) {
+	return nil, ErrMockNotImplemented
+}
+
 func (m *TestValidationMockGHClient) DiscoverOrgRepos(_ context.Context, _ string) ([]gh.RepoInfo, error) {
 	return nil, ErrMockNotImplemented
 }
@@ -1944,6 +2250,10 @@ func (m *TestValidationMockGHClient) SetTopics(_ context.Context, _ string, _ []
 	return ErrMockNotImplemented
 }
 
+func (m *TestValidationMockGHClient) GetRepoTopics(_ context.Context, _ string) ([]string, error) {
+	return nil, ErrMockNotImplemented
+}
+
 func (m *TestValidationMockGHClient) CloneRepository(_ context.Context, _, _ string) error {
 	return ErrMockNotImplemented
 }
@@ -2037,6 +2347,30 @@ func TestRepositorySync_validateAndCleanupOrphanedBranches(t *testing.T) {
 		assert.Equal(t, orphanedBranch, ghClient.deletedBranch) // Orphaned branch should be deleted
 	})
 
+	t.Run("branches pushed without a PR are kept", func(t *testing.T) {
+		ghClient := &TestValidationMockGHClient{
+			branches: []gh.Branch{
+				{Name: "chore/sync-files-test-20240115-120530-abc123" + state.NoPRBranchSuffix},
+			},
+		}
+
+		rs := &RepositorySync{
+			engine: &Engine{
+				gh: ghClient,
+				config: &config.Config{
+					Groups: []config.Group{{Defaults: config.DefaultConfig{BranchPrefix: "chore/sync-files"}}},
+				},
+			},
+			target:      config.TargetConfig{Repo: "org/repo"},
+			targetState: &state.TargetState{OpenPRs: []gh.PR{}},
+			logger:      logger,
+		}
+
+		err := rs.validateAndCleanupOrphanedBranches(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, ghClient.deletedBranch)
+	})
+
 	t.Run("ListBranches fails", func(t *testing.T) {
 		ghClient := &TestValidationMockGHClient{
 			shouldFailLB: true,
@@ -2357,6 +2691,9 @@ func TestRepositorySync_Execute_ExistingBranch(t *testing.T) {
 		err := rs.Execute(ctx)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to force push branch")
+		var syncErr *SyncError
+		require.ErrorAs(t, err, &syncErr)
+		assert.Equal(t, PhasePush, syncErr.Phase)
 
 		// Verify both push calls were made
 		gitClient.AssertCalled(t, "Push", mock.Anything, mock.AnythingOfType("string"), "origin", mock.AnythingOfType("string"), false)
@@ -2364,6 +2701,75 @@ func TestRepositorySync_Execute_ExistingBranch(t *testing.T) {
 	})
 }
 
+func TestRepositorySync_Execute_FixedBranch(t *testing.T) {
+	ctx := context.Background()
+	logger := logrus.NewEntry(logrus.New())
+	// The fixed branch matches the sync branch prefix, so orphan cleanup must skip it
+	const fixedBranch = "chore/sync-files-automated"
+
+	gitClient := git.NewMockClient()
+	ghClient := gh.NewMockClient()
+
+	gitClient.On("Clone", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		destPath := args[2].(string)
+		testutil.CreateTestDirectory(t, destPath)
+		testutil.WriteTestFile(t, destPath+"/test.txt", "test file content")
+	})
+	gitClient.On("Checkout", mock.Anything, mock.AnythingOfType("string"), "abc1234").Return(nil)
+	gitClient.On("CreateBranch", mock.Anything, mock.AnythingOfType("string"), fixedBranch).Return(nil)
+	gitClient.On("Checkout", mock.Anything, mock.AnythingOfType("string"), fixedBranch).Return(nil)
+	gitClient.On("Add", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("[]string")).Return(nil)
+	gitClient.On("Commit", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	gitClient.On("GetCurrentCommitSHA", mock.Anything, mock.AnythingOfType("string")).Return("commit123", nil)
+	gitClient.On("GetChangedFiles", mock.Anything, mock.AnythingOfType("string")).Return([]string{"test.txt"}, nil)
+	// The fixed branch is always force-pushed
+	gitClient.On("Push", mock.Anything, mock.AnythingOfType("string"), "origin", fixedBranch, true).Return(nil)
+
+	// The fixed branch has no PR in the discovered state, but one is open
+	existingPR := gh.PR{Number: 42, Title: "Sync files"}
+	existingPR.Head.Ref = fixedBranch
+	ghClient.On("ListBranches", mock.Anything, "target/repo").Return([]gh.Branch{{Name: "master"}, {Name: fixedBranch}}, nil)
+	ghClient.On("GetFile", mock.Anything, "target/repo", "test.txt", "").Return(nil, gh.ErrFileNotFound)
+	ghClient.On("ListPRs", mock.Anything, "target/repo", "open").Return([]gh.PR{existingPR}, nil)
+	ghClient.On("UpdatePR", mock.Anything, "target/repo", 42, mock.AnythingOfType("gh.PRUpdate")).Return(nil)
+
+	transformChain := &transform.MockChain{}
+	transformChain.On("Transform", mock.Anything, mock.AnythingOfType("[]uint8"), mock.AnythingOfType("transform.Context")).Return([]byte("transformed content"), nil)
+
+	engine := &Engine{
+		git:       gitClient,
+		gh:        ghClient,
+		transform: transformChain,
+		config: &config.Config{
+			Groups: []config.Group{{
+				ID:       "test-group",
+				Defaults: config.DefaultConfig{BranchPrefix: "chore/sync-files"},
+			}},
+		},
+		options: DefaultOptions(),
+		logger:  logrus.New(),
+	}
+
+	rs := &RepositorySync{
+		engine:      engine,
+		target:      config.TargetConfig{Repo: "target/repo", FixedBranch: fixedBranch, Files: []config.FileMapping{{Src: "test.txt", Dest: "test.txt"}}},
+		sourceState: &state.SourceState{Repo: "source/repo", Branch: "main", LatestCommit: "abc1234"},
+		targetState: &state.TargetState{LastSyncCommit: "different"},
+		logger:      logger,
+	}
+
+	require.NoError(t, rs.Execute(ctx))
+
+	gitClient.AssertExpectations(t)
+	ghClient.AssertExpectations(t)
+	gitClient.AssertNotCalled(t, "Push", mock.Anything, mock.Anything, mock.Anything, mock.Anything, false)
+	ghClient.AssertNotCalled(t, "CreatePR", mock.Anything, mock.Anything, mock.Anything)
+	ghClient.AssertNotCalled(t, "DeleteBranch", mock.Anything, mock.Anything, mock.Anything)
+	require.NotNil(t, rs.lastPRNumber)
+	assert.Equal(t, 42, *rs.lastPRNumber)
+	assert.Equal(t, PRActionUpdated, rs.lastPRAction)
+}
+
 // TestRepositorySync_NoChangesToSync tests the scenario where files are already synchronized
 func TestRepositorySync_NoChangesToSync(t *testing.T) {
 	// Setup test configuration
@@ -2414,6 +2820,9 @@ func TestRepositorySync_NoChangesToSync(t *testing.T) {
 		ghClient.On("GetFile", mock.Anything, "org/target", "file1.txt", "").Return(&gh.FileContent{
 			Content: []byte("identical content"),
 		}, nil)
+		ghClient.On("GetFile", mock.Anything, "org/template", "file1.txt", "abc123").Return(&gh.FileContent{
+			Content: []byte("source content"),
+		}, nil).Maybe()
 
 		// Mock git operations
 		gitClient.On("Clone", mock.Anything, mock.Anything, mock.MatchedBy(func(path string) bool {
@@ -2510,6 +2919,9 @@ func TestRepositorySync_NoChangesToSync(t *testing.T) {
 		ghClient.On("GetFile", mock.Anything, "org/target", "file1.txt", "").Return(&gh.FileContent{
 			Content: []byte("different content"),
 		}, nil)
+		ghClient.On("GetFile", mock.Anything, "org/template", "file1.txt", "abc123").Return(&gh.FileContent{
+			Content: []byte("content"),
+		}, nil).Maybe()
 
 		gitClient.On("Clone", mock.Anything, mock.Anything, mock.MatchedBy(func(path string) bool {
 			return strings.HasSuffix(path, "/source")
@@ -2742,3 +3154,186 @@ func TestRepositorySync_GetExistingFileContentUsesTargetBranch(t *testing.T) {
 	// Verify the mock expectation was met - this is the key assertion
 	ghClient.AssertExpectations(t)
 }
+
+func TestRepositorySync_processFiles_TemplateRender(t *testing.T) {
+	newRenderRepoSync := func(t *testing.T, template string) (*RepositorySync, *gh.MockClient) {
+		t.Helper()
+		tmp := t.TempDir()
+		srcDir := filepath.Join(tmp, "source", "templates")
+		require.NoError(t, os.MkdirAll(srcDir, 0o750))
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "ci.yml.tmpl"), []byte(template), 0o600))
+
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/target", mock.Anything, "").
+			Return(nil, gh.ErrFileNotFound)
+
+		chain := transform.NewChain(logrus.New())
+		chain.Add(transform.NewTemplateRenderTransformer())
+
+		target := config.TargetConfig{
+			Repo: "org/target",
+			Files: []config.FileMapping{
+				{Src: "templates/ci.yml.tmpl", Dest: ".github/workflows/ci.yml.tmpl"},
+			},
+			Transform: config.Transform{
+				TemplateRender: true,
+				Variables:      map[string]string{"GO_VERSION": "1.24"},
+			},
+		}
+		rs := newTestRepoSync(ghClient, chain, target, nil)
+		rs.tempDir = tmp
+		return rs, ghClient
+	}
+
+	t.Run("renders to destination without suffix", func(t *testing.T) {
+		rs, ghClient := newRenderRepoSync(t, "go: {{.GO_VERSION}} for {{.Repo.Name}}")
+
+		changes, err := rs.processFiles(context.Background())
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		assert.Equal(t, ".github/workflows/ci.yml", changes[0].Path)
+		assert.Equal(t, "go: 1.24 for target", string(changes[0].Content))
+		assert.True(t, changes[0].IsNew)
+		ghClient.AssertCalled(t, "GetFile", mock.Anything, "org/target", ".github/workflows/ci.yml", "")
+	})
+
+	t.Run("template error fails the file", func(t *testing.T) {
+		rs, _ := newRenderRepoSync(t, "go: {{.MISSING}}")
+
+		_, err := rs.processFiles(context.Background())
+		require.ErrorIs(t, err, transform.ErrTemplateRender)
+		assert.Contains(t, err.Error(), "templates/ci.yml.tmpl")
+	})
+
+	t.Run("directory files are rendered and renamed", func(t *testing.T) {
+		rs, _ := newRenderRepoSync(t, "go: {{.GO_VERSION}}")
+		rs.target.Files = nil
+		rs.target.Directories = []config.DirectoryMapping{{
+			Src:  "templates",
+			Dest: "out",
+			Transform: config.Transform{
+				TemplateRender: true,
+				Variables:      map[string]string{"GO_VERSION": "1.25"},
+			},
+		}}
+
+		changes, err := rs.ProcessDirectoriesWithOptions(context.Background(), DirectoryProcessingOptions{WorkerCount: 1})
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		assert.Equal(t, "out/ci.yml", changes[0].Path)
+		assert.Equal(t, "go: 1.25", string(changes[0].Content))
+	})
+}
+
+func TestRepositorySync_KeepTempOnFailure(t *testing.T) {
+	newRepoSync := func(opts *Options) *RepositorySync {
+		ghClient := &gh.MockClient{}
+		ghClient.On("ListBranches", mock.Anything, mock.Anything).Return([]gh.Branch{}, nil).Maybe()
+		ghClient.On("GetFile", mock.Anything, "org/target", mock.Anything, "").
+			Return(nil, gh.ErrFileNotFound).Maybe()
+		gitClient := &git.MockClient{}
+		gitClient.On("Clone", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(internalerrors.ErrTest)
+
+		return &RepositorySync{
+			engine: &Engine{
+				config:    &config.Config{Groups: []config.Group{{}}},
+				gh:        ghClient,
+				git:       gitClient,
+				transform: &transform.MockChain{},
+				options:   opts,
+				logger:    logrus.New(),
+			},
+			target:      config.TargetConfig{Repo: "org/target", Files: []config.FileMapping{{Src: "a.txt", Dest: "a.txt"}}},
+			sourceState: &state.SourceState{Repo: "org/template", Branch: "master", LatestCommit: "abc123"},
+			targetState: &state.TargetState{Repo: "org/target", LastSyncCommit: "old123", Status: state.StatusBehind},
+			logger:      testEntry(),
+		}
+	}
+
+	t.Run("failed target keeps its working tree", func(t *testing.T) {
+		base := filepath.Join(t.TempDir(), "work")
+		rs := newRepoSync(DefaultOptions().WithTempDir(base).WithKeepTemp(false, true))
+
+		require.Error(t, rs.Execute(context.Background()))
+		assert.Equal(t, base, filepath.Dir(rs.tempDir), "clones go under the configured base directory")
+		assert.DirExists(t, rs.tempDir)
+	})
+
+	t.Run("failed target is cleaned up by default", func(t *testing.T) {
+		rs := newRepoSync(DefaultOptions().WithTempDir(t.TempDir()))
+
+		require.Error(t, rs.Execute(context.Background()))
+		assert.NoDirExists(t, rs.tempDir)
+	})
+
+	t.Run("successful target is cleaned up", func(t *testing.T) {
+		rs := newRepoSync(DefaultOptions().WithKeepTemp(false, true))
+		rs.tempDir = t.TempDir()
+
+		rs.cleanup(false)
+		assert.NoDirExists(t, rs.tempDir)
+	})
+
+	t.Run("keep-temp keeps every working tree", func(t *testing.T) {
+		rs := newRepoSync(DefaultOptions().WithKeepTemp(true, false))
+		rs.tempDir = t.TempDir()
+
+		rs.cleanup(false)
+		assert.DirExists(t, rs.tempDir)
+	})
+}
+
+func TestRepositorySync_createSyncBranch_Template(t *testing.T) {
+	newRepoSync := func(group config.Group, target config.TargetConfig, opts *Options) *RepositorySync {
+		return &RepositorySync{
+			engine: &Engine{
+				config:  &config.Config{Groups: []config.Group{group}},
+				options: opts,
+			},
+			target:      target,
+			sourceState: &state.SourceState{LatestCommit: "abc123def456"},
+			logger:      logrus.NewEntry(logrus.New()),
+		}
+	}
+	group := config.Group{
+		ID:       "core",
+		Global:   config.GlobalConfig{BranchNameTemplate: "sync/{{.GroupID}}/{{.SourceCommit}}"},
+		Defaults: config.DefaultConfig{BranchPrefix: "chore/sync", BranchNameTemplate: "{{.Prefix}}-{{.SourceCommit}}"},
+	}
+
+	t.Run("target template wins", func(t *testing.T) {
+		target := config.TargetConfig{Repo: "org/service", BranchNameTemplate: "{{.Prefix}}/{{.TargetName}}-{{.SourceCommit}}"}
+		branch := newRepoSync(group, target, DefaultOptions()).createSyncBranch(context.Background())
+		assert.Equal(t, "chore/sync/service-abc123d", branch)
+	})
+
+	t.Run("global template before defaults", func(t *testing.T) {
+		branch := newRepoSync(group, config.TargetConfig{Repo: "org/service"}, DefaultOptions()).createSyncBranch(context.Background())
+		assert.Equal(t, "sync/core/abc123d", branch)
+	})
+
+	t.Run("defaults template", func(t *testing.T) {
+		defaultsOnly := group
+		defaultsOnly.Global = config.GlobalConfig{}
+		branch := newRepoSync(defaultsOnly, config.TargetConfig{Repo: "org/service"}, DefaultOptions()).createSyncBranch(context.Background())
+		assert.Equal(t, "chore/sync-abc123d", branch)
+	})
+
+	t.Run("no-pr suffix follows the rendered name", func(t *testing.T) {
+		branch := newRepoSync(group, config.TargetConfig{Repo: "org/service"}, DefaultOptions().WithNoPR(true)).createSyncBranch(context.Background())
+		assert.Equal(t, "sync/core/abc123d"+state.NoPRBranchSuffix, branch)
+	})
+
+	t.Run("unset keeps the default name", func(t *testing.T) {
+		plain := config.Group{ID: "core", Defaults: config.DefaultConfig{BranchPrefix: "chore/sync"}}
+		branch := newRepoSync(plain, config.TargetConfig{Repo: "org/service"}, DefaultOptions()).createSyncBranch(context.Background())
+		assert.Regexp(t, `^chore/sync-core-\d{8}-\d{6}-abc123d$`, branch)
+	})
+
+	t.Run("invalid rendered name falls back to the default name", func(t *testing.T) {
+		target := config.TargetConfig{Repo: "org/service", BranchNameTemplate: "sync {{.SourceCommit}}"}
+		branch := newRepoSync(group, target, DefaultOptions()).createSyncBranch(context.Background())
+		assert.True(t, strings.HasPrefix(branch, "chore/sync-core-"), branch)
+	})
+}
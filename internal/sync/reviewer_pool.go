@@ -0,0 +1,207 @@
+package sync
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// reviewerPoolLoad counts the reviewers picked from each pool in this run, for
+// the least-loaded strategy. Keys are lower-cased logins.
+type reviewerPoolLoad map[*config.PRReviewerPool]map[string]int
+
+// poolMember is a reviewer eligible for a PR with its selection weight
+type poolMember struct {
+	login  string
+	weight int
+}
+
+// getReviewerPool returns the PR reviewer pool of the current group, if any
+func (rs *RepositorySync) getReviewerPool() *config.PRReviewerPool {
+	if currentGroup := rs.engine.GetCurrentGroup(); currentGroup != nil {
+		return currentGroup.Defaults.PRReviewerPool
+	}
+	if len(rs.engine.config.Groups) > 0 {
+		return rs.engine.config.Groups[0].Defaults.PRReviewerPool
+	}
+	return nil
+}
+
+// poolReviewers returns the reviewers picked from the group's reviewer pool
+// for this target's PR, never including author. The pick is made once per
+// target so previews and the created PR agree. --pr-reviewer replaces the
+// pool along with the configured reviewers, unless it is merged.
+func (rs *RepositorySync) poolReviewers(author string) []string {
+	if rs.poolPicked {
+		return rs.poolPicks
+	}
+	rs.poolPicked = true
+
+	pool := rs.getReviewerPool()
+	if pool == nil || (rs.engine.options != nil && len(rs.engine.options.PRReviewers) > 0 &&
+		rs.engine.options.PRLabelsMode != config.PRLabelsModeMerge) {
+		return nil
+	}
+
+	members := poolMembers(pool, author)
+	count := pool.Count
+	if count <= 0 {
+		count = 1
+	}
+	count = min(count, len(members))
+	if count == 0 {
+		return nil
+	}
+
+	switch pool.Strategy {
+	case config.ReviewerStrategyRandomN:
+		rs.poolPicks = pickRandomReviewers(members, count, rs.engine.reviewerRand)
+	case config.ReviewerStrategyLeastLoaded:
+		rs.poolPicks = rs.engine.pickLeastLoadedReviewers(pool, members, count)
+	default:
+		rs.poolPicks = pickRoundRobinReviewers(members, count, rs.targetPosition()*count)
+	}
+
+	rs.logger.WithField("reviewers", rs.poolPicks).Debug("Picked reviewers from the reviewer pool")
+	return rs.poolPicks
+}
+
+// poolMembers returns the pool's reviewers that can be picked: weighted above
+// zero and not the PR author
+func poolMembers(pool *config.PRReviewerPool, author string) []poolMember {
+	weights := make(map[string]int, len(pool.Weights))
+	for login, weight := range pool.Weights {
+		weights[strings.ToLower(login)] = weight
+	}
+
+	members := make([]poolMember, 0, len(pool.Reviewers))
+	for _, login := range pool.Reviewers {
+		if author != "" && strings.EqualFold(login, author) {
+			continue
+		}
+		weight, ok := weights[strings.ToLower(login)]
+		if !ok {
+			weight = 1
+		}
+		if weight > 0 {
+			members = append(members, poolMember{login: login, weight: weight})
+		}
+	}
+	return members
+}
+
+// targetPosition returns the index of this target in its group, which places
+// it in the round-robin rotation independently of the order targets finish in
+func (rs *RepositorySync) targetPosition() int {
+	var targets []config.TargetConfig
+	if currentGroup := rs.engine.GetCurrentGroup(); currentGroup != nil {
+		targets = currentGroup.Targets
+	} else if len(rs.engine.config.Groups) > 0 {
+		targets = rs.engine.config.Groups[0].Targets
+	}
+	for i, target := range targets {
+		if target.Repo == rs.target.Repo && target.Branch == rs.target.Branch {
+			return i
+		}
+	}
+	return 0
+}
+
+// pickRoundRobinReviewers picks count distinct members from a weighted
+// rotation, starting offset picks into it. The rotation interleaves members
+// in proportion to their weights (smooth weighted round-robin), so a member
+// with weight 2 appears twice as often as one with weight 1.
+func pickRoundRobinReviewers(members []poolMember, count, offset int) []string {
+	rotation := weightedRotation(members)
+	picks := make([]string, 0, count)
+	for i := 0; i < len(rotation) && len(picks) < count; i++ {
+		login := rotation[(offset+i)%len(rotation)]
+		if !slices.Contains(picks, login) {
+			picks = append(picks, login)
+		}
+	}
+	return picks
+}
+
+// weightedRotation returns one full cycle of smooth weighted round-robin over members
+func weightedRotation(members []poolMember) []string {
+	total := 0
+	for _, member := range members {
+		total += member.weight
+	}
+
+	current := make([]int, len(members))
+	rotation := make([]string, 0, total)
+	for range total {
+		best := 0
+		for i, member := range members {
+			current[i] += member.weight
+			if current[i] > current[best] {
+				best = i
+			}
+		}
+		current[best] -= total
+		rotation = append(rotation, members[best].login)
+	}
+	return rotation
+}
+
+// pickRandomReviewers picks count distinct members at random, each draw
+// weighted by the remaining members' weights. A nil rng uses the global source.
+func pickRandomReviewers(members []poolMember, count int, rng *rand.Rand) []string {
+	intN := rand.IntN
+	if rng != nil {
+		intN = rng.IntN
+	}
+
+	remaining := slices.Clone(members)
+	picks := make([]string, 0, count)
+	for len(picks) < count {
+		total := 0
+		for _, member := range remaining {
+			total += member.weight
+		}
+		n := intN(total)
+		for i, member := range remaining {
+			if n < member.weight {
+				picks = append(picks, member.login)
+				remaining = slices.Delete(remaining, i, i+1)
+				break
+			}
+			n -= member.weight
+		}
+	}
+	return picks
+}
+
+// pickLeastLoadedReviewers picks the count members with the fewest picks from
+// pool so far this run relative to their weight, earlier pool members first on
+// ties, and records the picks. Safe for concurrent use by the target worker pool.
+func (e *Engine) pickLeastLoadedReviewers(pool *config.PRReviewerPool, members []poolMember, count int) []string {
+	run := e.shared()
+	run.reviewerLoadMu.Lock()
+	defer run.reviewerLoadMu.Unlock()
+	if run.reviewerLoad == nil {
+		run.reviewerLoad = make(reviewerPoolLoad)
+	}
+	load := run.reviewerLoad[pool]
+	if load == nil {
+		load = make(map[string]int)
+		run.reviewerLoad[pool] = load
+	}
+
+	ranked := slices.Clone(members)
+	slices.SortStableFunc(ranked, func(a, b poolMember) int {
+		// Compare load/weight without division
+		return load[strings.ToLower(a.login)]*b.weight - load[strings.ToLower(b.login)]*a.weight
+	})
+
+	picks := make([]string, 0, count)
+	for _, member := range ranked[:count] {
+		load[strings.ToLower(member.login)]++
+		picks = append(picks, member.login)
+	}
+	return picks
+}
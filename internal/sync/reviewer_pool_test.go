@@ -0,0 +1,125 @@
+package sync
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// newReviewerPoolTargets returns an engine whose group has pool and the
+// RepositorySyncs of its targets, in group order
+func newReviewerPoolTargets(pool *config.PRReviewerPool, repos ...string) (*Engine, []*RepositorySync) {
+	group := config.Group{
+		Global:   config.GlobalConfig{PRReviewers: []string{"lead"}},
+		Defaults: config.DefaultConfig{PRReviewerPool: pool},
+	}
+	for _, repo := range repos {
+		group.Targets = append(group.Targets, config.TargetConfig{Repo: repo})
+	}
+	engine := &Engine{config: &config.Config{Groups: []config.Group{group}}, options: DefaultOptions()}
+
+	targets := make([]*RepositorySync, 0, len(repos))
+	for _, target := range group.Targets {
+		targets = append(targets, &RepositorySync{engine: engine, target: target, logger: logrus.NewEntry(logrus.New())})
+	}
+	return engine, targets
+}
+
+func TestRepositorySync_getPRReviewers_RoundRobin(t *testing.T) {
+	repos := []string{"org/a", "org/b", "org/c", "org/d"}
+
+	t.Run("rotates across targets in group order", func(t *testing.T) {
+		pool := &config.PRReviewerPool{Reviewers: []string{"alice", "bob", "carol"}}
+		_, targets := newReviewerPoolTargets(pool, repos...)
+
+		// Resolve in reverse, as a worker pool might: picks follow group order
+		expected := [][]string{{"lead", "alice"}, {"lead", "bob"}, {"lead", "carol"}, {"lead", "alice"}}
+		for i := len(targets) - 1; i >= 0; i-- {
+			assert.Equal(t, expected[i], targets[i].getPRReviewers(""), repos[i])
+		}
+	})
+
+	t.Run("picks count reviewers per PR", func(t *testing.T) {
+		pool := &config.PRReviewerPool{Reviewers: []string{"alice", "bob", "carol"}, Count: 2}
+		_, targets := newReviewerPoolTargets(pool, repos...)
+
+		expected := [][]string{{"alice", "bob"}, {"carol", "alice"}, {"bob", "carol"}, {"alice", "bob"}}
+		for i, rs := range targets {
+			assert.Equal(t, expected[i], rs.poolReviewers(""), repos[i])
+		}
+	})
+
+	t.Run("weights repeat reviewers in the rotation", func(t *testing.T) {
+		pool := &config.PRReviewerPool{
+			Reviewers: []string{"alice", "bob", "carol"},
+			Weights:   map[string]int{"alice": 2, "carol": 0},
+		}
+		_, targets := newReviewerPoolTargets(pool, repos...)
+
+		expected := []string{"alice", "bob", "alice", "alice"}
+		for i, rs := range targets {
+			assert.Equal(t, []string{expected[i]}, rs.poolReviewers(""), repos[i])
+		}
+	})
+
+	t.Run("the author is never picked and the count is kept", func(t *testing.T) {
+		pool := &config.PRReviewerPool{Reviewers: []string{"alice", "bob", "carol"}, Count: 2}
+		_, targets := newReviewerPoolTargets(pool, repos...)
+
+		for _, rs := range targets {
+			picks := rs.getPRReviewers("Bob")
+			assert.NotContains(t, picks, "bob")
+			assert.Len(t, picks, 3, "lead plus two pool reviewers")
+		}
+	})
+
+	t.Run("the pick is stable per target", func(t *testing.T) {
+		_, targets := newReviewerPoolTargets(&config.PRReviewerPool{Reviewers: []string{"alice", "bob"}}, repos...)
+		first := targets[1].getPRReviewers("")
+		assert.Equal(t, first, targets[1].getPRReviewers(""))
+	})
+
+	t.Run("--pr-reviewer replaces the pool", func(t *testing.T) {
+		engine, targets := newReviewerPoolTargets(&config.PRReviewerPool{Reviewers: []string{"alice"}}, repos...)
+		engine.options.WithPRReviewers([]string{"dave"})
+		assert.Equal(t, []string{"dave"}, targets[0].getPRReviewers(""))
+	})
+}
+
+func TestRepositorySync_getPRReviewers_LeastLoaded(t *testing.T) {
+	pool := &config.PRReviewerPool{
+		Reviewers: []string{"alice", "bob", "carol"},
+		Strategy:  config.ReviewerStrategyLeastLoaded,
+		Weights:   map[string]int{"carol": 2},
+	}
+	_, targets := newReviewerPoolTargets(pool, "org/a", "org/b", "org/c", "org/d", "org/e", "org/f", "org/g", "org/h")
+
+	counts := make(map[string]int)
+	for _, rs := range targets {
+		picks := rs.poolReviewers("")
+		require.Len(t, picks, 1)
+		counts[picks[0]]++
+	}
+	assert.Equal(t, map[string]int{"alice": 2, "bob": 2, "carol": 4}, counts)
+}
+
+func TestRepositorySync_getPRReviewers_RandomN(t *testing.T) {
+	pool := &config.PRReviewerPool{
+		Reviewers: []string{"alice", "bob", "carol", "dave"},
+		Strategy:  config.ReviewerStrategyRandomN,
+		Count:     3,
+		Weights:   map[string]int{"dave": 0},
+	}
+	engine, targets := newReviewerPoolTargets(pool, "org/a", "org/b", "org/c")
+	engine.reviewerRand = rand.New(rand.NewPCG(1, 2)) //nolint:gosec // deterministic test source
+
+	for _, rs := range targets {
+		picks := rs.poolReviewers("carol")
+		assert.ElementsMatch(t, []string{"alice", "bob"}, picks, "only eligible reviewers are picked")
+	}
+}
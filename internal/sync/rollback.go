@@ -0,0 +1,311 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/git"
+	"github.com/mrz1836/go-broadcast/internal/state"
+)
+
+// rollbackBranchPrefix prefixes rollback branches. It differs from the sync
+// branch prefix so state discovery never mistakes a rollback for a sync.
+const rollbackBranchPrefix = "rollback/sync-pr"
+
+// Rollback errors
+var (
+	ErrNoMergedSyncPR    = errors.New("no merged sync pull request found")
+	ErrNoSyncCommit      = errors.New("sync pull request metadata has no sync commit")
+	ErrNoPreSyncCommit   = errors.New("sync commit has no parent commit")
+	ErrNothingToRollback = errors.New("target already matches its pre-sync content")
+)
+
+// RollbackPlan describes how to revert the files changed by the last merged
+// sync pull request of a target
+type RollbackPlan struct {
+	Repo       string       // Target repository
+	SyncPR     gh.PR        // Merged sync pull request being reverted
+	SourceRepo string       // Source repository of the reverted sync
+	SyncCommit string       // Sync commit recorded in the PR metadata
+	BaseCommit string       // Parent of the sync commit, where prior content is read
+	Changes    []FileChange // Reverse changes restoring the pre-sync content
+}
+
+// Rollbacker reverts the last merged sync of a target repository by opening a
+// pull request that restores the synced files to their pre-sync content
+type Rollbacker struct {
+	engine *Engine
+	logger *logrus.Entry
+}
+
+// NewRollbacker creates a Rollbacker that reads history through ghClient and
+// pushes rollback branches with gitClient
+func NewRollbacker(cfg *config.Config, ghClient gh.Client, gitClient git.Client, logger *logrus.Logger) *Rollbacker {
+	return &Rollbacker{
+		engine: &Engine{config: cfg, gh: ghClient, git: gitClient, logger: logger},
+		logger: logger.WithField("component", "rollback"),
+	}
+}
+
+// Plan finds the last merged sync pull request of repo and computes the
+// reverse changes that restore each file it touched. Files that already match
+// their pre-sync content are left out.
+func (r *Rollbacker) Plan(ctx context.Context, repo string) (*RollbackPlan, error) {
+	pr, metadata, err := r.lastMergedSyncPR(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	syncCommit := metadata.SyncMetadata.SyncCommit
+	if syncCommit == "" {
+		return nil, fmt.Errorf("%w: PR #%d", ErrNoSyncCommit, pr.Number)
+	}
+
+	commit, err := r.engine.gh.GetCommit(ctx, repo, syncCommit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sync commit %s: %w", syncCommit, err)
+	}
+	if len(commit.Parents) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoPreSyncCommit, syncCommit)
+	}
+
+	plan := &RollbackPlan{
+		Repo:       repo,
+		SyncPR:     pr,
+		SourceRepo: metadata.SyncMetadata.SourceRepo,
+		SyncCommit: syncCommit,
+		BaseCommit: commit.Parents[0].SHA,
+	}
+
+	// revert adds the change restoring path; existed reports whether the
+	// file was present before the sync
+	revert := func(path string, existed bool) error {
+		change, changeErr := r.reverseChange(ctx, plan, path, existed)
+		if changeErr != nil {
+			return changeErr
+		}
+		if change != nil {
+			plan.Changes = append(plan.Changes, *change)
+		}
+		return nil
+	}
+
+	for _, file := range commit.Files {
+		switch file.Status {
+		case "added", "copied":
+			err = revert(file.Filename, false)
+		case "renamed":
+			if err = revert(file.Filename, false); err == nil {
+				err = revert(file.PreviousFilename, true)
+			}
+		default:
+			err = revert(file.Filename, true)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	r.logger.WithFields(logrus.Fields{
+		"target_repo": repo,
+		"sync_pr":     pr.Number,
+		"base_commit": plan.BaseCommit,
+		"changes":     len(plan.Changes),
+	}).Info("Planned rollback")
+
+	return plan, nil
+}
+
+// lastMergedSyncPR returns the most recently merged pull request of repo that
+// carries sync metadata for it
+func (r *Rollbacker) lastMergedSyncPR(ctx context.Context, repo string) (gh.PR, *state.EnhancedPRMetadata, error) {
+	prs, err := r.engine.gh.ListPRs(ctx, repo, "closed")
+	if err != nil {
+		return gh.PR{}, nil, fmt.Errorf("failed to list pull requests: %w", err)
+	}
+
+	var (
+		latest   gh.PR
+		metadata *state.EnhancedPRMetadata
+	)
+	for _, pr := range prs {
+		if pr.MergedAt == nil {
+			continue
+		}
+		if metadata != nil && !pr.MergedAt.After(*latest.MergedAt) {
+			continue
+		}
+
+		prMetadata, metaErr := state.ExtractEnhancedPRMetadata(pr)
+		if metaErr != nil {
+			continue
+		}
+		if target := prMetadata.SyncMetadata.TargetRepo; target != "" && target != repo {
+			continue
+		}
+		latest, metadata = pr, prMetadata
+	}
+
+	if metadata == nil {
+		return gh.PR{}, nil, fmt.Errorf("%w: %s", ErrNoMergedSyncPR, repo)
+	}
+	return latest, metadata, nil
+}
+
+// reverseChange returns the change restoring path to its content at the base
+// commit, or deleting it when it did not exist there (existed is false). It
+// returns nil when the file already matches.
+func (r *Rollbacker) reverseChange(ctx context.Context, plan *RollbackPlan, path string, existed bool) (*FileChange, error) {
+	current, err := r.fileContent(ctx, plan.Repo, path, plan.SyncPR.Base.Ref)
+	if err != nil {
+		return nil, err
+	}
+
+	var prior []byte
+	if existed {
+		prior, err = r.fileContent(ctx, plan.Repo, path, plan.BaseCommit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case prior == nil && current == nil:
+		return nil, nil
+	case prior == nil:
+		return &FileChange{Path: path, OriginalContent: current, IsDeleted: true}, nil
+	case current != nil && bytes.Equal(prior, current):
+		return nil, nil
+	default:
+		return &FileChange{Path: path, Content: prior, OriginalContent: current, IsNew: current == nil}, nil
+	}
+}
+
+// fileContent reads a file at ref, returning nil when it does not exist
+func (r *Rollbacker) fileContent(ctx context.Context, repo, path, ref string) ([]byte, error) {
+	file, err := r.engine.gh.GetFile(ctx, repo, path, ref)
+	if err != nil {
+		if errors.Is(err, gh.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read %s at %s: %w", path, ref, err)
+	}
+	return file.Content, nil
+}
+
+// Apply commits the plan's changes on a new branch cut from the sync PR's base
+// branch and opens a pull request for it
+func (r *Rollbacker) Apply(ctx context.Context, plan *RollbackPlan) (*gh.PR, error) {
+	if len(plan.Changes) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNothingToRollback, plan.Repo)
+	}
+
+	tempDir, err := os.MkdirTemp("", "go-broadcast-rollback-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(tempDir) }()
+
+	repoPath := filepath.Join(tempDir, "target")
+	baseBranch := plan.SyncPR.Base.Ref
+	opts := &git.CloneOptions{BlobSizeLimit: "0"}
+	if err := r.engine.git.CloneWithBranch(ctx, r.engine.repoCloneURL(plan.Repo), repoPath, baseBranch, opts); err != nil {
+		return nil, fmt.Errorf("failed to clone target repository: %w", err)
+	}
+
+	branch := fmt.Sprintf("%s-%d-%s", rollbackBranchPrefix, plan.SyncPR.Number, time.Now().UTC().Format("20060102-150405"))
+	if err := r.engine.git.CreateBranch(ctx, repoPath, branch); err != nil {
+		return nil, fmt.Errorf("failed to create branch %s: %w", branch, err)
+	}
+	if err := r.engine.git.Checkout(ctx, repoPath, branch); err != nil {
+		return nil, fmt.Errorf("failed to checkout branch %s: %w", branch, err)
+	}
+
+	filesToDelete, err := applyFileChanges(repoPath, plan.Changes)
+	if err != nil {
+		return nil, err
+	}
+	if len(filesToDelete) > 0 {
+		if err := r.engine.git.BatchRemoveFiles(ctx, repoPath, filesToDelete, false); err != nil {
+			r.logger.WithError(err).WithField("files", filesToDelete).Warn("Failed to remove files from git, continuing")
+		}
+	}
+	if err := r.engine.git.Add(ctx, repoPath, "."); err != nil {
+		return nil, fmt.Errorf("failed to stage changes: %w", err)
+	}
+	if err := r.engine.git.Commit(ctx, repoPath, rollbackCommitMessage(plan)); err != nil {
+		return nil, fmt.Errorf("failed to commit rollback: %w", err)
+	}
+	if err := r.engine.git.Push(ctx, repoPath, "origin", branch, false); err != nil {
+		return nil, fmt.Errorf("failed to push branch %s: %w", branch, err)
+	}
+
+	pr, err := r.engine.gh.CreatePR(ctx, plan.Repo, gh.PRRequest{
+		Title: fmt.Sprintf("revert: roll back sync PR #%d", plan.SyncPR.Number),
+		Body:  r.rollbackPRBody(plan),
+		Head:  branch,
+		Base:  baseBranch,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rollback pull request: %w", err)
+	}
+
+	r.logger.WithFields(logrus.Fields{
+		"target_repo": plan.Repo,
+		"sync_pr":     plan.SyncPR.Number,
+		"rollback_pr": pr.Number,
+	}).Info("Opened rollback pull request")
+
+	return pr, nil
+}
+
+// rollbackCommitMessage returns the commit message of a rollback commit
+func rollbackCommitMessage(plan *RollbackPlan) string {
+	return fmt.Sprintf("revert: roll back sync PR #%d\n\nRestores %d file(s) to their content at %s.",
+		plan.SyncPR.Number, len(plan.Changes), shortSHA(plan.BaseCommit))
+}
+
+// rollbackPRBody describes the reverted sync and every restored file
+func (r *Rollbacker) rollbackPRBody(plan *RollbackPlan) string {
+	var sb strings.Builder
+	sb.WriteString("## What Changed\n")
+	fmt.Fprintf(&sb, "* Rolls back sync PR %s", r.engine.pullRequestURL(plan.Repo, plan.SyncPR.Number))
+	if plan.SourceRepo != "" {
+		fmt.Fprintf(&sb, " from %s", plan.SourceRepo)
+	}
+	sb.WriteString("\n")
+	fmt.Fprintf(&sb, "* Restores content from commit %s, before sync commit %s\n\n", plan.BaseCommit, plan.SyncCommit)
+
+	sb.WriteString("## Files\n")
+	for _, change := range plan.Changes {
+		action := "restored"
+		switch {
+		case change.IsDeleted:
+			action = "deleted"
+		case change.IsNew:
+			action = "recreated"
+		}
+		fmt.Fprintf(&sb, "* `%s` (%s)\n", change.Path, action)
+	}
+
+	sb.WriteString("\n---\n*This pull request was opened by `go-broadcast rollback`.*\n")
+	return sb.String()
+}
+
+// shortSHA abbreviates a commit SHA to seven characters
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
@@ -0,0 +1,173 @@
+package sync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/git"
+)
+
+// newRollbackSyncPR returns a merged sync PR for org/target whose metadata
+// records the given sync commit
+func newRollbackSyncPR(number int, mergedAt time.Time, syncCommit string) gh.PR {
+	pr := gh.PR{
+		Number:   number,
+		State:    "closed",
+		MergedAt: &mergedAt,
+		Body: "## What Changed\n\n<!-- go-broadcast-metadata\nsync_metadata:\n" +
+			"  source_repo: org/template\n  source_commit: abc123\n  target_repo: org/target\n" +
+			"  sync_commit: " + syncCommit + "\n  sync_time: 2026-01-01T00:00:00Z\n-->\n",
+	}
+	pr.Base.Ref = "main"
+	return pr
+}
+
+// mockFile stubs GetFile for path at ref; nil content means the file is absent
+func mockFile(ghClient *gh.MockClient, path, ref string, content []byte) {
+	if content == nil {
+		ghClient.On("GetFile", mock.Anything, "org/target", path, ref).Return(nil, gh.ErrFileNotFound)
+		return
+	}
+	ghClient.On("GetFile", mock.Anything, "org/target", path, ref).Return(&gh.FileContent{Path: path, Content: content}, nil)
+}
+
+func TestRollbacker_Plan(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	ghClient := &gh.MockClient{}
+	unmerged := newRollbackSyncPR(9, now, "unmerged")
+	unmerged.MergedAt = nil
+	ghClient.On("ListPRs", mock.Anything, "org/target", "closed").Return([]gh.PR{
+		newRollbackSyncPR(3, now.Add(-48*time.Hour), "old-sync"),
+		newRollbackSyncPR(5, now.Add(-time.Hour), "sync-sha"),
+		unmerged,
+		{Number: 7, MergedAt: &now, Body: "a regular PR"},
+	}, nil)
+
+	commit := &gh.Commit{SHA: "sync-sha", Files: []gh.CommitFile{
+		{Filename: "README.md", Status: "modified"},
+		{Filename: "new.yml", Status: "added"},
+		{Filename: "old.txt", Status: "removed"},
+		{Filename: "docs/guide.md", Status: "renamed", PreviousFilename: "guide.md"},
+		{Filename: "same.txt", Status: "modified"},
+	}}
+	commit.Parents = append(commit.Parents, struct {
+		SHA string `json:"sha"`
+	}{SHA: "base-sha"})
+	ghClient.On("GetCommit", mock.Anything, "org/target", "sync-sha").Return(commit, nil)
+
+	mockFile(ghClient, "README.md", "main", []byte("synced"))
+	mockFile(ghClient, "README.md", "base-sha", []byte("prior"))
+	mockFile(ghClient, "new.yml", "main", []byte("added"))
+	mockFile(ghClient, "old.txt", "main", nil)
+	mockFile(ghClient, "old.txt", "base-sha", []byte("removed content"))
+	mockFile(ghClient, "docs/guide.md", "main", []byte("guide"))
+	mockFile(ghClient, "guide.md", "main", nil)
+	mockFile(ghClient, "guide.md", "base-sha", []byte("guide"))
+	mockFile(ghClient, "same.txt", "main", []byte("reverted by hand"))
+	mockFile(ghClient, "same.txt", "base-sha", []byte("reverted by hand"))
+
+	plan, err := NewRollbacker(nil, ghClient, &git.MockClient{}, logrus.New()).Plan(ctx, "org/target")
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, plan.SyncPR.Number, "the latest merged sync PR is reverted")
+	assert.Equal(t, "base-sha", plan.BaseCommit)
+	assert.Equal(t, "org/template", plan.SourceRepo)
+	assert.Equal(t, []FileChange{
+		{Path: "README.md", Content: []byte("prior"), OriginalContent: []byte("synced")},
+		{Path: "new.yml", OriginalContent: []byte("added"), IsDeleted: true},
+		{Path: "old.txt", Content: []byte("removed content"), IsNew: true},
+		{Path: "docs/guide.md", OriginalContent: []byte("guide"), IsDeleted: true},
+		{Path: "guide.md", Content: []byte("guide"), IsNew: true},
+	}, plan.Changes)
+}
+
+func TestRollbacker_PlanErrors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no merged sync PR", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("ListPRs", mock.Anything, "org/target", "closed").Return([]gh.PR{}, nil)
+
+		_, err := NewRollbacker(nil, ghClient, &git.MockClient{}, logrus.New()).Plan(ctx, "org/target")
+		require.ErrorIs(t, err, ErrNoMergedSyncPR)
+	})
+
+	t.Run("sync commit without parent", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("ListPRs", mock.Anything, "org/target", "closed").
+			Return([]gh.PR{newRollbackSyncPR(5, time.Now(), "root-sha")}, nil)
+		ghClient.On("GetCommit", mock.Anything, "org/target", "root-sha").Return(&gh.Commit{SHA: "root-sha"}, nil)
+
+		_, err := NewRollbacker(nil, ghClient, &git.MockClient{}, logrus.New()).Plan(ctx, "org/target")
+		require.ErrorIs(t, err, ErrNoPreSyncCommit)
+	})
+}
+
+func TestRollbacker_Apply(t *testing.T) {
+	ctx := context.Background()
+	plan := &RollbackPlan{
+		Repo:       "org/target",
+		SyncPR:     newRollbackSyncPR(5, time.Now(), "sync-sha"),
+		SyncCommit: "sync-sha",
+		BaseCommit: "base-sha",
+		Changes: []FileChange{
+			{Path: "config/app.yml", Content: []byte("prior")},
+			{Path: "new.yml", IsDeleted: true},
+		},
+	}
+
+	t.Run("opens a rollback PR", func(t *testing.T) {
+		var repoPath string
+		gitClient := &git.MockClient{}
+		gitClient.On("CloneWithBranch", mock.Anything, "https://github.com/org/target.git", mock.Anything, "main", mock.Anything).
+			Run(func(args mock.Arguments) {
+				repoPath = args.String(2)
+				require.NoError(t, os.MkdirAll(repoPath, 0o750))
+			}).Return(nil)
+		gitClient.On("CreateBranch", mock.Anything, mock.Anything, mock.MatchedBy(func(branch string) bool {
+			return assert.Contains(t, branch, "rollback/sync-pr-5-")
+		})).Return(nil)
+		gitClient.On("Checkout", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		gitClient.On("BatchRemoveFiles", mock.Anything, mock.Anything, []string{"new.yml"}, false).Return(nil)
+		gitClient.On("Add", mock.Anything, mock.Anything, []string{"."}).Run(func(mock.Arguments) {
+			content, err := os.ReadFile(filepath.Join(repoPath, "config", "app.yml")) //nolint:gosec // test path
+			require.NoError(t, err)
+			assert.Equal(t, "prior", string(content))
+		}).Return(nil)
+		gitClient.On("Commit", mock.Anything, mock.Anything, mock.MatchedBy(func(msg string) bool {
+			return assert.Contains(t, msg, "roll back sync PR #5")
+		})).Return(nil)
+		gitClient.On("Push", mock.Anything, mock.Anything, "origin", mock.Anything, false).Return(nil)
+
+		ghClient := &gh.MockClient{}
+		ghClient.On("CreatePR", mock.Anything, "org/target", mock.MatchedBy(func(req gh.PRRequest) bool {
+			return req.Base == "main" &&
+				assert.Contains(t, req.Body, "https://github.com/org/target/pull/5") &&
+				assert.Contains(t, req.Body, "`new.yml` (deleted)") &&
+				assert.NotContains(t, req.Body, "go-broadcast-metadata")
+		})).Return(&gh.PR{Number: 42}, nil)
+
+		pr, err := NewRollbacker(nil, ghClient, gitClient, logrus.New()).Apply(ctx, plan)
+		require.NoError(t, err)
+		assert.Equal(t, 42, pr.Number)
+		gitClient.AssertExpectations(t)
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("nothing to roll back", func(t *testing.T) {
+		_, err := NewRollbacker(nil, &gh.MockClient{}, &git.MockClient{}, logrus.New()).
+			Apply(ctx, &RollbackPlan{Repo: "org/target"})
+		require.ErrorIs(t, err, ErrNothingToRollback)
+	})
+}
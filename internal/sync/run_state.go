@@ -0,0 +1,76 @@
+package sync
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mrz1836/go-broadcast/internal/transform"
+)
+
+// runState is the state of one sync run: totals, results, limits and caches
+// that span every target. The root engine and each per-group view created by
+// forGroup point at the same runState, so concurrent groups add to one set of
+// counters without forwarding calls to the root engine.
+type runState struct {
+	// Dry-run roll-up and plan across all targets
+	dryRunTotals DryRunTotals
+	dryRunPlan   []DryRunTargetPlan // Only collected when options.DryRunPlanFile is set
+	dryRunMu     sync.Mutex         // Protects dryRunTotals and dryRunPlan
+
+	// Per-target results (only collected when options.OutputDir or options.SummaryOnly is set)
+	syncResults    []SyncResult
+	artifactsStart time.Time
+	artifactsMu    sync.Mutex // Protects syncResults
+
+	// Why each target was or was not synced (only collected when options.Explain is set)
+	decisions []TargetDecision
+	explainMu sync.Mutex // Protects decisions
+
+	// Run-wide performance totals (only collected when options.MetricsFile is set)
+	runMetrics   RunMetrics
+	runMetricsMu sync.Mutex // Protects runMetrics
+
+	// Pull request pacing across all targets (only used when options.PRStagger is set)
+	prNextSlot  time.Time
+	prStaggerMu sync.Mutex // Protects prNextSlot
+
+	// Pull requests created or updated by this run (only used when options.MaxPRs is set)
+	prCount atomic.Int64
+
+	// Targets completed by this or an interrupted earlier run (only used when options.CheckpointFile is set)
+	checkpoint   map[string]CheckpointTarget
+	checkpointMu sync.Mutex // Protects checkpoint
+
+	// Chains for targets and directories with their own transform pipeline
+	pipelineChains   map[string]transform.Chain
+	pipelineChainsMu sync.Mutex // Protects pipelineChains
+
+	// Topics of target repositories, fetched once per run by repository
+	targetTopics   map[string][]string
+	targetTopicsMu sync.Mutex // Protects targetTopics
+
+	// PR labels and reviewers resolved once per group and target PR configuration
+	prSettings   map[prSettingsKey]*prSettings
+	prSettingsMu sync.Mutex // Protects prSettings
+
+	// Reviewers picked per reviewer pool in this run (least-loaded strategy)
+	reviewerLoad   reviewerPoolLoad
+	reviewerLoadMu sync.Mutex // Protects reviewerLoad
+
+	// Targets the operator did not approve (only used when options.Interactive is set)
+	interactiveSkips []string
+	interactiveQuit  bool       // The operator quit; later targets are skipped without a prompt
+	interactiveMu    sync.Mutex // Serializes prompts; protects interactiveSkips and interactiveQuit
+}
+
+// shared returns the run state of e, creating it on first use so engines
+// built without NewEngine work too
+func (e *Engine) shared() *runState {
+	e.runOnce.Do(func() {
+		if e.run == nil {
+			e.run = &runState{}
+		}
+	})
+	return e.run
+}
@@ -35,6 +35,10 @@ type ResolvedScope struct {
 	// RepoCount is the total number of in-scope target repositories (the
 	// resolved blast radius / total repos that may be written).
 	RepoCount int
+
+	// SkippedDependents lists the labels of groups that had matching targets
+	// but were dropped because a group they depend on had none.
+	SkippedDependents []string
 }
 
 // ResolveScope resolves the set of groups and target repositories a sync
@@ -48,10 +52,12 @@ type ResolvedScope struct {
 //  3. Disabled groups (Enabled explicitly false) are removed.
 //  4. targetFilter, when set, keeps only targets whose Repo is listed; groups
 //     left with zero matching targets are dropped.
+//  5. Groups depending (directly or transitively) on a group dropped in step 4
+//     are dropped as well and listed in SkippedDependents.
 //
-// When targetFilter is non-empty and no target repository matches it across the
-// surviving groups, it returns appErrors.ErrNoMatchingTargets, preserving the
-// engine's prior behavior. When nothing is filtered away (no explicit group /
+// When any targetFilter value matches no target repository across the
+// surviving groups, it returns appErrors.ErrNoMatchingTargets naming the
+// unmatched values. When nothing is filtered away (no explicit group /
 // skip / target filters and every group is enabled) the original config pointer
 // is preserved so callers relying on pointer identity (e.g. state discovery)
 // see the unchanged config.
@@ -68,7 +74,8 @@ func ResolveScope(cfg *config.Config, options *Options, targetFilter []string) (
 
 	// Step 4: narrow each surviving group's targets to the target filter.
 	narrowed := make([]config.Group, 0, len(filtered))
-	matchedAnyTarget := false
+	matchedTargets := make(map[string]bool, len(targetFilter))
+	droppedGroups := make(map[string]bool)
 	for _, group := range filtered {
 		if len(targetFilter) == 0 {
 			narrowed = append(narrowed, group)
@@ -79,22 +86,29 @@ func ResolveScope(cfg *config.Config, options *Options, targetFilter []string) (
 		for _, target := range group.Targets {
 			if containsRepo(targetFilter, target.Repo) {
 				keptTargets = append(keptTargets, target)
+				matchedTargets[target.Repo] = true
 			}
 		}
 		if len(keptTargets) == 0 {
+			droppedGroups[group.ID] = true
 			continue // group has no targets matching the filter; drop it
 		}
 
-		matchedAnyTarget = true
 		scopedGroup := group
 		scopedGroup.Targets = keptTargets
 		narrowed = append(narrowed, scopedGroup)
 	}
 
-	if len(targetFilter) > 0 && !matchedAnyTarget {
-		return ResolvedScope{}, fmt.Errorf("%w: %v", appErrors.ErrNoMatchingTargets, targetFilter)
+	// Every filter value must match a target in scope so typos fail loudly
+	// instead of silently syncing a subset.
+	if unmatched := unmatchedTargets(targetFilter, matchedTargets); len(unmatched) > 0 {
+		return ResolvedScope{}, fmt.Errorf("%w: %v", appErrors.ErrNoMatchingTargets, unmatched)
 	}
 
+	// Step 5: groups depending on a group dropped by the target filter cannot
+	// run without it, so they are dropped too and reported.
+	narrowed, skippedDependents := dropDependentGroups(narrowed, droppedGroups)
+
 	// Preserve the original config pointer when nothing was filtered away, so
 	// state discovery and other consumers keying off the config pointer are
 	// unaffected by the resolution step.
@@ -116,11 +130,12 @@ func ResolveScope(cfg *config.Config, options *Options, targetFilter []string) (
 	}
 
 	return ResolvedScope{
-		Config:     scopeConfig,
-		Groups:     groups,
-		Repos:      repos,
-		GroupCount: len(narrowed),
-		RepoCount:  len(repos),
+		Config:            scopeConfig,
+		Groups:            groups,
+		Repos:             repos,
+		GroupCount:        len(narrowed),
+		RepoCount:         len(repos),
+		SkippedDependents: skippedDependents,
 	}, nil
 }
 
@@ -173,3 +188,50 @@ func containsRepo(filter []string, repo string) bool {
 	}
 	return false
 }
+
+// unmatchedTargets returns the filter values not present in matched, in filter order
+func unmatchedTargets(filter []string, matched map[string]bool) []string {
+	var unmatched []string
+	for _, repo := range filter {
+		if !matched[repo] {
+			unmatched = append(unmatched, repo)
+		}
+	}
+	return unmatched
+}
+
+// dropDependentGroups removes groups that depend, directly or through other
+// removed groups, on a group in dropped. It returns the remaining groups and the
+// labels of the removed ones.
+func dropDependentGroups(groups []config.Group, dropped map[string]bool) ([]config.Group, []string) {
+	if len(dropped) == 0 {
+		return groups, nil
+	}
+
+	var skipped []string
+	for changed := true; changed; {
+		changed = false
+		kept := make([]config.Group, 0, len(groups))
+		for _, group := range groups {
+			if dependsOnAny(group, dropped) {
+				dropped[group.ID] = true
+				skipped = append(skipped, groupLabel(group))
+				changed = true
+				continue
+			}
+			kept = append(kept, group)
+		}
+		groups = kept
+	}
+	return groups, skipped
+}
+
+// dependsOnAny reports whether group directly depends on any group ID in ids
+func dependsOnAny(group config.Group, ids map[string]bool) bool {
+	for _, dep := range group.DependsOn {
+		if ids[dep] {
+			return true
+		}
+	}
+	return false
+}
@@ -99,6 +99,45 @@ func TestResolveScope_NonMatchingTargetReturnsError(t *testing.T) {
 	assert.ErrorIs(t, err, appErrors.ErrNoMatchingTargets)
 }
 
+func TestResolveScope_PartiallyMatchingTargetsReturnError(t *testing.T) {
+	// Every filter value must match, so a typo next to a valid repo still fails.
+	cfg := multiGroupConfig()
+
+	_, err := ResolveScope(cfg, DefaultOptions(), []string{"org/lib-a", "org/lib-b"})
+	require.ErrorIs(t, err, appErrors.ErrNoMatchingTargets)
+	assert.Contains(t, err.Error(), "org/lib-b")
+	assert.NotContains(t, err.Error(), "org/lib-a")
+}
+
+func TestResolveScope_TargetFilterSkipsDependents(t *testing.T) {
+	// A group depending on one dropped by the target filter is dropped too, and
+	// so is anything depending on it in turn.
+	cfg := multiGroupConfig()
+	cfg.Groups[1].DependsOn = []string{"core"}
+	cfg.Groups[1].Targets = append(cfg.Groups[1].Targets, config.TargetConfig{Repo: "org/app-a"})
+	cfg.Groups[2].DependsOn = []string{"libs"}
+
+	scope, err := ResolveScope(cfg, DefaultOptions(), []string{"org/app-a"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, scope.RepoCount)
+	assert.Empty(t, scope.Config.Groups)
+	assert.Equal(t, []string{"Libs", "Apps"}, scope.SkippedDependents)
+}
+
+func TestResolveScope_TargetFilterKeepsSatisfiedDependents(t *testing.T) {
+	cfg := multiGroupConfig()
+	cfg.Groups[0].Targets = append(cfg.Groups[0].Targets, config.TargetConfig{Repo: "org/lib-a"})
+	cfg.Groups[1].DependsOn = []string{"core"}
+
+	scope, err := ResolveScope(cfg, DefaultOptions(), []string{"org/lib-a"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Core", "Libs"}, scope.Groups)
+	assert.Equal(t, []string{"org/lib-a", "org/lib-a"}, scope.Repos)
+	assert.Empty(t, scope.SkippedDependents)
+}
+
 func TestResolveScope_DisabledGroupsExcluded(t *testing.T) {
 	cfg := multiGroupConfig()
 	disabled := false
@@ -0,0 +1,39 @@
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+)
+
+// ErrSourcePathEscapes indicates a mapped source path resolves, through ".."
+// or a symlink, to a location outside the source tree
+var ErrSourcePathEscapes = errors.New("source path resolves outside the source tree")
+
+// resolveSourcePath joins rel to the source tree root and resolves every
+// symlink along the way, so mappings may point into symlinked files and
+// directories. It returns ErrSourcePathEscapes when the resolved path is not
+// inside root. A missing path returns the *fs.PathError from resolving it
+// unwrapped, so os.IsNotExist still reports it.
+func resolveSourcePath(root, rel string) (string, error) {
+	realRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", err
+	}
+
+	resolved, err := filepath.EvalSymlinks(filepath.Join(realRoot, rel))
+	if err != nil {
+		return "", err
+	}
+	if !withinDir(realRoot, resolved) {
+		return "", fmt.Errorf("%w: %s resolves to %s", ErrSourcePathEscapes, rel, resolved)
+	}
+	return resolved, nil
+}
+
+// withinDir reports whether path is dir or lies below it. Both must be clean,
+// symlink-free paths.
+func withinDir(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && filepath.IsLocal(rel)
+}
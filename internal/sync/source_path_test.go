@@ -0,0 +1,226 @@
+package sync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	internalerrors "github.com/mrz1836/go-broadcast/internal/errors"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/state"
+)
+
+// newSymlinkSourceTree builds a source tree with symlinks that stay inside it
+// and malicious ones that lead out of it into a sibling "outside" directory
+//
+//	README.md
+//	real/a.txt, real/nested/b.txt
+//	linked -> real                      (symlinked directory)
+//	readme-link.md -> README.md
+//	escape.txt -> ../outside/secret.txt
+//	abs-escape -> /abs/path/to/outside
+//	real/up.md -> ../README.md          (outside the mapping, inside the tree)
+//	real/leak.txt -> ../../outside/secret.txt
+//	real/dirlink -> nested              (symlinked directory, not walked)
+//	real/dangling -> missing.txt
+func newSymlinkSourceTree(t *testing.T) string {
+	t.Helper()
+	base := t.TempDir()
+	root := filepath.Join(base, "source")
+	outside := filepath.Join(base, "outside")
+
+	for path, content := range map[string]string{
+		filepath.Join(outside, "secret.txt"):           "secret\n",
+		filepath.Join(root, "README.md"):               "readme\n",
+		filepath.Join(root, "real", "a.txt"):           "a\n",
+		filepath.Join(root, "real", "nested", "b.txt"): "b\n",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+
+	for link, target := range map[string]string{
+		filepath.Join(root, "linked"):           "real",
+		filepath.Join(root, "readme-link.md"):   "README.md",
+		filepath.Join(root, "escape.txt"):       filepath.Join("..", "outside", "secret.txt"),
+		filepath.Join(root, "abs-escape"):       outside,
+		filepath.Join(root, "real", "up.md"):    filepath.Join("..", "README.md"),
+		filepath.Join(root, "real", "leak.txt"): filepath.Join("..", "..", "outside", "secret.txt"),
+		filepath.Join(root, "real", "dirlink"):  "nested",
+		filepath.Join(root, "real", "dangling"): "missing.txt",
+	} {
+		require.NoError(t, os.Symlink(target, link))
+	}
+	return root
+}
+
+func TestResolveSourcePath(t *testing.T) {
+	root := newSymlinkSourceTree(t)
+	realRoot, err := filepath.EvalSymlinks(root)
+	require.NoError(t, err)
+
+	for rel, want := range map[string]string{
+		"README.md":         "README.md",
+		"linked/a.txt":      "real/a.txt",
+		"linked/nested":     "real/nested",
+		"readme-link.md":    "README.md",
+		"real/up.md":        "README.md",
+		"real/../README.md": "README.md",
+		"/real/a.txt":       "real/a.txt",
+	} {
+		resolved, err := resolveSourcePath(root, rel)
+		require.NoError(t, err, rel)
+		assert.Equal(t, filepath.Join(realRoot, want), resolved, rel)
+	}
+
+	for _, rel := range []string{"escape.txt", "abs-escape/secret.txt", "real/leak.txt", "../outside/secret.txt", "linked/../../outside/secret.txt"} {
+		_, err := resolveSourcePath(root, rel)
+		require.ErrorIs(t, err, ErrSourcePathEscapes, rel)
+	}
+
+	_, err = resolveSourcePath(root, "real/dangling")
+	assert.True(t, os.IsNotExist(err), "a dangling symlink is a missing file")
+	_, err = resolveSourcePath(root, "missing.txt")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestDirectoryProcessor_discoverFiles_Symlinks(t *testing.T) {
+	root := newSymlinkSourceTree(t)
+	dp := NewDirectoryProcessor(testEntry(), 2, nil)
+	defer dp.Close()
+	dp.sourceRoot = root
+
+	files, err := dp.discoverFiles(context.Background(), filepath.Join(root, "real"), config.DirectoryMapping{Src: "real", Dest: "real"})
+	require.NoError(t, err)
+
+	paths := make([]string, 0, len(files))
+	for _, file := range files {
+		paths = append(paths, file.RelativePath)
+	}
+	sort.Strings(paths)
+	assert.Equal(t, []string{"a.txt", filepath.Join("nested", "b.txt"), "up.md"}, paths)
+}
+
+func TestDirectoryProcessor_ProcessDirectoryMapping_SymlinkEscape(t *testing.T) {
+	root := newSymlinkSourceTree(t)
+	dp := NewDirectoryProcessor(testEntry(), 2, nil)
+	defer dp.Close()
+
+	_, err := dp.ProcessDirectoryMapping(context.Background(), root, config.DirectoryMapping{Src: "abs-escape", Dest: "out"},
+		config.TargetConfig{Repo: "org/target"}, &state.SourceState{}, nil)
+	require.ErrorIs(t, err, internalerrors.ErrFileNotFound)
+}
+
+func TestRepositorySync_processFile_Symlinks(t *testing.T) {
+	root := newSymlinkSourceTree(t)
+	target := config.TargetConfig{Repo: "org/target"}
+
+	t.Run("reads through a symlinked directory", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/target", "a.txt", "").Return(nil, gh.ErrFileNotFound).Once()
+		rs := newTestRepoSync(ghClient, nil, target, nil)
+
+		change, err := rs.processFile(context.Background(), root, config.FileMapping{Src: "linked/a.txt", Dest: "a.txt"})
+		require.NoError(t, err)
+		assert.Equal(t, "a\n", string(change.Content))
+	})
+
+	t.Run("skips a symlink escaping the source tree", func(t *testing.T) {
+		for _, src := range []string{"escape.txt", "abs-escape/secret.txt", "real/leak.txt"} {
+			ghClient := &gh.MockClient{}
+			rs := newTestRepoSync(ghClient, nil, target, nil)
+
+			_, err := rs.processFile(context.Background(), root, config.FileMapping{Src: src, Dest: "secret.txt"})
+			require.ErrorIs(t, err, internalerrors.ErrFileNotFound, src)
+			ghClient.AssertNotCalled(t, "GetFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		}
+	})
+}
+
+func TestBatchProcessor_processFileJob_SymlinkEscape(t *testing.T) {
+	root := newSymlinkSourceTree(t)
+	bp := NewBatchProcessor(nil, config.TargetConfig{Repo: "org/target"}, &state.SourceState{}, testEntry(), 1)
+
+	result := bp.processFileJob(context.Background(), root, NewFileJob("real/leak.txt", "leak.txt", config.Transform{}), testEntry())
+	require.ErrorIs(t, result.Error, internalerrors.ErrFileNotFound)
+	assert.Nil(t, result.Change)
+}
+
+// newSourceRootTree builds a source tree holding a template root under
+// templates/service next to files at the repository root
+func newSourceRootTree(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "templates", "service", "docs"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("root readme"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "templates", "service", "README.md"), []byte("service readme"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "templates", "service", "docs", "guide.md"), []byte("guide"), 0o600))
+	return dir
+}
+
+func TestRepositorySync_SourceRoot(t *testing.T) {
+	ctx := context.Background()
+	mapping := config.FileMapping{Src: "README.md", Dest: "README.md"}
+
+	t.Run("local source mappings resolve under the root", func(t *testing.T) {
+		dir := newSourceRootTree(t)
+		for root, want := range map[string]string{"": "root readme", "templates/service": "service readme"} {
+			rs := newTestRepoSync(&gh.MockClient{}, nil, config.TargetConfig{Repo: "org/target", SourceRoot: root}, nil)
+			rs.sourceState = &state.SourceState{Repo: dir, Branch: "master", LatestCommit: "abc123"}
+
+			require.NoError(t, rs.cloneSource(ctx))
+			content, err := rs.transformedSourceContent(ctx, mapping)
+			require.NoError(t, err)
+			assert.Equal(t, want, string(content), root)
+		}
+	})
+
+	t.Run("processed files are read under the root", func(t *testing.T) {
+		dir := newSourceRootTree(t)
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/target", mock.Anything, mock.Anything).Return(nil, gh.ErrFileNotFound)
+		target := config.TargetConfig{
+			Repo:       "org/target",
+			SourceRoot: "templates/service",
+			Files:      []config.FileMapping{mapping, {Src: "docs/guide.md", Dest: "GUIDE.md"}},
+		}
+		rs := newTestRepoSync(ghClient, nil, target, nil)
+		rs.sourceState = &state.SourceState{Repo: dir, Branch: "master", LatestCommit: "abc123"}
+
+		changes, err := rs.processFiles(ctx)
+		require.NoError(t, err)
+		contents := map[string]string{}
+		for _, change := range changes {
+			contents[change.Path] = string(change.Content)
+		}
+		assert.Equal(t, map[string]string{"README.md": "service readme", "GUIDE.md": "guide"}, contents)
+	})
+
+	t.Run("remote source reads the root through the API", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/template", "templates/service/README.md", "abc123").
+			Return(&gh.FileContent{Content: []byte("service readme")}, nil)
+		rs := newTestRepoSync(ghClient, nil, config.TargetConfig{Repo: "org/target", SourceRoot: "templates/service"}, nil)
+
+		content, err := rs.transformedSourceContent(ctx, mapping)
+		require.NoError(t, err)
+		assert.Equal(t, "service readme", string(content))
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("root must be a directory of the source", func(t *testing.T) {
+		dir := newSourceRootTree(t)
+		for _, root := range []string{"templates/missing", "templates/service/README.md"} {
+			rs := newTestRepoSync(&gh.MockClient{}, nil, config.TargetConfig{Repo: "org/target", SourceRoot: root}, nil)
+			rs.sourceState = &state.SourceState{Repo: dir, Branch: "master", LatestCommit: "abc123"}
+			require.ErrorIs(t, rs.cloneSource(ctx), ErrSourceRootNotFound, root)
+		}
+	})
+}
@@ -0,0 +1,149 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/state"
+)
+
+// sourceStatusMarker starts the source status comment, so later runs find and
+// update it instead of adding another
+const sourceStatusMarker = "<!-- go-broadcast:source-status -->"
+
+// Target states shown in the source status comment
+const (
+	sourceStatusInSync = "in sync"
+	sourceStatusOpenPR = "open PR"
+	sourceStatusPushed = "branch pushed"
+	sourceStatusFailed = "failed"
+)
+
+// newestOpenPR returns the most recently opened sync PR of a target, or nil
+func newestOpenPR(targetState *state.TargetState) *gh.PR {
+	if targetState == nil {
+		return nil
+	}
+	var newest *gh.PR
+	for i := range targetState.OpenPRs {
+		if newest == nil || targetState.OpenPRs[i].Number > newest.Number {
+			newest = &targetState.OpenPRs[i]
+		}
+	}
+	return newest
+}
+
+// sourceTargetStatus returns the state of a target shown in the source status comment
+func sourceTargetStatus(result SyncResult) string {
+	switch {
+	case result.Status == TargetStatusFailed:
+		return sourceStatusFailed
+	case result.PRNumber != nil:
+		return sourceStatusOpenPR
+	case result.Status == TargetStatusBranchPushed:
+		return sourceStatusPushed
+	default:
+		return sourceStatusInSync
+	}
+}
+
+// renderSourceStatus returns the source status comment for the results of
+// the targets synced from one source repository
+func renderSourceStatus(results []SyncResult, finishedAt time.Time) string {
+	results = append([]SyncResult(nil), results...)
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Repo < results[j].Repo })
+
+	counts := map[string]int{}
+	for _, result := range results {
+		counts[sourceTargetStatus(result)]++
+	}
+
+	var body strings.Builder
+	body.WriteString(sourceStatusMarker + "\n")
+	body.WriteString("## go-broadcast status\n\n")
+	fmt.Fprintf(&body, "Last broadcast finished %s: %d in sync, %d with an open PR, %d failed",
+		finishedAt.UTC().Format("2006-01-02 15:04 MST"),
+		counts[sourceStatusInSync], counts[sourceStatusOpenPR], counts[sourceStatusFailed])
+	if counts[sourceStatusPushed] > 0 {
+		fmt.Fprintf(&body, ", %d with a pushed branch", counts[sourceStatusPushed])
+	}
+	body.WriteString(".\n\n")
+
+	body.WriteString("| Target | Status | Pull request | Source commit |\n")
+	body.WriteString("|--------|--------|--------------|---------------|\n")
+	for _, result := range results {
+		status := sourceTargetStatus(result)
+		if result.Error != "" {
+			status += ": " + errorCell(result.Error)
+		}
+		pr := ""
+		if result.PRNumber != nil {
+			pr = fmt.Sprintf("[#%d](%s)", *result.PRNumber, result.PRURL)
+		}
+		fmt.Fprintf(&body, "| %s | %s | %s | `%s` |\n", result.Repo, status, pr, shortSHA(result.SourceCommit))
+	}
+	return body.String()
+}
+
+// errorCell returns the first line of a target error, safe for a Markdown table cell
+func errorCell(message string) string {
+	message, _, _ = strings.Cut(message, "\n")
+	return strings.ReplaceAll(message, "|", "\\|")
+}
+
+// postSourceStatus posts or updates the status comment on
+// Options.SourceStatusIssue in each source repository of the run. Dry runs
+// and local sources post nothing, and failures are logged without failing
+// the sync.
+func (e *Engine) postSourceStatus(ctx context.Context, log *logrus.Entry) {
+	if e.options.SourceStatusIssue <= 0 || e.options.DryRun {
+		return
+	}
+
+	bySource := map[string][]SyncResult{}
+	for _, result := range e.SyncResults() {
+		if result.sourceRepo == "" || config.IsLocalSource(result.sourceRepo) {
+			continue
+		}
+		bySource[result.sourceRepo] = append(bySource[result.sourceRepo], result)
+	}
+
+	sources := make([]string, 0, len(bySource))
+	for source := range bySource {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	finishedAt := time.Now()
+	for _, source := range sources {
+		fields := logrus.Fields{"source_repo": source, "issue": e.options.SourceStatusIssue}
+		if err := e.upsertSourceStatus(ctx, source, renderSourceStatus(bySource[source], finishedAt)); err != nil {
+			log.WithError(err).WithFields(fields).Warn("Failed to update the source status comment")
+			continue
+		}
+		log.WithFields(fields).Info("Updated the source status comment")
+	}
+}
+
+// upsertSourceStatus updates the status comment on the source issue of repo,
+// creating it on the first run
+func (e *Engine) upsertSourceStatus(ctx context.Context, repo, body string) error {
+	comments, err := e.gh.ListIssueComments(ctx, repo, e.options.SourceStatusIssue)
+	if err != nil {
+		return err
+	}
+	for _, comment := range comments {
+		if strings.HasPrefix(comment.Body, sourceStatusMarker) {
+			return e.gh.UpdateIssueComment(ctx, repo, comment.ID, body)
+		}
+	}
+	_, err = e.gh.CreateIssueComment(ctx, repo, e.options.SourceStatusIssue, body)
+	return err
+}
@@ -0,0 +1,107 @@
+package sync
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/state"
+)
+
+// sourceStatusResults returns one in-sync, one open-PR and one failed target
+// synced from org/template
+func sourceStatusResults() []SyncResult {
+	prNumber := 12
+	return []SyncResult{
+		{Repo: "org/c", Status: TargetStatusFailed, SourceCommit: "abc1234567", Error: "push rejected | retry\ndetails", sourceRepo: "org/template"},
+		{Repo: "org/a", Status: TargetStatusSkipped, SourceCommit: "abc1234567", sourceRepo: "org/template"},
+		{Repo: "org/b", Status: TargetStatusSuccess, SourceCommit: "abc1234567", PRNumber: &prNumber, PRURL: "https://github.com/org/b/pull/12", sourceRepo: "org/template"},
+	}
+}
+
+func TestRenderSourceStatus(t *testing.T) {
+	body := renderSourceStatus(sourceStatusResults(), time.Date(2026, 10, 16, 12, 30, 0, 0, time.UTC))
+
+	assert.True(t, strings.HasPrefix(body, sourceStatusMarker+"\n"))
+	assert.Contains(t, body, "Last broadcast finished 2026-10-16 12:30 UTC: 1 in sync, 1 with an open PR, 1 failed.")
+	assert.NotContains(t, body, "pushed branch")
+
+	// Targets are listed by name, errors on one line with pipes escaped
+	rows := body[strings.Index(body, "| org/a"):]
+	assert.Equal(t, strings.Join([]string{
+		"| org/a | in sync |  | `abc1234` |",
+		"| org/b | open PR | [#12](https://github.com/org/b/pull/12) | `abc1234` |",
+		"| org/c | failed: push rejected \\| retry |  | `abc1234` |",
+		"",
+	}, "\n"), rows)
+}
+
+func TestEngine_postSourceStatus(t *testing.T) {
+	newEngine := func(ghClient gh.Client, opts *Options) *Engine {
+		engine := &Engine{gh: ghClient, options: opts, logger: logrus.New()}
+		for _, result := range sourceStatusResults() {
+			engine.appendSyncResult(result)
+		}
+		return engine
+	}
+	log := logrus.NewEntry(logrus.New())
+	isStatus := mock.MatchedBy(func(body string) bool { return strings.HasPrefix(body, sourceStatusMarker) })
+
+	t.Run("first run creates the comment", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("ListIssueComments", mock.Anything, "org/template", 7).
+			Return([]gh.IssueComment{{ID: 1, Body: "unrelated"}}, nil)
+		ghClient.On("CreateIssueComment", mock.Anything, "org/template", 7, isStatus).
+			Return(&gh.IssueComment{ID: 2}, nil)
+
+		newEngine(ghClient, DefaultOptions().WithSourceStatusIssue(7)).postSourceStatus(context.Background(), log)
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("later runs update the same comment", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("ListIssueComments", mock.Anything, "org/template", 7).
+			Return([]gh.IssueComment{{ID: 1, Body: "unrelated"}, {ID: 5, Body: sourceStatusMarker + "\nold"}}, nil)
+		ghClient.On("UpdateIssueComment", mock.Anything, "org/template", int64(5), isStatus).Return(nil)
+
+		newEngine(ghClient, DefaultOptions().WithSourceStatusIssue(7)).postSourceStatus(context.Background(), log)
+		ghClient.AssertExpectations(t)
+		ghClient.AssertNotCalled(t, "CreateIssueComment", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("failures never fail the run", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("ListIssueComments", mock.Anything, "org/template", 7).Return(nil, gh.ErrFileNotFound)
+
+		newEngine(ghClient, DefaultOptions().WithSourceStatusIssue(7)).postSourceStatus(context.Background(), log)
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("disabled and dry runs post nothing", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		newEngine(ghClient, DefaultOptions()).postSourceStatus(context.Background(), log)
+		newEngine(ghClient, DefaultOptions().WithSourceStatusIssue(7).WithDryRun(true)).postSourceStatus(context.Background(), log)
+		ghClient.AssertNotCalled(t, "ListIssueComments", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestEngine_recordSkippedTarget_OpenPR(t *testing.T) {
+	engine := &Engine{options: DefaultOptions().WithSourceStatusIssue(7), logger: logrus.New()}
+	targetState := &state.TargetState{OpenPRs: []gh.PR{{Number: 3}, {Number: 9}}}
+
+	engine.recordSkippedTarget("org/a", state.SourceState{Repo: "org/template", LatestCommit: "abc123"}, targetState)
+
+	results := engine.SyncResults()
+	require.Len(t, results, 1)
+	require.NotNil(t, results[0].PRNumber)
+	assert.Equal(t, 9, *results[0].PRNumber)
+	assert.Equal(t, "https://github.com/org/a/pull/9", results[0].PRURL)
+	assert.Equal(t, sourceStatusOpenPR, sourceTargetStatus(results[0]))
+}
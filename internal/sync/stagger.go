@@ -0,0 +1,58 @@
+package sync
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// waitForPRSlot blocks until this sync may open a pull request and reserves
+// the next slot PRStagger (plus jitter) later, so concurrent targets open
+// their pull requests one gap apart. The first pull request opens at once.
+// It is a no-op without PRStagger or in dry-run mode, and returns the
+// context error if ctx is canceled while waiting.
+func (e *Engine) waitForPRSlot(ctx context.Context, logger *logrus.Entry) error {
+	if e.options == nil || e.options.PRStagger <= 0 || e.options.DryRun {
+		return nil
+	}
+	run := e.shared()
+	run.prStaggerMu.Lock()
+	slot := time.Now()
+	if run.prNextSlot.After(slot) {
+		slot = run.prNextSlot
+	}
+	run.prNextSlot = slot.Add(e.options.PRStagger + staggerJitter(e.options.PRStaggerJitter))
+	run.prStaggerMu.Unlock()
+
+	wait := time.Until(slot)
+	if wait <= 0 {
+		return nil
+	}
+	if logger != nil {
+		logger.WithField("wait", wait.Round(time.Millisecond).String()).Info("Staggering pull request creation")
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// staggerJitter returns a random duration in [0, maxJitter)
+func staggerJitter(maxJitter time.Duration) time.Duration {
+	if maxJitter <= 0 {
+		return 0
+	}
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(maxJitter)))
+	if err != nil {
+		return maxJitter / 2
+	}
+	return time.Duration(n.Int64())
+}
@@ -0,0 +1,65 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+func TestEngine_WaitForPRSlot(t *testing.T) {
+	ctx := context.Background()
+	const gap = 40 * time.Millisecond
+
+	t.Run("disabled and dry-run do not wait", func(t *testing.T) {
+		for _, opts := range []*Options{DefaultOptions(), DefaultOptions().WithPRStagger(time.Hour, 0).WithDryRun(true)} {
+			engine := &Engine{options: opts}
+			start := time.Now()
+			for range 3 {
+				require.NoError(t, engine.waitForPRSlot(ctx, nil))
+			}
+			assert.Less(t, time.Since(start), gap)
+		}
+	})
+
+	t.Run("spaces PRs across group views", func(t *testing.T) {
+		engine := &Engine{options: DefaultOptions().WithPRStagger(gap, 0)}
+		groupA := engine.forGroup(&config.Config{}, &config.Group{ID: "a"})
+		groupB := engine.forGroup(&config.Config{}, &config.Group{ID: "b"})
+
+		start := time.Now()
+		require.NoError(t, groupA.waitForPRSlot(ctx, nil))
+		assert.Less(t, time.Since(start), gap, "the first PR opens at once")
+		require.NoError(t, groupB.waitForPRSlot(ctx, nil))
+		require.NoError(t, groupA.waitForPRSlot(ctx, nil))
+		assert.GreaterOrEqual(t, time.Since(start), 2*gap)
+	})
+
+	t.Run("respects cancellation", func(t *testing.T) {
+		engine := &Engine{options: DefaultOptions().WithPRStagger(time.Hour, 0)}
+		require.NoError(t, engine.waitForPRSlot(ctx, nil))
+
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		require.ErrorIs(t, engine.waitForPRSlot(canceled, nil), context.Canceled)
+	})
+}
+
+func TestStaggerJitter(t *testing.T) {
+	assert.Zero(t, staggerJitter(0))
+	for range 20 {
+		jitter := staggerJitter(10 * time.Millisecond)
+		assert.GreaterOrEqual(t, jitter, time.Duration(0))
+		assert.Less(t, jitter, 10*time.Millisecond)
+	}
+}
+
+func TestOptions_WithPRStagger(t *testing.T) {
+	opts := DefaultOptions().WithPRStagger(30*time.Second, -time.Second)
+	assert.Equal(t, 30*time.Second, opts.PRStagger)
+	assert.Zero(t, opts.PRStaggerJitter)
+}
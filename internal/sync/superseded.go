@@ -0,0 +1,75 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/state"
+)
+
+// closeSupersededPRs closes the open sync PRs of the target that the PR just
+// created from branchName replaces, commenting with a link to it, and deletes
+// their branches. Failures are logged and never fail the sync.
+func (rs *RepositorySync) closeSupersededPRs(ctx context.Context, current *gh.PR, branchName, baseBranch string) {
+	superseded := rs.supersededPRs(current.Number, branchName, baseBranch)
+	if len(superseded) == 0 {
+		return
+	}
+
+	comment := fmt.Sprintf("Superseded by #%d (%s), which carries a newer sync from %s. Closed automatically by go-broadcast.",
+		current.Number, rs.engine.pullRequestURL(rs.target.Repo, current.Number), rs.sourceState.Repo)
+	for _, pr := range superseded {
+		log := rs.logger.WithFields(logrus.Fields{
+			"pr_number":     pr.Number,
+			"branch_name":   pr.Head.Ref,
+			"superseded_by": current.Number,
+		})
+
+		rs.TrackAPIRequest()
+		if err := rs.engine.gh.ClosePR(ctx, rs.target.Repo, pr.Number, comment); err != nil {
+			log.WithError(err).Warn("Failed to close superseded sync PR")
+			continue
+		}
+		rs.TrackAPIRequest()
+		if err := rs.engine.gh.DeleteBranch(ctx, rs.branchRepo(), pr.Head.Ref); err != nil && !errors.Is(err, gh.ErrBranchNotFound) {
+			log.WithError(err).Warn("Failed to delete branch of superseded sync PR")
+		}
+		log.Info("Closed superseded sync PR")
+	}
+}
+
+// supersededPRs returns the open sync PRs replaced by PR number, created from
+// branchName into baseBranch: those from another branch with the sync prefix
+// into the same base. Fixed branches are reused rather than replaced, and a
+// PR whose metadata records another source repository belongs to another
+// sync, so both are kept.
+func (rs *RepositorySync) supersededPRs(number int, branchName, baseBranch string) []gh.PR {
+	if rs.targetState == nil {
+		return nil
+	}
+
+	prefix := rs.getBranchPrefix()
+	var superseded []gh.PR
+	for _, pr := range rs.targetState.OpenPRs {
+		if pr.Number == number || pr.Head.Ref == branchName {
+			continue
+		}
+		if !strings.HasPrefix(pr.Head.Ref, prefix) || rs.isFixedBranch(pr.Head.Ref) {
+			continue
+		}
+		if pr.Base.Ref != "" && pr.Base.Ref != baseBranch {
+			continue
+		}
+		if metadata, err := state.ExtractEnhancedPRMetadata(pr); err == nil && metadata.SyncMetadata != nil &&
+			metadata.SyncMetadata.SourceRepo != "" && metadata.SyncMetadata.SourceRepo != rs.sourceState.Repo {
+			continue
+		}
+		superseded = append(superseded, pr)
+	}
+	return superseded
+}
@@ -0,0 +1,110 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/state"
+)
+
+// newSyncPR returns an open PR from branch into base
+func newSyncPR(number int, branch, base string) gh.PR {
+	pr := gh.PR{Number: number, State: "open"}
+	pr.Head.Ref = branch
+	pr.Base.Ref = base
+	return pr
+}
+
+func TestCreateNewPR_CloseSupersededPRs(t *testing.T) {
+	ctx := context.Background()
+	const newBranch = "chore/sync-files-core-20250102-150405-abc1234"
+
+	newRepoSync := func(ghClient *gh.MockClient, opts *Options, openPRs ...gh.PR) *RepositorySync {
+		ghClient.On("GetCurrentUser", ctx).Return(&gh.User{Login: "authoruser"}, nil)
+		ghClient.On("ListBranches", ctx, "org/target").Return([]gh.Branch{{Name: "master"}}, nil)
+		ghClient.On("CreatePR", ctx, "org/target", mock.Anything).Return(&gh.PR{Number: 42}, nil)
+
+		return &RepositorySync{
+			engine: &Engine{
+				config:  &config.Config{Groups: []config.Group{{ID: "core"}}},
+				gh:      ghClient,
+				logger:  logrus.New(),
+				options: opts,
+			},
+			target:      config.TargetConfig{Repo: "org/target"},
+			logger:      logrus.NewEntry(logrus.New()),
+			sourceState: &state.SourceState{Repo: "org/template", LatestCommit: "abc123"},
+			targetState: &state.TargetState{OpenPRs: openPRs},
+		}
+	}
+
+	t.Run("closes older sync PRs with a link to the new PR", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		older := newSyncPR(7, "chore/sync-files-core-20250101-090000-def5678", "master")
+		var comment string
+		ghClient.On("ClosePR", ctx, "org/target", 7, mock.AnythingOfType("string")).Return(nil).
+			Run(func(args mock.Arguments) { comment = args.String(3) })
+		ghClient.On("DeleteBranch", ctx, "org/target", older.Head.Ref).Return(nil)
+
+		rs := newRepoSync(ghClient, DefaultOptions().WithCloseSupersededPRs(true), older)
+		require.NoError(t, rs.createNewPR(ctx, newBranch, "abc123", []FileChange{}, nil))
+
+		ghClient.AssertExpectations(t)
+		assert.Contains(t, comment, "Superseded by #42 (https://github.com/org/target/pull/42)")
+		assert.Contains(t, comment, "org/template")
+	})
+
+	t.Run("never closes the new PR or unrelated PRs", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		metadataOfOtherSource := "<!-- go-broadcast-metadata\nsync_metadata:\n  source_repo: org/other-template\n-->"
+		otherSource := newSyncPR(9, "chore/sync-files-docs-20250101-090000-aaa1111", "master")
+		otherSource.Body = metadataOfOtherSource
+
+		rs := newRepoSync(ghClient, DefaultOptions().WithCloseSupersededPRs(true),
+			newSyncPR(42, newBranch, "master"),                                        // The new PR itself
+			newSyncPR(8, newBranch, "master"),                                         // Same branch
+			newSyncPR(10, "feature/unrelated", "master"),                              // Not a sync branch
+			newSyncPR(11, "chore/sync-files-core-20250101-090000-bbb2222", "develop"), // Another base
+			otherSource,
+		)
+		require.NoError(t, rs.createNewPR(ctx, newBranch, "abc123", []FileChange{}, nil))
+
+		ghClient.AssertNotCalled(t, "ClosePR", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		ghClient.AssertNotCalled(t, "DeleteBranch", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("keeps fixed branches", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		rs := newRepoSync(ghClient, DefaultOptions().WithCloseSupersededPRs(true),
+			newSyncPR(7, "chore/sync-files-automated", "master"))
+		rs.engine.config.Groups[0].Targets = []config.TargetConfig{{Repo: "org/target", FixedBranch: "chore/sync-files-automated"}}
+
+		require.NoError(t, rs.createNewPR(ctx, newBranch, "abc123", []FileChange{}, nil))
+		ghClient.AssertNotCalled(t, "ClosePR", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("close failure keeps the branch and the sync", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("ClosePR", ctx, "org/target", 7, mock.AnythingOfType("string")).Return(assert.AnError)
+
+		rs := newRepoSync(ghClient, DefaultOptions().WithCloseSupersededPRs(true),
+			newSyncPR(7, "chore/sync-files-core-20250101-090000-def5678", "master"))
+		require.NoError(t, rs.createNewPR(ctx, newBranch, "abc123", []FileChange{}, nil))
+		ghClient.AssertNotCalled(t, "DeleteBranch", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		rs := newRepoSync(ghClient, DefaultOptions(),
+			newSyncPR(7, "chore/sync-files-core-20250101-090000-def5678", "master"))
+		require.NoError(t, rs.createNewPR(ctx, newBranch, "abc123", []FileChange{}, nil))
+		ghClient.AssertNotCalled(t, "ClosePR", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,39 @@
+package sync
+
+// SyncPhase is the stage of a repository sync a failure occurred in
+type SyncPhase string
+
+// Phases reported by SyncError, in the order a sync runs them
+const (
+	PhasePrepare   SyncPhase = "prepare"   // File conditions, fork verification, target lock and workspace setup
+	PhaseClone     SyncPhase = "clone"     // Cloning the source repository
+	PhaseTransform SyncPhase = "transform" // Reading and transforming files and directories
+	PhaseCommit    SyncPhase = "commit"    // Creating the sync branch commit
+	PhasePush      SyncPhase = "push"      // Pushing the sync branch
+	PhasePR        SyncPhase = "pr"        // Creating or updating the pull request, awaiting checks and auto-merging
+	PhaseHook      SyncPhase = "hook"      // Running the post-sync hook
+)
+
+// SyncError is returned by a failed repository sync. It records the target
+// and phase that failed so callers can handle failures with errors.As, while
+// errors.Is still matches the sentinel errors Err wraps.
+type SyncError struct {
+	Target string
+	Phase  SyncPhase
+	Err    error
+}
+
+// Error implements the error interface
+func (se *SyncError) Error() string {
+	return se.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (se *SyncError) Unwrap() error {
+	return se.Err
+}
+
+// syncError wraps err, a failure of rs in phase, in a SyncError
+func (rs *RepositorySync) syncError(phase SyncPhase, err error) error {
+	return &SyncError{Target: rs.target.Repo, Phase: phase, Err: err}
+}
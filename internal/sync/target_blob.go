@@ -0,0 +1,121 @@
+package sync
+
+import (
+	"context"
+	"crypto/sha1" //nolint:gosec // Git identifies blobs by SHA-1; not used for security
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// gitBlobSHA returns the SHA git and the GitHub API report for a blob with
+// the given content
+func gitBlobSHA(content []byte) string {
+	digest := sha1.New() //nolint:gosec // Git identifies blobs by SHA-1; not used for security
+	fmt.Fprintf(digest, "blob %d\x00", len(content))
+	digest.Write(content)
+	return fmt.Sprintf("%x", digest.Sum(nil))
+}
+
+// recordTargetBlobSHA records the blob SHA of a target file as seen by this
+// sync, for the PR metadata block
+func (rs *RepositorySync) recordTargetBlobSHA(dest, sha string) {
+	if sha == "" {
+		return
+	}
+	if rs.targetBlobSHAs == nil {
+		rs.targetBlobSHAs = make(map[string]string, len(rs.target.Files))
+	}
+	rs.targetBlobSHAs[dest] = sha
+}
+
+// targetFileUnchanged reports whether the target file at dest still holds the
+// blob recorded in the open sync PR's metadata and that blob is this content.
+// The file is then neither fetched nor changed. The current blob SHA comes from
+// the target branch's tree, read once per sync, so a file edited on the target
+// since the last sync is fetched and compared as usual. So is every file
+// without a recorded SHA, or with --force.
+func (rs *RepositorySync) targetFileUnchanged(ctx context.Context, dest string, content []byte) bool {
+	if rs.engine.options.Force || rs.targetState == nil {
+		return false
+	}
+	recorded, ok := rs.targetState.LastSyncFileSHAs[dest]
+	if !ok || recorded != gitBlobSHA(content) {
+		return false
+	}
+	if current, ok := rs.currentTargetBlobSHAs(ctx)[dest]; !ok || current != recorded {
+		return false
+	}
+
+	rs.recordTargetBlobSHA(dest, recorded)
+	rs.TrackAPICallSaved(1)
+	rs.logger.WithFields(logrus.Fields{
+		"file":     dest,
+		"blob_sha": recorded,
+	}).Debug("Target file blob SHA unchanged since last sync, skipping fetch")
+	return true
+}
+
+// currentTargetBlobSHAs returns the blob SHA of each file on the target branch,
+// read with a single tree call on first use. It returns nil when the tree
+// cannot be read, so every file is fetched instead.
+func (rs *RepositorySync) currentTargetBlobSHAs(ctx context.Context) map[string]string {
+	if rs.targetTreeFetched {
+		return rs.targetTreeSHAs
+	}
+	rs.targetTreeFetched = true
+
+	ref := rs.target.Branch
+	if ref == "" {
+		ref = "HEAD"
+	}
+	rs.TrackAPIRequest()
+	tree, err := rs.engine.gh.GetGitTree(ctx, rs.target.Repo, ref, true)
+	if err != nil {
+		rs.logger.WithError(err).Debug("Failed to read the target tree, fetching files instead")
+		return nil
+	}
+
+	shas := make(map[string]string, len(tree.Tree))
+	for _, node := range tree.Tree {
+		if node.Type == "blob" {
+			shas[node.Path] = node.SHA
+		}
+	}
+	rs.targetTreeSHAs = shas
+	return shas
+}
+
+// writeFileBlobSHAs writes the files section of the metadata block with the
+// blob SHA recorded for each mapped target file
+func (rs *RepositorySync) writeFileBlobSHAs(sb *strings.Builder) {
+	if len(rs.targetBlobSHAs) == 0 {
+		return
+	}
+
+	srcByDest := make(map[string]string, len(rs.target.Files))
+	for _, fileMapping := range rs.target.Files {
+		fileMapping = rs.renderedFileMapping(fileMapping)
+		srcByDest[fileMapping.Dest] = fileMapping.Src
+	}
+
+	dests := make([]string, 0, len(rs.targetBlobSHAs))
+	for dest := range rs.targetBlobSHAs {
+		if _, mapped := srcByDest[dest]; mapped {
+			dests = append(dests, dest)
+		}
+	}
+	if len(dests) == 0 {
+		return
+	}
+	sort.Strings(dests)
+
+	sb.WriteString("files:\n")
+	for _, dest := range dests {
+		fmt.Fprintf(sb, "  - src: %s\n", srcByDest[dest])
+		fmt.Fprintf(sb, "    dest: %s\n", dest)
+		fmt.Fprintf(sb, "    blob_sha: %s\n", rs.targetBlobSHAs[dest])
+	}
+}
@@ -0,0 +1,127 @@
+package sync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	internalerrors "github.com/mrz1836/go-broadcast/internal/errors"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/state"
+)
+
+func TestGitBlobSHA(t *testing.T) {
+	assert.Equal(t, "e69de29bb2d1d6434b8b29ae775ad8c2e48c5391", gitBlobSHA(nil))
+	assert.Equal(t, "ce013625030ba8dba906f756967f9e9ca394464a", gitBlobSHA([]byte("hello\n")))
+}
+
+func TestRepositorySync_processFile_TargetBlobSHA(t *testing.T) {
+	ctx := context.Background()
+	target := config.TargetConfig{
+		Repo:  "org/target",
+		Files: []config.FileMapping{{Src: "a.txt", Dest: "a.txt"}},
+	}
+
+	newRepoSync := func(t *testing.T, ghClient *gh.MockClient, opts *Options, recorded map[string]string) *RepositorySync {
+		rs := newTestRepoSync(ghClient, nil, target, opts)
+		rs.targetState = &state.TargetState{LastSyncFileSHAs: recorded}
+		rs.tempDir = t.TempDir()
+		sourceDir := filepath.Join(rs.tempDir, "source")
+		require.NoError(t, os.MkdirAll(sourceDir, 0o750))
+		require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "a.txt"), []byte("alpha"), 0o600))
+		return rs
+	}
+
+	targetTree := func(sha string) *gh.GitTree {
+		return &gh.GitTree{Tree: []gh.GitTreeNode{{Path: "a.txt", Type: "blob", SHA: sha}}}
+	}
+
+	t.Run("recorded SHA matches skips the fetch", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetGitTree", mock.Anything, "org/target", "HEAD", true).
+			Return(targetTree(gitBlobSHA([]byte("alpha"))), nil).Once()
+		rs := newRepoSync(t, ghClient, nil, map[string]string{"a.txt": gitBlobSHA([]byte("alpha"))})
+
+		_, err := rs.processFile(ctx, filepath.Join(rs.tempDir, "source"), target.Files[0])
+		require.ErrorIs(t, err, internalerrors.ErrTransformNotFound)
+		ghClient.AssertNotCalled(t, "GetFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		ghClient.AssertExpectations(t)
+
+		var sb strings.Builder
+		rs.writeMetadataBlock(&sb, "def456", nil, false)
+		assert.Contains(t, sb.String(), "files:\n  - src: a.txt\n    dest: a.txt\n    blob_sha: "+gitBlobSHA([]byte("alpha"))+"\n")
+	})
+
+	t.Run("target changed since last sync fetches the target", func(t *testing.T) {
+		edited := []byte("alpha, edited on the target")
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetGitTree", mock.Anything, "org/target", "HEAD", true).
+			Return(targetTree(gitBlobSHA(edited)), nil).Once()
+		ghClient.On("GetFile", mock.Anything, "org/target", "a.txt", "").
+			Return(&gh.FileContent{Content: edited, SHA: gitBlobSHA(edited)}, nil).Once()
+		rs := newRepoSync(t, ghClient, nil, map[string]string{"a.txt": gitBlobSHA([]byte("alpha"))})
+
+		change, err := rs.processFile(ctx, filepath.Join(rs.tempDir, "source"), target.Files[0])
+		require.NoError(t, err)
+		require.NotNil(t, change)
+		assert.Equal(t, []byte("alpha"), change.Content)
+		assert.Equal(t, map[string]string{"a.txt": gitBlobSHA(edited)}, rs.targetBlobSHAs)
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("unreadable target tree fetches the target", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetGitTree", mock.Anything, "org/target", "HEAD", true).
+			Return(nil, gh.ErrGitTreeNotFound).Once()
+		ghClient.On("GetFile", mock.Anything, "org/target", "a.txt", "").
+			Return(&gh.FileContent{Content: []byte("alpha"), SHA: gitBlobSHA([]byte("alpha"))}, nil).Once()
+		rs := newRepoSync(t, ghClient, nil, map[string]string{"a.txt": gitBlobSHA([]byte("alpha"))})
+
+		_, err := rs.processFile(ctx, filepath.Join(rs.tempDir, "source"), target.Files[0])
+		require.ErrorIs(t, err, internalerrors.ErrTransformNotFound)
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("no recorded SHA fetches and records the target SHA", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/target", "a.txt", "").
+			Return(&gh.FileContent{Content: []byte("old"), SHA: "oldsha"}, nil).Once()
+		rs := newRepoSync(t, ghClient, nil, nil)
+
+		change, err := rs.processFile(ctx, filepath.Join(rs.tempDir, "source"), target.Files[0])
+		require.NoError(t, err)
+		require.NotNil(t, change)
+		assert.Equal(t, map[string]string{"a.txt": "oldsha"}, rs.targetBlobSHAs)
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("changed source fetches the target", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/target", "a.txt", "").
+			Return(&gh.FileContent{Content: []byte("old"), SHA: gitBlobSHA([]byte("old"))}, nil).Once()
+		rs := newRepoSync(t, ghClient, nil, map[string]string{"a.txt": gitBlobSHA([]byte("old"))})
+
+		change, err := rs.processFile(ctx, filepath.Join(rs.tempDir, "source"), target.Files[0])
+		require.NoError(t, err)
+		require.NotNil(t, change)
+		ghClient.AssertExpectations(t)
+	})
+
+	t.Run("force always fetches", func(t *testing.T) {
+		ghClient := &gh.MockClient{}
+		ghClient.On("GetFile", mock.Anything, "org/target", "a.txt", "").
+			Return(&gh.FileContent{Content: []byte("alpha"), SHA: gitBlobSHA([]byte("alpha"))}, nil).Once()
+		rs := newRepoSync(t, ghClient, DefaultOptions().WithForce(true), map[string]string{"a.txt": gitBlobSHA([]byte("alpha"))})
+
+		_, err := rs.processFile(ctx, filepath.Join(rs.tempDir, "source"), target.Files[0])
+		require.ErrorIs(t, err, internalerrors.ErrTransformNotFound)
+		ghClient.AssertExpectations(t)
+	})
+}
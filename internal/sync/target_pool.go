@@ -0,0 +1,101 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	appErrors "github.com/mrz1836/go-broadcast/internal/errors"
+	"github.com/mrz1836/go-broadcast/internal/state"
+)
+
+// ErrFailFastAborted indicates the sync was stopped after the first target failure
+var ErrFailFastAborted = errors.New("aborted on first failure (fail-fast)")
+
+// maxGroupErrorDetails limits how many target errors are inlined in a GroupSyncError message
+const maxGroupErrorDetails = 3
+
+// targetSyncTask adapts a single target repository sync to the worker.Task interface
+type targetSyncTask struct {
+	engine       *Engine
+	target       config.TargetConfig
+	currentState *state.State
+	progress     *ProgressTracker
+
+	// started is set once the pool hands the task to a worker; tasks dequeued
+	// after cancellation never start and must be recorded by the caller
+	started bool
+}
+
+// Name returns the target repository, which is unique within a group
+func (t *targetSyncTask) Name() string {
+	return t.target.Repo
+}
+
+// Execute syncs the target repository
+func (t *targetSyncTask) Execute(ctx context.Context) error {
+	t.started = true
+	return t.engine.syncRepository(ctx, t.target, t.currentState, t.progress)
+}
+
+// TargetError associates a sync failure with the target repository it came from
+type TargetError struct {
+	Repo string
+	Err  error
+}
+
+// Error implements the error interface
+func (te *TargetError) Error() string {
+	return te.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (te *TargetError) Unwrap() error {
+	return te.Err
+}
+
+// GroupSyncError aggregates the target failures of a single group sync.
+// Errors are kept in target configuration order so output is deterministic
+// regardless of which worker finished first.
+type GroupSyncError struct {
+	Total  int
+	Errors []*TargetError
+}
+
+// Error implements the error interface
+func (ge *GroupSyncError) Error() string {
+	details := make([]string, 0, maxGroupErrorDetails)
+	for i, err := range ge.Errors {
+		if i >= maxGroupErrorDetails {
+			break
+		}
+		details = append(details, err.Error())
+	}
+
+	msg := fmt.Sprintf("%s: completed with %d failures out of %d targets", appErrors.ErrSyncFailed, len(ge.Errors), ge.Total)
+	if len(details) > 0 {
+		msg += " (" + strings.Join(details, "; ") + ")"
+	}
+	return msg
+}
+
+// Unwrap exposes ErrSyncFailed and every target error to errors.Is and errors.As
+func (ge *GroupSyncError) Unwrap() []error {
+	errs := make([]error, 0, len(ge.Errors)+1)
+	errs = append(errs, appErrors.ErrSyncFailed)
+	for _, err := range ge.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// Repos returns the failed target repositories in configuration order
+func (ge *GroupSyncError) Repos() []string {
+	repos := make([]string, 0, len(ge.Errors))
+	for _, err := range ge.Errors {
+		repos = append(repos, err.Repo)
+	}
+	return repos
+}
@@ -0,0 +1,246 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	appErrors "github.com/mrz1836/go-broadcast/internal/errors"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/git"
+	"github.com/mrz1836/go-broadcast/internal/state"
+	"github.com/mrz1836/go-broadcast/internal/transform"
+)
+
+var errTestTargetFailed = errors.New("target failed")
+
+func TestGroupSyncError(t *testing.T) {
+	t.Run("message matches legacy format", func(t *testing.T) {
+		groupErr := &GroupSyncError{
+			Total: 5,
+			Errors: []*TargetError{
+				{Repo: "org/a", Err: errTestTargetFailed},
+				{Repo: "org/b", Err: errTestTargetFailed},
+				{Repo: "org/c", Err: errTestTargetFailed},
+				{Repo: "org/d", Err: errTestTargetFailed},
+			},
+		}
+
+		assert.Equal(t,
+			"sync operation failed: completed with 4 failures out of 5 targets (target failed; target failed; target failed)",
+			groupErr.Error())
+		assert.Equal(t, []string{"org/a", "org/b", "org/c", "org/d"}, groupErr.Repos())
+	})
+
+	t.Run("unwraps to sentinel and target errors", func(t *testing.T) {
+		var err error = &GroupSyncError{
+			Total:  2,
+			Errors: []*TargetError{{Repo: "org/a", Err: errTestTargetFailed}},
+		}
+
+		require.ErrorIs(t, err, appErrors.ErrSyncFailed)
+		require.ErrorIs(t, err, errTestTargetFailed)
+
+		var targetErr *TargetError
+		require.ErrorAs(t, err, &targetErr)
+		assert.Equal(t, "org/a", targetErr.Repo)
+	})
+}
+
+func TestEngine_executeSingleGroup_WorkerPool(t *testing.T) {
+	repos := []string{"org/target-c", "org/target-a", "org/target-b"}
+
+	group := config.Group{
+		Name:   "pool",
+		ID:     "pool",
+		Source: config.SourceConfig{Repo: "org/template", Branch: "master"},
+	}
+	currentState := &state.State{
+		Source: state.SourceState{
+			Repo:         "org/template",
+			Branch:       "master",
+			LatestCommit: "new123",
+			LastChecked:  time.Now(),
+		},
+		Targets: map[string]*state.TargetState{},
+	}
+	for _, repo := range repos {
+		group.Targets = append(group.Targets, config.TargetConfig{
+			Repo:  repo,
+			Files: []config.FileMapping{{Src: "file.txt", Dest: "file.txt"}},
+		})
+		currentState.Targets[repo] = &state.TargetState{
+			Repo:           repo,
+			LastSyncCommit: "old123",
+			Status:         state.StatusBehind,
+		}
+	}
+	cfg := &config.Config{Groups: []config.Group{group}}
+
+	for _, concurrency := range []int{0, 1, 2, 8} {
+		ghClient := &gh.MockClient{}
+		ghClient.On("ListBranches", mock.Anything, mock.Anything).Return([]gh.Branch{}, nil).Maybe()
+		ghClient.On("GetFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, gh.ErrFileNotFound).Maybe()
+
+		gitClient := &git.MockClient{}
+		gitClient.On("Clone", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errGitCloneFailed)
+
+		stateDiscoverer := &state.MockDiscoverer{}
+		stateDiscoverer.On("DiscoverState", mock.Anything, cfg).Return(currentState, nil)
+
+		engine := NewEngine(context.Background(), cfg, ghClient, gitClient, stateDiscoverer, &transform.MockChain{},
+			DefaultOptions().WithMaxConcurrency(concurrency))
+		engine.SetLogger(logrus.New())
+
+		err := engine.executeSingleGroup(context.Background(), group, nil)
+		require.Error(t, err)
+
+		// One failing target never cancels its siblings, and failures keep config order
+		var groupErr *GroupSyncError
+		require.ErrorAs(t, err, &groupErr)
+		assert.Equal(t, repos, groupErr.Repos(), "concurrency %d", concurrency)
+		assert.Equal(t, len(repos), groupErr.Total)
+		require.ErrorIs(t, err, appErrors.ErrSyncFailed)
+		require.ErrorIs(t, err, errGitCloneFailed)
+		gitClient.AssertNumberOfCalls(t, "Clone", len(repos))
+	}
+}
+
+func TestEngine_executeSingleGroup_ConcurrencyBound(t *testing.T) {
+	group := config.Group{
+		Name:   "pool",
+		ID:     "pool",
+		Source: config.SourceConfig{Repo: "org/template", Branch: "master"},
+	}
+	currentState := &state.State{
+		Source: state.SourceState{
+			Repo:         "org/template",
+			Branch:       "master",
+			LatestCommit: "new123",
+			LastChecked:  time.Now(),
+		},
+		Targets: map[string]*state.TargetState{},
+	}
+	for _, repo := range []string{"org/a", "org/b", "org/c", "org/d", "org/e", "org/f"} {
+		group.Targets = append(group.Targets, config.TargetConfig{
+			Repo:  repo,
+			Files: []config.FileMapping{{Src: "file.txt", Dest: "file.txt"}},
+		})
+		currentState.Targets[repo] = &state.TargetState{
+			Repo:           repo,
+			LastSyncCommit: "old123",
+			Status:         state.StatusBehind,
+		}
+	}
+	cfg := &config.Config{Groups: []config.Group{group}}
+
+	for _, concurrency := range []int{1, 2, 3} {
+		var active, peak atomic.Int32
+
+		ghClient := &gh.MockClient{}
+		ghClient.On("ListBranches", mock.Anything, mock.Anything).Return([]gh.Branch{}, nil).Maybe()
+		ghClient.On("GetFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, gh.ErrFileNotFound).Maybe()
+
+		gitClient := &git.MockClient{}
+		gitClient.On("Clone", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Run(func(mock.Arguments) {
+				n := active.Add(1)
+				for {
+					p := peak.Load()
+					if n <= p || peak.CompareAndSwap(p, n) {
+						break
+					}
+				}
+				time.Sleep(20 * time.Millisecond)
+				active.Add(-1)
+			}).
+			Return(errGitCloneFailed)
+
+		stateDiscoverer := &state.MockDiscoverer{}
+		stateDiscoverer.On("DiscoverState", mock.Anything, cfg).Return(currentState, nil)
+
+		engine := NewEngine(context.Background(), cfg, ghClient, gitClient, stateDiscoverer, &transform.MockChain{},
+			DefaultOptions().WithMaxConcurrency(concurrency))
+		engine.SetLogger(logrus.New())
+
+		require.Error(t, engine.executeSingleGroup(context.Background(), group, nil))
+		gitClient.AssertNumberOfCalls(t, "Clone", len(group.Targets))
+		assert.LessOrEqual(t, int(peak.Load()), concurrency, "concurrency %d", concurrency)
+		if concurrency == 1 {
+			assert.Equal(t, int32(1), peak.Load())
+		}
+	}
+}
+
+func TestEngine_executeSingleGroup_FailFast(t *testing.T) {
+	repos := []string{"org/target-a", "org/target-b", "org/target-c"}
+
+	group := config.Group{
+		Name:   "pool",
+		ID:     "pool",
+		Source: config.SourceConfig{Repo: "org/template", Branch: "master"},
+	}
+	currentState := &state.State{
+		Source: state.SourceState{
+			Repo:         "org/template",
+			Branch:       "master",
+			LatestCommit: "new123",
+			LastChecked:  time.Now(),
+		},
+		Targets: map[string]*state.TargetState{},
+	}
+	for _, repo := range repos {
+		group.Targets = append(group.Targets, config.TargetConfig{
+			Repo:  repo,
+			Files: []config.FileMapping{{Src: "file.txt", Dest: "file.txt"}},
+		})
+		currentState.Targets[repo] = &state.TargetState{
+			Repo:           repo,
+			LastSyncCommit: "old123",
+			Status:         state.StatusBehind,
+		}
+	}
+	cfg := &config.Config{Groups: []config.Group{group}}
+
+	for _, concurrency := range []int{1, 3} {
+		ghClient := &gh.MockClient{}
+		ghClient.On("ListBranches", mock.Anything, mock.Anything).Return([]gh.Branch{}, nil).Maybe()
+		ghClient.On("GetFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, gh.ErrFileNotFound).Maybe()
+
+		// The first clone fails; any other target blocks until its context is
+		// canceled, so the test only completes if fail-fast cancels in-flight work
+		gitClient := &git.MockClient{}
+		gitClient.On("Clone", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errGitCloneFailed).Once()
+		gitClient.On("Clone", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				ctx := args.Get(0).(context.Context)
+				select {
+				case <-ctx.Done():
+				case <-time.After(5 * time.Second):
+					t.Error("in-flight target did not observe fail-fast cancellation")
+				}
+			}).
+			Return(context.Canceled).Maybe()
+
+		stateDiscoverer := &state.MockDiscoverer{}
+		stateDiscoverer.On("DiscoverState", mock.Anything, cfg).Return(currentState, nil)
+
+		engine := NewEngine(context.Background(), cfg, ghClient, gitClient, stateDiscoverer, &transform.MockChain{},
+			DefaultOptions().WithMaxConcurrency(concurrency).WithFailFast(true))
+		engine.SetLogger(logrus.New())
+
+		err := engine.executeSingleGroup(context.Background(), group, nil)
+		require.Error(t, err, "concurrency %d", concurrency)
+		require.ErrorIs(t, err, ErrFailFastAborted)
+		require.ErrorIs(t, err, appErrors.ErrSyncFailed)
+		require.ErrorIs(t, err, errGitCloneFailed, "the triggering failure is returned, not the cancellations")
+	}
+}
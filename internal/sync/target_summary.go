@@ -0,0 +1,66 @@
+package sync
+
+import (
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// TargetSummary is one target as a sync would see it once the configuration
+// is fully resolved, with the group defaults and global settings applied
+type TargetSummary struct {
+	Group         string   `json:"group"`
+	Repo          string   `json:"repo"`
+	Files         int      `json:"files"`
+	Directories   int      `json:"directories"`
+	BranchPrefix  string   `json:"branch_prefix"`
+	Labels        []string `json:"labels"`
+	Assignees     []string `json:"assignees"`
+	Reviewers     []string `json:"reviewers"`
+	TeamReviewers []string `json:"team_reviewers"`
+	Draft         bool     `json:"draft"`
+}
+
+// ResolveTargets returns every target of cfg in configuration order, with the
+// branch prefix and PR settings a sync run with opts would use. As in a sync
+// run, disabled groups are left out and groups with several source branches
+// are listed once per branch. Nothing is read from GitHub.
+func ResolveTargets(cfg *config.Config, opts *Options) []TargetSummary {
+	if opts == nil {
+		opts = DefaultOptions()
+	}
+	engine := &Engine{config: cfg, options: opts}
+
+	var summaries []TargetSummary
+	groups := config.ExpandSourceBranches(cfg.Groups)
+	for i := range groups {
+		group := &groups[i]
+		if group.Enabled != nil && !*group.Enabled {
+			continue
+		}
+		engine.SetCurrentGroup(group)
+		for _, target := range group.Targets {
+			rs := &RepositorySync{engine: engine, target: target}
+			summaries = append(summaries, TargetSummary{
+				Group:         group.ID,
+				Repo:          target.Repo,
+				Files:         len(target.Files),
+				Directories:   len(target.Directories),
+				BranchPrefix:  rs.getBranchPrefix(),
+				Labels:        nonNilStrings(rs.resolvePRLabels()),
+				Assignees:     nonNilStrings(rs.resolvePRAssignees()),
+				Reviewers:     nonNilStrings(rs.resolvePRReviewers()),
+				TeamReviewers: nonNilStrings(rs.resolvePRTeamReviewers()),
+				Draft:         rs.getPRDraft(),
+			})
+		}
+	}
+	return summaries
+}
+
+// nonNilStrings returns values, or an empty slice when it is nil, so JSON
+// output lists empty settings as [] rather than null
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,74 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+func TestResolveTargets(t *testing.T) {
+	draft := true
+	cfg := &config.Config{Groups: []config.Group{
+		{
+			ID:       "core",
+			Global:   config.GlobalConfig{PRLabels: []string{"sync"}},
+			Defaults: config.DefaultConfig{BranchPrefix: "chore/core-sync", PRReviewers: []string{"alice"}},
+			Targets: []config.TargetConfig{
+				{Repo: "org/a", Files: []config.FileMapping{{Src: "a", Dest: "a"}, {Src: "b", Dest: "b"}}, PRLabels: []string{"core"}},
+				{Repo: "org/b", Directories: []config.DirectoryMapping{{Src: "d", Dest: "d"}}, PRReviewers: []string{"bob"}, PRDraft: &draft},
+			},
+		},
+		{
+			ID:       "docs",
+			Defaults: config.DefaultConfig{PRTeamReviewers: []string{"writers"}},
+			Targets:  []config.TargetConfig{{Repo: "org/docs"}},
+		},
+	}}
+
+	targets := ResolveTargets(cfg, nil)
+	require.Len(t, targets, 3)
+
+	assert.Equal(t, TargetSummary{
+		Group: "core", Repo: "org/a", Files: 2, BranchPrefix: "chore/core-sync",
+		Labels: []string{"sync", "core"}, Assignees: []string{}, Reviewers: []string{"alice"}, TeamReviewers: []string{},
+	}, targets[0])
+
+	// Target reviewers replace the group default, target draft wins
+	assert.Equal(t, "org/b", targets[1].Repo)
+	assert.Equal(t, 1, targets[1].Directories)
+	assert.Equal(t, []string{"bob"}, targets[1].Reviewers)
+	assert.True(t, targets[1].Draft)
+
+	// Each group resolves against its own defaults
+	assert.Equal(t, "docs", targets[2].Group)
+	assert.Equal(t, []string{"writers"}, targets[2].TeamReviewers)
+	assert.Empty(t, targets[2].Labels)
+}
+
+func TestResolveTargets_GroupsAsSynced(t *testing.T) {
+	disabled := false
+	cfg := &config.Config{Groups: []config.Group{
+		{
+			ID:      "core",
+			Source:  config.SourceConfig{Repo: "org/template", Branches: []string{"main", "release/v1"}},
+			Targets: []config.TargetConfig{{Repo: "org/a"}},
+		},
+		{
+			ID:      "paused",
+			Enabled: &disabled,
+			Targets: []config.TargetConfig{{Repo: "org/b"}},
+		},
+	}}
+
+	targets := ResolveTargets(cfg, nil)
+
+	// The multi-branch group is listed once per source branch, the disabled
+	// group not at all
+	require.Len(t, targets, 2)
+	assert.Equal(t, "core-main", targets[0].Group)
+	assert.Equal(t, "core-release-v1", targets[1].Group)
+	assert.Equal(t, "org/a", targets[1].Repo)
+}
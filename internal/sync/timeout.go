@@ -0,0 +1,62 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrTargetTimeout indicates a target's sync ran longer than Options.TargetTimeout
+	ErrTargetTimeout = errors.New("target sync timed out")
+
+	// ErrOperationTimeout indicates a clone, push or PR operation ran longer
+	// than its limit in Options.OperationTimeouts
+	ErrOperationTimeout = errors.New("operation timed out")
+)
+
+// OperationTimeouts bounds the slow network operations of a target's sync.
+// A zero limit leaves the operation bounded only by the target timeout.
+type OperationTimeouts struct {
+	Clone    time.Duration // Cloning the source repository
+	Push     time.Duration // Pushing the sync branch
+	PRCreate time.Duration // Creating or updating the pull request
+}
+
+// runWithTimeout runs fn with ctx bounded by limit, zero meaning no limit.
+// When fn fails because limit ran out, the returned error wraps cause, so
+// the failure reads as a timeout rather than a bare context error.
+func runWithTimeout(ctx context.Context, limit time.Duration, cause error, fn func(context.Context) error) error {
+	if limit <= 0 {
+		return fn(ctx)
+	}
+
+	limitCtx, cancel := context.WithTimeoutCause(ctx, limit, cause)
+	defer cancel()
+
+	err := fn(limitCtx)
+	if err != nil && !errors.Is(err, cause) && errors.Is(context.Cause(limitCtx), cause) {
+		return fmt.Errorf("%w: %w", cause, err)
+	}
+	return err
+}
+
+// isTimeout reports whether err is a target or operation timeout
+func isTimeout(err error) bool {
+	return errors.Is(err, ErrTargetTimeout) || errors.Is(err, ErrOperationTimeout)
+}
+
+// withOperationTimeout runs fn under limit, naming the operation in the
+// timeout error
+func (rs *RepositorySync) withOperationTimeout(ctx context.Context, operation string, limit time.Duration, fn func(context.Context) error) error {
+	cause := fmt.Errorf("%w: %s exceeded %s", ErrOperationTimeout, operation, limit)
+	return runWithTimeout(ctx, limit, cause, fn)
+}
+
+// executeWithTimeout runs Execute under Options.TargetTimeout
+func (rs *RepositorySync) executeWithTimeout(ctx context.Context) error {
+	limit := rs.engine.options.TargetTimeout
+	cause := fmt.Errorf("%w after %s", ErrTargetTimeout, limit)
+	return runWithTimeout(ctx, limit, cause, rs.Execute)
+}
@@ -0,0 +1,171 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/gh"
+	"github.com/mrz1836/go-broadcast/internal/git"
+	"github.com/mrz1836/go-broadcast/internal/state"
+	"github.com/mrz1836/go-broadcast/internal/transform"
+)
+
+var (
+	errTestOperationFailed = errors.New("operation failed")
+	errTestTimeoutCause    = errors.New("test timed out")
+)
+
+func TestRunWithTimeout(t *testing.T) {
+	cause := errTestTimeoutCause
+
+	t.Run("zero limit leaves the context alone", func(t *testing.T) {
+		err := runWithTimeout(context.Background(), 0, cause, func(ctx context.Context) error {
+			_, hasDeadline := ctx.Deadline()
+			assert.False(t, hasDeadline)
+			return nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("expired limit wraps the cause", func(t *testing.T) {
+		err := runWithTimeout(context.Background(), 10*time.Millisecond, cause, func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		require.ErrorIs(t, err, cause)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("failure within the limit is returned as is", func(t *testing.T) {
+		err := runWithTimeout(context.Background(), time.Minute, cause, func(context.Context) error {
+			return errTestOperationFailed
+		})
+		require.ErrorIs(t, err, errTestOperationFailed)
+		assert.NotErrorIs(t, err, cause)
+	})
+
+	t.Run("parent cancellation is not a timeout", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := runWithTimeout(ctx, time.Minute, cause, func(ctx context.Context) error {
+			return ctx.Err()
+		})
+		require.ErrorIs(t, err, context.Canceled)
+		assert.NotErrorIs(t, err, cause)
+	})
+}
+
+// newTimeoutEngine returns an engine syncing repos whose source clone blocks
+// until its context ends, and the group to run
+func newTimeoutEngine(t *testing.T, opts *Options, repos ...string) (*Engine, config.Group, *git.MockClient) {
+	t.Helper()
+	group := config.Group{
+		Name:   "timeouts",
+		ID:     "timeouts",
+		Source: config.SourceConfig{Repo: "org/template", Branch: "master"},
+	}
+	currentState := &state.State{
+		Source: state.SourceState{
+			Repo:         "org/template",
+			Branch:       "master",
+			LatestCommit: "new123",
+			LastChecked:  time.Now(),
+		},
+		Targets: map[string]*state.TargetState{},
+	}
+	for _, repo := range repos {
+		group.Targets = append(group.Targets, config.TargetConfig{
+			Repo:  repo,
+			Files: []config.FileMapping{{Src: "file.txt", Dest: "file.txt"}},
+		})
+		currentState.Targets[repo] = &state.TargetState{
+			Repo:           repo,
+			LastSyncCommit: "old123",
+			Status:         state.StatusBehind,
+		}
+	}
+	cfg := &config.Config{Groups: []config.Group{group}}
+
+	ghClient := &gh.MockClient{}
+	ghClient.On("ListBranches", mock.Anything, mock.Anything).Return([]gh.Branch{}, nil).Maybe()
+	ghClient.On("GetFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, gh.ErrFileNotFound).Maybe()
+
+	gitClient := &git.MockClient{}
+	gitClient.On("Clone", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			ctx, ok := args.Get(0).(context.Context)
+			require.True(t, ok)
+			<-ctx.Done()
+		}).
+		Return(context.DeadlineExceeded)
+
+	stateDiscoverer := &state.MockDiscoverer{}
+	stateDiscoverer.On("DiscoverState", mock.Anything, cfg).Return(currentState, nil)
+
+	engine := NewEngine(context.Background(), cfg, ghClient, gitClient, stateDiscoverer, &transform.MockChain{}, opts)
+	engine.SetLogger(logrus.New())
+	return engine, group, gitClient
+}
+
+func TestEngine_Timeouts(t *testing.T) {
+	repos := []string{"org/a", "org/b"}
+
+	t.Run("clone timeout fails each target and the run continues", func(t *testing.T) {
+		opts := DefaultOptions().WithMaxConcurrency(1).
+			WithOperationTimeouts(OperationTimeouts{Clone: 20 * time.Millisecond})
+		engine, group, gitClient := newTimeoutEngine(t, opts, repos...)
+
+		err := engine.executeSingleGroup(context.Background(), group, nil)
+		require.ErrorIs(t, err, ErrOperationTimeout)
+		assert.NotErrorIs(t, err, ErrTargetTimeout)
+		assert.Contains(t, err.Error(), "clone exceeded 20ms")
+
+		var groupErr *GroupSyncError
+		require.ErrorAs(t, err, &groupErr)
+		assert.Equal(t, repos, groupErr.Repos())
+		gitClient.AssertNumberOfCalls(t, "Clone", len(repos))
+	})
+
+	t.Run("target timeout fails each target", func(t *testing.T) {
+		opts := DefaultOptions().WithMaxConcurrency(1).
+			WithTargetTimeout(20 * time.Millisecond).
+			WithOperationTimeouts(OperationTimeouts{Clone: time.Minute})
+		engine, group, gitClient := newTimeoutEngine(t, opts, repos...)
+
+		err := engine.executeSingleGroup(context.Background(), group, nil)
+		require.ErrorIs(t, err, ErrTargetTimeout)
+		assert.NotErrorIs(t, err, ErrOperationTimeout)
+
+		var groupErr *GroupSyncError
+		require.ErrorAs(t, err, &groupErr)
+		assert.Equal(t, repos, groupErr.Repos())
+		gitClient.AssertNumberOfCalls(t, "Clone", len(repos))
+	})
+
+	t.Run("fail fast aborts on the first timeout", func(t *testing.T) {
+		opts := DefaultOptions().WithMaxConcurrency(1).WithFailFast(true).
+			WithTargetTimeout(20 * time.Millisecond)
+		engine, group, _ := newTimeoutEngine(t, opts, repos...)
+
+		err := engine.executeSingleGroup(context.Background(), group, nil)
+		require.ErrorIs(t, err, ErrFailFastAborted)
+		require.ErrorIs(t, err, ErrTargetTimeout)
+	})
+}
+
+func TestOptionsWithTimeouts(t *testing.T) {
+	opts := DefaultOptions().
+		WithTargetTimeout(-time.Second).
+		WithOperationTimeouts(OperationTimeouts{Clone: time.Minute, Push: -time.Second, PRCreate: 30 * time.Second})
+
+	assert.Zero(t, opts.TargetTimeout)
+	assert.Equal(t, OperationTimeouts{Clone: time.Minute, PRCreate: 30 * time.Second}, opts.OperationTimeouts)
+}
@@ -0,0 +1,241 @@
+package sync
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/logging"
+	"github.com/mrz1836/go-broadcast/internal/transform"
+)
+
+// NewTransformChain builds the transform chain the engine applies to the given
+// groups. A transformer is added once when any source or target in the groups
+// needs it, in a fixed order: conditional blocks, email, template variables,
+// template render, Go imports, copyright year, repo name, managed header, secret
+// scrub, line endings. Conditional blocks are resolved first so dropped regions
+// are never transformed, the email transformer runs before the repo name
+// transformer so email addresses are not corrupted by repo renames, the managed header is written
+// after them so its source repository name is not renamed, secrets are scrubbed
+// after everything that writes content, and line endings are normalized last.
+//
+// Callers previewing a single target pass a group containing only that target.
+// Targets and directories that configure transform.pipeline use
+// PipelineTransformChain instead.
+func NewTransformChain(groups []config.Group, logger *logrus.Logger, logConfig *logging.LogConfig) transform.Chain {
+	chain := transform.NewChain(logger)
+
+	if anyTarget(groups, usesConditionalBlocks) {
+		chain.Add(transform.NewConditionalBlocksTransformer())
+	}
+	if anyGroup(groups, usesEmailTransform) {
+		chain.Add(transform.NewEmailTransformer())
+	}
+	if anyTarget(groups, func(target config.TargetConfig) bool { return len(target.Transform.Variables) > 0 }) {
+		chain.Add(transform.NewTemplateTransformer(logger, logConfig))
+	}
+	if anyTarget(groups, usesTemplateRender) {
+		chain.Add(transform.NewTemplateRenderTransformer())
+	}
+	if anyTarget(groups, usesGoImportRewrite) {
+		chain.Add(transform.NewGoImportPathTransformer())
+	}
+	if anyTarget(groups, usesCopyrightYear) {
+		chain.Add(transform.NewCopyrightYearTransformer())
+	}
+	if anyTarget(groups, func(target config.TargetConfig) bool { return target.Transform.RepoName }) {
+		chain.Add(transform.NewRepoTransformer())
+	}
+	if anyTarget(groups, usesManagedHeader) {
+		chain.Add(transform.NewManagedHeaderTransformer())
+	}
+	if anyTarget(groups, usesSecretScrub) {
+		chain.Add(transform.NewSecretScrubTransformer())
+	}
+	if anyTarget(groups, usesLineEndings) {
+		chain.Add(transform.NewLineEndingsTransformer())
+	}
+
+	return chain
+}
+
+// PipelineTransformChain builds the chain for content transformed under t,
+// applying the transforms in t.Pipeline (or config.DefaultTransformPipeline
+// when unset) in order. The repo name transformer rewrites unconditionally, so
+// it is only added when t.RepoName is enabled; every other transformer skips
+// content its settings do not apply to.
+func PipelineTransformChain(t config.Transform, logger *logrus.Logger, logConfig *logging.LogConfig) (transform.Chain, error) {
+	pipeline := t.Pipeline
+	if len(pipeline) == 0 {
+		pipeline = config.DefaultTransformPipeline()
+	}
+	steps := make([]string, 0, len(pipeline))
+	for _, step := range pipeline {
+		if step == config.TransformStepRepoName && !t.RepoName {
+			continue
+		}
+		steps = append(steps, step)
+	}
+	return transform.NewPipelineChain(steps, logger, logConfig)
+}
+
+// transformChainFor returns the chain to apply for content transformed under
+// t: the engine's chain, or the chain built from t's pipeline. Pipeline chains
+// are built once per run and shared by every group through the run state.
+func (e *Engine) transformChainFor(t config.Transform) (transform.Chain, error) {
+	if len(t.Pipeline) == 0 {
+		return e.transform, nil
+	}
+	run := e.shared()
+	key := strings.Join(t.Pipeline, ",") + "|" + strconv.FormatBool(t.RepoName)
+	run.pipelineChainsMu.Lock()
+	defer run.pipelineChainsMu.Unlock()
+	if chain, ok := run.pipelineChains[key]; ok {
+		return chain, nil
+	}
+	chain, err := PipelineTransformChain(t, e.logger, nil)
+	if err != nil {
+		return nil, err
+	}
+	if run.pipelineChains == nil {
+		run.pipelineChains = make(map[string]transform.Chain)
+	}
+	run.pipelineChains[key] = chain
+	return chain, nil
+}
+
+// anyGroup reports whether pred holds for any group
+func anyGroup(groups []config.Group, pred func(config.Group) bool) bool {
+	for _, group := range groups {
+		if pred(group) {
+			return true
+		}
+	}
+	return false
+}
+
+// anyTarget reports whether pred holds for any target of any group
+func anyTarget(groups []config.Group, pred func(config.TargetConfig) bool) bool {
+	return anyGroup(groups, func(group config.Group) bool {
+		for _, target := range group.Targets {
+			if pred(target) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// usesEmailTransform reports whether a group's source or any of its targets
+// configures security or support email addresses
+func usesEmailTransform(group config.Group) bool {
+	if group.Source.SecurityEmail != "" || group.Source.SupportEmail != "" {
+		return true
+	}
+	for _, target := range group.Targets {
+		if target.SecurityEmail != "" || target.SupportEmail != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// usesTemplateRender reports whether a target renders template files,
+// either for its file mappings or for any of its directory mappings
+func usesTemplateRender(target config.TargetConfig) bool {
+	if target.Transform.TemplateRender {
+		return true
+	}
+	for _, dir := range target.Directories {
+		if dir.Transform.TemplateRender {
+			return true
+		}
+	}
+	return false
+}
+
+// usesGoImportRewrite reports whether a target rewrites Go import paths,
+// either for its file mappings or for any of its directory mappings
+func usesGoImportRewrite(target config.TargetConfig) bool {
+	if target.Transform.GoModulePath != "" {
+		return true
+	}
+	for _, dir := range target.Directories {
+		if dir.Transform.GoModulePath != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// usesCopyrightYear reports whether a target updates copyright years,
+// either for its file mappings or for any of its directory mappings
+func usesCopyrightYear(target config.TargetConfig) bool {
+	if target.Transform.UpdateCopyrightYear {
+		return true
+	}
+	for _, dir := range target.Directories {
+		if dir.Transform.UpdateCopyrightYear {
+			return true
+		}
+	}
+	return false
+}
+
+// usesManagedHeader reports whether a target writes or strips a managed
+// header, either for its file mappings or for any of its directory mappings
+func usesManagedHeader(target config.TargetConfig) bool {
+	if target.Transform.ManagedHeader != "" || target.Transform.StripManagedHeader {
+		return true
+	}
+	for _, dir := range target.Directories {
+		if dir.Transform.ManagedHeader != "" || dir.Transform.StripManagedHeader {
+			return true
+		}
+	}
+	return false
+}
+
+// usesSecretScrub reports whether a target scrubs secrets, either for its
+// file mappings or for any of its directory mappings
+func usesSecretScrub(target config.TargetConfig) bool {
+	if target.Transform.SecretScrub != nil {
+		return true
+	}
+	for _, dir := range target.Directories {
+		if dir.Transform.SecretScrub != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// usesConditionalBlocks reports whether a target resolves conditional blocks,
+// either for its file mappings or for any of its directory mappings
+func usesConditionalBlocks(target config.TargetConfig) bool {
+	if target.Transform.ConditionalBlocks {
+		return true
+	}
+	for _, dir := range target.Directories {
+		if dir.Transform.ConditionalBlocks {
+			return true
+		}
+	}
+	return false
+}
+
+// usesLineEndings reports whether a target normalizes line endings, either
+// for its file mappings or for any of its directory mappings
+func usesLineEndings(target config.TargetConfig) bool {
+	if target.Transform.NormalizesLineEndings() {
+		return true
+	}
+	for _, dir := range target.Directories {
+		if dir.Transform.NormalizesLineEndings() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/transform"
+)
+
+func transformerNames(chain transform.Chain) []string {
+	names := make([]string, 0, len(chain.Transformers()))
+	for _, transformer := range chain.Transformers() {
+		names = append(names, transformer.Name())
+	}
+	return names
+}
+
+func TestPipelineTransformChain(t *testing.T) {
+	t.Run("listed transforms in order", func(t *testing.T) {
+		chain, err := PipelineTransformChain(config.Transform{
+			Pipeline: []string{config.TransformStepVariables, config.TransformStepGoImports, config.TransformStepManagedHeader},
+		}, logrus.New(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"template-variable-replacer", "go-import-path", "managed-header"}, transformerNames(chain))
+	})
+
+	t.Run("repo name only when enabled", func(t *testing.T) {
+		pipeline := []string{config.TransformStepRepoName, config.TransformStepManagedHeader}
+
+		chain, err := PipelineTransformChain(config.Transform{Pipeline: pipeline}, logrus.New(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"managed-header"}, transformerNames(chain))
+
+		chain, err = PipelineTransformChain(config.Transform{Pipeline: pipeline, RepoName: true}, logrus.New(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"repository-name-replacer", "managed-header"}, transformerNames(chain))
+	})
+
+	t.Run("default pipeline when unset", func(t *testing.T) {
+		chain, err := PipelineTransformChain(config.Transform{RepoName: true}, logrus.New(), nil)
+		require.NoError(t, err)
+		assert.Len(t, chain.Transformers(), len(config.DefaultTransformPipeline()))
+	})
+
+	t.Run("unknown transform", func(t *testing.T) {
+		_, err := PipelineTransformChain(config.Transform{Pipeline: []string{"minify"}}, logrus.New(), nil)
+		require.ErrorIs(t, err, transform.ErrUnknownTransform)
+	})
+}
+
+func TestEngineTransformChainFor(t *testing.T) {
+	engineChain := transform.NewChain(nil)
+	engine := &Engine{transform: engineChain, logger: logrus.New()}
+	view := engine.forGroup(&config.Config{}, &config.Group{ID: "g"})
+
+	chain, err := view.transformChainFor(config.Transform{RepoName: true})
+	require.NoError(t, err)
+	assert.Same(t, engineChain, chain)
+
+	pipeline := config.Transform{Pipeline: []string{config.TransformStepManagedHeader}}
+	first, err := view.transformChainFor(pipeline)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"managed-header"}, transformerNames(first))
+
+	second, err := engine.transformChainFor(pipeline)
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+}
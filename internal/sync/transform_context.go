@@ -0,0 +1,49 @@
+package sync
+
+import (
+	"github.com/mrz1836/go-broadcast/internal/config"
+	"github.com/mrz1836/go-broadcast/internal/transform"
+)
+
+// NewTransformContext builds the context the transform chain sees for one file
+// synced from sourceRepo to target with the given transform settings. topics
+// are the target's repository topics, used by conditional blocks. The group
+// supplies the source security and support emails, which target emails
+// override; with a nil group no emails are set.
+//
+// Sync and the diff preview both build their contexts here, so a setting
+// added to transform.Context reaches every caller at once.
+func NewTransformContext(group *config.Group, sourceRepo string, target config.TargetConfig, settings config.Transform, sourcePath, destPath string, topics []string) transform.Context {
+	transformCtx := transform.Context{
+		SourceRepo:         sourceRepo,
+		TargetRepo:         target.Repo,
+		FilePath:           destPath,
+		SourcePath:         sourcePath,
+		Variables:          settings.Variables,
+		TemplateSuffix:     settings.RenderSuffix(),
+		GoModulePath:       settings.TargetGoModulePath(target.Repo),
+		GoSourceModulePath: settings.GoSourceModulePath,
+		CopyrightYear:      settings.TargetCopyrightYear(),
+		ManagedHeader:      settings.ManagedHeader,
+		StripManagedHeader: settings.StripManagedHeader,
+		LineEndings:        settings.LineEndings,
+		SecretScrub:        settings.SecretScrub,
+		ConditionalBlocks:  settings.ConditionalBlocks,
+		TargetTopics:       topics,
+	}
+
+	if group != nil {
+		transformCtx.SourceSecurityEmail = group.Source.SecurityEmail
+		transformCtx.SourceSupportEmail = group.Source.SupportEmail
+		// Use target-specific emails if set, otherwise use source emails
+		transformCtx.TargetSecurityEmail = group.Source.SecurityEmail
+		if target.SecurityEmail != "" {
+			transformCtx.TargetSecurityEmail = target.SecurityEmail
+		}
+		transformCtx.TargetSupportEmail = group.Source.SupportEmail
+		if target.SupportEmail != "" {
+			transformCtx.TargetSupportEmail = target.SupportEmail
+		}
+	}
+	return transformCtx
+}
@@ -0,0 +1,34 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+func TestNewTransformContext(t *testing.T) {
+	group := &config.Group{Source: config.SourceConfig{SecurityEmail: "security@org.dev", SupportEmail: "support@org.dev"}}
+	target := config.TargetConfig{Repo: "org/service", SupportEmail: "help@service.dev"}
+	settings := config.Transform{Variables: map[string]string{"NAME": "service"}, ConditionalBlocks: true}
+
+	ctx := NewTransformContext(group, "org/template", target, settings, "docs/README.md", "README.md", []string{"go"})
+	assert.Equal(t, "org/template", ctx.SourceRepo)
+	assert.Equal(t, "org/service", ctx.TargetRepo)
+	assert.Equal(t, "docs/README.md", ctx.SourcePath)
+	assert.Equal(t, "README.md", ctx.FilePath)
+	assert.Equal(t, map[string]string{"NAME": "service"}, ctx.Variables)
+	assert.True(t, ctx.ConditionalBlocks)
+	assert.Equal(t, []string{"go"}, ctx.TargetTopics)
+
+	// Target emails override the source's
+	assert.Equal(t, "security@org.dev", ctx.TargetSecurityEmail)
+	assert.Equal(t, "help@service.dev", ctx.TargetSupportEmail)
+	assert.Equal(t, "support@org.dev", ctx.SourceSupportEmail)
+
+	// Without a group no emails are set
+	ctx = NewTransformContext(nil, "org/template", target, settings, "a", "a", nil)
+	assert.Empty(t, ctx.SourceSecurityEmail)
+	assert.Empty(t, ctx.TargetSupportEmail)
+}
@@ -3,6 +3,7 @@ package transform
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/sirupsen/logrus"
@@ -281,3 +282,97 @@ func BenchmarkChainTransform_Binary(b *testing.B) {
 		_ = result
 	})
 }
+
+// multiMBVariables are the variables used by the multi-megabyte benchmarks
+//
+//nolint:gochecknoglobals // Test data
+var multiMBVariables = map[string]string{
+	"SERVICE_NAME":  "my-service",
+	"ENVIRONMENT":   "production",
+	"SERVICE_PORT":  "8080",
+	"DB_HOST":       "localhost",
+	"DB_PORT":       "5432",
+	"CACHE_HOST":    "redis",
+	"CACHE_PORT":    "6379",
+	"API_KEY":       "secret-key",
+	"PLATFORM_NAME": "MyPlatform",
+}
+
+// multiMBContent repeats chunk until the content is at least size bytes
+func multiMBContent(chunk []byte, size int) []byte {
+	return bytes.Repeat(chunk, size/len(chunk)+1)
+}
+
+func BenchmarkTemplateTransform_MultiMB(b *testing.B) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	transformer := NewTemplateTransformer(logger, nil)
+	ctx := Context{FilePath: "large-config.yaml", Variables: multiMBVariables}
+
+	for _, size := range []int{1 << 20, 4 << 20} {
+		content := multiMBContent(templateContent, size)
+		b.Run(fmt.Sprintf("%dMB", size>>20), func(b *testing.B) {
+			b.SetBytes(int64(len(content)))
+			benchmark.WithMemoryTracking(b, func() {
+				result, err := transformer.Transform(content, ctx)
+				if err != nil {
+					b.Fatal(err)
+				}
+				_ = result
+			})
+		})
+	}
+
+	b.Run("4MB_NoVariablesPresent", func(b *testing.B) {
+		content := multiMBContent(largeGoFile, 4<<20)
+		b.SetBytes(int64(len(content)))
+		benchmark.WithMemoryTracking(b, func() {
+			result, err := transformer.Transform(content, ctx)
+			if err != nil {
+				b.Fatal(err)
+			}
+			_ = result
+		})
+	})
+}
+
+func BenchmarkChainTransform_MultiMB(b *testing.B) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	chain := NewChain(logger).
+		Add(NewBinaryTransformer()).
+		Add(NewRepoTransformer()).
+		Add(NewTemplateTransformer(logger, nil))
+
+	testCases := []struct {
+		name     string
+		filePath string
+		chunk    []byte
+	}{
+		{"Markdown", "docs/README.md", append(append([]byte{}, smallMarkdown...), templateContent...)},
+		{"GoFile", "service/main.go", largeGoFile},
+		{"TemplateOnly", "config.yaml", templateContent},
+	}
+
+	for _, tc := range testCases {
+		content := multiMBContent(tc.chunk, 4<<20)
+		transformCtx := Context{
+			FilePath:   tc.filePath,
+			SourceRepo: "org/template-repo",
+			TargetRepo: "myorg/my-service",
+			Variables:  multiMBVariables,
+		}
+		b.Run(tc.name, func(b *testing.B) {
+			b.SetBytes(int64(len(content)))
+			benchmark.WithMemoryTracking(b, func() {
+				result, err := chain.Transform(context.Background(), content, transformCtx)
+				if err != nil {
+					b.Fatal(err)
+				}
+				_ = result
+			})
+		})
+	}
+}
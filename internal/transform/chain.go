@@ -50,12 +50,16 @@ func (c *chain) Transform(ctx context.Context, content []byte, transformCtx Cont
 	copy(transformers, c.transformers)
 	c.mu.RUnlock()
 
-	c.logger.WithFields(logrus.Fields{
-		"source_repo":  transformCtx.SourceRepo,
-		"target_repo":  transformCtx.TargetRepo,
-		"file_path":    transformCtx.FilePath,
-		"transformers": len(transformers),
-	}).Debug("Starting transform chain")
+	// Skip building debug fields and comparing whole files when nobody sees them
+	debug := c.logger.IsLevelEnabled(logrus.DebugLevel)
+	if debug {
+		c.logger.WithFields(logrus.Fields{
+			"source_repo":  transformCtx.SourceRepo,
+			"target_repo":  transformCtx.TargetRepo,
+			"file_path":    transformCtx.FilePath,
+			"transformers": len(transformers),
+		}).Debug("Starting transform chain")
+	}
 
 	for _, transformer := range transformers {
 		select {
@@ -64,10 +68,12 @@ func (c *chain) Transform(ctx context.Context, content []byte, transformCtx Cont
 		default:
 		}
 
-		c.logger.WithFields(logrus.Fields{
-			"transformer": transformer.Name(),
-			"file_path":   transformCtx.FilePath,
-		}).Debug("Applying transformer")
+		if debug {
+			c.logger.WithFields(logrus.Fields{
+				"transformer": transformer.Name(),
+				"file_path":   transformCtx.FilePath,
+			}).Debug("Applying transformer")
+		}
 
 		transformed, err := transformer.Transform(result, transformCtx)
 		if err != nil {
@@ -75,7 +81,7 @@ func (c *chain) Transform(ctx context.Context, content []byte, transformCtx Cont
 		}
 
 		// Use bytes.Equal for efficient comparison without string allocation
-		if !bytes.Equal(transformed, result) {
+		if debug && !bytes.Equal(transformed, result) {
 			c.logger.WithFields(logrus.Fields{
 				"transformer": transformer.Name(),
 				"file_path":   transformCtx.FilePath,
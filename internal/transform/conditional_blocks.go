@@ -0,0 +1,178 @@
+package transform
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/mrz1836/go-broadcast/internal/algorithms"
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// ErrInvalidConditionalBlock indicates a malformed conditional block directive,
+// or an include or exclude directive without its end, or an end without one
+var ErrInvalidConditionalBlock = errors.New("invalid conditional block")
+
+// Conditional block directive keywords
+const (
+	conditionalExclude = "exclude"
+	conditionalEnd     = "end"
+)
+
+// Conditional block directive keys
+const (
+	conditionalKeyRepo  = "repo"
+	conditionalKeyTopic = "topic"
+)
+
+// conditionalDirectiveRegex matches a conditional block directive, written in
+// any comment syntax (e.g., "# go-broadcast:include repo=org/a"), capturing its
+// keyword and arguments
+var conditionalDirectiveRegex = regexp.MustCompile(`go-broadcast:(include|exclude|end)\b(.*)$`)
+
+// conditionalCommentClosers are the comment terminators stripped from the end
+// of a directive's arguments
+//
+//nolint:gochecknoglobals // This is a read-only lookup table
+var conditionalCommentClosers = []string{"-->", "*/", "#}", "%>"}
+
+// conditionalBlocksTransformer keeps or drops regions of text files by target
+type conditionalBlocksTransformer struct{}
+
+//nolint:gochecknoinits // Transforms register themselves with the pipeline registry
+func init() {
+	Register(Info{
+		Name:        config.TransformStepConditionalBlocks,
+		ConfigKey:   "conditional_blocks",
+		Description: "Keeps go-broadcast:include/exclude regions only for matching targets",
+		TextOnly:    true,
+		New:         withoutLogging(NewConditionalBlocksTransformer),
+	})
+}
+
+// NewConditionalBlocksTransformer creates a transformer that keeps regions
+// between a go-broadcast:include directive and its go-broadcast:end only for
+// targets the directive matches, and drops regions between a
+// go-broadcast:exclude directive and its end for those targets. A directive
+// matches when the target is one of its repo= values or has one of its
+// topic= values, both compared case-insensitively; values are comma separated.
+// Directive lines are always removed, and blocks nest: a region is kept only
+// when every enclosing block keeps it. Binary content, and any content when
+// ConditionalBlocks is off, is returned unchanged.
+func NewConditionalBlocksTransformer() Transformer {
+	return &conditionalBlocksTransformer{}
+}
+
+// Name returns the name of this transformer
+func (c *conditionalBlocksTransformer) Name() string {
+	return "conditional-blocks"
+}
+
+// conditionalBlock is an open include or exclude block
+type conditionalBlock struct {
+	line int  // Line of the directive that opened the block
+	keep bool // Whether the block's region is kept for this target
+}
+
+// Transform resolves the conditional blocks in content for the context's target
+func (c *conditionalBlocksTransformer) Transform(content []byte, ctx Context) ([]byte, error) {
+	if !ctx.ConditionalBlocks || !bytes.Contains(content, []byte("go-broadcast:")) || algorithms.IsBinaryOptimized(content) {
+		return content, nil
+	}
+
+	var (
+		result bytes.Buffer
+		open   []conditionalBlock
+	)
+	result.Grow(len(content))
+	for i, line := range bytes.SplitAfter(content, []byte("\n")) {
+		lineNumber := i + 1
+		match := conditionalDirectiveRegex.FindSubmatch(bytes.TrimRight(line, "\r\n"))
+		if match == nil {
+			if keepsRegion(open) {
+				result.Write(line)
+			}
+			continue
+		}
+
+		keyword, args := string(match[1]), trimCommentCloser(string(match[2]))
+		if keyword == conditionalEnd {
+			if args != "" {
+				return nil, fmt.Errorf("%w in %s at line %d: end takes no arguments", ErrInvalidConditionalBlock, ctx.FilePath, lineNumber)
+			}
+			if len(open) == 0 {
+				return nil, fmt.Errorf("%w in %s at line %d: end without an include or exclude", ErrInvalidConditionalBlock, ctx.FilePath, lineNumber)
+			}
+			open = open[:len(open)-1]
+			continue
+		}
+
+		matched, problem := matchesConditionalDirective(args, ctx)
+		if problem != "" {
+			return nil, fmt.Errorf("%w in %s at line %d: %s", ErrInvalidConditionalBlock, ctx.FilePath, lineNumber, problem)
+		}
+		open = append(open, conditionalBlock{line: lineNumber, keep: matched != (keyword == conditionalExclude)})
+	}
+
+	if len(open) > 0 {
+		return nil, fmt.Errorf("%w in %s at line %d: block has no end", ErrInvalidConditionalBlock, ctx.FilePath, open[len(open)-1].line)
+	}
+	return result.Bytes(), nil
+}
+
+// keepsRegion reports whether every open block keeps its region
+func keepsRegion(open []conditionalBlock) bool {
+	for _, block := range open {
+		if !block.keep {
+			return false
+		}
+	}
+	return true
+}
+
+// trimCommentCloser removes a trailing comment terminator and surrounding
+// whitespace from directive arguments
+func trimCommentCloser(args string) string {
+	args = strings.TrimSpace(args)
+	for _, closer := range conditionalCommentClosers {
+		if trimmed, ok := strings.CutSuffix(args, closer); ok {
+			return strings.TrimSpace(trimmed)
+		}
+	}
+	return args
+}
+
+// matchesConditionalDirective reports whether the target of ctx matches any of
+// the key=value terms in args, or describes why args are malformed
+func matchesConditionalDirective(args string, ctx Context) (matched bool, problem string) {
+	terms := strings.Fields(args)
+	if len(terms) == 0 {
+		return false, fmt.Sprintf("directive needs a %s= or %s= value", conditionalKeyRepo, conditionalKeyTopic)
+	}
+
+	for _, term := range terms {
+		key, values, ok := strings.Cut(term, "=")
+		if !ok || values == "" {
+			return false, fmt.Sprintf("directive term %q is not key=value", term)
+		}
+
+		var candidates []string
+		switch key {
+		case conditionalKeyRepo:
+			candidates = []string{ctx.TargetRepo}
+		case conditionalKeyTopic:
+			candidates = ctx.TargetTopics
+		default:
+			return false, fmt.Sprintf("unknown directive key %q", key)
+		}
+		for _, value := range strings.Split(values, ",") {
+			if slices.ContainsFunc(candidates, func(candidate string) bool { return strings.EqualFold(candidate, value) }) {
+				matched = true
+			}
+		}
+	}
+	return matched, ""
+}
@@ -0,0 +1,151 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionalBlocksTransformer_Name(t *testing.T) {
+	assert.Equal(t, "conditional-blocks", NewConditionalBlocksTransformer().Name())
+}
+
+func TestConditionalBlocksTransformer_Transform(t *testing.T) {
+	transformer := NewConditionalBlocksTransformer()
+	ctx := Context{
+		TargetRepo:        "org/service-a",
+		TargetTopics:      []string{"go", "Legacy"},
+		FilePath:          "README.md",
+		ConditionalBlocks: true,
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "include for the target is kept",
+			content: "a\n# go-broadcast:include repo=org/other,ORG/Service-A\nb\n# go-broadcast:end\nc\n",
+			want:    "a\nb\nc\n",
+		},
+		{
+			name:    "include for another target is dropped",
+			content: "a\n// go-broadcast:include repo=org/other\nb\n// go-broadcast:end\nc\n",
+			want:    "a\nc\n",
+		},
+		{
+			name:    "exclude by topic drops the region",
+			content: "a\n<!-- go-broadcast:exclude topic=legacy -->\nb\n<!-- go-broadcast:end -->\nc\n",
+			want:    "a\nc\n",
+		},
+		{
+			name:    "exclude for another topic keeps the region",
+			content: "a\n/* go-broadcast:exclude topic=rust */\nb\n/* go-broadcast:end */\nc\n",
+			want:    "a\nb\nc\n",
+		},
+		{
+			name:    "any term matches",
+			content: "# go-broadcast:include repo=org/other topic=go\nb\n# go-broadcast:end\n",
+			want:    "b\n",
+		},
+		{
+			name: "nested blocks keep regions every block keeps",
+			content: "# go-broadcast:include topic=go\n" +
+				"go\n" +
+				"# go-broadcast:exclude repo=org/service-a\n" +
+				"not for service-a\n" +
+				"# go-broadcast:include topic=go\n" +
+				"inside a dropped block\n" +
+				"# go-broadcast:end\n" +
+				"# go-broadcast:end\n" +
+				"go again\n" +
+				"# go-broadcast:end\n" +
+				"tail\n",
+			want: "go\ngo again\ntail\n",
+		},
+		{
+			name:    "crlf line endings are preserved",
+			content: "a\r\n# go-broadcast:exclude repo=org/service-a\r\nb\r\n# go-broadcast:end\r\nc",
+			want:    "a\r\nc",
+		},
+		{
+			name:    "content without directives is unchanged",
+			content: "a\nb\n",
+			want:    "a\nb\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := transformer.Transform([]byte(tt.content), ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestConditionalBlocksTransformer_InvalidDirectives(t *testing.T) {
+	transformer := NewConditionalBlocksTransformer()
+	ctx := Context{TargetRepo: "org/service-a", FilePath: "Makefile", ConditionalBlocks: true}
+
+	tests := []struct {
+		name    string
+		content string
+		message string
+	}{
+		{
+			name:    "end without a block",
+			content: "a\n# go-broadcast:end\n",
+			message: "Makefile at line 2: end without an include or exclude",
+		},
+		{
+			name:    "block without an end",
+			content: "# go-broadcast:include repo=org/service-a\n# go-broadcast:exclude topic=go\nb\n# go-broadcast:end\n",
+			message: "Makefile at line 1: block has no end",
+		},
+		{
+			name:    "directive without terms",
+			content: "# go-broadcast:include\n# go-broadcast:end\n",
+			message: "line 1: directive needs a repo= or topic= value",
+		},
+		{
+			name:    "unknown key",
+			content: "# go-broadcast:include branch=main\n# go-broadcast:end\n",
+			message: `unknown directive key "branch"`,
+		},
+		{
+			name:    "term without a value",
+			content: "# go-broadcast:exclude repo\n# go-broadcast:end\n",
+			message: `directive term "repo" is not key=value`,
+		},
+		{
+			name:    "end with arguments",
+			content: "# go-broadcast:include repo=org/a\n# go-broadcast:end repo=org/a\n",
+			message: "line 2: end takes no arguments",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := transformer.Transform([]byte(tt.content), ctx)
+			require.ErrorIs(t, err, ErrInvalidConditionalBlock)
+			assert.Contains(t, err.Error(), tt.message)
+		})
+	}
+}
+
+func TestConditionalBlocksTransformer_Disabled(t *testing.T) {
+	transformer := NewConditionalBlocksTransformer()
+	content := []byte("# go-broadcast:include repo=org/other\nb\n")
+
+	got, err := transformer.Transform(content, Context{TargetRepo: "org/service-a"})
+	require.NoError(t, err)
+	assert.Equal(t, content, got)
+
+	binary := []byte("\x00\x01go-broadcast:end\x00")
+	got, err = transformer.Transform(binary, Context{ConditionalBlocks: true})
+	require.NoError(t, err)
+	assert.Equal(t, binary, got)
+}
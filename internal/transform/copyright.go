@@ -0,0 +1,76 @@
+package transform
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// copyrightYearPattern matches a copyright notice followed by a year or a
+// year range, e.g. "Copyright (c) 2021", "Copyright © 2019-2023" or
+// "copyright 2018 – 2020". Groups: 1 = notice prefix, 2 = start year,
+// 3 = separator including surrounding spaces, 4 = end year, 5 = a following
+// comma-separated year ("2019, 2021"), which marks a year list.
+var copyrightYearPattern = regexp.MustCompile(`(?i)(\bcopyright\s+(?:(?:\(c\)|©)\s*)?)(\d{4})(?:(\s*[-–]\s*)(\d{4}))?\b(\s*,\s*\d{4}\b)?`)
+
+// copyrightYearTransformer moves the end year of copyright notices forward
+type copyrightYearTransformer struct{}
+
+//nolint:gochecknoinits // Transforms register themselves with the pipeline registry
+func init() {
+	Register(Info{
+		Name:        config.TransformStepCopyrightYear,
+		ConfigKey:   "update_copyright_year",
+		Description: "Moves the end year of copyright notices forward",
+		TextOnly:    false,
+		New:         withoutLogging(NewCopyrightYearTransformer),
+	})
+}
+
+// NewCopyrightYearTransformer creates a transformer that sets the end year of
+// copyright notices to the context's CopyrightYear. A single year becomes a
+// range ("2021" -> "2021-2026") and the end of a range is replaced; the start
+// year is never changed. Notices already at or past the year and year lists
+// are left alone, and four-digit numbers outside a copyright notice are not
+// touched.
+func NewCopyrightYearTransformer() Transformer {
+	return &copyrightYearTransformer{}
+}
+
+// Name returns the name of this transformer
+func (c *copyrightYearTransformer) Name() string {
+	return "copyright-year"
+}
+
+// Transform updates the end year of every copyright notice in content
+func (c *copyrightYearTransformer) Transform(content []byte, ctx Context) ([]byte, error) {
+	if ctx.CopyrightYear <= 0 {
+		return content, nil
+	}
+	year := strconv.Itoa(ctx.CopyrightYear)
+
+	return copyrightYearPattern.ReplaceAllFunc(content, func(match []byte) []byte {
+		groups := copyrightYearPattern.FindSubmatch(match)
+		prefix, start, separator, end := groups[1], groups[2], groups[3], groups[4]
+		if groups[5] != nil {
+			return match
+		}
+
+		startYear, _ := strconv.Atoi(string(start))
+		if startYear >= ctx.CopyrightYear {
+			return match
+		}
+		if end == nil {
+			separator = []byte("-")
+		} else if endYear, _ := strconv.Atoi(string(end)); endYear >= ctx.CopyrightYear {
+			return match
+		}
+
+		result := make([]byte, 0, len(match)+len(year)+1)
+		result = append(result, prefix...)
+		result = append(result, start...)
+		result = append(result, separator...)
+		return append(result, year...)
+	}), nil
+}
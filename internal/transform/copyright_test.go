@@ -0,0 +1,94 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyrightYearTransformer_Name(t *testing.T) {
+	assert.Equal(t, "copyright-year", NewCopyrightYearTransformer().Name())
+}
+
+func TestCopyrightYearTransformer_Transform(t *testing.T) {
+	transformer := NewCopyrightYearTransformer()
+	ctx := Context{FilePath: "LICENSE", CopyrightYear: 2026}
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "single year becomes a range",
+			content: "Copyright (c) 2021 Acme Corp",
+			want:    "Copyright (c) 2021-2026 Acme Corp",
+		},
+		{
+			name:    "range end year is replaced",
+			content: "Copyright 2019-2023 Acme Corp",
+			want:    "Copyright 2019-2026 Acme Corp",
+		},
+		{
+			name:    "range separator and spacing are kept",
+			content: "Copyright © 2018 – 2020 Acme Corp",
+			want:    "Copyright © 2018 – 2026 Acme Corp",
+		},
+		{
+			name:    "multiple holders",
+			content: "Copyright (c) 2015 Jane Doe\nCopyright (C) 2020-2022 Acme Corp\n",
+			want:    "Copyright (c) 2015-2026 Jane Doe\nCopyright (C) 2020-2026 Acme Corp\n",
+		},
+		{
+			name:    "source code header",
+			content: "// Copyright 2024 The Acme Authors. All rights reserved.\npackage acme\n",
+			want:    "// Copyright 2024-2026 The Acme Authors. All rights reserved.\npackage acme\n",
+		},
+		{
+			name:    "already current",
+			content: "Copyright (c) 2026 Acme Corp\nCopyright 2020-2026 Acme Corp",
+			want:    "Copyright (c) 2026 Acme Corp\nCopyright 2020-2026 Acme Corp",
+		},
+		{
+			name:    "year list is left alone",
+			content: "Copyright 2019, 2021 Acme Corp",
+			want:    "Copyright 2019, 2021 Acme Corp",
+		},
+		{
+			name:    "unrelated four-digit numbers",
+			content: "Listening on port 8080 since 2019.\nSee RFC 2119 and issue #1234.\nCopyright notice updated in 2020.\n",
+			want:    "Listening on port 8080 since 2019.\nSee RFC 2119 and issue #1234.\nCopyright notice updated in 2020.\n",
+		},
+		{
+			name:    "longer numbers after copyright",
+			content: "Copyright 20210 Acme Corp",
+			want:    "Copyright 20210 Acme Corp",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := transformer.Transform([]byte(tt.content), ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestCopyrightYearTransformer_ConfiguredYear(t *testing.T) {
+	transformer := NewCopyrightYearTransformer()
+	content := []byte("Copyright (c) 2019-2025 Acme Corp")
+
+	got, err := transformer.Transform(content, Context{CopyrightYear: 2024})
+	require.NoError(t, err)
+	assert.Equal(t, string(content), string(got), "an end year past the configured year is kept")
+
+	got, err = transformer.Transform([]byte("Copyright (c) 2019 Acme Corp"), Context{CopyrightYear: 2024})
+	require.NoError(t, err)
+	assert.Equal(t, "Copyright (c) 2019-2024 Acme Corp", string(got))
+
+	got, err = transformer.Transform(content, Context{})
+	require.NoError(t, err)
+	assert.Equal(t, string(content), string(got), "zero year disables the transform")
+}
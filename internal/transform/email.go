@@ -4,6 +4,8 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
 )
 
 // emailTransformer replaces email addresses in specific contexts
@@ -11,6 +13,17 @@ type emailTransformer struct {
 	cache *RegexCache
 }
 
+//nolint:gochecknoinits // Transforms register themselves with the pipeline registry
+func init() {
+	Register(Info{
+		Name:        config.TransformStepEmail,
+		ConfigKey:   "security_email, support_email",
+		Description: "Replaces the source security and support emails with the target's",
+		TextOnly:    false,
+		New:         withoutLogging(NewEmailTransformer),
+	})
+}
+
 // NewEmailTransformer creates a new email address transformer
 func NewEmailTransformer() Transformer {
 	return &emailTransformer{
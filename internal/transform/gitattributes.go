@@ -0,0 +1,157 @@
+package transform
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// GitAttributesFile is the name of the file holding a repository's attributes
+const GitAttributesFile = ".gitattributes"
+
+// GitAttributes holds the binary and text declarations of a .gitattributes
+// file. Only the declarations that decide whether a path is binary are kept:
+// "binary" and "-text" mark a path binary, "text" and "eol=..." mark it text,
+// and "!text" or "text=auto" leave the decision to content detection.
+type GitAttributes struct {
+	rules []gitAttributesRule
+}
+
+// gitAttributesRule is one .gitattributes line that sets the text attribute
+type gitAttributesRule struct {
+	pattern *regexp.Regexp
+	state   textAttribute
+}
+
+// textAttribute is the state a rule gives the text attribute
+type textAttribute int
+
+const (
+	textUnspecified textAttribute = iota // content detection decides
+	textSet                              // declared text
+	textUnset                            // declared binary
+)
+
+// ParseGitAttributes parses the content of a .gitattributes file. Lines that
+// do not touch the text attribute, negative patterns and directory patterns
+// (which git never applies to files) are ignored.
+func ParseGitAttributes(content []byte) *GitAttributes {
+	attrs := &GitAttributes{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		pattern := fields[0]
+		if strings.HasPrefix(pattern, "!") || strings.HasSuffix(pattern, "/") {
+			continue
+		}
+
+		state, ok := parseTextAttribute(fields[1:])
+		if !ok {
+			continue
+		}
+
+		regex, err := compileGitAttributesPattern(pattern)
+		if err != nil {
+			continue
+		}
+		attrs.rules = append(attrs.rules, gitAttributesRule{pattern: regex, state: state})
+	}
+
+	return attrs
+}
+
+// LoadGitAttributes reads the .gitattributes file at the root of dir. A
+// missing file returns nil attributes and no error.
+func LoadGitAttributes(dir string) (*GitAttributes, error) {
+	content, err := os.ReadFile(filepath.Join(dir, GitAttributesFile)) //nolint:gosec // dir is a checkout created by the sync
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ParseGitAttributes(content), nil
+}
+
+// Binary reports whether filePath (relative to the repository root) is
+// declared binary and whether any rule declared it either way. As in git, the
+// last matching rule wins. A nil GitAttributes declares nothing.
+func (a *GitAttributes) Binary(filePath string) (binary, declared bool) {
+	if a == nil {
+		return false, false
+	}
+
+	filePath = strings.TrimPrefix(filepath.ToSlash(filePath), "/")
+	state := textUnspecified
+	for _, rule := range a.rules {
+		if rule.pattern.MatchString(filePath) {
+			state = rule.state
+		}
+	}
+
+	switch state {
+	case textUnset:
+		return true, true
+	case textSet:
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// IsBinaryWithAttributes checks if a file is binary, honoring a binary or text
+// declaration in attrs before falling back to IsBinary
+func IsBinaryWithAttributes(attrs *GitAttributes, filePath string, content []byte) bool {
+	if binary, declared := attrs.Binary(filePath); declared {
+		return binary
+	}
+	return IsBinary(filePath, content)
+}
+
+// parseTextAttribute returns the text attribute state set by a line's
+// attributes, and false when none of them touches it
+func parseTextAttribute(attributes []string) (textAttribute, bool) {
+	state, found := textUnspecified, false
+	for _, attribute := range attributes {
+		switch {
+		case attribute == "binary", attribute == "-text":
+			state, found = textUnset, true
+		case attribute == "text=auto", attribute == "!text":
+			state, found = textUnspecified, true
+		case attribute == "text", strings.HasPrefix(attribute, "text="), strings.HasPrefix(attribute, "eol="):
+			state, found = textSet, true
+		}
+	}
+	return state, found
+}
+
+// compileGitAttributesPattern converts a .gitattributes pattern to a regex. A
+// pattern without a slash matches the file name at any depth; any other
+// pattern is anchored at the repository root.
+func compileGitAttributesPattern(pattern string) (*regexp.Regexp, error) {
+	anchored := strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+
+	expr := regexp.QuoteMeta(pattern)
+	expr = strings.ReplaceAll(expr, `/\*\*/`, `/(.*/)?`)
+	if strings.HasPrefix(expr, `\*\*/`) {
+		expr = `(.*/)?` + strings.TrimPrefix(expr, `\*\*/`)
+	}
+	expr = strings.ReplaceAll(expr, `\*\*`, `.*`)
+	expr = strings.ReplaceAll(expr, `\*`, `[^/]*`)
+	expr = strings.ReplaceAll(expr, `\?`, `[^/]`)
+
+	if anchored {
+		return regexp.Compile("^" + expr + "$")
+	}
+	return regexp.Compile("(^|/)" + expr + "$")
+}
@@ -0,0 +1,89 @@
+package transform
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitAttributes_Binary(t *testing.T) {
+	attrs := ParseGitAttributes([]byte(`# Binary declarations
+*.dat binary
+/assets/** -text
+docs/*.txt -text
+legacy/*.ini text eol=crlf
+*.gen text=auto
+assets/keep.dat text
+fixtures/ !text
+!*.md binary
+*.csv diff=csv
+`))
+
+	tests := []struct {
+		path     string
+		binary   bool
+		declared bool
+	}{
+		{"model.dat", true, true},
+		{"nested/dir/model.dat", true, true},
+		{"assets/logo.txt", true, true},
+		{"assets/deep/icon.cfg", true, true},
+		{"docs/readme.txt", true, true},
+		{"sub/docs/readme.txt", false, false}, // slash patterns are anchored at the root
+		{"legacy/app.ini", false, true},
+		{"assets/keep.dat", false, true}, // the last matching rule wins
+		{"output.gen", false, false},     // text=auto leaves it to content detection
+		{"README.md", false, false},      // negative patterns are ignored
+		{"data.csv", false, false},       // lines without text attributes are ignored
+		{"main.go", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			binary, declared := attrs.Binary(tt.path)
+			assert.Equal(t, tt.binary, binary)
+			assert.Equal(t, tt.declared, declared)
+		})
+	}
+}
+
+func TestGitAttributes_NilDeclaresNothing(t *testing.T) {
+	var attrs *GitAttributes
+	binary, declared := attrs.Binary("file.dat")
+	assert.False(t, binary)
+	assert.False(t, declared)
+}
+
+func TestIsBinaryWithAttributes(t *testing.T) {
+	attrs := ParseGitAttributes([]byte("*.enc text\n*.conf binary\n"))
+	oddEncoding := []byte{0xff, 0xfe, 0x00, 'a', 0x00, 'b'}
+
+	// A text declaration overrides the null-byte heuristic
+	assert.True(t, IsBinary("strings.enc", oddEncoding))
+	assert.False(t, IsBinaryWithAttributes(attrs, "strings.enc", oddEncoding))
+
+	// A binary declaration overrides the text extension list
+	assert.True(t, IsBinaryWithAttributes(attrs, "app.conf", []byte("key=value")))
+
+	// Without a matching rule, or without attributes, content detection decides
+	assert.True(t, IsBinaryWithAttributes(attrs, "logo.png", []byte("x")))
+	assert.True(t, IsBinaryWithAttributes(nil, "strings.enc", oddEncoding))
+}
+
+func TestLoadGitAttributes(t *testing.T) {
+	dir := t.TempDir()
+
+	attrs, err := LoadGitAttributes(dir)
+	require.NoError(t, err)
+	assert.Nil(t, attrs)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, GitAttributesFile), []byte("*.dat binary\n"), 0o600))
+	attrs, err = LoadGitAttributes(dir)
+	require.NoError(t, err)
+	binary, declared := attrs.Binary("x.dat")
+	assert.True(t, binary)
+	assert.True(t, declared)
+}
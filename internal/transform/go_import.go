@@ -0,0 +1,108 @@
+package transform
+
+import (
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// goImportPathTransformer rewrites Go import paths of the source module
+type goImportPathTransformer struct{}
+
+//nolint:gochecknoinits // Transforms register themselves with the pipeline registry
+func init() {
+	Register(Info{
+		Name:        config.TransformStepGoImports,
+		ConfigKey:   "go_module_path",
+		Description: "Rewrites Go imports of the source module to the target module path",
+		TextOnly:    true,
+		New:         withoutLogging(NewGoImportPathTransformer),
+	})
+}
+
+// NewGoImportPathTransformer creates a transformer that rewrites imports of the
+// source module (the context's GoSourceModulePath, or github.com/<source repo>
+// when unset) to the context's GoModulePath in .go files. Only import declarations are changed; string literals, comments and
+// code that merely mention the module path are left as-is. Files that do not
+// parse as Go pass through unchanged.
+func NewGoImportPathTransformer() Transformer {
+	return &goImportPathTransformer{}
+}
+
+// Name returns the name of this transformer
+func (g *goImportPathTransformer) Name() string {
+	return "go-import-path"
+}
+
+// Transform rewrites matching import paths in Go source files
+func (g *goImportPathTransformer) Transform(content []byte, ctx Context) ([]byte, error) {
+	if ctx.GoModulePath == "" || strings.ToLower(filepath.Ext(ctx.FilePath)) != ".go" {
+		return content, nil
+	}
+
+	sourceModule := ctx.GoSourceModulePath
+	if sourceModule == "" {
+		sourceModule = "github.com/" + ctx.SourceRepo
+	}
+	if sourceModule == ctx.GoModulePath {
+		return content, nil
+	}
+
+	// ImportsOnly stops after the import declarations, so the rest of the
+	// file does not need to be valid Go
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, ctx.FilePath, content, parser.ImportsOnly)
+	if err != nil {
+		return content, nil //nolint:nilerr // not parseable as Go; leave the file untouched
+	}
+
+	type edit struct {
+		start, end int
+		literal    string
+	}
+	var edits []edit
+	for _, spec := range file.Imports {
+		importPath, unquoteErr := strconv.Unquote(spec.Path.Value)
+		if unquoteErr != nil {
+			continue
+		}
+		rewritten, ok := rewriteModulePath(importPath, sourceModule, ctx.GoModulePath)
+		if !ok {
+			continue
+		}
+		edits = append(edits, edit{
+			start:   fset.Position(spec.Path.Pos()).Offset,
+			end:     fset.Position(spec.Path.End()).Offset,
+			literal: strconv.Quote(rewritten),
+		})
+	}
+	if len(edits) == 0 {
+		return content, nil
+	}
+
+	// Apply back to front so earlier offsets stay valid
+	sort.Slice(edits, func(i, j int) bool { return edits[i].start > edits[j].start })
+	result := append([]byte(nil), content...)
+	for _, e := range edits {
+		result = append(result[:e.start], append([]byte(e.literal), result[e.end:]...)...)
+	}
+	return result, nil
+}
+
+// rewriteModulePath replaces the from module prefix of importPath with to.
+// Only the module itself and its packages match: "github.com/org/repo-extra"
+// is a different module than "github.com/org/repo".
+func rewriteModulePath(importPath, from, to string) (string, bool) {
+	if importPath == from {
+		return to, true
+	}
+	if rest, ok := strings.CutPrefix(importPath, from+"/"); ok {
+		return to + "/" + rest, true
+	}
+	return "", false
+}
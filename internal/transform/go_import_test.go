@@ -0,0 +1,246 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoImportPathTransformer_Name(t *testing.T) {
+	assert.Equal(t, "go-import-path", NewGoImportPathTransformer().Name())
+}
+
+func TestGoImportPathTransformer_Transform(t *testing.T) {
+	transformer := NewGoImportPathTransformer()
+
+	baseCtx := Context{
+		SourceRepo:   "orig/lib",
+		TargetRepo:   "fork/lib",
+		FilePath:     "pkg/server/server.go",
+		GoModulePath: "github.com/fork/lib",
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		ctx     func(Context) Context
+		want    string
+	}{
+		{
+			name: "multi-import block",
+			content: `package server
+
+import (
+	"context"
+
+	"github.com/orig/lib"
+	"github.com/orig/lib/internal/config"
+	"github.com/other/dep"
+)
+`,
+			want: `package server
+
+import (
+	"context"
+
+	"github.com/fork/lib"
+	"github.com/fork/lib/internal/config"
+	"github.com/other/dep"
+)
+`,
+		},
+		{
+			name: "aliased, dot and blank imports",
+			content: `package server
+
+import (
+	cfg "github.com/orig/lib/config"
+	. "github.com/orig/lib/testing"
+	_ "github.com/orig/lib/driver"
+)
+`,
+			want: `package server
+
+import (
+	cfg "github.com/fork/lib/config"
+	. "github.com/fork/lib/testing"
+	_ "github.com/fork/lib/driver"
+)
+`,
+		},
+		{
+			name: "multiple import declarations",
+			content: `package server
+
+import "github.com/orig/lib/a"
+import b "github.com/orig/lib/b"
+`,
+			want: `package server
+
+import "github.com/fork/lib/a"
+import b "github.com/fork/lib/b"
+`,
+		},
+		{
+			name: "string literals and comments are untouched",
+			content: `package server
+
+// Import "github.com/orig/lib/config" to configure the server.
+import "github.com/orig/lib/config"
+
+const upstream = "github.com/orig/lib/config"
+
+var docs = ` + "`see github.com/orig/lib`" + `
+`,
+			want: `package server
+
+// Import "github.com/orig/lib/config" to configure the server.
+import "github.com/fork/lib/config"
+
+const upstream = "github.com/orig/lib/config"
+
+var docs = ` + "`see github.com/orig/lib`" + `
+`,
+		},
+		{
+			name: "module with a shared prefix is a different module",
+			content: `package server
+
+import "github.com/orig/lib-extra/util"
+`,
+			want: `package server
+
+import "github.com/orig/lib-extra/util"
+`,
+		},
+		{
+			name:    "non-Go file passes through unchanged",
+			content: `import "github.com/orig/lib"`,
+			ctx: func(ctx Context) Context {
+				ctx.FilePath = "README.md"
+				return ctx
+			},
+			want: `import "github.com/orig/lib"`,
+		},
+		{
+			name:    "disabled without a module path",
+			content: "package server\n\nimport \"github.com/orig/lib\"\n",
+			ctx: func(ctx Context) Context {
+				ctx.GoModulePath = ""
+				return ctx
+			},
+			want: "package server\n\nimport \"github.com/orig/lib\"\n",
+		},
+		{
+			name:    "unparseable Go passes through unchanged",
+			content: "package {{.Name}}\n\nimport \"github.com/orig/lib\"\n",
+			want:    "package {{.Name}}\n\nimport \"github.com/orig/lib\"\n",
+		},
+		{
+			name: "code after the imports need not compile",
+			content: `package server
+
+import "github.com/orig/lib/config"
+
+func broken( {
+`,
+			want: `package server
+
+import "github.com/fork/lib/config"
+
+func broken( {
+`,
+		},
+		{
+			name: "explicit source module path for a vanity import",
+			content: `package server
+
+import (
+	"go.orig.dev/lib/config"
+	"github.com/orig/lib/config"
+)
+`,
+			ctx: func(ctx Context) Context {
+				ctx.GoSourceModulePath = "go.orig.dev/lib"
+				return ctx
+			},
+			want: `package server
+
+import (
+	"github.com/fork/lib/config"
+	"github.com/orig/lib/config"
+)
+`,
+		},
+		{
+			name: "explicit source module path differing in case from the repo",
+			content: `package server
+
+import "github.com/Orig/Lib/config"
+`,
+			ctx: func(ctx Context) Context {
+				ctx.GoSourceModulePath = "github.com/Orig/Lib"
+				return ctx
+			},
+			want: `package server
+
+import "github.com/fork/lib/config"
+`,
+		},
+		{
+			name: "rewrite to a vanity path with a different length",
+			content: `package server
+
+import (
+	"github.com/orig/lib/a"
+	"github.com/orig/lib/b"
+)
+`,
+			ctx: func(ctx Context) Context {
+				ctx.GoModulePath = "go.example.com/lib/v2"
+				return ctx
+			},
+			want: `package server
+
+import (
+	"go.example.com/lib/v2/a"
+	"go.example.com/lib/v2/b"
+)
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := baseCtx
+			if tt.ctx != nil {
+				ctx = tt.ctx(ctx)
+			}
+
+			got, err := transformer.Transform([]byte(tt.content), ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestRewriteModulePath(t *testing.T) {
+	tests := []struct {
+		importPath string
+		want       string
+		ok         bool
+	}{
+		{"github.com/orig/lib", "github.com/fork/lib", true},
+		{"github.com/orig/lib/pkg/util", "github.com/fork/lib/pkg/util", true},
+		{"github.com/orig/library", "", false},
+		{"github.com/orig", "", false},
+		{"fmt", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := rewriteModulePath(tt.importPath, "github.com/orig/lib", "github.com/fork/lib")
+		assert.Equal(t, tt.ok, ok, tt.importPath)
+		assert.Equal(t, tt.want, got, tt.importPath)
+	}
+}
@@ -0,0 +1,55 @@
+package transform
+
+import (
+	"bytes"
+
+	"github.com/mrz1836/go-broadcast/internal/algorithms"
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// lineEndingsTransformer rewrites the line endings of text files
+type lineEndingsTransformer struct{}
+
+//nolint:gochecknoinits // Transforms register themselves with the pipeline registry
+func init() {
+	Register(Info{
+		Name:        config.TransformStepLineEndings,
+		ConfigKey:   "line_endings",
+		Description: "Normalizes line endings to lf or crlf",
+		TextOnly:    true,
+		New:         withoutLogging(NewLineEndingsTransformer),
+	})
+}
+
+// NewLineEndingsTransformer creates a transformer that normalizes every line
+// ending of a text file to the context's LineEndings mode: config.LineEndingsLF
+// writes "\n" and config.LineEndingsCRLF writes "\r\n", including in files
+// that mix both. Lone "\r" characters are not line endings and are kept.
+// Binary content, and any content when the mode is empty or
+// config.LineEndingsPreserve, is returned unchanged.
+func NewLineEndingsTransformer() Transformer {
+	return &lineEndingsTransformer{}
+}
+
+// Name returns the name of this transformer
+func (l *lineEndingsTransformer) Name() string {
+	return "line-endings"
+}
+
+// Transform normalizes the line endings of content
+func (l *lineEndingsTransformer) Transform(content []byte, ctx Context) ([]byte, error) {
+	switch ctx.LineEndings {
+	case config.LineEndingsLF, config.LineEndingsCRLF:
+	default:
+		return content, nil
+	}
+	if !bytes.Contains(content, []byte("\n")) || algorithms.IsBinaryOptimized(content) {
+		return content, nil
+	}
+
+	lf := bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n"))
+	if ctx.LineEndings == config.LineEndingsLF {
+		return lf, nil
+	}
+	return bytes.ReplaceAll(lf, []byte("\n"), []byte("\r\n")), nil
+}
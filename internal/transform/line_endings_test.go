@@ -0,0 +1,58 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+func TestLineEndingsTransformer_Name(t *testing.T) {
+	assert.Equal(t, "line-endings", NewLineEndingsTransformer().Name())
+}
+
+func TestLineEndingsTransformer_Transform(t *testing.T) {
+	transformer := NewLineEndingsTransformer()
+	mixed := "one\r\ntwo\nthree\r\n"
+
+	tests := []struct {
+		name    string
+		mode    string
+		content string
+		want    string
+	}{
+		{name: "mixed to lf", mode: config.LineEndingsLF, content: mixed, want: "one\ntwo\nthree\n"},
+		{name: "mixed to crlf", mode: config.LineEndingsCRLF, content: mixed, want: "one\r\ntwo\r\nthree\r\n"},
+		{name: "crlf already", mode: config.LineEndingsCRLF, content: "a\r\nb\r\n", want: "a\r\nb\r\n"},
+		{name: "lone carriage return kept", mode: config.LineEndingsLF, content: "progress\r50%\r\n", want: "progress\r50%\n"},
+		{name: "no trailing newline", mode: config.LineEndingsCRLF, content: "a\nb", want: "a\r\nb"},
+		{name: "preserve", mode: config.LineEndingsPreserve, content: mixed, want: mixed},
+		{name: "unset", mode: "", content: mixed, want: mixed},
+		{name: "empty content", mode: config.LineEndingsCRLF, content: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := transformer.Transform([]byte(tt.content), Context{LineEndings: tt.mode})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+
+			again, err := transformer.Transform(got, Context{LineEndings: tt.mode})
+			require.NoError(t, err)
+			assert.Equal(t, string(got), string(again), "transform is idempotent")
+		})
+	}
+}
+
+func TestLineEndingsTransformer_Binary(t *testing.T) {
+	transformer := NewLineEndingsTransformer()
+	binary := []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n', 0x00, 0x00, '\n', 0xff}
+
+	for _, mode := range []string{config.LineEndingsLF, config.LineEndingsCRLF} {
+		got, err := transformer.Transform(binary, Context{LineEndings: mode})
+		require.NoError(t, err)
+		assert.Equal(t, binary, got, mode)
+	}
+}
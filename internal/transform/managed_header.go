@@ -0,0 +1,233 @@
+package transform
+
+import (
+	"bytes"
+	"path"
+	"strings"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// ManagedHeaderMarker opens every managed header. It identifies a header
+// written by an earlier sync so it is replaced instead of stacked.
+const ManagedHeaderMarker = "DO NOT EDIT — managed by go-broadcast"
+
+// headerCommentStyle is the line comment syntax a header is written in
+type headerCommentStyle struct {
+	prefix string
+	suffix string
+}
+
+// Comment styles of managed headers
+var (
+	slashCommentStyle = headerCommentStyle{prefix: "//"}
+	hashCommentStyle  = headerCommentStyle{prefix: "#"}
+	htmlCommentStyle  = headerCommentStyle{prefix: "<!--", suffix: "-->"}
+	cssCommentStyle   = headerCommentStyle{prefix: "/*", suffix: "*/"}
+	dashCommentStyle  = headerCommentStyle{prefix: "--"}
+)
+
+// headerCommentStyles maps lower-case file extensions to their comment style
+//
+//nolint:gochecknoglobals // This is a read-only lookup table
+var headerCommentStyles = map[string]headerCommentStyle{
+	".go": slashCommentStyle, ".js": slashCommentStyle, ".mjs": slashCommentStyle, ".cjs": slashCommentStyle,
+	".ts": slashCommentStyle, ".jsx": slashCommentStyle, ".tsx": slashCommentStyle, ".java": slashCommentStyle,
+	".kt": slashCommentStyle, ".scala": slashCommentStyle, ".swift": slashCommentStyle, ".rs": slashCommentStyle,
+	".c": slashCommentStyle, ".h": slashCommentStyle, ".cc": slashCommentStyle, ".cpp": slashCommentStyle,
+	".hpp": slashCommentStyle, ".cs": slashCommentStyle, ".proto": slashCommentStyle, ".dart": slashCommentStyle,
+
+	".sh": hashCommentStyle, ".bash": hashCommentStyle, ".zsh": hashCommentStyle, ".py": hashCommentStyle,
+	".rb": hashCommentStyle, ".pl": hashCommentStyle, ".yml": hashCommentStyle, ".yaml": hashCommentStyle,
+	".toml": hashCommentStyle, ".tf": hashCommentStyle, ".mk": hashCommentStyle, ".cfg": hashCommentStyle,
+	".conf": hashCommentStyle, ".env": hashCommentStyle, ".r": hashCommentStyle, ".ps1": hashCommentStyle,
+	".gitignore": hashCommentStyle, ".gitattributes": hashCommentStyle, ".dockerignore": hashCommentStyle,
+	".editorconfig": hashCommentStyle,
+
+	".md": htmlCommentStyle, ".markdown": htmlCommentStyle, ".html": htmlCommentStyle, ".htm": htmlCommentStyle,
+	".xml": htmlCommentStyle, ".svg": htmlCommentStyle, ".vue": htmlCommentStyle,
+
+	".css": cssCommentStyle, ".scss": cssCommentStyle, ".less": cssCommentStyle,
+
+	".sql": dashCommentStyle, ".lua": dashCommentStyle, ".hs": dashCommentStyle,
+}
+
+// headerCommentFileNames maps extension-less file names to their comment style
+//
+//nolint:gochecknoglobals // This is a read-only lookup table
+var headerCommentFileNames = map[string]headerCommentStyle{
+	"makefile":   hashCommentStyle,
+	"dockerfile": hashCommentStyle,
+	"codeowners": hashCommentStyle,
+	"gemfile":    hashCommentStyle,
+	"rakefile":   hashCommentStyle,
+}
+
+// headerCommentStyleFor infers the comment style of a file from its name.
+// Files without a known style (e.g. JSON, which has no comments) get none.
+func headerCommentStyleFor(filePath string) (headerCommentStyle, bool) {
+	base := strings.ToLower(path.Base(filePath))
+	if style, ok := headerCommentFileNames[base]; ok {
+		return style, true
+	}
+	if strings.HasPrefix(base, "dockerfile.") {
+		return hashCommentStyle, true
+	}
+	style, ok := headerCommentStyles[path.Ext(base)]
+	return style, ok
+}
+
+// line renders one header line in this comment style
+func (s headerCommentStyle) line(text string) string {
+	var b strings.Builder
+	b.WriteString(s.prefix)
+	if text != "" {
+		b.WriteString(" ")
+		b.WriteString(text)
+	}
+	if s.suffix != "" {
+		b.WriteString(" ")
+		b.WriteString(s.suffix)
+	}
+	return b.String()
+}
+
+// isComment reports whether a line is a single-line comment in this style
+func (s headerCommentStyle) isComment(line string) bool {
+	line = strings.TrimRight(line, " \t\r\n")
+	return strings.HasPrefix(line, s.prefix) && strings.HasSuffix(line, s.suffix) &&
+		len(line) >= len(s.prefix)+len(s.suffix)
+}
+
+// managedHeaderTransformer prepends, replaces or strips the managed header
+type managedHeaderTransformer struct{}
+
+//nolint:gochecknoinits // Transforms register themselves with the pipeline registry
+func init() {
+	Register(Info{
+		Name:        config.TransformStepManagedHeader,
+		ConfigKey:   "managed_header",
+		Description: "Writes or strips a managed header comment at the top of each file",
+		TextOnly:    true,
+		New:         withoutLogging(NewManagedHeaderTransformer),
+	})
+}
+
+// NewManagedHeaderTransformer creates a transformer that writes the context's
+// ManagedHeader at the top of each file, as comments in the style of the
+// file's extension ("//", "#", "<!-- -->", "/* */" or "--"). The header
+// opens with ManagedHeaderMarker followed by the configured text, in which
+// {{SOURCE_REPO}}, {{TARGET_REPO}} and {{FILE_PATH}} are replaced. A managed
+// header already in the file is replaced, so applying the transformer twice
+// yields the same content. With StripManagedHeader set the existing header
+// is removed and none is written. Shebang lines and XML declarations stay
+// first, and files without a known comment style are left unchanged.
+func NewManagedHeaderTransformer() Transformer {
+	return &managedHeaderTransformer{}
+}
+
+// Name returns the name of this transformer
+func (m *managedHeaderTransformer) Name() string {
+	return "managed-header"
+}
+
+// Transform replaces or strips the managed header of content
+func (m *managedHeaderTransformer) Transform(content []byte, ctx Context) ([]byte, error) {
+	if ctx.ManagedHeader == "" && !ctx.StripManagedHeader {
+		return content, nil
+	}
+	style, ok := headerCommentStyleFor(ctx.FilePath)
+	if !ok {
+		return content, nil
+	}
+
+	newline := "\n"
+	if bytes.Contains(content, []byte("\r\n")) {
+		newline = "\r\n"
+	}
+	lines := strings.SplitAfter(string(content), "\n")
+
+	start := managedHeaderStart(lines, ctx.FilePath)
+	end := managedHeaderEnd(lines, start, style)
+
+	var b strings.Builder
+	b.Grow(len(content) + len(ctx.ManagedHeader) + 64)
+	for _, line := range lines[:start] {
+		b.WriteString(line)
+	}
+	if start > 0 && !strings.HasSuffix(lines[start-1], "\n") {
+		b.WriteString(newline)
+	}
+	if !ctx.StripManagedHeader {
+		for _, line := range renderManagedHeader(ctx, style) {
+			b.WriteString(line)
+			b.WriteString(newline)
+		}
+		if end < len(lines) && lines[end] != "" {
+			b.WriteString(newline)
+		}
+	}
+	for _, line := range lines[end:] {
+		b.WriteString(line)
+	}
+	return []byte(b.String()), nil
+}
+
+// managedHeaderStart returns the index of the line the managed header goes
+// on. Shebang lines and XML declarations must stay first, and Markdown front
+// matter must stay at the top to be recognized.
+func managedHeaderStart(lines []string, filePath string) int {
+	first := strings.TrimRight(lines[0], "\r\n")
+	switch {
+	case strings.HasPrefix(first, "#!"), strings.HasPrefix(first, "<?xml"):
+		return 1
+	case first == "---" && isMarkdownFile(filePath):
+		for i := 1; i < len(lines); i++ {
+			if strings.TrimRight(lines[i], "\r\n") == "---" {
+				return i + 1
+			}
+		}
+	}
+	return 0
+}
+
+// isMarkdownFile reports whether a file is Markdown by its extension
+func isMarkdownFile(filePath string) bool {
+	ext := strings.ToLower(path.Ext(filePath))
+	return ext == ".md" || ext == ".markdown"
+}
+
+// managedHeaderEnd returns the index of the first line after the managed
+// header starting at lines[start], including the blank line that separates
+// it from the content. Without a managed header it returns start.
+func managedHeaderEnd(lines []string, start int, style headerCommentStyle) int {
+	if start >= len(lines) || !style.isComment(lines[start]) || !strings.Contains(lines[start], ManagedHeaderMarker) {
+		return start
+	}
+	end := start + 1
+	for end < len(lines) && style.isComment(lines[end]) {
+		end++
+	}
+	if end < len(lines) && strings.TrimSpace(lines[end]) == "" && strings.HasSuffix(lines[end], "\n") {
+		end++
+	}
+	return end
+}
+
+// renderManagedHeader returns the header lines written for the file in ctx
+func renderManagedHeader(ctx Context, style headerCommentStyle) []string {
+	text := strings.NewReplacer(
+		"{{SOURCE_REPO}}", ctx.SourceRepo,
+		"{{TARGET_REPO}}", ctx.TargetRepo,
+		"{{FILE_PATH}}", ctx.FilePath,
+	).Replace(strings.TrimSpace(ctx.ManagedHeader))
+
+	lines := []string{style.line(ManagedHeaderMarker)}
+	if text == "" {
+		return lines
+	}
+	for _, line := range strings.Split(text, "\n") {
+		lines = append(lines, style.line(strings.TrimRight(line, " \t\r")))
+	}
+	return lines
+}
@@ -0,0 +1,139 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManagedHeaderTransformer_Name(t *testing.T) {
+	assert.Equal(t, "managed-header", NewManagedHeaderTransformer().Name())
+}
+
+func TestManagedHeaderTransformer_Transform(t *testing.T) {
+	transformer := NewManagedHeaderTransformer()
+	header := "Source: {{SOURCE_REPO}}"
+
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		want    string
+	}{
+		{
+			name:    "go file",
+			file:    "internal/util/util.go",
+			content: "package util\n",
+			want:    "// DO NOT EDIT — managed by go-broadcast\n// Source: org/template\n\npackage util\n",
+		},
+		{
+			name:    "yaml file",
+			file:    ".github/workflows/ci.yml",
+			content: "name: ci\n",
+			want:    "# DO NOT EDIT — managed by go-broadcast\n# Source: org/template\n\nname: ci\n",
+		},
+		{
+			name:    "markdown file",
+			file:    "docs/CONTRIBUTING.md",
+			content: "# Contributing\n",
+			want:    "<!-- DO NOT EDIT — managed by go-broadcast -->\n<!-- Source: org/template -->\n\n# Contributing\n",
+		},
+		{
+			name:    "extension-less file name",
+			file:    "Makefile",
+			content: "all:\n",
+			want:    "# DO NOT EDIT — managed by go-broadcast\n# Source: org/template\n\nall:\n",
+		},
+		{
+			name:    "shebang stays first",
+			file:    "scripts/build.sh",
+			content: "#!/bin/sh\necho hi\n",
+			want:    "#!/bin/sh\n# DO NOT EDIT — managed by go-broadcast\n# Source: org/template\n\necho hi\n",
+		},
+		{
+			name:    "markdown front matter stays first",
+			file:    "docs/index.md",
+			content: "---\ntitle: Home\n---\n# Home\n",
+			want:    "---\ntitle: Home\n---\n<!-- DO NOT EDIT — managed by go-broadcast -->\n<!-- Source: org/template -->\n\n# Home\n",
+		},
+		{
+			name:    "outdated header is replaced",
+			file:    "main.go",
+			content: "// DO NOT EDIT — managed by go-broadcast\n// Old text\n// spanning two lines\n\n// Package main runs it\npackage main\n",
+			want:    "// DO NOT EDIT — managed by go-broadcast\n// Source: org/template\n\n// Package main runs it\npackage main\n",
+		},
+		{
+			name:    "crlf line endings",
+			file:    "run.py",
+			content: "print(1)\r\n",
+			want:    "# DO NOT EDIT — managed by go-broadcast\r\n# Source: org/template\r\n\r\nprint(1)\r\n",
+		},
+		{
+			name:    "empty file",
+			file:    "empty.go",
+			content: "",
+			want:    "// DO NOT EDIT — managed by go-broadcast\n// Source: org/template\n",
+		},
+		{
+			name:    "unknown comment style is left alone",
+			file:    "package.json",
+			content: "{}\n",
+			want:    "{}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := Context{SourceRepo: "org/template", TargetRepo: "org/service", FilePath: tt.file, ManagedHeader: header}
+
+			once, err := transformer.Transform([]byte(tt.content), ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(once))
+
+			twice, err := transformer.Transform(once, ctx)
+			require.NoError(t, err)
+			assert.Equal(t, string(once), string(twice), "applying the header twice must not stack it")
+		})
+	}
+}
+
+func TestManagedHeaderTransformer_Variables(t *testing.T) {
+	ctx := Context{
+		SourceRepo:    "org/template",
+		TargetRepo:    "org/service",
+		FilePath:      "Dockerfile",
+		ManagedHeader: "Edit {{FILE_PATH}} in {{SOURCE_REPO}}, not in {{TARGET_REPO}}",
+	}
+
+	result, err := NewManagedHeaderTransformer().Transform([]byte("FROM scratch\n"), ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "# DO NOT EDIT — managed by go-broadcast\n# Edit Dockerfile in org/template, not in org/service\n\nFROM scratch\n", string(result))
+}
+
+func TestManagedHeaderTransformer_Strip(t *testing.T) {
+	transformer := NewManagedHeaderTransformer()
+	ctx := Context{FilePath: "style.css", StripManagedHeader: true}
+
+	content := "/* DO NOT EDIT — managed by go-broadcast */\n/* Source: org/template */\n\nbody {}\n"
+	result, err := transformer.Transform([]byte(content), ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "body {}\n", string(result))
+
+	again, err := transformer.Transform(result, ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "body {}\n", string(again))
+
+	// Comments that are not a managed header are kept
+	unmanaged := "/* Copyright 2026 Acme */\nbody {}\n"
+	result, err = transformer.Transform([]byte(unmanaged), ctx)
+	require.NoError(t, err)
+	assert.Equal(t, unmanaged, string(result))
+}
+
+func TestManagedHeaderTransformer_Disabled(t *testing.T) {
+	content := []byte("// DO NOT EDIT — managed by go-broadcast\n\npackage main\n")
+	result, err := NewManagedHeaderTransformer().Transform(content, Context{FilePath: "main.go"})
+	require.NoError(t, err)
+	assert.Equal(t, content, result)
+}
@@ -0,0 +1,36 @@
+package transform
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/mrz1836/go-broadcast/internal/logging"
+)
+
+// ErrUnknownTransform indicates a pipeline names a transform that does not exist
+var ErrUnknownTransform = errors.New("unknown transform")
+
+// NewPipelineTransformer creates the transformer for a pipeline step name
+func NewPipelineTransformer(step string, logger *logrus.Logger, logConfig *logging.LogConfig) (Transformer, error) {
+	info, ok := Lookup(step)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownTransform, step)
+	}
+	return info.New(logger, logConfig), nil
+}
+
+// NewPipelineChain creates a chain that applies the named transforms in the
+// given order. Transforms not listed are not added.
+func NewPipelineChain(steps []string, logger *logrus.Logger, logConfig *logging.LogConfig) (Chain, error) {
+	chain := NewChain(logger)
+	for _, step := range steps {
+		transformer, err := NewPipelineTransformer(step, logger, logConfig)
+		if err != nil {
+			return nil, err
+		}
+		chain.Add(transformer)
+	}
+	return chain, nil
+}
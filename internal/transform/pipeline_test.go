@@ -0,0 +1,36 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+func TestNewPipelineChain_DefaultPipelineRegistered(t *testing.T) {
+	chain, err := NewPipelineChain(config.DefaultTransformPipeline(), logrus.New(), nil)
+	require.NoError(t, err)
+	assert.Len(t, chain.Transformers(), len(config.DefaultTransformPipeline()))
+}
+
+func TestNewPipelineChain_Order(t *testing.T) {
+	steps := []string{config.TransformStepManagedHeader, config.TransformStepVariables, config.TransformStepGoImports}
+
+	chain, err := NewPipelineChain(steps, nil, nil)
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(chain.Transformers()))
+	for _, transformer := range chain.Transformers() {
+		names = append(names, transformer.Name())
+	}
+	assert.Equal(t, []string{"managed-header", "template-variable-replacer", "go-import-path"}, names)
+}
+
+func TestNewPipelineChain_UnknownTransform(t *testing.T) {
+	_, err := NewPipelineChain([]string{config.TransformStepVariables, "minify"}, nil, nil)
+	require.ErrorIs(t, err, ErrUnknownTransform)
+	assert.Contains(t, err.Error(), "minify")
+}
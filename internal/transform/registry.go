@@ -0,0 +1,62 @@
+package transform
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/mrz1836/go-broadcast/internal/logging"
+)
+
+// Info describes a transform that pipelines can name
+type Info struct {
+	Name        string `json:"name"`        // Pipeline step name, such as repo_name
+	ConfigKey   string `json:"config_key"`  // Setting that turns the transform on
+	Description string `json:"description"` // One-line summary of what the transform changes
+	TextOnly    bool   `json:"text_only"`   // Leaves binary and non-matching files untouched on its own
+
+	// New creates the transformer for a pipeline step
+	New func(*logrus.Logger, *logging.LogConfig) Transformer `json:"-"`
+}
+
+// registry holds every registered transform by pipeline step name
+//
+//nolint:gochecknoglobals // Filled once by the transform files' init functions
+var registry = map[string]Info{}
+
+// Register adds a transform to the registry. It panics when the name is
+// empty, has no constructor or is already registered, as those are
+// programming errors caught at startup.
+func Register(info Info) {
+	if info.Name == "" || info.New == nil {
+		panic("transform: Register requires a name and a constructor")
+	}
+	if _, exists := registry[info.Name]; exists {
+		panic(fmt.Sprintf("transform: %q registered twice", info.Name))
+	}
+	registry[info.Name] = info
+}
+
+// Lookup returns the registered transform with the given pipeline step name
+func Lookup(name string) (Info, bool) {
+	info, ok := registry[name]
+	return info, ok
+}
+
+// Registered returns every registered transform sorted by name
+func Registered() []Info {
+	infos := make([]Info, 0, len(registry))
+	for _, info := range registry {
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
+// withoutLogging adapts a constructor that takes no logging settings to Info.New
+func withoutLogging(newTransformer func() Transformer) func(*logrus.Logger, *logging.LogConfig) Transformer {
+	return func(*logrus.Logger, *logging.LogConfig) Transformer {
+		return newTransformer()
+	}
+}
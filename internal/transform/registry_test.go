@@ -0,0 +1,39 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+func TestRegistered_CoversPipelineSteps(t *testing.T) {
+	names := make([]string, 0, len(Registered()))
+	for _, info := range Registered() {
+		names = append(names, info.Name)
+		assert.NotEmpty(t, info.ConfigKey, info.Name)
+		assert.NotEmpty(t, info.Description, info.Name)
+		assert.NotNil(t, info.New(nil, nil), info.Name)
+	}
+	assert.ElementsMatch(t, config.DefaultTransformPipeline(), names)
+	assert.IsIncreasing(t, names)
+}
+
+func TestLookup(t *testing.T) {
+	info, ok := Lookup(config.TransformStepLineEndings)
+	require.True(t, ok)
+	assert.True(t, info.TextOnly)
+	assert.Equal(t, "line_endings", info.ConfigKey)
+
+	_, ok = Lookup("minify")
+	assert.False(t, ok)
+}
+
+func TestRegister_Panics(t *testing.T) {
+	assert.Panics(t, func() { Register(Info{Name: config.TransformStepRepoName, New: withoutLogging(NewRepoTransformer)}) })
+	assert.Panics(t, func() { Register(Info{Name: "no-constructor"}) })
+	_, ok := Lookup("no-constructor")
+	assert.False(t, ok)
+}
@@ -1,11 +1,14 @@
 package transform
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"path/filepath"
 	"regexp"
 	"strings"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
 )
 
 // ErrInvalidRepoFormat is returned when a repository format is invalid
@@ -16,6 +19,17 @@ type repoTransformer struct {
 	cache *RegexCache
 }
 
+//nolint:gochecknoinits // Transforms register themselves with the pipeline registry
+func init() {
+	Register(Info{
+		Name:        config.TransformStepRepoName,
+		ConfigKey:   "repo_name",
+		Description: "Replaces the source repository name with the target's",
+		TextOnly:    false,
+		New:         withoutLogging(NewRepoTransformer),
+	})
+}
+
 // NewRepoTransformer creates a new repository name transformer
 func NewRepoTransformer() Transformer {
 	return &repoTransformer{
@@ -48,6 +62,12 @@ func (r *repoTransformer) Transform(content []byte, ctx Context) ([]byte, error)
 	targetOrg := targetParts[0]
 	targetRepoName := targetParts[1]
 
+	// Every pattern below contains the source repository name, and each
+	// regexp replacement copies the whole content even without a match
+	if !bytes.Contains(content, []byte(sourceRepoName)) {
+		return content, nil
+	}
+
 	// Apply transformations based on file type
 	result := content
 	fileExt := strings.ToLower(filepath.Ext(ctx.FilePath))
@@ -0,0 +1,67 @@
+package transform
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/mrz1836/go-broadcast/internal/algorithms"
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// ErrSecretDetected is returned when a file contains secret-like text and
+// the secret_scrub transform is configured to fail on a match
+var ErrSecretDetected = errors.New("secret-like text detected")
+
+// secretScrubTransformer replaces secret-like text in text files
+type secretScrubTransformer struct{}
+
+//nolint:gochecknoinits // Transforms register themselves with the pipeline registry
+func init() {
+	Register(Info{
+		Name:        config.TransformStepSecretScrub,
+		ConfigKey:   "secret_scrub",
+		Description: "Replaces secret-like text before it reaches targets",
+		TextOnly:    true,
+		New:         withoutLogging(NewSecretScrubTransformer),
+	})
+}
+
+// NewSecretScrubTransformer creates a transformer that replaces every match of
+// the context's SecretScrub patterns with its replacement, or fails with
+// ErrSecretDetected on the first match when FailOnMatch is set. The error names
+// the file, line and pattern but never the matched text. Binary content, and
+// any content when SecretScrub is nil, is returned unchanged.
+func NewSecretScrubTransformer() Transformer {
+	return &secretScrubTransformer{}
+}
+
+// Name returns the name of this transformer
+func (s *secretScrubTransformer) Name() string {
+	return "secret-scrub"
+}
+
+// Transform scrubs secret-like text from content
+func (s *secretScrubTransformer) Transform(content []byte, ctx Context) ([]byte, error) {
+	if ctx.SecretScrub == nil || len(content) == 0 || algorithms.IsBinaryOptimized(content) {
+		return content, nil
+	}
+
+	replacement := []byte(ctx.SecretScrub.ScrubReplacement())
+	for _, pattern := range ctx.SecretScrub.ScrubPatterns() {
+		re, err := CompileRegex(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secret_scrub pattern %q: %w", pattern, err)
+		}
+
+		if ctx.SecretScrub.FailOnMatch {
+			if loc := re.FindIndex(content); loc != nil {
+				line := bytes.Count(content[:loc[0]], []byte("\n")) + 1
+				return nil, fmt.Errorf("%w in %s at line %d (pattern %q)", ErrSecretDetected, ctx.FilePath, line, pattern)
+			}
+			continue
+		}
+		content = re.ReplaceAllLiteral(content, replacement)
+	}
+	return content, nil
+}
@@ -0,0 +1,120 @@
+package transform
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// Fake credentials are split so secret scanners do not flag this file
+//
+//nolint:gochecknoglobals // Test fixtures
+var (
+	fakeAWSKey      = "AKIA" + "IOSFODNN7EXAMPLE"
+	fakeGitHubToken = "ghp_" + strings.Repeat("a1B2", 9)
+	fakeGitHubPAT   = "github_pat_" + strings.Repeat("x9Y8", 6)
+	fakeSlackToken  = "xoxb-" + "1234567890-abcdefghij"
+	fakeStripeKey   = "sk_" + "live_" + strings.Repeat("Z7", 12)
+	fakeGoogleKey   = "AIza" + strings.Repeat("q", 35)
+	fakeJWT         = "eyJ" + "hbGciOiJIUzI1NiJ9.eyJ" + "zdWIiOiIxMjM0NTY3ODkwIn0.dozjgNryP4J3jVmNHl0w5N_XgL0n3I9PlFUP0THsR8U"
+	fakePrivateKey  = "-----BEGIN RSA " + "PRIVATE KEY-----\nMIIEow\nIBAAKC\n-----END RSA " + "PRIVATE KEY-----"
+)
+
+func TestSecretScrubTransformer_Name(t *testing.T) {
+	assert.Equal(t, "secret-scrub", NewSecretScrubTransformer().Name())
+}
+
+func TestSecretScrubTransformer_DefaultPatterns(t *testing.T) {
+	transformer := NewSecretScrubTransformer()
+	ctx := Context{FilePath: "config.env", SecretScrub: &config.SecretScrub{}}
+
+	for name, secret := range map[string]string{
+		"aws access key":      fakeAWSKey,
+		"github token":        fakeGitHubToken,
+		"github fine-grained": fakeGitHubPAT,
+		"slack token":         fakeSlackToken,
+		"stripe live key":     fakeStripeKey,
+		"google api key":      fakeGoogleKey,
+		"json web token":      fakeJWT,
+		"private key":         fakePrivateKey,
+	} {
+		t.Run(name, func(t *testing.T) {
+			content := "before\nvalue = \"" + secret + "\"\nafter\n"
+			got, err := transformer.Transform([]byte(content), ctx)
+			require.NoError(t, err)
+			assert.Equal(t, "before\nvalue = \"REDACTED\"\nafter\n", string(got))
+		})
+	}
+}
+
+func TestSecretScrubTransformer_NoFalsePositives(t *testing.T) {
+	transformer := NewSecretScrubTransformer()
+	ctx := Context{FilePath: "main.go", SecretScrub: &config.SecretScrub{}}
+
+	for _, content := range []string{
+		"package main\n\nfunc main() {\n\ttoken := os.Getenv(\"GITHUB_TOKEN\")\n\tfmt.Println(token)\n}\n",
+		"AKIA_PREFIX = \"AKIA\"\nconst awsKeyLength = 20\n",
+		"sha := \"3f786850e387550fdab836ed7e6dc881de23001b\"\n",
+		"checksum: sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08\n",
+		"uses: actions/checkout@b4ffde65f46336ab88eb53be808477a3936bed11 # v4\n",
+		"- run: gh pr create --title ghp_ --body xoxb-\n",
+		"import \"github.com/mrz1836/go-broadcast/internal/transform\"\n",
+		"// Decode the header: eyJhbGciOi... is base64 for {\"alg\"\n",
+		"-----BEGIN PUBLIC KEY-----\nMFkw\n-----END PUBLIC KEY-----\n",
+		"contact: security@example.com\n",
+	} {
+		got, err := transformer.Transform([]byte(content), ctx)
+		require.NoError(t, err)
+		assert.Equal(t, content, string(got))
+	}
+}
+
+func TestSecretScrubTransformer_CustomPatterns(t *testing.T) {
+	transformer := NewSecretScrubTransformer()
+	ctx := Context{SecretScrub: &config.SecretScrub{
+		Patterns:    []string{`[a-z0-9.]+@internal\.example\.com`, `api_key=\w+`},
+		Replacement: "$1<scrubbed>",
+	}}
+
+	got, err := transformer.Transform([]byte("owner: jane.doe@internal.example.com\nurl: /v1?api_key=abc123\nkey: "+fakeAWSKey+"\n"), ctx)
+	require.NoError(t, err)
+	// Configured patterns replace the defaults, and the replacement is literal
+	assert.Equal(t, "owner: $1<scrubbed>\nurl: /v1?$1<scrubbed>\nkey: "+fakeAWSKey+"\n", string(got))
+}
+
+func TestSecretScrubTransformer_FailOnMatch(t *testing.T) {
+	transformer := NewSecretScrubTransformer()
+	ctx := Context{FilePath: ".github/workflows/ci.yml", SecretScrub: &config.SecretScrub{FailOnMatch: true}}
+
+	_, err := transformer.Transform([]byte("name: ci\nenv:\n  TOKEN: "+fakeGitHubToken+"\n"), ctx)
+	require.ErrorIs(t, err, ErrSecretDetected)
+	assert.Contains(t, err.Error(), ".github/workflows/ci.yml at line 3")
+	assert.NotContains(t, err.Error(), fakeGitHubToken)
+
+	clean := []byte("name: ci\nenv:\n  TOKEN: ${{ secrets.TOKEN }}\n")
+	got, err := transformer.Transform(clean, ctx)
+	require.NoError(t, err)
+	assert.Equal(t, clean, got)
+}
+
+func TestSecretScrubTransformer_Skipped(t *testing.T) {
+	transformer := NewSecretScrubTransformer()
+
+	t.Run("not configured", func(t *testing.T) {
+		content := []byte("key: " + fakeAWSKey + "\n")
+		got, err := transformer.Transform(content, Context{})
+		require.NoError(t, err)
+		assert.Equal(t, content, got)
+	})
+
+	t.Run("binary content", func(t *testing.T) {
+		binary := append([]byte{0x89, 'P', 'N', 'G', 0x00, 0x00}, fakeAWSKey...)
+		got, err := transformer.Transform(binary, Context{SecretScrub: &config.SecretScrub{FailOnMatch: true}})
+		require.NoError(t, err)
+		assert.Equal(t, binary, got)
+	})
+}
@@ -1,14 +1,16 @@
 package transform
 
 import (
-	"fmt"
+	"bytes"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/mrz1836/go-broadcast/internal/config"
 	"github.com/mrz1836/go-broadcast/internal/logging"
+	"github.com/mrz1836/go-broadcast/internal/pool"
 )
 
 // templateTransformer replaces template variables in content
@@ -17,6 +19,17 @@ type templateTransformer struct {
 	logConfig *logging.LogConfig
 }
 
+//nolint:gochecknoinits // Transforms register themselves with the pipeline registry
+func init() {
+	Register(Info{
+		Name:        config.TransformStepVariables,
+		ConfigKey:   "variables",
+		Description: "Replaces {{VAR}} and ${VAR} placeholders with configured variables",
+		TextOnly:    false,
+		New:         NewTemplateTransformer,
+	})
+}
+
 // NewTemplateTransformer creates a new template variable transformer.
 //
 // Parameters:
@@ -97,10 +110,6 @@ func (t *templateTransformer) Transform(content []byte, ctx Context) ([]byte, er
 		return content, nil
 	}
 
-	result := string(content)
-	replacedVars := make([]string, 0)
-	replacementCount := 0
-
 	// Sort variables by length (longest first) to avoid partial replacements
 	// e.g., replace {{SERVICE_NAME}} before {{SERVICE}}
 	varKeys := make([]string, 0, len(ctx.Variables))
@@ -113,42 +122,20 @@ func (t *templateTransformer) Transform(content []byte, ctx Context) ([]byte, er
 		return len(varKeys[i]) > len(varKeys[j])
 	})
 
-	// Replace each variable
-	for _, varName := range varKeys {
-		value := ctx.Variables[varName]
-
-		// Support both {{VAR}} and ${VAR} syntax
-		patterns := []string{
-			fmt.Sprintf("{{%s}}", varName),
-			fmt.Sprintf("${%s}", varName),
-		}
-
-		replaced := false
-		patternReplacements := 0
-
-		for _, pattern := range patterns {
-			// Count occurrences before replacement
-			currentReplacements := strings.Count(result, pattern)
-			if currentReplacements > 0 {
-				// Use strings.ReplaceAll for efficient literal string replacement
-				result = strings.ReplaceAll(result, pattern, value)
-				replaced = true
-				patternReplacements += currentReplacements
-			}
-		}
-
-		if replaced {
-			replacedVars = append(replacedVars, varName)
-			replacementCount += patternReplacements
+	result, replacements := substituteVariables(content, varKeys, ctx.Variables)
+	replacedVars := make([]string, 0, len(replacements))
+	replacementCount := 0
+	for _, r := range replacements {
+		replacedVars = append(replacedVars, r.name)
+		replacementCount += r.count
 
-			// Debug logging for individual variable replacements
-			if t.logConfig != nil && t.logConfig.Debug.Transform {
-				logger.WithFields(logrus.Fields{
-					logging.StandardFields.Variable:      varName,
-					logging.StandardFields.VariableValue: value,
-					logging.StandardFields.Replacements:  patternReplacements,
-				}).Trace("Variable substitution")
-			}
+		// Debug logging for individual variable replacements
+		if t.logConfig != nil && t.logConfig.Debug.Transform {
+			logger.WithFields(logrus.Fields{
+				logging.StandardFields.Variable:      r.name,
+				logging.StandardFields.VariableValue: ctx.Variables[r.name],
+				logging.StandardFields.Replacements:  r.count,
+			}).Trace("Variable substitution")
 		}
 	}
 
@@ -172,9 +159,9 @@ func (t *templateTransformer) Transform(content []byte, ctx Context) ([]byte, er
 
 			// Log final content for small files (with size limits)
 			if len(result) > 0 && len(result) < 2048 {
-				logger.WithField("content", result).Trace("Transformed content")
+				logger.WithField("content", string(result)).Trace("Transformed content")
 			}
-		} else {
+		} else if t.logger.IsLevelEnabled(logrus.DebugLevel) {
 			// Basic logging for backwards compatibility
 			t.logger.WithFields(logrus.Fields{
 				logging.StandardFields.Component: logging.ComponentNames.Transform,
@@ -218,21 +205,111 @@ func (t *templateTransformer) Transform(content []byte, ctx Context) ([]byte, er
 		}
 	}
 
-	return []byte(result), nil
+	return result, nil
+}
+
+// templateVariableSyntaxes are the opening and closing delimiters of the
+// supported variable forms, {{VAR}} and ${VAR}, in replacement order
+//
+//nolint:gochecknoglobals // This is a read-only lookup table
+var templateVariableSyntaxes = [...][2]string{{"{{", "}}"}, {"${", "}"}}
+
+// variableReplacement records how often a variable was substituted
+type variableReplacement struct {
+	name  string
+	count int
+}
+
+// substituteVariables replaces both forms of each variable in varKeys order.
+// The result is identical to replacing the patterns one after another with
+// strings.ReplaceAll, including values that themselves contain variables, but
+// each pass writes into one of two pooled buffers instead of a new string.
+// Content is only copied for patterns that occur in it, and is returned
+// unmodified (not copied) when nothing matched.
+func substituteVariables(content []byte, varKeys []string, variables map[string]string) ([]byte, []variableReplacement) {
+	var (
+		replacements []variableReplacement
+		buffers      [2]*bytes.Buffer
+		next         int
+		pattern      []byte
+	)
+	current := content
+
+	for _, varName := range varKeys {
+		value := variables[varName]
+		count := 0
+
+		for _, syntax := range templateVariableSyntaxes {
+			pattern = append(append(append(pattern[:0], syntax[0]...), varName...), syntax[1]...)
+			n := bytes.Count(current, pattern)
+			if n == 0 {
+				continue
+			}
+
+			// current never aliases buffers[next]: it is the content or the
+			// other buffer, written by the previous pass
+			size := len(current) + n*(len(value)-len(pattern))
+			if buffers[next] == nil {
+				buffers[next] = pool.GetBuffer(size)
+			}
+			out := buffers[next]
+			out.Reset()
+			out.Grow(size)
+
+			rest := current
+			for i := 0; i < n; i++ {
+				idx := bytes.Index(rest, pattern)
+				out.Write(rest[:idx])
+				out.WriteString(value)
+				rest = rest[idx+len(pattern):]
+			}
+			out.Write(rest)
+
+			current = out.Bytes()
+			next ^= 1
+			count += n
+		}
+
+		if count > 0 {
+			replacements = append(replacements, variableReplacement{name: varName, count: count})
+		}
+	}
+
+	if len(replacements) == 0 {
+		return content, nil
+	}
+
+	// The last written buffer holds the result. Buffers too large to be
+	// pooled are handed to the caller as is; others are copied out first.
+	last := buffers[next^1]
+	buffers[next^1] = nil
+	result := current
+	if last.Cap() <= pool.MaxPoolableSize {
+		result = bytes.Clone(current)
+		pool.PutBuffer(last)
+	}
+	pool.PutBuffer(buffers[next])
+
+	return result, replacements
 }
 
 // findUnreplacedVariables finds any remaining template variables in the content
-func (t *templateTransformer) findUnreplacedVariables(content string) []string {
+func (t *templateTransformer) findUnreplacedVariables(content []byte) []string {
+	// Skip the regex scans when no variable delimiter is left
+	if !bytes.Contains(content, []byte("{{")) && !bytes.Contains(content, []byte("${")) {
+		return []string{}
+	}
+
 	vars := make(map[string]bool)
 	cache := getDefaultCache()
 
 	// Find {{VAR}} style variables
 	re1, err := cache.CompileRegex(`\{\{([A-Z_][A-Z0-9_]*)\}\}`)
 	if err == nil {
-		matches1 := re1.FindAllStringSubmatch(content, -1)
+		matches1 := re1.FindAllSubmatch(content, -1)
 		for _, match := range matches1 {
 			if len(match) > 1 {
-				vars[match[1]] = true
+				vars[string(match[1])] = true
 			}
 		}
 	}
@@ -240,10 +317,10 @@ func (t *templateTransformer) findUnreplacedVariables(content string) []string {
 	// Find ${VAR} style variables
 	re2, err := cache.CompileRegex(`\$\{([A-Z_][A-Z0-9_]*)\}`)
 	if err == nil {
-		matches2 := re2.FindAllStringSubmatch(content, -1)
+		matches2 := re2.FindAllSubmatch(content, -1)
 		for _, match := range matches2 {
 			if len(match) > 1 {
-				vars[match[1]] = true
+				vars[string(match[1])] = true
 			}
 		}
 	}
@@ -0,0 +1,115 @@
+package transform
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/mrz1836/go-broadcast/internal/config"
+)
+
+// ErrTemplateRender is returned when a template file fails to parse or execute
+var ErrTemplateRender = errors.New("failed to render template")
+
+// TemplateRepo is the repository metadata available to rendered templates as .Repo
+type TemplateRepo struct {
+	Source     string // Source repository (e.g., "org/template-repo")
+	Target     string // Target repository (e.g., "org/service-a")
+	Owner      string // Target repository owner
+	Name       string // Target repository name
+	FilePath   string // Destination path of the rendered file
+	SourcePath string // Path of the template in the source repository
+}
+
+// templateRenderTransformer executes template files with text/template
+type templateRenderTransformer struct{}
+
+//nolint:gochecknoinits // Transforms register themselves with the pipeline registry
+func init() {
+	Register(Info{
+		Name:        config.TransformStepTemplateRender,
+		ConfigKey:   "template_render",
+		Description: "Renders template files as text/template and drops their suffix",
+		TextOnly:    true,
+		New:         withoutLogging(NewTemplateRenderTransformer),
+	})
+}
+
+// NewTemplateRenderTransformer creates a transformer that renders source files
+// ending in the context's TemplateSuffix as text/template. Variables are
+// available as top-level keys (e.g., {{.SERVICE_NAME}}) and repository metadata
+// as .Repo (e.g., {{.Repo.Name}}). Other files pass through unchanged.
+func NewTemplateRenderTransformer() Transformer {
+	return &templateRenderTransformer{}
+}
+
+// Name returns the name of this transformer
+func (t *templateRenderTransformer) Name() string {
+	return "template-renderer"
+}
+
+// Transform renders the content when the file is a template
+func (t *templateRenderTransformer) Transform(content []byte, ctx Context) ([]byte, error) {
+	sourcePath := ctx.SourcePath
+	if sourcePath == "" {
+		sourcePath = ctx.FilePath
+	}
+	if !IsTemplateFile(sourcePath, ctx.TemplateSuffix) {
+		return content, nil
+	}
+
+	tmpl, err := template.New(sourcePath).Option("missingkey=error").Parse(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("%w %s: %w", ErrTemplateRender, sourcePath, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, templateRenderData(ctx, sourcePath)); err != nil {
+		return nil, fmt.Errorf("%w %s: %w", ErrTemplateRender, sourcePath, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// templateRenderData builds the data passed to a template.
+// The reserved "Repo" key always holds the repository metadata.
+func templateRenderData(ctx Context, sourcePath string) map[string]interface{} {
+	data := make(map[string]interface{}, len(ctx.Variables)+1)
+	for name, value := range ctx.Variables {
+		data[name] = value
+	}
+
+	repo := TemplateRepo{
+		Source:     ctx.SourceRepo,
+		Target:     ctx.TargetRepo,
+		FilePath:   ctx.FilePath,
+		SourcePath: sourcePath,
+	}
+	if owner, name, ok := strings.Cut(ctx.TargetRepo, "/"); ok {
+		repo.Owner = owner
+		repo.Name = name
+	}
+	data["Repo"] = repo
+
+	return data
+}
+
+// IsTemplateFile reports whether path should be rendered for the given suffix.
+// An empty suffix disables rendering.
+func IsTemplateFile(path, suffix string) bool {
+	name := filepath.Base(path)
+	return suffix != "" && len(name) > len(suffix) && strings.HasSuffix(name, suffix)
+}
+
+// RenderedPath returns the destination path for a file mapping. When the
+// source is a template and the destination still carries the suffix, the
+// suffix is stripped; otherwise dest is returned unchanged.
+func RenderedPath(src, dest, suffix string) string {
+	if !IsTemplateFile(src, suffix) || !IsTemplateFile(dest, suffix) {
+		return dest
+	}
+	return strings.TrimSuffix(dest, suffix)
+}
@@ -0,0 +1,167 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateRenderTransformer_Name(t *testing.T) {
+	assert.Equal(t, "template-renderer", NewTemplateRenderTransformer().Name())
+}
+
+func TestTemplateRenderTransformer_Transform(t *testing.T) {
+	transformer := NewTemplateRenderTransformer()
+
+	baseCtx := Context{
+		SourceRepo:     "org/template-repo",
+		TargetRepo:     "acme/service-a",
+		FilePath:       ".github/workflows/ci.yml",
+		SourcePath:     ".github/workflows/ci.yml.tmpl",
+		TemplateSuffix: ".tmpl",
+		Variables: map[string]string{
+			"SERVICE_NAME": "service-a",
+			"GO_VERSION":   "1.24",
+		},
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		ctx     func(Context) Context
+		want    string
+	}{
+		{
+			name:    "renders variables",
+			content: "name: {{.SERVICE_NAME}}\ngo: {{.GO_VERSION}}",
+			want:    "name: service-a\ngo: 1.24",
+		},
+		{
+			name:    "renders repo metadata",
+			content: "{{.Repo.Owner}}/{{.Repo.Name}} from {{.Repo.Source}} at {{.Repo.FilePath}}",
+			want:    "acme/service-a from org/template-repo at .github/workflows/ci.yml",
+		},
+		{
+			name:    "supports template actions",
+			content: `{{if eq .Repo.Name "service-a"}}primary{{else}}secondary{{end}}`,
+			want:    "primary",
+		},
+		{
+			name:    "repo metadata takes precedence over a Repo variable",
+			content: "{{.Repo.Target}}",
+			ctx: func(ctx Context) Context {
+				ctx.Variables = map[string]string{"Repo": "shadowed"}
+				return ctx
+			},
+			want: "acme/service-a",
+		},
+		{
+			name:    "non-matching file passes through unchanged",
+			content: "name: {{.SERVICE_NAME}}",
+			ctx: func(ctx Context) Context {
+				ctx.SourcePath = "README.md"
+				return ctx
+			},
+			want: "name: {{.SERVICE_NAME}}",
+		},
+		{
+			name:    "rendering disabled without a suffix",
+			content: "name: {{.SERVICE_NAME}}",
+			ctx: func(ctx Context) Context {
+				ctx.TemplateSuffix = ""
+				return ctx
+			},
+			want: "name: {{.SERVICE_NAME}}",
+		},
+		{
+			name:    "falls back to file path when source path is empty",
+			content: "name: {{.SERVICE_NAME}}",
+			ctx: func(ctx Context) Context {
+				ctx.SourcePath = ""
+				ctx.FilePath = "config.yml.tmpl"
+				return ctx
+			},
+			want: "name: service-a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := baseCtx
+			if tt.ctx != nil {
+				ctx = tt.ctx(ctx)
+			}
+
+			result, err := transformer.Transform([]byte(tt.content), ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(result))
+		})
+	}
+}
+
+func TestTemplateRenderTransformer_TransformErrors(t *testing.T) {
+	transformer := NewTemplateRenderTransformer()
+	ctx := Context{
+		TargetRepo:     "acme/service-a",
+		SourcePath:     "config.yml.tmpl",
+		TemplateSuffix: ".tmpl",
+		Variables:      map[string]string{"NAME": "x"},
+	}
+
+	t.Run("parse error", func(t *testing.T) {
+		_, err := transformer.Transform([]byte("{{.NAME"), ctx)
+		require.ErrorIs(t, err, ErrTemplateRender)
+		assert.Contains(t, err.Error(), "config.yml.tmpl")
+	})
+
+	t.Run("missing variable", func(t *testing.T) {
+		_, err := transformer.Transform([]byte("{{.UNDEFINED}}"), ctx)
+		require.ErrorIs(t, err, ErrTemplateRender)
+		assert.Contains(t, err.Error(), "UNDEFINED")
+	})
+}
+
+func TestIsTemplateFile(t *testing.T) {
+	tests := []struct {
+		path   string
+		suffix string
+		want   bool
+	}{
+		{"ci.yml.tmpl", ".tmpl", true},
+		{"dir/Makefile.tmpl", ".tmpl", true},
+		{"ci.yml", ".tmpl", false},
+		{"ci.yml.tmpl", "", false},
+		{".tmpl", ".tmpl", false},
+		{"dir/.tmpl", ".tmpl", false},
+		{"ci.yml.gotmpl", ".gotmpl", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path+"|"+tt.suffix, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsTemplateFile(tt.path, tt.suffix))
+		})
+	}
+}
+
+func TestRenderedPath(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		dest string
+		want string
+	}{
+		{"strips suffix", "ci.yml.tmpl", "ci.yml.tmpl", "ci.yml"},
+		{"strips suffix from renamed dest", "templates/ci.yml.tmpl", ".github/workflows/ci.yml.tmpl", ".github/workflows/ci.yml"},
+		{"dest already stripped", "ci.yml.tmpl", "ci.yml", "ci.yml"},
+		{"source not a template", "ci.yml", "ci.yml.tmpl", "ci.yml.tmpl"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RenderedPath(tt.src, tt.dest, ".tmpl"))
+		})
+	}
+
+	assert.Equal(t, "ci.yml.tmpl", RenderedPath("ci.yml.tmpl", "ci.yml.tmpl", ""))
+}
@@ -1,6 +1,8 @@
 package transform
 
 import (
+	"bytes"
+	"math/rand"
 	"strings"
 	"testing"
 
@@ -174,7 +176,7 @@ func TestTemplateTransformer_FindUnreplacedVariables(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			vars := transformer.findUnreplacedVariables(tt.content)
+			vars := transformer.findUnreplacedVariables([]byte(tt.content))
 
 			// Sort for consistent comparison
 			assert.ElementsMatch(t, tt.expected, vars)
@@ -622,7 +624,7 @@ func TestFindUnreplacedVariablesEdgeCases(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			vars := transformer.findUnreplacedVariables(tt.content)
+			vars := transformer.findUnreplacedVariables([]byte(tt.content))
 			assert.ElementsMatch(t, tt.expected, vars)
 		})
 	}
@@ -645,3 +647,84 @@ func formatValue(v interface{}) string {
 		return "value"
 	}
 }
+
+// replaceVariablesReference is the sequential strings.ReplaceAll substitution
+// that substituteVariables must reproduce exactly
+func replaceVariablesReference(content string, varKeys []string, variables map[string]string) string {
+	for _, varName := range varKeys {
+		content = strings.ReplaceAll(content, "{{"+varName+"}}", variables[varName])
+		content = strings.ReplaceAll(content, "${"+varName+"}", variables[varName])
+	}
+	return content
+}
+
+func TestSubstituteVariables(t *testing.T) {
+	t.Run("matches sequential replacement", func(t *testing.T) {
+		testCases := []struct {
+			name      string
+			content   string
+			varKeys   []string
+			variables map[string]string
+		}{
+			{"no matches", "plain text", []string{"NAME"}, map[string]string{"NAME": "x"}},
+			{"both syntaxes", "{{NAME}} and ${NAME}", []string{"NAME"}, map[string]string{"NAME": "svc"}},
+			{"longest first", "{{SERVICE_NAME}} {{SERVICE}}", []string{"SERVICE_NAME", "SERVICE"}, map[string]string{"SERVICE_NAME": "a", "SERVICE": "b"}},
+			{"value contains a later variable", "{{OUTER}}", []string{"OUTER", "IN"}, map[string]string{"OUTER": "<{{IN}}>", "IN": "x"}},
+			{"value contains an earlier variable", "{{IN}}", []string{"OUTER", "IN"}, map[string]string{"OUTER": "y", "IN": "{{OUTER}}"}},
+			{"value contains its own pattern", "{{SELF}}", []string{"SELF"}, map[string]string{"SELF": "{{SELF}}{{SELF}}"}},
+			{"replacement forms a new pattern", "{{{{A}}B}}", []string{"AB", "A"}, map[string]string{"AB": "done", "A": ""}},
+			{"empty value", "a{{GONE}}b${GONE}c", []string{"GONE"}, map[string]string{"GONE": ""}},
+			{"adjacent", "{{X}}{{X}}${X}${X}", []string{"X"}, map[string]string{"X": "longer-value"}},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				result, _ := substituteVariables([]byte(tc.content), tc.varKeys, tc.variables)
+				assert.Equal(t, replaceVariablesReference(tc.content, tc.varKeys, tc.variables), string(result))
+			})
+		}
+	})
+
+	t.Run("randomized content", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1)) //nolint:gosec // deterministic test data
+		varKeys := []string{"SERVICE_NAME", "SERVICE", "ENV", "A"}
+		variables := map[string]string{"SERVICE_NAME": "{{ENV}}-svc", "SERVICE": "s", "ENV": "${A}", "A": "prod"}
+		fragments := []string{"{{", "}}", "${", "}", "SERVICE", "_NAME", "ENV", "A", "text ", "\n", "{{SERVICE_NAME}}", "${ENV}"}
+
+		for i := 0; i < 500; i++ {
+			var sb strings.Builder
+			for j := rng.Intn(40); j > 0; j-- {
+				sb.WriteString(fragments[rng.Intn(len(fragments))])
+			}
+			content := sb.String()
+			result, _ := substituteVariables([]byte(content), varKeys, variables)
+			require.Equal(t, replaceVariablesReference(content, varKeys, variables), string(result), content)
+		}
+	})
+
+	t.Run("counts and leaves the input untouched", func(t *testing.T) {
+		content := []byte("{{A}} ${A} {{B}}")
+		original := bytes.Clone(content)
+		result, replacements := substituteVariables(content, []string{"A", "B", "C"}, map[string]string{"A": "1", "B": "2", "C": "3"})
+
+		assert.Equal(t, "1 1 2", string(result))
+		assert.Equal(t, []variableReplacement{{name: "A", count: 2}, {name: "B", count: 1}}, replacements)
+		assert.Equal(t, original, content)
+
+		// Results copied out of pooled buffers stay valid after later calls
+		_, _ = substituteVariables(content, []string{"A"}, map[string]string{"A": "overwritten"})
+		assert.Equal(t, "1 1 2", string(result))
+	})
+
+	t.Run("large content beyond the pooled size", func(t *testing.T) {
+		content := strings.Repeat("line {{NAME}} ${ENV}\n", 20000)
+		varKeys := []string{"NAME", "ENV"}
+		variables := map[string]string{"NAME": "service", "ENV": "prod"}
+
+		first, _ := substituteVariables([]byte(content), varKeys, variables)
+		second, _ := substituteVariables([]byte(content), varKeys, variables)
+		expected := replaceVariablesReference(content, varKeys, variables)
+		assert.Equal(t, expected, string(first), "results must not share buffers")
+		assert.Equal(t, expected, string(second))
+	})
+}
@@ -4,6 +4,7 @@ package transform
 import (
 	"context"
 
+	"github.com/mrz1836/go-broadcast/internal/config"
 	"github.com/mrz1836/go-broadcast/internal/logging"
 )
 
@@ -27,6 +28,51 @@ type Context struct {
 	// FilePath is the path of the file being transformed
 	FilePath string
 
+	// SourcePath is the path of the file in the source repository, when it
+	// differs from FilePath (e.g., a rendered template with its suffix stripped)
+	SourcePath string
+
+	// TemplateSuffix marks source files that are rendered as text/template
+	// (e.g., ".tmpl"); empty disables template rendering
+	TemplateSuffix string
+
+	// GoModulePath is the module path imports of the source module are
+	// rewritten to in .go files; empty disables import rewriting
+	GoModulePath string
+
+	// GoSourceModulePath is the Go module path of the source repository;
+	// empty means github.com/<SourceRepo>
+	GoSourceModulePath string
+
+	// CopyrightYear is the end year written into copyright notices; zero
+	// disables copyright year updates
+	CopyrightYear int
+
+	// ManagedHeader is the text of the managed header written at the top of
+	// each file; empty disables the header
+	ManagedHeader string
+
+	// StripManagedHeader removes an existing managed header instead of
+	// writing one
+	StripManagedHeader bool
+
+	// LineEndings is the line ending mode text files are normalized to
+	// (config.LineEndingsLF or config.LineEndingsCRLF); empty or
+	// config.LineEndingsPreserve keeps them unchanged
+	LineEndings string
+
+	// SecretScrub configures the text scrubbed from text files as secrets;
+	// nil disables scrubbing
+	SecretScrub *config.SecretScrub
+
+	// ConditionalBlocks resolves go-broadcast:include and go-broadcast:exclude
+	// blocks for the target; false leaves them untouched
+	ConditionalBlocks bool
+
+	// TargetTopics are the topics of the target repository that conditional
+	// block topic= values are matched against
+	TargetTopics []string
+
 	// Variables contains custom variables for template substitution
 	Variables map[string]string
 
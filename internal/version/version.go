@@ -42,6 +42,7 @@ type GitHubRelease struct {
 	Prerelease  bool      `json:"prerelease"`
 	PublishedAt time.Time `json:"published_at"`
 	Body        string    `json:"body"`
+	HTMLURL     string    `json:"html_url"`
 }
 
 // Info contains version information
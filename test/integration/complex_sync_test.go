@@ -83,7 +83,7 @@ func testMultiRepoSyncWithConflicts(t *testing.T, generator *fixtures.TestRepoGe
 		Return(scenario.State, nil)
 
 	// Mock GitHub operations for source repo
-	mockGH.On("GetFile", mock.Anything, "org/template-repo", mock.AnythingOfType("string"), "").
+	mockGH.On("GetFile", mock.Anything, "org/template-repo", mock.AnythingOfType("string"), mock.Anything).
 		Return(&gh.FileContent{Content: []byte("source content")}, nil).Maybe()
 
 	// Mock GitHub operations for target repos with different responses
@@ -206,6 +206,10 @@ func testPartialSyncFailureRecovery(t *testing.T, generator *fixtures.TestRepoGe
 	mockState.On("DiscoverState", mock.Anything, scenario.Config).
 		Return(scenario.State, nil)
 
+	// Mock source content at the source commit (differs from targets, so a sync is required)
+	mockGH.On("GetFile", mock.Anything, "org/template-repo", mock.AnythingOfType("string"), mock.Anything).
+		Return(&gh.FileContent{Content: []byte("source content")}, nil).Maybe()
+
 	// Mock successful operations for most repos
 	mockGH.On("GetFile", mock.Anything, mock.MatchedBy(func(repo string) bool {
 		return !strings.Contains(repo, "service-b")
@@ -357,9 +361,9 @@ func testLargeFileHandling(t *testing.T, generator *fixtures.TestRepoGenerator)
 		largeContent[i] = byte('A' + (i % 26))
 	}
 
-	mockGH.On("GetFile", mock.Anything, "org/template-repo", "large_file_50mb.txt", "").
+	mockGH.On("GetFile", mock.Anything, "org/template-repo", "large_file_50mb.txt", mock.Anything).
 		Return(&gh.FileContent{Content: largeContent}, nil)
-	mockGH.On("GetFile", mock.Anything, "org/template-repo", "README.md", "").
+	mockGH.On("GetFile", mock.Anything, "org/template-repo", "README.md", mock.Anything).
 		Return(&gh.FileContent{Content: []byte("# Large File Repository")}, nil)
 	mockGH.On("GetFile", mock.Anything, "org/large-service", mock.AnythingOfType("string"), "").
 		Return(&gh.FileContent{Content: []byte("old content")}, nil).Maybe()
@@ -472,6 +476,10 @@ func testConcurrentSyncOperations(t *testing.T, generator *fixtures.TestRepoGene
 	mockState.On("DiscoverState", mock.Anything, scenario.Config).
 		Return(scenario.State, nil)
 
+	// Mock source content at the source commit
+	mockGH.On("GetFile", mock.Anything, "org/template-repo", mock.AnythingOfType("string"), mock.Anything).
+		Return(&gh.FileContent{Content: []byte("source content")}, nil).Maybe()
+
 	// Mock GitHub operations with concurrency tracking
 	mockGH.On("GetFile", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), "").
 		Run(func(mock.Arguments) {
@@ -596,7 +604,7 @@ func testMemoryUsageMonitoring(t *testing.T, generator *fixtures.TestRepoGenerat
 		Return(scenario.State, nil)
 
 	// Mock different sized content
-	mockGH.On("GetFile", mock.Anything, "org/template-repo", mock.AnythingOfType("string"), "").
+	mockGH.On("GetFile", mock.Anything, "org/template-repo", mock.AnythingOfType("string"), mock.Anything).
 		Return(&gh.FileContent{Content: []byte("template content")}, nil).Maybe()
 
 	for _, target := range scenario.TargetRepos {
@@ -759,6 +767,10 @@ func testStateConsistencyAcrossFailures(t *testing.T, generator *fixtures.TestRe
 	// Mock operations with some controlled failures
 	failureRate := 0.3 // 30% failure rate
 	_ = failureRate    // Mark as used for linter
+	// Mock source content at the source commit
+	mockGH.On("GetFile", mock.Anything, "org/template-repo", mock.AnythingOfType("string"), mock.Anything).
+		Return(&gh.FileContent{Content: []byte("source content")}, nil).Maybe()
+
 	mockGH.On("GetFile", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), "").
 		Return(func(_ context.Context, _, _, _ string) (*gh.FileContent, error) {
 			// Introduce random failures
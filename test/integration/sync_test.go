@@ -308,9 +308,9 @@ func TestEndToEndSync(t *testing.T) {
 		mockGit.On("Push", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil).Maybe()
 
 		// Mock getting source files
-		mockGH.On("GetFile", mock.Anything, "org/template-repo", ".github/workflows/ci.yml", "").
+		mockGH.On("GetFile", mock.Anything, "org/template-repo", ".github/workflows/ci.yml", mock.Anything).
 			Return(&gh.FileContent{Content: []byte("workflow content")}, nil).Maybe()
-		mockGH.On("GetFile", mock.Anything, "org/template-repo", "Makefile", "").
+		mockGH.On("GetFile", mock.Anything, "org/template-repo", "Makefile", mock.Anything).
 			Return(&gh.FileContent{Content: []byte("makefile content")}, nil).Maybe()
 
 		// Mock ListBranches for orphaned branch cleanup check